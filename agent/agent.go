@@ -189,6 +189,20 @@ const (
 	LogSinkRateLimitBurst      = "LOGSINK_RATELIMIT_BURST"
 	LogSinkRateLimitRefill     = "LOGSINK_RATELIMIT_REFILL"
 
+	// LogSinkEntityRateLimitBurst and LogSinkEntityRateLimitRefill
+	// configure the per-agent rate limit applied by the controller's
+	// logsink. Log records sent in excess of the limit are dropped.
+	LogSinkEntityRateLimitBurst  = "LOGSINK_ENTITY_RATELIMIT_BURST"
+	LogSinkEntityRateLimitRefill = "LOGSINK_ENTITY_RATELIMIT_REFILL"
+
+	// ModelLogfileMaxSize and ModelLogfileMaxBackups configure the rotation
+	// of the per-model log files written by controllers. They are seeded
+	// from the controller's model-logfile-max-size and
+	// model-logfile-max-backups. A single model can override either with
+	// the key returned by ModelLogfileOverrideKey.
+	ModelLogfileMaxSize    = "MODEL_LOGFILE_MAX_SIZE"
+	ModelLogfileMaxBackups = "MODEL_LOGFILE_MAX_BACKUPS"
+
 	// These values are used to override various aspects of worker behaviour.
 	// They are used for debugging or testing purposes.
 
@@ -197,6 +211,12 @@ const (
 	CharmRevisionUpdateInterval = "CHARM_REVISION_UPDATE_INTERVAL"
 )
 
+// ModelLogfileOverrideKey returns the agent config key that overrides the
+// given model log file setting for a single model.
+func ModelLogfileOverrideKey(key, modelUUID string) string {
+	return key + "_" + modelUUID
+}
+
 // The Config interface is the sole way that the agent gets access to the
 // configuration information for the machine and unit agents.  There should
 // only be one instance of a config object for any given agent, and this
@@ -290,6 +310,10 @@ type Config interface {
 	// deployments. Empty means no X-Scope-OrgID header is sent.
 	LokiOrgID() string
 
+	// LokiProtocol returns the protocol spoken by the Loki endpoint, either
+	// "loki" or "otlp". Empty means "loki".
+	LokiProtocol() string
+
 	// Value returns the value associated with the key, or an empty string if
 	// the key is not found.
 	Value(key string) string
@@ -396,8 +420,9 @@ type configSetterOnly interface {
 	SetLoggingConfig(string)
 
 	// SetLokiConfig sets the Loki config values for the agent. The endpoint,
-	// CA certificate, insecure skip verify flag, and org ID are updated together.
-	SetLokiConfig(endpoint string, caCert *string, insecureSkipVerify *bool, orgID string)
+	// CA certificate, insecure skip verify flag, org ID and protocol are
+	// updated together.
+	SetLokiConfig(endpoint string, caCert *string, insecureSkipVerify *bool, orgID, protocol string)
 
 	// SetQueryTracingEnabled sets whether query tracing is enabled.
 	SetQueryTracingEnabled(bool)
@@ -513,6 +538,7 @@ type configInternal struct {
 	lokiCACert                         string
 	lokiInsecureSkipVerify             *bool
 	lokiOrgID                          string
+	lokiProtocol                       string
 	values                             map[string]string
 	agentLogfileMaxSizeMB              int
 	agentLogfileMaxBackups             int
@@ -571,6 +597,9 @@ type AgentConfigParams struct {
 	// LokiOrgID is the organization/tenant ID for multi-tenant Loki
 	// deployments. Empty means no X-Scope-OrgID header is sent.
 	LokiOrgID string
+	// LokiProtocol is the protocol spoken by the Loki endpoint, either
+	// "loki" or "otlp". Empty means "loki".
+	LokiProtocol string
 }
 
 // NewAgentConfig returns a new config object suitable for use for a
@@ -648,6 +677,7 @@ func NewAgentConfig(configParams AgentConfigParams) (ConfigSetterWriter, error)
 		lokiCACert:                         configParams.LokiCACert,
 		lokiInsecureSkipVerify:             configParams.LokiInsecureSkipVerify,
 		lokiOrgID:                          configParams.LokiOrgID,
+		lokiProtocol:                       configParams.LokiProtocol,
 	}
 	if len(configParams.APIAddresses) > 0 {
 		config.apiDetails = &apiDetails{
@@ -819,8 +849,13 @@ func (c *configInternal) LokiOrgID() string {
 	return c.lokiOrgID
 }
 
+// LokiProtocol implements Config.
+func (c *configInternal) LokiProtocol() string {
+	return c.lokiProtocol
+}
+
 // SetLokiConfig implements configSetterOnly.
-func (c *configInternal) SetLokiConfig(endpoint string, caCert *string, insecureSkipVerify *bool, orgID string) {
+func (c *configInternal) SetLokiConfig(endpoint string, caCert *string, insecureSkipVerify *bool, orgID, protocol string) {
 	c.lokiEndpoint = endpoint
 	if caCert != nil {
 		c.lokiCACert = *caCert
@@ -829,6 +864,7 @@ func (c *configInternal) SetLokiConfig(endpoint string, caCert *string, insecure
 	}
 	c.lokiInsecureSkipVerify = copyBoolPointer(insecureSkipVerify)
 	c.lokiOrgID = orgID
+	c.lokiProtocol = protocol
 }
 
 // copyBoolPointer preserves the Config snapshot contract: callers must not be
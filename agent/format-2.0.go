@@ -53,6 +53,7 @@ type format_2_0Serialization struct {
 	LokiCACert             string            `yaml:"lokicacert,omitempty"`
 	LokiInsecureSkipVerify *bool             `yaml:"lokiinsecureskipverify,omitempty"`
 	LokiOrgID              string            `yaml:"lokiorgid,omitempty"`
+	LokiProtocol           string            `yaml:"lokiprotocol,omitempty"`
 	Values                 map[string]string `yaml:"values"`
 
 	AgentLogfileMaxSizeMB  int `yaml:"agent-logfile-max-size"`
@@ -135,6 +136,7 @@ func (formatter_2_0) unmarshal(data []byte) (*configInternal, error) {
 		lokiCACert:             format.LokiCACert,
 		lokiInsecureSkipVerify: format.LokiInsecureSkipVerify,
 		lokiOrgID:              format.LokiOrgID,
+		lokiProtocol:           format.LokiProtocol,
 		values:                 format.Values,
 
 		agentLogfileMaxSizeMB:  format.AgentLogfileMaxSizeMB,
@@ -218,6 +220,7 @@ func (formatter_2_0) marshal(config *configInternal) ([]byte, error) {
 		LokiCACert:             config.lokiCACert,
 		LokiInsecureSkipVerify: config.lokiInsecureSkipVerify,
 		LokiOrgID:              config.lokiOrgID,
+		LokiProtocol:           config.lokiProtocol,
 		Values:                 config.values,
 
 		AgentLogfileMaxSizeMB:  config.agentLogfileMaxSizeMB,
@@ -15,6 +15,7 @@ import (
 	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -76,6 +77,21 @@ func (st *Client) StateServingInfo(ctx context.Context) (controller.ControllerAg
 	}, nil
 }
 
+// SecretKeyEncryptionKeys returns the keys the controller uses to wrap the
+// data keys encrypting secret content.
+func (st *Client) SecretKeyEncryptionKeys(ctx context.Context) ([]envelope.KeyEncryptionKey, error) {
+	var results params.StateServingInfo
+	err := st.facade.FacadeCall(ctx, "StateServingInfo", nil, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	keys := make([]envelope.KeyEncryptionKey, len(results.SecretKeyEncryptionKeys))
+	for i, key := range results.SecretKeyEncryptionKeys {
+		keys[i] = envelope.KeyEncryptionKey{ID: key.ID, Key: key.Key}
+	}
+	return keys, nil
+}
+
 type Entity struct {
 	st  *Client
 	tag names.Tag
@@ -14,6 +14,7 @@ import (
 	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/testhelpers"
 	"github.com/juju/juju/rpc/params"
 )
@@ -56,6 +57,28 @@ func (s *clientSuite) TestStateServingInfo(c *tc.C) {
 	})
 }
 
+func (s *clientSuite) TestSecretKeyEncryptionKeys(c *tc.C) {
+	apiCaller := basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Check(objType, tc.Equals, "Agent")
+		c.Check(request, tc.Equals, "StateServingInfo")
+		*result.(*params.StateServingInfo) = params.StateServingInfo{
+			SecretKeyEncryptionKeys: []params.SecretKeyEncryptionKey{{
+				ID:  "kek-1",
+				Key: []byte("key-material"),
+			}},
+		}
+		return nil
+	})
+	client, err := agent.NewClient(apiCaller)
+	c.Assert(err, tc.ErrorIsNil)
+	keys, err := client.SecretKeyEncryptionKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(keys, tc.DeepEquals, []envelope.KeyEncryptionKey{{
+		ID:  "kek-1",
+		Key: []byte("key-material"),
+	}})
+}
+
 func (s *clientSuite) TestIsControllerShortCircuits(c *tc.C) {
 	result, err := agent.IsController(c.Context(), nil, names.NewControllerAgentTag("0"))
 	c.Assert(err, tc.ErrorIsNil)
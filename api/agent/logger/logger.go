@@ -34,6 +34,7 @@ type ControllerLokiConfig struct {
 	CACert             string
 	InsecureSkipVerify *bool
 	OrgID              string
+	Protocol           string
 }
 
 // NewClient returns a version of the logger client that provides functionality
@@ -84,6 +85,7 @@ func (c *Client) GetControllerLokiConfig(ctx context.Context, agentTag names.Tag
 		CACert:             caCert,
 		InsecureSkipVerify: result.InsecureSkipVerify,
 		OrgID:              result.OrgID,
+		Protocol:           result.Protocol,
 	}, nil
 }
 
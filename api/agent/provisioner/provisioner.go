@@ -47,9 +47,13 @@ type MachineStatusResult struct {
 
 // DistributionGroupResult provides a slice of machine.Ids in the
 // distribution group and any Error related to finding it.
+// ExcludedZones and AntiAffinityMachineIds carry the application
+// placement rules that apply to the machine, when they are known.
 type DistributionGroupResult struct {
-	MachineIds []string
-	Err        *params.Error
+	MachineIds             []string
+	ExcludedZones          []string
+	AntiAffinityMachineIds []string
+	Err                    *params.Error
 }
 
 // LXDProfileResult provides a charm.LXDProfile, adding the name.
@@ -1537,6 +1537,7 @@ func (s *apiclientSuite) TestWatchDebugLogParamsEncoded(c *tc.C) {
 		NoTail:        true,
 		Firehose:      true,
 		StartTime:     time.Date(2016, 11, 30, 11, 48, 0, 100, time.UTC),
+		EndTime:       time.Date(2016, 12, 1, 9, 0, 0, 0, time.UTC),
 	}
 
 	urlValues := url.Values{
@@ -1554,6 +1555,7 @@ func (s *apiclientSuite) TestWatchDebugLogParamsEncoded(c *tc.C) {
 		"noTail":        {"true"},
 		"firehose":      {"true"},
 		"startTime":     {"2016-11-30T11:48:00.0000001Z"},
+		"endTime":       {"2016-12-01T09:00:00Z"},
 	}
 
 	info := s.APIInfo()
@@ -575,6 +575,45 @@ func (c *Client) DestroyConsumedApplication(ctx context.Context, in DestroyConsu
 	return allResults, nil
 }
 
+// PreviewDestroyConsumedApplication returns, for each of the given consumed
+// (remote) applications, the entities that would be removed if it were
+// destroyed. Nothing is removed.
+func (c *Client) PreviewDestroyConsumedApplication(ctx context.Context, saasNames ...string) ([]params.RemovalPreviewResult, error) {
+	if c.BestAPIVersion() < 23 {
+		return nil, errors.NotImplementedf("previewing SAAS application removal on this version of Juju")
+	}
+	args := params.DestroyConsumedApplicationsParams{
+		Applications: make([]params.DestroyConsumedApplicationParams, 0, len(saasNames)),
+	}
+
+	allResults := make([]params.RemovalPreviewResult, len(saasNames))
+	index := make([]int, 0, len(saasNames))
+	for i, name := range saasNames {
+		if !names.IsValidApplication(name) {
+			allResults[i].Error = &params.Error{
+				Message: errors.NotValidf("SAAS application name %q", name).Error(),
+			}
+			continue
+		}
+		index = append(index, i)
+		args.Applications = append(args.Applications, params.DestroyConsumedApplicationParams{
+			ApplicationTag: names.NewApplicationTag(name).String(),
+		})
+	}
+
+	var result params.RemovalPreviewResults
+	if err := c.facade.FacadeCall(ctx, "PreviewDestroyConsumedApplications", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if n := len(result.Results); n != len(args.Applications) {
+		return nil, errors.Errorf("expected %d result(s), got %d", len(args.Applications), n)
+	}
+	for i, result := range result.Results {
+		allResults[index[i]] = result
+	}
+	return allResults, nil
+}
+
 // ScaleApplicationParams contains parameters for the ScaleApplication API method.
 type ScaleApplicationParams struct {
 	// ApplicationName is the application to scale.
@@ -1300,3 +1339,93 @@ func (c *Client) UpdateApplicationStorage(applicationStorageUpdate ApplicationSt
 	}
 	return nil
 }
+
+// PlacementRules holds the placement rules for the units of an application.
+type PlacementRules struct {
+	// MaxUnitsPerMachine is the maximum number of units that may share a
+	// machine. Zero means there is no limit.
+	MaxUnitsPerMachine int
+
+	// MaxUnitsPerZone is the maximum number of units that may share an
+	// availability zone. Zero means there is no limit.
+	MaxUnitsPerZone int
+
+	// AntiAffinity holds the names of the applications whose units must
+	// never share a machine with the units of the application.
+	AntiAffinity []string
+
+	// Affinity holds the names of the applications whose units must be
+	// present on the machines the units of the application are placed on.
+	Affinity []string
+}
+
+// SetPlacementRules replaces the placement rules of the application.
+func (c *Client) SetPlacementRules(ctx context.Context, applicationName string, rules PlacementRules) error {
+	if c.BestAPIVersion() < 24 {
+		return errors.NotImplementedf("placement rules on this version of Juju")
+	}
+	in := params.SetApplicationPlacementRulesArgs{
+		Args: []params.SetApplicationPlacementRulesArg{{
+			ApplicationTag: names.NewApplicationTag(applicationName).String(),
+			Rules: params.ApplicationPlacementRules{
+				MaxUnitsPerMachine: rules.MaxUnitsPerMachine,
+				MaxUnitsPerZone:    rules.MaxUnitsPerZone,
+				AntiAffinity:       rules.AntiAffinity,
+				Affinity:           rules.Affinity,
+			},
+		}},
+	}
+	var out params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "SetPlacementRules", in, &out); err != nil {
+		return errors.Trace(err)
+	}
+	return out.OneError()
+}
+
+// RenameApplication changes the name of an application that has no units.
+func (c *Client) RenameApplication(ctx context.Context, applicationName, newName string) error {
+	if c.BestAPIVersion() < 25 {
+		return errors.NotImplementedf("renaming applications on this version of Juju")
+	}
+	in := params.RenameApplicationArgs{
+		Args: []params.RenameApplicationArg{{
+			ApplicationTag: names.NewApplicationTag(applicationName).String(),
+			Name:           newName,
+		}},
+	}
+	var out params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "RenameApplication", in, &out); err != nil {
+		return errors.Trace(err)
+	}
+	return out.OneError()
+}
+
+// GetPlacementRules returns the placement rules of the application.
+func (c *Client) GetPlacementRules(ctx context.Context, applicationName string) (PlacementRules, error) {
+	if c.BestAPIVersion() < 24 {
+		return PlacementRules{}, errors.NotImplementedf("placement rules on this version of Juju")
+	}
+	in := params.Entities{Entities: []params.Entity{{
+		Tag: names.NewApplicationTag(applicationName).String(),
+	}}}
+	var out params.ApplicationPlacementRulesResults
+	if err := c.facade.FacadeCall(ctx, "GetPlacementRules", in, &out); err != nil {
+		return PlacementRules{}, errors.Trace(err)
+	}
+	if resultsLen := len(out.Results); resultsLen != 1 {
+		return PlacementRules{}, errors.Errorf("expected 1 result, got %d", resultsLen)
+	}
+	result := out.Results[0]
+	if result.Error != nil {
+		return PlacementRules{}, apiservererrors.RestoreError(result.Error)
+	}
+	if result.Rules == nil {
+		return PlacementRules{}, nil
+	}
+	return PlacementRules{
+		MaxUnitsPerMachine: result.Rules.MaxUnitsPerMachine,
+		MaxUnitsPerZone:    result.Rules.MaxUnitsPerZone,
+		AntiAffinity:       result.Rules.AntiAffinity,
+		Affinity:           result.Rules.Affinity,
+	}, nil
+}
@@ -467,6 +467,165 @@ func (s *applicationSuite) TestDestroyConsumedApplications(c *tc.C) {
 	c.Check(res, tc.HasLen, 2)
 }
 
+func (s *applicationSuite) TestPreviewDestroyConsumedApplication(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	result := new(params.RemovalPreviewResults)
+	preview := &params.RemovalPreview{
+		RemoteApplications: []params.Entity{{Tag: "application-foo"}},
+	}
+	results := params.RemovalPreviewResults{
+		Results: []params.RemovalPreviewResult{{Info: preview}},
+	}
+	args := params.DestroyConsumedApplicationsParams{
+		Applications: []params.DestroyConsumedApplicationParams{
+			{ApplicationTag: "application-foo"},
+		},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "PreviewDestroyConsumedApplications", args, result).DoAndReturn(
+		func(_ context.Context, _ string, _ any, result any) error {
+			reflect.ValueOf(result).Elem().Set(reflect.ValueOf(results))
+			return nil
+		})
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(23).AnyTimes()
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	client.ClientFacade = mockClientFacade
+	res, err := client.PreviewDestroyConsumedApplication(c.Context(), "!", "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res, tc.HasLen, 2)
+	c.Check(res[0].Error, tc.ErrorMatches, `SAAS application name "!" not valid`)
+	c.Check(res[1], tc.DeepEquals, params.RemovalPreviewResult{Info: preview})
+}
+
+func (s *applicationSuite) TestPreviewDestroyConsumedApplicationNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(22).AnyTimes()
+
+	client := application.NewClientFromCaller(mocks.NewMockFacadeCaller(ctrl))
+	client.ClientFacade = mockClientFacade
+	_, err := client.PreviewDestroyConsumedApplication(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
+}
+
+func (s *applicationSuite) TestSetPlacementRules(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.SetApplicationPlacementRulesArgs{
+		Args: []params.SetApplicationPlacementRulesArg{{
+			ApplicationTag: "application-foo",
+			Rules: params.ApplicationPlacementRules{
+				MaxUnitsPerMachine: 1,
+				AntiAffinity:       []string{"bar"},
+			},
+		}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "SetPlacementRules", args, result).DoAndReturn(
+		func(_ context.Context, _ string, _ any, result any) error {
+			reflect.ValueOf(result).Elem().Set(reflect.ValueOf(results))
+			return nil
+		})
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(24).AnyTimes()
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	client.ClientFacade = mockClientFacade
+	err := client.SetPlacementRules(c.Context(), "foo", application.PlacementRules{
+		MaxUnitsPerMachine: 1,
+		AntiAffinity:       []string{"bar"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *applicationSuite) TestRenameApplication(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.RenameApplicationArgs{
+		Args: []params.RenameApplicationArg{{
+			ApplicationTag: "application-foo",
+			Name:           "bar",
+		}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RenameApplication", args, result).DoAndReturn(
+		func(_ context.Context, _ string, _ any, result any) error {
+			reflect.ValueOf(result).Elem().Set(reflect.ValueOf(results))
+			return nil
+		})
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(25).AnyTimes()
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	client.ClientFacade = mockClientFacade
+	err := client.RenameApplication(c.Context(), "foo", "bar")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *applicationSuite) TestGetPlacementRules(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.Entities{Entities: []params.Entity{{Tag: "application-foo"}}}
+	result := new(params.ApplicationPlacementRulesResults)
+	results := params.ApplicationPlacementRulesResults{
+		Results: []params.ApplicationPlacementRulesResult{{
+			Rules: &params.ApplicationPlacementRules{
+				MaxUnitsPerZone: 2,
+				Affinity:        []string{"bar"},
+			},
+		}},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "GetPlacementRules", args, result).DoAndReturn(
+		func(_ context.Context, _ string, _ any, result any) error {
+			reflect.ValueOf(result).Elem().Set(reflect.ValueOf(results))
+			return nil
+		})
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(24).AnyTimes()
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	client.ClientFacade = mockClientFacade
+	rules, err := client.GetPlacementRules(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rules, tc.DeepEquals, application.PlacementRules{
+		MaxUnitsPerZone: 2,
+		Affinity:        []string{"bar"},
+	})
+}
+
+func (s *applicationSuite) TestPlacementRulesNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(23).AnyTimes()
+
+	client := application.NewClientFromCaller(mocks.NewMockFacadeCaller(ctrl))
+	client.ClientFacade = mockClientFacade
+	_, err := client.GetPlacementRules(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
+	err = client.SetPlacementRules(c.Context(), "foo", application.PlacementRules{})
+	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
+}
+
 func (s *applicationSuite) TestDestroyUnits(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
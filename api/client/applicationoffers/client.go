@@ -9,6 +9,7 @@ import (
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
+	"gopkg.in/macaroon.v2"
 
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/core/crossmodel"
@@ -64,6 +65,33 @@ func (c *Client) Offer(ctx context.Context, modelUUID, application string, endpo
 	return out.Results, nil
 }
 
+// UpdateOffer changes the endpoints and, if desc is not nil, the description
+// of an existing offer in place. Endpoints with live relations are only
+// removed from the offer if force is true.
+func (c *Client) UpdateOffer(ctx context.Context, modelUUID, offerName string, endpoints []string, desc *string, force bool) error {
+	if c.BestAPIVersion() < 7 {
+		return errors.NotImplementedf("updating offers on this version of Juju")
+	}
+	ep := make(map[string]string)
+	for _, name := range endpoints {
+		ep[name] = name
+	}
+	args := params.UpdateApplicationOffers{
+		Offers: []params.UpdateApplicationOffer{{
+			ModelTag:    names.NewModelTag(modelUUID).String(),
+			OfferName:   offerName,
+			Endpoints:   ep,
+			Description: desc,
+			Force:       force,
+		}},
+	}
+	var out params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "UpdateOffer", args, &out); err != nil {
+		return errors.Trace(err)
+	}
+	return out.OneError()
+}
+
 // ListOffers gets all remote applications that have been offered from this Juju model.
 // Each returned application satisfies at least one of the the specified filters.
 func (c *Client) ListOffers(ctx context.Context, filters ...crossmodel.ApplicationOfferFilter) ([]*crossmodel.ApplicationOfferDetails, error) {
@@ -221,10 +249,18 @@ func (c *Client) ApplicationOffer(ctx context.Context, urlStr string) (*crossmod
 	if url.Source != "" {
 		return nil, errors.NotSupportedf("query for non-local application offers")
 	}
+	return c.applicationOffer(ctx, url, nil)
+}
 
+func (c *Client) applicationOffer(ctx context.Context, url crossmodel.OfferURL, macaroons []macaroon.Slice) (*crossmodel.ApplicationOfferDetails, error) {
 	found := params.ApplicationOffersResults{}
 
-	err = c.facade.FacadeCall(ctx, "ApplicationOffers", params.OfferURLs{[]string{urlStr}, bakery.LatestVersion}, &found)
+	args := params.OfferURLs{
+		OfferURLs:     []string{url.String()},
+		BakeryVersion: bakery.LatestVersion,
+		Macaroons:     macaroons,
+	}
+	err := c.facade.FacadeCall(ctx, "ApplicationOffers", args, &found)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -247,13 +283,19 @@ func (c *Client) FindApplicationOffers(ctx context.Context, filters ...crossmode
 	if len(filters) == 0 {
 		return nil, errors.New("at least one filter must be specified")
 	}
+	return c.findApplicationOffers(ctx, "", nil, filters)
+}
 
+func (c *Client) findApplicationOffers(
+	ctx context.Context, source string, macaroons []macaroon.Slice, filters []crossmodel.ApplicationOfferFilter,
+) ([]*crossmodel.ApplicationOfferDetails, error) {
 	var (
 		filtersArg   any
-		paramsFilter params.OfferFilters
+		paramsFilter = params.OfferFilters{Macaroons: macaroons}
 	)
 	for _, f := range filters {
 		filterTerm := params.OfferFilter{
+			Source:         source,
 			OfferName:      f.OfferName,
 			ModelName:      f.ModelName,
 			ModelQualifier: f.ModelQualifier.String(),
@@ -289,15 +331,21 @@ func (c *Client) GetConsumeDetails(ctx context.Context, urlStr string) (params.C
 	if url.Source != "" {
 		return params.ConsumeOfferDetails{}, errors.NotSupportedf("query for application offers on another controller")
 	}
+	return c.getConsumeDetails(ctx, url, nil)
+}
 
+func (c *Client) getConsumeDetails(ctx context.Context, url crossmodel.OfferURL, macaroons []macaroon.Slice) (params.ConsumeOfferDetails, error) {
 	found := params.ConsumeOfferDetailsResults{}
 
-	offerURLs := params.OfferURLs{[]string{urlStr}, bakery.LatestVersion}
 	args := params.ConsumeOfferDetailsArg{
-		OfferURLs: offerURLs,
+		OfferURLs: params.OfferURLs{
+			OfferURLs:     []string{url.String()},
+			BakeryVersion: bakery.LatestVersion,
+			Macaroons:     macaroons,
+		},
 	}
 
-	err = c.facade.FacadeCall(ctx, "GetConsumeDetails", args, &found)
+	err := c.facade.FacadeCall(ctx, "GetConsumeDetails", args, &found)
 	if err != nil {
 		return params.ConsumeOfferDetails{}, errors.Trace(err)
 	}
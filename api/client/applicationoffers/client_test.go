@@ -80,6 +80,46 @@ func (s *crossmodelMockSuite) TestOffer(c *tc.C) {
 		})
 }
 
+func (s *crossmodelMockSuite) TestUpdateOffer(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	desc := "desc"
+	args := params.UpdateApplicationOffers{
+		Offers: []params.UpdateApplicationOffer{{
+			ModelTag:    names.NewModelTag("uuid").String(),
+			OfferName:   "offer",
+			Endpoints:   map[string]string{"db": "db", "admin": "admin"},
+			Description: &desc,
+			Force:       true,
+		}},
+	}
+
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{Results: []params.ErrorResult{{Error: apiservererrors.ServerError(errors.New("fail"))}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "UpdateOffer", args, res).DoAndReturn(
+		func(_ context.Context, _ string, _ any, result any) error {
+			reflect.ValueOf(result).Elem().Set(reflect.ValueOf(ress))
+			return nil
+		})
+	client := applicationoffers.NewClientFromCaller(mockFacadeCaller)
+
+	err := client.UpdateOffer(c.Context(), "uuid", "offer", []string{"db", "admin"}, &desc, true)
+	c.Assert(err, tc.ErrorMatches, "fail")
+}
+
+func (s *crossmodelMockSuite) TestUpdateOfferNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	client := applicationoffers.NewClientFromCallerWithVersion(mockFacadeCaller, 6)
+
+	err := client.UpdateOffer(c.Context(), "uuid", "offer", []string{"db"}, nil, false)
+	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
+}
+
 func (s *crossmodelMockSuite) TestOfferFacadeCallError(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
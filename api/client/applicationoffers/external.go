@@ -0,0 +1,184 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package applicationoffers
+
+import (
+	"context"
+
+	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
+	"github.com/juju/errors"
+	"gopkg.in/macaroon.v2"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/rpc/params"
+)
+
+// ExternalClient queries the offers hosted on an external controller through
+// the controller the client is connected to. The connected controller must
+// know the external controller by its alias. The queries are authenticated
+// with macaroons minted by the external controller, which the client
+// discharges itself when the external controller requires it; the macaroons
+// held for the connected controller are never sent.
+type ExternalClient struct {
+	*Client
+	source     string
+	discharger Discharger
+	macaroons  []macaroon.Slice
+}
+
+// Discharger discharges the macaroons minted by an external controller.
+type Discharger interface {
+	// DischargeAll acquires discharge macaroons for all the third party
+	// caveats in m, and returns a slice containing all of them bound to m.
+	DischargeAll(ctx context.Context, m *bakery.Macaroon) (macaroon.Slice, error)
+}
+
+// NewExternalClient creates a new client for querying the offers hosted on
+// the external controller with the given alias. Macaroons minted by the
+// external controller are discharged with the bakery client of st.
+func NewExternalClient(st base.APICallCloser, source string, options ...Option) *ExternalClient {
+	return &ExternalClient{
+		Client:     NewClient(st, options...),
+		source:     source,
+		discharger: st.BakeryClient(),
+	}
+}
+
+// ApplicationOffer returns offered remote application details for a given
+// URL on the external controller.
+func (c *ExternalClient) ApplicationOffer(ctx context.Context, urlStr string) (*crossmodel.ApplicationOfferDetails, error) {
+	url, err := c.externalURL(urlStr)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var offer *crossmodel.ApplicationOfferDetails
+	err = c.withDischarge(ctx, func(macaroons []macaroon.Slice) error {
+		offer, err = c.applicationOffer(ctx, url, macaroons)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	offer.OfferURL = localURL(offer.OfferURL)
+	return offer, nil
+}
+
+// FindApplicationOffers returns all application offers on the external
+// controller matching the supplied filter.
+func (c *ExternalClient) FindApplicationOffers(ctx context.Context, filters ...crossmodel.ApplicationOfferFilter) ([]*crossmodel.ApplicationOfferDetails, error) {
+	if len(filters) == 0 {
+		return nil, errors.New("at least one filter must be specified")
+	}
+	if c.BestAPIVersion() < 8 {
+		return nil, errors.NotSupportedf("query for application offers on another controller")
+	}
+	var offers []*crossmodel.ApplicationOfferDetails
+	err := c.withDischarge(ctx, func(macaroons []macaroon.Slice) error {
+		var err error
+		offers, err = c.findApplicationOffers(ctx, c.source, macaroons, filters)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, offer := range offers {
+		offer.OfferURL = localURL(offer.OfferURL)
+	}
+	return offers, nil
+}
+
+// GetConsumeDetails returns details necessary to consume an offer at a given
+// URL on the external controller.
+func (c *ExternalClient) GetConsumeDetails(ctx context.Context, urlStr string) (params.ConsumeOfferDetails, error) {
+	url, err := c.externalURL(urlStr)
+	if err != nil {
+		return params.ConsumeOfferDetails{}, errors.Trace(err)
+	}
+	var details params.ConsumeOfferDetails
+	err = c.withDischarge(ctx, func(macaroons []macaroon.Slice) error {
+		details, err = c.getConsumeDetails(ctx, url, macaroons)
+		return err
+	})
+	if err != nil {
+		return params.ConsumeOfferDetails{}, errors.Trace(err)
+	}
+	if details.Offer != nil {
+		details.Offer.OfferURL = localURL(details.Offer.OfferURL)
+	}
+	return details, nil
+}
+
+// withDischarge calls f with the macaroons held for the external controller.
+// If the external controller requires a discharge, the macaroon it minted is
+// discharged and f is called again with the result.
+func (c *ExternalClient) withDischarge(ctx context.Context, f func([]macaroon.Slice) error) error {
+	err := f(c.macaroons)
+	if params.ErrCode(err) != params.CodeDischargeRequired {
+		return err
+	}
+	m, err := dischargeRequiredMacaroon(err)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	discharged, err := c.discharger.DischargeAll(ctx, m)
+	if err != nil {
+		return errors.Annotatef(err, "discharging macaroon for controller %q", c.source)
+	}
+	c.macaroons = []macaroon.Slice{discharged}
+	return f(c.macaroons)
+}
+
+// dischargeRequiredMacaroon returns the macaroon to discharge carried by a
+// discharge-required error.
+func dischargeRequiredMacaroon(err error) (*bakery.Macaroon, error) {
+	var infoErr interface {
+		UnmarshalInfo(any) error
+	}
+	if !errors.As(err, &infoErr) {
+		return nil, errors.Annotate(err, "missing discharge-required error info")
+	}
+	var info params.DischargeRequiredErrorInfo
+	if unmarshalErr := infoErr.UnmarshalInfo(&info); unmarshalErr != nil {
+		return nil, errors.Annotate(unmarshalErr, "unable to extract macaroon details from discharge-required response error")
+	}
+	if info.BakeryMacaroon != nil {
+		return info.BakeryMacaroon, nil
+	}
+	if info.Macaroon == nil {
+		return nil, errors.Annotate(err, "no macaroon in discharge-required error")
+	}
+	m, convErr := bakery.NewLegacyMacaroon(info.Macaroon)
+	if convErr != nil {
+		return nil, errors.Trace(convErr)
+	}
+	return m, nil
+}
+
+// externalURL qualifies the offer URL with the alias of the external
+// controller.
+func (c *ExternalClient) externalURL(urlStr string) (crossmodel.OfferURL, error) {
+	if c.BestAPIVersion() < 8 {
+		return crossmodel.OfferURL{}, errors.NotSupportedf("query for application offers on another controller")
+	}
+	url, err := crossmodel.ParseOfferURL(urlStr)
+	if err != nil {
+		return crossmodel.OfferURL{}, errors.Trace(err)
+	}
+	if url.Source != "" && url.Source != c.source {
+		return crossmodel.OfferURL{}, errors.NotValidf("offer URL %q for controller %q", urlStr, c.source)
+	}
+	url.Source = c.source
+	return url, nil
+}
+
+// localURL returns the offer URL as seen by the controller hosting it, so
+// results match those from a direct connection to that controller.
+func localURL(urlStr string) string {
+	url, err := crossmodel.ParseOfferURL(urlStr)
+	if err != nil {
+		return urlStr
+	}
+	return url.AsLocal().String()
+}
@@ -0,0 +1,238 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package applicationoffers_test
+
+import (
+	"context"
+	"reflect"
+	stdtesting "testing"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"gopkg.in/macaroon.v2"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/applicationoffers"
+	jujucrossmodel "github.com/juju/juju/core/crossmodel"
+	coremodel "github.com/juju/juju/core/model"
+	jujutesting "github.com/juju/juju/juju/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type externalClientSuite struct {
+}
+
+func TestExternalClientSuite(t *stdtesting.T) {
+	tc.Run(t, &externalClientSuite{})
+}
+
+func (s *externalClientSuite) TestApplicationOffer(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.OfferURLs{
+		OfferURLs:     []string{"other:prod/model.db2"},
+		BakeryVersion: bakery.LatestVersion,
+	}
+	res := new(params.ApplicationOffersResults)
+	ress := params.ApplicationOffersResults{
+		Results: []params.ApplicationOfferResult{{
+			Result: &params.ApplicationOfferAdminDetailsV5{
+				ApplicationOfferDetailsV5: params.ApplicationOfferDetailsV5{
+					OfferURL:  "other:prod/model.db2",
+					OfferName: "db2",
+				},
+			},
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ApplicationOffers", args, res).DoAndReturn(
+		func(_ context.Context, _ string, _ any, result any) error {
+			reflect.ValueOf(result).Elem().Set(reflect.ValueOf(ress))
+			return nil
+		})
+	client := applicationoffers.NewExternalClientFromCallerWithVersion(mockFacadeCaller, 8, "other", nil)
+
+	offer, err := client.ApplicationOffer(c.Context(), "prod/model.db2")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(offer.OfferURL, tc.Equals, "prod/model.db2")
+	c.Check(offer.OfferName, tc.Equals, "db2")
+}
+
+func (s *externalClientSuite) TestApplicationOfferWrongSource(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	client := applicationoffers.NewExternalClientFromCallerWithVersion(mockFacadeCaller, 8, "other", nil)
+
+	_, err := client.ApplicationOffer(c.Context(), "another:prod/model.db2")
+	c.Assert(err, tc.Satisfies, errors.IsNotValid)
+}
+
+func (s *externalClientSuite) TestApplicationOfferNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	client := applicationoffers.NewExternalClientFromCallerWithVersion(mockFacadeCaller, 7, "other", nil)
+
+	_, err := client.ApplicationOffer(c.Context(), "prod/model.db2")
+	c.Assert(err, tc.Satisfies, errors.IsNotSupported)
+}
+
+func (s *externalClientSuite) TestFindApplicationOffers(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.OfferFilters{
+		Filters: []params.OfferFilter{{
+			Source:         "other",
+			ModelQualifier: "prod",
+			ModelName:      "model",
+			Endpoints:      []params.EndpointFilterAttributes{},
+		}},
+	}
+	res := new(params.QueryApplicationOffersResultsV5)
+	ress := params.QueryApplicationOffersResultsV5{
+		Results: []params.ApplicationOfferAdminDetailsV5{{
+			ApplicationOfferDetailsV5: params.ApplicationOfferDetailsV5{
+				OfferURL:  "other:prod/model.db2",
+				OfferName: "db2",
+			},
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "FindApplicationOffers", args, res).DoAndReturn(
+		func(_ context.Context, _ string, _ any, result any) error {
+			reflect.ValueOf(result).Elem().Set(reflect.ValueOf(ress))
+			return nil
+		})
+	client := applicationoffers.NewExternalClientFromCallerWithVersion(mockFacadeCaller, 8, "other", nil)
+
+	offers, err := client.FindApplicationOffers(c.Context(), jujucrossmodel.ApplicationOfferFilter{
+		ModelQualifier: coremodel.Qualifier("prod"),
+		ModelName:      "model",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(offers, tc.HasLen, 1)
+	c.Check(offers[0].OfferURL, tc.Equals, "prod/model.db2")
+}
+
+func (s *externalClientSuite) TestGetConsumeDetails(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mac, err := jujutesting.NewMacaroon("id")
+	c.Assert(err, tc.ErrorIsNil)
+
+	args := params.ConsumeOfferDetailsArg{
+		OfferURLs: params.OfferURLs{
+			OfferURLs:     []string{"other:me/prod.app"},
+			BakeryVersion: bakery.LatestVersion,
+		},
+	}
+	controllerInfo := &params.ExternalControllerInfo{
+		Addrs: []string{"1.2.3.4"},
+	}
+	res := new(params.ConsumeOfferDetailsResults)
+	ress := params.ConsumeOfferDetailsResults{
+		Results: []params.ConsumeOfferDetailsResult{{
+			ConsumeOfferDetails: params.ConsumeOfferDetails{
+				Offer: &params.ApplicationOfferDetailsV5{
+					OfferURL:  "other:me/prod.app",
+					OfferName: "app",
+				},
+				Macaroon:       mac,
+				ControllerInfo: controllerInfo,
+			},
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "GetConsumeDetails", args, res).DoAndReturn(
+		func(_ context.Context, _ string, _ any, result any) error {
+			reflect.ValueOf(result).Elem().Set(reflect.ValueOf(ress))
+			return nil
+		})
+	client := applicationoffers.NewExternalClientFromCallerWithVersion(mockFacadeCaller, 8, "other", nil)
+
+	details, err := client.GetConsumeDetails(c.Context(), "me/prod.app")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(details.Offer.OfferURL, tc.Equals, "me/prod.app")
+	c.Check(details.Macaroon, tc.Equals, mac)
+	c.Check(details.ControllerInfo, tc.DeepEquals, controllerInfo)
+}
+
+func (s *externalClientSuite) TestApplicationOfferDischargeRequired(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	external, err := jujutesting.NewMacaroon("external")
+	c.Assert(err, tc.ErrorIsNil)
+	externalBakery, err := bakery.NewLegacyMacaroon(external)
+	c.Assert(err, tc.ErrorIsNil)
+	discharge, err := jujutesting.NewMacaroon("discharge")
+	c.Assert(err, tc.ErrorIsNil)
+	discharged := macaroon.Slice{external, discharge}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	gomock.InOrder(
+		mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ApplicationOffers", params.OfferURLs{
+			OfferURLs:     []string{"other:prod/model.db2"},
+			BakeryVersion: bakery.LatestVersion,
+		}, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ any, result any) error {
+				*(result.(*params.ApplicationOffersResults)) = params.ApplicationOffersResults{
+					Results: []params.ApplicationOfferResult{{
+						Error: &params.Error{
+							Code:    params.CodeDischargeRequired,
+							Message: "login required",
+							Info: params.DischargeRequiredErrorInfo{
+								BakeryMacaroon: externalBakery,
+							}.AsMap(),
+						},
+					}},
+				}
+				return nil
+			}),
+		mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ApplicationOffers", params.OfferURLs{
+			OfferURLs:     []string{"other:prod/model.db2"},
+			BakeryVersion: bakery.LatestVersion,
+			Macaroons:     []macaroon.Slice{discharged},
+		}, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ any, result any) error {
+				*(result.(*params.ApplicationOffersResults)) = params.ApplicationOffersResults{
+					Results: []params.ApplicationOfferResult{{
+						Result: &params.ApplicationOfferAdminDetailsV5{
+							ApplicationOfferDetailsV5: params.ApplicationOfferDetailsV5{
+								OfferURL:  "other:prod/model.db2",
+								OfferName: "db2",
+							},
+						},
+					}},
+				}
+				return nil
+			}),
+	)
+	discharger := &fakeDischarger{result: discharged}
+	client := applicationoffers.NewExternalClientFromCallerWithVersion(mockFacadeCaller, 8, "other", discharger)
+
+	offer, err := client.ApplicationOffer(c.Context(), "prod/model.db2")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(offer.OfferName, tc.Equals, "db2")
+	c.Assert(discharger.macaroon, tc.NotNil)
+	c.Check(discharger.macaroon.M().Id(), tc.DeepEquals, external.Id())
+}
+
+type fakeDischarger struct {
+	macaroon *bakery.Macaroon
+	result   macaroon.Slice
+}
+
+func (d *fakeDischarger) DischargeAll(_ context.Context, m *bakery.Macaroon) (macaroon.Slice, error) {
+	d.macaroon = m
+	return d.result, nil
+}
@@ -8,19 +8,34 @@ import (
 )
 
 func NewClientFromCaller(caller base.FacadeCaller) *Client {
+	return NewClientFromCallerWithVersion(caller, 7)
+}
+
+func NewClientFromCallerWithVersion(caller base.FacadeCaller, version int) *Client {
 	return &Client{
 		facade:       caller,
-		ClientFacade: &mockClient{},
+		ClientFacade: &mockClient{version: version},
 	}
 }
 
 type mockClient struct {
+	version int
 }
 
 func (m *mockClient) BestAPIVersion() int {
-	return 6
+	return m.version
 }
 
 func (*mockClient) Close() error {
 	return nil
 }
+
+func NewExternalClientFromCallerWithVersion(
+	caller base.FacadeCaller, version int, source string, discharger Discharger,
+) *ExternalClient {
+	return &ExternalClient{
+		Client:     NewClientFromCallerWithVersion(caller, version),
+		source:     source,
+		discharger: discharger,
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/juju/errors"
@@ -15,9 +16,12 @@ import (
 
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/common"
+	apihttp "github.com/juju/juju/api/http"
+	apiwatcher "github.com/juju/juju/api/watcher"
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/semversion"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/internal/tools"
 	"github.com/juju/juju/rpc/params"
 )
@@ -56,6 +60,10 @@ type StatusArgs struct {
 
 	// IncludeStorage can be set to true to return storage in the response.
 	IncludeStorage bool
+
+	// Statuses, if not empty, restricts the response to the entities in one
+	// of the given statuses.
+	Statuses []string
 }
 
 // Status returns the status of the juju model.
@@ -63,14 +71,38 @@ func (c *Client) Status(ctx context.Context, args *StatusArgs) (*params.FullStat
 	if args == nil {
 		args = &StatusArgs{}
 	}
+	if len(args.Statuses) > 0 && c.BestAPIVersion() < 10 {
+		return nil, errors.NotImplementedf("filtering status by status value on this version of Juju")
+	}
 	var result params.FullStatus
-	p := params.StatusParams{Patterns: args.Patterns, IncludeStorage: args.IncludeStorage}
+	p := params.StatusParams{
+		Patterns:       args.Patterns,
+		IncludeStorage: args.IncludeStorage,
+		Statuses:       args.Statuses,
+	}
 	if err := c.facade.FacadeCall(ctx, "FullStatus", p, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// WatchStatus returns a watcher which notifies when an application, unit or
+// machine is added to or removed from the model, or when the status of any of
+// them changes.
+func (c *Client) WatchStatus(ctx context.Context) (watcher.NotifyWatcher, error) {
+	if c.BestAPIVersion() < 9 {
+		return nil, errors.NotImplementedf("watching status on this version of Juju")
+	}
+	var result params.NotifyWatchResult
+	if err := c.facade.FacadeCall(ctx, "WatchStatus", nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return apiwatcher.NewNotifyWatcher(c.facade.RawAPICaller(), result), nil
+}
+
 // StatusHistory retrieves the last <size> results of
 // <kind:combined|agent|workload|machine|machineinstance|container|containerinstance> status
 // for <name> unit
@@ -171,3 +203,62 @@ func (c *Client) httpPost(ctx context.Context, content io.ReadSeeker, endpoint,
 func (c *Client) WatchDebugLog(ctx context.Context, args common.DebugLogParams) (<-chan common.LogMessage, error) {
 	return common.StreamDebugLog(ctx, c.conn, args)
 }
+
+// ExportDebugLog returns a gzipped tar archive of the log records that match
+// the filtering specified in the DebugLogParams. The archive holds a single
+// file, with one JSON encoded log record per line. Only the records logged
+// so far are exported; the Replay and NoTail arguments are implied, and
+// Backlog and Limit are ignored.
+func (c *Client) ExportDebugLog(ctx context.Context, args common.DebugLogParams) (io.ReadCloser, error) {
+	httpClient, err := c.conn.HTTPClient(base.HTTPClientScopeModel)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	archive, err := apihttp.OpenURI(ctx, httpClient, "/log/export", args.URLQuery())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return archive, nil
+}
+
+// ExportModel returns a tar archive holding an offline export of the model:
+// its migration envelope together with the charms, agent binaries and
+// resources needed to import it into another controller. Only controller
+// admins may export a model. The model is locked against changes while the
+// export is assembled; if lock is true, it is left locked afterwards.
+func (c *Client) ExportModel(ctx context.Context, lock bool) (io.ReadCloser, error) {
+	httpClient, err := c.conn.HTTPClient(base.HTTPClientScopeModel)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var query url.Values
+	if lock {
+		query = url.Values{"lock": {"true"}}
+	}
+	archive, err := apihttp.OpenURI(ctx, httpClient, "/export", query)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return archive, nil
+}
+
+// CloneModelExport returns a tar archive like ExportModel, describing a copy
+// of the model under the given name and a fresh UUID, without any of its
+// machines or units. The archive is imported into the same controller to
+// create the copy. If withoutUnits is true, every application in the copy is
+// scaled to zero.
+func (c *Client) CloneModelExport(ctx context.Context, name string, withoutUnits bool) (io.ReadCloser, error) {
+	httpClient, err := c.conn.HTTPClient(base.HTTPClientScopeModel)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	query := url.Values{"clone": {name}}
+	if withoutUnits {
+		query.Set("without-units", "true")
+	}
+	archive, err := apihttp.OpenURI(ctx, httpClient, "/export", query)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return archive, nil
+}
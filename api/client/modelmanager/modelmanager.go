@@ -374,6 +374,32 @@ func (c *Client) DestroyModel(ctx context.Context, tag names.ModelTag, destroySt
 	return nil
 }
 
+// PreviewDestroyModel returns the entities that would be removed if the
+// specified model were destroyed. Nothing is removed.
+func (c *Client) PreviewDestroyModel(ctx context.Context, tag names.ModelTag, force *bool) (params.RemovalPreview, error) {
+	if c.BestAPIVersion() < 12 {
+		return params.RemovalPreview{}, errors.NotImplementedf("previewing model destruction on this version of Juju")
+	}
+	args := params.DestroyModelsParams{Models: []params.DestroyModelParams{{
+		ModelTag: tag.String(),
+		Force:    force,
+	}}}
+	var results params.RemovalPreviewResults
+	if err := c.facade.FacadeCall(ctx, "PreviewDestroyModels", args, &results); err != nil {
+		return params.RemovalPreview{}, errors.Trace(err)
+	}
+	if n := len(results.Results); n != 1 {
+		return params.RemovalPreview{}, errors.Errorf("expected 1 result, got %d", n)
+	}
+	if err := results.Results[0].Error; err != nil {
+		return params.RemovalPreview{}, errors.Trace(err)
+	}
+	if results.Results[0].Info == nil {
+		return params.RemovalPreview{}, nil
+	}
+	return *results.Results[0].Info, nil
+}
+
 // GrantModel grants a user access to the specified models.
 func (c *Client) GrantModel(ctx context.Context, user, access string, modelUUIDs ...string) error {
 	return c.modifyModelUser(ctx, params.GrantModelAccess, user, access, modelUUIDs)
@@ -519,6 +545,23 @@ func (c *Client) ChangeModelCredential(ctx context.Context, model names.ModelTag
 	return out.OneError()
 }
 
+// RenameModel changes the name of the model. The model keeps its qualifier.
+func (c *Client) RenameModel(ctx context.Context, model names.ModelTag, name string) error {
+	if c.BestAPIVersion() < 13 {
+		return errors.NotImplementedf("renaming models on this version of Juju")
+	}
+	in := params.RenameModelsParams{
+		Models: []params.RenameModelParams{
+			{ModelTag: model.String(), Name: name},
+		},
+	}
+	var out params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "RenameModels", in, &out); err != nil {
+		return errors.Trace(err)
+	}
+	return out.OneError()
+}
+
 // ValidateModelUpgrade checks to see if it's possible to upgrade a model,
 // before actually attempting to do the real environ-upgrade.
 func (c *Client) ValidateModelUpgrade(ctx context.Context, model names.ModelTag, force bool) error {
@@ -280,6 +280,51 @@ func (s *modelmanagerSuite) TestDestroyModel(c *tc.C) {
 	s.testDestroyModel(c, &false_, &true_, &defaultMin, time.Minute)
 }
 
+func (s *modelmanagerSuite) TestPreviewDestroyModel(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	force := true
+	args := params.DestroyModelsParams{
+		Models: []params.DestroyModelParams{{
+			ModelTag: coretesting.ModelTag.String(),
+			Force:    &force,
+		}},
+	}
+	preview := params.RemovalPreview{
+		Machines: []params.Entity{{Tag: "machine-0"}},
+		Units:    []params.Entity{{Tag: "unit-mysql-0"}},
+	}
+
+	result := new(params.RemovalPreviewResults)
+	ress := params.RemovalPreviewResults{
+		Results: []params.RemovalPreviewResult{{Info: &preview}},
+	}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "PreviewDestroyModels", args, result,
+	).DoAndReturn(func(_ context.Context, _ string, _ any, result any) error {
+		reflect.ValueOf(result).Elem().Set(reflect.ValueOf(ress))
+		return nil
+	})
+	client := modelmanager.NewClientFromCaller(mockFacadeCaller)
+
+	res, err := client.PreviewDestroyModel(c.Context(), coretesting.ModelTag, &force)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(res, tc.DeepEquals, preview)
+}
+
+func (s *modelmanagerSuite) TestPreviewDestroyModelLegacy(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	client := modelmanager.NewLegacyClientFromCaller(basemocks.NewMockFacadeCaller(ctrl))
+
+	_, err := client.PreviewDestroyModel(c.Context(), coretesting.ModelTag, nil)
+	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
+}
+
 func (s *modelmanagerSuite) TestModelDefaults(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -649,6 +694,34 @@ func (s *modelmanagerSuite) TestChangeModelCredential(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *modelmanagerSuite) TestRenameModel(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.RenameModelsParams{
+		Models: []params.RenameModelParams{
+			{ModelTag: coretesting.ModelTag.String(), Name: "production"},
+		},
+	}
+
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "RenameModels", args, res,
+	).DoAndReturn(func(_ context.Context, _ string, _ any, result any) error {
+		reflect.ValueOf(result).Elem().Set(reflect.ValueOf(ress))
+		return nil
+	})
+	client := modelmanager.NewClientFromCaller(mockFacadeCaller)
+
+	err := client.RenameModel(c.Context(), coretesting.ModelTag, "production")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 func (s *modelmanagerSuite) TestChangeModelCredentialManyResults(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
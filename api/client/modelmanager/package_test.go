@@ -10,7 +10,7 @@ import (
 func NewClientFromCaller(caller base.FacadeCaller) *Client {
 	return &Client{
 		facade:       caller,
-		ClientFacade: &mockClient{bestAPIVersion: 11},
+		ClientFacade: &mockClient{bestAPIVersion: 13},
 	}
 }
 
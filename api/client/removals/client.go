@@ -0,0 +1,65 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removals
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// Client allows access to the removals API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the removals API.
+func NewClient(st base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(st, "Removals", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// List returns the pending removal jobs for the current model.
+func (c *Client) List(ctx context.Context) ([]params.RemovalJob, error) {
+	var result params.RemovalJobResults
+	if err := c.facade.FacadeCall(ctx, "ListRemovals", nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result.Results, nil
+}
+
+// Retry reschedules the removal jobs with the input UUIDs
+// so that they are run again as soon as possible.
+func (c *Client) Retry(ctx context.Context, uuids ...string) error {
+	return c.reschedule(ctx, "RetryRemovals", uuids)
+}
+
+// Force escalates the removal jobs with the input UUIDs to forced removals,
+// and reschedules them so that they are run again as soon as possible.
+func (c *Client) Force(ctx context.Context, uuids ...string) error {
+	return c.reschedule(ctx, "ForceRemovals", uuids)
+}
+
+func (c *Client) reschedule(ctx context.Context, method string, uuids []string) error {
+	args := params.RemovalJobArgs{UUIDs: uuids}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, method, args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	if len(results.Results) != len(uuids) {
+		return errors.Errorf("expected %d results, got %d", len(uuids), len(results.Results))
+	}
+	return results.Combine()
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removals_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/tc"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/removals"
+	"github.com/juju/juju/rpc/params"
+)
+
+type removalsMockSuite struct{}
+
+func TestRemovalsMockSuite(t *testing.T) {
+	tc.Run(t, &removalsMockSuite{})
+}
+
+func (s *removalsMockSuite) TestList(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	jobs := []params.RemovalJob{{
+		UUID:         "job-uuid",
+		EntityType:   "unit",
+		EntityUUID:   "unit-uuid",
+		ScheduledFor: time.Now().UTC(),
+		Attempts:     2,
+		LastError:    "the front fell off",
+	}}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "ListRemovals", nil, gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, _ any, resPtr any) error {
+		reflect.ValueOf(resPtr).Elem().Set(reflect.ValueOf(params.RemovalJobResults{Results: jobs}))
+		return nil
+	})
+
+	client := removals.NewClientFromCaller(mockFacadeCaller)
+	result, err := client.List(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, jobs)
+}
+
+func (s *removalsMockSuite) TestRetry(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "RetryRemovals", params.RemovalJobArgs{UUIDs: []string{"job-1"}}, gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, _ any, resPtr any) error {
+		reflect.ValueOf(resPtr).Elem().Set(reflect.ValueOf(params.ErrorResults{
+			Results: []params.ErrorResult{{}},
+		}))
+		return nil
+	})
+
+	client := removals.NewClientFromCaller(mockFacadeCaller)
+	err := client.Retry(c.Context(), "job-1")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *removalsMockSuite) TestForceError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "ForceRemovals", params.RemovalJobArgs{UUIDs: []string{"job-1"}}, gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, _ any, resPtr any) error {
+		reflect.ValueOf(resPtr).Elem().Set(reflect.ValueOf(params.ErrorResults{
+			Results: []params.ErrorResult{{Error: &params.Error{Message: "removal job \"job-1\" not found"}}},
+		}))
+		return nil
+	})
+
+	client := removals.NewClientFromCaller(mockFacadeCaller)
+	err := client.Force(c.Context(), "job-1")
+	c.Assert(err, tc.ErrorMatches, `removal job "job-1" not found`)
+}
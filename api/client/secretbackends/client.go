@@ -167,3 +167,21 @@ func (api *Client) RemoveSecretBackend(ctx context.Context, name string, force b
 	}
 	return params.TranslateWellKnownError(results.OneError())
 }
+
+// RotateSecretEncryptionKey rotates the key used to protect the secret
+// content stored in the controller.
+func (api *Client) RotateSecretEncryptionKey(ctx context.Context) error {
+	if api.BestAPIVersion() < 2 {
+		return errors.NotSupportedf("rotating the secret encryption key on this juju version")
+	}
+
+	var result params.ErrorResult
+	err := api.facade.FacadeCall(ctx, "RotateSecretEncryptionKey", nil, &result)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
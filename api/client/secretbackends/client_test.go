@@ -175,3 +175,35 @@ func (s *SecretBackendsSuite) TestUpdateSecretsBackend(c *tc.C) {
 	err := client.UpdateSecretBackend(c.Context(), backend, true)
 	c.Assert(err, tc.ErrorMatches, "FAIL")
 }
+
+func (s *SecretBackendsSuite) TestRotateSecretEncryptionKey(c *tc.C) {
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Check(objType, tc.Equals, "SecretBackends")
+			c.Check(version, tc.Equals, 2)
+			c.Check(id, tc.Equals, "")
+			c.Check(request, tc.Equals, "RotateSecretEncryptionKey")
+			c.Check(arg, tc.IsNil)
+			c.Assert(result, tc.FitsTypeOf, &params.ErrorResult{})
+			*(result.(*params.ErrorResult)) = params.ErrorResult{
+				Error: &params.Error{Message: "boom"},
+			}
+			return nil
+		}), BestVersion: 2,
+	}
+	client := secretbackends.NewClient(apiCaller)
+	err := client.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *SecretBackendsSuite) TestRotateSecretEncryptionKeyNotSupported(c *tc.C) {
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+			c.Fatalf("unexpected call to %s", request)
+			return nil
+		}), BestVersion: 1,
+	}
+	client := secretbackends.NewClient(apiCaller)
+	err := client.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorMatches, "rotating the secret encryption key on this juju version not supported")
+}
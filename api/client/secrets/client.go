@@ -5,6 +5,7 @@ package secrets
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -170,6 +171,131 @@ func (c *Client) CreateSecret(ctx context.Context, name, description string, dat
 	return result.Result, nil
 }
 
+// CreateExternalSecret creates a user secret whose content is held at the
+// specified path in the named secret backend, and is managed outside of Juju.
+// If key is set, only that key of the content is used.
+func (c *Client) CreateExternalSecret(
+	ctx context.Context, name, description, backendName, path, key string,
+) (string, error) {
+	if c.BestAPIVersion() < 3 {
+		return "", errors.NotSupportedf("external secrets")
+	}
+	var results params.StringResults
+	arg := params.CreateExternalSecretArg{
+		BackendName: backendName,
+		Path:        path,
+		Key:         key,
+	}
+	if name != "" {
+		arg.Label = &name
+	}
+	if description != "" {
+		arg.Description = &description
+	}
+
+	err := c.facade.FacadeCall(ctx, "CreateExternalSecrets", params.CreateExternalSecretArgs{
+		Args: []params.CreateExternalSecretArg{arg},
+	}, &results)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return "", errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return "", params.TranslateWellKnownError(result.Error)
+	}
+	return result.Result, nil
+}
+
+// CreateGeneratedSecret creates a user secret whose content is generated by
+// the controller as described by generator. If rotatePolicy is set, new
+// content is generated on that schedule.
+func (c *Client) CreateGeneratedSecret(
+	ctx context.Context, name, description string,
+	generator secrets.GeneratorSpec, rotatePolicy secrets.RotatePolicy,
+) (string, error) {
+	if c.BestAPIVersion() < 4 {
+		return "", errors.NotSupportedf("generated secrets")
+	}
+	var results params.StringResults
+	arg := params.CreateGeneratedSecretArg{
+		Generator: params.SecretGeneratorSpec{
+			Format:  string(generator.Format),
+			Length:  generator.Length,
+			Charset: generator.Charset,
+		},
+	}
+	if name != "" {
+		arg.Label = &name
+	}
+	if description != "" {
+		arg.Description = &description
+	}
+	if rotatePolicy != "" {
+		arg.RotatePolicy = &rotatePolicy
+	}
+
+	err := c.facade.FacadeCall(ctx, "CreateGeneratedSecrets", params.CreateGeneratedSecretArgs{
+		Args: []params.CreateGeneratedSecretArg{arg},
+	}, &results)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return "", errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return "", params.TranslateWellKnownError(result.Error)
+	}
+	return result.Result, nil
+}
+
+// SecretAccessRecord records reads of a secret revision's content
+// by a single unit, application or user.
+type SecretAccessRecord struct {
+	Revision        int
+	Accessor        string
+	FirstAccessTime time.Time
+	LastAccessTime  time.Time
+	AccessCount     int
+}
+
+// ListSecretAccess returns the access log for the specified secret,
+// most recently read revisions first.
+func (c *Client) ListSecretAccess(ctx context.Context, uri *secrets.URI) ([]SecretAccessRecord, error) {
+	if c.BestAPIVersion() < 5 {
+		return nil, errors.NotSupportedf("secret access log")
+	}
+	var results params.SecretAccessLogResults
+	err := c.facade.FacadeCall(ctx, "ListSecretAccess", params.SecretURIArgs{
+		Args: []params.SecretURIArg{{URI: uri.String()}},
+	}, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, params.TranslateWellKnownError(result.Error)
+	}
+	access := make([]SecretAccessRecord, len(result.Access))
+	for i, r := range result.Access {
+		access[i] = SecretAccessRecord{
+			Revision:        r.Revision,
+			Accessor:        r.AccessorTag,
+			FirstAccessTime: r.FirstAccessTime,
+			LastAccessTime:  r.LastAccessTime,
+			AccessCount:     r.AccessCount,
+		}
+	}
+	return access, nil
+}
+
 // UpdateSecret updates an existing secret.
 func (c *Client) UpdateSecret(
 	ctx context.Context,
@@ -190,6 +190,134 @@ func (s *SecretsSuite) TestCreateSecret(c *tc.C) {
 	c.Assert(result, tc.DeepEquals, uri.String())
 }
 
+func (s *SecretsSuite) TestCreateExternalSecretNotSupported(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
+	client := apisecrets.NewClient(caller)
+	_, err := client.CreateExternalSecret(c.Context(), "label", "", "myvault", "kv/app/db", "")
+	c.Assert(err, tc.ErrorMatches, "external secrets not supported")
+}
+
+func (s *SecretsSuite) TestCreateExternalSecret(c *tc.C) {
+	uri := secrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Assert(objType, tc.Equals, "Secrets")
+		c.Assert(request, tc.Equals, "CreateExternalSecrets")
+		c.Assert(arg, tc.DeepEquals, params.CreateExternalSecretArgs{
+			Args: []params.CreateExternalSecretArg{
+				{
+					Label:       new("my-secret"),
+					Description: new("this is a secret."),
+					BackendName: "myvault",
+					Path:        "kv/app/db",
+					Key:         "password",
+				},
+			},
+		})
+		*(result.(*params.StringResults)) = params.StringResults{
+			Results: []params.StringResult{
+				{Result: uri.String()},
+			},
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 3}
+	client := apisecrets.NewClient(caller)
+	result, err := client.CreateExternalSecret(c.Context(), "my-secret", "this is a secret.", "myvault", "kv/app/db", "password")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.Equals, uri.String())
+}
+
+func (s *SecretsSuite) TestCreateGeneratedSecretNotSupported(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 3}
+	client := apisecrets.NewClient(caller)
+	_, err := client.CreateGeneratedSecret(c.Context(), "label", "", secrets.GeneratorSpec{Format: secrets.GenerateHex}, "")
+	c.Assert(err, tc.ErrorMatches, "generated secrets not supported")
+}
+
+func (s *SecretsSuite) TestCreateGeneratedSecret(c *tc.C) {
+	uri := secrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Assert(objType, tc.Equals, "Secrets")
+		c.Assert(request, tc.Equals, "CreateGeneratedSecrets")
+		c.Assert(arg, tc.DeepEquals, params.CreateGeneratedSecretArgs{
+			Args: []params.CreateGeneratedSecretArg{
+				{
+					Label:       new("my-secret"),
+					Description: new("this is a secret."),
+					Generator: params.SecretGeneratorSpec{
+						Format: "password",
+						Length: 24,
+					},
+					RotatePolicy: new(secrets.RotateMonthly),
+				},
+			},
+		})
+		*(result.(*params.StringResults)) = params.StringResults{
+			Results: []params.StringResult{
+				{Result: uri.String()},
+			},
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 4}
+	client := apisecrets.NewClient(caller)
+	result, err := client.CreateGeneratedSecret(c.Context(), "my-secret", "this is a secret.",
+		secrets.GeneratorSpec{Format: secrets.GeneratePassword, Length: 24}, secrets.RotateMonthly)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.Equals, uri.String())
+}
+
+func (s *SecretsSuite) TestListSecretAccessNotSupported(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 4}
+	client := apisecrets.NewClient(caller)
+	_, err := client.ListSecretAccess(c.Context(), secrets.NewURI())
+	c.Assert(err, tc.ErrorMatches, "secret access log not supported")
+}
+
+func (s *SecretsSuite) TestListSecretAccess(c *tc.C) {
+	uri := secrets.NewURI()
+	now := time.Now()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
+		c.Assert(objType, tc.Equals, "Secrets")
+		c.Assert(request, tc.Equals, "ListSecretAccess")
+		c.Assert(arg, tc.DeepEquals, params.SecretURIArgs{
+			Args: []params.SecretURIArg{{URI: uri.String()}},
+		})
+		*(result.(*params.SecretAccessLogResults)) = params.SecretAccessLogResults{
+			Results: []params.SecretAccessLogResult{{
+				Access: []params.SecretAccessRecord{{
+					Revision:        1,
+					AccessorTag:     "unit-gitlab-0",
+					FirstAccessTime: now.Add(-time.Hour),
+					LastAccessTime:  now,
+					AccessCount:     2,
+				}},
+			}},
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 5}
+	client := apisecrets.NewClient(caller)
+	result, err := client.ListSecretAccess(c.Context(), uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, []apisecrets.SecretAccessRecord{{
+		Revision:        1,
+		Accessor:        "unit-gitlab-0",
+		FirstAccessTime: now.Add(-time.Hour),
+		LastAccessTime:  now,
+		AccessCount:     2,
+	}})
+}
+
 func (s *SecretsSuite) TestUpdateSecretError(c *tc.C) {
 	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result any) error {
 		return nil
@@ -25,8 +25,8 @@ var facadeVersions = facades.FacadeVersions{
 	"Agent":             {3},
 	"AgentLifeFlag":     {1},
 	"Annotations":       {2},
-	"Application":       {19, 20, 21, 22},
-	"ApplicationOffers": {5, 6},
+	"Application":       {19, 20, 21, 22, 23, 24, 25},
+	"ApplicationOffers": {5, 6, 7, 8},
 	"Backups":           {3},
 	"Block":             {2},
 	// Note that this version of Juju does not implement version 6 of the
@@ -40,7 +40,7 @@ var facadeVersions = facades.FacadeVersions{
 	"CAASApplication":              {1},
 	"CAASOperatorUpgrader":         {1},
 	"Charms":                       {7},
-	"Client":                       {8},
+	"Client":                       {8, 9, 10},
 	"Cloud":                        {7, 8},
 	"Controller":                   {12, 13, 14},
 	"CredentialManager":            {1},
@@ -77,7 +77,7 @@ var facadeVersions = facades.FacadeVersions{
 	// to negotiate the new model migration path against those targets.
 	"MigrationTarget":              {4, 5, 6, 7, 8},
 	"ModelConfig":                  {3, 4},
-	"ModelManager":                 {9, 10, 11},
+	"ModelManager":                 {9, 10, 11, 12, 13},
 	"ModelSummaryWatcher":          {1},
 	"ModelUpgrader":                {1, 2},
 	"NotifyWatcher":                {1},
@@ -89,14 +89,15 @@ var facadeVersions = facades.FacadeVersions{
 	"RelationStatusWatcher":        {1},
 	"RelationUnitsWatcher":         {1},
 	"RemoteRelationWatcher":        {1},
+	"Removals":                     {1},
 	"Resources":                    {3},
 	"ResourcesHookContext":         {1},
 	"RetryStrategy":                {1},
 	"SecretsTriggerWatcher":        {1},
-	"SecretBackends":               {1},
+	"SecretBackends":               {1, 2},
 	"SecretBackendsRotateWatcher":  {1},
 	"SecretsRevisionWatcher":       {1},
-	"Secrets":                      {1, 2},
+	"Secrets":                      {1, 2, 3, 4, 5},
 	"SecretsManager":               {4},
 	"SecretsDrain":                 {1},
 	"UserSecretsDrain":             {1},
@@ -57,6 +57,7 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/modelmanager"   // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/modelupgrader"
 	"github.com/juju/juju/apiserver/facades/client/pinger"
+	"github.com/juju/juju/apiserver/facades/client/removals"
 	"github.com/juju/juju/apiserver/facades/client/resources"
 	"github.com/juju/juju/apiserver/facades/client/secretbackends"
 	"github.com/juju/juju/apiserver/facades/client/secrets"
@@ -194,6 +195,7 @@ func AllFacades() *facade.Registry {
 	provisioner.Register(registry)
 	proxyupdater.Register(registry)
 	reboot.Register(registry)
+	removals.Register(registry)
 	resources.Register(registry)
 	resourceshookcontext.Register(registry)
 	retrystrategy.Register(registry)
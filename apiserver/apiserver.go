@@ -419,9 +419,11 @@ func newServer(ctx context.Context, cfg ServerConfig) (_ *Server, err error) {
 		publicDNSName_:                cfg.PublicDNSName,
 		registerIntrospectionHandlers: cfg.RegisterIntrospectionHandlers,
 		logsinkRateLimitConfig: logsink.RateLimitConfig{
-			Refill: cfg.LogSinkConfig.RateLimitRefill,
-			Burst:  cfg.LogSinkConfig.RateLimitBurst,
-			Clock:  cfg.Clock,
+			Refill:       cfg.LogSinkConfig.RateLimitRefill,
+			Burst:        cfg.LogSinkConfig.RateLimitBurst,
+			EntityRefill: cfg.LogSinkConfig.EntityRateLimitRefill,
+			EntityBurst:  cfg.LogSinkConfig.EntityRateLimitBurst,
+			Clock:        cfg.Clock,
 		},
 		getAuditConfig:   cfg.GetAuditConfig,
 		logSink:          cfg.LogSink,
@@ -785,6 +787,11 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		debuglogAuth,
 		srv.logDir,
 	), "log")
+	debugLogExportHandler := srv.monitoredHandler(newDebugLogExportHandler(
+		httpCtxt,
+		srv.logDir,
+	), "log-export")
+	modelExportHandler := srv.monitoredHandler(newModelExportHandler(httpCtxt), "export")
 	logSinkHandler := logsink.NewHTTPHandler(
 		newAgentLogWriteFunc(httpCtxt, srv.logSink),
 		httpCtxt.stop(),
@@ -945,6 +952,16 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		// The authentication is handled within the debugLogHandler in order
 		// for discharge required errors to be handled correctly.
 		unauthenticated: true,
+	}, {
+		pattern:    modelRoutePrefix + "/log/export",
+		methods:    []string{"GET"},
+		handler:    debugLogExportHandler,
+		authorizer: debuglogAuth,
+	}, {
+		pattern:    modelRoutePrefix + "/export",
+		methods:    []string{"GET"},
+		handler:    modelExportHandler,
+		authorizer: controllerAdminAuthorizer,
 	}, {
 		pattern:    modelRoutePrefix + "/logsink",
 		handler:    logSinkHandler,
@@ -0,0 +1,50 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package common
+
+import (
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/domain/removal"
+	"github.com/juju/juju/rpc/params"
+)
+
+// RemovalPreview converts a removal preview from the removal domain into its
+// wire representation.
+func RemovalPreview(p removal.Preview) *params.RemovalPreview {
+	return &params.RemovalPreview{
+		Machines:           entities(p.Machines, func(id string) names.Tag { return names.NewMachineTag(id) }),
+		Applications:       entities(p.Applications, func(id string) names.Tag { return names.NewApplicationTag(id) }),
+		RemoteApplications: entities(p.RemoteApplications, func(id string) names.Tag { return names.NewApplicationTag(id) }),
+		Units:              entities(p.Units, func(id string) names.Tag { return names.NewUnitTag(id) }),
+		Relations:          entities(p.Relations, func(id string) names.Tag { return names.NewRelationTag(id) }),
+		Offers:             p.Offers,
+		DestroyedStorage:   entities(p.DestroyedStorage, func(id string) names.Tag { return names.NewStorageTag(id) }),
+		DetachedStorage:    entities(p.DetachedStorage, func(id string) names.Tag { return names.NewStorageTag(id) }),
+		Secrets:            secretURIs(p.Secrets),
+	}
+}
+
+func secretURIs(ids []string) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = (&secrets.URI{ID: id}).String()
+	}
+	return result
+}
+
+func entities(ids []string, tag func(string) names.Tag) []params.Entity {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make([]params.Entity, len(ids))
+	for i, id := range ids {
+		result[i] = params.Entity{Tag: tag(id).String()}
+	}
+	return result
+}
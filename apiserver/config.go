@@ -25,6 +25,15 @@ type LogSinkConfig struct {
 	// RateLimitRefill defines the rate at which log messages will be let
 	// through once the initial burst amount has been depleted.
 	RateLimitRefill time.Duration
+
+	// EntityRateLimitBurst defines the number of log messages an individual
+	// agent may send before any further messages from it are dropped. Zero
+	// disables per-entity rate limiting.
+	EntityRateLimitBurst int64
+
+	// EntityRateLimitRefill defines the rate at which an agent's allowance
+	// is restored once its burst has been depleted.
+	EntityRateLimitRefill time.Duration
 }
 
 // Validate validates the logsink endpoint configuration.
@@ -35,6 +44,12 @@ func (cfg LogSinkConfig) Validate() error {
 	if cfg.RateLimitRefill <= 0 {
 		return errors.NotValidf("RateLimitRefill %s <= 0", cfg.RateLimitRefill)
 	}
+	if cfg.EntityRateLimitBurst < 0 {
+		return errors.NotValidf("EntityRateLimitBurst %d < 0", cfg.EntityRateLimitBurst)
+	}
+	if cfg.EntityRateLimitBurst > 0 && cfg.EntityRateLimitRefill <= 0 {
+		return errors.NotValidf("EntityRateLimitRefill %s <= 0", cfg.EntityRateLimitRefill)
+	}
 	return nil
 }
 
@@ -95,6 +95,8 @@ func newDebugLogHandler(
 //	replay -> string - one of [true, false], if true, start the file from the start
 //	noTail -> string - one of [true, false], if true, existing logs are sent back,
 //	   - but the command does not wait for new ones.
+//	startTime -> string - RFC3339 time, only lines logged at or after it are sent
+//	endTime -> string - RFC3339 time, only lines logged at or before it are sent
 func (h *debugLogHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	handler := func(conn *websocket.Conn) {
 		var socket debugLogSocket = &debugLogSocketImpl{conn: conn}
@@ -235,6 +237,7 @@ func (s *debugLogSocketImpl) sendLogRecord(record *params.LogMessage, version in
 type debugLogParams struct {
 	version       int
 	startTime     time.Time
+	endTime       time.Time
 	fromTheStart  bool
 	noTail        bool
 	firehose      bool
@@ -317,6 +320,14 @@ func readDebugLogParams(queryMap url.Values) (debugLogParams, error) {
 		params.startTime = startTime
 	}
 
+	if value := queryMap.Get("endTime"); value != "" {
+		endTime, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return params, errors.Errorf("end time %q is not a valid time in RFC3339 format", value)
+		}
+		params.endTime = endTime
+	}
+
 	params.includeEntity = queryMap["includeEntity"]
 	params.excludeEntity = queryMap["excludeEntity"]
 	params.includeModule = queryMap["includeModule"]
@@ -0,0 +1,291 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/worker/v5"
+
+	"github.com/juju/juju/apiserver/httpcontext"
+	"github.com/juju/juju/internal/logtailer"
+)
+
+// debugLogExportHandler serves a compressed archive of the historical log
+// records for a model, filtered in the same way as debug-log.
+type debugLogExportHandler struct {
+	ctxt   httpContext
+	logDir string
+}
+
+func newDebugLogExportHandler(ctxt httpContext, logDir string) http.Handler {
+	return &debugLogExportHandler{
+		ctxt:   ctxt,
+		logDir: logDir,
+	}
+}
+
+// ServeHTTP reads the model's log records in the requested time window, and
+// returns them as a gzipped tar archive containing a single file of JSON
+// encoded log records, one per line. Rotated backups of the log file are
+// read as well as the current log file, so the window may reach back as far as
+// the oldest backup that has been kept.
+//
+// The request accepts the same filtering arguments as debug-log. The noTail
+// and replay arguments are implied, and backlog and maxLines are ignored.
+func (h *debugLogExportHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if err := h.serveExport(w, req); err != nil {
+		if err := sendError(w, err); err != nil {
+			logger.Errorf(req.Context(), "%v", err)
+		}
+	}
+}
+
+func (h *debugLogExportHandler) serveExport(w http.ResponseWriter, req *http.Request) error {
+	if req.Method != http.MethodGet {
+		return errors.MethodNotAllowedf("unsupported method: %q", req.Method)
+	}
+
+	if lokiForwardingEnabled(req.Context(), h.ctxt.srv.shared.controllerDomainServices) {
+		return errors.NotSupportedf("exporting logs while they are being forwarded to Loki")
+	}
+
+	reqParams, err := readDebugLogParams(req.URL.Query())
+	if err != nil {
+		return errors.NewNotValid(err, "")
+	}
+	if !reqParams.startTime.IsZero() && !reqParams.endTime.IsZero() &&
+		reqParams.endTime.Before(reqParams.startTime) {
+		return errors.NotValidf("end time %s before start time %s",
+			reqParams.endTime.Format(time.RFC3339), reqParams.startTime.Format(time.RFC3339))
+	}
+	reqParams.noTail = true
+	reqParams.fromTheStart = true
+	reqParams.initialLines = 0
+
+	modelUUID, _ := httpcontext.RequestModelUUID(req.Context())
+	if reqParams.firehose {
+		modelUUID = ""
+	}
+
+	// Records older than the last rotation of the log file are held in the
+	// rotated backups, so those that overlap the window are read first.
+	logFiles, err := logFilesForWindow(h.logDir, reqParams.startTime, reqParams.endTime)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	// The size of each tar entry must be known before it is written, so the
+	// records are first collected in a temporary file.
+	tmpFile, err := os.CreateTemp("", "debug-log-export-")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+	}()
+
+	for _, logFile := range logFiles {
+		err := exportLogFile(tmpFile, modelUUID, logFile, makeLogTailerParams(reqParams), h.ctxt.stop())
+		if err != nil {
+			return errors.Annotatef(err, "exporting %q", filepath.Base(logFile))
+		}
+	}
+
+	name := "debug-log.log"
+	if modelUUID != "" {
+		name = fmt.Sprintf("debug-log-%s.log", modelUUID)
+	}
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", "attachment; filename=debug-log.tar.gz")
+	w.WriteHeader(http.StatusOK)
+
+	// Any failure from here on can't be reported to the client as an error
+	// response, as the headers have already been sent.
+	if err := writeLogArchive(w, name, tmpFile, time.Now()); err != nil {
+		logger.Errorf(req.Context(), "writing debug-log export: %v", err)
+	}
+	return nil
+}
+
+const (
+	// logsinkBackupTimeFormat is the format of the timestamp in the names
+	// of rotated logsink.log backups.
+	logsinkBackupTimeFormat = "2006-01-02T15-04-05.000"
+
+	logsinkBackupPrefix = "logsink-"
+)
+
+// logsinkBackup is a rotated backup of logsink.log.
+type logsinkBackup struct {
+	path      string
+	rotatedAt time.Time
+}
+
+// logFilesForWindow returns the paths of the log files in the input
+// directory which may hold records between the input start and end times,
+// oldest first. Rotated backups of logsink.log hold the records written since
+// the previous rotation up to the time in their name; backups which can't
+// hold records in the window are omitted. A zero start or end time leaves the
+// window open at that end. The current logsink.log is always included.
+func logFilesForWindow(logDir string, start, end time.Time) ([]string, error) {
+	entries, err := os.ReadDir(logDir)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var backups []logsinkBackup
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, logsinkBackupPrefix) {
+			continue
+		}
+		ts := strings.TrimSuffix(strings.TrimSuffix(name, ".gz"), ".log")
+		if len(ts) == len(name) {
+			continue
+		}
+		rotatedAt, err := time.Parse(logsinkBackupTimeFormat, strings.TrimPrefix(ts, logsinkBackupPrefix))
+		if err != nil {
+			continue
+		}
+		backups = append(backups, logsinkBackup{
+			path:      filepath.Join(logDir, name),
+			rotatedAt: rotatedAt,
+		})
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].rotatedAt.Before(backups[j].rotatedAt)
+	})
+
+	var paths []string
+	var previous time.Time
+	for _, backup := range backups {
+		if (start.IsZero() || !backup.rotatedAt.Before(start)) &&
+			(end.IsZero() || previous.IsZero() || !previous.After(end)) {
+			paths = append(paths, backup.path)
+		}
+		previous = backup.rotatedAt
+	}
+	return append(paths, filepath.Join(logDir, "logsink.log")), nil
+}
+
+// exportLogFile writes the records in the input log file which match the
+// input parameters to the input writer, as [writeLogRecords] does. Rotated
+// backups compressed with gzip are decompressed first.
+func exportLogFile(
+	w io.Writer, modelUUID, logFile string, params logtailer.LogTailerParams, stop <-chan struct{},
+) error {
+	if strings.HasSuffix(logFile, ".gz") {
+		decompressed, err := decompressLogFile(logFile)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		defer func() {
+			_ = os.Remove(decompressed)
+		}()
+		logFile = decompressed
+	}
+
+	tailer, err := logtailer.NewLogTailer(modelUUID, logFile, params)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		_ = worker.Stop(tailer)
+	}()
+	return writeLogRecords(w, tailer, stop)
+}
+
+// decompressLogFile decompresses the input gzipped log file into a temporary
+// file, and returns its path. The caller is responsible for removing it.
+func decompressLogFile(path string) (_ string, err error) {
+	in, err := os.Open(path)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	defer func() {
+		_ = in.Close()
+	}()
+	gzr, err := gzip.NewReader(in)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+
+	out, err := os.CreateTemp("", "debug-log-export-backup-")
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	defer func() {
+		_ = out.Close()
+		if err != nil {
+			_ = os.Remove(out.Name())
+		}
+	}()
+	if _, err := io.Copy(out, gzr); err != nil {
+		return "", errors.Trace(err)
+	}
+	return out.Name(), errors.Trace(out.Close())
+}
+
+// writeLogRecords writes every record sent by the input tailer to the input
+// writer as a JSON document followed by a newline. It returns when the tailer
+// has no more records to send, or the input channel is closed.
+func writeLogRecords(w io.Writer, tailer logtailer.LogTailer, stop <-chan struct{}) error {
+	enc := json.NewEncoder(w)
+	for {
+		select {
+		case <-stop:
+			return errors.New("export aborted")
+		case rec, ok := <-tailer.Logs():
+			if !ok {
+				return errors.Annotate(tailer.Wait(), "tailer stopped")
+			}
+			if err := enc.Encode(formatLogRecord(rec)); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+}
+
+// writeLogArchive writes a gzipped tar archive to the input writer,
+// containing the contents of the input file under the input name.
+func writeLogArchive(w io.Writer, name string, f *os.File, modTime time.Time) error {
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return errors.Trace(err)
+	}
+
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    info.Size(),
+		ModTime: modTime,
+	}); err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := io.Copy(tw, f); err != nil {
+		return errors.Trace(err)
+	}
+	if err := tw.Close(); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(gzw.Close())
+}
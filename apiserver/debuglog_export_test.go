@@ -0,0 +1,329 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/apiserver/httpcontext"
+	corelogger "github.com/juju/juju/core/logger"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/logtailer"
+	"github.com/juju/juju/internal/services"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type debugLogExportSuite struct {
+	coretesting.BaseSuite
+}
+
+func TestDebugLogExportSuite(t *testing.T) {
+	tc.Run(t, &debugLogExportSuite{})
+}
+
+func (s *debugLogExportSuite) TestWriteLogRecordsAndArchive(c *tc.C) {
+	modelUUID := coretesting.ModelTag.Id()
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for i, entity := range []string{"machine-0", "unit-mysql-0", "machine-1"} {
+		err := enc.Encode(corelogger.LogRecord{
+			Time:      start.Add(time.Duration(i) * time.Hour),
+			ModelUUID: modelUUID,
+			Entity:    entity,
+			Level:     corelogger.INFO,
+			Module:    "juju.worker",
+			Message:   "message " + entity,
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}
+	logFile := filepath.Join(c.MkDir(), "logsink.log")
+	err := os.WriteFile(logFile, buf.Bytes(), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+
+	reqParams := debugLogParams{
+		noTail:        true,
+		fromTheStart:  true,
+		endTime:       start.Add(90 * time.Minute),
+		excludeEntity: []string{"unit-*"},
+	}
+	tailer, err := logtailer.NewLogTailer(modelUUID, logFile, makeLogTailerParams(reqParams))
+	c.Assert(err, tc.ErrorIsNil)
+
+	tmpFile, err := os.CreateTemp(c.MkDir(), "export")
+	c.Assert(err, tc.ErrorIsNil)
+	defer tmpFile.Close()
+
+	err = writeLogRecords(tmpFile, tailer, make(chan struct{}))
+	c.Assert(err, tc.ErrorIsNil)
+
+	var archive bytes.Buffer
+	err = writeLogArchive(&archive, "debug-log.log", tmpFile, start)
+	c.Assert(err, tc.ErrorIsNil)
+
+	gzr, err := gzip.NewReader(&archive)
+	c.Assert(err, tc.ErrorIsNil)
+	tr := tar.NewReader(gzr)
+	hdr, err := tr.Next()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(hdr.Name, tc.Equals, "debug-log.log")
+
+	content, err := io.ReadAll(tr)
+	c.Assert(err, tc.ErrorIsNil)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	c.Assert(lines, tc.HasLen, 1)
+
+	var msg params.LogMessage
+	err = json.Unmarshal([]byte(lines[0]), &msg)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(msg.Entity, tc.Equals, "machine-0")
+	c.Check(msg.Message, tc.Equals, "message machine-0")
+
+	_, err = tr.Next()
+	c.Check(err, tc.Equals, io.EOF)
+}
+
+func (s *debugLogExportSuite) TestLogFilesForWindow(c *tc.C) {
+	logDir := c.MkDir()
+	for _, name := range []string{
+		"logsink-2026-10-01T01-00-00.000.log.gz",
+		"logsink-2026-10-01T02-00-00.000.log.gz",
+		"logsink-2026-10-01T03-00-00.000.log",
+		"logsink.log",
+		"machine-0.log",
+	} {
+		err := os.WriteFile(filepath.Join(logDir, name), nil, 0644)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+	at := func(hour, minute int) time.Time {
+		return time.Date(2026, 10, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	for i, test := range []struct {
+		start, end time.Time
+		expected   []string
+	}{{
+		expected: []string{
+			"logsink-2026-10-01T01-00-00.000.log.gz",
+			"logsink-2026-10-01T02-00-00.000.log.gz",
+			"logsink-2026-10-01T03-00-00.000.log",
+			"logsink.log",
+		},
+	}, {
+		start: at(1, 30),
+		expected: []string{
+			"logsink-2026-10-01T02-00-00.000.log.gz",
+			"logsink-2026-10-01T03-00-00.000.log",
+			"logsink.log",
+		},
+	}, {
+		end: at(1, 30),
+		expected: []string{
+			"logsink-2026-10-01T01-00-00.000.log.gz",
+			"logsink-2026-10-01T02-00-00.000.log.gz",
+			"logsink.log",
+		},
+	}, {
+		start: at(4, 0),
+		expected: []string{
+			"logsink.log",
+		},
+	}} {
+		c.Logf("test %d", i)
+		paths, err := logFilesForWindow(logDir, test.start, test.end)
+		c.Assert(err, tc.ErrorIsNil)
+		names := make([]string, len(paths))
+		for i, path := range paths {
+			names[i] = filepath.Base(path)
+		}
+		c.Check(names, tc.DeepEquals, test.expected)
+	}
+}
+
+func (s *debugLogExportSuite) TestExportLogFileCompressed(c *tc.C) {
+	modelUUID := coretesting.ModelTag.Id()
+
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	err := json.NewEncoder(gzw).Encode(corelogger.LogRecord{
+		Time:      time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		ModelUUID: modelUUID,
+		Entity:    "machine-0",
+		Level:     corelogger.INFO,
+		Module:    "juju.worker",
+		Message:   "rotated",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(gzw.Close(), tc.ErrorIsNil)
+	logFile := filepath.Join(c.MkDir(), "logsink-2026-10-01T01-00-00.000.log.gz")
+	err = os.WriteFile(logFile, buf.Bytes(), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var out bytes.Buffer
+	err = exportLogFile(&out, modelUUID, logFile, makeLogTailerParams(debugLogParams{
+		noTail:       true,
+		fromTheStart: true,
+	}), make(chan struct{}))
+	c.Assert(err, tc.ErrorIsNil)
+
+	var msg params.LogMessage
+	err = json.Unmarshal(out.Bytes(), &msg)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(msg.Message, tc.Equals, "rotated")
+}
+
+// exportAuthenticator is an HTTP authenticator which authenticates every
+// request as the given entity.
+type exportAuthenticator struct {
+	authInfo authentication.AuthInfo
+}
+
+func (a exportAuthenticator) Authenticate(*http.Request) (authentication.AuthInfo, error) {
+	return a.authInfo, nil
+}
+
+func (s *debugLogExportSuite) exportHandler(logDir string) http.Handler {
+	return newDebugLogExportHandler(httpContext{srv: &Server{shared: &sharedServerContext{}}}, logDir)
+}
+
+func (s *debugLogExportSuite) serveExport(handler http.Handler, method, query string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/model/"+coretesting.ModelTag.Id()+"/log/export?"+query, nil)
+	req = req.WithContext(httpcontext.SetContextModelUUID(req.Context(), coremodel.UUID(coretesting.ModelTag.Id())))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func (s *debugLogExportSuite) patchLokiForwarding(enabled bool) {
+	s.PatchValue(&lokiForwardingEnabled, func(context.Context, services.ControllerDomainServices) bool {
+		return enabled
+	})
+}
+
+func readErrorResult(c *tc.C, recorder *httptest.ResponseRecorder) *params.Error {
+	var result params.ErrorResult
+	err := json.Unmarshal(recorder.Body.Bytes(), &result)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error, tc.NotNil)
+	return result.Error
+}
+
+func (s *debugLogExportSuite) TestServeHTTP(c *tc.C) {
+	s.patchLokiForwarding(false)
+	modelUUID := coretesting.ModelTag.Id()
+	logDir := c.MkDir()
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, rec := range []corelogger.LogRecord{{
+		Time:      time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		ModelUUID: modelUUID,
+		Entity:    "machine-0",
+		Level:     corelogger.INFO,
+		Message:   "in model",
+	}, {
+		Time:      time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		ModelUUID: "other-model",
+		Entity:    "machine-0",
+		Level:     corelogger.INFO,
+		Message:   "other model",
+	}} {
+		c.Assert(enc.Encode(rec), tc.ErrorIsNil)
+	}
+	err := os.WriteFile(filepath.Join(logDir, "logsink.log"), buf.Bytes(), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+
+	recorder := s.serveExport(s.exportHandler(logDir), http.MethodGet, "")
+	c.Assert(recorder.Code, tc.Equals, http.StatusOK)
+	c.Check(recorder.Header().Get("Content-Type"), tc.Equals, "application/gzip")
+
+	gzr, err := gzip.NewReader(recorder.Body)
+	c.Assert(err, tc.ErrorIsNil)
+	tr := tar.NewReader(gzr)
+	hdr, err := tr.Next()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(hdr.Name, tc.Equals, "debug-log-"+modelUUID+".log")
+	content, err := io.ReadAll(tr)
+	c.Assert(err, tc.ErrorIsNil)
+	var msg params.LogMessage
+	err = json.Unmarshal(content, &msg)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(msg.Message, tc.Equals, "in model")
+}
+
+func (s *debugLogExportSuite) TestServeHTTPMethodNotAllowed(c *tc.C) {
+	s.patchLokiForwarding(false)
+
+	recorder := s.serveExport(s.exportHandler(c.MkDir()), http.MethodPost, "")
+	c.Check(recorder.Code, tc.Equals, http.StatusMethodNotAllowed)
+	c.Check(readErrorResult(c, recorder).Message, tc.Equals, `unsupported method: "POST"`)
+}
+
+func (s *debugLogExportSuite) TestServeHTTPLokiForwarding(c *tc.C) {
+	s.patchLokiForwarding(true)
+
+	recorder := s.serveExport(s.exportHandler(c.MkDir()), http.MethodGet, "")
+	c.Check(recorder.Code, tc.Not(tc.Equals), http.StatusOK)
+	apiErr := readErrorResult(c, recorder)
+	c.Check(apiErr.Code, tc.Equals, params.CodeNotSupported)
+	c.Check(apiErr.Message, tc.Equals, "exporting logs while they are being forwarded to Loki not supported")
+}
+
+func (s *debugLogExportSuite) TestServeHTTPInvertedWindow(c *tc.C) {
+	s.patchLokiForwarding(false)
+
+	recorder := s.serveExport(s.exportHandler(c.MkDir()), http.MethodGet,
+		"startTime=2026-10-01T02:00:00Z&endTime=2026-10-01T01:00:00Z")
+	c.Check(recorder.Code, tc.Not(tc.Equals), http.StatusOK)
+	apiErr := readErrorResult(c, recorder)
+	c.Check(apiErr.Code, tc.Equals, params.CodeNotValid)
+	c.Check(apiErr.Message, tc.Equals,
+		"end time 2026-10-01T01:00:00Z before start time 2026-10-01T02:00:00Z not valid")
+}
+
+func (s *debugLogExportSuite) TestServeHTTPInvalidTime(c *tc.C) {
+	s.patchLokiForwarding(false)
+
+	recorder := s.serveExport(s.exportHandler(c.MkDir()), http.MethodGet, "startTime=yesterday")
+	c.Check(recorder.Code, tc.Not(tc.Equals), http.StatusOK)
+	c.Check(readErrorResult(c, recorder).Code, tc.Equals, params.CodeNotValid)
+}
+
+func (s *debugLogExportSuite) TestServeHTTPPermissionDenied(c *tc.C) {
+	s.patchLokiForwarding(false)
+
+	// The export endpoint is served behind the same authorizer as
+	// debug-log, which denies workload machine agents.
+	handler := &httpcontext.AuthHandler{
+		NextHandler: s.exportHandler(c.MkDir()),
+		Authenticator: exportAuthenticator{authInfo: authentication.AuthInfo{
+			Tag: names.NewMachineTag("1"),
+		}},
+		Authorizer: debugLogAuthorizer(controllerAdminAuthorizer{
+			controllerTag: names.NewControllerTag(coretesting.ControllerTag.Id()),
+		}),
+	}
+
+	recorder := s.serveExport(handler, http.MethodGet, "")
+	c.Check(recorder.Code, tc.Equals, http.StatusForbidden)
+	c.Check(recorder.Body.String(), tc.Matches, "authorization failed: .*\n")
+}
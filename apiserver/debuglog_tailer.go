@@ -80,6 +80,7 @@ func makeLogTailerParams(reqParams debugLogParams) logtailer.LogTailerParams {
 		NoTail:        reqParams.noTail,
 		Firehose:      reqParams.firehose,
 		StartTime:     reqParams.startTime,
+		EndTime:       reqParams.endTime,
 		InitialLines:  int(reqParams.initialLines),
 		IncludeEntity: reqParams.includeEntity,
 		ExcludeEntity: reqParams.excludeEntity,
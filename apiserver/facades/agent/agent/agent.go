@@ -31,6 +31,7 @@ import (
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	"github.com/juju/juju/domain/model"
 	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -172,6 +173,7 @@ type AgentAPI struct {
 	controllerConfigService ControllerConfigService
 	applicationService      ApplicationService
 	machineService          MachineService
+	secretKeyStore          envelope.KeyStore
 	auth                    facade.Authorizer
 	watcherRegistry         facade.WatcherRegistry
 }
@@ -189,6 +191,7 @@ func NewAgentAPI(
 	machineService MachineService,
 	modelConfigService ModelConfigService,
 	applicationService ApplicationService,
+	secretKeyStore envelope.KeyStore,
 ) *AgentAPI {
 	getCanChange := func(context.Context) (common.AuthFunc, error) {
 		return auth.AuthOwner, nil
@@ -208,6 +211,7 @@ func NewAgentAPI(
 		controllerConfigService: controllerConfigService,
 		applicationService:      applicationService,
 		machineService:          machineService,
+		secretKeyStore:          secretKeyStore,
 		auth:                    auth,
 		watcherRegistry:         watcherRegistry,
 	}
@@ -332,6 +336,17 @@ func (api *AgentAPI) StateServingInfo(ctx context.Context) (result params.StateS
 		SystemIdentity: info.SystemIdentity,
 	}
 
+	keys, err := api.secretKeyStore.KeyEncryptionKeys()
+	if err != nil {
+		return params.StateServingInfo{}, errors.Annotate(err, "getting secret key-encryption keys")
+	}
+	for _, key := range keys {
+		result.SecretKeyEncryptionKeys = append(result.SecretKeyEncryptionKeys, params.SecretKeyEncryptionKey{
+			ID:  key.ID,
+			Key: key.Key,
+		})
+	}
+
 	return result, nil
 }
 
@@ -9,6 +9,7 @@ import (
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/internal/secrets/envelope"
 )
 
 // Register is called to expose a package of facades onto a given registry.
@@ -39,5 +40,6 @@ func NewAgentAPIV3(ctx facade.ModelContext) (*AgentAPI, error) {
 		services.Machine(),
 		services.Config(),
 		services.Application(),
+		envelope.NewFileKeyStore(envelope.KeyStorePath(ctx.DataDir())),
 	), nil
 }
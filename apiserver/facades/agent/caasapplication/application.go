@@ -215,6 +215,8 @@ func (f *Facade) UnitIntroduction(ctx context.Context, args params.CAASUnitIntro
 			LokiEndpoint:           lokiConfig.Endpoint,
 			LokiCACert:             lokiConfig.CACertificate,
 			LokiInsecureSkipVerify: lokiConfig.InsecureSkipVerify,
+			LokiOrgID:              lokiConfig.OrgID,
+			LokiProtocol:           string(lokiConfig.Protocol),
 		},
 	)
 	if err != nil {
@@ -187,6 +187,7 @@ func (api *LoggerAPIV2) GetControllerLokiConfig(ctx context.Context, arg params.
 		Endpoint:           config.Endpoint,
 		InsecureSkipVerify: config.InsecureSkipVerify,
 		OrgID:              config.OrgID,
+		Protocol:           string(config.Protocol),
 	}
 	if config.CACertificate != "" {
 		result.CACert = &config.CACertificate
@@ -74,6 +74,7 @@ func (api *ProvisionerAPI) ProvisioningInfo(ctx context.Context, args params.Ent
 		pInfo.LokiCACert = shared.LokiCACert
 		pInfo.LokiInsecureSkipVerify = shared.LokiInsecureSkipVerify
 		pInfo.LokiOrgID = shared.LokiOrgID
+		pInfo.LokiProtocol = shared.LokiProtocol
 		pInfo.TracingHTTPEndpoint = tracingConfig.HTTPEndpoint
 		pInfo.TracingGRPCEndpoint = tracingConfig.GRPCEndpoint
 		pInfo.TracingCACertificate = tracingConfig.CACertificate
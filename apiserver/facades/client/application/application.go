@@ -65,9 +65,24 @@ import (
 	"github.com/juju/juju/rpc/params"
 )
 
+// APIv25 provides the Application API facade for version 25.
+type APIv25 struct {
+	*APIBase
+}
+
+// APIv24 provides the Application API facade for version 24.
+type APIv24 struct {
+	*APIv25
+}
+
+// APIv23 provides the Application API facade for version 23.
+type APIv23 struct {
+	*APIv24
+}
+
 // APIv22 provides the Application API facade for version 22.
 type APIv22 struct {
-	*APIBase
+	*APIv23
 }
 
 // APIv21 provides the Application API facade for version 21.
@@ -1227,6 +1242,48 @@ func (api *APIBase) DestroyConsumedApplications(ctx context.Context, args params
 	}, nil
 }
 
+// PreviewDestroyConsumedApplications returns, for each of the specified
+// consumed (SAAS) applications, the entities that would be removed if the
+// application were destroyed. Nothing is removed.
+func (api *APIBase) PreviewDestroyConsumedApplications(ctx context.Context, args params.DestroyConsumedApplicationsParams) (params.RemovalPreviewResults, error) {
+	if err := api.checkCanRead(ctx); err != nil {
+		return params.RemovalPreviewResults{}, err
+	}
+	results := make([]params.RemovalPreviewResult, len(args.Applications))
+	for i, arg := range args.Applications {
+		appTag, err := names.ParseApplicationTag(arg.ApplicationTag)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+
+		remoteAppUUID, err := api.crossModelRelationService.GetRemoteApplicationOffererByApplicationName(ctx, appTag.Name)
+		if errors.Is(err, crossmodelrelationerrors.RemoteApplicationNotFound) {
+			results[i].Error = apiservererrors.ServerError(errors.NotFoundf("SAAS application %q", appTag.Name))
+			continue
+		} else if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+
+		preview, err := api.removalService.PreviewRemoteApplicationOffererRemoval(ctx, remoteAppUUID)
+		if errors.Is(err, crossmodelrelationerrors.RemoteApplicationNotFound) {
+			results[i].Error = apiservererrors.ServerError(errors.NotFoundf("SAAS application %q", appTag.Name))
+			continue
+		} else if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results[i].Info = common.RemovalPreview(preview)
+	}
+	return params.RemovalPreviewResults{
+		Results: results,
+	}, nil
+}
+
+// PreviewDestroyConsumedApplications isn't on the v22 API.
+func (api *APIv22) PreviewDestroyConsumedApplications(_ struct{}) {}
+
 // ScaleApplications scales the specified application to the requested number of units.
 func (api *APIBase) ScaleApplications(ctx context.Context, args params.ScaleApplicationsParamsV2) (params.ScaleApplicationResults, error) {
 	if api.modelType != model.CAAS {
@@ -27,6 +27,7 @@ import (
 	"github.com/juju/juju/core/os/ostype"
 	corerelation "github.com/juju/juju/core/relation"
 	relationtesting "github.com/juju/juju/core/relation/testing"
+	coreremoteapplication "github.com/juju/juju/core/remoteapplication"
 	"github.com/juju/juju/core/resource"
 	"github.com/juju/juju/core/resource/testing"
 	"github.com/juju/juju/core/status"
@@ -2118,6 +2119,146 @@ func (s *applicationSuite) TestGetApplicationStorageDirectivesInfo(c *tc.C) {
 	})
 }
 
+func (s *applicationSuite) TestPreviewDestroyConsumedApplications(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	remoteAppUUID := tc.Must(c, coreremoteapplication.NewUUID)
+	s.crossModelRelationService.EXPECT().GetRemoteApplicationOffererByApplicationName(
+		gomock.Any(), "mysql",
+	).Return(remoteAppUUID, nil)
+	s.removalService.EXPECT().PreviewRemoteApplicationOffererRemoval(
+		gomock.Any(), remoteAppUUID,
+	).Return(removal.Preview{
+		RemoteApplications: []string{"mysql"},
+		Relations:          []string{"wordpress:db mysql:db"},
+	}, nil)
+
+	res, err := s.api.PreviewDestroyConsumedApplications(c.Context(), params.DestroyConsumedApplicationsParams{
+		Applications: []params.DestroyConsumedApplicationParams{{
+			ApplicationTag: "application-mysql",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Assert(res.Results[0].Error, tc.IsNil)
+	c.Check(res.Results[0].Info, tc.DeepEquals, &params.RemovalPreview{
+		RemoteApplications: []params.Entity{{Tag: "application-mysql"}},
+		Relations:          []params.Entity{{Tag: "relation-wordpress.db#mysql.db"}},
+	})
+}
+
+func (s *applicationSuite) TestPreviewDestroyConsumedApplicationsNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	s.crossModelRelationService.EXPECT().GetRemoteApplicationOffererByApplicationName(
+		gomock.Any(), "mysql",
+	).Return("", crossmodelrelationerrors.RemoteApplicationNotFound)
+
+	res, err := s.api.PreviewDestroyConsumedApplications(c.Context(), params.DestroyConsumedApplicationsParams{
+		Applications: []params.DestroyConsumedApplicationParams{{
+			ApplicationTag: "application-mysql",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Check(res.Results[0].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *applicationSuite) TestSetPlacementRules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	s.applicationService.EXPECT().SetApplicationPlacementRules(gomock.Any(), "foo", domainapplication.PlacementRules{
+		MaxUnitsPerMachine: 1,
+		AntiAffinity:       []string{"bar"},
+	}).Return(nil)
+	s.applicationService.EXPECT().SetApplicationPlacementRules(gomock.Any(), "baz", domainapplication.PlacementRules{
+		Affinity: []string{"baz"},
+	}).Return(applicationerrors.PlacementRulesNotValid)
+
+	res, err := s.api.SetPlacementRules(c.Context(), params.SetApplicationPlacementRulesArgs{
+		Args: []params.SetApplicationPlacementRulesArg{{
+			ApplicationTag: names.NewApplicationTag("foo").String(),
+			Rules: params.ApplicationPlacementRules{
+				MaxUnitsPerMachine: 1,
+				AntiAffinity:       []string{"bar"},
+			},
+		}, {
+			ApplicationTag: names.NewApplicationTag("baz").String(),
+			Rules: params.ApplicationPlacementRules{
+				Affinity: []string{"baz"},
+			},
+		}, {
+			ApplicationTag: "unit-foo-0",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 3)
+	c.Check(res.Results[0].Error, tc.IsNil)
+	c.Check(res.Results[1].Error, tc.Satisfies, params.IsCodeNotValid)
+	c.Check(res.Results[2].Error, tc.ErrorMatches, `"unit-foo-0" is not a valid application tag`)
+}
+
+func (s *applicationSuite) TestGetPlacementRules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	s.applicationService.EXPECT().GetApplicationPlacementRules(gomock.Any(), "foo").Return(domainapplication.PlacementRules{
+		MaxUnitsPerZone: 2,
+		Affinity:        []string{"bar"},
+	}, nil)
+	s.applicationService.EXPECT().GetApplicationPlacementRules(gomock.Any(), "baz").Return(
+		domainapplication.PlacementRules{}, applicationerrors.ApplicationNotFound,
+	)
+
+	res, err := s.api.GetPlacementRules(c.Context(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: names.NewApplicationTag("foo").String()},
+			{Tag: names.NewApplicationTag("baz").String()},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 2)
+	c.Check(res.Results[0], tc.DeepEquals, params.ApplicationPlacementRulesResult{
+		Rules: &params.ApplicationPlacementRules{
+			MaxUnitsPerZone: 2,
+			Affinity:        []string{"bar"},
+		},
+	})
+	c.Check(res.Results[1].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *applicationSuite) TestRenameApplication(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	s.applicationService.EXPECT().RenameApplication(gomock.Any(), "foo", "bar").Return(nil)
+	s.applicationService.EXPECT().RenameApplication(gomock.Any(), "baz", "qux").Return(applicationerrors.ApplicationNotAlive)
+	s.applicationService.EXPECT().RenameApplication(gomock.Any(), "quux", "bar").Return(applicationerrors.ApplicationAlreadyExists)
+
+	res, err := s.api.RenameApplication(c.Context(), params.RenameApplicationArgs{
+		Args: []params.RenameApplicationArg{
+			{ApplicationTag: names.NewApplicationTag("foo").String(), Name: "bar"},
+			{ApplicationTag: names.NewApplicationTag("baz").String(), Name: "qux"},
+			{ApplicationTag: names.NewApplicationTag("quux").String(), Name: "bar"},
+			{ApplicationTag: "unit-foo-0", Name: "bar"},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 4)
+	c.Check(res.Results[0].Error, tc.IsNil)
+	c.Check(res.Results[1].Error, tc.Satisfies, params.IsCodeNotSupported)
+	c.Check(res.Results[2].Error, tc.Satisfies, params.IsCodeAlreadyExists)
+	c.Check(res.Results[3].Error, tc.ErrorMatches, `"unit-foo-0" is not a valid application tag`)
+}
+
 func (s *applicationSuite) setupAPI(c *tc.C) {
 	s.expectAuthClient()
 	s.expectAnyPermissions()
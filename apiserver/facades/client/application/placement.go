@@ -0,0 +1,102 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// SetPlacementRules replaces the placement rules of the given applications.
+// The rules are enforced when units are added to existing machines.
+func (api *APIBase) SetPlacementRules(ctx context.Context, args params.SetApplicationPlacementRulesArgs) (params.ErrorResults, error) {
+	if err := api.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	results := make([]params.ErrorResult, len(args.Args))
+	for i, arg := range args.Args {
+		err := api.setPlacementRules(ctx, arg)
+		results[i].Error = apiservererrors.ServerError(err)
+	}
+	return params.ErrorResults{Results: results}, nil
+}
+
+func (api *APIBase) setPlacementRules(ctx context.Context, arg params.SetApplicationPlacementRulesArg) error {
+	appTag, err := names.ParseApplicationTag(arg.ApplicationTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	err = api.applicationService.SetApplicationPlacementRules(ctx, appTag.Id(), application.PlacementRules{
+		MaxUnitsPerMachine: arg.Rules.MaxUnitsPerMachine,
+		MaxUnitsPerZone:    arg.Rules.MaxUnitsPerZone,
+		AntiAffinity:       arg.Rules.AntiAffinity,
+		Affinity:           arg.Rules.Affinity,
+	})
+	switch {
+	case errors.Is(err, applicationerrors.ApplicationNotFound):
+		return internalerrors.Errorf("%w%w", err, errors.Hide(errors.NotFound))
+	case errors.Is(err, applicationerrors.PlacementRulesNotValid):
+		return internalerrors.Errorf("%w%w", err, errors.Hide(errors.NotValid))
+	case err != nil:
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// GetPlacementRules returns the placement rules of the given applications.
+func (api *APIBase) GetPlacementRules(ctx context.Context, args params.Entities) (params.ApplicationPlacementRulesResults, error) {
+	if err := api.checkCanRead(ctx); err != nil {
+		return params.ApplicationPlacementRulesResults{}, errors.Trace(err)
+	}
+
+	results := make([]params.ApplicationPlacementRulesResult, len(args.Entities))
+	for i, entity := range args.Entities {
+		rules, err := api.getPlacementRules(ctx, entity.Tag)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results[i].Rules = rules
+	}
+	return params.ApplicationPlacementRulesResults{Results: results}, nil
+}
+
+func (api *APIBase) getPlacementRules(ctx context.Context, tag string) (*params.ApplicationPlacementRules, error) {
+	appTag, err := names.ParseApplicationTag(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	rules, err := api.applicationService.GetApplicationPlacementRules(ctx, appTag.Id())
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return nil, errors.NotFoundf("application %q", appTag.Id())
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &params.ApplicationPlacementRules{
+		MaxUnitsPerMachine: rules.MaxUnitsPerMachine,
+		MaxUnitsPerZone:    rules.MaxUnitsPerZone,
+		AntiAffinity:       rules.AntiAffinity,
+		Affinity:           rules.Affinity,
+	}, nil
+}
+
+// SetPlacementRules isn't on the v23 API.
+func (api *APIv23) SetPlacementRules(_ struct{}) {}
+
+// GetPlacementRules isn't on the v23 API.
+func (api *APIv23) GetPlacementRules(_ struct{}) {}
@@ -27,6 +27,15 @@ func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Application", 22, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newFacadeV22(stdCtx, ctx) // Added GetApplicationStorage and UpdateApplicationStorage storage constraints support
 	}, reflect.TypeFor[*APIv22]())
+	registry.MustRegister("Application", 23, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacadeV23(stdCtx, ctx) // Added PreviewDestroyConsumedApplications
+	}, reflect.TypeFor[*APIv23]())
+	registry.MustRegister("Application", 24, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacadeV24(stdCtx, ctx) // Added GetPlacementRules and SetPlacementRules
+	}, reflect.TypeFor[*APIv24]())
+	registry.MustRegister("Application", 25, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacadeV25(stdCtx, ctx) // Added RenameApplication
+	}, reflect.TypeFor[*APIv25]())
 }
 
 func newFacadeV19(stdCtx context.Context, ctx facade.ModelContext) (*APIv19, error) {
@@ -54,9 +63,33 @@ func newFacadeV21(stdCtx context.Context, ctx facade.ModelContext) (*APIv21, err
 }
 
 func newFacadeV22(stdCtx context.Context, ctx facade.ModelContext) (*APIv22, error) {
-	api, err := newFacadeBase(stdCtx, ctx)
+	api, err := newFacadeV23(stdCtx, ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	return &APIv22{api}, nil
 }
+
+func newFacadeV23(stdCtx context.Context, ctx facade.ModelContext) (*APIv23, error) {
+	api, err := newFacadeV24(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv23{api}, nil
+}
+
+func newFacadeV24(stdCtx context.Context, ctx facade.ModelContext) (*APIv24, error) {
+	api, err := newFacadeV25(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv24{api}, nil
+}
+
+func newFacadeV25(stdCtx context.Context, ctx facade.ModelContext) (*APIv25, error) {
+	api, err := newFacadeBase(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv25{api}, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	coreerrors "github.com/juju/juju/core/errors"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// RenameApplication changes the names of the given applications. The units
+// of each application are renamed with it.
+func (api *APIBase) RenameApplication(ctx context.Context, args params.RenameApplicationArgs) (params.ErrorResults, error) {
+	if err := api.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	results := make([]params.ErrorResult, len(args.Args))
+	for i, arg := range args.Args {
+		err := api.renameApplication(ctx, arg)
+		results[i].Error = apiservererrors.ServerError(err)
+	}
+	return params.ErrorResults{Results: results}, nil
+}
+
+func (api *APIBase) renameApplication(ctx context.Context, arg params.RenameApplicationArg) error {
+	appTag, err := names.ParseApplicationTag(arg.ApplicationTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	err = api.applicationService.RenameApplication(ctx, appTag.Id(), arg.Name)
+	switch {
+	case errors.Is(err, applicationerrors.ApplicationNotFound):
+		return internalerrors.Errorf("%w%w", err, errors.Hide(errors.NotFound))
+	case errors.Is(err, applicationerrors.ApplicationAlreadyExists):
+		return internalerrors.Errorf("%w%w", err, errors.Hide(errors.AlreadyExists))
+	case errors.Is(err, applicationerrors.ApplicationNameNotValid):
+		return internalerrors.Errorf("%w%w", err, errors.Hide(errors.NotValid))
+	case errors.Is(err, applicationerrors.ApplicationNotAlive),
+		errors.Is(err, coreerrors.NotSupported):
+		return internalerrors.Errorf("%w%w", err, errors.Hide(errors.NotSupported))
+	case err != nil:
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// RenameApplication isn't on the v24 API.
+func (api *APIv24) RenameApplication(_ struct{}) {}
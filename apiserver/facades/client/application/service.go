@@ -396,6 +396,18 @@ type ApplicationService interface {
 	// ResolveApplicationConstraints resolves given application constraints, taking
 	// into account the model constraints.
 	ResolveApplicationConstraints(ctx context.Context, appCons constraints.Value) (domainconstraints.Constraints, error)
+
+	// SetApplicationPlacementRules replaces the placement rules of the named
+	// application.
+	SetApplicationPlacementRules(ctx context.Context, appName string, rules application.PlacementRules) error
+
+	// GetApplicationPlacementRules returns the placement rules of the named
+	// application.
+	GetApplicationPlacementRules(ctx context.Context, appName string) (application.PlacementRules, error)
+
+	// RenameApplication changes the name of an application that has no
+	// units.
+	RenameApplication(ctx context.Context, name, newName string) error
 }
 
 type ResolveService interface {
@@ -585,6 +597,14 @@ type RemovalService interface {
 		wait time.Duration,
 	) (removal.UUID, error)
 
+	// PreviewRemoteApplicationOffererRemoval returns the entities that would
+	// be removed if the remote application offerer with the input UUID were
+	// removed. The model is not changed.
+	PreviewRemoteApplicationOffererRemoval(
+		ctx context.Context,
+		remoteAppOffererUUID coreremoteapplication.UUID,
+	) (removal.Preview, error)
+
 	// RemoveRelationWithRemoteOfferer checks if a relation with the input UUID exists.
 	// If it does, the relation is guaranteed after this call to be:
 	// - No longer alive.
@@ -357,6 +357,7 @@ type MockApplicationServiceMockRecorder struct {
 	getApplicationEndpointBindingsExpects      []*gomock.Call2_2[context.Context, string, map[string]network.SpaceUUID, error]
 	getApplicationEndpointNamesExpects         []*gomock.Call2_2[context.Context, application.UUID, []string, error]
 	getApplicationLifeExpects                  []*gomock.Call2_2[context.Context, application.UUID, life.Value, error]
+	getApplicationPlacementRulesExpects        []*gomock.Call2_2[context.Context, string, application0.PlacementRules, error]
 	getApplicationStorageDirectivesInfoExpects []*gomock.Call2_2[context.Context, application.UUID, map[string]application0.ApplicationStorageInfo, error]
 	getApplicationUUIDByNameExpects            []*gomock.Call2_2[context.Context, string, application.UUID, error]
 	getCharmExpects                            []*gomock.Call2_4[context.Context, charm0.CharmLocator, charm1.Charm, charm0.CharmLocator, bool, error]
@@ -378,9 +379,11 @@ type MockApplicationServiceMockRecorder struct {
 	isSubordinateApplicationByNameExpects      []*gomock.Call2_2[context.Context, string, bool, error]
 	mergeApplicationEndpointBindingsExpects    []*gomock.Call4_1[context.Context, application.UUID, map[string]network.SpaceName, bool, error]
 	mergeExposeSettingsExpects                 []*gomock.Call3_1[context.Context, string, map[string]application0.ExposedEndpoint, error]
+	renameApplicationExpects                   []*gomock.Call3_1[context.Context, string, string, error]
 	resolveApplicationConstraintsExpects       []*gomock.Call2_2[context.Context, constraints.Value, constraints0.Constraints, error]
 	setApplicationCharmExpects                 []*gomock.Call4_1[context.Context, string, charm0.CharmLocator, application0.SetCharmParams, error]
 	setApplicationConstraintsExpects           []*gomock.Call3_1[context.Context, application.UUID, constraints.Value, error]
+	setApplicationPlacementRulesExpects        []*gomock.Call3_1[context.Context, string, application0.PlacementRules, error]
 	setApplicationScaleExpects                 []*gomock.Call3_1[context.Context, string, int, error]
 	unsetApplicationConfigKeysExpects          []*gomock.Call3_1[context.Context, application.UUID, []string, error]
 	unsetExposeSettingsExpects                 []*gomock.Call3_1[context.Context, string, set.Strings, error]
@@ -619,6 +622,24 @@ func (mr *MockApplicationServiceMockRecorder) GetApplicationLife(arg0, arg1 any)
 // MockApplicationServiceGetApplicationLifeCall is the typed call wrapper for GetApplicationLife.
 type MockApplicationServiceGetApplicationLifeCall = gomock.Call2_2[context.Context, application.UUID, life.Value, error]
 
+// GetApplicationPlacementRules mocks base method.
+func (m *MockApplicationService) GetApplicationPlacementRules(ctx context.Context, appName string) (application0.PlacementRules, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getApplicationPlacementRulesExpects, m.ctrl, m, "GetApplicationPlacementRules", ctx, appName)
+}
+
+// GetApplicationPlacementRules indicates an expected call of GetApplicationPlacementRules.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationPlacementRules(ctx, appName any) *MockApplicationServiceGetApplicationPlacementRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, application0.PlacementRules, error](mr.mock.ctrl.T, mr.mock, "GetApplicationPlacementRules", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appName))
+	mr.getApplicationPlacementRulesExpects = append(mr.getApplicationPlacementRulesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceGetApplicationPlacementRulesCall is the typed call wrapper for GetApplicationPlacementRules.
+type MockApplicationServiceGetApplicationPlacementRulesCall = gomock.Call2_2[context.Context, string, application0.PlacementRules, error]
+
 // GetApplicationStorageDirectivesInfo mocks base method.
 func (m *MockApplicationService) GetApplicationStorageDirectivesInfo(ctx context.Context, uuid application.UUID) (map[string]application0.ApplicationStorageInfo, error) {
 	m.ctrl.T.Helper()
@@ -997,6 +1018,24 @@ func (mr *MockApplicationServiceMockRecorder) MergeExposeSettings(ctx, appName,
 // MockApplicationServiceMergeExposeSettingsCall is the typed call wrapper for MergeExposeSettings.
 type MockApplicationServiceMergeExposeSettingsCall = gomock.Call3_1[context.Context, string, map[string]application0.ExposedEndpoint, error]
 
+// RenameApplication mocks base method.
+func (m *MockApplicationService) RenameApplication(ctx context.Context, name, newName string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.renameApplicationExpects, m.ctrl, m, "RenameApplication", ctx, name, newName)
+}
+
+// RenameApplication indicates an expected call of RenameApplication.
+func (mr *MockApplicationServiceMockRecorder) RenameApplication(ctx, name, newName any) *MockApplicationServiceRenameApplicationCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, string, string, error](mr.mock.ctrl.T, mr.mock, "RenameApplication", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name), gomock.EnsureMatcher(newName))
+	mr.renameApplicationExpects = append(mr.renameApplicationExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceRenameApplicationCall is the typed call wrapper for RenameApplication.
+type MockApplicationServiceRenameApplicationCall = gomock.Call3_1[context.Context, string, string, error]
+
 // ResolveApplicationConstraints mocks base method.
 func (m *MockApplicationService) ResolveApplicationConstraints(ctx context.Context, appCons constraints.Value) (constraints0.Constraints, error) {
 	m.ctrl.T.Helper()
@@ -1051,6 +1090,24 @@ func (mr *MockApplicationServiceMockRecorder) SetApplicationConstraints(arg0, ar
 // MockApplicationServiceSetApplicationConstraintsCall is the typed call wrapper for SetApplicationConstraints.
 type MockApplicationServiceSetApplicationConstraintsCall = gomock.Call3_1[context.Context, application.UUID, constraints.Value, error]
 
+// SetApplicationPlacementRules mocks base method.
+func (m *MockApplicationService) SetApplicationPlacementRules(ctx context.Context, appName string, rules application0.PlacementRules) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.setApplicationPlacementRulesExpects, m.ctrl, m, "SetApplicationPlacementRules", ctx, appName, rules)
+}
+
+// SetApplicationPlacementRules indicates an expected call of SetApplicationPlacementRules.
+func (mr *MockApplicationServiceMockRecorder) SetApplicationPlacementRules(ctx, appName, rules any) *MockApplicationServiceSetApplicationPlacementRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, string, application0.PlacementRules, error](mr.mock.ctrl.T, mr.mock, "SetApplicationPlacementRules", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appName), gomock.EnsureMatcher(rules))
+	mr.setApplicationPlacementRulesExpects = append(mr.setApplicationPlacementRulesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceSetApplicationPlacementRulesCall is the typed call wrapper for SetApplicationPlacementRules.
+type MockApplicationServiceSetApplicationPlacementRulesCall = gomock.Call3_1[context.Context, string, application0.PlacementRules, error]
+
 // SetApplicationScale mocks base method.
 func (m *MockApplicationService) SetApplicationScale(ctx context.Context, name string, scale int) error {
 	m.ctrl.T.Helper()
@@ -1562,12 +1619,13 @@ type MockRemovalService struct {
 
 // MockRemovalServiceMockRecorder is the mock recorder for MockRemovalService.
 type MockRemovalServiceMockRecorder struct {
-	mock                                   *MockRemovalService
-	removeApplicationExpects               []*gomock.Call5_2[context.Context, application.UUID, bool, bool, time.Duration, removal.UUID, error]
-	removeRelationExpects                  []*gomock.Call4_2[context.Context, relation.UUID, bool, time.Duration, removal.UUID, error]
-	removeRelationWithRemoteOffererExpects []*gomock.Call4_2[context.Context, relation.UUID, bool, time.Duration, removal.UUID, error]
-	removeRemoteApplicationOffererExpects  []*gomock.Call4_2[context.Context, remoteapplication.UUID, bool, time.Duration, removal.UUID, error]
-	removeUnitExpects                      []*gomock.Call5_2[context.Context, unit.UUID, bool, bool, time.Duration, removal.UUID, error]
+	mock                                          *MockRemovalService
+	previewRemoteApplicationOffererRemovalExpects []*gomock.Call2_2[context.Context, remoteapplication.UUID, removal.Preview, error]
+	removeApplicationExpects                      []*gomock.Call5_2[context.Context, application.UUID, bool, bool, time.Duration, removal.UUID, error]
+	removeRelationExpects                         []*gomock.Call4_2[context.Context, relation.UUID, bool, time.Duration, removal.UUID, error]
+	removeRelationWithRemoteOffererExpects        []*gomock.Call4_2[context.Context, relation.UUID, bool, time.Duration, removal.UUID, error]
+	removeRemoteApplicationOffererExpects         []*gomock.Call4_2[context.Context, remoteapplication.UUID, bool, time.Duration, removal.UUID, error]
+	removeUnitExpects                             []*gomock.Call5_2[context.Context, unit.UUID, bool, bool, time.Duration, removal.UUID, error]
 }
 
 // NewMockRemovalService creates a new mock instance.
@@ -1582,6 +1640,24 @@ func (m *MockRemovalService) EXPECT() *MockRemovalServiceMockRecorder {
 	return m.recorder
 }
 
+// PreviewRemoteApplicationOffererRemoval mocks base method.
+func (m *MockRemovalService) PreviewRemoteApplicationOffererRemoval(ctx context.Context, remoteAppOffererUUID remoteapplication.UUID) (removal.Preview, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.previewRemoteApplicationOffererRemovalExpects, m.ctrl, m, "PreviewRemoteApplicationOffererRemoval", ctx, remoteAppOffererUUID)
+}
+
+// PreviewRemoteApplicationOffererRemoval indicates an expected call of PreviewRemoteApplicationOffererRemoval.
+func (mr *MockRemovalServiceMockRecorder) PreviewRemoteApplicationOffererRemoval(ctx, remoteAppOffererUUID any) *MockRemovalServicePreviewRemoteApplicationOffererRemovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, remoteapplication.UUID, removal.Preview, error](mr.mock.ctrl.T, mr.mock, "PreviewRemoteApplicationOffererRemoval", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(remoteAppOffererUUID))
+	mr.previewRemoteApplicationOffererRemovalExpects = append(mr.previewRemoteApplicationOffererRemovalExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockRemovalServicePreviewRemoteApplicationOffererRemovalCall is the typed call wrapper for PreviewRemoteApplicationOffererRemoval.
+type MockRemovalServicePreviewRemoteApplicationOffererRemovalCall = gomock.Call2_2[context.Context, remoteapplication.UUID, removal.Preview, error]
+
 // RemoveApplication mocks base method.
 func (m *MockRemovalService) RemoveApplication(ctx context.Context, appUUID application.UUID, destroyStorage, force bool, wait time.Duration) (removal.UUID, error) {
 	m.ctrl.T.Helper()
@@ -15,6 +15,7 @@ import (
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
 	"github.com/juju/collections/transform"
 	"github.com/juju/names/v6"
+	"gopkg.in/macaroon.v2"
 
 	"github.com/juju/juju/apiserver/authentication"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
@@ -60,6 +61,18 @@ type OffersAPIv5 struct {
 	*OffersAPI
 }
 
+// OffersAPIv6 implements the cross model interface for version 6 of the
+// ApplicationOffers facade.
+type OffersAPIv6 struct {
+	*OffersAPI
+}
+
+// OffersAPIv7 implements the cross model interface for version 7 of the
+// ApplicationOffers facade, which does not query external controllers.
+type OffersAPIv7 struct {
+	*OffersAPI
+}
+
 // OffersAPI implements the cross model interface and is the concrete
 // implementation of the api end point.
 type OffersAPI struct {
@@ -70,12 +83,18 @@ type OffersAPI struct {
 	modelUUID      model.UUID
 	logger         corelogger.Logger
 
-	accessService     AccessService
-	controllerService ControllerService
-	modelService      ModelService
+	accessService             AccessService
+	controllerService         ControllerService
+	externalControllerService ExternalControllerService
+	modelService              ModelService
 
 	crossModelRelationServiceGetter func(c context.Context, modelUUID model.UUID) (CrossModelRelationService, error)
 	removalServiceGetter            func(c context.Context, modelUUID model.UUID) (RemovalService, error)
+
+	// externalOffersAPIGetter connects to an external controller to proxy
+	// offer queries for URLs with a source. If nil, such queries are not
+	// supported.
+	externalOffersAPIGetter func(c context.Context, info corecrossmodel.ControllerInfo, macaroons []macaroon.Slice) (ExternalOffersAPI, error)
 }
 
 // createAPI returns a new application offers OffersAPI facade.
@@ -86,9 +105,11 @@ func createOffersAPI(
 	modelUUID model.UUID,
 	accessService AccessService,
 	controllerService ControllerService,
+	externalControllerService ExternalControllerService,
 	modelService ModelService,
 	crossModelRelationServiceGetter func(c context.Context, modelUUID model.UUID) (CrossModelRelationService, error),
 	removalServiceGetter func(c context.Context, modelUUID model.UUID) (RemovalService, error),
+	externalOffersAPIGetter func(c context.Context, info corecrossmodel.ControllerInfo, macaroons []macaroon.Slice) (ExternalOffersAPI, error),
 	logger corelogger.Logger,
 ) (*OffersAPI, error) {
 	if !authorizer.AuthClient() {
@@ -102,9 +123,11 @@ func createOffersAPI(
 		modelUUID:                       modelUUID,
 		accessService:                   accessService,
 		controllerService:               controllerService,
+		externalControllerService:       externalControllerService,
 		modelService:                    modelService,
 		crossModelRelationServiceGetter: crossModelRelationServiceGetter,
 		removalServiceGetter:            removalServiceGetter,
+		externalOffersAPIGetter:         externalOffersAPIGetter,
 		logger:                          logger,
 	}
 	return api, nil
@@ -177,15 +200,76 @@ func (api *OffersAPI) Offer(ctx context.Context, all params.AddApplicationOffers
 
 	err = crossModelRelationService.CreateOffer(ctx, applicationOfferArgs)
 	if errors.Is(err, crossmodelrelationerrors.OfferAlreadyExists) {
-		// We don't support updating offers via this API, so return an
-		// appropriate error.
-		err = errors.Errorf("offer %q already exists, updating offers is not supported", applicationOfferArgs.OfferName).Add(coreerrors.BadRequest)
+		// Offers are updated with UpdateOffer, so return an appropriate
+		// error.
+		err = errors.Errorf("offer %q already exists, use UpdateOffer to change it", applicationOfferArgs.OfferName).Add(coreerrors.BadRequest)
 	} else if errors.Is(err, applicationerrors.ApplicationNotFound) {
 		err = errors.Errorf("application %q not found in model %q", applicationOfferArgs.ApplicationName, offerModelUUID.String()).Add(coreerrors.NotFound)
 	}
 	return handleErr(err), nil
 }
 
+// UpdateOffer changes the endpoints and description of an existing offer in
+// place, keeping the relations of its consumers on the endpoints which
+// remain offered.
+func (api *OffersAPI) UpdateOffer(ctx context.Context, all params.UpdateApplicationOffers) (params.ErrorResults, error) {
+	// As with Offer, only one offer is updated per call.
+	numOffers := len(all.Offers)
+	if numOffers != 1 {
+		return params.ErrorResults{}, errors.Errorf("expected exactly one offer, got %d", numOffers)
+	}
+
+	handleErr := func(err error) params.ErrorResults {
+		return params.ErrorResults{Results: []params.ErrorResult{{
+			Error: apiservererrors.ServerError(err),
+		}}}
+	}
+
+	apiUserTag, ok := api.authorizer.GetAuthTag().(names.UserTag)
+	if !ok {
+		return handleErr(apiservererrors.ErrPerm), nil
+	}
+
+	one := all.Offers[0]
+	offerModelUUID := api.modelUUID
+	if one.ModelTag != "" {
+		modelTag, err := names.ParseModelTag(one.ModelTag)
+		if err != nil {
+			return handleErr(err), nil
+		}
+		offerModelUUID = model.UUID(modelTag.Id())
+	}
+
+	if err := api.checkAPIUserAdmin(ctx, offerModelUUID); err != nil {
+		msgerr := errors.Errorf("checking user %q has admin permission on model %q: %w", apiUserTag.String(), offerModelUUID.String(), apiservererrors.ErrPerm)
+		return handleErr(msgerr), nil
+	}
+
+	crossModelRelationService, err := api.crossModelRelationServiceGetter(ctx, offerModelUUID)
+	if err != nil {
+		return handleErr(err), nil
+	}
+
+	err = crossModelRelationService.UpdateOffer(ctx, crossmodelrelation.UpdateApplicationOfferArgs{
+		OfferName:   one.OfferName,
+		Endpoints:   one.Endpoints,
+		Description: one.Description,
+		Force:       one.Force,
+	})
+	if errors.Is(err, crossmodelrelationerrors.OfferNotFound) {
+		err = errors.Errorf("offer %q not found in model %q", one.OfferName, offerModelUUID.String()).Add(coreerrors.NotFound)
+	} else if errors.Is(err, crossmodelrelationerrors.OfferEndpointHasRelations) {
+		err = errors.Errorf("updating offer %q: %w", one.OfferName, err).Add(coreerrors.BadRequest)
+	}
+	return handleErr(err), nil
+}
+
+// UpdateOffer isn't on the v6 API.
+func (*OffersAPIv6) UpdateOffer(_ struct{}) {}
+
+// UpdateOffer isn't on the v5 API.
+func (*OffersAPIv5) UpdateOffer(_ struct{}) {}
+
 func (api *OffersAPI) parseApplicationOfferArgs(
 	apiUser names.UserTag,
 	addOfferParams params.AddApplicationOffer,
@@ -823,6 +907,7 @@ func (api *OffersAPI) modelForName(ctx context.Context, modelName string, qualif
 }
 
 // ApplicationOffers gets details about remote applications that match given URLs.
+// URLs with a source are queried on the external controller with that alias.
 func (api *OffersAPI) ApplicationOffers(ctx context.Context, urls params.OfferURLs) (params.ApplicationOffersResults, error) {
 	var results params.ApplicationOffersResults
 	results.Results = make([]params.ApplicationOfferResult, len(urls.OfferURLs))
@@ -835,6 +920,9 @@ func (api *OffersAPI) ApplicationOffers(ctx context.Context, urls params.OfferUR
 	if err != nil {
 		return results, apiservererrors.ServerError(err)
 	}
+	if api.proxiesExternalOffers() {
+		api.proxyApplicationOffers(ctx, urls, offers)
+	}
 
 	results.Results = offers
 	return results, nil
@@ -940,29 +1028,58 @@ func (api *OffersAPI) FindApplicationOffers(ctx context.Context, filters params.
 		filtersToUse params.OfferFilters
 	)
 
+	// Filters with a source are queried on the external controller with
+	// that alias.
+	var localFilters []params.OfferFilter
+	externalFilters := make(map[string][]params.OfferFilter)
+	for _, f := range filters.Filters {
+		if f.Source == "" {
+			localFilters = append(localFilters, f)
+			continue
+		}
+		if !api.proxiesExternalOffers() {
+			return result, &params.Error{
+				Code:    params.CodeNotSupported,
+				Message: "query for non-local application offers",
+			}
+		}
+		externalFilters[f.Source] = append(externalFilters[f.Source], f)
+	}
+
 	// If there is only one filter term, and no model is specified, add in
 	// any models the user can see and query across those.
 	// If there's more than one filter term, each must specify a model.
-	if len(filters.Filters) == 1 && filters.Filters[0].ModelName == "" {
+	if len(localFilters) == 1 && localFilters[0].ModelName == "" {
 		models, err := api.modelService.GetAllModels(ctx)
 		if err != nil {
 			return result, errors.Capture(err)
 		}
 		for _, m := range models {
-			modelFilter := filters.Filters[0]
+			modelFilter := localFilters[0]
 			modelFilter.ModelName = m.Name
 			modelFilter.ModelQualifier = m.Qualifier.String()
 			filtersToUse.Filters = append(filtersToUse.Filters, modelFilter)
 		}
 	} else {
-		filtersToUse = filters
+		filtersToUse.Filters = localFilters
 	}
 
-	offers, err := api.getApplicationOffersDetails(ctx, apiUser, permission.ReadAccess, filtersToUse)
-	if err != nil {
-		return result, apiservererrors.ServerError(err)
+	if len(filtersToUse.Filters) > 0 || len(externalFilters) == 0 {
+		offers, err := api.getApplicationOffersDetails(ctx, apiUser, permission.ReadAccess, filtersToUse)
+		if err != nil {
+			return result, apiservererrors.ServerError(err)
+		}
+		result.Results = offers
+	}
+
+	for _, source := range slices.Sorted(maps.Keys(externalFilters)) {
+		offers, err := api.proxyFindApplicationOffers(
+			ctx, source, externalFilters[source], filters.Macaroons)
+		if err != nil {
+			return result, apiservererrors.ServerError(err)
+		}
+		result.Results = append(result.Results, offers...)
 	}
-	result.Results = offers
 	return result, nil
 }
 
@@ -996,7 +1113,14 @@ func (api *OffersAPI) GetConsumeDetails(ctx context.Context, args params.Consume
 		return params.ConsumeOfferDetailsResults{}, apiservererrors.ServerError(err)
 	}
 
-	return api.getConsumeDetails(ctx, controllerInfo, user, args.OfferURLs)
+	results, err := api.getConsumeDetails(ctx, controllerInfo, user, args.OfferURLs)
+	if err != nil {
+		return results, err
+	}
+	if api.proxiesExternalOffers() {
+		api.proxyGetConsumeDetails(ctx, args, results.Results)
+	}
+	return results, nil
 }
 
 func (api *OffersAPI) getControllerInfo(ctx context.Context) (controller.ControllerInfo, error) {
@@ -47,6 +47,8 @@ type offerSuite struct {
 	crossModelAuthContext     *MockCrossModelAuthContext
 	removalService            *MockRemovalService
 	controllerService         *MockControllerService
+	externalControllerService *MockExternalControllerService
+	externalOffersAPI         *MockExternalOffersAPI
 }
 
 func TestOfferSuite(t *testing.T) {
@@ -127,6 +129,94 @@ func (s *offerSuite) TestOfferPermission(c *tc.C) {
 	c.Assert(result.Results[0].Error, tc.ErrorMatches, `checking user "user-fred" has admin permission on model ".*": permission denied`)
 }
 
+func (s *offerSuite) TestUpdateOffer(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange
+	offerAPI := s.offerAPI(c)
+	modelTag := names.NewModelTag(offerAPI.modelUUID.String())
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("fred"))
+	s.setupCheckAPIUserAdmin(offerAPI.controllerUUID, modelTag)
+
+	description := "new description"
+	s.crossModelRelationService.EXPECT().UpdateOffer(gomock.Any(), crossmodelrelation.UpdateApplicationOfferArgs{
+		OfferName:   "test-offer",
+		Endpoints:   map[string]string{"db": "db", "admin": "admin"},
+		Description: &description,
+		Force:       true,
+	}).Return(nil)
+
+	all := params.UpdateApplicationOffers{Offers: []params.UpdateApplicationOffer{{
+		ModelTag:    modelTag.String(),
+		OfferName:   "test-offer",
+		Endpoints:   map[string]string{"db": "db", "admin": "admin"},
+		Description: &description,
+		Force:       true,
+	}}}
+
+	// Act
+	results, err := offerAPI.UpdateOffer(c.Context(), all)
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.DeepEquals, params.ErrorResults{Results: []params.ErrorResult{{Error: nil}}})
+}
+
+func (s *offerSuite) TestUpdateOfferEndpointHasRelations(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange
+	offerAPI := s.offerAPI(c)
+	modelTag := names.NewModelTag(offerAPI.modelUUID.String())
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("fred"))
+	s.setupCheckAPIUserAdmin(offerAPI.controllerUUID, modelTag)
+
+	s.crossModelRelationService.EXPECT().UpdateOffer(gomock.Any(), gomock.Any()).
+		Return(errors.Errorf(`cannot remove endpoints "db" with live relations`).Add(crossmodelrelationerrors.OfferEndpointHasRelations))
+
+	all := params.UpdateApplicationOffers{Offers: []params.UpdateApplicationOffer{{
+		ModelTag:  modelTag.String(),
+		OfferName: "test-offer",
+		Endpoints: map[string]string{"admin": "admin"},
+	}}}
+
+	// Act
+	results, err := offerAPI.UpdateOffer(c.Context(), all)
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Check(results.Results[0].Error, tc.ErrorMatches, `updating offer "test-offer": cannot remove endpoints "db" with live relations`)
+	c.Check(results.Results[0].Error.Code, tc.Equals, params.CodeBadRequest)
+}
+
+func (s *offerSuite) TestUpdateOfferNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange
+	offerAPI := s.offerAPI(c)
+	modelTag := names.NewModelTag(offerAPI.modelUUID.String())
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("fred"))
+	s.setupCheckAPIUserAdmin(offerAPI.controllerUUID, modelTag)
+
+	s.crossModelRelationService.EXPECT().UpdateOffer(gomock.Any(), gomock.Any()).
+		Return(crossmodelrelationerrors.OfferNotFound)
+
+	all := params.UpdateApplicationOffers{Offers: []params.UpdateApplicationOffer{{
+		ModelTag:  modelTag.String(),
+		OfferName: "test-offer",
+		Endpoints: map[string]string{"db": "db"},
+	}}}
+
+	// Act
+	results, err := offerAPI.UpdateOffer(c.Context(), all)
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Check(results.Results[0].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
 // TestOfferOwnerViaArgs tests that the offer is created with a different
 // owner than the caller.
 func (s *offerSuite) TestOfferOwnerViaArgs(c *tc.C) {
@@ -2865,6 +2955,8 @@ func (s *offerSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.crossModelAuthContext = NewMockCrossModelAuthContext(ctrl)
 	s.removalService = NewMockRemovalService(ctrl)
 	s.controllerService = NewMockControllerService(ctrl)
+	s.externalControllerService = NewMockExternalControllerService(ctrl)
+	s.externalOffersAPI = NewMockExternalOffersAPI(ctrl)
 
 	c.Cleanup(func() {
 		s.accessService = nil
@@ -2873,6 +2965,8 @@ func (s *offerSuite) setupMocks(c *tc.C) *gomock.Controller {
 		s.crossModelRelationService = nil
 		s.crossModelAuthContext = nil
 		s.removalService = nil
+		s.externalControllerService = nil
+		s.externalOffersAPI = nil
 	})
 	return ctrl
 }
@@ -0,0 +1,328 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package applicationoffers
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
+	"gopkg.in/macaroon.v2"
+
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/api/base"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	corecrossmodel "github.com/juju/juju/core/crossmodel"
+	jujuversion "github.com/juju/juju/core/version"
+	externalcontrollererrors "github.com/juju/juju/domain/externalcontroller/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// externalOffersAPI is an ExternalOffersAPI backed by a connection to the
+// ApplicationOffers facade on an external controller.
+type externalOffersAPI struct {
+	facade base.FacadeCaller
+	conn   base.APICallCloser
+}
+
+// newExternalOffersAPI returns an ExternalOffersAPI calling the
+// ApplicationOffers facade over the given external controller connection.
+func newExternalOffersAPI(conn base.APICallCloser) *externalOffersAPI {
+	return &externalOffersAPI{
+		facade: base.NewFacadeCaller(conn, "ApplicationOffers"),
+		conn:   conn,
+	}
+}
+
+// ApplicationOffers implements ExternalOffersAPI.
+func (a *externalOffersAPI) ApplicationOffers(ctx context.Context, urls params.OfferURLs) (params.ApplicationOffersResults, error) {
+	var results params.ApplicationOffersResults
+	err := a.facade.FacadeCall(ctx, "ApplicationOffers", urls, &results)
+	return results, errors.Capture(err)
+}
+
+// FindApplicationOffers implements ExternalOffersAPI.
+func (a *externalOffersAPI) FindApplicationOffers(ctx context.Context, filters params.OfferFilters) (params.QueryApplicationOffersResultsV5, error) {
+	var results params.QueryApplicationOffersResultsV5
+	err := a.facade.FacadeCall(ctx, "FindApplicationOffers", filters, &results)
+	return results, errors.Capture(err)
+}
+
+// GetConsumeDetails implements ExternalOffersAPI.
+func (a *externalOffersAPI) GetConsumeDetails(ctx context.Context, args params.ConsumeOfferDetailsArg) (params.ConsumeOfferDetailsResults, error) {
+	var results params.ConsumeOfferDetailsResults
+	err := a.facade.FacadeCall(ctx, "GetConsumeDetails", args, &results)
+	return results, errors.Capture(err)
+}
+
+// Close implements ExternalOffersAPI.
+func (a *externalOffersAPI) Close() error {
+	return a.conn.Close()
+}
+
+// externalLoginProvider logs in to an external controller with macaroons
+// minted by that controller. When the external controller requires a
+// discharge, the discharge-required error is returned rather than handled,
+// so that the client can discharge the macaroon with its own credentials for
+// the external controller and retry.
+type externalLoginProvider struct {
+	macaroons []macaroon.Slice
+}
+
+// newExternalLoginProvider returns a login provider for an external
+// controller using the supplied macaroons.
+func newExternalLoginProvider(macaroons []macaroon.Slice) *externalLoginProvider {
+	return &externalLoginProvider{macaroons: macaroons}
+}
+
+// Login implements api.LoginProvider.
+func (p *externalLoginProvider) Login(ctx context.Context, caller base.APICaller) (*api.LoginResultParams, error) {
+	request := &params.LoginRequest{
+		Macaroons:     p.macaroons,
+		BakeryVersion: bakery.LatestVersion,
+		ClientVersion: jujuversion.Current.String(),
+	}
+	var result params.LoginResult
+	if err := caller.APICall(ctx, "Admin", 3, "", "Login", request, &result); err != nil {
+		return nil, errors.Capture(err)
+	}
+	if result.DischargeRequired != nil || result.BakeryDischargeRequired != nil {
+		reason := result.DischargeRequiredReason
+		if reason == "" {
+			reason = "no reason given for discharge requirement"
+		}
+		return nil, &apiservererrors.DischargeRequiredError{
+			Cause:          errors.New(reason),
+			LegacyMacaroon: result.DischargeRequired,
+			Macaroon:       result.BakeryDischargeRequired,
+		}
+	}
+	return api.NewLoginResultParams(result)
+}
+
+// AuthHeader implements api.LoginProvider. Only RPC calls are made to the
+// external controller, so no HTTP authentication is provided.
+func (p *externalLoginProvider) AuthHeader() (http.Header, error) {
+	return nil, api.ErrorLoginFirst
+}
+
+// String implements api.LoginProvider.
+func (p *externalLoginProvider) String() string {
+	return "ExternalControllerLoginProvider"
+}
+
+// externalOffersAPIForSource returns an ExternalOffersAPI connected to the
+// external controller with the given alias, authenticating with the
+// supplied macaroons.
+func (api *OffersAPI) externalOffersAPIForSource(
+	ctx context.Context, source string, macaroons []macaroon.Slice,
+) (ExternalOffersAPI, error) {
+	if api.externalOffersAPIGetter == nil {
+		return nil, &params.Error{
+			Code:    params.CodeNotSupported,
+			Message: "query for non-local application offers",
+		}
+	}
+
+	info, err := api.externalControllerService.ControllerForAlias(ctx, source)
+	if errors.Is(err, externalcontrollererrors.NotFound) {
+		return nil, apiservererrors.ParamsErrorf(
+			params.CodeNotFound, "external controller %q not found", source,
+		)
+	} else if err != nil {
+		return nil, errors.Errorf("getting external controller %q: %w", source, err)
+	}
+
+	remote, err := api.externalOffersAPIGetter(ctx, *info, macaroons)
+	if err != nil {
+		return nil, errors.Errorf("connecting to external controller %q: %w", source, err)
+	}
+	return remote, nil
+}
+
+// proxiesExternalOffers returns true if offer queries for URLs with a source
+// controller are proxied to the external controller.
+func (api *OffersAPI) proxiesExternalOffers() bool {
+	return api.externalOffersAPIGetter != nil
+}
+
+// externalOfferURLs groups the indexes of the URLs which specify a source
+// controller by that source. URLs which fail to parse are left for the local
+// query to report.
+func externalOfferURLs(urls []string) map[string][]int {
+	bySource := make(map[string][]int)
+	for i, urlStr := range urls {
+		url, err := corecrossmodel.ParseOfferURL(urlStr)
+		if err != nil || url.Source == "" {
+			continue
+		}
+		bySource[url.Source] = append(bySource[url.Source], i)
+	}
+	return bySource
+}
+
+// localOfferURL returns the offer URL as seen by its hosting controller.
+func localOfferURL(urlStr string) string {
+	url, err := corecrossmodel.ParseOfferURL(urlStr)
+	if err != nil {
+		return urlStr
+	}
+	return url.AsLocal().String()
+}
+
+// sourcedOfferURL returns the offer URL returned by an external controller
+// qualified with the alias of that controller.
+func sourcedOfferURL(urlStr, source string) string {
+	url, err := corecrossmodel.ParseOfferURL(urlStr)
+	if err != nil {
+		return urlStr
+	}
+	url.Source = source
+	return url.String()
+}
+
+// proxyApplicationOffers queries the external controllers for the offer URLs
+// which specify a source, filling in the corresponding results.
+func (api *OffersAPI) proxyApplicationOffers(
+	ctx context.Context, urls params.OfferURLs, results []params.ApplicationOfferResult,
+) {
+	bySource := externalOfferURLs(urls.OfferURLs)
+	for _, source := range slices.Sorted(maps.Keys(bySource)) {
+		indexes := bySource[source]
+		setErr := func(err error) {
+			for _, i := range indexes {
+				results[i] = params.ApplicationOfferResult{Error: apiservererrors.ServerError(err)}
+			}
+		}
+
+		remote, err := api.externalOffersAPIForSource(ctx, source, urls.Macaroons)
+		if err != nil {
+			setErr(err)
+			continue
+		}
+
+		remoteURLs := params.OfferURLs{BakeryVersion: urls.BakeryVersion}
+		for _, i := range indexes {
+			remoteURLs.OfferURLs = append(remoteURLs.OfferURLs, localOfferURL(urls.OfferURLs[i]))
+		}
+		remoteResults, err := remote.ApplicationOffers(ctx, remoteURLs)
+		_ = remote.Close()
+		if err == nil && len(remoteResults.Results) != len(indexes) {
+			err = errors.Errorf(
+				"expected %d results from external controller %q, got %d",
+				len(indexes), source, len(remoteResults.Results),
+			)
+		}
+		if err != nil {
+			setErr(err)
+			continue
+		}
+
+		for j, i := range indexes {
+			result := remoteResults.Results[j]
+			if result.Result != nil {
+				result.Result.OfferURL = sourcedOfferURL(result.Result.OfferURL, source)
+			}
+			results[i] = result
+		}
+	}
+}
+
+// proxyFindApplicationOffers queries the external controller with the given
+// alias for offers matching the filters.
+func (api *OffersAPI) proxyFindApplicationOffers(
+	ctx context.Context, source string, filters []params.OfferFilter, macaroons []macaroon.Slice,
+) ([]params.ApplicationOfferAdminDetailsV5, error) {
+	remote, err := api.externalOffersAPIForSource(ctx, source, macaroons)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	defer func() { _ = remote.Close() }()
+
+	remoteFilters := params.OfferFilters{
+		Filters: make([]params.OfferFilter, len(filters)),
+	}
+	for i, f := range filters {
+		f.Source = ""
+		remoteFilters.Filters[i] = f
+	}
+	found, err := remote.FindApplicationOffers(ctx, remoteFilters)
+	if err != nil {
+		return nil, errors.Errorf(
+			"finding offers on external controller %q: %w", source, err,
+		)
+	}
+
+	offers := found.Results
+	for i := range offers {
+		offers[i].OfferURL = sourcedOfferURL(offers[i].OfferURL, source)
+	}
+	return offers, nil
+}
+
+// proxyGetConsumeDetails gets the consume details for the offer URLs which
+// specify a source from the external controllers, filling in the
+// corresponding results.
+func (api *OffersAPI) proxyGetConsumeDetails(
+	ctx context.Context, args params.ConsumeOfferDetailsArg, results []params.ConsumeOfferDetailsResult,
+) {
+	urls := args.OfferURLs
+	bySource := externalOfferURLs(urls.OfferURLs)
+	for _, source := range slices.Sorted(maps.Keys(bySource)) {
+		indexes := bySource[source]
+		setErr := func(err error) {
+			for _, i := range indexes {
+				results[i] = params.ConsumeOfferDetailsResult{Error: apiservererrors.ServerError(err)}
+			}
+		}
+
+		if args.UserTag != "" {
+			setErr(&params.Error{
+				Code: params.CodeNotSupported,
+				Message: fmt.Sprintf(
+					"getting consume details for another user from external controller %q", source,
+				),
+			})
+			continue
+		}
+
+		remote, err := api.externalOffersAPIForSource(ctx, source, urls.Macaroons)
+		if err != nil {
+			setErr(err)
+			continue
+		}
+
+		remoteArgs := params.ConsumeOfferDetailsArg{
+			OfferURLs: params.OfferURLs{BakeryVersion: urls.BakeryVersion},
+		}
+		for _, i := range indexes {
+			remoteArgs.OfferURLs.OfferURLs = append(
+				remoteArgs.OfferURLs.OfferURLs, localOfferURL(urls.OfferURLs[i]))
+		}
+		remoteResults, err := remote.GetConsumeDetails(ctx, remoteArgs)
+		_ = remote.Close()
+		if err == nil && len(remoteResults.Results) != len(indexes) {
+			err = errors.Errorf(
+				"expected %d results from external controller %q, got %d",
+				len(indexes), source, len(remoteResults.Results),
+			)
+		}
+		if err != nil {
+			setErr(err)
+			continue
+		}
+
+		for j, i := range indexes {
+			result := remoteResults.Results[j]
+			if result.Offer != nil {
+				result.Offer.OfferURL = sourcedOfferURL(result.Offer.OfferURL, source)
+			}
+			results[i] = result
+		}
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package applicationoffers
+
+import (
+	"context"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"gopkg.in/macaroon.v2"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/controller"
+	externalcontrollererrors "github.com/juju/juju/domain/externalcontroller/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// offerAPIWithExternal returns an OffersAPI which proxies offer queries for
+// URLs with a source to the mocked external controller, recording the
+// macaroons it was given.
+func (s *offerSuite) offerAPIWithExternal(c *tc.C, gotMacaroons *[]macaroon.Slice) *OffersAPI {
+	api := s.offerAPI(c)
+	api.externalControllerService = s.externalControllerService
+	api.externalOffersAPIGetter = func(_ context.Context, info crossmodel.ControllerInfo, macaroons []macaroon.Slice) (ExternalOffersAPI, error) {
+		c.Check(info.ControllerUUID, tc.Equals, "other-controller-uuid")
+		*gotMacaroons = macaroons
+		return s.externalOffersAPI, nil
+	}
+	return api
+}
+
+func (s *offerSuite) expectControllerForAlias(alias string) {
+	s.externalControllerService.EXPECT().ControllerForAlias(gomock.Any(), alias).Return(&crossmodel.ControllerInfo{
+		ControllerUUID: "other-controller-uuid",
+		Alias:          alias,
+		Addrs:          []string{"10.0.0.1:17070"},
+		CACert:         "cert",
+	}, nil)
+}
+
+func (s *offerSuite) TestApplicationOffersExternal(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var gotMacaroons []macaroon.Slice
+	offerAPI := s.offerAPIWithExternal(c, &gotMacaroons)
+	s.setupAuthUser(user.AdminUserName.Name())
+	s.expectControllerForAlias("other")
+
+	mac := newMacaroon(c, "id")
+	s.externalOffersAPI.EXPECT().ApplicationOffers(gomock.Any(), params.OfferURLs{
+		OfferURLs: []string{"fred/prod.db", "fred/prod.missing"},
+	}).Return(params.ApplicationOffersResults{
+		Results: []params.ApplicationOfferResult{{
+			Result: &params.ApplicationOfferAdminDetailsV5{
+				ApplicationOfferDetailsV5: params.ApplicationOfferDetailsV5{
+					OfferURL:  "fred/prod.db",
+					OfferName: "db",
+				},
+			},
+		}, {
+			Error: &params.Error{Code: params.CodeNotFound, Message: "not found"},
+		}},
+	}, nil)
+	s.externalOffersAPI.EXPECT().Close().Return(nil)
+
+	results, err := offerAPI.ApplicationOffers(c.Context(), params.OfferURLs{
+		OfferURLs: []string{"other:fred/prod.db", "other:fred/prod.missing"},
+		Macaroons: []macaroon.Slice{{mac}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 2)
+	c.Check(results.Results[0].Error, tc.IsNil)
+	c.Check(results.Results[0].Result.OfferURL, tc.Equals, "other:fred/prod.db")
+	c.Check(results.Results[1].Error, tc.DeepEquals, &params.Error{
+		Code: params.CodeNotFound, Message: "not found",
+	})
+	c.Check(gotMacaroons, tc.DeepEquals, []macaroon.Slice{{mac}})
+}
+
+func (s *offerSuite) TestApplicationOffersExternalControllerNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var gotMacaroons []macaroon.Slice
+	offerAPI := s.offerAPIWithExternal(c, &gotMacaroons)
+	s.setupAuthUser(user.AdminUserName.Name())
+	s.externalControllerService.EXPECT().ControllerForAlias(gomock.Any(), "other").
+		Return(nil, externalcontrollererrors.NotFound)
+
+	results, err := offerAPI.ApplicationOffers(c.Context(), params.OfferURLs{
+		OfferURLs: []string{"other:fred/prod.db"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Check(results.Results[0].Error, tc.DeepEquals, &params.Error{
+		Code:    params.CodeNotFound,
+		Message: `external controller "other" not found`,
+	})
+}
+
+func (s *offerSuite) TestApplicationOffersExternalNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	offerAPI := s.offerAPI(c)
+	s.setupAuthUser(user.AdminUserName.Name())
+
+	results, err := offerAPI.ApplicationOffers(c.Context(), params.OfferURLs{
+		OfferURLs: []string{"other:fred/prod.db"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Check(results.Results[0].Error, tc.DeepEquals, &params.Error{
+		Code:    params.CodeNotSupported,
+		Message: "query for non-local application offers",
+	})
+}
+
+func (s *offerSuite) TestFindApplicationOffersExternal(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var gotMacaroons []macaroon.Slice
+	offerAPI := s.offerAPIWithExternal(c, &gotMacaroons)
+	s.setupAuthUser(user.AdminUserName.Name())
+	s.expectControllerForAlias("other")
+
+	mac := newMacaroon(c, "id")
+	s.externalOffersAPI.EXPECT().FindApplicationOffers(gomock.Any(), params.OfferFilters{
+		Filters: []params.OfferFilter{{ModelQualifier: "fred", ModelName: "prod"}},
+	}).Return(params.QueryApplicationOffersResultsV5{
+		Results: []params.ApplicationOfferAdminDetailsV5{{
+			ApplicationOfferDetailsV5: params.ApplicationOfferDetailsV5{
+				OfferURL:  "fred/prod.db",
+				OfferName: "db",
+			},
+		}},
+	}, nil)
+	s.externalOffersAPI.EXPECT().Close().Return(nil)
+
+	results, err := offerAPI.FindApplicationOffers(c.Context(), params.OfferFilters{
+		Filters: []params.OfferFilter{{
+			Source:         "other",
+			ModelQualifier: "fred",
+			ModelName:      "prod",
+		}},
+		Macaroons: []macaroon.Slice{{mac}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Check(results.Results[0].OfferURL, tc.Equals, "other:fred/prod.db")
+	c.Check(gotMacaroons, tc.DeepEquals, []macaroon.Slice{{mac}})
+}
+
+func (s *offerSuite) TestFindApplicationOffersExternalNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	offerAPI := s.offerAPI(c)
+	s.setupAuthUser(user.AdminUserName.Name())
+
+	_, err := offerAPI.FindApplicationOffers(c.Context(), params.OfferFilters{
+		Filters: []params.OfferFilter{{Source: "other", ModelName: "prod"}},
+	})
+	c.Assert(err, tc.ErrorMatches, "query for non-local application offers")
+}
+
+func (s *offerSuite) TestGetConsumeDetailsExternal(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var gotMacaroons []macaroon.Slice
+	offerAPI := s.offerAPIWithExternal(c, &gotMacaroons)
+	s.setupAuthUser(user.AdminUserName.Name())
+	s.controllerService.EXPECT().GetControllerInfo(gomock.Any()).Return(controller.ControllerInfo{
+		UUID: s.controllerUUID,
+	}, nil)
+	s.expectControllerForAlias("other")
+
+	mac := newMacaroon(c, "id")
+	remoteController := &params.ExternalControllerInfo{
+		ControllerTag: names.NewControllerTag("other-controller-uuid").String(),
+		Addrs:         []string{"10.0.0.1:17070"},
+	}
+	s.externalOffersAPI.EXPECT().GetConsumeDetails(gomock.Any(), params.ConsumeOfferDetailsArg{
+		OfferURLs: params.OfferURLs{OfferURLs: []string{"fred/prod.db"}},
+	}).Return(params.ConsumeOfferDetailsResults{
+		Results: []params.ConsumeOfferDetailsResult{{
+			ConsumeOfferDetails: params.ConsumeOfferDetails{
+				Offer: &params.ApplicationOfferDetailsV5{
+					OfferURL:  "fred/prod.db",
+					OfferName: "db",
+				},
+				ControllerInfo: remoteController,
+				Macaroon:       mac,
+			},
+		}},
+	}, nil)
+	s.externalOffersAPI.EXPECT().Close().Return(nil)
+
+	results, err := offerAPI.GetConsumeDetails(c.Context(), params.ConsumeOfferDetailsArg{
+		OfferURLs: params.OfferURLs{
+			OfferURLs: []string{"other:fred/prod.db"},
+			Macaroons: []macaroon.Slice{{mac}},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Assert(results.Results[0].Error, tc.IsNil)
+	c.Check(results.Results[0].Offer.OfferURL, tc.Equals, "other:fred/prod.db")
+	c.Check(results.Results[0].ControllerInfo, tc.DeepEquals, remoteController)
+	c.Check(results.Results[0].Macaroon, tc.Equals, mac)
+}
+
+func (s *offerSuite) TestApplicationOffersExternalDischargeRequired(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	offerAPI := s.offerAPI(c)
+	offerAPI.externalControllerService = s.externalControllerService
+	mac := newBakeryMacaroon(c, "external")
+	offerAPI.externalOffersAPIGetter = func(_ context.Context, _ crossmodel.ControllerInfo, _ []macaroon.Slice) (ExternalOffersAPI, error) {
+		return nil, &apiservererrors.DischargeRequiredError{
+			Cause:    errors.New("login required"),
+			Macaroon: mac,
+		}
+	}
+	s.setupAuthUser(user.AdminUserName.Name())
+	s.expectControllerForAlias("other")
+
+	results, err := offerAPI.ApplicationOffers(c.Context(), params.OfferURLs{
+		OfferURLs: []string{"other:fred/prod.db"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	resultErr := results.Results[0].Error
+	c.Assert(resultErr, tc.NotNil)
+	c.Check(resultErr.Code, tc.Equals, params.CodeDischargeRequired)
+
+	// The macaroon to discharge is the one minted by the external
+	// controller, so the client can discharge it for that controller.
+	var info params.DischargeRequiredErrorInfo
+	err = resultErr.UnmarshalInfo(&info)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(info.BakeryMacaroon.M().Id(), tc.DeepEquals, mac.M().Id())
+}
+
+func (s *offerSuite) TestExternalLoginProviderSendsOnlyGivenMacaroons(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mac := newMacaroon(c, "external")
+	caller := basemocks.NewMockAPICaller(ctrl)
+	caller.EXPECT().APICall(gomock.Any(), "Admin", 3, "", "Login", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _ int, _, _ string, args, result any) error {
+			request := args.(*params.LoginRequest)
+			c.Check(request.AuthTag, tc.Equals, "")
+			c.Check(request.Credentials, tc.Equals, "")
+			c.Check(request.Macaroons, tc.DeepEquals, []macaroon.Slice{{mac}})
+			*(result.(*params.LoginResult)) = params.LoginResult{
+				ControllerTag: names.NewControllerTag("other-controller-uuid").String(),
+				ServerVersion: "4.1.0",
+			}
+			return nil
+		})
+
+	_, err := newExternalLoginProvider([]macaroon.Slice{{mac}}).Login(c.Context(), caller)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *offerSuite) TestExternalLoginProviderDischargeRequired(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mac := newBakeryMacaroon(c, "external")
+	caller := basemocks.NewMockAPICaller(ctrl)
+	caller.EXPECT().APICall(gomock.Any(), "Admin", 3, "", "Login", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _ int, _, _ string, _, result any) error {
+			*(result.(*params.LoginResult)) = params.LoginResult{
+				BakeryDischargeRequired: mac,
+				DischargeRequiredReason: "login required",
+			}
+			return nil
+		})
+
+	_, err := newExternalLoginProvider(nil).Login(c.Context(), caller)
+	var dischargeErr *apiservererrors.DischargeRequiredError
+	c.Assert(errors.As(err, &dischargeErr), tc.IsTrue)
+	c.Check(dischargeErr.Macaroon, tc.Equals, mac)
+	c.Check(err, tc.ErrorMatches, "login required")
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/applicationoffers (interfaces: AccessService,ModelService,CrossModelRelationService,RemovalService,CrossModelAuthContext,ControllerService)
+// Source: github.com/juju/juju/apiserver/facades/client/applicationoffers (interfaces: AccessService,ModelService,CrossModelRelationService,RemovalService,CrossModelAuthContext,ControllerService,ExternalControllerService,ExternalOffersAPI)
 //
 // Generated by this command:
 //
-//	mockgen -package applicationoffers -destination package_mock_test.go github.com/juju/juju/apiserver/facades/client/applicationoffers AccessService,ModelService,CrossModelRelationService,RemovalService,CrossModelAuthContext,ControllerService
+//	mockgen -package applicationoffers -destination package_mock_test.go github.com/juju/juju/apiserver/facades/client/applicationoffers AccessService,ModelService,CrossModelRelationService,RemovalService,CrossModelAuthContext,ControllerService,ExternalControllerService,ExternalOffersAPI
 //
 
 // Package applicationoffers is a generated GoMock package.
@@ -23,6 +23,7 @@ import (
 	controller "github.com/juju/juju/domain/controller"
 	crossmodelrelation "github.com/juju/juju/domain/crossmodelrelation"
 	service "github.com/juju/juju/domain/crossmodelrelation/service"
+	params "github.com/juju/juju/rpc/params"
 )
 
 // MockAccessService is a mock of AccessService interface.
@@ -182,6 +183,7 @@ type MockCrossModelRelationServiceMockRecorder struct {
 	getConsumeDetailsExpects        []*gomock.Call2_2[context.Context, crossmodel.OfferURL, crossmodelrelation.ConsumeDetails, error]
 	getOfferUUIDExpects             []*gomock.Call2_2[context.Context, crossmodel.OfferURL, offer.UUID, error]
 	getOffersWithConnectionsExpects []*gomock.Call2_2[context.Context, []service.OfferFilter, []*crossmodelrelation.OfferDetailWithConnections, error]
+	updateOfferExpects              []*gomock.Call2_1[context.Context, crossmodelrelation.UpdateApplicationOfferArgs, error]
 }
 
 // NewMockCrossModelRelationService creates a new mock instance.
@@ -268,6 +270,24 @@ func (mr *MockCrossModelRelationServiceMockRecorder) GetOffersWithConnections(ct
 // MockCrossModelRelationServiceGetOffersWithConnectionsCall is the typed call wrapper for GetOffersWithConnections.
 type MockCrossModelRelationServiceGetOffersWithConnectionsCall = gomock.Call2_2[context.Context, []service.OfferFilter, []*crossmodelrelation.OfferDetailWithConnections, error]
 
+// UpdateOffer mocks base method.
+func (m *MockCrossModelRelationService) UpdateOffer(ctx context.Context, args crossmodelrelation.UpdateApplicationOfferArgs) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.updateOfferExpects, m.ctrl, m, "UpdateOffer", ctx, args)
+}
+
+// UpdateOffer indicates an expected call of UpdateOffer.
+func (mr *MockCrossModelRelationServiceMockRecorder) UpdateOffer(ctx, args any) *MockCrossModelRelationServiceUpdateOfferCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, crossmodelrelation.UpdateApplicationOfferArgs, error](mr.mock.ctrl.T, mr.mock, "UpdateOffer", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(args))
+	mr.updateOfferExpects = append(mr.updateOfferExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockCrossModelRelationServiceUpdateOfferCall is the typed call wrapper for UpdateOffer.
+type MockCrossModelRelationServiceUpdateOfferCall = gomock.Call2_1[context.Context, crossmodelrelation.UpdateApplicationOfferArgs, error]
+
 // MockRemovalService is a mock of RemovalService interface.
 type MockRemovalService struct {
 	ctrl     *gomock.Controller
@@ -396,3 +416,146 @@ func (mr *MockControllerServiceMockRecorder) GetControllerInfo(ctx any) *MockCon
 
 // MockControllerServiceGetControllerInfoCall is the typed call wrapper for GetControllerInfo.
 type MockControllerServiceGetControllerInfoCall = gomock.Call1_2[context.Context, controller.ControllerInfo, error]
+
+// MockExternalControllerService is a mock of ExternalControllerService interface.
+type MockExternalControllerService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalControllerServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockExternalControllerServiceMockRecorder is the mock recorder for MockExternalControllerService.
+type MockExternalControllerServiceMockRecorder struct {
+	mock                      *MockExternalControllerService
+	controllerForAliasExpects []*gomock.Call2_2[context.Context, string, *crossmodel.ControllerInfo, error]
+}
+
+// NewMockExternalControllerService creates a new mock instance.
+func NewMockExternalControllerService(ctrl *gomock.Controller) *MockExternalControllerService {
+	mock := &MockExternalControllerService{ctrl: ctrl}
+	mock.recorder = &MockExternalControllerServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalControllerService) EXPECT() *MockExternalControllerServiceMockRecorder {
+	return m.recorder
+}
+
+// ControllerForAlias mocks base method.
+func (m *MockExternalControllerService) ControllerForAlias(ctx context.Context, alias string) (*crossmodel.ControllerInfo, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.controllerForAliasExpects, m.ctrl, m, "ControllerForAlias", ctx, alias)
+}
+
+// ControllerForAlias indicates an expected call of ControllerForAlias.
+func (mr *MockExternalControllerServiceMockRecorder) ControllerForAlias(ctx, alias any) *MockExternalControllerServiceControllerForAliasCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, *crossmodel.ControllerInfo, error](mr.mock.ctrl.T, mr.mock, "ControllerForAlias", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(alias))
+	mr.controllerForAliasExpects = append(mr.controllerForAliasExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockExternalControllerServiceControllerForAliasCall is the typed call wrapper for ControllerForAlias.
+type MockExternalControllerServiceControllerForAliasCall = gomock.Call2_2[context.Context, string, *crossmodel.ControllerInfo, error]
+
+// MockExternalOffersAPI is a mock of ExternalOffersAPI interface.
+type MockExternalOffersAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalOffersAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockExternalOffersAPIMockRecorder is the mock recorder for MockExternalOffersAPI.
+type MockExternalOffersAPIMockRecorder struct {
+	mock                         *MockExternalOffersAPI
+	applicationOffersExpects     []*gomock.Call2_2[context.Context, params.OfferURLs, params.ApplicationOffersResults, error]
+	closeExpects                 []*gomock.Call0_1[error]
+	findApplicationOffersExpects []*gomock.Call2_2[context.Context, params.OfferFilters, params.QueryApplicationOffersResultsV5, error]
+	getConsumeDetailsExpects     []*gomock.Call2_2[context.Context, params.ConsumeOfferDetailsArg, params.ConsumeOfferDetailsResults, error]
+}
+
+// NewMockExternalOffersAPI creates a new mock instance.
+func NewMockExternalOffersAPI(ctrl *gomock.Controller) *MockExternalOffersAPI {
+	mock := &MockExternalOffersAPI{ctrl: ctrl}
+	mock.recorder = &MockExternalOffersAPIMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalOffersAPI) EXPECT() *MockExternalOffersAPIMockRecorder {
+	return m.recorder
+}
+
+// ApplicationOffers mocks base method.
+func (m *MockExternalOffersAPI) ApplicationOffers(ctx context.Context, urls params.OfferURLs) (params.ApplicationOffersResults, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.applicationOffersExpects, m.ctrl, m, "ApplicationOffers", ctx, urls)
+}
+
+// ApplicationOffers indicates an expected call of ApplicationOffers.
+func (mr *MockExternalOffersAPIMockRecorder) ApplicationOffers(ctx, urls any) *MockExternalOffersAPIApplicationOffersCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, params.OfferURLs, params.ApplicationOffersResults, error](mr.mock.ctrl.T, mr.mock, "ApplicationOffers", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(urls))
+	mr.applicationOffersExpects = append(mr.applicationOffersExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockExternalOffersAPIApplicationOffersCall is the typed call wrapper for ApplicationOffers.
+type MockExternalOffersAPIApplicationOffersCall = gomock.Call2_2[context.Context, params.OfferURLs, params.ApplicationOffersResults, error]
+
+// Close mocks base method.
+func (m *MockExternalOffersAPI) Close() error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.closeExpects, m.ctrl, m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockExternalOffersAPIMockRecorder) Close() *MockExternalOffersAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[error](mr.mock.ctrl.T, mr.mock, "Close")
+	mr.closeExpects = append(mr.closeExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockExternalOffersAPICloseCall is the typed call wrapper for Close.
+type MockExternalOffersAPICloseCall = gomock.Call0_1[error]
+
+// FindApplicationOffers mocks base method.
+func (m *MockExternalOffersAPI) FindApplicationOffers(ctx context.Context, filters params.OfferFilters) (params.QueryApplicationOffersResultsV5, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.findApplicationOffersExpects, m.ctrl, m, "FindApplicationOffers", ctx, filters)
+}
+
+// FindApplicationOffers indicates an expected call of FindApplicationOffers.
+func (mr *MockExternalOffersAPIMockRecorder) FindApplicationOffers(ctx, filters any) *MockExternalOffersAPIFindApplicationOffersCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, params.OfferFilters, params.QueryApplicationOffersResultsV5, error](mr.mock.ctrl.T, mr.mock, "FindApplicationOffers", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(filters))
+	mr.findApplicationOffersExpects = append(mr.findApplicationOffersExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockExternalOffersAPIFindApplicationOffersCall is the typed call wrapper for FindApplicationOffers.
+type MockExternalOffersAPIFindApplicationOffersCall = gomock.Call2_2[context.Context, params.OfferFilters, params.QueryApplicationOffersResultsV5, error]
+
+// GetConsumeDetails mocks base method.
+func (m *MockExternalOffersAPI) GetConsumeDetails(ctx context.Context, args params.ConsumeOfferDetailsArg) (params.ConsumeOfferDetailsResults, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getConsumeDetailsExpects, m.ctrl, m, "GetConsumeDetails", ctx, args)
+}
+
+// GetConsumeDetails indicates an expected call of GetConsumeDetails.
+func (mr *MockExternalOffersAPIMockRecorder) GetConsumeDetails(ctx, args any) *MockExternalOffersAPIGetConsumeDetailsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, params.ConsumeOfferDetailsArg, params.ConsumeOfferDetailsResults, error](mr.mock.ctrl.T, mr.mock, "GetConsumeDetails", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(args))
+	mr.getConsumeDetailsExpects = append(mr.getConsumeDetailsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockExternalOffersAPIGetConsumeDetailsCall is the typed call wrapper for GetConsumeDetails.
+type MockExternalOffersAPIGetConsumeDetailsCall = gomock.Call2_2[context.Context, params.ConsumeOfferDetailsArg, params.ConsumeOfferDetailsResults, error]
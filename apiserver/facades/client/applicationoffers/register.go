@@ -8,9 +8,13 @@ import (
 	"reflect"
 
 	"github.com/juju/errors"
+	"gopkg.in/macaroon.v2"
 
+	"github.com/juju/juju/api"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/crossmodel"
 	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/worker/apicaller"
 )
 
 // Register is called to expose a package of facades onto a given registry.
@@ -28,16 +32,47 @@ func Register(registry facade.FacadeRegistry) {
 	}, reflect.TypeFor[*OffersAPIv5]())
 	// v6 handles offer URLs with a model qualifier instead of a username.
 	registry.MustRegisterForMultiModel("ApplicationOffers", 6, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
-		return makeOffersAPI(ctx)
+		return makeOffersAPIV6(ctx)
+	}, reflect.TypeFor[*OffersAPIv6]())
+	registry.MustRegisterForMultiModel("ApplicationOffers", 7, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
+		return makeOffersAPIV7(ctx) // Added UpdateOffer.
+	}, reflect.TypeFor[*OffersAPIv7]())
+	registry.MustRegisterForMultiModel("ApplicationOffers", 8, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
+		return makeOffersAPI(ctx) // Added external controller offer queries.
 	}, reflect.TypeFor[*OffersAPI]())
 }
 
+// makeOffersAPIV7 returns a new application offers OffersAPIv7 facade.
+func makeOffersAPIV7(facadeContext facade.MultiModelContext) (*OffersAPIv7, error) {
+	api, err := makeOffersAPI(facadeContext)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	api.externalOffersAPIGetter = nil
+	return &OffersAPIv7{
+		OffersAPI: api,
+	}, nil
+}
+
+// makeOffersAPIV6 returns a new application offers OffersAPIv6 facade.
+func makeOffersAPIV6(facadeContext facade.MultiModelContext) (*OffersAPIv6, error) {
+	api, err := makeOffersAPI(facadeContext)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	api.externalOffersAPIGetter = nil
+	return &OffersAPIv6{
+		OffersAPI: api,
+	}, nil
+}
+
 // makeOffersAPIv5 returns a new application offers OffersAPIv5 facade.
 func makeOffersAPIV5(facadeContext facade.MultiModelContext) (*OffersAPIv5, error) {
 	api, err := makeOffersAPI(facadeContext)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	api.externalOffersAPIGetter = nil
 	return &OffersAPIv5{
 		OffersAPI: api,
 	}, nil
@@ -60,6 +95,23 @@ func makeOffersAPI(ctx facade.MultiModelContext) (*OffersAPI, error) {
 		return svc.Removal(), nil
 	}
 
+	externalOffersAPIGetter := func(c context.Context, info crossmodel.ControllerInfo, macaroons []macaroon.Slice) (ExternalOffersAPI, error) {
+		// The macaroons are those minted by the external controller and
+		// discharged by the client; they are never the client's macaroons
+		// for this controller.
+		apiInfo := api.Info{
+			Addrs:  info.Addrs,
+			CACert: info.CACert,
+		}
+		conn, err := apicaller.NewExternalControllerConnectionWithLoginProvider(
+			c, &apiInfo, newExternalLoginProvider(macaroons),
+		)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return newExternalOffersAPI(conn), nil
+	}
+
 	domainServices := ctx.DomainServices()
 
 	return createOffersAPI(
@@ -69,9 +121,11 @@ func makeOffersAPI(ctx facade.MultiModelContext) (*OffersAPI, error) {
 		ctx.ModelUUID(),
 		domainServices.Access(),
 		domainServices.Controller(),
+		domainServices.ExternalController(),
 		domainServices.Model(),
 		crossModelRelationServiceGetter,
 		removalServiceGetter,
+		externalOffersAPIGetter,
 		ctx.Logger().Child("applicationoffers"),
 	)
 }
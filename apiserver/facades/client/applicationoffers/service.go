@@ -15,6 +15,7 @@ import (
 	"github.com/juju/juju/domain/controller"
 	"github.com/juju/juju/domain/crossmodelrelation"
 	crossmodelrelationservice "github.com/juju/juju/domain/crossmodelrelation/service"
+	"github.com/juju/juju/rpc/params"
 )
 
 // AccessService defines the interface for interacting with the access domain.
@@ -70,12 +71,19 @@ type CrossModelRelationService interface {
 		filters []crossmodelrelationservice.OfferFilter,
 	) ([]*crossmodelrelation.OfferDetailWithConnections, error)
 
-	// CreateOffer updates an existing offer, or creates a new offer if it does
-	// not exist. Permissions are created for a new offer only.
+	// CreateOffer creates a new offer if it does not exist. Permissions are
+	// created for a new offer only.
 	CreateOffer(
 		ctx context.Context,
 		args crossmodelrelation.ApplicationOfferArgs,
 	) error
+
+	// UpdateOffer updates the endpoints and description of an existing
+	// offer in place.
+	UpdateOffer(
+		ctx context.Context,
+		args crossmodelrelation.UpdateApplicationOfferArgs,
+	) error
 }
 
 // RemovalService defines operations for removing juju entities,
@@ -91,3 +99,30 @@ type ControllerService interface {
 	// GetControllerInfo returns the controller information.
 	GetControllerInfo(ctx context.Context) (controller.ControllerInfo, error)
 }
+
+// ExternalControllerService defines the interface for interacting with the
+// external controller domain.
+type ExternalControllerService interface {
+	// ControllerForAlias returns the controller record with the given alias,
+	// as used in the source of an offer URL.
+	ControllerForAlias(ctx context.Context, alias string) (*crossmodel.ControllerInfo, error)
+}
+
+// ExternalOffersAPI defines the ApplicationOffers facade methods called on an
+// external controller when proxying offer queries to it.
+type ExternalOffersAPI interface {
+	// ApplicationOffers returns the offers on the external controller
+	// matching the given URLs.
+	ApplicationOffers(ctx context.Context, urls params.OfferURLs) (params.ApplicationOffersResults, error)
+
+	// FindApplicationOffers returns the offers on the external controller
+	// matching the given filters.
+	FindApplicationOffers(ctx context.Context, filters params.OfferFilters) (params.QueryApplicationOffersResultsV5, error)
+
+	// GetConsumeDetails returns the details necessary to consume the offers
+	// on the external controller with the given URLs.
+	GetConsumeDetails(ctx context.Context, args params.ConsumeOfferDetailsArg) (params.ConsumeOfferDetailsResults, error)
+
+	// Close closes the connection to the external controller.
+	Close() error
+}
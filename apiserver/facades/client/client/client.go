@@ -11,7 +11,9 @@ import (
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/internal"
 	"github.com/juju/juju/core/leadership"
 	"github.com/juju/juju/core/permission"
 	internallogger "github.com/juju/juju/internal/logger"
@@ -27,6 +29,7 @@ type Client struct {
 
 	auth             facade.Authorizer
 	leadershipReader leadership.Reader
+	watcherRegistry  facade.WatcherRegistry
 
 	logDir string
 	clock  clock.Clock
@@ -45,6 +48,18 @@ type Client struct {
 	isControllerModel bool
 }
 
+// ClientV9 serves client-specific API methods for version 9 of the Client
+// facade.
+type ClientV9 struct {
+	*Client
+}
+
+// ClientV8 serves client-specific API methods for version 8 of the Client
+// facade.
+type ClientV8 struct {
+	ClientV9
+}
+
 func (c *Client) checkCanRead(ctx context.Context) error {
 	err := c.auth.HasPermission(ctx, permission.SuperuserAccess, c.controllerTag)
 	if err != nil && !errors.Is(err, authentication.ErrorEntityMissingPermission) {
@@ -76,6 +91,41 @@ func (c *Client) WatchAll(ctx context.Context) (params.AllWatcherId, error) {
 	return params.AllWatcherId{}, errors.NotImplementedf("WatchAll")
 }
 
+// WatchStatus returns a watcher which notifies when an application, unit or
+// machine is added to or removed from the model, or when the status of any of
+// them changes. The watcher is intended to let clients re-read the status of
+// the model only when it might have changed.
+func (c *Client) WatchStatus(ctx context.Context) (params.NotifyWatchResult, error) {
+	if err := c.checkCanRead(ctx); err != nil {
+		return params.NotifyWatchResult{}, err
+	}
+
+	w, err := c.statusService.WatchModelStatus(ctx)
+	if err != nil {
+		return params.NotifyWatchResult{
+			Error: apiservererrors.ServerError(err),
+		}, nil
+	}
+	id, _, err := internal.EnsureRegisterWatcher(ctx, c.watcherRegistry, w)
+	if err != nil {
+		return params.NotifyWatchResult{
+			Error: apiservererrors.ServerError(err),
+		}, nil
+	}
+	return params.NotifyWatchResult{NotifyWatcherId: id}, nil
+}
+
+// WatchStatus isn't on the v8 API.
+func (c *ClientV8) WatchStatus(_ struct{}) {}
+
+// FullStatus gives the information needed for juju status over the api.
+// Filtering by status value isn't supported before v10, so any statuses are
+// ignored.
+func (c *ClientV9) FullStatus(ctx context.Context, args params.StatusParams) (params.FullStatus, error) {
+	args.Statuses = nil
+	return c.Client.FullStatus(ctx, args)
+}
+
 // NOTE: this is necessary for the other packages that do upgrade tests.
 // Really they should be using a mocked out api server, but that is outside
 // the scope of this fix.
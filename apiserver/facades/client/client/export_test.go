@@ -4,5 +4,5 @@
 package client
 
 var (
-	NewFacade = newFacadeV8
+	NewFacade = newFacadeV10
 )
@@ -18,9 +18,11 @@ import (
 
 	"github.com/juju/juju/apiserver/authentication"
 	"github.com/juju/juju/controller"
+	coreerrors "github.com/juju/juju/core/errors"
 	corelife "github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/modelconfig"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/status"
 	coreunit "github.com/juju/juju/core/unit"
@@ -29,6 +31,7 @@ import (
 	"github.com/juju/juju/domain/application/charm"
 	"github.com/juju/juju/domain/crossmodelrelation"
 	"github.com/juju/juju/domain/deployment"
+	domainmachine "github.com/juju/juju/domain/machine"
 	domainnetwork "github.com/juju/juju/domain/network"
 	service "github.com/juju/juju/domain/status/service"
 	domainstorage "github.com/juju/juju/domain/storage"
@@ -106,6 +109,7 @@ func (s *fullStatusSuite) TestFullStatusNetworkInterfaces(c *tc.C) {
 			InstanceID:  "i-12345",
 		},
 	}, nil)
+	s.machineService.EXPECT().GetAllMachineRecoveryStatuses(gomock.Any()).Return(nil, nil)
 
 	macAddr := "aa:bb:cc:dd:ee:ff"
 	gatewayAddr := "10.0.0.1"
@@ -150,7 +154,7 @@ func (s *fullStatusSuite) TestFullStatusNetworkInterfaces(c *tc.C) {
 	}, nil)
 
 	s.applicationService.EXPECT().GetAllEndpointBindings(gomock.Any()).Return(nil, nil)
-	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(nil, nil)
+	s.statusService.EXPECT().GetApplicationAndUnitStatusesForFilter(gomock.Any(), gomock.Any()).Return(nil, nil)
 	s.statusService.EXPECT().GetRemoteApplicationOffererStatuses(gomock.Any()).Return(nil, nil)
 	s.portService.EXPECT().GetAllOpenedPorts(gomock.Any()).Return(nil, nil)
 	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(nil, nil)
@@ -309,8 +313,9 @@ func (s *fullStatusSuite) TestFullStatusUsesControllerFlagOnMachineStatus(c *tc.
 			IsController: true,
 		},
 	}, nil)
+	s.machineService.EXPECT().GetAllMachineRecoveryStatuses(gomock.Any()).Return(nil, nil)
 	s.applicationService.EXPECT().GetAllEndpointBindings(gomock.Any()).Return(nil, nil)
-	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(nil, nil)
+	s.statusService.EXPECT().GetApplicationAndUnitStatusesForFilter(gomock.Any(), gomock.Any()).Return(nil, nil)
 	s.statusService.EXPECT().GetRemoteApplicationOffererStatuses(gomock.Any()).Return(nil, nil)
 	s.portService.EXPECT().GetAllOpenedPorts(gomock.Any()).Return(nil, nil)
 	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(nil, nil)
@@ -325,6 +330,65 @@ func (s *fullStatusSuite) TestFullStatusUsesControllerFlagOnMachineStatus(c *tc.
 	})
 }
 
+func (s *fullStatusSuite) TestFullStatusMachineRecovery(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	client := s.client(false)
+	s.expectCheckCanRead(client, true)
+	s.expectCheckIsAdmin(client, false)
+
+	s.modelInfoService.EXPECT().GetModelInfo(c.Context()).Return(model.ModelInfo{
+		Cloud:     "dummy",
+		CloudType: "dummy",
+		Type:      model.IAAS,
+	}, nil)
+	s.statusService.EXPECT().GetModelStatus(gomock.Any()).Return(status.StatusInfo{
+		Status: status.Available,
+	}, nil)
+	s.statusService.EXPECT().GetMachineFullStatuses(gomock.Any()).Return(map[machine.Name]service.Machine{
+		"0": {Name: "0"},
+		"1": {Name: "1"},
+	}, nil)
+	attemptedAt := time.Date(2026, 7, 23, 12, 0, 0, 0, time.UTC)
+	nextAttempt := attemptedAt.Add(5 * time.Minute)
+	s.machineService.EXPECT().GetAllMachineRecoveryStatuses(gomock.Any()).Return(map[machine.Name]domainmachine.RecoveryStatus{
+		"0": {
+			Policy:        modelconfig.MachineRecoveryPolicyReprovision,
+			Attempts:      1,
+			NextAttemptAt: nextAttempt,
+			History: []domainmachine.RecoveryAttempt{{
+				AttemptedAt: attemptedAt,
+				InstanceID:  "i-1234",
+				Reason:      "instance stopped",
+				Error:       "machine is a controller",
+			}},
+		},
+	}, nil)
+	s.applicationService.EXPECT().GetAllEndpointBindings(gomock.Any()).Return(nil, nil)
+	s.statusService.EXPECT().GetApplicationAndUnitStatusesForFilter(gomock.Any(), gomock.Any()).Return(nil, nil)
+	s.statusService.EXPECT().GetRemoteApplicationOffererStatuses(gomock.Any()).Return(nil, nil)
+	s.portService.EXPECT().GetAllOpenedPorts(gomock.Any()).Return(nil, nil)
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(nil, nil)
+	s.networkService.EXPECT().GetAllDevicesByMachineNames(gomock.Any()).Return(nil, nil)
+	s.relationService.EXPECT().GetAllRelationDetails(gomock.Any()).Return(nil, nil)
+
+	output, err := client.FullStatus(c.Context(), params.StatusParams{})
+	c.Assert(err, tc.IsNil)
+	c.Check(output.Machines["0"].Recovery, tc.DeepEquals, &params.MachineRecoveryStatus{
+		Policy:      "reprovision",
+		Attempts:    1,
+		MaxAttempts: domainmachine.MaxRecoveryAttempts,
+		NextAttempt: &nextAttempt,
+		History: []params.MachineRecoveryAttempt{{
+			AttemptedAt: attemptedAt,
+			InstanceId:  "i-1234",
+			Reason:      "instance stopped",
+			Error:       "machine is a controller",
+		}},
+	})
+	c.Check(output.Machines["1"].Recovery, tc.IsNil)
+}
+
 func (s *fullStatusSuite) TestFullStatusControllerAppPortsAugmented(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -345,7 +409,7 @@ func (s *fullStatusSuite) TestFullStatusControllerAppPortsAugmented(c *tc.C) {
 		controller.APIPort:       17777,
 		controller.SSHServerPort: 2222,
 	}, nil)
-	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]service.Application{
+	s.statusService.EXPECT().GetApplicationAndUnitStatusesForFilter(gomock.Any(), gomock.Any()).Return(map[string]service.Application{
 		"controller": {
 			CharmLocator: charm.CharmLocator{
 				Name:         "juju-controller",
@@ -374,6 +438,7 @@ func (s *fullStatusSuite) TestFullStatusControllerAppPortsAugmented(c *tc.C) {
 		},
 	}, nil)
 	s.applicationService.EXPECT().GetAllEndpointBindings(gomock.Any()).Return(nil, nil)
+	s.applicationService.EXPECT().GetAllApplicationPlacementViolations(gomock.Any()).Return(nil, nil)
 	s.statusService.EXPECT().GetRemoteApplicationOffererStatuses(gomock.Any()).Return(nil, nil)
 	s.statusService.EXPECT().GetMachineFullStatuses(gomock.Any()).Return(nil, nil)
 	s.portService.EXPECT().GetAllOpenedPorts(gomock.Any()).Return(nil, nil)
@@ -405,7 +470,14 @@ func (s *fullStatusSuite) TestFullStatusExposedEndpointsFetchedInBulk(c *tc.C) {
 		Status: status.Available,
 	}, nil)
 	s.applicationService.EXPECT().GetAllEndpointBindings(gomock.Any()).Return(nil, nil)
-	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]service.Application{
+	s.applicationService.EXPECT().GetAllApplicationPlacementViolations(gomock.Any()).Return(map[string][]application.PlacementViolation{
+		"mysql": {{
+			Unit:    "mysql/0",
+			Machine: "0",
+			Reason:  "more than 1 unit(s) per machine",
+		}},
+	}, nil)
+	s.statusService.EXPECT().GetApplicationAndUnitStatusesForFilter(gomock.Any(), gomock.Any()).Return(map[string]service.Application{
 		"mysql": {
 			CharmLocator: charm.CharmLocator{
 				Name:         "mysql",
@@ -444,6 +516,9 @@ func (s *fullStatusSuite) TestFullStatusExposedEndpointsFetchedInBulk(c *tc.C) {
 			ExposeToCIDRs: []string{"0.0.0.0/0"},
 		},
 	})
+	c.Check(output.Applications["mysql"].PlacementViolations, tc.DeepEquals, []string{
+		"mysql/0 on machine 0: more than 1 unit(s) per machine",
+	})
 }
 
 func (s *fullStatusSuite) TestFullStatusCAASApplicationAddress(c *tc.C) {
@@ -463,7 +538,7 @@ func (s *fullStatusSuite) TestFullStatusCAASApplicationAddress(c *tc.C) {
 		Status: status.Available,
 	}, nil)
 	s.applicationService.EXPECT().GetAllEndpointBindings(gomock.Any()).Return(nil, nil)
-	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]service.Application{
+	s.statusService.EXPECT().GetApplicationAndUnitStatusesForFilter(gomock.Any(), gomock.Any()).Return(map[string]service.Application{
 		"postgresql-k8s": {
 			CharmLocator: charm.CharmLocator{
 				Name:         "postgresql-k8s",
@@ -512,7 +587,8 @@ func (s *fullStatusSuite) TestFullStatusFilteredByApplicationName(c *tc.C) {
 		Status: status.Available,
 	}, nil)
 	s.applicationService.EXPECT().GetAllEndpointBindings(gomock.Any()).Return(nil, nil)
-	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]service.Application{
+	s.applicationService.EXPECT().GetAllApplicationPlacementViolations(gomock.Any()).Return(nil, nil)
+	s.statusService.EXPECT().GetApplicationAndUnitStatusesForFilter(gomock.Any(), gomock.Any()).Return(map[string]service.Application{
 		"mysql": {
 			CharmLocator: charm.CharmLocator{
 				Name:         "mysql",
@@ -587,6 +663,7 @@ func (s *fullStatusSuite) TestFullStatusFilteredByApplicationName(c *tc.C) {
 			},
 		},
 	}, nil)
+	s.machineService.EXPECT().GetAllMachineRecoveryStatuses(gomock.Any()).Return(nil, nil)
 	s.portService.EXPECT().GetAllOpenedPorts(gomock.Any()).Return(nil, nil)
 	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(nil, nil)
 	s.networkService.EXPECT().GetAllDevicesByMachineNames(gomock.Any()).Return(nil, nil)
@@ -611,6 +688,20 @@ func (s *fullStatusSuite) TestFullStatusFilteredByApplicationName(c *tc.C) {
 	c.Check(output.Applications["mysql"].Units, tc.HasLen, 1)
 }
 
+func (s *fullStatusSuite) TestFullStatusFilterNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	client := s.client(false)
+	s.expectCheckCanRead(client, true)
+
+	_, err := client.FullStatus(c.Context(), params.StatusParams{
+		Patterns: []string{"mysql/*"},
+		Statuses: []string{"on-fire"},
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Check(err, tc.ErrorMatches, `unknown status "on-fire": status filter not valid`)
+}
+
 func (s *fullStatusSuite) TestProcessStorageIncludesPoolNames(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -869,7 +960,7 @@ func (s *fullStatusSuite) expectCheckIsAdmin(client *Client, read bool) {
 }
 
 func (s *fullStatusSuite) expectEmptyModelModuloOffers(c *tc.C) {
-	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(nil, nil)
+	s.statusService.EXPECT().GetApplicationAndUnitStatusesForFilter(gomock.Any(), gomock.Any()).Return(nil, nil)
 	s.statusService.EXPECT().GetRemoteApplicationOffererStatuses(gomock.Any()).Return(nil, nil)
 	s.applicationService.EXPECT().GetAllEndpointBindings(gomock.Any()).Return(nil, nil)
 
@@ -18,11 +18,35 @@ import (
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Client", 8, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newFacadeV8(ctx)
+	}, reflect.TypeFor[*ClientV8]())
+	registry.MustRegister("Client", 9, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacadeV9(ctx) // Added WatchStatus.
+	}, reflect.TypeFor[*ClientV9]())
+	registry.MustRegister("Client", 10, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacadeV10(ctx) // Added status filtering by status value.
 	}, reflect.TypeFor[*Client]())
 }
 
 // newFacadeV8 returns a new Client facade (v8).
-func newFacadeV8(ctx facade.ModelContext) (*Client, error) {
+func newFacadeV8(ctx facade.ModelContext) (*ClientV8, error) {
+	client, err := newFacadeV9(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &ClientV8{ClientV9: *client}, nil
+}
+
+// newFacadeV9 returns a new Client facade (v9).
+func newFacadeV9(ctx facade.ModelContext) (*ClientV9, error) {
+	client, err := newFacadeV10(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &ClientV9{Client: client}, nil
+}
+
+// newFacadeV10 returns a new Client facade (v10).
+func newFacadeV10(ctx facade.ModelContext) (*Client, error) {
 	authorizer := ctx.Auth()
 	if !authorizer.AuthClient() {
 		return nil, apiservererrors.ErrPerm
@@ -42,6 +66,7 @@ func newFacadeV8(ctx facade.ModelContext) (*Client, error) {
 		modelTag:         names.NewModelTag(ctx.ModelUUID().String()),
 		auth:             authorizer,
 		leadershipReader: leadershipReader,
+		watcherRegistry:  ctx.WatcherRegistry(),
 
 		applicationService:        domainServices.Application(),
 		crossModelRelationService: domainServices.CrossModelRelation(),
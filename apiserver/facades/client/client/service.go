@@ -14,11 +14,13 @@ import (
 	"github.com/juju/juju/core/relation"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/domain/application"
 	"github.com/juju/juju/domain/application/architecture"
 	"github.com/juju/juju/domain/application/charm"
 	"github.com/juju/juju/domain/crossmodelrelation"
 	crossmodelrelationservice "github.com/juju/juju/domain/crossmodelrelation/service"
+	domainmachine "github.com/juju/juju/domain/machine"
 	domainnetwork "github.com/juju/juju/domain/network"
 	"github.com/juju/juju/domain/port"
 	domainrelation "github.com/juju/juju/domain/relation"
@@ -57,6 +59,10 @@ type ApplicationService interface {
 
 	// GetUnitsK8sPodInfo returns information about the k8s pods for all alive units.
 	GetUnitsK8sPodInfo(ctx context.Context) (map[unit.Name]application.K8sPodInfo, error)
+
+	// GetAllApplicationPlacementViolations returns the units whose current
+	// placement breaks the placement rules, indexed by application name.
+	GetAllApplicationPlacementViolations(ctx context.Context) (map[string][]application.PlacementViolation, error)
 }
 
 // StatusService defines the methods that the facade assumes from the Status
@@ -65,9 +71,10 @@ type StatusService interface {
 	// GetAllRelationStatuses returns all the relation statuses of the given model.
 	GetAllRelationStatuses(context.Context) (map[relation.UUID]status.StatusInfo, error)
 
-	// GetApplicationAndUnitStatuses returns the application statuses of all the
-	// applications in the model, indexed by application name.
-	GetApplicationAndUnitStatuses(context.Context) (map[string]statusservice.Application, error)
+	// GetApplicationAndUnitStatusesForFilter returns the application
+	// statuses of the applications the filter can match, indexed by
+	// application name.
+	GetApplicationAndUnitStatusesForFilter(context.Context, statusservice.StatusFilter) (map[string]statusservice.Application, error)
 
 	// GetRemoteApplicationOffererStatuses returns the statuses of all remote
 	// application offerers in the model, indexed by application name.
@@ -79,6 +86,11 @@ type StatusService interface {
 	// GetModelStatus returns the current status of the model.
 	GetModelStatus(context.Context) (status.StatusInfo, error)
 
+	// WatchModelStatus returns a watcher that notifies when an application,
+	// unit or machine is added to or removed from the model, or when the
+	// status of any of them changes.
+	WatchModelStatus(context.Context) (watcher.NotifyWatcher, error)
+
 	// GetMachineFullStatuses returns all the machine statuses for the model, indexed
 	// by machine name.
 	GetMachineFullStatuses(ctx context.Context) (map[machine.Name]statusservice.Machine, error)
@@ -115,6 +127,11 @@ type MachineService interface {
 	// IsMachineController returns true if the machine if the machine is the
 	// controller machine.
 	IsMachineController(ctx context.Context, machineName machine.Name) (bool, error)
+
+	// GetAllMachineRecoveryStatuses returns the automatic recovery status of
+	// all the machines in the model which have a recovery history, keyed by
+	// machine name.
+	GetAllMachineRecoveryStatuses(ctx context.Context) (map[machine.Name]domainmachine.RecoveryStatus, error)
 }
 
 // ModelInfoService provides access to information about the model.
@@ -21,11 +21,13 @@ import (
 	relation "github.com/juju/juju/core/relation"
 	status "github.com/juju/juju/core/status"
 	unit "github.com/juju/juju/core/unit"
+	watcher "github.com/juju/juju/core/watcher"
 	application "github.com/juju/juju/domain/application"
 	architecture "github.com/juju/juju/domain/application/architecture"
 	charm "github.com/juju/juju/domain/application/charm"
 	crossmodelrelation "github.com/juju/juju/domain/crossmodelrelation"
 	service "github.com/juju/juju/domain/crossmodelrelation/service"
+	machine0 "github.com/juju/juju/domain/machine"
 	network0 "github.com/juju/juju/domain/network"
 	port "github.com/juju/juju/domain/port"
 	relation0 "github.com/juju/juju/domain/relation"
@@ -41,13 +43,14 @@ type MockApplicationService struct {
 
 // MockApplicationServiceMockRecorder is the mock recorder for MockApplicationService.
 type MockApplicationServiceMockRecorder struct {
-	mock                                 *MockApplicationService
-	getAllEndpointBindingsExpects        []*gomock.Call1_2[context.Context, map[string]map[string]network.SpaceName, error]
-	getAllExposedEndpointsExpects        []*gomock.Call1_2[context.Context, map[string]map[string]application.ExposedEndpoint, error]
-	getExposedEndpointsExpects           []*gomock.Call2_2[context.Context, string, map[string]application.ExposedEndpoint, error]
-	getLatestPendingCharmhubCharmExpects []*gomock.Call3_2[context.Context, string, architecture.Architecture, charm.CharmLocator, error]
-	getUnitUUIDExpects                   []*gomock.Call2_2[context.Context, unit.Name, unit.UUID, error]
-	getUnitsK8sPodInfoExpects            []*gomock.Call1_2[context.Context, map[unit.Name]application.K8sPodInfo, error]
+	mock                                        *MockApplicationService
+	getAllApplicationPlacementViolationsExpects []*gomock.Call1_2[context.Context, map[string][]application.PlacementViolation, error]
+	getAllEndpointBindingsExpects               []*gomock.Call1_2[context.Context, map[string]map[string]network.SpaceName, error]
+	getAllExposedEndpointsExpects               []*gomock.Call1_2[context.Context, map[string]map[string]application.ExposedEndpoint, error]
+	getExposedEndpointsExpects                  []*gomock.Call2_2[context.Context, string, map[string]application.ExposedEndpoint, error]
+	getLatestPendingCharmhubCharmExpects        []*gomock.Call3_2[context.Context, string, architecture.Architecture, charm.CharmLocator, error]
+	getUnitUUIDExpects                          []*gomock.Call2_2[context.Context, unit.Name, unit.UUID, error]
+	getUnitsK8sPodInfoExpects                   []*gomock.Call1_2[context.Context, map[unit.Name]application.K8sPodInfo, error]
 }
 
 // NewMockApplicationService creates a new mock instance.
@@ -62,6 +65,24 @@ func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
 	return m.recorder
 }
 
+// GetAllApplicationPlacementViolations mocks base method.
+func (m *MockApplicationService) GetAllApplicationPlacementViolations(ctx context.Context) (map[string][]application.PlacementViolation, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getAllApplicationPlacementViolationsExpects, m.ctrl, m, "GetAllApplicationPlacementViolations", ctx)
+}
+
+// GetAllApplicationPlacementViolations indicates an expected call of GetAllApplicationPlacementViolations.
+func (mr *MockApplicationServiceMockRecorder) GetAllApplicationPlacementViolations(ctx any) *MockApplicationServiceGetAllApplicationPlacementViolationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, map[string][]application.PlacementViolation, error](mr.mock.ctrl.T, mr.mock, "GetAllApplicationPlacementViolations", gomock.EnsureMatcher(ctx))
+	mr.getAllApplicationPlacementViolationsExpects = append(mr.getAllApplicationPlacementViolationsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceGetAllApplicationPlacementViolationsCall is the typed call wrapper for GetAllApplicationPlacementViolations.
+type MockApplicationServiceGetAllApplicationPlacementViolationsCall = gomock.Call1_2[context.Context, map[string][]application.PlacementViolation, error]
+
 // GetAllEndpointBindings mocks base method.
 func (m *MockApplicationService) GetAllEndpointBindings(ctx context.Context) (map[string]map[string]network.SpaceName, error) {
 	m.ctrl.T.Helper()
@@ -308,8 +329,9 @@ type MockMachineService struct {
 
 // MockMachineServiceMockRecorder is the mock recorder for MockMachineService.
 type MockMachineServiceMockRecorder struct {
-	mock                       *MockMachineService
-	isMachineControllerExpects []*gomock.Call2_2[context.Context, machine.Name, bool, error]
+	mock                                 *MockMachineService
+	getAllMachineRecoveryStatusesExpects []*gomock.Call1_2[context.Context, map[machine.Name]machine0.RecoveryStatus, error]
+	isMachineControllerExpects           []*gomock.Call2_2[context.Context, machine.Name, bool, error]
 }
 
 // NewMockMachineService creates a new mock instance.
@@ -324,6 +346,24 @@ func (m *MockMachineService) EXPECT() *MockMachineServiceMockRecorder {
 	return m.recorder
 }
 
+// GetAllMachineRecoveryStatuses mocks base method.
+func (m *MockMachineService) GetAllMachineRecoveryStatuses(ctx context.Context) (map[machine.Name]machine0.RecoveryStatus, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getAllMachineRecoveryStatusesExpects, m.ctrl, m, "GetAllMachineRecoveryStatuses", ctx)
+}
+
+// GetAllMachineRecoveryStatuses indicates an expected call of GetAllMachineRecoveryStatuses.
+func (mr *MockMachineServiceMockRecorder) GetAllMachineRecoveryStatuses(ctx any) *MockMachineServiceGetAllMachineRecoveryStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, map[machine.Name]machine0.RecoveryStatus, error](mr.mock.ctrl.T, mr.mock, "GetAllMachineRecoveryStatuses", gomock.EnsureMatcher(ctx))
+	mr.getAllMachineRecoveryStatusesExpects = append(mr.getAllMachineRecoveryStatusesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockMachineServiceGetAllMachineRecoveryStatusesCall is the typed call wrapper for GetAllMachineRecoveryStatuses.
+type MockMachineServiceGetAllMachineRecoveryStatusesCall = gomock.Call1_2[context.Context, map[machine.Name]machine0.RecoveryStatus, error]
+
 // IsMachineController mocks base method.
 func (m *MockMachineService) IsMachineController(ctx context.Context, machineName machine.Name) (bool, error) {
 	m.ctrl.T.Helper()
@@ -580,16 +620,17 @@ type MockStatusService struct {
 
 // MockStatusServiceMockRecorder is the mock recorder for MockStatusService.
 type MockStatusServiceMockRecorder struct {
-	mock                                       *MockStatusService
-	getAllFilesystemStatusesExpects            []*gomock.Call1_2[context.Context, []service0.Filesystem, error]
-	getAllRelationStatusesExpects              []*gomock.Call1_2[context.Context, map[relation.UUID]status.StatusInfo, error]
-	getAllStorageInstanceStatusesExpects       []*gomock.Call1_2[context.Context, []service0.StorageInstance, error]
-	getAllVolumeStatusesExpects                []*gomock.Call1_2[context.Context, []service0.Volume, error]
-	getApplicationAndUnitStatusesExpects       []*gomock.Call1_2[context.Context, map[string]service0.Application, error]
-	getMachineFullStatusesExpects              []*gomock.Call1_2[context.Context, map[machine.Name]service0.Machine, error]
-	getModelStatusExpects                      []*gomock.Call1_2[context.Context, status.StatusInfo, error]
-	getRemoteApplicationOffererStatusesExpects []*gomock.Call1_2[context.Context, map[string]service0.RemoteApplicationOfferer, error]
-	getStatusHistoryExpects                    []*gomock.Call2_2[context.Context, service0.StatusHistoryRequest, []status.DetailedStatus, error]
+	mock                                          *MockStatusService
+	getAllFilesystemStatusesExpects               []*gomock.Call1_2[context.Context, []service0.Filesystem, error]
+	getAllRelationStatusesExpects                 []*gomock.Call1_2[context.Context, map[relation.UUID]status.StatusInfo, error]
+	getAllStorageInstanceStatusesExpects          []*gomock.Call1_2[context.Context, []service0.StorageInstance, error]
+	getAllVolumeStatusesExpects                   []*gomock.Call1_2[context.Context, []service0.Volume, error]
+	getApplicationAndUnitStatusesForFilterExpects []*gomock.Call2_2[context.Context, service0.StatusFilter, map[string]service0.Application, error]
+	getMachineFullStatusesExpects                 []*gomock.Call1_2[context.Context, map[machine.Name]service0.Machine, error]
+	getModelStatusExpects                         []*gomock.Call1_2[context.Context, status.StatusInfo, error]
+	getRemoteApplicationOffererStatusesExpects    []*gomock.Call1_2[context.Context, map[string]service0.RemoteApplicationOfferer, error]
+	getStatusHistoryExpects                       []*gomock.Call2_2[context.Context, service0.StatusHistoryRequest, []status.DetailedStatus, error]
+	watchModelStatusExpects                       []*gomock.Call1_2[context.Context, watcher.NotifyWatcher, error]
 }
 
 // NewMockStatusService creates a new mock instance.
@@ -676,23 +717,23 @@ func (mr *MockStatusServiceMockRecorder) GetAllVolumeStatuses(ctx any) *MockStat
 // MockStatusServiceGetAllVolumeStatusesCall is the typed call wrapper for GetAllVolumeStatuses.
 type MockStatusServiceGetAllVolumeStatusesCall = gomock.Call1_2[context.Context, []service0.Volume, error]
 
-// GetApplicationAndUnitStatuses mocks base method.
-func (m *MockStatusService) GetApplicationAndUnitStatuses(arg0 context.Context) (map[string]service0.Application, error) {
+// GetApplicationAndUnitStatusesForFilter mocks base method.
+func (m *MockStatusService) GetApplicationAndUnitStatusesForFilter(arg0 context.Context, arg1 service0.StatusFilter) (map[string]service0.Application, error) {
 	m.ctrl.T.Helper()
-	return gomock.Dispatch1_2(&m.recorder.getApplicationAndUnitStatusesExpects, m.ctrl, m, "GetApplicationAndUnitStatuses", arg0)
+	return gomock.Dispatch2_2(&m.recorder.getApplicationAndUnitStatusesForFilterExpects, m.ctrl, m, "GetApplicationAndUnitStatusesForFilter", arg0, arg1)
 }
 
-// GetApplicationAndUnitStatuses indicates an expected call of GetApplicationAndUnitStatuses.
-func (mr *MockStatusServiceMockRecorder) GetApplicationAndUnitStatuses(arg0 any) *MockStatusServiceGetApplicationAndUnitStatusesCall {
+// GetApplicationAndUnitStatusesForFilter indicates an expected call of GetApplicationAndUnitStatusesForFilter.
+func (mr *MockStatusServiceMockRecorder) GetApplicationAndUnitStatusesForFilter(arg0, arg1 any) *MockStatusServiceGetApplicationAndUnitStatusesForFilterCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall1_2[context.Context, map[string]service0.Application, error](mr.mock.ctrl.T, mr.mock, "GetApplicationAndUnitStatuses", gomock.EnsureMatcher(arg0))
-	mr.getApplicationAndUnitStatusesExpects = append(mr.getApplicationAndUnitStatusesExpects, call)
+	call := gomock.NewCall2_2[context.Context, service0.StatusFilter, map[string]service0.Application, error](mr.mock.ctrl.T, mr.mock, "GetApplicationAndUnitStatusesForFilter", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1))
+	mr.getApplicationAndUnitStatusesForFilterExpects = append(mr.getApplicationAndUnitStatusesForFilterExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
-// MockStatusServiceGetApplicationAndUnitStatusesCall is the typed call wrapper for GetApplicationAndUnitStatuses.
-type MockStatusServiceGetApplicationAndUnitStatusesCall = gomock.Call1_2[context.Context, map[string]service0.Application, error]
+// MockStatusServiceGetApplicationAndUnitStatusesForFilterCall is the typed call wrapper for GetApplicationAndUnitStatusesForFilter.
+type MockStatusServiceGetApplicationAndUnitStatusesForFilterCall = gomock.Call2_2[context.Context, service0.StatusFilter, map[string]service0.Application, error]
 
 // GetMachineFullStatuses mocks base method.
 func (m *MockStatusService) GetMachineFullStatuses(ctx context.Context) (map[machine.Name]service0.Machine, error) {
@@ -765,3 +806,21 @@ func (mr *MockStatusServiceMockRecorder) GetStatusHistory(arg0, arg1 any) *MockS
 
 // MockStatusServiceGetStatusHistoryCall is the typed call wrapper for GetStatusHistory.
 type MockStatusServiceGetStatusHistoryCall = gomock.Call2_2[context.Context, service0.StatusHistoryRequest, []status.DetailedStatus, error]
+
+// WatchModelStatus mocks base method.
+func (m *MockStatusService) WatchModelStatus(arg0 context.Context) (watcher.NotifyWatcher, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.watchModelStatusExpects, m.ctrl, m, "WatchModelStatus", arg0)
+}
+
+// WatchModelStatus indicates an expected call of WatchModelStatus.
+func (mr *MockStatusServiceMockRecorder) WatchModelStatus(arg0 any) *MockStatusServiceWatchModelStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, watcher.NotifyWatcher, error](mr.mock.ctrl.T, mr.mock, "WatchModelStatus", gomock.EnsureMatcher(arg0))
+	mr.watchModelStatusExpects = append(mr.watchModelStatusExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStatusServiceWatchModelStatusCall is the typed call wrapper for WatchModelStatus.
+type MockStatusServiceWatchModelStatusCall = gomock.Call1_2[context.Context, watcher.NotifyWatcher, error]
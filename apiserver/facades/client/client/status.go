@@ -38,6 +38,7 @@ import (
 	crossmodelrelationservice "github.com/juju/juju/domain/crossmodelrelation/service"
 	"github.com/juju/juju/domain/deployment"
 	"github.com/juju/juju/domain/deployment/charm"
+	domainmachine "github.com/juju/juju/domain/machine"
 	domainmodelerrors "github.com/juju/juju/domain/model/errors"
 	domainnetwork "github.com/juju/juju/domain/network"
 	"github.com/juju/juju/domain/port"
@@ -129,6 +130,11 @@ func (c *Client) FullStatus(ctx context.Context, args params.StatusParams) (para
 		return params.FullStatus{}, err
 	}
 
+	filter, err := statusservice.ParseStatusFilter(args.Patterns, args.Statuses)
+	if err != nil {
+		return params.FullStatus{}, internalerrors.Errorf("%w", err).Add(errors.NotValid)
+	}
+
 	machineJobFetcher := func(_ context.Context, _ statusservice.Machine) []model.MachineJob {
 		return []model.MachineJob{model.JobHostUnits}
 	}
@@ -151,7 +157,6 @@ func (c *Client) FullStatus(ctx context.Context, args params.StatusParams) (para
 		machineJobFetcher: machineJobFetcher,
 	}
 
-	var err error
 	if context.model, err = c.modelInfoService.GetModelInfo(ctx); err != nil {
 		return noStatus, fmt.Errorf("getting model info: %w", err)
 	}
@@ -161,7 +166,7 @@ func (c *Client) FullStatus(ctx context.Context, args params.StatusParams) (para
 		return noStatus, internalerrors.Errorf("cannot obtain space information: %w", err)
 	}
 	if context.allAppsUnitsCharmBindings, context.units, err =
-		fetchAllApplicationsAndUnits(ctx, c.statusService, c.applicationService); err != nil {
+		fetchAllApplicationsAndUnits(ctx, c.statusService, c.applicationService, filter); err != nil {
 		return noStatus, internalerrors.Errorf("could not fetch applications and units: %w", err)
 	}
 	if hasExposedApplications(context.allAppsUnitsCharmBindings.applications) {
@@ -180,6 +185,11 @@ func (c *Client) FullStatus(ctx context.Context, args params.StatusParams) (para
 	if err = context.fetchMachines(ctx); err != nil {
 		return noStatus, internalerrors.Errorf("could not fetch machines: %w", err)
 	}
+	if len(context.allMachines) > 0 {
+		if context.machineRecovery, err = c.machineService.GetAllMachineRecoveryStatuses(ctx); err != nil {
+			return noStatus, internalerrors.Errorf("could not fetch machine recovery statuses: %w", err)
+		}
+	}
 	if err = context.fetchAllOpenPortRanges(ctx, c.portService); err != nil {
 		return noStatus, internalerrors.Errorf("could not fetch open port ranges: %w", err)
 	}
@@ -202,6 +212,10 @@ func (c *Client) FullStatus(ctx context.Context, args params.StatusParams) (para
 		if context.podsInfo, err = c.applicationService.GetUnitsK8sPodInfo(ctx); err != nil {
 			return noStatus, internalerrors.Errorf("could not fetch pods info: %w", err)
 		}
+	} else if len(context.allAppsUnitsCharmBindings.applications) > 0 {
+		if context.placementViolations, err = c.applicationService.GetAllApplicationPlacementViolations(ctx); err != nil {
+			return noStatus, internalerrors.Errorf("could not fetch placement violations: %w", err)
+		}
 	}
 
 	if len(context.allAppsUnitsCharmBindings.applications) > 0 {
@@ -223,15 +237,20 @@ func (c *Client) FullStatus(ctx context.Context, args params.StatusParams) (para
 	}
 
 	var matchedUnits map[coreunit.Name]struct{}
-	if len(args.Patterns) > 0 {
-		matches := statusservice.MatchStatusNames(
-			args.Patterns,
+	if !filter.IsEmpty() {
+		relationKeys := make([]corerelation.Key, 0, len(context.relationsByID))
+		for _, rel := range context.relationsByID {
+			relationKeys = append(relationKeys, rel.Key)
+		}
+		matches := statusservice.MatchStatus(
+			filter,
 			context.allAppsUnitsCharmBindings.applications,
 			context.units,
 			context.allMachines,
 			context.leaders,
+			relationKeys,
 		)
-		context.applyNameMatches(matches)
+		context.applyMatches(matches)
 		matchedUnits = matches.Units
 	}
 
@@ -382,6 +401,12 @@ type statusContext struct {
 	leaders                   map[string]string
 	podsInfo                  map[coreunit.Name]application.K8sPodInfo
 
+	// placementViolations: application name -> misplaced units.
+	placementViolations map[string][]application.PlacementViolation
+
+	// machineRecovery: machine name -> automatic recovery status.
+	machineRecovery map[coremachine.Name]domainmachine.RecoveryStatus
+
 	// Information about all spaces.
 	spaceInfos network.SpaceInfos
 }
@@ -522,7 +547,7 @@ func fetchNetworkInterfaces(
 	return ipAddresses, devices, nil
 }
 
-func (c *statusContext) applyNameMatches(matches statusservice.NameMatchResult) {
+func (c *statusContext) applyMatches(matches statusservice.StatusMatchResult) {
 	keptApplications := make(map[string]statusservice.Application, len(matches.Applications))
 	keptCharmURLs := make(map[string]string, len(matches.Applications))
 	keptBindings := make(map[string]map[string]network.SpaceName, len(matches.Applications))
@@ -631,6 +656,7 @@ func (c *statusContext) applyNameMatches(matches statusservice.NameMatchResult)
 // a map from application name to unit name to unit, and a map from base charm URL to latest URL.
 func fetchAllApplicationsAndUnits(
 	ctx context.Context, statusService StatusService, applicationService ApplicationService,
+	filter statusservice.StatusFilter,
 ) (applicationStatusInfo, map[coreunit.Name]statusservice.Unit, error) {
 	var (
 		apps         = make(map[string]statusservice.Application)
@@ -639,7 +665,7 @@ func fetchAllApplicationsAndUnits(
 		latestCharms = make(map[applicationcharm.CharmLocator]applicationcharm.CharmLocator)
 	)
 
-	applications, err := statusService.GetApplicationAndUnitStatuses(ctx)
+	applications, err := statusService.GetApplicationAndUnitStatusesForFilter(ctx, filter)
 	if err != nil {
 		return applicationStatusInfo{}, nil, err
 	}
@@ -945,9 +971,37 @@ func (c *statusContext) makeMachineStatus(
 		status.InstanceId = "pending"
 	}
 
+	status.Recovery = c.processMachineRecovery(machineName)
 	return
 }
 
+// processMachineRecovery returns the automatic recovery status of the
+// machine, or nil if no recovery has been attempted.
+func (c *statusContext) processMachineRecovery(machineName coremachine.Name) *params.MachineRecoveryStatus {
+	recovery, ok := c.machineRecovery[machineName]
+	if !ok || len(recovery.History) == 0 {
+		return nil
+	}
+	result := &params.MachineRecoveryStatus{
+		Policy:      recovery.Policy.String(),
+		Attempts:    recovery.Attempts,
+		MaxAttempts: domainmachine.MaxRecoveryAttempts,
+		History:     make([]params.MachineRecoveryAttempt, len(recovery.History)),
+	}
+	if !recovery.NextAttemptAt.IsZero() {
+		result.NextAttempt = &recovery.NextAttemptAt
+	}
+	for i, attempt := range recovery.History {
+		result.History[i] = params.MachineRecoveryAttempt{
+			AttemptedAt: attempt.AttemptedAt,
+			InstanceId:  attempt.InstanceID.String(),
+			Reason:      attempt.Reason,
+			Error:       attempt.Error,
+		}
+	}
+	return result
+}
+
 func (c *statusContext) processRelations() []params.RelationStatus {
 	var out []params.RelationStatus
 	for _, current := range c.relationsByID {
@@ -1087,8 +1141,10 @@ func (c *statusContext) processApplication(ctx context.Context, name string, app
 	)
 
 	// IAAS applications have all the information they need in the application
-	// status. CAAS applications have some additional information.
+	// status, bar any units placed against the application's placement rules.
+	// CAAS applications have some additional information.
 	if c.model.Type == model.IAAS {
+		processedStatus.PlacementViolations = c.processApplicationPlacementViolations(name)
 		return processedStatus
 	}
 
@@ -1107,6 +1163,14 @@ func (c *statusContext) processApplication(ctx context.Context, name string, app
 	return processedStatus
 }
 
+func (c *statusContext) processApplicationPlacementViolations(name string) []string {
+	var result []string
+	for _, v := range c.placementViolations[name] {
+		result = append(result, fmt.Sprintf("%s on machine %s: %s", v.Unit, v.Machine, v.Reason))
+	}
+	return result
+}
+
 func (c *statusContext) mapExposedEndpointsFromDomain(
 	exposedEndpoints map[string]application.ExposedEndpoint,
 ) (map[string]params.ExposedEndpoint, error) {
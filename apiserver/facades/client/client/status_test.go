@@ -15,6 +15,7 @@ import (
 	"github.com/juju/juju/core/model"
 	permission "github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/watcher/watchertest"
 	"github.com/juju/juju/domain/application/architecture"
 	"github.com/juju/juju/domain/application/charm"
 	"github.com/juju/juju/domain/crossmodelrelation"
@@ -34,6 +35,7 @@ type statusSuite struct {
 	authorizer       *MockAuthorizer
 	modelInfoService *MockModelInfoService
 	statusService    *MockStatusService
+	watcherRegistry  *MockWatcherRegistry
 }
 
 func TestStatusSuite(t *testing.T) {
@@ -273,12 +275,49 @@ func (s *statusSuite) TestFetchOffers(c *tc.C) {
 	})
 }
 
+func (s *statusSuite) TestWatchStatus(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ch := make(chan struct{}, 1)
+	ch <- struct{}{}
+	w := watchertest.NewMockNotifyWatcher(ch)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.statusService.EXPECT().WatchModelStatus(gomock.Any()).Return(w, nil)
+	s.watcherRegistry.EXPECT().Register(gomock.Any(), w).Return("42", nil)
+
+	client := &Client{
+		statusService:   s.statusService,
+		auth:            s.authorizer,
+		watcherRegistry: s.watcherRegistry,
+	}
+	result, err := client.WatchStatus(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.NotifyWatchResult{NotifyWatcherId: "42"})
+}
+
+func (s *statusSuite) TestWatchStatusError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.statusService.EXPECT().WatchModelStatus(gomock.Any()).Return(nil, errors.New("boom"))
+
+	client := &Client{
+		statusService: s.statusService,
+		auth:          s.authorizer,
+	}
+	result, err := client.WatchStatus(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Error, tc.ErrorMatches, "boom")
+}
+
 func (s *statusSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.modelInfoService = NewMockModelInfoService(ctrl)
 	s.statusService = NewMockStatusService(ctrl)
 	s.authorizer = NewMockAuthorizer(ctrl)
+	s.watcherRegistry = NewMockWatcherRegistry(ctrl)
 
 	s.modelUUID = tc.Must0(c, model.NewUUID)
 
@@ -286,6 +325,7 @@ func (s *statusSuite) setupMocks(c *tc.C) *gomock.Controller {
 		s.authorizer = nil
 		s.modelInfoService = nil
 		s.statusService = nil
+		s.watcherRegistry = nil
 		s.modelUUID = ""
 	})
 
@@ -0,0 +1,174 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/watcherregistry (interfaces: WatcherRegistry)
+//
+// Generated by this command:
+//
+//	mockgen -package client -destination watcherregistry_mock_test.go github.com/juju/juju/internal/worker/watcherregistry WatcherRegistry
+//
+
+// Package client is a generated GoMock package.
+package client
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	worker "github.com/juju/worker/v5"
+)
+
+// MockWatcherRegistry is a mock of WatcherRegistry interface.
+type MockWatcherRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatcherRegistryMockRecorder
+	isgomock struct{}
+}
+
+// MockWatcherRegistryMockRecorder is the mock recorder for MockWatcherRegistry.
+type MockWatcherRegistryMockRecorder struct {
+	mock                 *MockWatcherRegistry
+	countExpects         []*gomock.Call0_1[int]
+	getExpects           []*gomock.Call1_2[string, worker.Worker, error]
+	registerExpects      []*gomock.Call2_2[context.Context, worker.Worker, string, error]
+	registerNamedExpects []*gomock.Call3_1[context.Context, string, worker.Worker, error]
+	reportExpects        []*gomock.Call1_1[context.Context, map[string]any]
+	stopExpects          []*gomock.Call1_1[string, error]
+	stopAllExpects       []*gomock.Call0_1[error]
+}
+
+// NewMockWatcherRegistry creates a new mock instance.
+func NewMockWatcherRegistry(ctrl *gomock.Controller) *MockWatcherRegistry {
+	mock := &MockWatcherRegistry{ctrl: ctrl}
+	mock.recorder = &MockWatcherRegistryMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatcherRegistry) EXPECT() *MockWatcherRegistryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockWatcherRegistry) Count() int {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.countExpects, m.ctrl, m, "Count")
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockWatcherRegistryMockRecorder) Count() *MockWatcherRegistryCountCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[int](mr.mock.ctrl.T, mr.mock, "Count")
+	mr.countExpects = append(mr.countExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockWatcherRegistryCountCall is the typed call wrapper for Count.
+type MockWatcherRegistryCountCall = gomock.Call0_1[int]
+
+// Get mocks base method.
+func (m *MockWatcherRegistry) Get(arg0 string) (worker.Worker, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getExpects, m.ctrl, m, "Get", arg0)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWatcherRegistryMockRecorder) Get(arg0 any) *MockWatcherRegistryGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[string, worker.Worker, error](mr.mock.ctrl.T, mr.mock, "Get", gomock.EnsureMatcher(arg0))
+	mr.getExpects = append(mr.getExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockWatcherRegistryGetCall is the typed call wrapper for Get.
+type MockWatcherRegistryGetCall = gomock.Call1_2[string, worker.Worker, error]
+
+// Register mocks base method.
+func (m *MockWatcherRegistry) Register(arg0 context.Context, arg1 worker.Worker) (string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.registerExpects, m.ctrl, m, "Register", arg0, arg1)
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockWatcherRegistryMockRecorder) Register(arg0, arg1 any) *MockWatcherRegistryRegisterCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, worker.Worker, string, error](mr.mock.ctrl.T, mr.mock, "Register", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1))
+	mr.registerExpects = append(mr.registerExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockWatcherRegistryRegisterCall is the typed call wrapper for Register.
+type MockWatcherRegistryRegisterCall = gomock.Call2_2[context.Context, worker.Worker, string, error]
+
+// RegisterNamed mocks base method.
+func (m *MockWatcherRegistry) RegisterNamed(arg0 context.Context, arg1 string, arg2 worker.Worker) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.registerNamedExpects, m.ctrl, m, "RegisterNamed", arg0, arg1, arg2)
+}
+
+// RegisterNamed indicates an expected call of RegisterNamed.
+func (mr *MockWatcherRegistryMockRecorder) RegisterNamed(arg0, arg1, arg2 any) *MockWatcherRegistryRegisterNamedCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, string, worker.Worker, error](mr.mock.ctrl.T, mr.mock, "RegisterNamed", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1), gomock.EnsureMatcher(arg2))
+	mr.registerNamedExpects = append(mr.registerNamedExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockWatcherRegistryRegisterNamedCall is the typed call wrapper for RegisterNamed.
+type MockWatcherRegistryRegisterNamedCall = gomock.Call3_1[context.Context, string, worker.Worker, error]
+
+// Report mocks base method.
+func (m *MockWatcherRegistry) Report(ctx context.Context) map[string]any {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_1(&m.recorder.reportExpects, m.ctrl, m, "Report", ctx)
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockWatcherRegistryMockRecorder) Report(ctx any) *MockWatcherRegistryReportCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_1[context.Context, map[string]any](mr.mock.ctrl.T, mr.mock, "Report", gomock.EnsureMatcher(ctx))
+	mr.reportExpects = append(mr.reportExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockWatcherRegistryReportCall is the typed call wrapper for Report.
+type MockWatcherRegistryReportCall = gomock.Call1_1[context.Context, map[string]any]
+
+// Stop mocks base method.
+func (m *MockWatcherRegistry) Stop(id string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_1(&m.recorder.stopExpects, m.ctrl, m, "Stop", id)
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockWatcherRegistryMockRecorder) Stop(id any) *MockWatcherRegistryStopCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_1[string, error](mr.mock.ctrl.T, mr.mock, "Stop", gomock.EnsureMatcher(id))
+	mr.stopExpects = append(mr.stopExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockWatcherRegistryStopCall is the typed call wrapper for Stop.
+type MockWatcherRegistryStopCall = gomock.Call1_1[string, error]
+
+// StopAll mocks base method.
+func (m *MockWatcherRegistry) StopAll() error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.stopAllExpects, m.ctrl, m, "StopAll")
+}
+
+// StopAll indicates an expected call of StopAll.
+func (mr *MockWatcherRegistryMockRecorder) StopAll() *MockWatcherRegistryStopAllCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[error](mr.mock.ctrl.T, mr.mock, "StopAll")
+	mr.stopAllExpects = append(mr.stopAllExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockWatcherRegistryStopAllCall is the typed call wrapper for StopAll.
+type MockWatcherRegistryStopAllCall = gomock.Call0_1[error]
@@ -26,7 +26,6 @@ import (
 	"github.com/juju/juju/core/os/ostype"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/status"
-	coreunit "github.com/juju/juju/core/unit"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/domain/constraints"
 	"github.com/juju/juju/domain/deployment"
@@ -484,6 +483,16 @@ func (mm *MachineManagerAPI) destroyMachine(ctx context.Context, args params.Ent
 		}
 
 		if dryRun {
+			// The storage is classified as if the removal were forced, so
+			// that the preview is available for machines which still have
+			// units or containers; the client asks for --force for those.
+			preview, err := mm.removalService.PreviewMachineRemoval(ctx, machineUUID, true)
+			if err != nil {
+				fail(internalerrors.Errorf("previewing machine removal: %w", err))
+				continue
+			}
+			info.DestroyedStorage = storageEntities(preview.DestroyedStorage)
+			info.DetachedStorage = storageEntities(preview.DetachedStorage)
 			result.Info = &info
 			results[i] = result
 			continue
@@ -547,21 +556,19 @@ func (mm *MachineManagerAPI) destroyResultForMachine(ctx context.Context, machin
 		info.DestroyedUnits = append(info.DestroyedUnits, params.Entity{Tag: unitTag.String()})
 	}
 
-	info.DestroyedStorage, info.DetachedStorage, err = mm.classifyDetachedStorage(unitNames)
-	if err != nil {
-		return info, internalerrors.Errorf("classifying storage for machine %q: %w", machineName, err)
-	}
-
 	return info, nil
 }
 
-func (mm *MachineManagerAPI) classifyDetachedStorage(unitNames []coreunit.Name) (destroyed, detached []params.Entity, _ error) {
-	var storageErrors []params.ErrorResult
-
-	// TODO(storage): classify detached storage
-
-	err := params.ErrorResults{Results: storageErrors}.Combine()
-	return destroyed, detached, err
+// storageEntities returns the storage tags for the input storage IDs.
+func storageEntities(storageIDs []string) []params.Entity {
+	if len(storageIDs) == 0 {
+		return nil
+	}
+	entities := make([]params.Entity, len(storageIDs))
+	for i, id := range storageIDs {
+		entities[i] = params.Entity{Tag: names.NewStorageTag(id).String()}
+	}
+	return entities
 }
 
 // ModelAuthorizer defines if a given operation can be performed based on a
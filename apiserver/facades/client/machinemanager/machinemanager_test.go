@@ -4,6 +4,7 @@
 package machinemanager
 
 import (
+	"context"
 	"reflect"
 	"strings"
 	"testing"
@@ -33,6 +34,8 @@ import (
 	domainmachine "github.com/juju/juju/domain/machine"
 	machineservice "github.com/juju/juju/domain/machine/service"
 	"github.com/juju/juju/domain/modelmigration"
+	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
 	"github.com/juju/juju/environs/config"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/testhelpers"
@@ -307,6 +310,12 @@ func (s *DestroyMachineManagerSuite) expectCalculateDestroyResult(
 	s.applicationService.EXPECT().GetUnitNamesOnMachine(gomock.Any(), machineName).Return(unitNames, nil).Times(1)
 }
 
+func (s *DestroyMachineManagerSuite) expectPreviewMachineRemoval(
+	machineUUID coremachine.UUID, force bool, preview removal.Preview,
+) {
+	s.removalService.EXPECT().PreviewMachineRemoval(gomock.Any(), machineUUID, force).Return(preview, nil)
+}
+
 func (s *DestroyMachineManagerSuite) TestDestroyMachineDryRun(c *tc.C) {
 	ctrl := s.setupMocks(c)
 	defer ctrl.Finish()
@@ -316,6 +325,10 @@ func (s *DestroyMachineManagerSuite) TestDestroyMachineDryRun(c *tc.C) {
 
 	s.machineService.EXPECT().GetMachineContainers(gomock.Any(), machineUUID).Return(nil, nil)
 	s.expectCalculateDestroyResult(c, ctrl, "0", nil, nil)
+	s.expectPreviewMachineRemoval(machineUUID, true, removal.Preview{
+		DestroyedStorage: []string{"cache/0"},
+		DetachedStorage:  []string{"data/0", "data/1"},
+	})
 
 	results, err := s.api.DestroyMachineWithParams(c.Context(), params.DestroyMachinesParams{
 		MachineTags: []string{"machine-0"},
@@ -332,6 +345,13 @@ func (s *DestroyMachineManagerSuite) TestDestroyMachineDryRun(c *tc.C) {
 					{Tag: "unit-foo-1"},
 					{Tag: "unit-foo-2"},
 				},
+				DestroyedStorage: []params.Entity{
+					{Tag: "storage-cache-0"},
+				},
+				DetachedStorage: []params.Entity{
+					{Tag: "storage-data-0"},
+					{Tag: "storage-data-1"},
+				},
 			},
 		}},
 	})
@@ -347,6 +367,15 @@ func (s *DestroyMachineManagerSuite) TestDestroyMachineWithContainersDryRun(c *t
 	s.machineService.EXPECT().GetMachineContainers(gomock.Any(), machineUUID).Return([]coremachine.Name{"0/lxd/0"}, nil)
 	s.expectCalculateDestroyResult(c, ctrl, "0", nil, nil)
 	s.expectCalculateDestroyResult(c, ctrl, "0/lxd/0", nil, nil)
+	// The removal service cannot preview the removal of a machine with
+	// containers unless it is forced.
+	s.removalService.EXPECT().PreviewMachineRemoval(gomock.Any(), machineUUID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ coremachine.UUID, force bool) (removal.Preview, error) {
+			if !force {
+				return removal.Preview{}, removalerrors.MachineHasContainers
+			}
+			return removal.Preview{}, nil
+		})
 
 	results, err := s.api.DestroyMachineWithParams(c.Context(), params.DestroyMachinesParams{
 		MachineTags: []string{"machine-0"},
@@ -868,8 +868,9 @@ type MockRemovalService struct {
 
 // MockRemovalServiceMockRecorder is the mock recorder for MockRemovalService.
 type MockRemovalServiceMockRecorder struct {
-	mock                 *MockRemovalService
-	removeMachineExpects []*gomock.Call4_2[context.Context, machine.UUID, bool, time.Duration, removal.UUID, error]
+	mock                         *MockRemovalService
+	previewMachineRemovalExpects []*gomock.Call3_2[context.Context, machine.UUID, bool, removal.Preview, error]
+	removeMachineExpects         []*gomock.Call4_2[context.Context, machine.UUID, bool, time.Duration, removal.UUID, error]
 }
 
 // NewMockRemovalService creates a new mock instance.
@@ -884,6 +885,24 @@ func (m *MockRemovalService) EXPECT() *MockRemovalServiceMockRecorder {
 	return m.recorder
 }
 
+// PreviewMachineRemoval mocks base method.
+func (m *MockRemovalService) PreviewMachineRemoval(ctx context.Context, machineUUID machine.UUID, force bool) (removal.Preview, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.previewMachineRemovalExpects, m.ctrl, m, "PreviewMachineRemoval", ctx, machineUUID, force)
+}
+
+// PreviewMachineRemoval indicates an expected call of PreviewMachineRemoval.
+func (mr *MockRemovalServiceMockRecorder) PreviewMachineRemoval(ctx, machineUUID, force any) *MockRemovalServicePreviewMachineRemovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, machine.UUID, bool, removal.Preview, error](mr.mock.ctrl.T, mr.mock, "PreviewMachineRemoval", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(machineUUID), gomock.EnsureMatcher(force))
+	mr.previewMachineRemovalExpects = append(mr.previewMachineRemovalExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockRemovalServicePreviewMachineRemovalCall is the typed call wrapper for PreviewMachineRemoval.
+type MockRemovalServicePreviewMachineRemovalCall = gomock.Call3_2[context.Context, machine.UUID, bool, removal.Preview, error]
+
 // RemoveMachine mocks base method.
 func (m *MockRemovalService) RemoveMachine(ctx context.Context, machineUUID machine.UUID, force bool, wait time.Duration) (removal.UUID, error) {
 	m.ctrl.T.Helper()
@@ -197,6 +197,15 @@ type RemovalService interface {
 		force bool,
 		wait time.Duration,
 	) (removal.UUID, error)
+
+	// PreviewMachineRemoval returns the entities that would be removed, or
+	// have their life advanced, if the machine with the input UUID were
+	// removed with the input force qualification. The model is not changed.
+	PreviewMachineRemoval(
+		ctx context.Context,
+		machineUUID coremachine.UUID,
+		force bool,
+	) (removal.Preview, error)
 }
 
 // ModelMigrationService provides access to model migration status.
@@ -13,6 +13,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/apiserver/common"
 	commonmodel "github.com/juju/juju/apiserver/common/model"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
@@ -51,6 +52,16 @@ type ModelStatusAPI interface {
 
 // ModelManagerAPIV10 implements the model manager V10.
 type ModelManagerAPIV10 struct {
+	*ModelManagerAPIV11
+}
+
+// ModelManagerAPIV11 implements the model manager V11.
+type ModelManagerAPIV11 struct {
+	*ModelManagerAPIV12
+}
+
+// ModelManagerAPIV12 implements the model manager V12.
+type ModelManagerAPIV12 struct {
 	*ModelManagerAPI
 }
 
@@ -851,6 +862,62 @@ func (m *ModelManagerAPI) DestroyModels(
 	return results, nil
 }
 
+// PreviewDestroyModels returns, for each of the specified models, the
+// entities that would be removed if the model were destroyed. Nothing is
+// removed.
+func (m *ModelManagerAPI) PreviewDestroyModels(
+	ctx context.Context, args params.DestroyModelsParams,
+) (params.RemovalPreviewResults, error) {
+	results := params.RemovalPreviewResults{
+		Results: make([]params.RemovalPreviewResult, len(args.Models)),
+	}
+
+	previewModel := func(modelTag names.ModelTag, force *bool) (*params.RemovalPreview, error) {
+		if !m.isAdmin {
+			if err := m.authorizer.HasPermission(ctx, permission.AdminAccess, modelTag); err != nil {
+				return nil, err
+			}
+		}
+
+		var argForce bool
+		if force != nil {
+			argForce = *force
+		}
+
+		mUUID := coremodel.UUID(modelTag.Id())
+		modelDomainServices, err := m.domainServicesGetter.DomainServicesForModel(ctx, mUUID)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		preview, err := modelDomainServices.Removal().PreviewModelRemoval(ctx, mUUID, argForce)
+		if err != nil {
+			return nil, errors.Annotatef(err, "previewing removal of model %q", modelTag.Id())
+		}
+		return common.RemovalPreview(preview), nil
+	}
+
+	for i, arg := range args.Models {
+		tag, err := names.ParseModelTag(arg.ModelTag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		info, err := previewModel(tag, arg.Force)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Info = info
+	}
+	return results, nil
+}
+
+// PreviewDestroyModels isn't on the v11 API.
+func (m *ModelManagerAPIV11) PreviewDestroyModels(_ struct{}) {}
+
+// RenameModels isn't on the v12 API.
+func (m *ModelManagerAPIV12) RenameModels(_ struct{}) {}
+
 // ModelInfo returns information about the specified models.
 func (m *ModelManagerAPI) ModelInfo(ctx context.Context, args params.Entities) (params.ModelInfoResults, error) {
 	results := params.ModelInfoResults{
@@ -1309,3 +1376,57 @@ func (m *ModelManagerAPI) ChangeModelCredential(
 	}
 	return params.ErrorResults{Results: results}, nil
 }
+
+// RenameModels changes the names of the given models. The models keep their
+// qualifiers. Only controller superusers and model admins can rename a model.
+func (m *ModelManagerAPI) RenameModels(
+	ctx context.Context, args params.RenameModelsParams,
+) (params.ErrorResults, error) {
+	err := m.authorizer.HasPermission(ctx, permission.SuperuserAccess,
+		names.NewControllerTag(m.controllerUUID.String()))
+	if err != nil && !errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	controllerAdmin := err == nil
+
+	renameModel := func(arg params.RenameModelParams) error {
+		modelTag, err := names.ParseModelTag(arg.ModelTag)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if !controllerAdmin {
+			if err := m.authorizer.HasPermission(ctx, permission.AdminAccess, modelTag); err != nil {
+				return errors.Trace(err)
+			}
+		}
+
+		modelUUID := coremodel.UUID(modelTag.Id())
+		check, err := m.getBlockChecker(ctx, modelUUID)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := check.ChangeAllowed(ctx); err != nil {
+			return errors.Trace(err)
+		}
+
+		modelDomainServices, err := m.domainServicesGetter.DomainServicesForModel(ctx, modelUUID)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		err = modelDomainServices.ModelInfo().RenameModel(ctx, arg.Name)
+		if errors.Is(err, modelerrors.AlreadyExists) {
+			return internalerrors.Errorf(
+				"model with name %q already exists", arg.Name,
+			).Add(coreerrors.AlreadyExists)
+		}
+		return err
+	}
+
+	results := make([]params.ErrorResult, len(args.Models))
+	for i, arg := range args.Models {
+		if err := renameModel(arg); err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return params.ErrorResults{Results: results}, nil
+}
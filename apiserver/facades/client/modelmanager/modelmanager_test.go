@@ -42,6 +42,8 @@ import (
 	domainmodel "github.com/juju/juju/domain/model"
 	modelerrors "github.com/juju/juju/domain/model/errors"
 	"github.com/juju/juju/domain/modeldefaults"
+	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/config"
 	_ "github.com/juju/juju/internal/provider/azure"
@@ -689,6 +691,68 @@ func (s *modelManagerSuite) TestDumpModelMissingModel(c *tc.C) {
 	c.Check(results.Results[0].Error.Message, tc.Matches, ".*not found.*")
 }
 
+func (s *modelManagerSuite) TestPreviewDestroyModels(c *tc.C) {
+	defer s.setUpAPI(c).Finish()
+
+	modelUUID, modelTag := generateModelUUIDAndTag(c)
+	removalService := NewMockRemovalService(gomock.NewController(c))
+
+	s.domainServicesGetter.EXPECT().DomainServicesForModel(
+		gomock.Any(), modelUUID,
+	).Return(s.domainServices, nil)
+	s.domainServices.EXPECT().Removal().Return(removalService)
+	removalService.EXPECT().PreviewModelRemoval(gomock.Any(), modelUUID, true).Return(removal.Preview{
+		Machines:         []string{"0", "0/lxd/0"},
+		Applications:     []string{"mysql"},
+		Units:            []string{"mysql/0"},
+		Relations:        []string{"mysql:cluster"},
+		DestroyedStorage: []string{"data/0"},
+	}, nil)
+
+	force := true
+	results, err := s.api.PreviewDestroyModels(c.Context(), params.DestroyModelsParams{
+		Models: []params.DestroyModelParams{{
+			ModelTag: modelTag.String(),
+			Force:    &force,
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Assert(results.Results[0].Error, tc.IsNil)
+	c.Check(results.Results[0].Info, tc.DeepEquals, &params.RemovalPreview{
+		Machines:         []params.Entity{{Tag: "machine-0"}, {Tag: "machine-0-lxd-0"}},
+		Applications:     []params.Entity{{Tag: "application-mysql"}},
+		Units:            []params.Entity{{Tag: "unit-mysql-0"}},
+		Relations:        []params.Entity{{Tag: "relation-mysql.cluster"}},
+		DestroyedStorage: []params.Entity{{Tag: "storage-data-0"}},
+	})
+}
+
+func (s *modelManagerSuite) TestPreviewDestroyModelsForceRequired(c *tc.C) {
+	defer s.setUpAPI(c).Finish()
+
+	modelUUID, modelTag := generateModelUUIDAndTag(c)
+	removalService := NewMockRemovalService(gomock.NewController(c))
+
+	s.domainServicesGetter.EXPECT().DomainServicesForModel(
+		gomock.Any(), modelUUID,
+	).Return(s.domainServices, nil)
+	s.domainServices.EXPECT().Removal().Return(removalService)
+	removalService.EXPECT().PreviewModelRemoval(gomock.Any(), modelUUID, false).Return(
+		removal.Preview{}, removalerrors.ForceRequired,
+	)
+
+	results, err := s.api.PreviewDestroyModels(c.Context(), params.DestroyModelsParams{
+		Models: []params.DestroyModelParams{{
+			ModelTag: modelTag.String(),
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Assert(results.Results[0].Error, tc.NotNil)
+	c.Check(results.Results[0].Info, tc.IsNil)
+}
+
 func (s *modelManagerSuite) TestDumpModelUsers(c *tc.C) {
 	modelUUID, modelTag := generateModelUUIDAndTag(c)
 	user := names.NewUserTag("admin-" + modelTag.String())
@@ -967,6 +1031,47 @@ func (s *modelManagerSuite) TestChangeModelCredentialUnauthorisedUser(c *tc.C) {
 	c.Assert(results.Results[0].Error, tc.ErrorMatches, `permission denied`)
 }
 
+func (s *modelManagerSuite) TestRenameModels(c *tc.C) {
+	defer s.setUpAPI(c).Finish()
+	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), blockcommand.ChangeBlock).
+		Return("", blockcommanderrors.NotFound).AnyTimes()
+
+	modelUUID, modelTag := generateModelUUIDAndTag(c)
+	otherUUID, otherTag := generateModelUUIDAndTag(c)
+	modelInfoService := NewMockModelInfoService(gomock.NewController(c))
+	s.domainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any(), modelUUID).Return(s.domainServices, nil)
+	s.domainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any(), otherUUID).Return(s.domainServices, nil)
+	s.domainServices.EXPECT().ModelInfo().Return(modelInfoService).Times(2)
+	modelInfoService.EXPECT().RenameModel(gomock.Any(), "production").Return(nil)
+	modelInfoService.EXPECT().RenameModel(gomock.Any(), "taken").Return(modelerrors.AlreadyExists)
+
+	results, err := s.api.RenameModels(c.Context(), params.RenameModelsParams{
+		Models: []params.RenameModelParams{
+			{ModelTag: modelTag.String(), Name: "production"},
+			{ModelTag: "bad-model-tag", Name: "production"},
+			{ModelTag: otherTag.String(), Name: "taken"},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 3)
+	c.Check(results.Results[0].Error, tc.IsNil)
+	c.Check(results.Results[1].Error, tc.ErrorMatches, `"bad-model-tag" is not a valid tag`)
+	c.Check(results.Results[2].Error, tc.Satisfies, params.IsCodeAlreadyExists)
+}
+
+func (s *modelManagerSuite) TestRenameModelsUnauthorisedUser(c *tc.C) {
+	defer s.setUpAPIWithUser(c, names.NewUserTag("bob@remote")).Finish()
+
+	_, modelTag := generateModelUUIDAndTag(c)
+	results, err := s.api.RenameModels(c.Context(), params.RenameModelsParams{
+		Models: []params.RenameModelParams{
+			{ModelTag: modelTag.String(), Name: "production"},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results[0].Error, tc.ErrorMatches, `permission denied`)
+}
+
 func (s *modelManagerSuite) TestListModelsAdminSelf(c *tc.C) {
 	defer s.setUpAPI(c).Finish()
 
@@ -32,6 +32,14 @@ func Register(registry facade.FacadeRegistry) {
 	// v11 handles requests with a model qualifier instead of a model owner.
 	registry.MustRegisterForMultiModel("ModelManager", 11, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
 		return newFacadeV11(stdCtx, ctx)
+	}, reflect.TypeFor[*ModelManagerAPIV11]())
+	// v12 adds PreviewDestroyModels.
+	registry.MustRegisterForMultiModel("ModelManager", 12, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
+		return newFacadeV12(stdCtx, ctx)
+	}, reflect.TypeFor[*ModelManagerAPIV12]())
+	// v13 adds RenameModels.
+	registry.MustRegisterForMultiModel("ModelManager", 13, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
+		return newFacadeV13(stdCtx, ctx)
 	}, reflect.TypeFor[*ModelManagerAPI]())
 }
 
@@ -41,11 +49,29 @@ func newFacadeV10(stdCtx context.Context, ctx facade.MultiModelContext) (*ModelM
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &ModelManagerAPIV10{ModelManagerAPI: api}, nil
+	return &ModelManagerAPIV10{ModelManagerAPIV11: api}, nil
 }
 
 // newFacadeV11 is used for API registration.
-func newFacadeV11(stdCtx context.Context, ctx facade.MultiModelContext) (*ModelManagerAPI, error) {
+func newFacadeV11(stdCtx context.Context, ctx facade.MultiModelContext) (*ModelManagerAPIV11, error) {
+	api, err := newFacadeV12(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &ModelManagerAPIV11{ModelManagerAPIV12: api}, nil
+}
+
+// newFacadeV12 is used for API registration.
+func newFacadeV12(stdCtx context.Context, ctx facade.MultiModelContext) (*ModelManagerAPIV12, error) {
+	api, err := newFacadeV13(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &ModelManagerAPIV12{ModelManagerAPI: api}, nil
+}
+
+// newFacadeV13 is used for API registration.
+func newFacadeV13(stdCtx context.Context, ctx facade.MultiModelContext) (*ModelManagerAPI, error) {
 	auth := ctx.Auth()
 	// Since we know this is a user tag (because AuthClient is true),
 	// we just do the type assertion to the UserTag.
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/modelmanager (interfaces: ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,StatusService)
+// Source: github.com/juju/juju/apiserver/facades/client/modelmanager (interfaces: ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,StatusService,RemovalService)
 //
 // Generated by this command:
 //
-//	mockgen -package modelmanager_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/modelmanager ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,StatusService
+//	mockgen -package modelmanager_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/modelmanager ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,StatusService,RemovalService
 //
 
 // Package modelmanager_test is a generated GoMock package.
@@ -29,6 +29,7 @@ import (
 	access "github.com/juju/juju/domain/access"
 	model0 "github.com/juju/juju/domain/model"
 	modeldefaults "github.com/juju/juju/domain/modeldefaults"
+	removal "github.com/juju/juju/domain/removal"
 	service "github.com/juju/juju/domain/secretbackend/service"
 	status0 "github.com/juju/juju/domain/status"
 )
@@ -613,6 +614,7 @@ type MockModelInfoServiceMockRecorder struct {
 	getUserModelSummaryExpects               []*gomock.Call2_2[context.Context, user.UUID, model.UserModelSummary, error]
 	hasValidCredentialExpects                []*gomock.Call1_2[context.Context, bool, error]
 	isControllerModelExpects                 []*gomock.Call1_2[context.Context, bool, error]
+	renameModelExpects                       []*gomock.Call2_1[context.Context, string, error]
 }
 
 // NewMockModelInfoService creates a new mock instance.
@@ -771,6 +773,24 @@ func (mr *MockModelInfoServiceMockRecorder) IsControllerModel(ctx any) *MockMode
 // MockModelInfoServiceIsControllerModelCall is the typed call wrapper for IsControllerModel.
 type MockModelInfoServiceIsControllerModelCall = gomock.Call1_2[context.Context, bool, error]
 
+// RenameModel mocks base method.
+func (m *MockModelInfoService) RenameModel(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.renameModelExpects, m.ctrl, m, "RenameModel", ctx, name)
+}
+
+// RenameModel indicates an expected call of RenameModel.
+func (mr *MockModelInfoServiceMockRecorder) RenameModel(ctx, name any) *MockModelInfoServiceRenameModelCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, string, error](mr.mock.ctrl.T, mr.mock, "RenameModel", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.renameModelExpects = append(mr.renameModelExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelInfoServiceRenameModelCall is the typed call wrapper for RenameModel.
+type MockModelInfoServiceRenameModelCall = gomock.Call2_1[context.Context, string, error]
+
 // MockModelConfigService is a mock of ModelConfigService interface.
 type MockModelConfigService struct {
 	ctrl     *gomock.Controller
@@ -1370,3 +1390,65 @@ func (mr *MockStatusServiceMockRecorder) GetModelStatusInfo(ctx any) *MockStatus
 
 // MockStatusServiceGetModelStatusInfoCall is the typed call wrapper for GetModelStatusInfo.
 type MockStatusServiceGetModelStatusInfoCall = gomock.Call1_2[context.Context, status0.ModelStatusInfo, error]
+
+// MockRemovalService is a mock of RemovalService interface.
+type MockRemovalService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemovalServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockRemovalServiceMockRecorder is the mock recorder for MockRemovalService.
+type MockRemovalServiceMockRecorder struct {
+	mock                       *MockRemovalService
+	previewModelRemovalExpects []*gomock.Call3_2[context.Context, model.UUID, bool, removal.Preview, error]
+	removeModelExpects         []*gomock.Call4_2[context.Context, model.UUID, bool, time.Duration, removal.UUID, error]
+}
+
+// NewMockRemovalService creates a new mock instance.
+func NewMockRemovalService(ctrl *gomock.Controller) *MockRemovalService {
+	mock := &MockRemovalService{ctrl: ctrl}
+	mock.recorder = &MockRemovalServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemovalService) EXPECT() *MockRemovalServiceMockRecorder {
+	return m.recorder
+}
+
+// PreviewModelRemoval mocks base method.
+func (m *MockRemovalService) PreviewModelRemoval(ctx context.Context, modelUUID model.UUID, force bool) (removal.Preview, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.previewModelRemovalExpects, m.ctrl, m, "PreviewModelRemoval", ctx, modelUUID, force)
+}
+
+// PreviewModelRemoval indicates an expected call of PreviewModelRemoval.
+func (mr *MockRemovalServiceMockRecorder) PreviewModelRemoval(ctx, modelUUID, force any) *MockRemovalServicePreviewModelRemovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, model.UUID, bool, removal.Preview, error](mr.mock.ctrl.T, mr.mock, "PreviewModelRemoval", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(modelUUID), gomock.EnsureMatcher(force))
+	mr.previewModelRemovalExpects = append(mr.previewModelRemovalExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockRemovalServicePreviewModelRemovalCall is the typed call wrapper for PreviewModelRemoval.
+type MockRemovalServicePreviewModelRemovalCall = gomock.Call3_2[context.Context, model.UUID, bool, removal.Preview, error]
+
+// RemoveModel mocks base method.
+func (m *MockRemovalService) RemoveModel(ctx context.Context, modelUUID model.UUID, force bool, wait time.Duration) (removal.UUID, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_2(&m.recorder.removeModelExpects, m.ctrl, m, "RemoveModel", ctx, modelUUID, force, wait)
+}
+
+// RemoveModel indicates an expected call of RemoveModel.
+func (mr *MockRemovalServiceMockRecorder) RemoveModel(ctx, modelUUID, force, wait any) *MockRemovalServiceRemoveModelCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_2[context.Context, model.UUID, bool, time.Duration, removal.UUID, error](mr.mock.ctrl.T, mr.mock, "RemoveModel", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(modelUUID), gomock.EnsureMatcher(force), gomock.EnsureMatcher(wait))
+	mr.removeModelExpects = append(mr.removeModelExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockRemovalServiceRemoveModelCall is the typed call wrapper for RemoveModel.
+type MockRemovalServiceRemoveModelCall = gomock.Call4_2[context.Context, model.UUID, bool, time.Duration, removal.UUID, error]
@@ -200,6 +200,14 @@ type ModelInfoService interface {
 	// The following errors may be returned:
 	// - [modelerrors.NotFound] when the model no longer exists.
 	HasValidCredential(ctx context.Context) (bool, error)
+
+	// RenameModel changes the name of the model.
+	// The following errors may be returned:
+	// - [coreerrors.NotValid] when the new name is not a valid model name.
+	// - [coreerrors.NotSupported] when the model can't be renamed.
+	// - [modelerrors.AlreadyExists] when a model with the new name already
+	// exists for the same qualifier.
+	RenameModel(ctx context.Context, name string) error
 }
 
 // CredentialService exposes State methods needed by credential manager.
@@ -324,6 +332,13 @@ type RemovalService interface {
 	RemoveModel(
 		ctx context.Context, modelUUID coremodel.UUID, force bool, wait time.Duration,
 	) (removal.UUID, error)
+
+	// PreviewModelRemoval returns the entities that would be removed if the
+	// model with the input UUID were removed with the input force
+	// qualification. The model is not changed.
+	PreviewModelRemoval(
+		ctx context.Context, modelUUID coremodel.UUID, force bool,
+	) (removal.Preview, error)
 }
 
 // Services holds the services needed by the model manager api.
@@ -0,0 +1,6 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removals
+
+//go:generate go run github.com/canonical/gomock/mockgen -package removals -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/removals RemovalService,Authorizer
@@ -0,0 +1,126 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removals
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// RemovalService defines the methods that the Removals
+// facade requires from the domain service.
+type RemovalService interface {
+	// GetAllJobs returns all removal jobs.
+	GetAllJobs(ctx context.Context) ([]removal.Job, error)
+	// GetJobEntityNames returns the names by which the entities being
+	// removed by the scheduled removal jobs are known to users, keyed by
+	// removal job UUID.
+	GetJobEntityNames(ctx context.Context) (map[removal.UUID]string, error)
+	// RetryJob reschedules the removal job with the input UUID
+	// so that it is run again as soon as possible.
+	RetryJob(ctx context.Context, jUUID removal.UUID) error
+	// ForceJob escalates the removal job with the input UUID to a forced
+	// removal, and reschedules it so that it is run again as soon as possible.
+	ForceJob(ctx context.Context, jUUID removal.UUID) error
+}
+
+// Authorizer defines the methods that the Removals facade
+// requires for checking permissions.
+type Authorizer interface {
+	// HasPermission reports whether the given access is allowed for the given
+	// target by the authenticated entity.
+	HasPermission(ctx context.Context, operation permission.Access, target names.Tag) error
+}
+
+// API implements the Removals facade, allowing pending
+// removal jobs to be inspected and managed.
+type API struct {
+	modelTag   names.ModelTag
+	service    RemovalService
+	authorizer Authorizer
+}
+
+func (a *API) checkCanRead(ctx context.Context) error {
+	return a.authorizer.HasPermission(ctx, permission.ReadAccess, a.modelTag)
+}
+
+func (a *API) checkCanWrite(ctx context.Context) error {
+	return a.authorizer.HasPermission(ctx, permission.WriteAccess, a.modelTag)
+}
+
+// ListRemovals returns all pending removal jobs for the model.
+func (a *API) ListRemovals(ctx context.Context) (params.RemovalJobResults, error) {
+	if err := a.checkCanRead(ctx); err != nil {
+		return params.RemovalJobResults{}, err
+	}
+
+	jobs, err := a.service.GetAllJobs(ctx)
+	if err != nil {
+		return params.RemovalJobResults{}, apiservererrors.ServerError(err)
+	}
+
+	names, err := a.service.GetJobEntityNames(ctx)
+	if err != nil {
+		return params.RemovalJobResults{}, apiservererrors.ServerError(err)
+	}
+
+	results := make([]params.RemovalJob, len(jobs))
+	for i, job := range jobs {
+		results[i] = params.RemovalJob{
+			UUID:            job.UUID.String(),
+			EntityType:      job.RemovalType.String(),
+			EntityUUID:      job.EntityUUID,
+			EntityName:      names[job.UUID],
+			Force:           job.Force,
+			ScheduledFor:    job.ScheduledFor,
+			Attempts:        job.Attempts,
+			LastError:       job.LastError,
+			LastAttemptedAt: job.LastAttemptedAt,
+		}
+	}
+	return params.RemovalJobResults{Results: results}, nil
+}
+
+// RetryRemovals reschedules the input removal jobs
+// so that they are run again as soon as possible.
+func (a *API) RetryRemovals(ctx context.Context, args params.RemovalJobArgs) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, err
+	}
+	return a.reschedule(ctx, args, a.service.RetryJob), nil
+}
+
+// ForceRemovals escalates the input removal jobs to forced
+// removals, and reschedules them to run as soon as possible.
+func (a *API) ForceRemovals(ctx context.Context, args params.RemovalJobArgs) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, err
+	}
+	return a.reschedule(ctx, args, a.service.ForceJob), nil
+}
+
+func (a *API) reschedule(
+	ctx context.Context, args params.RemovalJobArgs, fn func(context.Context, removal.UUID) error,
+) params.ErrorResults {
+	results := make([]params.ErrorResult, len(args.UUIDs))
+	for i, id := range args.UUIDs {
+		err := fn(ctx, removal.UUID(id))
+		switch {
+		case errors.Is(err, removalerrors.RemovalJobNotFound):
+			err = errors.NotFoundf("removal job %q", id)
+		case errors.Is(err, removalerrors.RemovalJobArgsInvalid):
+			err = errors.NotValidf("removal job UUID %q", id)
+		}
+		results[i].Error = apiservererrors.ServerError(err)
+	}
+	return params.ErrorResults{Results: results}
+}
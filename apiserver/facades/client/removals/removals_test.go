@@ -0,0 +1,125 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removals
+
+import (
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+type removalsSuite struct {
+	api *API
+
+	service    *MockRemovalService
+	authorizer *MockAuthorizer
+}
+
+func TestRemovalsSuite(t *testing.T) {
+	tc.Run(t, &removalsSuite{})
+}
+
+func (s *removalsSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.service = NewMockRemovalService(ctrl)
+	s.authorizer = NewMockAuthorizer(ctrl)
+
+	s.api = &API{
+		modelTag:   names.NewModelTag("beef1beef1-0000-0000-000011112222"),
+		service:    s.service,
+		authorizer: s.authorizer,
+	}
+
+	return ctrl
+}
+
+func (s *removalsSuite) TestListRemovals(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Now().UTC()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(nil)
+	s.service.EXPECT().GetAllJobs(gomock.Any()).Return([]removal.Job{{
+		UUID:            "job-uuid",
+		RemovalType:     removal.UnitJob,
+		EntityUUID:      "unit-uuid",
+		Force:           true,
+		ScheduledFor:    now,
+		Attempts:        3,
+		LastError:       "the front fell off",
+		LastAttemptedAt: &now,
+	}}, nil)
+	s.service.EXPECT().GetJobEntityNames(gomock.Any()).Return(map[removal.UUID]string{
+		"job-uuid": "foo/0",
+	}, nil)
+
+	result, err := s.api.ListRemovals(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.RemovalJobResults{
+		Results: []params.RemovalJob{{
+			UUID:            "job-uuid",
+			EntityType:      "unit",
+			EntityUUID:      "unit-uuid",
+			EntityName:      "foo/0",
+			Force:           true,
+			ScheduledFor:    now,
+			Attempts:        3,
+			LastError:       "the front fell off",
+			LastAttemptedAt: &now,
+		}},
+	})
+}
+
+func (s *removalsSuite) TestListRemovalsPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(
+		apiservererrors.ErrPerm)
+
+	_, err := s.api.ListRemovals(c.Context())
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *removalsSuite) TestRetryRemovals(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, s.api.modelTag).Return(nil)
+	s.service.EXPECT().RetryJob(gomock.Any(), removal.UUID("job-1")).Return(nil)
+	s.service.EXPECT().RetryJob(gomock.Any(), removal.UUID("job-2")).Return(removalerrors.RemovalJobNotFound)
+
+	result, err := s.api.RetryRemovals(c.Context(), params.RemovalJobArgs{UUIDs: []string{"job-1", "job-2"}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 2)
+	c.Check(result.Results[0].Error, tc.IsNil)
+	c.Check(result.Results[1].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *removalsSuite) TestForceRemovals(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, s.api.modelTag).Return(nil)
+	s.service.EXPECT().ForceJob(gomock.Any(), removal.UUID("job-1")).Return(nil)
+
+	result, err := s.api.ForceRemovals(c.Context(), params.RemovalJobArgs{UUIDs: []string{"job-1"}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.ErrorResults{Results: []params.ErrorResult{{}}})
+}
+
+func (s *removalsSuite) TestForceRemovalsPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, s.api.modelTag).Return(
+		apiservererrors.ErrPerm)
+
+	_, err := s.api.ForceRemovals(c.Context(), params.RemovalJobArgs{UUIDs: []string{"job-1"}})
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
@@ -0,0 +1,162 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/client/removals (interfaces: RemovalService,Authorizer)
+//
+// Generated by this command:
+//
+//	mockgen -package removals -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/removals RemovalService,Authorizer
+//
+
+// Package removals is a generated GoMock package.
+package removals
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	permission "github.com/juju/juju/core/permission"
+	removal "github.com/juju/juju/domain/removal"
+	names "github.com/juju/names/v6"
+)
+
+// MockRemovalService is a mock of RemovalService interface.
+type MockRemovalService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemovalServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockRemovalServiceMockRecorder is the mock recorder for MockRemovalService.
+type MockRemovalServiceMockRecorder struct {
+	mock                     *MockRemovalService
+	forceJobExpects          []*gomock.Call2_1[context.Context, removal.UUID, error]
+	getAllJobsExpects        []*gomock.Call1_2[context.Context, []removal.Job, error]
+	getJobEntityNamesExpects []*gomock.Call1_2[context.Context, map[removal.UUID]string, error]
+	retryJobExpects          []*gomock.Call2_1[context.Context, removal.UUID, error]
+}
+
+// NewMockRemovalService creates a new mock instance.
+func NewMockRemovalService(ctrl *gomock.Controller) *MockRemovalService {
+	mock := &MockRemovalService{ctrl: ctrl}
+	mock.recorder = &MockRemovalServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemovalService) EXPECT() *MockRemovalServiceMockRecorder {
+	return m.recorder
+}
+
+// ForceJob mocks base method.
+func (m *MockRemovalService) ForceJob(ctx context.Context, jUUID removal.UUID) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.forceJobExpects, m.ctrl, m, "ForceJob", ctx, jUUID)
+}
+
+// ForceJob indicates an expected call of ForceJob.
+func (mr *MockRemovalServiceMockRecorder) ForceJob(ctx, jUUID any) *MockRemovalServiceForceJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, removal.UUID, error](mr.mock.ctrl.T, mr.mock, "ForceJob", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(jUUID))
+	mr.forceJobExpects = append(mr.forceJobExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockRemovalServiceForceJobCall is the typed call wrapper for ForceJob.
+type MockRemovalServiceForceJobCall = gomock.Call2_1[context.Context, removal.UUID, error]
+
+// GetAllJobs mocks base method.
+func (m *MockRemovalService) GetAllJobs(ctx context.Context) ([]removal.Job, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getAllJobsExpects, m.ctrl, m, "GetAllJobs", ctx)
+}
+
+// GetAllJobs indicates an expected call of GetAllJobs.
+func (mr *MockRemovalServiceMockRecorder) GetAllJobs(ctx any) *MockRemovalServiceGetAllJobsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []removal.Job, error](mr.mock.ctrl.T, mr.mock, "GetAllJobs", gomock.EnsureMatcher(ctx))
+	mr.getAllJobsExpects = append(mr.getAllJobsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockRemovalServiceGetAllJobsCall is the typed call wrapper for GetAllJobs.
+type MockRemovalServiceGetAllJobsCall = gomock.Call1_2[context.Context, []removal.Job, error]
+
+// GetJobEntityNames mocks base method.
+func (m *MockRemovalService) GetJobEntityNames(ctx context.Context) (map[removal.UUID]string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getJobEntityNamesExpects, m.ctrl, m, "GetJobEntityNames", ctx)
+}
+
+// GetJobEntityNames indicates an expected call of GetJobEntityNames.
+func (mr *MockRemovalServiceMockRecorder) GetJobEntityNames(ctx any) *MockRemovalServiceGetJobEntityNamesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, map[removal.UUID]string, error](mr.mock.ctrl.T, mr.mock, "GetJobEntityNames", gomock.EnsureMatcher(ctx))
+	mr.getJobEntityNamesExpects = append(mr.getJobEntityNamesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockRemovalServiceGetJobEntityNamesCall is the typed call wrapper for GetJobEntityNames.
+type MockRemovalServiceGetJobEntityNamesCall = gomock.Call1_2[context.Context, map[removal.UUID]string, error]
+
+// RetryJob mocks base method.
+func (m *MockRemovalService) RetryJob(ctx context.Context, jUUID removal.UUID) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.retryJobExpects, m.ctrl, m, "RetryJob", ctx, jUUID)
+}
+
+// RetryJob indicates an expected call of RetryJob.
+func (mr *MockRemovalServiceMockRecorder) RetryJob(ctx, jUUID any) *MockRemovalServiceRetryJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, removal.UUID, error](mr.mock.ctrl.T, mr.mock, "RetryJob", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(jUUID))
+	mr.retryJobExpects = append(mr.retryJobExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockRemovalServiceRetryJobCall is the typed call wrapper for RetryJob.
+type MockRemovalServiceRetryJobCall = gomock.Call2_1[context.Context, removal.UUID, error]
+
+// MockAuthorizer is a mock of Authorizer interface.
+type MockAuthorizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizerMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthorizerMockRecorder is the mock recorder for MockAuthorizer.
+type MockAuthorizerMockRecorder struct {
+	mock                 *MockAuthorizer
+	hasPermissionExpects []*gomock.Call3_1[context.Context, permission.Access, names.Tag, error]
+}
+
+// NewMockAuthorizer creates a new mock instance.
+func NewMockAuthorizer(ctrl *gomock.Controller) *MockAuthorizer {
+	mock := &MockAuthorizer{ctrl: ctrl}
+	mock.recorder = &MockAuthorizerMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizer) EXPECT() *MockAuthorizerMockRecorder {
+	return m.recorder
+}
+
+// HasPermission mocks base method.
+func (m *MockAuthorizer) HasPermission(ctx context.Context, operation permission.Access, target names.Tag) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.hasPermissionExpects, m.ctrl, m, "HasPermission", ctx, operation, target)
+}
+
+// HasPermission indicates an expected call of HasPermission.
+func (mr *MockAuthorizerMockRecorder) HasPermission(ctx, operation, target any) *MockAuthorizerHasPermissionCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, permission.Access, names.Tag, error](mr.mock.ctrl.T, mr.mock, "HasPermission", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(operation), gomock.EnsureMatcher(target))
+	mr.hasPermissionExpects = append(mr.hasPermissionExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuthorizerHasPermissionCall is the typed call wrapper for HasPermission.
+type MockAuthorizerHasPermissionCall = gomock.Call3_1[context.Context, permission.Access, names.Tag, error]
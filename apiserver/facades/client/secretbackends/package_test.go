@@ -4,16 +4,21 @@
 package secretbackends
 
 import (
+	"context"
+
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/model"
 	coretesting "github.com/juju/juju/internal/testing"
 )
 
-//go:generate go run github.com/canonical/gomock/mockgen -package secretbackends -destination service_mocks_test.go github.com/juju/juju/apiserver/facades/client/secretbackends SecretBackendService
+//go:generate go run github.com/canonical/gomock/mockgen -package secretbackends -destination service_mocks_test.go github.com/juju/juju/apiserver/facades/client/secretbackends SecretBackendService,ModelService,SecretService
 
 func NewTestAPI(
 	authorizer facade.Authorizer,
 	backendService SecretBackendService,
+	modelService ModelService,
+	secretServices map[model.UUID]SecretService,
 ) (*SecretBackendsAPI, error) {
 	if !authorizer.AuthClient() {
 		return nil, apiservererrors.ErrPerm
@@ -23,5 +28,9 @@ func NewTestAPI(
 		authorizer:     authorizer,
 		controllerUUID: coretesting.ControllerTag.Id(),
 		backendService: backendService,
+		modelService:   modelService,
+		secretServiceGetter: func(_ context.Context, modelUUID model.UUID) (SecretService, error) {
+			return secretServices[modelUUID], nil
+		},
 	}, nil
 }
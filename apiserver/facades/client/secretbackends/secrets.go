@@ -5,12 +5,15 @@ package secretbackends
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/domain/secretbackend"
@@ -26,6 +29,15 @@ type SecretBackendsAPI struct {
 	authorizer     facade.Authorizer
 	controllerUUID string
 	backendService SecretBackendService
+
+	modelService        ModelService
+	secretServiceGetter func(context.Context, model.UUID) (SecretService, error)
+}
+
+// SecretBackendsAPIV1 is the server implementation for version 1 of the
+// SecretBackends facade.
+type SecretBackendsAPIV1 struct {
+	*SecretBackendsAPI
 }
 
 func (s *SecretBackendsAPI) checkCanAdmin(ctx context.Context) error {
@@ -146,3 +158,46 @@ func (s *SecretBackendsAPI) RemoveSecretBackends(ctx context.Context, args param
 	}
 	return result, nil
 }
+
+// RotateSecretEncryptionKey isn't implemented in the SecretBackendsAPIV1
+// facade.
+func (s *SecretBackendsAPIV1) RotateSecretEncryptionKey(_, _ struct{}) {}
+
+// RotateSecretEncryptionKey rotates the key used to protect the secret
+// content stored in the controller. The data keys of every model are
+// re-wrapped with the new key; the secret content itself is not
+// re-encrypted.
+func (s *SecretBackendsAPI) RotateSecretEncryptionKey(ctx context.Context) (params.ErrorResult, error) {
+	if err := s.checkCanAdmin(ctx); err != nil {
+		return params.ErrorResult{}, errors.Trace(err)
+	}
+	if err := s.backendService.RotateKeyEncryptionKey(ctx); err != nil {
+		return params.ErrorResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	modelUUIDs, err := s.modelService.GetModelUUIDs(ctx)
+	if err != nil {
+		return params.ErrorResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	// Keep going if a model fails so that as many data keys as possible
+	// are moved to the new key. Running the rotation again picks up any
+	// models which were missed.
+	var failed []string
+	for _, modelUUID := range modelUUIDs {
+		if err := s.rewrapModelDataKeys(ctx, modelUUID); err != nil {
+			failed = append(failed, fmt.Sprintf("model %q: %v", modelUUID, err))
+		}
+	}
+	if len(failed) > 0 {
+		err := errors.Errorf("re-wrapping secret data keys: %s", strings.Join(failed, "; "))
+		return params.ErrorResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	return params.ErrorResult{}, nil
+}
+
+func (s *SecretBackendsAPI) rewrapModelDataKeys(ctx context.Context, modelUUID model.UUID) error {
+	secretService, err := s.secretServiceGetter(ctx, modelUUID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(secretService.RewrapSecretDataKeys(ctx))
+}
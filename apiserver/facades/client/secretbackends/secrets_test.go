@@ -14,6 +14,7 @@ import (
 	"github.com/juju/juju/apiserver/authentication"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	facademocks "github.com/juju/juju/apiserver/facade/mocks"
+	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/domain/secretbackend"
@@ -24,11 +25,15 @@ import (
 	"github.com/juju/juju/rpc/params"
 )
 
+var modelUUID = model.UUID(coretesting.ModelTag.Id())
+
 type SecretsSuite struct {
 	testhelpers.IsolationSuite
 
 	authorizer         *facademocks.MockAuthorizer
 	mockBackendService *MockSecretBackendService
+	mockModelService   *MockModelService
+	mockSecretService  *MockSecretService
 }
 
 func TestSecretsSuite(t *testing.T) {
@@ -41,7 +46,11 @@ func (s *SecretsSuite) setup(c *tc.C) (*SecretBackendsAPI, *gomock.Controller) {
 	s.authorizer = facademocks.NewMockAuthorizer(ctrl)
 	s.authorizer.EXPECT().AuthClient().Return(true)
 	s.mockBackendService = NewMockSecretBackendService(ctrl)
-	api, err := NewTestAPI(s.authorizer, s.mockBackendService)
+	s.mockModelService = NewMockModelService(ctrl)
+	s.mockSecretService = NewMockSecretService(ctrl)
+	api, err := NewTestAPI(s.authorizer, s.mockBackendService, s.mockModelService, map[model.UUID]SecretService{
+		modelUUID: s.mockSecretService,
+	})
 	c.Assert(err, tc.ErrorIsNil)
 	return api, ctrl
 }
@@ -335,3 +344,44 @@ func (s *SecretsSuite) TestRemoveSecretBackendsInUse(c *tc.C) {
 			Message: `deleting in use secret backend not supported`}},
 	})
 }
+
+func (s *SecretsSuite) TestRotateSecretEncryptionKey(c *tc.C) {
+	facade, ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+	gomock.InOrder(
+		s.mockBackendService.EXPECT().RotateKeyEncryptionKey(gomock.Any()).Return(nil),
+		s.mockModelService.EXPECT().GetModelUUIDs(gomock.Any()).Return([]model.UUID{modelUUID}, nil),
+		s.mockSecretService.EXPECT().RewrapSecretDataKeys(gomock.Any()).Return(nil),
+	)
+
+	result, err := facade.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error, tc.IsNil)
+}
+
+func (s *SecretsSuite) TestRotateSecretEncryptionKeyRewrapFailure(c *tc.C) {
+	facade, ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+	s.mockBackendService.EXPECT().RotateKeyEncryptionKey(gomock.Any()).Return(nil)
+	s.mockModelService.EXPECT().GetModelUUIDs(gomock.Any()).Return([]model.UUID{modelUUID}, nil)
+	s.mockSecretService.EXPECT().RewrapSecretDataKeys(gomock.Any()).Return(errors.New("boom"))
+
+	result, err := facade.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error, tc.ErrorMatches, `re-wrapping secret data keys: model ".*": boom`)
+}
+
+func (s *SecretsSuite) TestRotateSecretEncryptionKeyPermissionDenied(c *tc.C) {
+	facade, ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+
+	_, err := facade.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
@@ -6,6 +6,7 @@ package secretbackends
 import (
 	"context"
 
+	"github.com/juju/juju/core/model"
 	coresecrets "github.com/juju/juju/core/secrets"
 	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
 )
@@ -16,4 +17,16 @@ type SecretBackendService interface {
 	UpdateSecretBackend(context.Context, secretbackendservice.UpdateSecretBackendParams) error
 	DeleteSecretBackend(context.Context, secretbackendservice.DeleteSecretBackendParams) error
 	BackendSummaryInfo(ctx context.Context, reveal bool, names ...string) ([]*secretbackendservice.SecretBackendInfo, error)
+	RotateKeyEncryptionKey(context.Context) error
+}
+
+// ModelService is an interface for listing the models on the controller.
+type ModelService interface {
+	GetModelUUIDs(context.Context) ([]model.UUID, error)
+}
+
+// SecretService is an interface for managing the encryption of a model's
+// secret content.
+type SecretService interface {
+	RewrapSecretDataKeys(context.Context) error
 }
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/secretbackends (interfaces: SecretBackendService)
+// Source: github.com/juju/juju/apiserver/facades/client/secretbackends (interfaces: SecretBackendService,ModelService,SecretService)
 //
 // Generated by this command:
 //
-//	mockgen -package secretbackends -destination service_mocks_test.go github.com/juju/juju/apiserver/facades/client/secretbackends SecretBackendService
+//	mockgen -package secretbackends -destination service_mocks_test.go github.com/juju/juju/apiserver/facades/client/secretbackends SecretBackendService,ModelService,SecretService
 //
 
 // Package secretbackends is a generated GoMock package.
@@ -13,6 +13,7 @@ import (
 	context "context"
 
 	gomock "github.com/canonical/gomock/gomock"
+	model "github.com/juju/juju/core/model"
 	secrets "github.com/juju/juju/core/secrets"
 	service "github.com/juju/juju/domain/secretbackend/service"
 )
@@ -26,11 +27,12 @@ type MockSecretBackendService struct {
 
 // MockSecretBackendServiceMockRecorder is the mock recorder for MockSecretBackendService.
 type MockSecretBackendServiceMockRecorder struct {
-	mock                       *MockSecretBackendService
-	backendSummaryInfoExpects  []*gomock.Call2V_2[context.Context, bool, string, []*service.SecretBackendInfo, error]
-	createSecretBackendExpects []*gomock.Call2_1[context.Context, secrets.SecretBackend, error]
-	deleteSecretBackendExpects []*gomock.Call2_1[context.Context, service.DeleteSecretBackendParams, error]
-	updateSecretBackendExpects []*gomock.Call2_1[context.Context, service.UpdateSecretBackendParams, error]
+	mock                          *MockSecretBackendService
+	backendSummaryInfoExpects     []*gomock.Call2V_2[context.Context, bool, string, []*service.SecretBackendInfo, error]
+	createSecretBackendExpects    []*gomock.Call2_1[context.Context, secrets.SecretBackend, error]
+	deleteSecretBackendExpects    []*gomock.Call2_1[context.Context, service.DeleteSecretBackendParams, error]
+	rotateKeyEncryptionKeyExpects []*gomock.Call1_1[context.Context, error]
+	updateSecretBackendExpects    []*gomock.Call2_1[context.Context, service.UpdateSecretBackendParams, error]
 }
 
 // NewMockSecretBackendService creates a new mock instance.
@@ -100,6 +102,24 @@ func (mr *MockSecretBackendServiceMockRecorder) DeleteSecretBackend(arg0, arg1 a
 // MockSecretBackendServiceDeleteSecretBackendCall is the typed call wrapper for DeleteSecretBackend.
 type MockSecretBackendServiceDeleteSecretBackendCall = gomock.Call2_1[context.Context, service.DeleteSecretBackendParams, error]
 
+// RotateKeyEncryptionKey mocks base method.
+func (m *MockSecretBackendService) RotateKeyEncryptionKey(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_1(&m.recorder.rotateKeyEncryptionKeyExpects, m.ctrl, m, "RotateKeyEncryptionKey", arg0)
+}
+
+// RotateKeyEncryptionKey indicates an expected call of RotateKeyEncryptionKey.
+func (mr *MockSecretBackendServiceMockRecorder) RotateKeyEncryptionKey(arg0 any) *MockSecretBackendServiceRotateKeyEncryptionKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_1[context.Context, error](mr.mock.ctrl.T, mr.mock, "RotateKeyEncryptionKey", gomock.EnsureMatcher(arg0))
+	mr.rotateKeyEncryptionKeyExpects = append(mr.rotateKeyEncryptionKeyExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretBackendServiceRotateKeyEncryptionKeyCall is the typed call wrapper for RotateKeyEncryptionKey.
+type MockSecretBackendServiceRotateKeyEncryptionKeyCall = gomock.Call1_1[context.Context, error]
+
 // UpdateSecretBackend mocks base method.
 func (m *MockSecretBackendService) UpdateSecretBackend(arg0 context.Context, arg1 service.UpdateSecretBackendParams) error {
 	m.ctrl.T.Helper()
@@ -117,3 +137,89 @@ func (mr *MockSecretBackendServiceMockRecorder) UpdateSecretBackend(arg0, arg1 a
 
 // MockSecretBackendServiceUpdateSecretBackendCall is the typed call wrapper for UpdateSecretBackend.
 type MockSecretBackendServiceUpdateSecretBackendCall = gomock.Call2_1[context.Context, service.UpdateSecretBackendParams, error]
+
+// MockModelService is a mock of ModelService interface.
+type MockModelService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockModelServiceMockRecorder is the mock recorder for MockModelService.
+type MockModelServiceMockRecorder struct {
+	mock                 *MockModelService
+	getModelUUIDsExpects []*gomock.Call1_2[context.Context, []model.UUID, error]
+}
+
+// NewMockModelService creates a new mock instance.
+func NewMockModelService(ctrl *gomock.Controller) *MockModelService {
+	mock := &MockModelService{ctrl: ctrl}
+	mock.recorder = &MockModelServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelService) EXPECT() *MockModelServiceMockRecorder {
+	return m.recorder
+}
+
+// GetModelUUIDs mocks base method.
+func (m *MockModelService) GetModelUUIDs(arg0 context.Context) ([]model.UUID, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getModelUUIDsExpects, m.ctrl, m, "GetModelUUIDs", arg0)
+}
+
+// GetModelUUIDs indicates an expected call of GetModelUUIDs.
+func (mr *MockModelServiceMockRecorder) GetModelUUIDs(arg0 any) *MockModelServiceGetModelUUIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []model.UUID, error](mr.mock.ctrl.T, mr.mock, "GetModelUUIDs", gomock.EnsureMatcher(arg0))
+	mr.getModelUUIDsExpects = append(mr.getModelUUIDsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelServiceGetModelUUIDsCall is the typed call wrapper for GetModelUUIDs.
+type MockModelServiceGetModelUUIDsCall = gomock.Call1_2[context.Context, []model.UUID, error]
+
+// MockSecretService is a mock of SecretService interface.
+type MockSecretService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSecretServiceMockRecorder is the mock recorder for MockSecretService.
+type MockSecretServiceMockRecorder struct {
+	mock                        *MockSecretService
+	rewrapSecretDataKeysExpects []*gomock.Call1_1[context.Context, error]
+}
+
+// NewMockSecretService creates a new mock instance.
+func NewMockSecretService(ctrl *gomock.Controller) *MockSecretService {
+	mock := &MockSecretService{ctrl: ctrl}
+	mock.recorder = &MockSecretServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretService) EXPECT() *MockSecretServiceMockRecorder {
+	return m.recorder
+}
+
+// RewrapSecretDataKeys mocks base method.
+func (m *MockSecretService) RewrapSecretDataKeys(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_1(&m.recorder.rewrapSecretDataKeysExpects, m.ctrl, m, "RewrapSecretDataKeys", arg0)
+}
+
+// RewrapSecretDataKeys indicates an expected call of RewrapSecretDataKeys.
+func (mr *MockSecretServiceMockRecorder) RewrapSecretDataKeys(arg0 any) *MockSecretServiceRewrapSecretDataKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_1[context.Context, error](mr.mock.ctrl.T, mr.mock, "RewrapSecretDataKeys", gomock.EnsureMatcher(arg0))
+	mr.rewrapSecretDataKeysExpects = append(mr.rewrapSecretDataKeysExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretServiceRewrapSecretDataKeysCall is the typed call wrapper for RewrapSecretDataKeys.
+type MockSecretServiceRewrapSecretDataKeysCall = gomock.Call1_1[context.Context, error]
@@ -30,6 +30,7 @@ type MockSecretService struct {
 // MockSecretServiceMockRecorder is the mock recorder for MockSecretService.
 type MockSecretServiceMockRecorder struct {
 	mock                               *MockSecretService
+	createExternalUserSecretExpects    []*gomock.Call3_1[context.Context, *secrets.URI, service.CreateExternalUserSecretParams, error]
 	createUserSecretExpects            []*gomock.Call3_1[context.Context, *secrets.URI, service.CreateUserSecretParams, error]
 	deleteSecretExpects                []*gomock.Call3_1[context.Context, *secrets.URI, secret.DeleteSecretParams, error]
 	getSecretContentFromBackendExpects []*gomock.Call3_2[context.Context, *secrets.URI, int, secrets.SecretValue, error]
@@ -37,7 +38,9 @@ type MockSecretServiceMockRecorder struct {
 	getUserSecretURIByLabelExpects     []*gomock.Call2_2[context.Context, string, *secrets.URI, error]
 	grantSecretAccessExpects           []*gomock.Call3_1[context.Context, *secrets.URI, secret.SecretAccessParams, error]
 	listCharmSecretsExpects            []*gomock.Call1V_3[context.Context, secret.CharmSecretOwner, []*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error]
+	listSecretAccessExpects            []*gomock.Call2_2[context.Context, *secrets.URI, []secret.SecretAccessRecord, error]
 	listSecretsExpects                 []*gomock.Call4_3[context.Context, *secrets.URI, *int, secret.Labels, []*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error]
+	recordSecretAccessExpects          []*gomock.Call4_1[context.Context, *secrets.URI, int, secret.SecretAccessor, error]
 	revokeSecretAccessExpects          []*gomock.Call3_1[context.Context, *secrets.URI, secret.SecretAccessParams, error]
 	updateUserSecretExpects            []*gomock.Call3_1[context.Context, *secrets.URI, service.UpdateUserSecretParams, error]
 }
@@ -54,6 +57,24 @@ func (m *MockSecretService) EXPECT() *MockSecretServiceMockRecorder {
 	return m.recorder
 }
 
+// CreateExternalUserSecret mocks base method.
+func (m *MockSecretService) CreateExternalUserSecret(arg0 context.Context, arg1 *secrets.URI, arg2 service.CreateExternalUserSecretParams) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.createExternalUserSecretExpects, m.ctrl, m, "CreateExternalUserSecret", arg0, arg1, arg2)
+}
+
+// CreateExternalUserSecret indicates an expected call of CreateExternalUserSecret.
+func (mr *MockSecretServiceMockRecorder) CreateExternalUserSecret(arg0, arg1, arg2 any) *MockSecretServiceCreateExternalUserSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, *secrets.URI, service.CreateExternalUserSecretParams, error](mr.mock.ctrl.T, mr.mock, "CreateExternalUserSecret", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1), gomock.EnsureMatcher(arg2))
+	mr.createExternalUserSecretExpects = append(mr.createExternalUserSecretExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretServiceCreateExternalUserSecretCall is the typed call wrapper for CreateExternalUserSecret.
+type MockSecretServiceCreateExternalUserSecretCall = gomock.Call3_1[context.Context, *secrets.URI, service.CreateExternalUserSecretParams, error]
+
 // CreateUserSecret mocks base method.
 func (m *MockSecretService) CreateUserSecret(arg0 context.Context, arg1 *secrets.URI, arg2 service.CreateUserSecretParams) error {
 	m.ctrl.T.Helper()
@@ -181,6 +202,24 @@ func (mr *MockSecretServiceMockRecorder) ListCharmSecrets(ctx any, owners ...any
 // MockSecretServiceListCharmSecretsCall is the typed call wrapper for ListCharmSecrets.
 type MockSecretServiceListCharmSecretsCall = gomock.Call1V_3[context.Context, secret.CharmSecretOwner, []*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error]
 
+// ListSecretAccess mocks base method.
+func (m *MockSecretService) ListSecretAccess(ctx context.Context, uri *secrets.URI) ([]secret.SecretAccessRecord, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.listSecretAccessExpects, m.ctrl, m, "ListSecretAccess", ctx, uri)
+}
+
+// ListSecretAccess indicates an expected call of ListSecretAccess.
+func (mr *MockSecretServiceMockRecorder) ListSecretAccess(ctx, uri any) *MockSecretServiceListSecretAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, *secrets.URI, []secret.SecretAccessRecord, error](mr.mock.ctrl.T, mr.mock, "ListSecretAccess", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(uri))
+	mr.listSecretAccessExpects = append(mr.listSecretAccessExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretServiceListSecretAccessCall is the typed call wrapper for ListSecretAccess.
+type MockSecretServiceListSecretAccessCall = gomock.Call2_2[context.Context, *secrets.URI, []secret.SecretAccessRecord, error]
+
 // ListSecrets mocks base method.
 func (m *MockSecretService) ListSecrets(ctx context.Context, uri *secrets.URI, revision *int, labels secret.Labels) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error) {
 	m.ctrl.T.Helper()
@@ -199,6 +238,24 @@ func (mr *MockSecretServiceMockRecorder) ListSecrets(ctx, uri, revision, labels
 // MockSecretServiceListSecretsCall is the typed call wrapper for ListSecrets.
 type MockSecretServiceListSecretsCall = gomock.Call4_3[context.Context, *secrets.URI, *int, secret.Labels, []*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error]
 
+// RecordSecretAccess mocks base method.
+func (m *MockSecretService) RecordSecretAccess(ctx context.Context, uri *secrets.URI, rev int, accessor secret.SecretAccessor) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_1(&m.recorder.recordSecretAccessExpects, m.ctrl, m, "RecordSecretAccess", ctx, uri, rev, accessor)
+}
+
+// RecordSecretAccess indicates an expected call of RecordSecretAccess.
+func (mr *MockSecretServiceMockRecorder) RecordSecretAccess(ctx, uri, rev, accessor any) *MockSecretServiceRecordSecretAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_1[context.Context, *secrets.URI, int, secret.SecretAccessor, error](mr.mock.ctrl.T, mr.mock, "RecordSecretAccess", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(uri), gomock.EnsureMatcher(rev), gomock.EnsureMatcher(accessor))
+	mr.recordSecretAccessExpects = append(mr.recordSecretAccessExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretServiceRecordSecretAccessCall is the typed call wrapper for RecordSecretAccess.
+type MockSecretServiceRecordSecretAccessCall = gomock.Call4_1[context.Context, *secrets.URI, int, secret.SecretAccessor, error]
+
 // RevokeSecretAccess mocks base method.
 func (m *MockSecretService) RevokeSecretAccess(ctx context.Context, uri *secrets.URI, p secret.SecretAccessParams) error {
 	m.ctrl.T.Helper()
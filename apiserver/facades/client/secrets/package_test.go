@@ -8,6 +8,7 @@ import (
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	internallogger "github.com/juju/juju/internal/logger"
 	coretesting "github.com/juju/juju/internal/testing"
 )
 
@@ -30,6 +31,7 @@ func NewTestAPI(
 		controllerUUID:       coretesting.ControllerTag.Id(),
 		modelUUID:            coretesting.ModelTag.Id(),
 		modelName:            modelName,
+		logger:               internallogger.GetLogger("juju.apiserver.secrets"),
 		secretService:        secretService,
 		secretBackendService: secretBackendService,
 	}, nil
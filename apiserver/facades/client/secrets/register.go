@@ -17,8 +17,17 @@ import (
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Secrets", 1, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newSecretsAPIV1(stdCtx, ctx)
-	}, reflect.TypeFor[*SecretsAPI]())
+	}, reflect.TypeFor[*SecretsAPIV1]())
 	registry.MustRegister("Secrets", 2, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newSecretsAPIV2(stdCtx, ctx)
+	}, reflect.TypeFor[*SecretsAPIV2]())
+	registry.MustRegister("Secrets", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newSecretsAPIV3(stdCtx, ctx)
+	}, reflect.TypeFor[*SecretsAPIV3]())
+	registry.MustRegister("Secrets", 4, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newSecretsAPIV4(stdCtx, ctx)
+	}, reflect.TypeFor[*SecretsAPIV4]())
+	registry.MustRegister("Secrets", 5, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newSecretsAPI(stdCtx, ctx)
 	}, reflect.TypeFor[*SecretsAPI]())
 }
@@ -28,7 +37,31 @@ func newSecretsAPIV1(stdCtx context.Context, context facade.ModelContext) (*Secr
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &SecretsAPIV1{SecretsAPI: api}, nil
+	return &SecretsAPIV1{SecretsAPIV2: &SecretsAPIV2{SecretsAPIV3: &SecretsAPIV3{SecretsAPIV4: &SecretsAPIV4{SecretsAPI: api}}}}, nil
+}
+
+func newSecretsAPIV2(stdCtx context.Context, context facade.ModelContext) (*SecretsAPIV2, error) {
+	api, err := newSecretsAPI(stdCtx, context)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &SecretsAPIV2{SecretsAPIV3: &SecretsAPIV3{SecretsAPIV4: &SecretsAPIV4{SecretsAPI: api}}}, nil
+}
+
+func newSecretsAPIV3(stdCtx context.Context, context facade.ModelContext) (*SecretsAPIV3, error) {
+	api, err := newSecretsAPI(stdCtx, context)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &SecretsAPIV3{SecretsAPIV4: &SecretsAPIV4{SecretsAPI: api}}, nil
+}
+
+func newSecretsAPIV4(stdCtx context.Context, context facade.ModelContext) (*SecretsAPIV4, error) {
+	api, err := newSecretsAPI(stdCtx, context)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &SecretsAPIV4{SecretsAPI: api}, nil
 }
 
 // newSecretsAPI creates a SecretsAPI.
@@ -54,6 +87,7 @@ func newSecretsAPI(stdCtx context.Context, ctx facade.ModelContext) (*SecretsAPI
 		controllerUUID:       ctx.ControllerUUID(),
 		modelUUID:            ctx.ModelUUID().String(),
 		modelName:            modelInfo.Name,
+		logger:               ctx.Logger().Child("secrets"),
 		secretService:        secretService,
 		secretBackendService: backendService,
 	}, nil
@@ -13,6 +13,7 @@ import (
 	commonsecrets "github.com/juju/juju/apiserver/common/secrets"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	corelogger "github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/permission"
 	coresecrets "github.com/juju/juju/core/secrets"
 	domainsecret "github.com/juju/juju/domain/secret"
@@ -30,14 +31,30 @@ type SecretsAPI struct {
 	controllerUUID string
 	modelUUID      string
 	modelName      string
+	logger         corelogger.Logger
 
 	secretBackendService SecretBackendService
 	secretService        SecretService
 }
 
+// SecretsAPIV4 is the backend for the Secrets facade v4.
+type SecretsAPIV4 struct {
+	*SecretsAPI
+}
+
+// SecretsAPIV3 is the backend for the Secrets facade v3.
+type SecretsAPIV3 struct {
+	*SecretsAPIV4
+}
+
+// SecretsAPIV2 is the backend for the Secrets facade v2.
+type SecretsAPIV2 struct {
+	*SecretsAPIV3
+}
+
 // SecretsAPIV1 is the backend for the Secrets facade v1.
 type SecretsAPIV1 struct {
-	*SecretsAPI
+	*SecretsAPIV2
 }
 
 func (s *SecretsAPI) checkCanRead(ctx context.Context) error {
@@ -190,6 +207,7 @@ func (s *SecretsAPI) ListSecrets(ctx context.Context, arg params.ListSecretsArgs
 			}
 			if err == nil {
 				valueResult.Data = val.EncodedValues()
+				s.recordReveal(ctx, m.URI, rev)
 			}
 			secretResult.Value = valueResult
 		}
@@ -198,6 +216,65 @@ func (s *SecretsAPI) ListSecrets(ctx context.Context, arg params.ListSecretsArgs
 	return result, nil
 }
 
+// recordReveal records in the secret access log that the authenticated
+// user has read the content of the specified secret revision.
+func (s *SecretsAPI) recordReveal(ctx context.Context, uri *coresecrets.URI, rev int) {
+	accessor := domainsecret.SecretAccessor{
+		Kind: domainsecret.UserAccessor,
+		ID:   s.authTag.Id(),
+	}
+	if err := s.secretService.RecordSecretAccess(ctx, uri, rev, accessor); err != nil {
+		s.logger.Warningf(ctx, "recording access to secret %q revision %d: %v", uri, rev, err)
+	}
+}
+
+// ListSecretAccess isn't on the v4 API.
+func (s *SecretsAPIV4) ListSecretAccess(_ context.Context, _ struct{}) {}
+
+// ListSecretAccess returns the access log for the specified secrets,
+// recording which units, applications and users have read the content
+// of each revision, and when.
+func (s *SecretsAPI) ListSecretAccess(ctx context.Context, args params.SecretURIArgs) (params.SecretAccessLogResults, error) {
+	result := params.SecretAccessLogResults{
+		Results: make([]params.SecretAccessLogResult, len(args.Args)),
+	}
+	if err := s.checkCanAdmin(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, arg := range args.Args {
+		access, err := s.listSecretAccess(ctx, arg.URI)
+		result.Results[i].Access = access
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
+func (s *SecretsAPI) listSecretAccess(ctx context.Context, uriStr string) ([]params.SecretAccessRecord, error) {
+	uri, err := coresecrets.ParseURI(uriStr)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	records, err := s.secretService.ListSecretAccess(ctx, uri)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]params.SecretAccessRecord, len(records))
+	for i, r := range records {
+		accessorTag, err := tagFromSubject(r.Accessor)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		result[i] = params.SecretAccessRecord{
+			Revision:        r.Revision,
+			AccessorTag:     accessorTag.String(),
+			FirstAccessTime: r.FirstAccessTime,
+			LastAccessTime:  r.LastAccessTime,
+			AccessCount:     r.AccessCount,
+		}
+	}
+	return result, nil
+}
+
 func tagFromSubject(access domainsecret.SecretAccessor) (names.Tag, error) {
 	switch kind := access.Kind; kind {
 	case domainsecret.UnitAccessor:
@@ -206,6 +283,8 @@ func tagFromSubject(access domainsecret.SecretAccessor) (names.Tag, error) {
 		return names.NewApplicationTag(access.ID), nil
 	case domainsecret.ModelAccessor:
 		return names.NewModelTag(access.ID), nil
+	case domainsecret.UserAccessor:
+		return names.NewUserTag(access.ID), nil
 	default:
 		return nil, errors.NotValidf("subject kind %q", kind)
 	}
@@ -287,6 +366,107 @@ func fromUpsertParams(modelUUID string, autoPrune *bool, p params.UpsertSecretAr
 	}
 }
 
+// CreateExternalSecrets isn't on the v2 API.
+func (s *SecretsAPIV2) CreateExternalSecrets(_ context.Context, _ struct{}) {}
+
+// CreateExternalSecrets creates new secrets whose content is managed
+// outside of Juju, in a secret backend configured for the model. The
+// content is read with the backend's admin credentials, so only model
+// admins may reference it.
+func (s *SecretsAPI) CreateExternalSecrets(ctx context.Context, args params.CreateExternalSecretArgs) (params.StringResults, error) {
+	result := params.StringResults{
+		Results: make([]params.StringResult, len(args.Args)),
+	}
+	if err := s.checkCanAdmin(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, arg := range args.Args {
+		id, err := s.createExternalSecret(ctx, arg)
+		result.Results[i].Result = id
+		if errors.Is(err, secreterrors.SecretLabelAlreadyExists) {
+			err = errors.AlreadyExistsf("secret with name %q", *arg.Label)
+		}
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
+func (s *SecretsAPI) createExternalSecret(ctx context.Context, arg params.CreateExternalSecretArg) (string, error) {
+	if arg.OwnerTag != "" && arg.OwnerTag != s.modelUUID {
+		return "", errors.NotValidf("owner tag %q", arg.OwnerTag)
+	}
+	if arg.BackendName == "" {
+		return "", errors.NotValidf("empty secret backend name")
+	}
+	if arg.Path == "" {
+		return "", errors.NotValidf("empty external secret path")
+	}
+
+	uri := coresecrets.NewURI()
+	err := s.secretService.CreateExternalUserSecret(ctx, uri, secretservice.CreateExternalUserSecretParams{
+		Version:     secrets.Version,
+		Description: arg.Description,
+		Label:       arg.Label,
+		BackendName: arg.BackendName,
+		Path:        arg.Path,
+		Key:         arg.Key,
+	})
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return uri.String(), nil
+}
+
+// CreateGeneratedSecrets isn't on the v3 API.
+func (s *SecretsAPIV3) CreateGeneratedSecrets(_ context.Context, _ struct{}) {}
+
+// CreateGeneratedSecrets creates new secrets whose content is generated
+// by the controller, and optionally regenerated on a rotation schedule.
+func (s *SecretsAPI) CreateGeneratedSecrets(ctx context.Context, args params.CreateGeneratedSecretArgs) (params.StringResults, error) {
+	result := params.StringResults{
+		Results: make([]params.StringResult, len(args.Args)),
+	}
+	if err := s.checkCanWrite(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, arg := range args.Args {
+		id, err := s.createGeneratedSecret(ctx, arg)
+		result.Results[i].Result = id
+		if errors.Is(err, secreterrors.SecretLabelAlreadyExists) {
+			err = errors.AlreadyExistsf("secret with name %q", *arg.Label)
+		}
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
+func (s *SecretsAPI) createGeneratedSecret(ctx context.Context, arg params.CreateGeneratedSecretArg) (string, error) {
+	if arg.OwnerTag != "" && arg.OwnerTag != s.modelUUID {
+		return "", errors.NotValidf("owner tag %q", arg.OwnerTag)
+	}
+	spec := coresecrets.GeneratorSpec{
+		Format:  coresecrets.GeneratorFormat(arg.Generator.Format),
+		Length:  arg.Generator.Length,
+		Charset: arg.Generator.Charset,
+	}
+
+	uri := coresecrets.NewURI()
+	err := s.secretService.CreateUserSecret(ctx, uri, secretservice.CreateUserSecretParams{
+		Version: secrets.Version,
+		UpdateUserSecretParams: secretservice.UpdateUserSecretParams{
+			Accessor:    domainsecret.SecretAccessor{Kind: domainsecret.ModelAccessor, ID: s.modelUUID},
+			Description: arg.Description,
+			Label:       arg.Label,
+		},
+		Generator:    &spec,
+		RotatePolicy: arg.RotatePolicy,
+	})
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return uri.String(), nil
+}
+
 // UpdateSecrets isn't on the v1 API.
 func (s *SecretsAPIV1) UpdateSecrets(ctx context.Context, _ struct{}) {}
 
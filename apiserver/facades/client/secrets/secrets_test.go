@@ -252,6 +252,10 @@ func (s *SecretsSuite) assertListSecrets(c *tc.C, reveal bool) {
 		s.secretService.EXPECT().GetSecretContentFromBackend(gomock.Any(), uri, 2).Return(
 			coresecrets.NewSecretValue(valueResult.Data), nil,
 		)
+		s.secretService.EXPECT().RecordSecretAccess(gomock.Any(), uri, 2, secret.SecretAccessor{
+			Kind: secret.UserAccessor,
+			ID:   "foo",
+		}).Return(nil)
 	}
 
 	results, err := facade.ListSecrets(c.Context(), params.ListSecretsArgs{ShowSecrets: reveal})
@@ -413,6 +417,241 @@ func (s *SecretsSuite) TestCreateSecrets(c *tc.C) {
 	c.Assert(result.Results[0].Result, tc.NotZero)
 }
 
+func (s *SecretsSuite) TestCreateExternalSecrets(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+
+	s.secretService.EXPECT().CreateExternalUserSecret(gomock.Any(), gomock.Any(), secretservice.CreateExternalUserSecretParams{
+		Version:     1,
+		Description: new("this is an external secret."),
+		Label:       new("label"),
+		BackendName: "myvault",
+		Path:        "kv/app/db",
+		Key:         "password",
+	}).Return(nil)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := facade.CreateExternalSecrets(c.Context(), params.CreateExternalSecretArgs{
+		Args: []params.CreateExternalSecretArg{
+			{
+				OwnerTag:    coretesting.ModelTag.Id(),
+				Description: new("this is an external secret."),
+				Label:       new("label"),
+				BackendName: "myvault",
+				Path:        "kv/app/db",
+				Key:         "password",
+			},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results[0].Error, tc.IsNil)
+	_, err = coresecrets.ParseURI(result.Results[0].Result)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *SecretsSuite) TestCreateExternalSecretsLabelExists(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+
+	s.secretService.EXPECT().CreateExternalUserSecret(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(secreterrors.SecretLabelAlreadyExists)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := facade.CreateExternalSecrets(c.Context(), params.CreateExternalSecretArgs{
+		Args: []params.CreateExternalSecretArg{{
+			Label:       new("label"),
+			BackendName: "myvault",
+			Path:        "kv/app/db",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results[0].Error, tc.Satisfies, params.IsCodeAlreadyExists)
+}
+
+func (s *SecretsSuite) TestCreateExternalSecretsEmptyPath(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := facade.CreateExternalSecrets(c.Context(), params.CreateExternalSecretArgs{
+		Args: []params.CreateExternalSecretArg{{
+			BackendName: "myvault",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results[0].Error, tc.ErrorMatches, "empty external secret path not valid")
+}
+
+func (s *SecretsSuite) TestCreateExternalSecretsPermissionDenied(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, coretesting.ModelTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = facade.CreateExternalSecrets(c.Context(), params.CreateExternalSecretArgs{})
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
+
+func (s *SecretsSuite) TestCreateGeneratedSecrets(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, coretesting.ModelTag).Return(nil)
+
+	s.secretService.EXPECT().CreateUserSecret(gomock.Any(), gomock.Any(), secretservice.CreateUserSecretParams{
+		Version: 1,
+		UpdateUserSecretParams: secretservice.UpdateUserSecretParams{
+			Accessor: secret.SecretAccessor{
+				Kind: secret.ModelAccessor,
+				ID:   coretesting.ModelTag.Id(),
+			},
+			Description: new("this is a generated secret."),
+			Label:       new("label"),
+		},
+		Generator: &coresecrets.GeneratorSpec{
+			Format:  coresecrets.GeneratePassword,
+			Length:  20,
+			Charset: "abc123",
+		},
+		RotatePolicy: new(coresecrets.RotateWeekly),
+	}).Return(nil)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := facade.CreateGeneratedSecrets(c.Context(), params.CreateGeneratedSecretArgs{
+		Args: []params.CreateGeneratedSecretArg{{
+			OwnerTag:    coretesting.ModelTag.Id(),
+			Description: new("this is a generated secret."),
+			Label:       new("label"),
+			Generator: params.SecretGeneratorSpec{
+				Format:  "password",
+				Length:  20,
+				Charset: "abc123",
+			},
+			RotatePolicy: new(coresecrets.RotateWeekly),
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results[0].Error, tc.IsNil)
+	_, err = coresecrets.ParseURI(result.Results[0].Result)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *SecretsSuite) TestCreateGeneratedSecretsLabelExists(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, coretesting.ModelTag).Return(nil)
+
+	s.secretService.EXPECT().CreateUserSecret(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(secreterrors.SecretLabelAlreadyExists)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := facade.CreateGeneratedSecrets(c.Context(), params.CreateGeneratedSecretArgs{
+		Args: []params.CreateGeneratedSecretArg{{
+			Label:     new("label"),
+			Generator: params.SecretGeneratorSpec{Format: "hex"},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results[0].Error, tc.Satisfies, params.IsCodeAlreadyExists)
+}
+
+func (s *SecretsSuite) TestCreateGeneratedSecretsPermissionDenied(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, coretesting.ModelTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = facade.CreateGeneratedSecrets(c.Context(), params.CreateGeneratedSecretArgs{})
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
+
+func (s *SecretsSuite) TestListSecretAccess(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+
+	now := time.Now()
+	uri := coresecrets.NewURI()
+	s.secretService.EXPECT().ListSecretAccess(gomock.Any(), uri).Return([]secret.SecretAccessRecord{{
+		Revision:        2,
+		Accessor:        secret.SecretAccessor{Kind: secret.UnitAccessor, ID: "gitlab/0"},
+		FirstAccessTime: now.Add(-time.Hour),
+		LastAccessTime:  now,
+		AccessCount:     3,
+	}, {
+		Revision:        1,
+		Accessor:        secret.SecretAccessor{Kind: secret.UserAccessor, ID: "fred"},
+		FirstAccessTime: now.Add(-2 * time.Hour),
+		LastAccessTime:  now.Add(-2 * time.Hour),
+		AccessCount:     1,
+	}}, nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := facade.ListSecretAccess(c.Context(), params.SecretURIArgs{
+		Args: []params.SecretURIArg{{URI: uri.String()}, {URI: "bad"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 2)
+	c.Check(result.Results[0], tc.DeepEquals, params.SecretAccessLogResult{
+		Access: []params.SecretAccessRecord{{
+			Revision:        2,
+			AccessorTag:     "unit-gitlab-0",
+			FirstAccessTime: now.Add(-time.Hour),
+			LastAccessTime:  now,
+			AccessCount:     3,
+		}, {
+			Revision:        1,
+			AccessorTag:     "user-fred",
+			FirstAccessTime: now.Add(-2 * time.Hour),
+			LastAccessTime:  now.Add(-2 * time.Hour),
+			AccessCount:     1,
+		}},
+	})
+	c.Check(result.Results[1].Error, tc.ErrorMatches, `secret URI "bad" not valid`)
+}
+
+func (s *SecretsSuite) TestListSecretAccessPermissionDenied(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, coretesting.ModelTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, s.modelName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = facade.ListSecretAccess(c.Context(), params.SecretURIArgs{})
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
+
 func (s *SecretsSuite) assertUpdateSecrets(c *tc.C, uri *coresecrets.URI) {
 	defer s.setup(c).Finish()
 
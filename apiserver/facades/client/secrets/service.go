@@ -19,6 +19,7 @@ type SecretService interface {
 
 	CreateUserSecret(context.Context, *secrets.URI, secretservice.CreateUserSecretParams) error
 	UpdateUserSecret(context.Context, *secrets.URI, secretservice.UpdateUserSecretParams) error
+	CreateExternalUserSecret(context.Context, *secrets.URI, secretservice.CreateExternalUserSecretParams) error
 
 	// View and fetch secrets.
 
@@ -30,6 +31,11 @@ type SecretService interface {
 	) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error)
 	ListCharmSecrets(ctx context.Context, owners ...domainsecret.CharmSecretOwner) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error)
 
+	// Record and list reads of secret content.
+
+	RecordSecretAccess(ctx context.Context, uri *secrets.URI, rev int, accessor domainsecret.SecretAccessor) error
+	ListSecretAccess(ctx context.Context, uri *secrets.URI) ([]domainsecret.SecretAccessRecord, error)
+
 	// Delete secrets.
 
 	DeleteSecret(ctx context.Context, uri *secrets.URI, params domainsecret.DeleteSecretParams) error
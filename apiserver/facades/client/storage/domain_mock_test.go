@@ -420,6 +420,7 @@ type MockStorageService struct {
 type MockStorageServiceMockRecorder struct {
 	mock                                                     *MockStorageService
 	adoptFilesystemExpects                                   []*gomock.Call5_2[context.Context, storage0.Name, storage0.StoragePoolUUID, string, bool, storage.ID, error]
+	adoptVolumeExpects                                       []*gomock.Call5_2[context.Context, storage0.Name, storage0.StoragePoolUUID, string, bool, storage.ID, error]
 	createStoragePoolExpects                                 []*gomock.Call4_2[context.Context, string, storage0.ProviderType, map[string]any, storage0.StoragePoolUUID, error]
 	getFilesystemsByMachinesExpects                          []*gomock.Call2_2[context.Context, []machine.UUID, []storage0.FilesystemUUID, error]
 	getStorageAttachmentUUIDForStorageInstanceAndUnitExpects []*gomock.Call3_2[context.Context, storage0.StorageInstanceUUID, unit.UUID, storage0.StorageAttachmentUUID, error]
@@ -464,6 +465,24 @@ func (mr *MockStorageServiceMockRecorder) AdoptFilesystem(ctx, storageName, pool
 // MockStorageServiceAdoptFilesystemCall is the typed call wrapper for AdoptFilesystem.
 type MockStorageServiceAdoptFilesystemCall = gomock.Call5_2[context.Context, storage0.Name, storage0.StoragePoolUUID, string, bool, storage.ID, error]
 
+// AdoptVolume mocks base method.
+func (m *MockStorageService) AdoptVolume(ctx context.Context, storageName storage0.Name, pool storage0.StoragePoolUUID, providerID string, force bool) (storage.ID, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch5_2(&m.recorder.adoptVolumeExpects, m.ctrl, m, "AdoptVolume", ctx, storageName, pool, providerID, force)
+}
+
+// AdoptVolume indicates an expected call of AdoptVolume.
+func (mr *MockStorageServiceMockRecorder) AdoptVolume(ctx, storageName, pool, providerID, force any) *MockStorageServiceAdoptVolumeCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall5_2[context.Context, storage0.Name, storage0.StoragePoolUUID, string, bool, storage.ID, error](mr.mock.ctrl.T, mr.mock, "AdoptVolume", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(storageName), gomock.EnsureMatcher(pool), gomock.EnsureMatcher(providerID), gomock.EnsureMatcher(force))
+	mr.adoptVolumeExpects = append(mr.adoptVolumeExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStorageServiceAdoptVolumeCall is the typed call wrapper for AdoptVolume.
+type MockStorageServiceAdoptVolumeCall = gomock.Call5_2[context.Context, storage0.Name, storage0.StoragePoolUUID, string, bool, storage.ID, error]
+
 // CreateStoragePool mocks base method.
 func (m *MockStorageService) CreateStoragePool(arg0 context.Context, arg1 string, arg2 storage0.ProviderType, arg3 map[string]any) (storage0.StoragePoolUUID, error) {
 	m.ctrl.T.Helper()
@@ -32,6 +32,18 @@ type StorageAdoptionService interface {
 		force bool,
 	) (corestorage.ID, error)
 
+	// AdoptVolume adopts a block volume by invoking the provider of the given
+	// storage pool to identify the volume specified by the provider ID. The
+	// result of this call is the name of a new block storage instance using
+	// the given storage name.
+	AdoptVolume(
+		ctx context.Context,
+		storageName domainstorage.Name,
+		pool domainstorage.StoragePoolUUID,
+		providerID string,
+		force bool,
+	) (corestorage.ID, error)
+
 	// GetStoragePoolUUID returns the UUID of the storage pool for the specified
 	// name.
 	GetStoragePoolUUID(
@@ -69,40 +81,47 @@ func (a *StorageAPI) Import(
 			)
 		}
 
+		var (
+			id   corestorage.ID
+			kind string
+		)
 		switch arg.Kind {
 		case params.StorageKindFilesystem:
-			id, err := a.storageService.AdoptFilesystem(
+			kind = "filesystem"
+			id, err = a.storageService.AdoptFilesystem(
 				ctx,
 				domainstorage.Name(arg.StorageName),
 				poolUUID,
 				arg.ProviderId,
 				arg.Force,
 			)
-			if errors.Is(err, domainstorageerrors.StoragePoolNotFound) {
-				return details, apiservererrors.ParamsErrorf(
-					params.CodeNotFound, "storage pool not found",
-				)
-			} else if errors.Is(err, domainstorageerrors.StorageEntityNotFoundInPool) {
-				return details, apiservererrors.ParamsErrorf(
-					params.CodeNotFound, "storage entity not found in pool",
-				)
-			} else if err != nil {
-				return details, errors.Errorf(
-					"adopting filesystem: %w", err,
-				)
-			}
-			details.StorageTag = names.NewStorageTag(id.String()).String()
 		case params.StorageKindBlock:
-			return details, apiservererrors.ParamsErrorf(
-				params.CodeNotSupported,
-				"adopting block storage is not supported",
+			kind = "volume"
+			id, err = a.storageService.AdoptVolume(
+				ctx,
+				domainstorage.Name(arg.StorageName),
+				poolUUID,
+				arg.ProviderId,
+				arg.Force,
 			)
 		default:
 			return details, apiservererrors.ParamsErrorf(
 				params.CodeNotValid, "invalid storage kind",
 			)
 		}
+		if errors.Is(err, domainstorageerrors.StoragePoolNotFound) {
+			return details, apiservererrors.ParamsErrorf(
+				params.CodeNotFound, "storage pool not found",
+			)
+		} else if errors.Is(err, domainstorageerrors.StorageEntityNotFoundInPool) {
+			return details, apiservererrors.ParamsErrorf(
+				params.CodeNotFound, "storage entity not found in pool",
+			)
+		} else if err != nil {
+			return details, errors.Errorf("adopting %s: %w", kind, err)
+		}
 
+		details.StorageTag = names.NewStorageTag(id.String()).String()
 		return details, nil
 	}
 
@@ -178,6 +178,78 @@ func (s *importSuite) TestImportPooledStorageEntityNotFound(c *tc.C) {
 		"storage entity not found in pool")
 }
 
+// TestImportBlock asserts that importing block storage adopts the volume
+// through the storage service and returns the new storage tag.
+func (s *importSuite) TestImportBlock(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	api := s.makeTestAPIForIAASModel(c)
+
+	spUUID := tc.Must(c, domainstorage.NewStoragePoolUUID)
+	storageID := corestorage.MakeID("pgdata", 11)
+
+	s.storageService.EXPECT().GetStoragePoolUUID(
+		gomock.Any(), "mypool").Return(spUUID, nil)
+	s.storageService.EXPECT().AdoptVolume(
+		gomock.Any(),
+		domainstorage.Name("pgdata"),
+		spUUID, "vol-123", false,
+	).Return(storageID, nil)
+
+	apiArgs := params.BulkImportStorageParamsV2{
+		Storage: []params.ImportStorageParamsV2{
+			{
+				Kind:        params.StorageKindBlock,
+				Pool:        "mypool",
+				ProviderId:  "vol-123",
+				StorageName: "pgdata",
+			},
+		},
+	}
+
+	res, err := api.Import(c.Context(), apiArgs)
+	c.Check(err, tc.IsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Check(res.Results[0].Error, tc.IsNil)
+	c.Check(res.Results[0].Result, tc.DeepEquals, &params.ImportStorageDetails{
+		StorageTag: "storage-pgdata-11",
+	})
+}
+
+// TestImportBlockNotFound asserts that a volume missing from the pool is
+// reported as not found.
+func (s *importSuite) TestImportBlockNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	api := s.makeTestAPIForIAASModel(c)
+
+	spUUID := tc.Must(c, domainstorage.NewStoragePoolUUID)
+
+	s.storageService.EXPECT().GetStoragePoolUUID(
+		gomock.Any(), "mypool").Return(spUUID, nil)
+	s.storageService.EXPECT().AdoptVolume(
+		gomock.Any(),
+		domainstorage.Name("pgdata"),
+		spUUID, "vol-123", false,
+	).Return("", domainstorageerrors.StorageEntityNotFoundInPool)
+
+	apiArgs := params.BulkImportStorageParamsV2{
+		Storage: []params.ImportStorageParamsV2{
+			{
+				Kind:        params.StorageKindBlock,
+				Pool:        "mypool",
+				ProviderId:  "vol-123",
+				StorageName: "pgdata",
+			},
+		},
+	}
+
+	res, err := api.Import(c.Context(), apiArgs)
+	c.Check(err, tc.IsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Check(res.Results[0].Error.Code, tc.Equals, params.CodeNotFound)
+	c.Check(res.Results[0].Error.Message, tc.Equals,
+		"storage entity not found in pool")
+}
+
 type importV6Suite struct {
 	baseStorageSuite
 }
@@ -780,7 +780,7 @@ func validateModelInfo(info params.SerializedModelInfo) error {
 // importModelArgs decodes a v8 wire envelope's controller-scoped semantic
 // fields into their target-portable domain form. It is the inverse of the
 // source side's envelopeFromControllerModelInfo
-// (internal/worker/migrationmaster/envelope.go), and is kept in the apiserver
+// (internal/migration/envelope.go), and is kept in the apiserver
 // facade so internal migration code does not depend on rpc/params.
 func importModelArgs(envelope params.SerializedModelV2, modelDBPayload *latest.ModelExport) migration.ImportModelArgs {
 	info := coremodelmigration.ControllerModelInfo{
@@ -27,6 +27,14 @@ func NewHTTPHandlerForTest(
 	}
 }
 
+func EntityBucketCount(c *tc.C, handler http.Handler) int {
+	h, ok := handler.(*logSinkHandler)
+	c.Assert(ok, tc.Equals, true)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.entityBuckets)
+}
+
 func ReceiverStopped(c *tc.C, handler http.Handler) bool {
 	h, ok := handler.(*logSinkHandler)
 	c.Assert(ok, tc.Equals, true)
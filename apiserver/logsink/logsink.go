@@ -5,6 +5,7 @@ package logsink
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"sync"
 	"time"
@@ -12,6 +13,7 @@ import (
 	gorillaws "github.com/gorilla/websocket"
 	"github.com/juju/clock"
 	"github.com/juju/errors"
+	"github.com/juju/loggo/v3"
 	"github.com/juju/ratelimit"
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -25,6 +27,7 @@ import (
 const (
 	metricLogWriteLabelSuccess = "success"
 	metricLogWriteLabelFailure = "failure"
+	metricLogWriteLabelDropped = "dropped"
 )
 
 const (
@@ -57,6 +60,15 @@ type RateLimitConfig struct {
 	// the initial burst amount has been depleted.
 	Refill time.Duration
 
+	// EntityBurst is the number of log messages an individual entity may
+	// send, across all of its connections, before further messages are
+	// dropped. Zero disables per-entity rate limiting.
+	EntityBurst int64
+
+	// EntityRefill is the rate at which an entity's allowance is restored
+	// once its burst has been depleted.
+	EntityRefill time.Duration
+
 	// Clock is the clock used to wait when rate-limiting log receives.
 	Clock clock.Clock
 }
@@ -142,6 +154,11 @@ type logSinkHandler struct {
 	modelUUID    string
 	mu           sync.Mutex
 
+	// entityBuckets holds the per-entity token buckets, keyed on model
+	// and entity, so that an entity's allowance survives reconnects.
+	// Buckets are evicted once idle; see releaseEntityBucket.
+	entityBuckets map[string]*entityBucket
+
 	// newStopChannel is overridden in tests so that we can check the
 	// goroutine exits when prompted.
 	newStopChannel  func() (chan struct{}, func())
@@ -296,12 +313,50 @@ func (h *logSinkHandler) receiveLogs(
 			ratelimitClock{Clock: h.ratelimit.Clock},
 		)
 	}
+	entity := requestEntity(ctx)
+	entityBucket := h.acquireEntityBucket(resolvedModelUUID, entity)
 
 	go func() {
 		// Close the channel to signal ServeHTTP to finish. Otherwise
 		// we leak goroutines on client disconnect, because the server
 		// isn't shutting down so h.abort is never closed.
 		defer close(logCh)
+		if entityBucket != nil {
+			defer h.releaseEntityBucket(resolvedModelUUID, entity)
+		}
+
+		send := func(m params.LogRecord) bool {
+			select {
+			case <-h.abort:
+				// The API server is stopping.
+				return false
+			case <-stop:
+				// The ServeHTTP handler has stopped.
+				return false
+			case logCh <- m:
+				// If the remote end does not support ping/pong, we bump
+				// the read deadline everytime a message is received.
+				if endpointVersion == 0 {
+					_ = socket.SetReadDeadline(time.Now().Add(vZeroDelay))
+				}
+				return true
+			}
+		}
+
+		var dropped int
+		// flushDropped reports any records dropped since the last
+		// accepted record, so that the loss is never silent when the
+		// connection closes while the entity is still over its limit.
+		flushDropped := func() {
+			if dropped == 0 {
+				return
+			}
+			warning := droppedLogRecord(h.ratelimit.Clock.Now(), entity, dropped)
+			if !send(warning) {
+				logger.Warningf(ctx, "%s", warning.Message)
+			}
+			dropped = 0
+		}
 		for {
 			// Receive() blocks until data arrives but will also be
 			// unblocked when the API handler calls socket.Close as it
@@ -330,10 +385,29 @@ func (h *logSinkHandler) receiveLogs(
 				h.mu.Lock()
 				_ = socket.WriteMessage(gorillaws.CloseMessage, gorillaws.FormatCloseMessage(gorillaws.CloseGoingAway, ""))
 				h.mu.Unlock()
+				flushDropped()
 				return
 			}
 			h.metrics.LogReadCount(resolvedModelUUID, metricLogReadLabelSuccess).Inc()
 
+			// Drop messages from entities that have exceeded their own
+			// allowance, so that a single chatty agent can't swamp the
+			// controller. Once messages are accepted again, a warning
+			// recording how many were lost is written into the stream.
+			if entityBucket != nil {
+				if entityBucket.TakeAvailable(1) == 0 {
+					dropped++
+					h.metrics.LogWriteCount(resolvedModelUUID, metricLogWriteLabelDropped).Inc()
+					continue
+				}
+				if dropped > 0 {
+					if !send(droppedLogRecord(h.ratelimit.Clock.Now(), entity, dropped)) {
+						return
+					}
+					dropped = 0
+				}
+			}
+
 			// Rate-limit receipt of log messages. We rate-limit
 			// each connection individually to prevent one noisy
 			// individual from drowning out the others.
@@ -348,19 +422,8 @@ func (h *logSinkHandler) receiveLogs(
 			}
 
 			// Send the log message.
-			select {
-			case <-h.abort:
-				// The API server is stopping.
+			if !send(m) {
 				return
-			case <-stop:
-				// The ServeHTTP handler has stopped.
-				return
-			case logCh <- m:
-				// If the remote end does not support ping/pong, we bump
-				// the read deadline everytime a message is received.
-				if endpointVersion == 0 {
-					_ = socket.SetReadDeadline(time.Now().Add(vZeroDelay))
-				}
 			}
 		}
 	}()
@@ -368,6 +431,96 @@ func (h *logSinkHandler) receiveLogs(
 	return logCh
 }
 
+// entityBucket is a per-entity token bucket shared by all of the entity's
+// connections.
+type entityBucket struct {
+	*ratelimit.Bucket
+
+	// connections is the number of open connections using the bucket.
+	connections int
+}
+
+// acquireEntityBucket returns the token bucket used to rate-limit the
+// supplied entity in the supplied model, creating it if necessary. It returns
+// nil if per-entity rate-limiting is not configured. Each bucket returned
+// must be released with releaseEntityBucket once the connection closes.
+func (h *logSinkHandler) acquireEntityBucket(modelUUID, entity string) *ratelimit.Bucket {
+	if h.ratelimit == nil || h.ratelimit.EntityBurst <= 0 || h.ratelimit.EntityRefill <= 0 {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.evictIdleEntityBuckets()
+	if h.entityBuckets == nil {
+		h.entityBuckets = make(map[string]*entityBucket)
+	}
+	key := entityBucketKey(modelUUID, entity)
+	bucket, ok := h.entityBuckets[key]
+	if !ok {
+		bucket = &entityBucket{
+			Bucket: ratelimit.NewBucketWithClock(
+				h.ratelimit.EntityRefill,
+				h.ratelimit.EntityBurst,
+				ratelimitClock{Clock: h.ratelimit.Clock},
+			),
+		}
+		h.entityBuckets[key] = bucket
+	}
+	bucket.connections++
+	return bucket.Bucket
+}
+
+// releaseEntityBucket records that a connection using the entity's bucket
+// has closed, and evicts any idle buckets.
+func (h *logSinkHandler) releaseEntityBucket(modelUUID, entity string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if bucket, ok := h.entityBuckets[entityBucketKey(modelUUID, entity)]; ok {
+		bucket.connections--
+	}
+	h.evictIdleEntityBuckets()
+}
+
+// evictIdleEntityBuckets removes the buckets of entities with no open
+// connections whose allowance has been fully restored. Such a bucket is
+// indistinguishable from a new one, so evicting it never resets a depleted
+// allowance. The caller must hold h.mu.
+func (h *logSinkHandler) evictIdleEntityBuckets() {
+	for key, bucket := range h.entityBuckets {
+		if bucket.connections <= 0 && bucket.Available() >= bucket.Capacity() {
+			delete(h.entityBuckets, key)
+		}
+	}
+}
+
+func entityBucketKey(modelUUID, entity string) string {
+	return modelUUID + ":" + entity
+}
+
+// requestEntity returns the tag of the entity that authenticated the
+// request, or "unknown" if there is none.
+func requestEntity(ctx context.Context) string {
+	authInfo, ok := httpcontext.RequestAuthInfo(ctx)
+	if !ok || authInfo.Tag == nil {
+		return "unknown"
+	}
+	return authInfo.Tag.String()
+}
+
+// droppedLogRecord returns a warning log record noting that the supplied
+// number of records from the entity were dropped by the rate-limiter.
+func droppedLogRecord(now time.Time, entity string, dropped int) params.LogRecord {
+	return params.LogRecord{
+		Time:    now,
+		Module:  "juju.apiserver.logsink",
+		Level:   loggo.WARNING.String(),
+		Message: fmt.Sprintf("dropped %d log records from %s: rate limit exceeded", dropped, entity),
+	}
+}
+
 // sendError sends a JSON-encoded error response.
 func (h *logSinkHandler) sendError(ws *websocket.Conn, req *http.Request, err error) {
 	// There is no need to log the error for normal operators as there is nothing
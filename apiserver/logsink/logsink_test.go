@@ -334,6 +334,236 @@ func (s *logsinkSuite) TestRateLimit(c *tc.C) {
 	expectNoRecord()
 }
 
+func (s *logsinkSuite) TestEntityRateLimitDropsExcess(c *tc.C) {
+	modelUUID, err := uuid.NewUUID()
+	c.Assert(err, tc.ErrorIsNil)
+
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	counter := mocks.NewMockCounter(ctrl)
+	counter.EXPECT().Inc().AnyTimes()
+	dropped := mocks.NewMockCounter(ctrl)
+	dropped.EXPECT().Inc().Times(2)
+	gauge := mocks.NewMockGauge(ctrl)
+	gauge.EXPECT().Inc().AnyTimes()
+	gauge.EXPECT().Dec().AnyTimes()
+
+	metricsCollector := mocks.NewMockMetricsCollector(ctrl)
+	metricsCollector.EXPECT().TotalConnections().Return(counter).AnyTimes()
+	metricsCollector.EXPECT().Connections().Return(gauge).AnyTimes()
+	metricsCollector.EXPECT().LogWriteCount(modelUUID.String(), "dropped").Return(dropped).AnyTimes()
+	metricsCollector.EXPECT().LogWriteCount(modelUUID.String(), gomock.Any()).Return(counter).AnyTimes()
+	metricsCollector.EXPECT().LogReadCount(modelUUID.String(), gomock.Any()).Return(counter).AnyTimes()
+
+	testClock := testclock.NewClock(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC))
+	s.written = make(chan params.LogRecord, 10)
+	srv := httptest.NewServer(logsink.NewHTTPHandler(
+		func(req *http.Request) (logsink.LogWriter, error) {
+			return &mockLogWriter{
+				s.stub,
+				s.written,
+				nil,
+			}, nil
+		},
+		s.abort,
+		&logsink.RateLimitConfig{
+			Burst:        100,
+			Refill:       time.Millisecond,
+			EntityBurst:  2,
+			EntityRefill: time.Second,
+			Clock:        testClock,
+		},
+		metricsCollector,
+		modelUUID.String(),
+	))
+	defer srv.Close()
+
+	conn := s.dialWebsocket(c, srv)
+	websockettest.AssertJSONInitialErrorNil(c, conn)
+
+	record := params.LogRecord{
+		Time:     time.Date(2015, time.June, 1, 23, 2, 1, 0, time.UTC),
+		Module:   "some.where",
+		Location: "foo.go:42",
+		Level:    loggo.INFO.String(),
+		Message:  "all is well",
+	}
+	for range 4 {
+		err := conn.WriteJSON(&record)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	expectRecord := func() params.LogRecord {
+		select {
+		case written, ok := <-s.written:
+			c.Assert(ok, tc.IsTrue)
+			return written
+		case <-time.After(coretesting.LongWait):
+			c.Fatal("timed out waiting for log record to be written")
+		}
+		return params.LogRecord{}
+	}
+	expectNoRecord := func() {
+		select {
+		case <-s.written:
+			c.Fatal("unexpected log record")
+		case <-time.After(coretesting.ShortWait):
+		}
+	}
+
+	// The first 2 records are let through, and the rest are dropped
+	// rather than delayed.
+	c.Check(expectRecord(), tc.DeepEquals, record)
+	c.Check(expectRecord(), tc.DeepEquals, record)
+	expectNoRecord()
+
+	// Once the allowance is restored, a warning noting the dropped
+	// records precedes the next record.
+	testClock.Advance(time.Second)
+	err = conn.WriteJSON(&record)
+	c.Assert(err, tc.ErrorIsNil)
+
+	warning := expectRecord()
+	c.Check(warning.Level, tc.Equals, loggo.WARNING.String())
+	c.Check(warning.Message, tc.Equals, "dropped 2 log records from unknown: rate limit exceeded")
+	c.Check(expectRecord(), tc.DeepEquals, record)
+	expectNoRecord()
+}
+
+func (s *logsinkSuite) TestEntityRateLimitReportsDroppedOnClose(c *tc.C) {
+	modelUUID, err := uuid.NewUUID()
+	c.Assert(err, tc.ErrorIsNil)
+
+	metricsCollector, finish := createMockMetrics(c, modelUUID.String())
+	defer finish()
+
+	testClock := testclock.NewClock(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC))
+	s.written = make(chan params.LogRecord, 10)
+	srv := httptest.NewServer(logsink.NewHTTPHandler(
+		func(req *http.Request) (logsink.LogWriter, error) {
+			return &mockLogWriter{
+				s.stub,
+				s.written,
+				nil,
+			}, nil
+		},
+		s.abort,
+		&logsink.RateLimitConfig{
+			Burst:        100,
+			Refill:       time.Millisecond,
+			EntityBurst:  1,
+			EntityRefill: time.Second,
+			Clock:        testClock,
+		},
+		metricsCollector,
+		modelUUID.String(),
+	))
+	defer srv.Close()
+
+	conn := s.dialWebsocket(c, srv)
+	websockettest.AssertJSONInitialErrorNil(c, conn)
+
+	record := params.LogRecord{
+		Time:     time.Date(2015, time.June, 1, 23, 2, 1, 0, time.UTC),
+		Module:   "some.where",
+		Location: "foo.go:42",
+		Level:    loggo.INFO.String(),
+		Message:  "all is well",
+	}
+	for range 3 {
+		err := conn.WriteJSON(&record)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+	select {
+	case written := <-s.written:
+		c.Check(written, tc.DeepEquals, record)
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for log record to be written")
+	}
+
+	// Closing the connection while the entity is still over its limit
+	// reports the dropped records.
+	err = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	c.Assert(err, tc.ErrorIsNil)
+	select {
+	case written := <-s.written:
+		c.Check(written.Level, tc.Equals, loggo.WARNING.String())
+		c.Check(written.Message, tc.Equals, "dropped 2 log records from unknown: rate limit exceeded")
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for dropped records warning")
+	}
+}
+
+func (s *logsinkSuite) TestEntityRateLimitEvictsIdleBuckets(c *tc.C) {
+	modelUUID, err := uuid.NewUUID()
+	c.Assert(err, tc.ErrorIsNil)
+
+	metricsCollector, finish := createMockMetrics(c, modelUUID.String())
+	defer finish()
+
+	testClock := testclock.NewClock(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC))
+	s.written = make(chan params.LogRecord, 10)
+	handler := logsink.NewHTTPHandler(
+		func(req *http.Request) (logsink.LogWriter, error) {
+			return &mockLogWriter{
+				s.stub,
+				s.written,
+				nil,
+			}, nil
+		},
+		s.abort,
+		&logsink.RateLimitConfig{
+			Burst:        100,
+			Refill:       time.Millisecond,
+			EntityBurst:  2,
+			EntityRefill: time.Second,
+			Clock:        testClock,
+		},
+		metricsCollector,
+		modelUUID.String(),
+	)
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	waitForBuckets := func(expected int) {
+		for a := longAttempt.Start(); a.Next(); {
+			if logsink.EntityBucketCount(c, handler) == expected {
+				return
+			}
+		}
+		c.Fatalf("expected %d entity buckets, got %d", expected, logsink.EntityBucketCount(c, handler))
+	}
+
+	conn := s.dialWebsocket(c, srv)
+	websockettest.AssertJSONInitialErrorNil(c, conn)
+	err = conn.WriteJSON(&params.LogRecord{
+		Time:    time.Date(2015, time.June, 1, 23, 2, 1, 0, time.UTC),
+		Level:   loggo.INFO.String(),
+		Message: "all is well",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	select {
+	case <-s.written:
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for log record to be written")
+	}
+	err = conn.Close()
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The depleted allowance is kept after the entity disconnects, so
+	// that reconnecting doesn't reset it.
+	waitForBuckets(1)
+
+	// Once the allowance has been restored, the idle bucket is evicted.
+	testClock.Advance(time.Second)
+	conn = s.dialWebsocket(c, srv)
+	websockettest.AssertJSONInitialErrorNil(c, conn)
+	err = conn.Close()
+	c.Assert(err, tc.ErrorIsNil)
+	waitForBuckets(0)
+}
+
 func (s *logsinkSuite) TestReceiverStopsWhenAsked(c *tc.C) {
 	myStopCh := make(chan struct{})
 
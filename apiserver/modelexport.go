@@ -0,0 +1,215 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/apiserver/httpcontext"
+	"github.com/juju/juju/domain/blockcommand"
+	blockcommanderrors "github.com/juju/juju/domain/blockcommand/errors"
+	"github.com/juju/juju/internal/migration"
+	"github.com/juju/juju/internal/migration/exportarchive"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// modelExportHandler serves a tar archive holding an offline export of a
+// model: its migration envelope together with the charms, agent binaries and
+// resources held in the model's object store. The archive can be imported
+// into another controller with the migration target facade.
+//
+// When the clone query parameter is set, the archive instead describes a
+// copy of the model under the given name and a fresh UUID, without any of
+// the model's machines or units, for import into the same controller. The
+// without-units query parameter additionally scales every application in
+// the copy to zero.
+//
+// The model is locked against changes while the export is assembled, so
+// that the archive describes a single consistent state of the model. When
+// the lock query parameter is set, the model is left locked once the export
+// is written, so that it can be handed over to the importing controller.
+type modelExportHandler struct {
+	ctxt httpContext
+}
+
+func newModelExportHandler(ctxt httpContext) http.Handler {
+	return &modelExportHandler{ctxt: ctxt}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *modelExportHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if err := h.serveExport(w, req); err != nil {
+		if err := sendError(w, err); err != nil {
+			logger.Errorf(req.Context(), "%v", err)
+		}
+	}
+}
+
+func (h *modelExportHandler) serveExport(w http.ResponseWriter, req *http.Request) error {
+	if req.Method != http.MethodGet {
+		return errors.MethodNotAllowedf("unsupported method: %q", req.Method)
+	}
+	if httpcontext.RequestIsForControllerModel(req.Context()) {
+		return errors.NotSupportedf("exporting the controller model")
+	}
+
+	cloneName := req.URL.Query().Get("clone")
+	withoutUnits, err := parseBoolQuery(req, "without-units")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	keepLocked, err := parseBoolQuery(req, "lock")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if keepLocked && cloneName != "" {
+		return errors.NotSupportedf("locking a model that is being cloned")
+	}
+
+	domainServices, err := h.ctxt.domainServicesForRequest(req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	unlock, err := lockModelForExport(req.Context(), domainServices.BlockCommand(), keepLocked)
+	if err != nil {
+		return errors.Annotate(err, "locking model")
+	}
+	defer unlock()
+
+	// Each export is recorded as a distinct source migration, so that the
+	// same archive can't be imported twice into a target controller.
+	exportUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	resourceService := domainServices.Resource()
+	model, err := migration.AssembleEnvelope(req.Context(), migration.EnvelopeServices{
+		ExportService:     domainServices.Export(),
+		CharmService:      domainServices.Application(),
+		ModelAgentService: domainServices.Agent(),
+		ResourceService:   resourceService,
+	}, exportUUID.String())
+	if err != nil {
+		return errors.Annotate(err, "exporting model")
+	}
+	if cloneName != "" {
+		modelUUID, err := uuid.NewUUID()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		model, err = migration.CloneEnvelope(model, migration.CloneArgs{
+			ModelUUID:    modelUUID.String(),
+			ModelName:    cloneName,
+			WithoutUnits: withoutUnits,
+		})
+		if err != nil {
+			return errors.Annotate(err, "cloning model")
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/x-tar")
+	w.Header().Set("Content-Disposition", "attachment; filename=model.tar")
+	w.WriteHeader(http.StatusOK)
+
+	// Any failure from here on can't be reported to the client as an error
+	// response, as the headers have already been sent. The client detects
+	// the truncated archive instead.
+	if err := writeModelExport(req.Context(), w, model, migration.UploadBinariesConfig{
+		CharmService:       domainServices.Application(),
+		AgentBinaryStore:   domainServices.AgentBinaryStore(),
+		ResourceDownloader: migration.NewResourceDownloader(resourceService),
+	}, keepLocked, time.Now()); err != nil {
+		logger.Errorf(req.Context(), "writing model export: %v", err)
+	}
+	return nil
+}
+
+// parseBoolQuery returns the boolean value of the named query parameter,
+// which is false when the parameter is not set.
+func parseBoolQuery(req *http.Request, name string) (bool, error) {
+	value := req.URL.Query().Get(name)
+	if value == "" {
+		return false, nil
+	}
+	result, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.NotValidf("%s %q", name, value)
+	}
+	return result, nil
+}
+
+// exportBlockService switches the block that locks a model against changes.
+type exportBlockService interface {
+	GetBlockSwitchedOn(ctx context.Context, t blockcommand.BlockType) (string, error)
+	SwitchBlockOn(ctx context.Context, t blockcommand.BlockType, message string) error
+	SwitchBlockOff(ctx context.Context, t blockcommand.BlockType) error
+}
+
+// exportLockMessage is the message of the block switched on while a model is
+// being exported.
+const exportLockMessage = "model is being exported"
+
+// lockModelForExport switches on the change block of the model, so that the
+// model can't be changed while it is being exported. The returned func
+// switches the block off again, unless the block was already on or keep is
+// true.
+func lockModelForExport(ctx context.Context, blocks exportBlockService, keep bool) (func(), error) {
+	_, err := blocks.GetBlockSwitchedOn(ctx, blockcommand.ChangeBlock)
+	if err == nil {
+		return func() {}, nil
+	} else if !errors.Is(err, blockcommanderrors.NotFound) {
+		return nil, errors.Trace(err)
+	}
+	if err := blocks.SwitchBlockOn(ctx, blockcommand.ChangeBlock, exportLockMessage); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if keep {
+		return func() {}, nil
+	}
+	return func() {
+		// The request context may be done once the archive is written, but
+		// the model must still be unlocked.
+		ctx := context.WithoutCancel(ctx)
+		if err := blocks.SwitchBlockOff(ctx, blockcommand.ChangeBlock); err != nil {
+			logger.Errorf(ctx, "unlocking model after export: %v", err)
+		}
+	}, nil
+}
+
+// writeModelExport writes the export archive for the assembled model to the
+// input writer. The binaries are read with the sources in the input config.
+// If locked is true, the archive records that the model was left locked.
+func writeModelExport(
+	ctx context.Context,
+	w io.Writer,
+	model migration.AssembledModel,
+	config migration.UploadBinariesConfig,
+	locked bool,
+	modTime time.Time,
+) error {
+	archive := exportarchive.NewWriter(w, modTime)
+	if locked {
+		archive.SetLocked()
+	}
+	if err := archive.WriteModel(model.Envelope); err != nil {
+		return errors.Trace(err)
+	}
+
+	config.Charms = model.Charms
+	config.CharmUploader = archive
+	config.Tools = model.Tools
+	config.ToolsUploader = archive
+	config.Resources = model.Resources
+	config.ResourceUploader = archive
+	if err := migration.UploadBinaries(ctx, config, logger); err != nil {
+		return errors.Annotate(err, "exporting model binaries")
+	}
+	return errors.Trace(archive.Close())
+}
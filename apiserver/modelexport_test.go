@@ -0,0 +1,145 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/semversion"
+	domaincharm "github.com/juju/juju/domain/application/charm"
+	"github.com/juju/juju/domain/blockcommand"
+	blockcommanderrors "github.com/juju/juju/domain/blockcommand/errors"
+	"github.com/juju/juju/internal/migration"
+	"github.com/juju/juju/internal/migration/exportarchive"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type modelExportSuite struct {
+	coretesting.BaseSuite
+}
+
+func TestModelExportSuite(t *testing.T) {
+	tc.Run(t, &modelExportSuite{})
+}
+
+func (s *modelExportSuite) TestWriteModelExport(c *tc.C) {
+	model := migration.AssembledModel{
+		Envelope: params.SerializedModelV2{
+			ModelInfo: params.SerializedModelInfo{
+				UUID:                coretesting.ModelTag.Id(),
+				Name:                "prod",
+				SourceMigrationUUID: "export-uuid",
+			},
+			Charms: []string{"ch:amd64/app-1"},
+		},
+		Charms: []string{"ch:amd64/app-1"},
+		Tools: map[string]semversion.Binary{
+			"sha": semversion.MustParseBinary("4.1.0-ubuntu-amd64"),
+		},
+	}
+
+	var buf bytes.Buffer
+	err := writeModelExport(c.Context(), &buf, model, migration.UploadBinariesConfig{
+		CharmService:       exportCharmService{},
+		AgentBinaryStore:   exportAgentBinaryStore{},
+		ResourceDownloader: exportResourceDownloader{},
+	}, true, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	envelope, manifest, err := exportarchive.ReadModel(bytes.NewReader(buf.Bytes()))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(envelope, tc.DeepEquals, model.Envelope)
+	c.Check(manifest.Charms, tc.DeepEquals, []exportarchive.CharmEntry{{
+		URL:  "ch:amd64/app-1",
+		Ref:  "app-01234567",
+		Path: "charms/app-01234567.charm",
+	}})
+	c.Check(manifest.Tools, tc.DeepEquals, []exportarchive.ToolsEntry{{
+		Version: "4.1.0-ubuntu-amd64",
+		Path:    "tools/4.1.0-ubuntu-amd64.tgz",
+	}})
+	c.Check(manifest.Resources, tc.HasLen, 0)
+	c.Check(manifest.Locked, tc.IsTrue)
+}
+
+func (s *modelExportSuite) TestLockModelForExport(c *tc.C) {
+	blocks := &exportBlocks{}
+
+	unlock, err := lockModelForExport(c.Context(), blocks, false)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(blocks.on, tc.IsTrue)
+
+	unlock()
+	c.Check(blocks.on, tc.IsFalse)
+}
+
+func (s *modelExportSuite) TestLockModelForExportKeep(c *tc.C) {
+	blocks := &exportBlocks{}
+
+	unlock, err := lockModelForExport(c.Context(), blocks, true)
+	c.Assert(err, tc.ErrorIsNil)
+
+	unlock()
+	c.Check(blocks.on, tc.IsTrue)
+}
+
+func (s *modelExportSuite) TestLockModelForExportAlreadyLocked(c *tc.C) {
+	blocks := &exportBlocks{on: true}
+
+	unlock, err := lockModelForExport(c.Context(), blocks, false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// A block switched on by the user is left in place.
+	unlock()
+	c.Check(blocks.on, tc.IsTrue)
+}
+
+type exportBlocks struct {
+	on bool
+}
+
+func (b *exportBlocks) GetBlockSwitchedOn(_ context.Context, t blockcommand.BlockType) (string, error) {
+	if t != blockcommand.ChangeBlock || !b.on {
+		return "", blockcommanderrors.NotFound
+	}
+	return exportLockMessage, nil
+}
+
+func (b *exportBlocks) SwitchBlockOn(_ context.Context, t blockcommand.BlockType, _ string) error {
+	b.on = t == blockcommand.ChangeBlock
+	return nil
+}
+
+func (b *exportBlocks) SwitchBlockOff(_ context.Context, t blockcommand.BlockType) error {
+	if t == blockcommand.ChangeBlock {
+		b.on = false
+	}
+	return nil
+}
+
+type exportCharmService struct{}
+
+func (exportCharmService) GetCharmArchive(_ context.Context, locator domaincharm.CharmLocator) (io.ReadCloser, string, error) {
+	return io.NopCloser(strings.NewReader("charm " + locator.Name)), "0123456789abcdef", nil
+}
+
+type exportAgentBinaryStore struct{}
+
+func (exportAgentBinaryStore) GetAgentBinaryUsingSHA256(_ context.Context, sha string) (io.ReadCloser, int64, error) {
+	return io.NopCloser(strings.NewReader("tools " + sha)), int64(len("tools " + sha)), nil
+}
+
+type exportResourceDownloader struct{}
+
+func (exportResourceDownloader) OpenResource(_ context.Context, app, name string) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(app + "/" + name)), nil
+}
@@ -53,6 +53,7 @@ var commonModelFacadeNames = set.NewStrings(
 	"GetResourceInfo",
 	"RelationStatusWatcher",
 	"RelationUnitsWatcher",
+	"Removals",
 	"ResourcesHookContext",
 	"Resumer",
 	"RetryStrategy",
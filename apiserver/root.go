@@ -33,6 +33,7 @@ import (
 	"github.com/juju/juju/core/user"
 	"github.com/juju/juju/domain/modelmigration"
 	"github.com/juju/juju/internal/migration"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/worker/watcherregistry"
 	"github.com/juju/juju/rpc"
@@ -899,6 +900,7 @@ func (ctx *facadeContext) ModelImporter() facade.ModelImporter {
 	return migration.NewModelImporter(
 		ctx.migrationScope,
 		ctx.r.domainServicesGetter,
+		envelope.NewFileKeyStore(envelope.KeyStorePath(ctx.DataDir())),
 		ctx.ControllerUUID(),
 		ctx.Logger(),
 		ctx.r.clock,
@@ -111,6 +111,10 @@ func (c *configFromEnv) LokiOrgID() string {
 	panic("not implemented")
 }
 
+func (c *configFromEnv) LokiProtocol() string {
+	panic("not implemented")
+}
+
 func (c *configFromEnv) Value(key string) string {
 	panic("not implemented")
 }
@@ -32,6 +32,11 @@ The path to the remote offer is formatted as follows:
 If the controller name is omitted, Juju will use the currently active
 controller. Similarly, if the model qualifier is omitted, Juju will use the user
 that is currently logged in to the controller providing the offer.
+
+If the named controller is not known to the client, the offer details are
+retrieved through the current controller, which must itself know the named
+controller. The named controller authenticates the request itself, so you may
+be asked to log in to it.
 `[1:]
 
 const usageConsumeExamples = `
@@ -112,6 +117,12 @@ func (c *consumeCommand) getSourceAPI(ctx context.Context, url crossmodel.OfferU
 		}
 		url.Source = controllerName
 	}
+	_, err := c.ClientStore().ControllerByName(url.Source)
+	if errors.Is(err, errors.NotFound) {
+		return c.getExternalSourceAPI(ctx, url.Source)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
 	root, err := c.CommandBase.NewAPIRoot(ctx, c.ClientStore(), url.Source, "")
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -119,6 +130,22 @@ func (c *consumeCommand) getSourceAPI(ctx context.Context, url crossmodel.OfferU
 	return applicationoffers.NewClient(root), nil
 }
 
+// getExternalSourceAPI returns an api which gets the consume details for
+// offers on the named external controller through the current controller,
+// authenticating to the external controller with macaroons it mints and
+// which are discharged by this client.
+func (c *consumeCommand) getExternalSourceAPI(ctx context.Context, source string) (applicationConsumeDetailsAPI, error) {
+	controllerName, err := c.ControllerName()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	root, err := c.CommandBase.NewAPIRoot(ctx, c.ClientStore(), controllerName, "")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return applicationoffers.NewExternalClient(root, source), nil
+}
+
 // Run adds the requested remote offer to the model. Implements
 // cmd.Command.
 func (c *consumeCommand) Run(ctx *cmd.Context) error {
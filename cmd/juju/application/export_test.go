@@ -206,3 +206,29 @@ func NewConfigCommandForTest(api ApplicationAPI, store jujuclient.ClientStore) m
 	c.SetClientStore(store)
 	return c
 }
+
+// NewPlacementRulesCommandForTest returns a placement-rules command with the
+// api provided as specified.
+func NewPlacementRulesCommandForTest(api applicationPlacementRulesAPI, store jujuclient.ClientStore) modelcmd.ModelCommand {
+	cmd := &placementRulesCommand{}
+	cmd.api = api
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+// NewSetPlacementRulesCommandForTest returns a set-placement-rules command
+// with the api provided as specified.
+func NewSetPlacementRulesCommandForTest(api applicationPlacementRulesAPI, store jujuclient.ClientStore) modelcmd.ModelCommand {
+	cmd := &setPlacementRulesCommand{}
+	cmd.api = api
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+// NewRenameApplicationCommandForTest returns a rename-application command
+// with the api provided as specified.
+func NewRenameApplicationCommandForTest(api renameApplicationAPI, store jujuclient.ClientStore) modelcmd.ModelCommand {
+	cmd := &renameApplicationCommand{api: api}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
@@ -0,0 +1,258 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"slices"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/application"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+var usagePlacementRulesSummary = `
+Displays the unit placement rules for an application.`[1:]
+
+var usagePlacementRulesDetails = `
+Shows the unit placement rules that have been set for an application with
+` + "`juju set-placement-rules`" + `.
+
+Units of the application that were placed before the rules were set, and
+which break them, are reported by ` + "`juju status`" + `.
+`
+
+const usagePlacementRulesExamples = `
+    juju placement-rules mysql
+    juju placement-rules --format json mysql
+`
+
+var usageSetPlacementRulesSummary = `
+Sets the unit placement rules for an application.`[1:]
+
+var usageSetPlacementRulesDetails = `
+Sets the rules which restrict where units of an application may be placed.
+The rules are checked when units are added with ` + "`juju add-unit --to`" + `
+to an existing machine, or to a container on an existing machine. Units in a
+container count towards the machine hosting the container.
+
+The rules replace any rules already set for the application. Limits of zero
+and omitted application lists remove the corresponding rule. Use --reset to
+remove all rules.
+
+--max-units-per-machine limits how many units of the application may share a
+machine.
+
+--max-units-per-zone limits how many units of the application may share an
+availability zone.
+
+--anti-affinity lists the applications whose units may never share a machine
+with units of this application. The rule applies in both directions.
+
+--affinity lists the applications whose units must already be on a machine
+before a unit of this application may be placed there. Units of an
+application with affinity rules must be placed with --to.
+`
+
+const usageSetPlacementRulesExamples = `
+    juju set-placement-rules mysql --max-units-per-machine 1
+    juju set-placement-rules mysql --max-units-per-zone 1 --anti-affinity postgresql,mariadb
+    juju set-placement-rules telegraf-proxy --affinity haproxy
+    juju set-placement-rules mysql --reset
+`
+
+type applicationPlacementRulesAPI interface {
+	Close() error
+	GetPlacementRules(context.Context, string) (application.PlacementRules, error)
+	SetPlacementRules(context.Context, string, application.PlacementRules) error
+}
+
+type placementRulesCommandBase struct {
+	modelcmd.ModelCommandBase
+	applicationName string
+	api             applicationPlacementRulesAPI
+}
+
+func (c *placementRulesCommandBase) getAPI(ctx context.Context) (applicationPlacementRulesAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return application.NewClient(root), nil
+}
+
+func (c *placementRulesCommandBase) parseApplication(args []string) ([]string, error) {
+	if len(args) == 0 {
+		return nil, errors.Errorf("no application name specified")
+	}
+	if !names.IsValidApplication(args[0]) {
+		return nil, errors.Errorf("invalid application name %q", args[0])
+	}
+	c.applicationName = args[0]
+	return args[1:], nil
+}
+
+// NewPlacementRulesCommand returns a command which shows the placement rules
+// of an application.
+func NewPlacementRulesCommand() modelcmd.ModelCommand {
+	return modelcmd.Wrap(&placementRulesCommand{})
+}
+
+type placementRulesCommand struct {
+	placementRulesCommandBase
+	out cmd.Output
+}
+
+// placementRules is the serialised form of the placement rules.
+type placementRules struct {
+	MaxUnitsPerMachine int      `yaml:"max-units-per-machine,omitempty" json:"max-units-per-machine,omitempty"`
+	MaxUnitsPerZone    int      `yaml:"max-units-per-zone,omitempty" json:"max-units-per-zone,omitempty"`
+	AntiAffinity       []string `yaml:"anti-affinity,omitempty" json:"anti-affinity,omitempty"`
+	Affinity           []string `yaml:"affinity,omitempty" json:"affinity,omitempty"`
+}
+
+// Info implements Command.Info.
+func (c *placementRulesCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "placement-rules",
+		Args:     "<application>",
+		Purpose:  usagePlacementRulesSummary,
+		Doc:      usagePlacementRulesDetails,
+		Examples: usagePlacementRulesExamples,
+		SeeAlso: []string{
+			"set-placement-rules",
+			"add-unit",
+			"status",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *placementRulesCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "yaml", cmd.DefaultFormatters.Formatters())
+}
+
+// Init implements Command.Init.
+func (c *placementRulesCommand) Init(args []string) error {
+	args, err := c.parseApplication(args)
+	if err != nil {
+		return err
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements Command.Run.
+func (c *placementRulesCommand) Run(ctx *cmd.Context) error {
+	apiclient, err := c.getAPI(ctx)
+	if err != nil {
+		return err
+	}
+	defer apiclient.Close()
+
+	rules, err := apiclient.GetPlacementRules(ctx, c.applicationName)
+	if err != nil {
+		return err
+	}
+	return c.out.Write(ctx, placementRules{
+		MaxUnitsPerMachine: rules.MaxUnitsPerMachine,
+		MaxUnitsPerZone:    rules.MaxUnitsPerZone,
+		AntiAffinity:       rules.AntiAffinity,
+		Affinity:           rules.Affinity,
+	})
+}
+
+// NewSetPlacementRulesCommand returns a command which sets the placement
+// rules of an application.
+func NewSetPlacementRulesCommand() modelcmd.ModelCommand {
+	return modelcmd.Wrap(&setPlacementRulesCommand{})
+}
+
+type setPlacementRulesCommand struct {
+	placementRulesCommandBase
+	rules application.PlacementRules
+	reset bool
+}
+
+// Info implements Command.Info.
+func (c *setPlacementRulesCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "set-placement-rules",
+		Args:     "<application>",
+		Purpose:  usageSetPlacementRulesSummary,
+		Doc:      usageSetPlacementRulesDetails,
+		Examples: usageSetPlacementRulesExamples,
+		SeeAlso: []string{
+			"placement-rules",
+			"add-unit",
+			"status",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *setPlacementRulesCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.IntVar(&c.rules.MaxUnitsPerMachine, "max-units-per-machine", 0, "Maximum number of units on a machine")
+	f.IntVar(&c.rules.MaxUnitsPerZone, "max-units-per-zone", 0, "Maximum number of units in an availability zone")
+	f.Var(cmd.NewStringsValue(nil, &c.rules.AntiAffinity), "anti-affinity", "Comma separated applications whose units may not share a machine")
+	f.Var(cmd.NewStringsValue(nil, &c.rules.Affinity), "affinity", "Comma separated applications whose units must be on the machine")
+	f.BoolVar(&c.reset, "reset", false, "Remove all placement rules")
+}
+
+// Init implements Command.Init.
+func (c *setPlacementRulesCommand) Init(args []string) error {
+	args, err := c.parseApplication(args)
+	if err != nil {
+		return err
+	}
+	if err := cmd.CheckEmpty(args); err != nil {
+		return err
+	}
+
+	if c.rules.MaxUnitsPerMachine < 0 {
+		return errors.Errorf("--max-units-per-machine must not be negative")
+	}
+	if c.rules.MaxUnitsPerZone < 0 {
+		return errors.Errorf("--max-units-per-zone must not be negative")
+	}
+	for _, name := range slices.Concat(c.rules.AntiAffinity, c.rules.Affinity) {
+		if !names.IsValidApplication(name) {
+			return errors.Errorf("invalid application name %q", name)
+		}
+	}
+
+	empty := c.rules.MaxUnitsPerMachine == 0 &&
+		c.rules.MaxUnitsPerZone == 0 &&
+		len(c.rules.AntiAffinity) == 0 &&
+		len(c.rules.Affinity) == 0
+	switch {
+	case c.reset && !empty:
+		return errors.Errorf("--reset can not be combined with other placement rules")
+	case !c.reset && empty:
+		return errors.Errorf("no placement rules specified, use --reset to remove all rules")
+	}
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *setPlacementRulesCommand) Run(ctx *cmd.Context) error {
+	apiclient, err := c.getAPI(ctx)
+	if err != nil {
+		return err
+	}
+	defer apiclient.Close()
+
+	err = apiclient.SetPlacementRules(ctx, c.applicationName, c.rules)
+	return block.ProcessBlockedError(err, block.BlockChange)
+}
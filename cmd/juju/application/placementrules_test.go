@@ -0,0 +1,131 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application_test
+
+import (
+	"context"
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+
+	apiapplication "github.com/juju/juju/api/client/application"
+	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/application"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/internal/testing"
+)
+
+type PlacementRulesCommandsSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+
+	api *fakePlacementRulesAPI
+}
+
+func TestPlacementRulesCommandsSuite(t *stdtesting.T) {
+	tc.Run(t, &PlacementRulesCommandsSuite{})
+}
+
+func (s *PlacementRulesCommandsSuite) SetUpTest(c *tc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.api = &fakePlacementRulesAPI{}
+}
+
+func (s *PlacementRulesCommandsSuite) TestSetInit(c *tc.C) {
+	for _, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{},
+		err:  `no application name specified`,
+	}, {
+		args: []string{"mysql-0", "--max-units-per-machine", "1"},
+		err:  `invalid application name "mysql-0"`,
+	}, {
+		args: []string{"mysql"},
+		err:  `no placement rules specified, use --reset to remove all rules`,
+	}, {
+		args: []string{"mysql", "--reset", "--affinity", "haproxy"},
+		err:  `--reset can not be combined with other placement rules`,
+	}, {
+		args: []string{"mysql", "--max-units-per-zone", "-1"},
+		err:  `--max-units-per-zone must not be negative`,
+	}, {
+		args: []string{"mysql", "--anti-affinity", "postgresql,bad_name"},
+		err:  `invalid application name "bad_name"`,
+	}, {
+		args: []string{"mysql", "extra", "--reset"},
+		err:  `unrecognized args: \["extra"\]`,
+	}, {
+		args: []string{"mysql", "--reset"},
+	}, {
+		args: []string{"mysql", "--max-units-per-machine", "1", "--anti-affinity", "postgresql"},
+	}} {
+		cmd := application.NewSetPlacementRulesCommand()
+		cmd.SetClientStore(jujuclienttesting.MinimalStore())
+		err := cmdtesting.InitCommand(cmd, test.args)
+		if test.err == "" {
+			c.Check(err, tc.ErrorIsNil)
+		} else {
+			c.Check(err, tc.ErrorMatches, test.err)
+		}
+	}
+}
+
+func (s *PlacementRulesCommandsSuite) TestSet(c *tc.C) {
+	cmd := application.NewSetPlacementRulesCommandForTest(s.api, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, "mysql",
+		"--max-units-per-machine", "1",
+		"--max-units-per-zone", "2",
+		"--anti-affinity", "postgresql,mariadb",
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "SetPlacementRules", "mysql", apiapplication.PlacementRules{
+		MaxUnitsPerMachine: 1,
+		MaxUnitsPerZone:    2,
+		AntiAffinity:       []string{"postgresql", "mariadb"},
+	})
+}
+
+func (s *PlacementRulesCommandsSuite) TestSetReset(c *tc.C) {
+	cmd := application.NewSetPlacementRulesCommandForTest(s.api, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, "mysql", "--reset")
+	c.Assert(err, tc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "SetPlacementRules", "mysql", apiapplication.PlacementRules{})
+}
+
+func (s *PlacementRulesCommandsSuite) TestGet(c *tc.C) {
+	s.api.rules = apiapplication.PlacementRules{
+		MaxUnitsPerMachine: 1,
+		Affinity:           []string{"haproxy"},
+	}
+	cmd := application.NewPlacementRulesCommandForTest(s.api, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, cmd, "mysql")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+max-units-per-machine: 1
+affinity:
+- haproxy
+`[1:])
+	s.api.CheckCall(c, 0, "GetPlacementRules", "mysql")
+}
+
+type fakePlacementRulesAPI struct {
+	testhelpers.Stub
+	rules apiapplication.PlacementRules
+}
+
+func (f *fakePlacementRulesAPI) Close() error {
+	return nil
+}
+
+func (f *fakePlacementRulesAPI) GetPlacementRules(_ context.Context, appName string) (apiapplication.PlacementRules, error) {
+	f.AddCall("GetPlacementRules", appName)
+	return f.rules, f.NextErr()
+}
+
+func (f *fakePlacementRulesAPI) SetPlacementRules(_ context.Context, appName string, rules apiapplication.PlacementRules) error {
+	f.AddCall("SetPlacementRules", appName, rules)
+	return f.NextErr()
+}
@@ -5,6 +5,7 @@ package application
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/juju/errors"
@@ -15,6 +16,7 @@ import (
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/cmd"
 	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/rpc/params"
 )
@@ -39,6 +41,7 @@ type removeSaasCommand struct {
 
 	newAPIFunc func(ctx context.Context) (RemoveSaasAPI, error)
 
+	DryRun bool
 	Force  bool
 	NoWait bool
 	fs     *gnuflag.FlagSet
@@ -52,11 +55,15 @@ Removing a consumed (SAAS) application will terminate any relations that
 application has, potentially leaving any related local applications
 in a non-functional state.
 
+Use ` + "`--dry-run`" + ` to list the relations and other entities that would be
+removed along with the SAAS application, without removing anything.
+
 `[1:]
 
 const helpExamplesRmSaas = `
     juju remove-saas hosted-mysql
     juju remove-saas -m test-model hosted-mariadb
+    juju remove-saas --dry-run hosted-mysql
 
 `
 
@@ -89,6 +96,7 @@ func (c *removeSaasCommand) Init(args []string) error {
 
 func (c *removeSaasCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ModelCommandBase.SetFlags(f)
+	f.BoolVar(&c.DryRun, "dry-run", false, "Print what this command would remove without removing")
 	f.BoolVar(&c.Force, "force", false, "Completely remove a SAAS and all its dependencies")
 	f.BoolVar(&c.NoWait, "no-wait", false, "Rush through SAAS removal without waiting for each individual step to complete")
 	c.fs = f
@@ -98,6 +106,7 @@ func (c *removeSaasCommand) SetFlags(f *gnuflag.FlagSet) {
 type RemoveSaasAPI interface {
 	Close() error
 	DestroyConsumedApplication(context.Context, application.DestroyConsumedApplicationParams) ([]params.ErrorResult, error)
+	PreviewDestroyConsumedApplication(context.Context, ...string) ([]params.RemovalPreviewResult, error)
 }
 
 func (c *removeSaasCommand) Run(ctx *cmd.Context) error {
@@ -111,9 +120,39 @@ func (c *removeSaasCommand) Run(ctx *cmd.Context) error {
 		return errors.New("--no-wait requires --force")
 	}
 
+	if c.DryRun {
+		return c.performDryRun(ctx, client)
+	}
 	return c.removeSaass(ctx, client)
 }
 
+func (c *removeSaasCommand) performDryRun(
+	ctx *cmd.Context,
+	client RemoveSaasAPI,
+) error {
+	results, err := client.PreviewDestroyConsumedApplication(ctx, c.SaasNames...)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	anyFailed := false
+	for i, name := range c.SaasNames {
+		result := results[i]
+		if result.Error != nil {
+			ctx.Infof("removing SAAS application %s failed: %s", name, result.Error)
+			anyFailed = true
+			continue
+		}
+		_, _ = fmt.Fprintf(ctx.Stdout, "will remove SAAS application %s\n", name)
+		if result.Info != nil {
+			common.PrintRemovalPreview(ctx.Stdout, *result.Info)
+		}
+	}
+	if anyFailed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
+
 func (c *removeSaasCommand) removeSaass(
 	ctx *cmd.Context,
 	client RemoveSaasAPI,
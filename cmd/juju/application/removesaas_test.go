@@ -78,6 +78,32 @@ removing SAAS application gargleblaster failed: an error
 `[1:])
 }
 
+func (s *RemoveSaasSuite) TestRemoveDryRun(c *tc.C) {
+	s.mockAPI.preview = &params.RemovalPreview{
+		RemoteApplications: []params.Entity{{Tag: "application-foo"}},
+		Relations:          []params.Entity{{Tag: "relation-wordpress.db#foo.db"}},
+	}
+	ctx, err := s.runRemoveSaas(c, "--dry-run", "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+will remove SAAS application foo
+- will remove SAAS application foo
+- will remove relation wordpress:db foo:db
+`[1:])
+	s.mockAPI.CheckCallNames(c, "PreviewDestroyConsumedApplication", "Close")
+	s.mockAPI.CheckCall(c, 0, "PreviewDestroyConsumedApplication", []string{"foo"})
+}
+
+func (s *RemoveSaasSuite) TestRemoveDryRunFailure(c *tc.C) {
+	s.mockAPI.err = errors.New("an error")
+	ctx, err := s.runRemoveSaas(c, "--dry-run", "foo")
+	c.Assert(err, tc.Equals, cmd.ErrSilent)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, `
+removing SAAS application foo failed: an error
+`[1:])
+	s.mockAPI.CheckCallNames(c, "PreviewDestroyConsumedApplication", "Close")
+}
+
 func (s *RemoveSaasSuite) TestInvalidArgs(c *tc.C) {
 	_, err := s.runRemoveSaas(c)
 	c.Assert(err, tc.ErrorMatches, `no SAAS application names specified`)
@@ -87,7 +113,8 @@ func (s *RemoveSaasSuite) TestInvalidArgs(c *tc.C) {
 
 type mockRemoveSaasAPI struct {
 	*testhelpers.Stub
-	err error
+	err     error
+	preview *params.RemovalPreview
 }
 
 func (s mockRemoveSaasAPI) Close() error {
@@ -105,3 +132,14 @@ func (s mockRemoveSaasAPI) DestroyConsumedApplication(ctx context.Context, destr
 	}
 	return result, s.NextErr()
 }
+
+func (s mockRemoveSaasAPI) PreviewDestroyConsumedApplication(ctx context.Context, saasNames ...string) ([]params.RemovalPreviewResult, error) {
+	s.MethodCall(s, "PreviewDestroyConsumedApplication", saasNames)
+
+	result := make([]params.RemovalPreviewResult, len(saasNames))
+	for i := range saasNames {
+		result[i].Error = apiservererrors.ServerError(s.err)
+		result[i].Info = s.preview
+	}
+	return result, s.NextErr()
+}
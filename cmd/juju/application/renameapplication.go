@@ -0,0 +1,113 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/application"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+var usageRenameApplicationSummary = `
+Renames an application.`[1:]
+
+var usageRenameApplicationDetails = `
+Renames an application in the model. The application keeps its charm
+and configuration, and the change is recorded in the application's
+status history.
+
+The application's units are renamed with it, so unit "mysql/0" becomes
+"database/0". Its relations, offers and the secrets it owns are kept:
+offers keep their names and existing consumers are unaffected.
+Applications in Kubernetes models can't be renamed.
+`
+
+const usageRenameApplicationExamples = `
+    juju rename-application mysql database
+`
+
+type renameApplicationAPI interface {
+	Close() error
+	RenameApplication(ctx context.Context, applicationName, newName string) error
+}
+
+// NewRenameApplicationCommand returns a command which renames an
+// application.
+func NewRenameApplicationCommand() modelcmd.ModelCommand {
+	return modelcmd.Wrap(&renameApplicationCommand{})
+}
+
+type renameApplicationCommand struct {
+	modelcmd.ModelCommandBase
+	api renameApplicationAPI
+
+	applicationName string
+	newName         string
+}
+
+// Info implements Command.Info.
+func (c *renameApplicationCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "rename-application",
+		Args:     "<application> <new name>",
+		Purpose:  usageRenameApplicationSummary,
+		Doc:      usageRenameApplicationDetails,
+		Examples: usageRenameApplicationExamples,
+		SeeAlso: []string{
+			"remove-unit",
+			"scale-application",
+			"rename-model",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *renameApplicationCommand) Init(args []string) error {
+	switch len(args) {
+	case 0:
+		return errors.Errorf("no application name specified")
+	case 1:
+		return errors.Errorf("no new application name specified")
+	}
+	for _, name := range args[:2] {
+		if !names.IsValidApplication(name) {
+			return errors.Errorf("invalid application name %q", name)
+		}
+	}
+	c.applicationName, c.newName = args[0], args[1]
+	return cmd.CheckEmpty(args[2:])
+}
+
+func (c *renameApplicationCommand) getAPI(ctx context.Context) (renameApplicationAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return application.NewClient(root), nil
+}
+
+// Run implements Command.Run.
+func (c *renameApplicationCommand) Run(ctx *cmd.Context) error {
+	apiclient, err := c.getAPI(ctx)
+	if err != nil {
+		return err
+	}
+	defer apiclient.Close()
+
+	if err := apiclient.RenameApplication(ctx, c.applicationName, c.newName); err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	ctx.Infof("Application %q renamed to %q", c.applicationName, c.newName)
+	return nil
+}
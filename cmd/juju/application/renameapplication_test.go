@@ -0,0 +1,94 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application_test
+
+import (
+	"context"
+	stdtesting "testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/application"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/internal/testing"
+)
+
+type RenameApplicationSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+
+	api *fakeRenameApplicationAPI
+}
+
+func TestRenameApplicationSuite(t *stdtesting.T) {
+	tc.Run(t, &RenameApplicationSuite{})
+}
+
+func (s *RenameApplicationSuite) SetUpTest(c *tc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.api = &fakeRenameApplicationAPI{}
+}
+
+func (s *RenameApplicationSuite) TestInit(c *tc.C) {
+	for _, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{},
+		err:  `no application name specified`,
+	}, {
+		args: []string{"mysql"},
+		err:  `no new application name specified`,
+	}, {
+		args: []string{"mysql-0", "database"},
+		err:  `invalid application name "mysql-0"`,
+	}, {
+		args: []string{"mysql", "bad_name"},
+		err:  `invalid application name "bad_name"`,
+	}, {
+		args: []string{"mysql", "database", "extra"},
+		err:  `unrecognized args: \["extra"\]`,
+	}, {
+		args: []string{"mysql", "database"},
+	}} {
+		cmd := application.NewRenameApplicationCommand()
+		cmd.SetClientStore(jujuclienttesting.MinimalStore())
+		err := cmdtesting.InitCommand(cmd, test.args)
+		if test.err == "" {
+			c.Check(err, tc.ErrorIsNil)
+		} else {
+			c.Check(err, tc.ErrorMatches, test.err)
+		}
+	}
+}
+
+func (s *RenameApplicationSuite) TestRename(c *tc.C) {
+	cmd := application.NewRenameApplicationCommandForTest(s.api, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, cmd, "mysql", "database")
+	c.Assert(err, tc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "RenameApplication", "mysql", "database")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Application \"mysql\" renamed to \"database\"\n")
+}
+
+func (s *RenameApplicationSuite) TestRenameFails(c *tc.C) {
+	s.api.SetErrors(errors.NotSupportedf("renaming application with units"))
+	cmd := application.NewRenameApplicationCommandForTest(s.api, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, "mysql", "database")
+	c.Assert(err, tc.ErrorMatches, "renaming application with units not supported")
+}
+
+type fakeRenameApplicationAPI struct {
+	testhelpers.Stub
+}
+
+func (f *fakeRenameApplicationAPI) Close() error {
+	return nil
+}
+
+func (f *fakeRenameApplicationAPI) RenameApplication(_ context.Context, appName, newName string) error {
+	f.AddCall("RenameApplication", appName, newName)
+	return f.NextErr()
+}
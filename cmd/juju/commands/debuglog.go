@@ -4,6 +4,8 @@
 package commands
 
 import (
+	"archive/tar"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
@@ -98,6 +100,16 @@ Given the above, the following flag combinations are incompatible and cannot be
 * ` + "`--no-tail`" + ` and ` + "`--lines (-n)`" + `
 * ` + "`--limit`" + ` and ` + "`--lines (-n)`" + `
 * ` + "`--replay`" + ` and ` + "`--lines (-n)`" + `
+
+The ` + "`--since`" + ` and ` + "`--until`" + ` options limit the log lines to those logged
+within a time window. Each takes a date (YYYY-MM-DD) or an RFC3339 timestamp.
+Dates are interpreted in the local time zone, or in UTC if ` + "`--utc`" + ` is set.
+
+The ` + "`--export`" + ` option writes the log lines logged so far, filtered as above, to a
+gzipped tar archive at the specified path rather than displaying them. Each
+file in the archive holds one JSON encoded log line per line. When connected to
+a highly available controller, the archive holds one directory per controller.
+` + "`--export`" + ` cannot be combined with ` + "`--tail`" + `, ` + "`--lines (-n)`" + `, ` + "`--limit`" + ` or ` + "`--retry`" + `.
 `
 
 const usageDebugLogExamples = `
@@ -175,6 +187,11 @@ filter and append:
         --include-module juju.worker.uniter \
         --include wordpress/0
 
+Export all WARNING and ERROR messages logged during October 2026 to an archive:
+
+    juju debug-log --export logs.tar.gz --level WARNING \
+        --since 2026-10-01 --until 2026-11-01
+
 Show all messages from the ` + "`juju.worker.uniter`" + ` module, except those sent from
 ` + "`machine-3`" + ` or ` + "`machine-4`" + `, and then stop:
 
@@ -235,6 +252,10 @@ type debugLogCommand struct {
 
 	includeLabels []string
 	excludeLabels []string
+
+	since      string
+	until      string
+	exportPath string
 }
 
 func (c *debugLogCommand) SetFlags(f *gnuflag.FlagSet) {
@@ -271,6 +292,10 @@ func (c *debugLogCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.date, "date", false, "Show dates as well as times")
 	f.BoolVar(&c.ms, "ms", false, "Show times to millisecond precision")
 
+	f.StringVar(&c.since, "since", "", "Only show log messages logged at or after this date or RFC3339 time")
+	f.StringVar(&c.until, "until", "", "Only show log messages logged at or before this date or RFC3339 time")
+	f.StringVar(&c.exportPath, "export", "", "Write existing log messages to this gzipped tar archive and then exit")
+
 	f.BoolVar(&c.retry, "retry", false, "Retry connection on failure")
 	f.DurationVar(&c.retryDelay, "retry-delay", 1*time.Second, "Retry delay between connection failure retries")
 
@@ -310,6 +335,18 @@ func (c *debugLogCommand) Init(args []string) error {
 	if c.retryDelay < 0 {
 		return errors.NotValidf("negative retry delay")
 	}
+	if c.exportPath != "" {
+		switch {
+		case c.tail:
+			return errors.NotValidf("setting --export and --tail")
+		case c.backLogFlag.IsSet():
+			return errors.NotValidf("setting --export and --lines")
+		case c.limitFlag.IsSet():
+			return errors.NotValidf("setting --export and --limit")
+		case c.retry:
+			return errors.NotValidf("setting --export and --retry")
+		}
+	}
 	if c.limitFlag.IsSet() {
 		c.noTail = true
 	}
@@ -322,6 +359,23 @@ func (c *debugLogCommand) Init(args []string) error {
 	if c.utc {
 		c.tz = time.UTC
 	}
+	if c.since != "" {
+		since, err := parseLogTime(c.since, c.tz)
+		if err != nil {
+			return errors.Annotate(err, "invalid --since value")
+		}
+		c.params.StartTime = since
+	}
+	if c.until != "" {
+		until, err := parseLogTime(c.until, c.tz)
+		if err != nil {
+			return errors.Annotate(err, "invalid --until value")
+		}
+		if until.Before(c.params.StartTime) {
+			return errors.NotValidf("--until before --since")
+		}
+		c.params.EndTime = until
+	}
 	if c.date {
 		c.format = "2006-01-02 15:04:05"
 	} else {
@@ -357,6 +411,19 @@ func (c *debugLogCommand) Init(args []string) error {
 	return cmd.CheckEmpty(args)
 }
 
+// parseLogTime parses the input value as either an RFC3339 time,
+// or a date in the input location.
+func parseLogTime(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation(time.DateOnly, value, loc)
+	if err != nil {
+		return time.Time{}, errors.Errorf("%q is neither a date (YYYY-MM-DD) nor an RFC3339 time", value)
+	}
+	return t, nil
+}
+
 func (c *debugLogCommand) parseEntity(entity string) string {
 	tag, err := names.ParseTag(entity)
 	switch {
@@ -383,6 +450,9 @@ type DebugLogAPI interface {
 	// WatchDebugLog streams debug log messages according to the specified
 	// parameters.
 	WatchDebugLog(ctx context.Context, params common.DebugLogParams) (<-chan common.LogMessage, error)
+	// ExportDebugLog returns a gzipped tar archive of the existing debug log
+	// messages that match the specified parameters.
+	ExportDebugLog(ctx context.Context, params common.DebugLogParams) (io.ReadCloser, error)
 	// Close closes the API client.
 	Close() error
 }
@@ -492,6 +562,10 @@ func (c *debugLogCommand) getDebugLogClient(ctx context.Context) ([]DebugLogAPI,
 
 // Run retrieves the debug log via the API.
 func (c *debugLogCommand) Run(ctx *cmd.Context) error {
+	if c.exportPath != "" {
+		return c.exportLogs(ctx)
+	}
+
 	if c.tail {
 		c.params.NoTail = false
 	} else if c.noTail {
@@ -583,6 +657,93 @@ loop:
 	return pollErr
 }
 
+// exportLogs writes an archive of the existing debug logs from every
+// controller to the export path.
+func (c *debugLogCommand) exportLogs(ctx *cmd.Context) (err error) {
+	c.params.Replay = true
+	c.params.NoTail = true
+
+	clients, err := c.getDebugLogClients(ctx, ctx)
+	if err != nil {
+		return err
+	} else if len(clients) == 0 {
+		return errors.New("no controller debug-log clients available; is bootstrap still in progress?")
+	}
+	defer func() {
+		for _, client := range clients {
+			_ = client.Close()
+		}
+	}()
+
+	path := ctx.AbsPath(c.exportPath)
+	f, err := os.Create(path)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			_ = os.Remove(path)
+		}
+	}()
+
+	gzw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gzw)
+	for i, client := range clients {
+		// Only prefix the entries when there is more than one controller, so
+		// that the entries from each controller do not collide.
+		var prefix string
+		if len(clients) > 1 {
+			prefix = fmt.Sprintf("controller-%d/", i)
+		}
+		if err := c.copyLogArchive(ctx, client, tw, prefix); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return errors.Trace(err)
+	}
+	if err := gzw.Close(); err != nil {
+		return errors.Trace(err)
+	}
+
+	ctx.Infof("Exported debug log to %s", path)
+	return nil
+}
+
+// copyLogArchive copies the entries from the debug log archive returned
+// by the input client into the input writer, prefixing their names.
+func (c *debugLogCommand) copyLogArchive(ctx context.Context, client DebugLogAPI, tw *tar.Writer, prefix string) error {
+	archive, err := client.ExportDebugLog(ctx, c.params)
+	if err != nil {
+		return errors.Annotate(err, "exporting debug log")
+	}
+	defer func() { _ = archive.Close() }()
+
+	gzr, err := gzip.NewReader(archive)
+	if err != nil {
+		return errors.Annotate(err, "reading debug log archive")
+	}
+	tr := tar.NewReader(gzr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errors.Annotate(err, "reading debug log archive")
+		}
+		hdr.Name = prefix + hdr.Name
+		if err := tw.WriteHeader(hdr); err != nil {
+			return errors.Trace(err)
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
+
 // streamLogs watches debug logs from the specified controller and logs any results
 // into the supplied buffered logger. Any error is reported to the errors channel.
 func (c *debugLogCommand) streamLogs(ctx context.Context, client DebugLogAPI, buf *corelogger.BufferedLogWriter) error {
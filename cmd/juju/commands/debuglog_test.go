@@ -4,7 +4,15 @@
 package commands
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	stdtesting "testing"
 	"time"
@@ -176,6 +184,28 @@ func (s *DebugLogSuite) TestArgParsing(c *tc.C) {
 		}, {
 			args:     []string{"--lines", "30", "--no-tail", "--limit", "50"},
 			errMatch: `setting --no-tail and --lines not valid`,
+		}, {
+			args: []string{"--utc", "--since", "2026-10-01", "--until", "2026-10-02T12:00:00Z"},
+			expected: common.DebugLogParams{
+				Backlog:   10,
+				StartTime: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2026, 10, 2, 12, 0, 0, 0, time.UTC),
+			},
+		}, {
+			args:     []string{"--since", "yesterday"},
+			errMatch: `invalid --since value: "yesterday" is neither a date \(YYYY-MM-DD\) nor an RFC3339 time`,
+		}, {
+			args:     []string{"--since", "2026-10-02T00:00:00Z", "--until", "2026-10-01T00:00:00Z"},
+			errMatch: `--until before --since not valid`,
+		}, {
+			args:     []string{"--export", "logs.tar.gz", "--tail"},
+			errMatch: `setting --export and --tail not valid`,
+		}, {
+			args:     []string{"--export", "logs.tar.gz", "--lines", "10"},
+			errMatch: `setting --export and --lines not valid`,
+		}, {
+			args:     []string{"--export", "logs.tar.gz", "--retry"},
+			errMatch: `setting --export and --retry not valid`,
 		},
 	} {
 		c.Logf("test %v", i)
@@ -460,10 +490,67 @@ func (s *DebugLogSuite) TestLogOutputWithLogs(c *tc.C) {
 		"machine-0: 14:15:23 INFO test.module somefile.go:123 logger-tags:http,foo this is the log output\n")
 }
 
+func (s *DebugLogSuite) TestExport(c *tc.C) {
+	s.PatchValue(&getControllerDetailsClient, func(_ context.Context, _ *debugLogCommand) (ControllerDetailsAPI, error) {
+		return &fakeControllerDetailsAPI{
+			details: map[string]highavailability.ControllerDetails{
+				"0": {ControllerID: "0", APIEndpoints: []string{"address-0"}},
+				"1": {ControllerID: "1", APIEndpoints: []string{"address-1"}},
+			},
+			apiVersion: 3,
+		}, nil
+	})
+	fakes := map[string]*fakeDebugLogAPI{
+		"address-0": {archive: map[string]string{"debug-log.log": "zero\n"}},
+		"address-1": {archive: map[string]string{"debug-log.log": "one\n"}},
+	}
+	s.PatchValue(&getDebugLogClientForAddresses, func(_ context.Context, _ *debugLogCommand, addrs []string) (DebugLogAPI, error) {
+		return fakes[addrs[0]], nil
+	})
+
+	path := filepath.Join(c.MkDir(), "logs.tar.gz")
+	store := jujuclienttesting.MinimalStore()
+	_, err := cmdtesting.RunCommand(c, newDebugLogCommand(store),
+		"--export", path,
+		"--since", "2026-10-01T00:00:00Z",
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	for _, fake := range fakes {
+		c.Check(fake.params.Replay, tc.IsTrue)
+		c.Check(fake.params.NoTail, tc.IsTrue)
+		c.Check(fake.params.StartTime, tc.Equals, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC))
+	}
+
+	f, err := os.Open(path)
+	c.Assert(err, tc.ErrorIsNil)
+	defer f.Close()
+	gzr, err := gzip.NewReader(f)
+	c.Assert(err, tc.ErrorIsNil)
+	tr := tar.NewReader(gzr)
+	contents := make(map[string]string)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, tc.ErrorIsNil)
+		data, err := io.ReadAll(tr)
+		c.Assert(err, tc.ErrorIsNil)
+		contents[hdr.Name] = string(data)
+	}
+	c.Assert(contents, tc.HasLen, 2)
+	c.Check(slices.Sorted(maps.Values(contents)), tc.DeepEquals, []string{"one\n", "zero\n"})
+	for name := range contents {
+		c.Check(name, tc.Matches, `controller-[01]/debug-log\.log`)
+	}
+}
+
 type fakeDebugLogAPI struct {
-	log    []common.LogMessage
-	params common.DebugLogParams
-	err    error
+	log     []common.LogMessage
+	archive map[string]string
+	params  common.DebugLogParams
+	err     error
 }
 
 func (fake *fakeDebugLogAPI) WatchDebugLog(ctx context.Context, params common.DebugLogParams) (<-chan common.LogMessage, error) {
@@ -481,6 +568,28 @@ func (fake *fakeDebugLogAPI) WatchDebugLog(ctx context.Context, params common.De
 	return response, nil
 }
 
+func (fake *fakeDebugLogAPI) ExportDebugLog(ctx context.Context, params common.DebugLogParams) (io.ReadCloser, error) {
+	if fake.err != nil {
+		return nil, fake.err
+	}
+	fake.params = params
+
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+	for name, content := range fake.archive {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))}); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			return nil, err
+		}
+	}
+	_ = tw.Close()
+	_ = gzw.Close()
+	return io.NopCloser(&buf), nil
+}
+
 func (fake *fakeDebugLogAPI) Close() error {
 	return nil
 }
@@ -32,6 +32,7 @@ import (
 	"github.com/juju/juju/cmd/juju/firewall"
 	"github.com/juju/juju/cmd/juju/machine"
 	"github.com/juju/juju/cmd/juju/model"
+	"github.com/juju/juju/cmd/juju/removals"
 	"github.com/juju/juju/cmd/juju/resource"
 	"github.com/juju/juju/cmd/juju/secretbackends"
 	"github.com/juju/juju/cmd/juju/secrets"
@@ -42,6 +43,7 @@ import (
 	"github.com/juju/juju/cmd/juju/storage"
 	"github.com/juju/juju/cmd/juju/subnet"
 	"github.com/juju/juju/cmd/juju/user"
+	"github.com/juju/juju/cmd/juju/waitfor"
 	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/internal/featureflag"
 	internallogger "github.com/juju/juju/internal/logger"
@@ -364,6 +366,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(status.NewStatusCommand())
 	r.Register(newSwitchCommand())
 	r.Register(status.NewStatusHistoryCommand())
+	r.Register(waitfor.NewWaitForCommand())
 
 	// Error resolution and debugging commands.
 	r.Register(action.NewExecCommand(nil))
@@ -428,6 +431,10 @@ func registerCommands(r commandRegistry) {
 
 	r.Register(newMigrateCommand())
 	r.Register(model.NewExportBundleCommand())
+	r.Register(model.NewExportModelCommand())
+	r.Register(model.NewImportModelCommand())
+	r.Register(model.NewCloneModelCommand())
+	r.Register(model.NewRenameModelCommand())
 
 	if featureflag.Enabled(featureflag.DeveloperMode) {
 		r.Register(model.NewDumpCommand())
@@ -451,6 +458,9 @@ func registerCommands(r commandRegistry) {
 	r.Register(application.NewUnexposeCommand())
 	r.Register(application.NewApplicationGetConstraintsCommand())
 	r.Register(application.NewApplicationSetConstraintsCommand())
+	r.Register(application.NewPlacementRulesCommand())
+	r.Register(application.NewSetPlacementRulesCommand())
+	r.Register(application.NewRenameApplicationCommand())
 	r.Register(application.NewDiffBundleCommand())
 	r.Register(application.NewShowApplicationCommand())
 	r.Register(application.NewShowUnitCommand())
@@ -460,6 +470,11 @@ func registerCommands(r commandRegistry) {
 	r.Register(block.NewListCommand())
 	r.Register(block.NewEnableCommand())
 
+	// Manage pending removals
+	r.Register(removals.NewListCommand())
+	r.Register(removals.NewRetryCommand())
+	r.Register(removals.NewForceCommand())
+
 	// Manage storage
 	r.Register(storage.NewAddCommand())
 	r.Register(storage.NewListCommand())
@@ -472,6 +487,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(storage.NewDetachStorageCommandWithAPI())
 	r.Register(storage.NewAttachStorageCommandWithAPI())
 	r.Register(storage.NewImportFilesystemCommand(storage.NewStorageImporter, nil))
+	r.Register(storage.NewImportVolumeCommand(storage.NewStorageImporter, nil))
 
 	// Manage spaces
 	r.Register(space.NewAddCommand())
@@ -554,6 +570,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(secretbackends.NewRemoveSecretBackendCommand())
 	r.Register(secretbackends.NewShowSecretBackendCommand())
 	r.Register(secretbackends.NewModelSecretBackendCommand())
+	r.Register(secretbackends.NewRotateSecretEncryptionKeyCommand())
 }
 
 type cloudToCommandAdaptor struct{}
@@ -220,6 +220,7 @@ var commandNames = []string{
 	"cancel-task",
 	"change-user-password",
 	"charm-resources",
+	"clone-model",
 	"clouds",
 	"config",
 	"constraints",
@@ -252,10 +253,12 @@ var commandNames = []string{
 	"enable-user",
 	"exec",
 	"export-bundle",
+	"export-model",
 	"expose",
 	"find-offers",
 	"find",
 	"firewall-rules",
+	"force-removal",
 	"grant-cloud",
 	"grant-secret",
 	"grant",
@@ -263,7 +266,9 @@ var commandNames = []string{
 	"help-action-commands",
 	"help-hook-commands",
 	"import-filesystem",
+	"import-model",
 	"import-ssh-key",
+	"import-volume",
 	"info",
 	"integrate",
 	"kill-controller",
@@ -279,6 +284,7 @@ var commandNames = []string{
 	"list-offers",
 	"list-operations",
 	"list-regions",
+	"list-removals",
 	"list-resources",
 	"list-secret-backends",
 	"list-secrets",
@@ -302,11 +308,13 @@ var commandNames = []string{
 	"offer",
 	"offers",
 	"operations",
+	"placement-rules",
 	"refresh",
 	"regions",
 	"register",
 	"relate", // alias for integrate
 	"reload-spaces",
+	"removals",
 	"remove-application",
 	"remove-cloud",
 	"remove-credential",
@@ -323,6 +331,8 @@ var commandNames = []string{
 	"remove-storage",
 	"remove-unit",
 	"remove-user",
+	"rename-application",
+	"rename-model",
 	"rename-space",
 	"reprovision-machine",
 	"resolve",
@@ -330,9 +340,11 @@ var commandNames = []string{
 	"resources",
 	"resume-relation",
 	"retry-provisioning",
+	"retry-removal",
 	"revoke-cloud",
 	"revoke-secret",
 	"revoke",
+	"rotate-secret-encryption-key",
 	"run",
 	"scale-application",
 	"scp",
@@ -344,6 +356,7 @@ var commandNames = []string{
 	"set-default-region",
 	"set-firewall-rule",
 	"set-model-constraints",
+	"set-placement-rules",
 	"show-action",
 	"show-application",
 	"show-cloud",
@@ -387,6 +400,7 @@ var commandNames = []string{
 	"upgrade-model",
 	"users",
 	"version",
+	"wait-for",
 	"whoami",
 }
 
@@ -0,0 +1,41 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package common
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// PrintRemovalPreview writes one line for each entity in the input removal
+// preview, describing what would happen to it.
+func PrintRemovalPreview(writer io.Writer, preview params.RemovalPreview) {
+	printPreviewEntities(writer, "will remove machine", preview.Machines)
+	printPreviewEntities(writer, "will remove application", preview.Applications)
+	printPreviewEntities(writer, "will remove SAAS application", preview.RemoteApplications)
+	printPreviewEntities(writer, "will remove unit", preview.Units)
+	printPreviewEntities(writer, "will remove relation", preview.Relations)
+	for _, offer := range preview.Offers {
+		_, _ = fmt.Fprintf(writer, "- will remove offer %s\n", offer)
+	}
+	printPreviewEntities(writer, "will remove storage", preview.DestroyedStorage)
+	printPreviewEntities(writer, "will detach storage", preview.DetachedStorage)
+	for _, secret := range preview.Secrets {
+		_, _ = fmt.Fprintf(writer, "- will remove secret %s\n", secret)
+	}
+}
+
+func printPreviewEntities(writer io.Writer, action string, entities []params.Entity) {
+	for _, entity := range entities {
+		id := entity.Tag
+		if tag, err := names.ParseTag(entity.Tag); err == nil {
+			id = tag.Id()
+		}
+		_, _ = fmt.Fprintf(writer, "- %s %s\n", action, id)
+	}
+}
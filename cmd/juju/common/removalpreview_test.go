@@ -0,0 +1,50 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package common_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+)
+
+type removalPreviewSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestRemovalPreviewSuite(t *testing.T) {
+	tc.Run(t, &removalPreviewSuite{})
+}
+
+func (s *removalPreviewSuite) TestPrintRemovalPreview(c *tc.C) {
+	var buf bytes.Buffer
+	common.PrintRemovalPreview(&buf, params.RemovalPreview{
+		Machines:           []params.Entity{{Tag: "machine-0"}, {Tag: "machine-0-lxd-0"}},
+		Applications:       []params.Entity{{Tag: "application-wordpress"}},
+		RemoteApplications: []params.Entity{{Tag: "application-mysql"}},
+		Units:              []params.Entity{{Tag: "unit-wordpress-0"}},
+		Relations:          []params.Entity{{Tag: "relation-wordpress.db#mysql.db"}},
+		Offers:             []string{"blog"},
+		DestroyedStorage:   []params.Entity{{Tag: "storage-data-0"}},
+		DetachedStorage:    []params.Entity{{Tag: "storage-logs-1"}},
+		Secrets:            []string{"secret:d0c8qlnmp25c76cdc2t0"},
+	})
+	c.Check(buf.String(), tc.Equals, `
+- will remove machine 0
+- will remove machine 0/lxd/0
+- will remove application wordpress
+- will remove SAAS application mysql
+- will remove unit wordpress/0
+- will remove relation wordpress:db mysql:db
+- will remove offer blog
+- will remove storage data/0
+- will detach storage logs/1
+- will remove secret secret:d0c8qlnmp25c76cdc2t0
+`[1:])
+}
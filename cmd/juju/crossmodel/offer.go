@@ -26,6 +26,15 @@ const (
 Deployed application endpoints are offered for use by consumers.
 By default, the offer is named after the application, unless
 an offer name is explicitly specified.
+
+An existing offer is changed in place with ` + "`--update`" + `. The endpoints
+given replace those of the offer: endpoints not given are removed from
+the offer and new ones are added to it, without affecting the relations
+of consumers on the endpoints which remain offered. Endpoints with live
+relations through the offer are not removed unless ` + "`--force`" + ` is used,
+in which case those relations are left in place. The description shown
+to consumers is changed with ` + "`--description`" + `; an empty description
+reverts to the description of the charm.
 `
 
 	offerCommandExamples = `
@@ -33,6 +42,8 @@ an offer name is explicitly specified.
     juju offer mymodel.mysql:db
     juju offer db2:db hosted-db2
     juju offer db2:db,log hosted-db2
+    juju offer --update db2:db hosted-db2
+    juju offer --update --description "Shared DB2" db2:db,log hosted-db2
 `
 )
 
@@ -63,6 +74,12 @@ type offerCommand struct {
 
 	// QualifiedModelName stores the name of the model hosting the offer.
 	QualifiedModelName string
+
+	fs             *gnuflag.FlagSet
+	update         bool
+	force          bool
+	description    string
+	descriptionSet bool
 }
 
 // NewApplicationOffersAPI returns an application offers api for the root api endpoint
@@ -102,12 +119,24 @@ func (c *offerCommand) Init(args []string) error {
 		argCount = 2
 		c.OfferName = args[1]
 	}
+	c.fs.Visit(func(flag *gnuflag.Flag) {
+		if flag.Name == "description" {
+			c.descriptionSet = true
+		}
+	})
+	if !c.update && (c.force || c.descriptionSet) {
+		return errors.New("--force and --description can only be used with --update")
+	}
 	return cmd.CheckEmpty(args[argCount:])
 }
 
 // SetFlags implements Command.SetFlags.
 func (c *offerCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ControllerCommandBase.SetFlags(f)
+	f.BoolVar(&c.update, "update", false, "Update the endpoints and description of an existing offer")
+	f.BoolVar(&c.force, "force", false, "Remove endpoints from the offer even if they have live relations")
+	f.StringVar(&c.description, "description", "", "Set the description of the offer, used with --update")
+	c.fs = f
 }
 
 // Run implements Command.Run.
@@ -153,6 +182,9 @@ func (c *offerCommand) Run(ctx *cmd.Context) error {
 	if c.OfferName == "" {
 		c.OfferName = c.Application
 	}
+	if c.update {
+		return c.updateOffer(ctx, api, modelDetails.ModelUUID)
+	}
 	accountDetails, err := c.CurrentAccountDetails()
 	if err != nil {
 		return errors.Trace(err)
@@ -177,10 +209,27 @@ func (c *offerCommand) Run(ctx *cmd.Context) error {
 	return nil
 }
 
+// updateOffer changes the endpoints and description of an existing offer.
+func (c *offerCommand) updateOffer(ctx *cmd.Context, api OfferAPI, modelUUID string) error {
+	var desc *string
+	if c.descriptionSet {
+		desc = &c.description
+	}
+	err := api.UpdateOffer(ctx, modelUUID, c.OfferName, c.Endpoints, desc, c.force)
+	if errors.Is(err, errors.NotImplemented) {
+		return errors.New("updating offers is not supported by this controller, upgrade the controller to use it")
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Offer %q updated, application %q endpoints [%s] offered", c.OfferName, c.Application, strings.Join(c.Endpoints, ", "))
+	return nil
+}
+
 // OfferAPI defines the API methods that the offer command uses.
 type OfferAPI interface {
 	Close() error
 	Offer(ctx context.Context, modelUUID, application string, endpoints []string, owner, offerName, desc string) ([]params.ErrorResult, error)
+	UpdateOffer(ctx context.Context, modelUUID, offerName string, endpoints []string, desc *string, force bool) error
 }
 
 // applicationParse is used to split an application string
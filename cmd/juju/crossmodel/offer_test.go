@@ -128,6 +128,37 @@ func (s *offerSuite) TestOfferMultipleEndpoints(c *tc.C) {
 	s.assertOfferOutput(c, "test", "tst", "tst", []string{"db", "admin"})
 }
 
+func (s *offerSuite) TestOfferUpdate(c *tc.C) {
+	_, err := s.runOffer(c, "--update", "--force", "tst:db,admin", "hosted-tst")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.mockAPI.modelUUID, tc.Equals, "test-uuid")
+	c.Check(s.mockAPI.offers["hosted-tst"], tc.SameContents, []string{"db", "admin"})
+	c.Check(s.mockAPI.updateDesc, tc.IsNil)
+	c.Check(s.mockAPI.force, tc.IsTrue)
+}
+
+func (s *offerSuite) TestOfferUpdateDescription(c *tc.C) {
+	_, err := s.runOffer(c, "--update", "--description", "", "tst:db")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.mockAPI.offers["tst"], tc.SameContents, []string{"db"})
+	c.Assert(s.mockAPI.updateDesc, tc.NotNil)
+	c.Check(*s.mockAPI.updateDesc, tc.Equals, "")
+	c.Check(s.mockAPI.force, tc.IsFalse)
+}
+
+func (s *offerSuite) TestOfferUpdateFlagsRequireUpdate(c *tc.C) {
+	s.args = []string{"--description", "shared", "tst:db"}
+	s.assertOfferErrorOutput(c, "--force and --description can only be used with --update")
+	s.args = []string{"--force", "tst:db"}
+	s.assertOfferErrorOutput(c, "--force and --description can only be used with --update")
+}
+
+func (s *offerSuite) TestOfferUpdateNotSupported(c *tc.C) {
+	s.mockAPI.errUpdate = errors.NotImplementedf("updating offers on this version of Juju")
+	s.args = []string{"--update", "tst:db"}
+	s.assertOfferErrorOutput(c, "updating offers is not supported by this controller, upgrade the controller to use it")
+}
+
 func (s *offerSuite) assertOfferOutput(c *tc.C, expectedModel, expectedOffer, expectedApplication string, endpoints []string) {
 	_, err := s.runOffer(c, s.args...)
 	c.Assert(err, tc.ErrorIsNil)
@@ -140,6 +171,10 @@ type mockOfferAPI struct {
 	offers           map[string][]string
 	applications     map[string]string
 	descs            map[string]string
+
+	updateDesc *string
+	force      bool
+	errUpdate  error
 }
 
 func newMockOfferAPI() *mockOfferAPI {
@@ -175,3 +210,14 @@ func (s *mockOfferAPI) Offer(ctx context.Context, modelUUID, application string,
 	s.descs[offerName] = desc
 	return result, nil
 }
+
+func (s *mockOfferAPI) UpdateOffer(ctx context.Context, modelUUID, offerName string, endpoints []string, desc *string, force bool) error {
+	if s.errUpdate != nil {
+		return s.errUpdate
+	}
+	s.modelUUID = modelUUID
+	s.offers[offerName] = endpoints
+	s.updateDesc = desc
+	s.force = force
+	return nil
+}
@@ -6,6 +6,8 @@ package crossmodel
 import (
 	"context"
 
+	"github.com/juju/errors"
+
 	"github.com/juju/juju/api/client/applicationoffers"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/domain/deployment/charm"
@@ -16,9 +18,23 @@ type RemoteEndpointsCommandBase struct {
 	modelcmd.ModelCommandBase
 }
 
+// RemoteEndpointsAPI defines the API methods used to query offers.
+type RemoteEndpointsAPI interface {
+	ShowAPI
+	FindAPI
+}
+
 // NewRemoteEndpointsAPI returns a remote endpoints api for the root api endpoint
-// that the command returns.
-func (c *RemoteEndpointsCommandBase) NewRemoteEndpointsAPI(ctx context.Context, controllerName string) (*applicationoffers.Client, error) {
+// that the command returns. If the controller is not known to the client, the
+// offers are queried through the current controller, which must know the
+// controller by that name.
+func (c *RemoteEndpointsCommandBase) NewRemoteEndpointsAPI(ctx context.Context, controllerName string) (RemoteEndpointsAPI, error) {
+	_, err := c.ClientStore().ControllerByName(controllerName)
+	if errors.Is(err, errors.NotFound) {
+		return c.newExternalEndpointsAPI(ctx, controllerName)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
 	root, err := c.CommandBase.NewAPIRoot(ctx, c.ClientStore(), controllerName, "")
 	if err != nil {
 		return nil, err
@@ -26,6 +42,22 @@ func (c *RemoteEndpointsCommandBase) NewRemoteEndpointsAPI(ctx context.Context,
 	return applicationoffers.NewClient(root), nil
 }
 
+// newExternalEndpointsAPI returns a remote endpoints api which queries the
+// offers on the named external controller through the current controller,
+// authenticating to the external controller with macaroons it mints and
+// which are discharged by this client.
+func (c *RemoteEndpointsCommandBase) newExternalEndpointsAPI(ctx context.Context, source string) (RemoteEndpointsAPI, error) {
+	controllerName, err := c.ControllerName()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	root, err := c.CommandBase.NewAPIRoot(ctx, c.ClientStore(), controllerName, "")
+	if err != nil {
+		return nil, err
+	}
+	return applicationoffers.NewExternalClient(root, source), nil
+}
+
 // RemoteEndpoint defines the serialization behaviour of remote endpoints.
 // This is used in map-style yaml output where remote endpoint name is the key.
 type RemoteEndpoint struct {
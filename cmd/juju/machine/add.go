@@ -76,6 +76,24 @@ This is the process of installing Juju on an existing machine
 and bringing it under Juju's management. The Juju controller must be able to
 access the new machine over the network.
 
+To add many pre-existing machines at once, list them in a YAML inventory
+file and pass it to ` + "`--inventory`" + `. Each host may set the SSH user to
+connect as, and the spaces and tags to record as its machine's constraints.
+As the host already exists, the constraints don't change it; they are
+shown with the machine by ` + "`juju show-machine`" + `:
+
+    hosts:
+      - host: 10.10.0.3
+        user: ubuntu
+        spaces: [db]
+        tags: [rack-1]
+      - host: admin@10.10.0.4
+
+Hosts are added concurrently, at most ` + "`--parallel`" + ` at a time, and the
+outcome for each host is reported. Hosts which are already machines in the
+model are skipped, so the same inventory can be used again after a failure.
+When hosts are added concurrently, sudo cannot prompt for a password.
+
 ### Container creation
 
 If ` + "`lxd`" + ` is specified, ` + "`add-machine` " + `will allocate a container of that type on a new machine
@@ -136,6 +154,10 @@ Allocate a machine specifying a public key to set in the list of authorized keys
 
 	juju add-machine ssh:user@10.10.0.3 --public-key /tmp/id_ed25519.pub --private-key /tmp/id_ed25519
 
+Allocate the machines listed in an inventory file to the model, 8 at a time:
+
+	juju add-machine --inventory hosts.yaml --parallel 8
+
 Allocate a machine to the model. Note: specific to MAAS.
 
 	juju add-machine host.internal
@@ -151,6 +173,7 @@ type addCommand struct {
 	baseMachinesCommand
 	modelConfigAPI    ModelConfigAPI
 	machineManagerAPI MachineManagerAPI
+	statusAPI         statusAPI
 	// Base defines the base the machine should use instead of the
 	// default-base.
 	Base string
@@ -170,12 +193,16 @@ type addCommand struct {
 	// PublicKey is the path for a file containing a public key required
 	// by the server
 	PublicKey string
+	// Inventory is the path for a file listing hosts to add over SSH.
+	Inventory string
+	// Parallel is the number of inventory hosts to add at once.
+	Parallel int
 }
 
 func (c *addCommand) Info() *cmd.Info {
 	return jujucmd.Info(&cmd.Info{
 		Name:     "add-machine",
-		Args:     "[lxd[:<machine-id>] | ssh:[<user>@]<host> | <placement>] | <private-key> | <public-key>",
+		Args:     "[lxd[:<machine-id>] | ssh:[<user>@]<host> | <placement>] | <private-key> | <public-key> | <inventory>",
 		Purpose:  "Provision a new machine or assign one to the model.",
 		Doc:      addMachineDoc,
 		Examples: addMachineExamples,
@@ -195,6 +222,8 @@ func (c *addCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.Var(disksFlag{&c.Disks}, "disks", "Specify the storage directives for disks to attach to the machine(s)")
 	f.StringVar(&c.PrivateKey, "private-key", "", "Specify the path to the private key to use during the connection")
 	f.StringVar(&c.PublicKey, "public-key", "", "Specify the path to the public key to add to the remote authorized keys")
+	f.StringVar(&c.Inventory, "inventory", "", "Specify the path to a YAML file listing hosts to add over SSH")
+	f.IntVar(&c.Parallel, "parallel", 4, "Specify the number of inventory hosts to add at once")
 }
 
 func (c *addCommand) Init(args []string) error {
@@ -216,6 +245,19 @@ func (c *addCommand) Init(args []string) error {
 	if c.NumMachines > 1 && c.Placement != nil && c.Placement.Directive != "" {
 		return errors.New("cannot use -n when specifying a placement directive")
 	}
+	if c.Parallel < 1 {
+		return errors.New("--parallel must be at least 1")
+	}
+	if c.Inventory != "" {
+		switch {
+		case c.Placement != nil:
+			return errors.New("cannot use --inventory when specifying a placement directive")
+		case c.NumMachines > 1:
+			return errors.New("cannot use -n with --inventory")
+		case c.Base != "" || len(c.ConstraintsStr) > 0 || len(c.Disks) > 0:
+			return errors.New("cannot use --base, --constraints or --disks with --inventory")
+		}
+	}
 	return nil
 }
 
@@ -261,6 +303,13 @@ func (c *addCommand) newMachineManagerClient(ctx context.Context) (*machinemanag
 	return machinemanager.NewClient(root), nil
 }
 
+func (c *addCommand) getStatusAPI(ctx context.Context) (statusAPI, error) {
+	if c.statusAPI != nil {
+		return c.statusAPI, nil
+	}
+	return c.NewAPIClient(ctx)
+}
+
 func (c *addCommand) getMachineManagerAPI(ctx context.Context) (MachineManagerAPI, error) {
 	if c.machineManagerAPI != nil {
 		return c.machineManagerAPI, nil
@@ -307,6 +356,10 @@ func (c *addCommand) Run(ctx *cmd.Context) error {
 		return errors.Trace(err)
 	}
 
+	if c.Inventory != "" {
+		return c.addInventoryMachines(ctx, machineManager, cfg)
+	}
+
 	if c.Placement != nil {
 		err := c.tryManualProvision(ctx, machineManager, cfg)
 		if err != errNonManualScope {
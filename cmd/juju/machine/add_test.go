@@ -5,8 +5,11 @@ package machine_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	stdtesting "testing"
 	"time"
 
@@ -99,6 +102,21 @@ func (s *AddMachineSuite) TestInit(c *tc.C) {
 		}, {
 			args:        []string{"anything", "else"},
 			errorString: `unrecognized args: \["else"\]`,
+		}, {
+			args:  []string{"--inventory", "hosts.yaml"},
+			count: 1,
+		}, {
+			args:        []string{"--inventory", "hosts.yaml", "ssh:10.10.0.3"},
+			errorString: "cannot use --inventory when specifying a placement directive",
+		}, {
+			args:        []string{"--inventory", "hosts.yaml", "-n", "2"},
+			errorString: "cannot use -n with --inventory",
+		}, {
+			args:        []string{"--inventory", "hosts.yaml", "--constraints", "mem=8G"},
+			errorString: `cannot use --base, --constraints or --disks with --inventory`,
+		}, {
+			args:        []string{"--parallel", "0"},
+			errorString: "--parallel must be at least 1",
 		}, {
 			args:      []string{"something:special"},
 			count:     1,
@@ -166,6 +184,88 @@ func (s *AddMachineSuite) TestSSHPlacementError(c *tc.C) {
 	c.Assert(cmdtesting.Stderr(context), tc.Equals, "")
 }
 
+func (s *AddMachineSuite) writeInventory(c *tc.C, content string) string {
+	path := filepath.Join(c.MkDir(), "hosts.yaml")
+	err := os.WriteFile(path, []byte(content), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+	return path
+}
+
+func (s *AddMachineSuite) runInventory(c *tc.C, args ...string) (*cmd.Context, error) {
+	add := machine.NewAddCommandWithStatusForTest(s.fakeAddMachine, s.fakeAddMachine, &fakeStatusAPI{})
+	return cmdtesting.RunCommand(c, add, args...)
+}
+
+func (s *AddMachineSuite) TestInventory(c *tc.C) {
+	var (
+		mu   sync.Mutex
+		args = make(map[string]manual.ProvisionMachineArgs)
+	)
+	s.PatchValue(machine.SSHProvisioner, func(_ context.Context, a manual.ProvisionMachineArgs) (string, error) {
+		mu.Lock()
+		args[a.Host] = a
+		mu.Unlock()
+		switch a.Host {
+		case "10.10.0.4":
+			return "", manual.ErrProvisioned
+		case "10.10.0.5":
+			return "", errors.New("failed to initialize warp core")
+		}
+		return "42", nil
+	})
+	path := s.writeInventory(c, `
+hosts:
+  - host: 10.10.0.3
+    user: ubuntu
+    spaces: [db]
+    tags: [rack-1]
+  - host: 10.0.0.1
+  - host: admin@10.10.0.4
+  - host: 10.10.0.5
+`)
+	ctx, err := s.runInventory(c, "--inventory", path)
+	c.Assert(err, tc.ErrorMatches, "failed to add 1 of 4 hosts")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, `
+created machine 42 for 10.10.0.3
+skipped 10.0.0.1: already machine 0
+skipped 10.10.0.4: machine is already provisioned
+failed to add 10.10.0.5: failed to initialize warp core
+`[1:])
+
+	c.Assert(args, tc.HasLen, 3)
+	c.Check(args["10.10.0.3"].User, tc.Equals, "ubuntu")
+	c.Check(args["10.10.0.3"].Constraints.String(), tc.Equals, "tags=rack-1 spaces=db")
+	c.Check(args["10.10.0.4"].User, tc.Equals, "admin")
+	c.Check(args["10.10.0.4"].Constraints.String(), tc.Equals, "")
+}
+
+func (s *AddMachineSuite) TestInventoryInvalid(c *tc.C) {
+	for i, test := range []struct {
+		content     string
+		errorString string
+	}{{
+		content:     "hosts: []",
+		errorString: `inventory ".*" with no hosts not valid`,
+	}, {
+		content:     "hosts:\n  - host: 10.10.0.3\n    address: 10.10.0.4",
+		errorString: `parsing inventory .*: yaml: unmarshal errors:\n.*`,
+	}, {
+		content:     "hosts:\n  - host: 10.10.0.3\n  - host: ubuntu@10.10.0.3",
+		errorString: `inventory host "10.10.0.3" listed more than once not valid`,
+	}, {
+		content:     "hosts:\n  - host: ubuntu@10.10.0.3\n    user: admin",
+		errorString: `inventory host "10.10.0.3" with conflicting users "ubuntu" and "admin" not valid`,
+	}, {
+		content:     "hosts:\n  - host: 10.10.0.3\n    spaces: [Not-Valid]",
+		errorString: `inventory host "10.10.0.3" space "Not-Valid" not valid`,
+	}} {
+		c.Logf("test %d", i)
+		path := s.writeInventory(c, test.content)
+		_, err := s.runInventory(c, "--inventory", path)
+		c.Check(err, tc.ErrorMatches, test.errorString)
+	}
+}
+
 func (s *AddMachineSuite) TestParamsPassedOn(c *tc.C) {
 	_, err := s.run(c, "--constraints", "mem=8G", "--base=ubuntu@22.04", "zone=nz")
 	c.Assert(err, tc.ErrorIsNil)
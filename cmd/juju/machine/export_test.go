@@ -29,6 +29,18 @@ func NewAddCommandForTest(mcAPI ModelConfigAPI, mmAPI MachineManagerAPI) (cmd.Co
 	return modelcmd.Wrap(command), &AddCommand{command}
 }
 
+// NewAddCommandWithStatusForTest returns an AddCommand with the api provided
+// as specified, which uses the status api to find the machines in the model.
+func NewAddCommandWithStatusForTest(mcAPI ModelConfigAPI, mmAPI MachineManagerAPI, api statusAPI) cmd.Command {
+	command := &addCommand{
+		machineManagerAPI: mmAPI,
+		modelConfigAPI:    mcAPI,
+		statusAPI:         api,
+	}
+	command.SetClientStore(jujuclienttesting.MinimalStore())
+	return modelcmd.Wrap(command)
+}
+
 // NewListCommandForTest returns a listMachineCommand with specified api
 func NewListCommandForTest(api statusAPI) cmd.Command {
 	command := newListMachinesCommand(api)
@@ -0,0 +1,272 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machine
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"golang.org/x/sync/errgroup"
+	"gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/core/constraints"
+	domainmachine "github.com/juju/juju/domain/machine"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/environs/manual"
+	"github.com/juju/juju/rpc/params"
+)
+
+// inventory describes the hosts to add to the model with
+// add-machine --inventory.
+type inventory struct {
+	Hosts []inventoryHost `yaml:"hosts"`
+}
+
+// inventoryHost describes a single host in an inventory file.
+type inventoryHost struct {
+	// Host is the address of the host, optionally prefixed with
+	// "<user>@".
+	Host string `yaml:"host"`
+
+	// User is the user to connect to the host as over SSH.
+	User string `yaml:"user,omitempty"`
+
+	// Spaces are recorded as a spaces constraint on the machine.
+	Spaces []string `yaml:"spaces,omitempty"`
+
+	// Tags are recorded as a tags constraint on the machine.
+	Tags []string `yaml:"tags,omitempty"`
+}
+
+// constraints returns the constraints to record against the machine added
+// for the host.
+func (h inventoryHost) constraints() constraints.Value {
+	var cons constraints.Value
+	if len(h.Spaces) > 0 {
+		cons.Spaces = &h.Spaces
+	}
+	if len(h.Tags) > 0 {
+		cons.Tags = &h.Tags
+	}
+	return cons
+}
+
+// readInventory reads and validates the inventory file at the given path.
+// Hosts given as "<user>@<host>" are split into their user and host.
+func readInventory(path string) ([]inventoryHost, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Annotate(err, "reading inventory")
+	}
+	var inv inventory
+	if err := yaml.UnmarshalStrict(data, &inv); err != nil {
+		return nil, errors.Annotatef(err, "parsing inventory %q", path)
+	}
+	if len(inv.Hosts) == 0 {
+		return nil, errors.NotValidf("inventory %q with no hosts", path)
+	}
+
+	seen := make(map[string]bool)
+	for i, h := range inv.Hosts {
+		user, host := splitUserHost(h.Host)
+		if host == "" {
+			return nil, errors.NotValidf("inventory host %d with no address", i+1)
+		}
+		if user != "" && h.User != "" && user != h.User {
+			return nil, errors.NotValidf("inventory host %q with conflicting users %q and %q", host, user, h.User)
+		}
+		if seen[host] {
+			return nil, errors.NotValidf("inventory host %q listed more than once", host)
+		}
+		seen[host] = true
+		for _, space := range h.Spaces {
+			if !names.IsValidSpace(space) {
+				return nil, errors.NotValidf("inventory host %q space %q", host, space)
+			}
+		}
+		for _, tag := range h.Tags {
+			if tag == "" {
+				return nil, errors.NotValidf("inventory host %q with empty tag", host)
+			}
+		}
+		inv.Hosts[i].Host = host
+		if user != "" {
+			inv.Hosts[i].User = user
+		}
+	}
+	return inv.Hosts, nil
+}
+
+// inventoryResult records the outcome of adding an inventory host.
+type inventoryResult struct {
+	host    string
+	machine string
+	skipped string
+	err     error
+}
+
+// knownHosts returns the hosts of the machines already in the model, keyed
+// by the address used to add them, the DNS name or any IP address of the
+// machine, with the machine ID as the value.
+func knownHosts(fullStatus *params.FullStatus) map[string]string {
+	known := make(map[string]string)
+	for id, m := range fullStatus.Machines {
+		if host, ok := strings.CutPrefix(m.InstanceId.String(), domainmachine.ManualInstancePrefix); ok {
+			known[host] = id
+		}
+		if m.DNSName != "" {
+			known[m.DNSName] = id
+		}
+		for _, addr := range m.IPAddresses {
+			known[addr] = id
+		}
+	}
+	return known
+}
+
+// addInventoryMachines adds the hosts in the inventory file to the model over
+// SSH, at most c.Parallel at a time. Hosts which are already machines in the
+// model, or which already run a machine agent, are skipped so that the
+// inventory can be applied again after a partial failure.
+func (c *addCommand) addInventoryMachines(
+	ctx *cmd.Context, client manual.ProvisioningClientAPI, cfg *config.Config,
+) error {
+	hosts, err := readInventory(ctx.AbsPath(c.Inventory))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	authKeys, err := common.ReadAuthorizedKeys(c.PublicKey)
+	if err != nil {
+		return errors.Annotatef(err, "cannot reading authorized-keys")
+	}
+
+	statusClient, err := c.getStatusAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer statusClient.Close()
+	fullStatus, err := statusClient.Status(ctx, nil)
+	if err != nil {
+		return errors.Annotate(err, "getting machines in the model")
+	}
+	known := knownHosts(fullStatus)
+
+	// Prompts for a sudo password cannot be answered when hosts are added
+	// concurrently, so they only read from stdin one at a time.
+	stdin := ctx.Stdin
+	if c.Parallel > 1 {
+		stdin = strings.NewReader("")
+	}
+	var outputMu sync.Mutex
+
+	results := make([]inventoryResult, len(hosts))
+	var group errgroup.Group
+	group.SetLimit(c.Parallel)
+	for i, h := range hosts {
+		results[i].host = h.Host
+		if id, ok := known[h.Host]; ok {
+			results[i].skipped = fmt.Sprintf("already machine %s", id)
+			continue
+		}
+
+		output := &hostWriter{prefix: h.Host + ": ", mu: &outputMu, w: ctx.Stderr}
+		args := manual.ProvisionMachineArgs{
+			Host:           h.Host,
+			User:           h.User,
+			Client:         client,
+			Stdin:          stdin,
+			Stdout:         output,
+			Stderr:         output,
+			AuthorizedKeys: authKeys,
+			PrivateKey:     c.PrivateKey,
+			Constraints:    h.constraints(),
+			UpdateBehavior: &params.UpdateBehavior{
+				EnableOSRefreshUpdate: cfg.EnableOSRefreshUpdate(),
+				EnableOSUpgrade:       cfg.EnableOSUpgrade(),
+			},
+		}
+		group.Go(func() error {
+			defer output.Flush()
+			machineId, err := sshProvisioner(ctx, args)
+			switch {
+			case errors.Is(err, manual.ErrProvisioned):
+				results[i].skipped = err.Error()
+			case err != nil:
+				results[i].err = err
+			default:
+				results[i].machine = machineId
+			}
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	return reportInventoryResults(ctx, results)
+}
+
+// reportInventoryResults writes the outcome for each host, in inventory
+// order, and returns an error if any host could not be added.
+func reportInventoryResults(ctx *cmd.Context, results []inventoryResult) error {
+	var failed int
+	for _, result := range results {
+		switch {
+		case result.err != nil:
+			failed++
+			ctx.Infof("failed to add %s: %v", result.host, result.err)
+		case result.skipped != "":
+			ctx.Infof("skipped %s: %s", result.host, result.skipped)
+		default:
+			ctx.Infof("created machine %v for %s", result.machine, result.host)
+		}
+	}
+	if failed > 0 {
+		return errors.Errorf("failed to add %d of %d hosts", failed, len(results))
+	}
+	return nil
+}
+
+// hostWriter prefixes each line written to it so that the output of hosts
+// being added concurrently can be told apart.
+type hostWriter struct {
+	prefix string
+	mu     *sync.Mutex
+	w      io.Writer
+	buf    bytes.Buffer
+}
+
+// Write implements io.Writer, writing out each complete line.
+func (w *hostWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+	for {
+		line, err := w.buf.ReadBytes('\n')
+		if err != nil {
+			// Keep the incomplete line until the rest of it is written.
+			w.buf.Write(line)
+			return len(p), nil
+		}
+		w.writeLine(line)
+	}
+}
+
+// Flush writes out any incomplete line.
+func (w *hostWriter) Flush() {
+	if w.buf.Len() > 0 {
+		w.writeLine(append(w.buf.Bytes(), '\n'))
+		w.buf.Reset()
+	}
+}
+
+func (w *hostWriter) writeLine(line []byte) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_, _ = fmt.Fprintf(w.w, "%s%s", w.prefix, line)
+}
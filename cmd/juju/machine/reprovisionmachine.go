@@ -48,6 +48,10 @@ data are NOT recovered. The replacement instance will have empty storage.
 This command is only supported for top-level, non-controller, IaaS
 provider-backed machines without child container machines or attached
 model-scoped storage.
+
+Machines can instead be reprovisioned automatically when their cloud
+instance stops or disappears by setting the machine-recovery-policy model
+config key to "reprovision".
 `
 
 const reprovisionMachineExamples = `
@@ -0,0 +1,308 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"context"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/application"
+	"github.com/juju/juju/api/client/client"
+	"github.com/juju/juju/api/controller/migrationtarget"
+	"github.com/juju/juju/api/jujuclient"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/migration/exportarchive"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewCloneModelCommand returns a fully constructed clone-model command.
+func NewCloneModelCommand() cmd.Command {
+	command := &cloneModelCommand{}
+	command.newSourceAPIFunc = func(ctx context.Context) (CloneModelSourceAPI, error) {
+		return command.getSourceAPI(ctx)
+	}
+	command.newImportAPIFunc = func(ctx context.Context) (ImportModelAPI, error) {
+		return command.getImportAPI(ctx)
+	}
+	command.newTargetAPIFunc = func(ctx context.Context, modelName string) (CloneModelTargetAPI, error) {
+		return command.getTargetAPI(ctx, modelName)
+	}
+	return modelcmd.Wrap(command, modelcmd.WrapSkipModelFlags)
+}
+
+type cloneModelCommand struct {
+	modelcmd.ModelCommandBase
+	newSourceAPIFunc func(ctx context.Context) (CloneModelSourceAPI, error)
+	newImportAPIFunc func(ctx context.Context) (ImportModelAPI, error)
+	newTargetAPIFunc func(ctx context.Context, modelName string) (CloneModelTargetAPI, error)
+
+	target       string
+	withoutUnits bool
+}
+
+const cloneModelHelpDoc = `
+Creates a copy of a model on the same controller.
+
+The new model has the same applications, charms, configuration,
+relations, constraints, offers and secrets as the source model, but none
+of its machines: everything in the copy is provisioned from scratch. The
+copy is given a fresh identity, so it is entirely independent of the
+source model, which keeps running unchanged.
+
+By default each application in the copy is given as many units as it has
+in the source model. Use --without-units to create the applications with
+no units, so they can be scaled as needed.
+
+Models taking part in cross-model relations can't be cloned. Secrets
+held in an external secret backend are cloned without their content.
+Cloning a model requires superuser access to the controller.
+`
+
+const cloneModelHelpExamples = `
+    juju clone-model staging staging-copy
+    juju clone-model staging staging-copy --without-units
+`
+
+// Info implements Command.
+func (c *cloneModelCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "clone-model",
+		Args:     "<source model name> <target model name>",
+		Purpose:  "Creates a copy of a model on the same controller.",
+		Doc:      cloneModelHelpDoc,
+		Examples: cloneModelHelpExamples,
+		SeeAlso: []string{
+			"add-model",
+			"export-model",
+			"import-model",
+		},
+	})
+}
+
+// SetFlags implements Command.
+func (c *cloneModelCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.BoolVar(&c.withoutUnits, "without-units", false, "Create the applications in the copy without units")
+}
+
+// Init implements Command.
+func (c *cloneModelCommand) Init(args []string) error {
+	switch len(args) {
+	case 0:
+		return errors.New("no source model specified")
+	case 1:
+		return errors.New("no target model specified")
+	}
+	if err := c.SetModelIdentifier(args[0], false); err != nil {
+		return errors.Trace(err)
+	}
+	c.target = args[1]
+	if !names.IsValidModelName(c.target) {
+		return errors.NotValidf("model name %q", c.target)
+	}
+	return cmd.CheckEmpty(args[2:])
+}
+
+// CloneModelSourceAPI specifies the used function calls of the Client
+// facade of the source model.
+type CloneModelSourceAPI interface {
+	Close() error
+	Status(ctx context.Context, args *client.StatusArgs) (*params.FullStatus, error)
+	CloneModelExport(ctx context.Context, name string, withoutUnits bool) (io.ReadCloser, error)
+}
+
+// CloneModelTargetAPI specifies the used function calls of the Application
+// facade of the cloned model.
+type CloneModelTargetAPI interface {
+	Close() error
+	AddUnits(ctx context.Context, args application.AddUnitsParams) ([]string, error)
+}
+
+func (c *cloneModelCommand) getSourceAPI(ctx context.Context) (CloneModelSourceAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return client.NewClient(root, logger), nil
+}
+
+func (c *cloneModelCommand) getImportAPI(ctx context.Context) (ImportModelAPI, error) {
+	root, err := c.NewControllerAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return importModelClient{
+		Client: migrationtarget.NewClient(root),
+		conn:   root,
+	}, nil
+}
+
+func (c *cloneModelCommand) getTargetAPI(ctx context.Context, modelName string) (CloneModelTargetAPI, error) {
+	controllerName, err := c.ControllerName()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	root, err := c.CommandBase.NewAPIRoot(ctx, c.ClientStore(), controllerName, modelName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return application.NewClient(root), nil
+}
+
+// Run implements Command.
+func (c *cloneModelCommand) Run(ctx *cmd.Context) error {
+	sourceName, err := c.ModelIdentifier()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	modelType, err := c.ModelType(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	sourceClient, err := c.newSourceAPIFunc(ctx)
+	if err != nil {
+		return err
+	}
+	defer sourceClient.Close()
+
+	// Kubernetes applications keep their scale in the clone. Machine
+	// applications have their units recreated once the clone exists.
+	var unitCounts map[string]int
+	if modelType == coremodel.IAAS && !c.withoutUnits {
+		status, err := sourceClient.Status(ctx, nil)
+		if err != nil {
+			return errors.Annotatef(err, "getting status of model %q", sourceName)
+		}
+		unitCounts = principalUnitCounts(status)
+	}
+
+	file, err := os.CreateTemp("", "juju-clone-model-*.tar")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+	envelope, manifest, err := c.writeClone(ctx, sourceClient, file)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	importClient, err := c.newImportAPIFunc(ctx)
+	if err != nil {
+		return err
+	}
+	defer importClient.Close()
+
+	// The copy holds none of the source model's machines or units, so it
+	// has no cloud resources of its own to adopt.
+	if err := importExport(ctx, importClient, envelope, manifest, file, false); err != nil {
+		return errors.Annotatef(err, "cloning model %q", sourceName)
+	}
+
+	targetName, err := c.recordClone(envelope)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := c.addUnits(ctx, targetName, unitCounts); err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Model %q successfully cloned to %q", sourceName, c.target)
+	return nil
+}
+
+// writeClone writes the export archive of the clone to the file, and
+// returns the envelope and manifest read back from it.
+func (c *cloneModelCommand) writeClone(
+	ctx context.Context,
+	sourceClient CloneModelSourceAPI,
+	file *os.File,
+) (params.SerializedModelV2, exportarchive.Manifest, error) {
+	archive, err := sourceClient.CloneModelExport(ctx, c.target, c.withoutUnits)
+	if err != nil {
+		return params.SerializedModelV2{}, exportarchive.Manifest{}, errors.Annotate(err, "exporting model")
+	}
+	defer archive.Close()
+
+	if _, err := io.Copy(file, archive); err != nil {
+		return params.SerializedModelV2{}, exportarchive.Manifest{}, errors.Annotate(err, "while copying in local file")
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return params.SerializedModelV2{}, exportarchive.Manifest{}, errors.Trace(err)
+	}
+	envelope, manifest, err := exportarchive.ReadModel(file)
+	if err != nil {
+		return params.SerializedModelV2{}, exportarchive.Manifest{}, errors.Annotate(err, "model export incomplete")
+	}
+	return envelope, manifest, nil
+}
+
+// recordClone adds the clone to the client store, returning its qualified
+// name.
+func (c *cloneModelCommand) recordClone(envelope params.SerializedModelV2) (string, error) {
+	controllerName, err := c.ControllerName()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	modelName := jujuclient.QualifyModelName(envelope.ModelInfo.Qualifier, envelope.ModelInfo.Name)
+	if err := c.ClientStore().UpdateModel(controllerName, modelName, jujuclient.ModelDetails{
+		ModelUUID: envelope.ModelInfo.UUID,
+		ModelType: coremodel.ModelType(envelope.ModelInfo.Type),
+	}); err != nil {
+		return "", errors.Trace(err)
+	}
+	return modelName, nil
+}
+
+// addUnits adds the given number of units to each application in the
+// clone. New machines are provisioned for the units.
+func (c *cloneModelCommand) addUnits(ctx *cmd.Context, modelName string, unitCounts map[string]int) error {
+	if len(unitCounts) == 0 {
+		return nil
+	}
+	targetClient, err := c.newTargetAPIFunc(ctx, modelName)
+	if err != nil {
+		return err
+	}
+	defer targetClient.Close()
+
+	appNames := make([]string, 0, len(unitCounts))
+	for appName := range unitCounts {
+		appNames = append(appNames, appName)
+	}
+	sort.Strings(appNames)
+	for _, appName := range appNames {
+		if _, err := targetClient.AddUnits(ctx, application.AddUnitsParams{
+			ApplicationName: appName,
+			NumUnits:        unitCounts[appName],
+		}); err != nil {
+			return errors.Annotatef(err, "model %q cloned, but adding units to %q failed", c.target, appName)
+		}
+	}
+	return nil
+}
+
+// principalUnitCounts returns the number of units of each principal
+// application in the status. Subordinate units follow their principals.
+func principalUnitCounts(status *params.FullStatus) map[string]int {
+	counts := make(map[string]int)
+	for appName, app := range status.Applications {
+		if len(app.SubordinateTo) > 0 || len(app.Units) == 0 {
+			continue
+		}
+		counts[appName] = len(app.Units)
+	}
+	return counts
+}
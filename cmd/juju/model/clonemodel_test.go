@@ -0,0 +1,200 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	stdtesting "testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/client/application"
+	"github.com/juju/juju/api/client/client"
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/model"
+	coremigration "github.com/juju/juju/core/migration"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+const cloneUUID = "c10e0000-0000-4000-8000-000000000001"
+
+type CloneModelCommandSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+	stub      *testhelpers.Stub
+	sourceAPI *fakeCloneModelSourceClient
+	importAPI *fakeImportModelClient
+	targetAPI *fakeCloneModelTargetClient
+	store     *jujuclient.MemStore
+	envelope  params.SerializedModelV2
+}
+
+func TestCloneModelCommandSuite(t *stdtesting.T) {
+	tc.Run(t, &CloneModelCommandSuite{})
+}
+
+func (s *CloneModelCommandSuite) SetUpTest(c *tc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.stub = &testhelpers.Stub{}
+	s.envelope = params.SerializedModelV2{
+		ModelInfo: params.SerializedModelInfo{
+			UUID:      cloneUUID,
+			Name:      "copy",
+			Qualifier: "admin",
+			Type:      "iaas",
+		},
+	}
+	s.sourceAPI = &fakeCloneModelSourceClient{
+		Stub:    s.stub,
+		archive: exportArchive(c, s.envelope),
+		status: &params.FullStatus{
+			Applications: map[string]params.ApplicationStatus{
+				"mysql":     {Units: map[string]params.UnitStatus{"mysql/0": {}, "mysql/1": {}}},
+				"wordpress": {Units: map[string]params.UnitStatus{"wordpress/0": {}}},
+				"logging":   {SubordinateTo: []string{"mysql"}},
+			},
+		},
+	}
+	s.importAPI = &fakeImportModelClient{
+		Stub:        s.stub,
+		version:     8,
+		charmBodies: make(map[string]string),
+	}
+	s.targetAPI = &fakeCloneModelTargetClient{Stub: s.stub}
+
+	s.store = jujuclient.NewMemStore()
+	s.store.CurrentControllerName = "testing"
+	s.store.Controllers["testing"] = jujuclient.ControllerDetails{}
+	s.store.Accounts["testing"] = jujuclient.AccountDetails{
+		User: "admin",
+	}
+	err := s.store.UpdateModel("testing", "admin/mymodel", jujuclient.ModelDetails{
+		ModelUUID: testing.ModelTag.Id(),
+		ModelType: coremodel.IAAS,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *CloneModelCommandSuite) runClone(c *tc.C, args ...string) (*cmd.Context, error) {
+	command := model.NewCloneModelCommandForTest(s.sourceAPI, s.importAPI, s.targetAPI, s.store)
+	return cmdtesting.RunCommand(c, command, args...)
+}
+
+func (s *CloneModelCommandSuite) TestCloneModel(c *tc.C) {
+	ctx, err := s.runClone(c, "mymodel", "copy")
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.stub.CheckCalls(c, []testhelpers.StubCall{
+		{FuncName: "Status"},
+		{FuncName: "CloneModelExport", Args: []any{"copy", false}},
+		{FuncName: "BestFacadeVersion"},
+		{FuncName: "PrechecksV2", Args: []any{s.envelope}},
+		{FuncName: "ImportV2", Args: []any{s.envelope}},
+		{FuncName: "UploadCharm", Args: []any{cloneUUID, "ch:amd64/app-1", "app-abcdef01"}},
+		{FuncName: "CheckMachines", Args: []any{cloneUUID}},
+		{FuncName: "Activate", Args: []any{cloneUUID, coremigration.SourceControllerInfo{}, []string(nil)}},
+		{FuncName: "AddUnits", Args: []any{application.AddUnitsParams{ApplicationName: "mysql", NumUnits: 2}}},
+		{FuncName: "AddUnits", Args: []any{application.AddUnitsParams{ApplicationName: "wordpress", NumUnits: 1}}},
+		{FuncName: "Close"},
+		{FuncName: "Close"},
+		{FuncName: "Close"},
+	})
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Model \"mymodel\" successfully cloned to \"copy\"\n")
+
+	details, err := s.store.ModelByName("testing", "admin/copy")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(*details, tc.DeepEquals, jujuclient.ModelDetails{
+		ModelUUID: cloneUUID,
+		ModelType: coremodel.IAAS,
+	})
+}
+
+func (s *CloneModelCommandSuite) TestCloneModelWithoutUnits(c *tc.C) {
+	_, err := s.runClone(c, "mymodel", "copy", "--without-units")
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.stub.CheckCallNames(c,
+		"CloneModelExport", "BestFacadeVersion", "PrechecksV2", "ImportV2",
+		"UploadCharm", "CheckMachines", "Activate",
+		"Close", "Close",
+	)
+	c.Check(s.stub.Calls()[0].Args, tc.DeepEquals, []any{"copy", true})
+}
+
+func (s *CloneModelCommandSuite) TestCloneModelImportFails(c *tc.C) {
+	s.stub.SetErrors(
+		nil, // Status
+		nil, // CloneModelExport
+		errors.New("model name in use"),
+	)
+
+	_, err := s.runClone(c, "mymodel", "copy")
+	c.Assert(err, tc.ErrorMatches, `cloning model "mymodel": controller prechecks failed: model name in use`)
+	_, err = s.store.ModelByName("testing", "admin/copy")
+	c.Check(err, tc.ErrorIs, errors.NotFound)
+}
+
+func (s *CloneModelCommandSuite) TestCloneModelIncompleteExport(c *tc.C) {
+	s.sourceAPI.archive = s.sourceAPI.archive[:len(s.sourceAPI.archive)/2]
+
+	_, err := s.runClone(c, "mymodel", "copy")
+	c.Assert(err, tc.ErrorMatches, "model export incomplete: .*")
+	s.stub.CheckCallNames(c, "Status", "CloneModelExport", "Close")
+}
+
+func (s *CloneModelCommandSuite) TestCloneModelArgs(c *tc.C) {
+	_, err := s.runClone(c)
+	c.Check(err, tc.ErrorMatches, "no source model specified")
+	_, err = s.runClone(c, "mymodel")
+	c.Check(err, tc.ErrorMatches, "no target model specified")
+	_, err = s.runClone(c, "mymodel", "Not_Valid")
+	c.Check(err, tc.ErrorMatches, `model name "Not_Valid" not valid`)
+	_, err = s.runClone(c, "mymodel", "copy", "extra")
+	c.Check(err, tc.ErrorMatches, `unrecognized args: \["extra"\]`)
+}
+
+type fakeCloneModelSourceClient struct {
+	*testhelpers.Stub
+	archive []byte
+	status  *params.FullStatus
+}
+
+func (f *fakeCloneModelSourceClient) Close() error {
+	f.MethodCall(f, "Close")
+	return nil
+}
+
+func (f *fakeCloneModelSourceClient) Status(ctx context.Context, args *client.StatusArgs) (*params.FullStatus, error) {
+	f.MethodCall(f, "Status")
+	return f.status, f.NextErr()
+}
+
+func (f *fakeCloneModelSourceClient) CloneModelExport(ctx context.Context, name string, withoutUnits bool) (io.ReadCloser, error) {
+	f.MethodCall(f, "CloneModelExport", name, withoutUnits)
+	if err := f.NextErr(); err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(f.archive)), nil
+}
+
+type fakeCloneModelTargetClient struct {
+	*testhelpers.Stub
+}
+
+func (f *fakeCloneModelTargetClient) Close() error {
+	f.MethodCall(f, "Close")
+	return nil
+}
+
+func (f *fakeCloneModelTargetClient) AddUnits(ctx context.Context, args application.AddUnitsParams) ([]string, error) {
+	f.MethodCall(f, "AddUnits", args)
+	return nil, f.NextErr()
+}
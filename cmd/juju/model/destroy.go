@@ -23,6 +23,7 @@ import (
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/cmd"
 	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/output"
@@ -58,6 +59,7 @@ type destroyCommand struct {
 	releaseStorage bool
 	api            DestroyModelAPI
 
+	DryRun bool
 	Force  bool
 	NoWait bool
 	fs     *gnuflag.FlagSet
@@ -91,6 +93,9 @@ consideration or respect for clean shutdown or resource cleanup. If timeout
 elapses with ` + "`--force`" + `, you may have resources left behind that will require
 manual cleanup. If ` + "`--force --timeout 0`" + ` is passed, the model is brutally
 removed with haste. It is recommended to use graceful destroy (without ` + "`--force`" + ` or ` + "`--no-wait`" + `).
+
+Use ` + "`--dry-run`" + ` to list the machines, applications, units, relations and
+storage that would be removed, without destroying the model.
 `
 
 const destroyExamples = `
@@ -99,6 +104,7 @@ const destroyExamples = `
     juju destroy-model --no-prompt mymodel --release-storage
     juju destroy-model --no-prompt mymodel --force
     juju destroy-model --no-prompt mymodel --force --no-wait
+    juju destroy-model mymodel --dry-run
 `
 
 var destroyModelMsg = `
@@ -136,6 +142,7 @@ type DestroyModelAPI interface {
 	Close() error
 	DestroyModel(ctx context.Context, tag names.ModelTag, destroyStorage, force *bool, maxWait *time.Duration, timeout *time.Duration) error
 	ModelStatus(ctx context.Context, models ...names.ModelTag) ([]base.ModelStatus, error)
+	PreviewDestroyModel(ctx context.Context, tag names.ModelTag, force *bool) (params.RemovalPreview, error)
 }
 
 // Info implements Command.Info.
@@ -162,6 +169,7 @@ func (c *destroyCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.DurationVar(&c.timeout, "timeout", unsetTimeout, "")
 	f.BoolVar(&c.destroyStorage, "destroy-storage", false, "Destroy all storage instances in the model")
 	f.BoolVar(&c.releaseStorage, "release-storage", false, "Release all storage instances from the model, and management of the controller, without destroying them")
+	f.BoolVar(&c.DryRun, "dry-run", false, "Print what this command would remove without destroying the model")
 	f.BoolVar(&c.Force, "force", false, "Force destroy model ignoring any errors")
 	f.BoolVar(&c.NoWait, "no-wait", false, "Rush through model destruction without waiting for each individual step to complete")
 	c.fs = f
@@ -273,6 +281,10 @@ func (c *destroyCommand) Run(ctx *cmd.Context) error {
 	defer func() { _ = api.Close() }()
 
 	modelTag := names.NewModelTag(modelDetails.ModelUUID)
+	if c.DryRun {
+		return c.performDryRun(ctx, api, modelTag, modelName)
+	}
+
 	modelStatus, err := getModelStatus(ctx, modelTag, api)
 	if err != nil {
 		return err
@@ -347,6 +359,20 @@ func (c *destroyCommand) Run(ctx *cmd.Context) error {
 	return nil
 }
 
+func (c *destroyCommand) performDryRun(ctx *cmd.Context, api DestroyModelAPI, modelTag names.ModelTag, modelName string) error {
+	var force *bool
+	if c.Force {
+		force = &c.Force
+	}
+	preview, err := api.PreviewDestroyModel(ctx, modelTag, force)
+	if err != nil {
+		return errors.Annotatef(err, "cannot preview destruction of model %q", modelName)
+	}
+	_, _ = fmt.Fprintf(ctx.Stdout, "will destroy model %s\n", modelName)
+	common.PrintRemovalPreview(ctx.Stdout, preview)
+	return nil
+}
+
 type modelData struct {
 	machineCount     int
 	applicationCount int
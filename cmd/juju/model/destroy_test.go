@@ -47,6 +47,7 @@ type fakeAPI struct {
 	statusCallCount    int
 	modelInfoErr       []*params.Error
 	modelStatusPayload []base.ModelStatus
+	preview            params.RemovalPreview
 }
 
 func (f *fakeAPI) Close() error { return nil }
@@ -56,6 +57,11 @@ func (f *fakeAPI) DestroyModel(ctx context.Context, tag names.ModelTag, destroyS
 	return f.NextErr()
 }
 
+func (f *fakeAPI) PreviewDestroyModel(ctx context.Context, tag names.ModelTag, force *bool) (params.RemovalPreview, error) {
+	f.MethodCall(f, "PreviewDestroyModel", tag, force)
+	return f.preview, f.NextErr()
+}
+
 func (f *fakeAPI) ModelStatus(_ context.Context, models ...names.ModelTag) ([]base.ModelStatus, error) {
 	var err error
 	if f.statusCallCount < len(f.modelInfoErr) {
@@ -187,6 +193,38 @@ func (s *DestroySuite) TestDestroy(c *tc.C) {
 	})
 }
 
+func (s *DestroySuite) TestDestroyDryRun(c *tc.C) {
+	s.api.preview = params.RemovalPreview{
+		Machines:         []params.Entity{{Tag: "machine-0"}},
+		Applications:     []params.Entity{{Tag: "application-mysql"}},
+		Units:            []params.Entity{{Tag: "unit-mysql-0"}},
+		DestroyedStorage: []params.Entity{{Tag: "storage-data-0"}},
+	}
+	ctx, err := s.runDestroyCommand(c, "test2", "--dry-run")
+	c.Assert(err, tc.ErrorIsNil)
+	checkModelExistsInStore(c, "test1:admin/test2", s.store)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+will destroy model test2
+- will remove machine 0
+- will remove application mysql
+- will remove unit mysql/0
+- will remove storage data/0
+`[1:])
+	s.stub.CheckCalls(c, []testhelpers.StubCall{
+		{"PreviewDestroyModel", []any{names.NewModelTag("test2-uuid"), (*bool)(nil)}},
+	})
+}
+
+func (s *DestroySuite) TestDestroyDryRunWithForce(c *tc.C) {
+	_, err := s.runDestroyCommand(c, "test2", "--dry-run", "--force")
+	c.Assert(err, tc.ErrorIsNil)
+	checkModelExistsInStore(c, "test1:admin/test2", s.store)
+	force := true
+	s.stub.CheckCalls(c, []testhelpers.StubCall{
+		{"PreviewDestroyModel", []any{names.NewModelTag("test2-uuid"), &force}},
+	})
+}
+
 func (s *DestroySuite) TestDestroyWithPartModelUUID(c *tc.C) {
 	checkModelExistsInStore(c, "test1:admin/test2", s.store)
 	s.api.modelStatusPayload = []base.ModelStatus{{}}
@@ -81,6 +81,46 @@ func NewExportBundleCommandForTest(bundleAPI ExportBundleAPI, store jujuclient.C
 	return modelcmd.Wrap(cmd)
 }
 
+// NewExportModelCommandForTest returns an ExportModelCommand with the api provided as specified.
+func NewExportModelCommandForTest(exportAPI ExportModelAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &exportModelCommand{newAPIFunc: func(ctx context.Context) (ExportModelAPI, error) {
+		return exportAPI, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd, modelcmd.WrapSkipModelFlags)
+}
+
+// NewImportModelCommandForTest returns an ImportModelCommand with the api provided as specified.
+func NewImportModelCommandForTest(importAPI ImportModelAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &importModelCommand{newAPIFunc: func(ctx context.Context) (ImportModelAPI, error) {
+		return importAPI, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.WrapController(cmd)
+}
+
+// NewCloneModelCommandForTest returns a CloneModelCommand with the apis provided as specified.
+func NewCloneModelCommandForTest(
+	sourceAPI CloneModelSourceAPI,
+	importAPI ImportModelAPI,
+	targetAPI CloneModelTargetAPI,
+	store jujuclient.ClientStore,
+) cmd.Command {
+	cmd := &cloneModelCommand{
+		newSourceAPIFunc: func(ctx context.Context) (CloneModelSourceAPI, error) {
+			return sourceAPI, nil
+		},
+		newImportAPIFunc: func(ctx context.Context) (ImportModelAPI, error) {
+			return importAPI, nil
+		},
+		newTargetAPIFunc: func(ctx context.Context, modelName string) (CloneModelTargetAPI, error) {
+			return targetAPI, nil
+		},
+	}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd, modelcmd.WrapSkipModelFlags)
+}
+
 // NewDestroyCommandForTest returns a DestroyCommand with the api provided as specified.
 func NewDestroyCommandForTest(
 	api DestroyModelAPI,
@@ -180,3 +220,14 @@ func NewModelCredentialCommandForTest(modelClient ModelCredentialAPI, cloudClien
 	cmd.SetClientStore(store)
 	return modelcmd.Wrap(cmd)
 }
+
+// NewRenameModelCommandForTest returns a RenameModelCommand with the api provided as specified.
+func NewRenameModelCommandForTest(api RenameModelAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &renameModelCommand{
+		newAPIFunc: func(ctx context.Context) (RenameModelAPI, error) {
+			return api, nil
+		},
+	}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd, modelcmd.WrapSkipModelFlags)
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/client"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/migration/exportarchive"
+)
+
+// NewExportModelCommand returns a fully constructed export-model command.
+func NewExportModelCommand() cmd.Command {
+	command := &exportModelCommand{}
+	command.newAPIFunc = func(ctx context.Context) (ExportModelAPI, error) {
+		return command.getAPI(ctx)
+	}
+	return modelcmd.Wrap(command, modelcmd.WrapSkipModelFlags)
+}
+
+type exportModelCommand struct {
+	modelcmd.ModelCommandBase
+	newAPIFunc func(ctx context.Context) (ExportModelAPI, error)
+	filename   string
+	lock       bool
+}
+
+const exportModelHelpDoc = `
+Writes an offline export of a model to a file.
+
+The export holds the model's description together with the charms,
+resources and agent binaries it uses, so that the model can be recreated
+on another controller with ` + "`juju import-model`" + `, without the two
+controllers ever needing to reach each other.
+
+The export is a point-in-time copy of the model; the model keeps running
+on this controller. Changes to the model are blocked while the export is
+written, so that it describes a single consistent state of the model.
+
+Use --lock to leave changes blocked once the export is written, when the
+model is being handed over to another controller. The model is unlocked
+again with ` + "`juju enable-command all`" + `.
+
+Exporting a model requires controller admin access.
+`
+
+const exportModelHelpExamples = `
+    juju export-model -o mymodel.tar
+    juju export-model mymodel --output mymodel.tar
+    juju export-model mymodel --lock -o mymodel.tar
+`
+
+// Info implements Command.
+func (c *exportModelCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "export-model",
+		Args:     "[<model name>]",
+		Purpose:  "Exports a model, with its charms, resources and agent binaries, to a file.",
+		Doc:      exportModelHelpDoc,
+		Examples: exportModelHelpExamples,
+		SeeAlso: []string{
+			"import-model",
+			"migrate",
+		},
+	})
+}
+
+// SetFlags implements Command.
+func (c *exportModelCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.StringVar(&c.filename, "o", "", "The file to write the export to")
+	f.StringVar(&c.filename, "output", "", "")
+	f.BoolVar(&c.lock, "lock", false, "Leave changes to the model blocked once it is exported")
+}
+
+// Init implements Command.
+func (c *exportModelCommand) Init(args []string) error {
+	modelName := ""
+	if len(args) > 0 {
+		modelName = args[0]
+		args = args[1:]
+	}
+	if err := c.SetModelIdentifier(modelName, true); err != nil {
+		return errors.Trace(err)
+	}
+	if c.filename == "" {
+		return errors.New("an output file must be specified with --output")
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// ExportModelAPI specifies the used function calls of the Client facade.
+type ExportModelAPI interface {
+	Close() error
+	ExportModel(ctx context.Context, lock bool) (io.ReadCloser, error)
+}
+
+func (c *exportModelCommand) getAPI(ctx context.Context) (ExportModelAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return client.NewClient(root, logger), nil
+}
+
+// Run implements Command.
+func (c *exportModelCommand) Run(ctx *cmd.Context) error {
+	modelName, err := c.ModelIdentifier()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	exportClient, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return err
+	}
+	defer exportClient.Close()
+
+	archive, err := exportClient.ExportModel(ctx, c.lock)
+	if err != nil {
+		return errors.Annotate(err, "exporting model")
+	}
+	defer archive.Close()
+
+	filename := ctx.AbsPath(c.filename)
+	if err := c.writeExport(filename, archive); err != nil {
+		_ = c.Filesystem().RemoveAll(filename)
+		return errors.Trace(err)
+	}
+
+	ctx.Infof("Model %q successfully exported to %s", modelName, c.filename)
+	return nil
+}
+
+// writeExport writes the archive to the named file, checking that it was
+// received in full. The controller can't report a failure once it has
+// started sending the archive, so a truncated export is detected by the
+// absence of its manifest.
+func (c *exportModelCommand) writeExport(filename string, archive io.Reader) error {
+	file, err := c.Filesystem().OpenFile(filename, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Annotate(err, "while creating local file")
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(file, archive); err != nil {
+		return errors.Annotate(err, "while copying in local file")
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return errors.Trace(err)
+	}
+	if _, _, err := exportarchive.ReadModel(file); err != nil {
+		return errors.Annotate(err, "model export incomplete")
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/model"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/migration/exportarchive"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type ExportModelCommandSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+	api   *fakeExportModelClient
+	store *jujuclient.MemStore
+}
+
+func TestExportModelCommandSuite(t *stdtesting.T) {
+	tc.Run(t, &ExportModelCommandSuite{})
+}
+
+func (s *ExportModelCommandSuite) SetUpTest(c *tc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.api = &fakeExportModelClient{Stub: &testhelpers.Stub{}}
+	s.store = jujuclient.NewMemStore()
+	s.store.CurrentControllerName = "testing"
+	s.store.Controllers["testing"] = jujuclient.ControllerDetails{}
+	s.store.Accounts["testing"] = jujuclient.AccountDetails{
+		User: "admin",
+	}
+	err := s.store.UpdateModel("testing", "admin/mymodel", jujuclient.ModelDetails{
+		ModelUUID: testing.ModelTag.Id(),
+		ModelType: coremodel.IAAS,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	s.store.Models["testing"].CurrentModel = "admin/mymodel"
+}
+
+func (s *ExportModelCommandSuite) TestExportModel(c *tc.C) {
+	s.api.archive = exportArchive(c, params.SerializedModelV2{
+		ModelInfo: params.SerializedModelInfo{
+			UUID: testing.ModelTag.Id(),
+			Name: "mymodel",
+		},
+	})
+	filename := filepath.Join(c.MkDir(), "mymodel.tar")
+
+	ctx, err := cmdtesting.RunCommand(c, model.NewExportModelCommandForTest(s.api, s.store), "mymodel", "-o", filename)
+	c.Assert(err, tc.ErrorIsNil)
+	s.api.CheckCalls(c, []testhelpers.StubCall{
+		{FuncName: "ExportModel", Args: []any{false}},
+		{FuncName: "Close"},
+	})
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Model \"mymodel\" successfully exported to "+filename+"\n")
+
+	data, err := os.ReadFile(filename)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(data, tc.DeepEquals, s.api.archive)
+}
+
+func (s *ExportModelCommandSuite) TestExportModelLock(c *tc.C) {
+	s.api.archive = exportArchive(c, params.SerializedModelV2{})
+	filename := filepath.Join(c.MkDir(), "mymodel.tar")
+
+	_, err := cmdtesting.RunCommand(c, model.NewExportModelCommandForTest(s.api, s.store), "--lock", "-o", filename)
+	c.Assert(err, tc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "ExportModel", true)
+}
+
+func (s *ExportModelCommandSuite) TestExportModelTruncated(c *tc.C) {
+	archive := exportArchive(c, params.SerializedModelV2{})
+	s.api.archive = archive[:len(archive)/2]
+	filename := filepath.Join(c.MkDir(), "mymodel.tar")
+
+	_, err := cmdtesting.RunCommand(c, model.NewExportModelCommandForTest(s.api, s.store), "--output", filename)
+	c.Assert(err, tc.ErrorMatches, "model export incomplete: .*")
+	_, err = os.Stat(filename)
+	c.Check(os.IsNotExist(err), tc.IsTrue)
+}
+
+func (s *ExportModelCommandSuite) TestExportModelNoOutput(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, model.NewExportModelCommandForTest(s.api, s.store))
+	c.Assert(err, tc.ErrorMatches, "an output file must be specified with --output")
+}
+
+// exportArchive returns an export archive holding the envelope and a
+// single charm.
+func exportArchive(c *tc.C, envelope params.SerializedModelV2) []byte {
+	var buf bytes.Buffer
+	w := exportarchive.NewWriter(&buf, time.Now())
+	c.Assert(w.WriteModel(envelope), tc.ErrorIsNil)
+	_, err := w.UploadCharm(c.Context(), "ch:amd64/app-1", "app-abcdef01", strings.NewReader("charm body"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(w.Close(), tc.ErrorIsNil)
+	return buf.Bytes()
+}
+
+type fakeExportModelClient struct {
+	*testhelpers.Stub
+	archive []byte
+}
+
+func (f *fakeExportModelClient) Close() error {
+	f.MethodCall(f, "Close")
+	return f.NextErr()
+}
+
+func (f *fakeExportModelClient) ExportModel(ctx context.Context, lock bool) (io.ReadCloser, error) {
+	f.MethodCall(f, "ExportModel", lock)
+	if err := f.NextErr(); err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(f.archive)), nil
+}
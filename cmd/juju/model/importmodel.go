@@ -0,0 +1,296 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/api/controller/migrationtarget"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	coremigration "github.com/juju/juju/core/migration"
+	"github.com/juju/juju/core/resource"
+	"github.com/juju/juju/core/semversion"
+	"github.com/juju/juju/internal/migration"
+	"github.com/juju/juju/internal/migration/exportarchive"
+	"github.com/juju/juju/internal/tools"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewImportModelCommand returns a fully constructed import-model command.
+func NewImportModelCommand() cmd.Command {
+	command := &importModelCommand{}
+	command.newAPIFunc = func(ctx context.Context) (ImportModelAPI, error) {
+		return command.getAPI(ctx)
+	}
+	return modelcmd.WrapController(command)
+}
+
+type importModelCommand struct {
+	modelcmd.ControllerCommandBase
+	newAPIFunc func(ctx context.Context) (ImportModelAPI, error)
+	filename   string
+	adopt      bool
+}
+
+const importModelHelpDoc = `
+Imports a model from a file written by ` + "`juju export-model`" + `.
+
+The model is recreated on the current controller, together with the
+charms, resources and agent binaries held in the export, following the
+same steps as a model migration. The source controller does not need to
+be reachable.
+
+The machines and units of the imported model keep running; their agents
+are not redirected to the importing controller by the import. Importing
+a model requires superuser access to the controller, and the controller
+must not already host a model with the same UUID or the same owner and
+name.
+
+The cloud resources of the model, such as instances and volumes, are
+left tagged with the controller the model was exported from, unless
+--adopt is given. Resources can only be adopted from an export written
+with ` + "`juju export-model --lock`" + `, which leaves the model locked
+against changes on its own controller, so that the two controllers do
+not both manage them.
+`
+
+const importModelHelpExamples = `
+    juju import-model mymodel.tar
+    juju import-model -c other-controller mymodel.tar
+    juju import-model --adopt mymodel.tar
+`
+
+// Info implements Command.
+func (c *importModelCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "import-model",
+		Args:     "<export file>",
+		Purpose:  "Imports a model from a file written by export-model.",
+		Doc:      importModelHelpDoc,
+		Examples: importModelHelpExamples,
+		SeeAlso: []string{
+			"export-model",
+			"migrate",
+		},
+	})
+}
+
+// SetFlags implements Command.
+func (c *importModelCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.BoolVar(&c.adopt, "adopt", false, "Take over the cloud resources of the model from the controller it was exported from")
+}
+
+// Init implements Command.
+func (c *importModelCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no export file specified")
+	}
+	c.filename, args = args[0], args[1:]
+	return cmd.CheckEmpty(args)
+}
+
+// ImportModelAPI specifies the used function calls of the MigrationTarget
+// facade.
+type ImportModelAPI interface {
+	Close() error
+	BestFacadeVersion() int
+	PrechecksV2(ctx context.Context, envelope params.SerializedModelV2) error
+	ImportV2(ctx context.Context, envelope params.SerializedModelV2) error
+	UploadCharm(ctx context.Context, modelUUID string, curl string, charmRef string, content io.Reader) (string, error)
+	UploadTools(ctx context.Context, modelUUID string, r io.Reader, vers semversion.Binary) (tools.List, error)
+	UploadResource(ctx context.Context, modelUUID string, res resource.Resource, r io.Reader) error
+	CheckMachines(ctx context.Context, modelUUID string) ([]error, error)
+	Activate(ctx context.Context, modelUUID string, sourceInfo coremigration.SourceControllerInfo, relatedModels []string) error
+	AdoptResources(ctx context.Context, modelUUID string) error
+	Abort(ctx context.Context, modelUUID string) error
+}
+
+// importModelClient closes the connection the MigrationTarget client was
+// created with.
+type importModelClient struct {
+	*migrationtarget.Client
+	conn api.Connection
+}
+
+// Close implements ImportModelAPI.
+func (c importModelClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *importModelCommand) getAPI(ctx context.Context) (ImportModelAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return importModelClient{
+		Client: migrationtarget.NewClient(root),
+		conn:   root,
+	}, nil
+}
+
+// Run implements Command.
+func (c *importModelCommand) Run(ctx *cmd.Context) error {
+	file, err := c.Filesystem().Open(ctx.AbsPath(c.filename))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer file.Close()
+
+	envelope, manifest, err := exportarchive.ReadModel(file)
+	if err != nil {
+		return errors.Annotatef(err, "reading %s", c.filename)
+	}
+
+	client, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := importExport(ctx, client, envelope, manifest, file, c.adopt); err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Model %q successfully imported", envelope.ModelInfo.Name)
+	return nil
+}
+
+// importExport imports the model held in an export archive into the
+// controller, following the steps of a model migration. The envelope and
+// manifest are those read from the archive. The model's cloud resources are
+// only adopted if adopt is true.
+func importExport(
+	ctx *cmd.Context,
+	client ImportModelAPI,
+	envelope params.SerializedModelV2,
+	manifest exportarchive.Manifest,
+	file io.ReadSeeker,
+	adopt bool,
+) error {
+	modelName := envelope.ModelInfo.Name
+	modelUUID := envelope.ModelInfo.UUID
+	if adopt && !manifest.Locked {
+		return errors.Errorf("cannot adopt resources of model %q: the model was not locked when exported", modelName)
+	}
+
+	if err := migration.CheckTargetSupportsEnvelope(client.BestFacadeVersion()); err != nil {
+		return errors.Trace(err)
+	}
+	if err := client.PrechecksV2(ctx, envelope); err != nil {
+		return errors.Annotate(err, "controller prechecks failed")
+	}
+	if err := client.ImportV2(ctx, envelope); err != nil {
+		return errors.Annotatef(err, "importing model %q", modelName)
+	}
+
+	if err := completeImport(ctx, client, modelUUID, manifest, file); err != nil {
+		if abortErr := client.Abort(ctx, modelUUID); abortErr != nil {
+			ctx.Warningf("failed to abort import of model %q: %v", modelName, abortErr)
+		}
+		return errors.Annotatef(err, "importing model %q", modelName)
+	}
+
+	// Unlike a migration, the source controller may still be managing the
+	// model's resources, so they are only adopted when asked to. They are
+	// adopted once the model is active; failing to adopt them leaves a
+	// working model, so the import is not undone.
+	if !adopt {
+		return nil
+	}
+	if err := client.AdoptResources(ctx, modelUUID); err != nil {
+		return errors.Annotatef(err, "adopting resources of model %q", modelName)
+	}
+	return nil
+}
+
+// completeImport uploads the binaries held in the export to the imported
+// model, validates the model's machines and activates it.
+func completeImport(
+	ctx *cmd.Context,
+	client ImportModelAPI,
+	modelUUID string,
+	manifest exportarchive.Manifest,
+	file io.ReadSeeker,
+) error {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return errors.Trace(err)
+	}
+	if err := uploadExportBinaries(ctx, client, modelUUID, manifest, file); err != nil {
+		return errors.Trace(err)
+	}
+
+	machineErrs, err := client.CheckMachines(ctx, modelUUID)
+	if err != nil {
+		return errors.Annotate(err, "checking machines")
+	}
+	if len(machineErrs) > 0 {
+		messages := make([]string, len(machineErrs))
+		for i, err := range machineErrs {
+			messages[i] = err.Error()
+		}
+		return errors.Errorf("machine validation failed:\n\t%s", strings.Join(messages, "\n\t"))
+	}
+
+	// The model has no live relation to the controller it was exported
+	// from, so no source controller or related models are recorded.
+	return errors.Annotate(
+		client.Activate(ctx, modelUUID, coremigration.SourceControllerInfo{}, nil),
+		"activating model",
+	)
+}
+
+// uploadExportBinaries uploads each charm, agent binary and resource in the
+// export archive to the imported model, as described by the manifest.
+func uploadExportBinaries(
+	ctx context.Context,
+	client ImportModelAPI,
+	modelUUID string,
+	manifest exportarchive.Manifest,
+	archive io.Reader,
+) error {
+	charms := make(map[string]exportarchive.CharmEntry)
+	for _, entry := range manifest.Charms {
+		charms[entry.Path] = entry
+	}
+	agentBinaries := make(map[string]exportarchive.ToolsEntry)
+	for _, entry := range manifest.Tools {
+		agentBinaries[entry.Path] = entry
+	}
+	resources := make(map[string]exportarchive.ResourceEntry)
+	for _, entry := range manifest.Resources {
+		resources[entry.Path] = entry
+	}
+
+	return exportarchive.WalkBinaries(archive, func(name string, content io.Reader) error {
+		if entry, ok := charms[name]; ok {
+			_, err := client.UploadCharm(ctx, modelUUID, entry.URL, entry.Ref, content)
+			return errors.Annotatef(err, "uploading charm %s", entry.URL)
+		}
+		if entry, ok := agentBinaries[name]; ok {
+			vers, err := semversion.ParseBinary(entry.Version)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			_, err = client.UploadTools(ctx, modelUUID, content, vers)
+			return errors.Annotatef(err, "uploading agent binaries %s", vers)
+		}
+		if entry, ok := resources[name]; ok {
+			res, err := entry.Resource()
+			if err != nil {
+				return errors.Trace(err)
+			}
+			err = client.UploadResource(ctx, modelUUID, res, content)
+			return errors.Annotatef(err, "uploading resource %s/%s", res.ApplicationName, res.Name)
+		}
+		return errors.NotValidf("binary %s missing from export manifest", name)
+	})
+}
@@ -0,0 +1,212 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/model"
+	coremigration "github.com/juju/juju/core/migration"
+	"github.com/juju/juju/core/resource"
+	"github.com/juju/juju/core/semversion"
+	"github.com/juju/juju/internal/migration/exportarchive"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/tools"
+	"github.com/juju/juju/rpc/params"
+)
+
+type ImportModelCommandSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+	api      *fakeImportModelClient
+	store    *jujuclient.MemStore
+	filename string
+	envelope params.SerializedModelV2
+}
+
+func TestImportModelCommandSuite(t *stdtesting.T) {
+	tc.Run(t, &ImportModelCommandSuite{})
+}
+
+func (s *ImportModelCommandSuite) SetUpTest(c *tc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.api = &fakeImportModelClient{
+		Stub:        &testhelpers.Stub{},
+		version:     8,
+		charmBodies: make(map[string]string),
+	}
+	s.store = jujuclient.NewMemStore()
+	s.store.CurrentControllerName = "testing"
+	s.store.Controllers["testing"] = jujuclient.ControllerDetails{}
+	s.store.Accounts["testing"] = jujuclient.AccountDetails{
+		User: "admin",
+	}
+
+	s.envelope = params.SerializedModelV2{
+		ModelInfo: params.SerializedModelInfo{
+			UUID: testing.ModelTag.Id(),
+			Name: "mymodel",
+		},
+	}
+	s.filename = filepath.Join(c.MkDir(), "mymodel.tar")
+	err := os.WriteFile(s.filename, exportArchive(c, s.envelope), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *ImportModelCommandSuite) TestImportModel(c *tc.C) {
+	ctx, err := cmdtesting.RunCommand(c, model.NewImportModelCommandForTest(s.api, s.store), s.filename)
+	c.Assert(err, tc.ErrorIsNil)
+
+	modelUUID := testing.ModelTag.Id()
+	s.api.CheckCalls(c, []testhelpers.StubCall{
+		{FuncName: "BestFacadeVersion"},
+		{FuncName: "PrechecksV2", Args: []any{s.envelope}},
+		{FuncName: "ImportV2", Args: []any{s.envelope}},
+		{FuncName: "UploadCharm", Args: []any{modelUUID, "ch:amd64/app-1", "app-abcdef01"}},
+		{FuncName: "CheckMachines", Args: []any{modelUUID}},
+		{FuncName: "Activate", Args: []any{modelUUID, coremigration.SourceControllerInfo{}, []string(nil)}},
+		{FuncName: "Close"},
+	})
+	c.Check(s.api.charmBodies, tc.DeepEquals, map[string]string{
+		"ch:amd64/app-1": "charm body",
+	})
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Model \"mymodel\" successfully imported\n")
+}
+
+func (s *ImportModelCommandSuite) TestImportModelAdopt(c *tc.C) {
+	var buf bytes.Buffer
+	w := exportarchive.NewWriter(&buf, time.Now())
+	w.SetLocked()
+	c.Assert(w.WriteModel(s.envelope), tc.ErrorIsNil)
+	c.Assert(w.Close(), tc.ErrorIsNil)
+	err := os.WriteFile(s.filename, buf.Bytes(), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = cmdtesting.RunCommand(c, model.NewImportModelCommandForTest(s.api, s.store), "--adopt", s.filename)
+	c.Assert(err, tc.ErrorIsNil)
+	s.api.CheckCallNames(c,
+		"BestFacadeVersion", "PrechecksV2", "ImportV2",
+		"CheckMachines", "Activate", "AdoptResources", "Close",
+	)
+}
+
+func (s *ImportModelCommandSuite) TestImportModelAdoptNotLocked(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, model.NewImportModelCommandForTest(s.api, s.store), "--adopt", s.filename)
+	c.Assert(err, tc.ErrorMatches, `cannot adopt resources of model "mymodel": the model was not locked when exported`)
+	s.api.CheckCallNames(c, "Close")
+}
+
+func (s *ImportModelCommandSuite) TestImportModelAbortsOnFailure(c *tc.C) {
+	s.api.SetErrors(
+		nil, // PrechecksV2
+		nil, // ImportV2
+		errors.New("boom"),
+	)
+
+	_, err := cmdtesting.RunCommand(c, model.NewImportModelCommandForTest(s.api, s.store), s.filename)
+	c.Assert(err, tc.ErrorMatches, `importing model "mymodel": uploading charm ch:amd64/app-1: boom`)
+	s.api.CheckCallNames(c, "BestFacadeVersion", "PrechecksV2", "ImportV2", "UploadCharm", "Abort", "Close")
+}
+
+func (s *ImportModelCommandSuite) TestImportModelTargetTooOld(c *tc.C) {
+	s.api.version = 7
+
+	_, err := cmdtesting.RunCommand(c, model.NewImportModelCommandForTest(s.api, s.store), s.filename)
+	c.Assert(err, tc.ErrorMatches, "target controller does not support the model migration format.*")
+	s.api.CheckCallNames(c, "BestFacadeVersion", "Close")
+}
+
+func (s *ImportModelCommandSuite) TestImportModelNotAnExport(c *tc.C) {
+	err := os.WriteFile(s.filename, nil, 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = cmdtesting.RunCommand(c, model.NewImportModelCommandForTest(s.api, s.store), s.filename)
+	c.Assert(err, tc.ErrorMatches, "reading .*: export archive without model.json not valid")
+	s.api.CheckNoCalls(c)
+}
+
+func (s *ImportModelCommandSuite) TestImportModelNoFile(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, model.NewImportModelCommandForTest(s.api, s.store))
+	c.Assert(err, tc.ErrorMatches, "no export file specified")
+}
+
+type fakeImportModelClient struct {
+	*testhelpers.Stub
+	version     int
+	charmBodies map[string]string
+}
+
+func (f *fakeImportModelClient) Close() error {
+	f.MethodCall(f, "Close")
+	return nil
+}
+
+func (f *fakeImportModelClient) BestFacadeVersion() int {
+	f.MethodCall(f, "BestFacadeVersion")
+	return f.version
+}
+
+func (f *fakeImportModelClient) PrechecksV2(ctx context.Context, envelope params.SerializedModelV2) error {
+	f.MethodCall(f, "PrechecksV2", envelope)
+	return f.NextErr()
+}
+
+func (f *fakeImportModelClient) ImportV2(ctx context.Context, envelope params.SerializedModelV2) error {
+	f.MethodCall(f, "ImportV2", envelope)
+	return f.NextErr()
+}
+
+func (f *fakeImportModelClient) UploadCharm(ctx context.Context, modelUUID string, curl string, charmRef string, content io.Reader) (string, error) {
+	f.MethodCall(f, "UploadCharm", modelUUID, curl, charmRef)
+	if err := f.NextErr(); err != nil {
+		return "", err
+	}
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return "", err
+	}
+	f.charmBodies[curl] = string(data)
+	return curl, nil
+}
+
+func (f *fakeImportModelClient) UploadTools(ctx context.Context, modelUUID string, r io.Reader, vers semversion.Binary) (tools.List, error) {
+	f.MethodCall(f, "UploadTools", modelUUID, vers)
+	return nil, f.NextErr()
+}
+
+func (f *fakeImportModelClient) UploadResource(ctx context.Context, modelUUID string, res resource.Resource, r io.Reader) error {
+	f.MethodCall(f, "UploadResource", modelUUID, res)
+	return f.NextErr()
+}
+
+func (f *fakeImportModelClient) CheckMachines(ctx context.Context, modelUUID string) ([]error, error) {
+	f.MethodCall(f, "CheckMachines", modelUUID)
+	return nil, f.NextErr()
+}
+
+func (f *fakeImportModelClient) Activate(ctx context.Context, modelUUID string, sourceInfo coremigration.SourceControllerInfo, relatedModels []string) error {
+	f.MethodCall(f, "Activate", modelUUID, sourceInfo, relatedModels)
+	return f.NextErr()
+}
+
+func (f *fakeImportModelClient) AdoptResources(ctx context.Context, modelUUID string) error {
+	f.MethodCall(f, "AdoptResources", modelUUID)
+	return f.NextErr()
+}
+
+func (f *fakeImportModelClient) Abort(ctx context.Context, modelUUID string) error {
+	f.MethodCall(f, "Abort", modelUUID)
+	return f.NextErr()
+}
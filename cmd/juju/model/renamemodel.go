@@ -0,0 +1,165 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/modelmanager"
+	"github.com/juju/juju/api/jujuclient"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// RenameModelAPI defines the methods used to rename a model.
+type RenameModelAPI interface {
+	Close() error
+	RenameModel(ctx context.Context, model names.ModelTag, name string) error
+}
+
+// NewRenameModelCommand returns a fully constructed rename-model command.
+func NewRenameModelCommand() cmd.Command {
+	command := &renameModelCommand{}
+	command.newAPIFunc = func(ctx context.Context) (RenameModelAPI, error) {
+		root, err := command.NewControllerAPIRoot(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return modelmanager.NewClient(root), nil
+	}
+	return modelcmd.Wrap(command, modelcmd.WrapSkipModelFlags)
+}
+
+type renameModelCommand struct {
+	modelcmd.ModelCommandBase
+	newAPIFunc func(ctx context.Context) (RenameModelAPI, error)
+
+	newName string
+}
+
+const renameModelHelpDoc = `
+Renames a model on the controller.
+
+The model keeps its UUID, its applications and their units, and all of
+its relations, offers and secrets. Only the name changes, and the change
+is recorded in the model's status history.
+
+Kubernetes models and the controller model can't be renamed, as
+resources in the cloud are named after them. A model can't be renamed
+while it is being destroyed or migrated.
+
+Renaming a model requires admin access to the model.
+`
+
+const renameModelHelpExamples = `
+    juju rename-model staging production
+`
+
+// Info implements Command.
+func (c *renameModelCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "rename-model",
+		Args:     "<model name> <new model name>",
+		Purpose:  "Renames a model.",
+		Doc:      renameModelHelpDoc,
+		Examples: renameModelHelpExamples,
+		SeeAlso: []string{
+			"models",
+			"show-model",
+			"rename-application",
+		},
+	})
+}
+
+// Init implements Command.
+func (c *renameModelCommand) Init(args []string) error {
+	switch len(args) {
+	case 0:
+		return errors.New("no model specified")
+	case 1:
+		return errors.New("no new model name specified")
+	}
+	if err := c.SetModelIdentifier(args[0], false); err != nil {
+		return errors.Trace(err)
+	}
+	c.newName = args[1]
+	if !names.IsValidModelName(c.newName) {
+		return errors.NotValidf("model name %q", c.newName)
+	}
+	return cmd.CheckEmpty(args[2:])
+}
+
+// Run implements Command.
+func (c *renameModelCommand) Run(ctx *cmd.Context) error {
+	modelName, details, err := c.ModelDetails(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	client, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	if err := client.RenameModel(ctx, names.NewModelTag(details.ModelUUID), c.newName); err != nil {
+		return block.ProcessBlockedError(errors.Annotatef(err, "renaming model %q", modelName), block.BlockChange)
+	}
+	if err := c.updateStore(*details); err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Model %q renamed to %q", modelName, c.newName)
+	return nil
+}
+
+// updateStore moves the model's entry in the client store to its new
+// name, keeping it as the current model if it was before.
+func (c *renameModelCommand) updateStore(details jujuclient.ModelDetails) error {
+	controllerName, err := c.ControllerName()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	store := c.ClientStore()
+	models, err := store.AllModels(controllerName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	current, err := store.CurrentModel(controllerName)
+	if err != nil && !errors.Is(err, errors.NotFound) {
+		return errors.Trace(err)
+	}
+	var oldNames []string
+	for name, stored := range models {
+		if stored.ModelUUID == details.ModelUUID {
+			oldNames = append(oldNames, name)
+		}
+	}
+	for _, oldName := range oldNames {
+		newName := c.newName
+		if jujuclient.IsQualifiedModelName(oldName) {
+			_, qualifier, err := jujuclient.SplitFullyQualifiedModelName(oldName)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			newName = jujuclient.QualifyModelName(qualifier, c.newName)
+		}
+		if err := store.UpdateModel(controllerName, newName, details); err != nil {
+			return errors.Trace(err)
+		}
+		if current == oldName {
+			if err := store.SetCurrentModel(controllerName, newName); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		if err := store.RemoveModel(controllerName, oldName); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
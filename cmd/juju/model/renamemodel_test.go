@@ -0,0 +1,119 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model_test
+
+import (
+	"context"
+	stdtesting "testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/model"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/internal/testing"
+)
+
+type RenameModelCommandSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+	api   *fakeRenameModelClient
+	store *jujuclient.MemStore
+}
+
+func TestRenameModelCommandSuite(t *stdtesting.T) {
+	tc.Run(t, &RenameModelCommandSuite{})
+}
+
+func (s *RenameModelCommandSuite) SetUpTest(c *tc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.api = &fakeRenameModelClient{Stub: &testhelpers.Stub{}}
+
+	s.store = jujuclient.NewMemStore()
+	s.store.CurrentControllerName = "testing"
+	s.store.Controllers["testing"] = jujuclient.ControllerDetails{}
+	s.store.Accounts["testing"] = jujuclient.AccountDetails{
+		User: "admin",
+	}
+	err := s.store.UpdateModel("testing", "admin/staging", jujuclient.ModelDetails{
+		ModelUUID: testing.ModelTag.Id(),
+		ModelType: coremodel.IAAS,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *RenameModelCommandSuite) runRename(c *tc.C, args ...string) (*cmd.Context, error) {
+	command := model.NewRenameModelCommandForTest(s.api, s.store)
+	return cmdtesting.RunCommand(c, command, args...)
+}
+
+func (s *RenameModelCommandSuite) TestInit(c *tc.C) {
+	_, err := s.runRename(c)
+	c.Check(err, tc.ErrorMatches, "no model specified")
+	_, err = s.runRename(c, "staging")
+	c.Check(err, tc.ErrorMatches, "no new model name specified")
+	_, err = s.runRename(c, "staging", "Prod!")
+	c.Check(err, tc.ErrorMatches, `model name "Prod!" not valid`)
+	_, err = s.runRename(c, "staging", "production", "extra")
+	c.Check(err, tc.ErrorMatches, `unrecognized args: \["extra"\]`)
+}
+
+func (s *RenameModelCommandSuite) TestRenameModel(c *tc.C) {
+	ctx, err := s.runRename(c, "staging", "production")
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.api.CheckCalls(c, []testhelpers.StubCall{
+		{FuncName: "RenameModel", Args: []any{testing.ModelTag, "production"}},
+		{FuncName: "Close"},
+	})
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Model \"staging\" renamed to \"production\"\n")
+
+	details, err := s.store.ModelByName("testing", "admin/production")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(details.ModelUUID, tc.Equals, testing.ModelTag.Id())
+	_, err = s.store.ModelByName("testing", "admin/staging")
+	c.Check(err, tc.ErrorIs, errors.NotFound)
+}
+
+func (s *RenameModelCommandSuite) TestRenameCurrentModel(c *tc.C) {
+	err := s.store.SetCurrentModel("testing", "admin/staging")
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.runRename(c, "staging", "production")
+	c.Assert(err, tc.ErrorIsNil)
+
+	current, err := s.store.CurrentModel("testing")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(current, tc.Equals, "admin/production")
+}
+
+func (s *RenameModelCommandSuite) TestRenameModelFails(c *tc.C) {
+	s.api.SetErrors(errors.AlreadyExistsf("model %q", "production"))
+
+	_, err := s.runRename(c, "staging", "production")
+	c.Assert(err, tc.ErrorMatches, `renaming model "staging": model "production" already exists`)
+
+	_, err = s.store.ModelByName("testing", "admin/staging")
+	c.Check(err, tc.ErrorIsNil)
+	_, err = s.store.ModelByName("testing", "admin/production")
+	c.Check(err, tc.ErrorIs, errors.NotFound)
+}
+
+type fakeRenameModelClient struct {
+	*testhelpers.Stub
+}
+
+func (f *fakeRenameModelClient) Close() error {
+	f.MethodCall(f, "Close")
+	return nil
+}
+
+func (f *fakeRenameModelClient) RenameModel(ctx context.Context, model names.ModelTag, name string) error {
+	f.MethodCall(f, "RenameModel", model, name)
+	return f.NextErr()
+}
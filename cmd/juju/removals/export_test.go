@@ -0,0 +1,39 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removals
+
+import (
+	"context"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+func apiFuncForTest(api RemovalsAPI) func(context.Context, newAPIRoot) (RemovalsAPI, error) {
+	return func(context.Context, newAPIRoot) (RemovalsAPI, error) {
+		return api, nil
+	}
+}
+
+// NewListCommandForTest returns a list command using the input API.
+func NewListCommandForTest(store jujuclient.ClientStore, api RemovalsAPI) cmd.Command {
+	c := &listCommand{apiFunc: apiFuncForTest(api)}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c)
+}
+
+// NewRetryCommandForTest returns a retry-removal command using the input API.
+func NewRetryCommandForTest(store jujuclient.ClientStore, api RemovalsAPI) cmd.Command {
+	c := &retryCommand{rescheduleCommand: rescheduleCommand{apiFunc: apiFuncForTest(api)}}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c)
+}
+
+// NewForceCommandForTest returns a force-removal command using the input API.
+func NewForceCommandForTest(store jujuclient.ClientStore, api RemovalsAPI) cmd.Command {
+	c := &forceCommand{rescheduleCommand: rescheduleCommand{apiFunc: apiFuncForTest(api)}}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c)
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removals
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewListCommand returns the command that lists
+// the pending removal jobs for the model.
+func NewListCommand() cmd.Command {
+	return modelcmd.Wrap(&listCommand{
+		apiFunc: getRemovalsAPI,
+	})
+}
+
+const listCommandDoc = `
+Lists the pending removal jobs for the model.
+
+When an entity such as a unit, machine or application is removed, a removal
+job is scheduled to clean it up. A job that cannot complete is retried
+periodically. For each job, this command shows the type and name of the entity
+being removed, whether the removal is forced, the number of unsuccessful
+attempts so far and the reason the last attempt did not complete. The UUID of
+the entity is shown instead of its name if the entity no longer exists, and is
+always included in the yaml and json output.
+`
+
+const listCommandExamples = `
+    juju removals
+    juju removals --format yaml
+`
+
+// listCommand lists pending removal jobs.
+type listCommand struct {
+	modelcmd.ModelCommandBase
+	apiFunc func(context.Context, newAPIRoot) (RemovalsAPI, error)
+	out     cmd.Output
+}
+
+// Init implements Command.Init.
+func (c *listCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+// Info implements Command.Info.
+func (c *listCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "removals",
+		Purpose:  "Lists pending removal jobs.",
+		Doc:      listCommandDoc,
+		Examples: listCommandExamples,
+		Aliases:  []string{"list-removals"},
+		SeeAlso: []string{
+			"retry-removal",
+			"force-removal",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *listCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatRemovals,
+	})
+}
+
+const noRemovals = "No removal jobs are pending."
+
+// Run implements Command.Run.
+func (c *listCommand) Run(ctx *cmd.Context) error {
+	api, err := c.apiFunc(ctx, c)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	jobs, err := api.List(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(jobs) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof(noRemovals)
+		return nil
+	}
+	return c.out.Write(ctx, formatRemovalInfo(jobs))
+}
+
+// RemovalInfo defines the serialization behaviour of a removal job.
+type RemovalInfo struct {
+	ID              string     `yaml:"id" json:"id"`
+	EntityType      string     `yaml:"entity-type" json:"entity-type"`
+	EntityUUID      string     `yaml:"entity-uuid" json:"entity-uuid"`
+	EntityName      string     `yaml:"entity-name,omitempty" json:"entity-name,omitempty"`
+	Force           bool       `yaml:"force" json:"force"`
+	ScheduledFor    time.Time  `yaml:"scheduled-for" json:"scheduled-for"`
+	Attempts        int        `yaml:"attempts" json:"attempts"`
+	LastError       string     `yaml:"last-error,omitempty" json:"last-error,omitempty"`
+	LastAttemptedAt *time.Time `yaml:"last-attempted-at,omitempty" json:"last-attempted-at,omitempty"`
+}
+
+func formatRemovalInfo(jobs []params.RemovalJob) []RemovalInfo {
+	out := make([]RemovalInfo, len(jobs))
+	for i, job := range jobs {
+		out[i] = RemovalInfo{
+			ID:              job.UUID,
+			EntityType:      job.EntityType,
+			EntityUUID:      job.EntityUUID,
+			EntityName:      job.EntityName,
+			Force:           job.Force,
+			ScheduledFor:    job.ScheduledFor,
+			Attempts:        job.Attempts,
+			LastError:       job.LastError,
+			LastAttemptedAt: job.LastAttemptedAt,
+		}
+	}
+	return out
+}
+
+// formatRemovals writes a tabular representation of removal jobs.
+func formatRemovals(writer io.Writer, value any) error {
+	jobs, ok := value.([]RemovalInfo)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", jobs, value)
+	}
+
+	if len(jobs) == 0 {
+		fmt.Fprintf(writer, noRemovals)
+		return nil
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.Println("ID", "Type", "Entity", "Force", "Attempts", "Last error")
+	for _, job := range jobs {
+		entity := job.EntityName
+		if entity == "" {
+			entity = job.EntityUUID
+		}
+		w.Println(job.ID, job.EntityType, entity, job.Force, job.Attempts, job.LastError)
+	}
+	tw.Flush()
+
+	return nil
+}
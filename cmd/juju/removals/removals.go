@@ -0,0 +1,34 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removals
+
+import (
+	"context"
+
+	"github.com/juju/juju/api"
+	apiremovals "github.com/juju/juju/api/client/removals"
+	"github.com/juju/juju/rpc/params"
+)
+
+type newAPIRoot interface {
+	NewAPIRoot(ctx context.Context) (api.Connection, error)
+}
+
+// RemovalsAPI defines the client API methods
+// used by the removal job commands.
+type RemovalsAPI interface {
+	Close() error
+	List(ctx context.Context) ([]params.RemovalJob, error)
+	Retry(ctx context.Context, uuids ...string) error
+	Force(ctx context.Context, uuids ...string) error
+}
+
+// getRemovalsAPI returns a removals api for managing removal jobs.
+func getRemovalsAPI(ctx context.Context, c newAPIRoot) (RemovalsAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return apiremovals.NewClient(root), nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removals_test
+
+import (
+	"context"
+	"errors"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/removals"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+func TestRemovalsCommandSuite(t *stdtesting.T) {
+	tc.Run(t, &removalsCommandSuite{})
+}
+
+type removalsCommandSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+}
+
+func (s *removalsCommandSuite) TestListEmpty(c *tc.C) {
+	store := jujuclienttesting.MinimalStore()
+	ctx, err := cmdtesting.RunCommand(c, removals.NewListCommandForTest(store, &mockRemovalsAPI{}))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "No removal jobs are pending.\n")
+}
+
+func (s *removalsCommandSuite) TestListTabular(c *tc.C) {
+	api := &mockRemovalsAPI{
+		jobs: []params.RemovalJob{{
+			UUID:         "job-1",
+			EntityType:   "unit",
+			EntityUUID:   "unit-uuid",
+			Force:        false,
+			ScheduledFor: time.Now(),
+			Attempts:     4,
+			LastError:    "unit still has subordinates",
+		}, {
+			UUID:         "job-2",
+			EntityType:   "machine",
+			EntityUUID:   "machine-uuid",
+			EntityName:   "0",
+			Force:        true,
+			ScheduledFor: time.Now(),
+		}},
+	}
+
+	store := jujuclienttesting.MinimalStore()
+	ctx, err := cmdtesting.RunCommand(c, removals.NewListCommandForTest(store, api))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, ""+
+		"ID     Type     Entity     Force  Attempts  Last error\n"+
+		"job-1  unit     unit-uuid  false  4         unit still has subordinates\n"+
+		"job-2  machine  0          true   0         \n")
+}
+
+func (s *removalsCommandSuite) TestListError(c *tc.C) {
+	store := jujuclienttesting.MinimalStore()
+	_, err := cmdtesting.RunCommand(c, removals.NewListCommandForTest(store, &mockRemovalsAPI{
+		err: errors.New("boom"),
+	}))
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *removalsCommandSuite) TestRetry(c *tc.C) {
+	api := &mockRemovalsAPI{}
+	store := jujuclienttesting.MinimalStore()
+	_, err := cmdtesting.RunCommand(c, removals.NewRetryCommandForTest(store, api), "job-1", "job-2")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(api.retried, tc.DeepEquals, []string{"job-1", "job-2"})
+}
+
+func (s *removalsCommandSuite) TestRetryNoArgs(c *tc.C) {
+	store := jujuclienttesting.MinimalStore()
+	_, err := cmdtesting.RunCommand(c, removals.NewRetryCommandForTest(store, &mockRemovalsAPI{}))
+	c.Assert(err, tc.ErrorMatches, "no removal job IDs specified")
+}
+
+func (s *removalsCommandSuite) TestForce(c *tc.C) {
+	api := &mockRemovalsAPI{}
+	store := jujuclienttesting.MinimalStore()
+	_, err := cmdtesting.RunCommand(c, removals.NewForceCommandForTest(store, api), "job-1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(api.forced, tc.DeepEquals, []string{"job-1"})
+}
+
+type mockRemovalsAPI struct {
+	jobs    []params.RemovalJob
+	retried []string
+	forced  []string
+	err     error
+}
+
+func (m *mockRemovalsAPI) Close() error {
+	return nil
+}
+
+func (m *mockRemovalsAPI) List(context.Context) ([]params.RemovalJob, error) {
+	return m.jobs, m.err
+}
+
+func (m *mockRemovalsAPI) Retry(_ context.Context, uuids ...string) error {
+	m.retried = append(m.retried, uuids...)
+	return m.err
+}
+
+func (m *mockRemovalsAPI) Force(_ context.Context, uuids ...string) error {
+	m.forced = append(m.forced, uuids...)
+	return m.err
+}
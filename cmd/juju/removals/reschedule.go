@@ -0,0 +1,135 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package removals
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewRetryCommand returns the command that reschedules
+// removal jobs to run as soon as possible.
+func NewRetryCommand() cmd.Command {
+	return modelcmd.Wrap(&retryCommand{
+		rescheduleCommand: rescheduleCommand{apiFunc: getRemovalsAPI},
+	})
+}
+
+// NewForceCommand returns the command that escalates
+// removal jobs to forced removals.
+func NewForceCommand() cmd.Command {
+	return modelcmd.Wrap(&forceCommand{
+		rescheduleCommand: rescheduleCommand{apiFunc: getRemovalsAPI},
+	})
+}
+
+const retryCommandDoc = `
+Retries one or more pending removal jobs immediately, rather than
+waiting for them to be next due.
+
+Removal job IDs are shown by the 'removals' command.
+`
+
+const retryCommandExamples = `
+    juju retry-removal 5e6a2b44-0d4f-4b5e-8a43-7ad3d2a2d7b1
+`
+
+const forceCommandDoc = `
+Escalates one or more pending removal jobs to forced removals, and retries
+them immediately.
+
+A forced removal proceeds even if the entity being removed, or the entities
+that depend on it, have not been cleaned up. This can leave resources behind
+in the cloud, so it should only be used when a removal is stuck.
+
+Removal job IDs are shown by the 'removals' command.
+`
+
+const forceCommandExamples = `
+    juju force-removal 5e6a2b44-0d4f-4b5e-8a43-7ad3d2a2d7b1
+`
+
+// rescheduleCommand holds what is common to
+// the commands that reschedule removal jobs.
+type rescheduleCommand struct {
+	modelcmd.ModelCommandBase
+	apiFunc func(context.Context, newAPIRoot) (RemovalsAPI, error)
+	ids     []string
+}
+
+// Init implements Command.Init.
+func (c *rescheduleCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no removal job IDs specified")
+	}
+	c.ids = args
+	return nil
+}
+
+// retryCommand retries removal jobs.
+type retryCommand struct {
+	rescheduleCommand
+}
+
+// Info implements Command.Info.
+func (c *retryCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "retry-removal",
+		Args:     "<removal ID> [<removal ID>...]",
+		Purpose:  "Retries pending removal jobs.",
+		Doc:      retryCommandDoc,
+		Examples: retryCommandExamples,
+		SeeAlso: []string{
+			"removals",
+			"force-removal",
+		},
+	})
+}
+
+// Run implements Command.Run.
+func (c *retryCommand) Run(ctx *cmd.Context) error {
+	api, err := c.apiFunc(ctx, c)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	return errors.Trace(api.Retry(ctx, c.ids...))
+}
+
+// forceCommand escalates removal jobs to forced removals.
+type forceCommand struct {
+	rescheduleCommand
+}
+
+// Info implements Command.Info.
+func (c *forceCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "force-removal",
+		Args:     "<removal ID> [<removal ID>...]",
+		Purpose:  "Forces pending removal jobs.",
+		Doc:      forceCommandDoc,
+		Examples: forceCommandExamples,
+		SeeAlso: []string{
+			"removals",
+			"retry-removal",
+		},
+	})
+}
+
+// Run implements Command.Run.
+func (c *forceCommand) Run(ctx *cmd.Context) error {
+	api, err := c.apiFunc(ctx, c)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	return errors.Trace(api.Force(ctx, c.ids...))
+}
@@ -57,6 +57,7 @@ const addSecretBackendsExamples = `
     juju add-secret-backend myvault vault --config /path/to/cfg.yaml
     juju add-secret-backend myvault vault token-rotate=10m --config /path/to/cfg.yaml
     juju add-secret-backend myvault vault endpoint=https://vault.io:8200 token=s.1wshwhw
+    juju add-secret-backend myaws aws-secrets-manager region=us-east-1 role-arn=arn:aws:iam::123456789012:role/juju-secrets
 `
 
 // AddSecretBackendsAPI is the secrets client API.
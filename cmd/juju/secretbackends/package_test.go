@@ -9,7 +9,7 @@ import (
 	"github.com/juju/juju/api/jujuclient"
 )
 
-//go:generate go run github.com/canonical/gomock/mockgen -package secretbackends -destination secretbackendsapi_mock_test.go github.com/juju/juju/cmd/juju/secretbackends ListSecretBackendsAPI,AddSecretBackendsAPI,RemoveSecretBackendsAPI,UpdateSecretBackendsAPI,ModelSecretBackendAPI
+//go:generate go run github.com/canonical/gomock/mockgen -package secretbackends -destination secretbackendsapi_mock_test.go github.com/juju/juju/cmd/juju/secretbackends ListSecretBackendsAPI,AddSecretBackendsAPI,RemoveSecretBackendsAPI,UpdateSecretBackendsAPI,ModelSecretBackendAPI,RotateSecretEncryptionKeyAPI
 
 // NewListCommandForTest returns a secret backends command for testing.
 func NewListCommandForTest(store jujuclient.ClientStore, listSecretsAPI ListSecretBackendsAPI) *listSecretBackendsCommand {
@@ -64,3 +64,13 @@ func NewModelCredentialCommandForTest(store jujuclient.ClientStore, api ModelSec
 	c.SetClientStore(store)
 	return c
 }
+
+// NewRotateSecretEncryptionKeyCommandForTest returns a rotate secret
+// encryption key command for testing.
+func NewRotateSecretEncryptionKeyCommandForTest(store jujuclient.ClientStore, api RotateSecretEncryptionKeyAPI) *rotateSecretEncryptionKeyCommand {
+	c := &rotateSecretEncryptionKeyCommand{
+		RotateSecretEncryptionKeyAPIFunc: func(ctx context.Context) (RotateSecretEncryptionKeyAPI, error) { return api, nil },
+	}
+	c.SetClientStore(store)
+	return c
+}
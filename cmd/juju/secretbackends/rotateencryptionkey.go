@@ -0,0 +1,104 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretbackends
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/client/secretbackends"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+type rotateSecretEncryptionKeyCommand struct {
+	modelcmd.ControllerCommandBase
+
+	RotateSecretEncryptionKeyAPIFunc func(ctx context.Context) (RotateSecretEncryptionKeyAPI, error)
+}
+
+var rotateSecretEncryptionKeyDoc = `
+Rotates the key used to protect secret content stored in the controller's
+internal secret backend.
+
+Secret content is encrypted with a data key per model, and each data key is
+encrypted with a key held by the controller, or by an external key management
+service if the ` + "`secret-encryption-kms`" + ` controller config is set. This
+command replaces the controller key and re-encrypts the data keys of every
+model with it. Secret content is not re-encrypted.
+
+The controller key is held in a file on each controller machine, not in the
+controller database, and is copied to new controller machines as they join.
+A new key can't be copied to the machines of a running controller, so
+rotating it is not supported while the controller has more than one machine.
+Likewise, a controller which had more than one machine before secret content
+was encrypted has no key to copy, and stores secret content unencrypted until
+it is reduced to a single machine. Use the "vault-transit" key management
+service to encrypt secret content on such controllers.
+
+When an external key management service is used, rotate the key there first,
+then run this command to move the data keys to the latest key version.
+
+Content stored before encryption was enabled is encrypted as part of the
+rotation.
+`
+
+const rotateSecretEncryptionKeyExamples = `
+    juju rotate-secret-encryption-key
+`
+
+// RotateSecretEncryptionKeyAPI is the secret backends client API.
+type RotateSecretEncryptionKeyAPI interface {
+	RotateSecretEncryptionKey(context.Context) error
+	Close() error
+}
+
+// NewRotateSecretEncryptionKeyCommand returns a command to rotate the
+// secret encryption key.
+func NewRotateSecretEncryptionKeyCommand() cmd.Command {
+	c := &rotateSecretEncryptionKeyCommand{}
+	c.RotateSecretEncryptionKeyAPIFunc = c.secretBackendsAPI
+
+	return modelcmd.WrapController(c)
+}
+
+func (c *rotateSecretEncryptionKeyCommand) secretBackendsAPI(ctx context.Context) (RotateSecretEncryptionKeyAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return secretbackends.NewClient(root), nil
+}
+
+// Info implements cmd.Info.
+func (c *rotateSecretEncryptionKeyCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "rotate-secret-encryption-key",
+		Purpose:  "Rotates the key protecting secret content stored in the controller.",
+		Doc:      rotateSecretEncryptionKeyDoc,
+		Examples: rotateSecretEncryptionKeyExamples,
+		SeeAlso: []string{
+			"controller-config",
+			"secret-backends",
+		},
+	})
+}
+
+// Init implements cmd.Init.
+func (c *rotateSecretEncryptionKeyCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements cmd.Run.
+func (c *rotateSecretEncryptionKeyCommand) Run(ctxt *cmd.Context) error {
+	api, err := c.RotateSecretEncryptionKeyAPIFunc(ctxt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	return errors.Trace(api.RotateSecretEncryptionKey(ctxt))
+}
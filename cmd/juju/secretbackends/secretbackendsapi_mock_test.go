@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/cmd/juju/secretbackends (interfaces: ListSecretBackendsAPI,AddSecretBackendsAPI,RemoveSecretBackendsAPI,UpdateSecretBackendsAPI,ModelSecretBackendAPI)
+// Source: github.com/juju/juju/cmd/juju/secretbackends (interfaces: ListSecretBackendsAPI,AddSecretBackendsAPI,RemoveSecretBackendsAPI,UpdateSecretBackendsAPI,ModelSecretBackendAPI,RotateSecretEncryptionKeyAPI)
 //
 // Generated by this command:
 //
-//	mockgen -package secretbackends -destination secretbackendsapi_mock_test.go github.com/juju/juju/cmd/juju/secretbackends ListSecretBackendsAPI,AddSecretBackendsAPI,RemoveSecretBackendsAPI,UpdateSecretBackendsAPI,ModelSecretBackendAPI
+//	mockgen -package secretbackends -destination secretbackendsapi_mock_test.go github.com/juju/juju/cmd/juju/secretbackends ListSecretBackendsAPI,AddSecretBackendsAPI,RemoveSecretBackendsAPI,UpdateSecretBackendsAPI,ModelSecretBackendAPI,RotateSecretEncryptionKeyAPI
 //
 
 // Package secretbackends is a generated GoMock package.
@@ -344,3 +344,65 @@ func (mr *MockModelSecretBackendAPIMockRecorder) SetModelSecretBackend(ctx, secr
 
 // MockModelSecretBackendAPISetModelSecretBackendCall is the typed call wrapper for SetModelSecretBackend.
 type MockModelSecretBackendAPISetModelSecretBackendCall = gomock.Call2_1[context.Context, string, error]
+
+// MockRotateSecretEncryptionKeyAPI is a mock of RotateSecretEncryptionKeyAPI interface.
+type MockRotateSecretEncryptionKeyAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockRotateSecretEncryptionKeyAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockRotateSecretEncryptionKeyAPIMockRecorder is the mock recorder for MockRotateSecretEncryptionKeyAPI.
+type MockRotateSecretEncryptionKeyAPIMockRecorder struct {
+	mock                             *MockRotateSecretEncryptionKeyAPI
+	closeExpects                     []*gomock.Call0_1[error]
+	rotateSecretEncryptionKeyExpects []*gomock.Call1_1[context.Context, error]
+}
+
+// NewMockRotateSecretEncryptionKeyAPI creates a new mock instance.
+func NewMockRotateSecretEncryptionKeyAPI(ctrl *gomock.Controller) *MockRotateSecretEncryptionKeyAPI {
+	mock := &MockRotateSecretEncryptionKeyAPI{ctrl: ctrl}
+	mock.recorder = &MockRotateSecretEncryptionKeyAPIMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRotateSecretEncryptionKeyAPI) EXPECT() *MockRotateSecretEncryptionKeyAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockRotateSecretEncryptionKeyAPI) Close() error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.closeExpects, m.ctrl, m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockRotateSecretEncryptionKeyAPIMockRecorder) Close() *MockRotateSecretEncryptionKeyAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[error](mr.mock.ctrl.T, mr.mock, "Close")
+	mr.closeExpects = append(mr.closeExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockRotateSecretEncryptionKeyAPICloseCall is the typed call wrapper for Close.
+type MockRotateSecretEncryptionKeyAPICloseCall = gomock.Call0_1[error]
+
+// RotateSecretEncryptionKey mocks base method.
+func (m *MockRotateSecretEncryptionKeyAPI) RotateSecretEncryptionKey(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_1(&m.recorder.rotateSecretEncryptionKeyExpects, m.ctrl, m, "RotateSecretEncryptionKey", arg0)
+}
+
+// RotateSecretEncryptionKey indicates an expected call of RotateSecretEncryptionKey.
+func (mr *MockRotateSecretEncryptionKeyAPIMockRecorder) RotateSecretEncryptionKey(arg0 any) *MockRotateSecretEncryptionKeyAPIRotateSecretEncryptionKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_1[context.Context, error](mr.mock.ctrl.T, mr.mock, "RotateSecretEncryptionKey", gomock.EnsureMatcher(arg0))
+	mr.rotateSecretEncryptionKeyExpects = append(mr.rotateSecretEncryptionKeyExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockRotateSecretEncryptionKeyAPIRotateSecretEncryptionKeyCall is the typed call wrapper for RotateSecretEncryptionKey.
+type MockRotateSecretEncryptionKeyAPIRotateSecretEncryptionKeyCall = gomock.Call1_1[context.Context, error]
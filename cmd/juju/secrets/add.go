@@ -6,26 +6,44 @@ package secrets
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 
 	apisecrets "github.com/juju/juju/api/client/secrets"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/cmd"
 	"github.com/juju/juju/cmd/modelcmd"
+	coresecrets "github.com/juju/juju/core/secrets"
 )
 
 type addSecretCommand struct {
 	modelcmd.ModelCommandBase
 
 	SecretUpsertContentCommand
-	name           string
+	name     string
+	external string
+	generate string
+	length   int
+	charset  string
+	rotate   string
+
+	externalBackend string
+	externalPath    string
+	externalKey     string
+
 	secretsAPIFunc func(context.Context) (AddSecretsAPI, error)
 }
 
 // AddSecretsAPI is the secrets client API.
 type AddSecretsAPI interface {
 	CreateSecret(ctx context.Context, name, description string, data map[string]string) (string, error)
+	CreateExternalSecret(ctx context.Context, name, description, backendName, path, key string) (string, error)
+	CreateGeneratedSecret(
+		ctx context.Context, name, description string,
+		generator coresecrets.GeneratorSpec, rotatePolicy coresecrets.RotatePolicy,
+	) (string, error)
 	Close() error
 }
 
@@ -56,6 +74,30 @@ If a key has the ` + "`#file` " + `suffix, the value is read from the correspond
 
 A secret is owned by the model, meaning only the model admin
 can manage it, ie grant/revoke access, update, remove etc.
+
+Use ` + "`--external`" + ` to add a secret whose content is managed outside of Juju,
+at an existing path in a secret backend configured for the model. The value
+takes the form ` + "`<backend>:<path>[#key]`" + `, where ` + "`key`" + ` optionally selects a
+single key from the content. The content is never copied into Juju; instead
+Juju watches for new versions of the content upstream, and adds a new secret
+revision for each one, so that consumers of the secret are notified.
+The content of an external secret cannot be updated using Juju.
+Only model admins may add an external secret, and the path must be under one
+of the prefixes listed in the backend's ` + "`external-path-prefixes`" + ` config.
+Paths holding content managed by Juju are rejected.
+
+Use ` + "`--generate`" + ` to have the controller generate the secret content, in one
+of the following formats:
+  - ` + "`password`" + `: a random string of ` + "`--length`" + ` characters (default 32), taken
+    from ` + "`--charset`" + ` (default alphanumeric), held under the ` + "`value`" + ` key.
+  - ` + "`hex`" + `: a random hex string of ` + "`--length`" + ` characters (default 64), held
+    under the ` + "`value`" + ` key.
+  - ` + "`rsa`" + `: an RSA key pair of ` + "`--length`" + ` bits (default 3072).
+  - ` + "`ed25519`" + `: an Ed25519 key pair.
+Key pairs are PEM encoded and held under the ` + "`private-key`" + ` and ` + "`public-key`" + ` keys.
+With ` + "`--rotate`" + `, the controller generates new content on the given schedule,
+one of hourly, daily, weekly, monthly, quarterly or yearly, and consumers of
+the secret are notified of each new revision.
 `
 	addSecretExamples = `
     juju add-secret my-apitoken token=34ae35facd4
@@ -67,6 +109,9 @@ can manage it, ie grant/revoke access, update, remove etc.
     juju add-secret db-password \
         --info "my database password" \
         --file=/path/to/file
+    juju add-secret db-password --external myvault:kv/app/db#password
+    juju add-secret db-password --generate password --length 24 --rotate monthly
+    juju add-secret ssh-key --generate ed25519
 `
 )
 
@@ -81,6 +126,21 @@ func (c *addSecretCommand) Info() *cmd.Info {
 	})
 }
 
+// SetFlags implements cmd.Command.
+func (c *addSecretCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.SecretUpsertContentCommand.SetFlags(f)
+	f.StringVar(&c.external, "external", "",
+		"The location of content managed outside of Juju, as <backend>:<path>[#key]")
+	f.StringVar(&c.generate, "generate", "",
+		"Generate the secret content, one of password, hex, rsa or ed25519")
+	f.IntVar(&c.length, "length", 0,
+		"The length of a generated password or hex value, or the size in bits of an RSA key")
+	f.StringVar(&c.charset, "charset", "",
+		"The characters used in a generated password")
+	f.StringVar(&c.rotate, "rotate", "",
+		"How often to generate new secret content, one of hourly, daily, weekly, monthly, quarterly or yearly")
+}
+
 // Init implements cmd.Command.
 func (c *addSecretCommand) Init(args []string) error {
 	if len(args) < 1 {
@@ -88,6 +148,24 @@ func (c *addSecretCommand) Init(args []string) error {
 	}
 	c.name = args[0]
 	args = args[1:]
+	if c.generate == "" && (c.length != 0 || c.charset != "" || c.rotate != "") {
+		return errors.New("--length, --charset and --rotate are only valid with --generate")
+	}
+	if c.generate != "" {
+		if c.external != "" {
+			return errors.New("--generate and --external cannot be used together")
+		}
+		if len(args) > 0 || c.FileName != "" {
+			return errors.New("secret values cannot be specified for a generated secret")
+		}
+		return c.validateGenerator()
+	}
+	if c.external != "" {
+		if len(args) > 0 || c.FileName != "" {
+			return errors.New("secret values cannot be specified for an external secret")
+		}
+		return c.parseExternal()
+	}
 	if err := c.SecretUpsertContentCommand.Init(args); err != nil {
 		return err
 	}
@@ -105,10 +183,61 @@ func (c *addSecretCommand) Run(ctx *cmd.Context) error {
 	}
 	defer secretsAPI.Close()
 
-	uri, err := secretsAPI.CreateSecret(ctx, c.name, c.Description, c.Data)
+	var uri string
+	switch {
+	case c.generate != "":
+		uri, err = secretsAPI.CreateGeneratedSecret(
+			ctx, c.name, c.Description, c.generatorSpec(), coresecrets.RotatePolicy(c.rotate))
+	case c.external != "":
+		uri, err = secretsAPI.CreateExternalSecret(
+			ctx, c.name, c.Description, c.externalBackend, c.externalPath, c.externalKey)
+	default:
+		uri, err = secretsAPI.CreateSecret(ctx, c.name, c.Description, c.Data)
+	}
 	if err != nil {
 		return err
 	}
 	fmt.Fprintln(ctx.Stdout, uri)
 	return nil
 }
+
+// parseExternal parses the location of external
+// content in the form <backend>:<path>[#key].
+func (c *addSecretCommand) parseExternal() error {
+	backend, path, ok := strings.Cut(c.external, ":")
+	if !ok || backend == "" || path == "" {
+		return errors.Errorf("invalid external secret %q, expected <backend>:<path>[#key]", c.external)
+	}
+	if i := strings.LastIndex(path, "#"); i >= 0 {
+		c.externalKey = path[i+1:]
+		path = path[:i]
+		if c.externalKey == "" || path == "" {
+			return errors.Errorf("invalid external secret %q, expected <backend>:<path>[#key]", c.external)
+		}
+	}
+	c.externalBackend = backend
+	c.externalPath = path
+	return nil
+}
+
+func (c *addSecretCommand) generatorSpec() coresecrets.GeneratorSpec {
+	return coresecrets.GeneratorSpec{
+		Format:  coresecrets.GeneratorFormat(c.generate),
+		Length:  c.length,
+		Charset: c.charset,
+	}
+}
+
+// validateGenerator checks the generator and rotate policy flags.
+func (c *addSecretCommand) validateGenerator() error {
+	if err := c.generatorSpec().Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	if c.rotate != "" {
+		policy := coresecrets.RotatePolicy(c.rotate)
+		if !policy.IsValid() {
+			return errors.NotValidf("rotate policy %q", c.rotate)
+		}
+	}
+	return nil
+}
@@ -83,3 +83,118 @@ func (s *addSuite) TestAddEmptyData(c *tc.C) {
 	_, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI), "my-secret", "--info", "this is a secret.")
 	c.Assert(err, tc.ErrorMatches, `missing secret value or filename`)
 }
+
+func (s *addSuite) TestAddExternal(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().CreateExternalSecret(
+		gomock.Any(), "my-secret", "this is a secret.", "myvault", "kv/app/db", "password",
+	).Return(uri.String(), nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI),
+		"my-secret", "--external", "myvault:kv/app/db#password", "--info", "this is a secret.")
+	c.Assert(err, tc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, uri.String()+"\n")
+}
+
+func (s *addSuite) TestAddExternalNoKey(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().CreateExternalSecret(
+		gomock.Any(), "my-secret", "", "myvault", "kv/app/db", "",
+	).Return(uri.String(), nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI),
+		"my-secret", "--external", "myvault:kv/app/db")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *addSuite) TestAddExternalWithData(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI),
+		"my-secret", "foo=bar", "--external", "myvault:kv/app/db")
+	c.Assert(err, tc.ErrorMatches, `secret values cannot be specified for an external secret`)
+}
+
+func (s *addSuite) TestAddExternalInvalid(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	for _, external := range []string{"kv/app/db", ":kv/app/db", "myvault:", "myvault:kv/app/db#", "myvault:#key"} {
+		_, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI),
+			"my-secret", "--external", external)
+		c.Check(err, tc.ErrorMatches, `invalid external secret .*, expected <backend>:<path>\[#key\]`)
+	}
+}
+
+func (s *addSuite) TestAddGenerated(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().CreateGeneratedSecret(
+		gomock.Any(), "my-secret", "this is a secret.",
+		coresecrets.GeneratorSpec{Format: coresecrets.GeneratePassword, Length: 24, Charset: "abc123"},
+		coresecrets.RotateMonthly,
+	).Return(uri.String(), nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI),
+		"my-secret", "--generate", "password", "--length", "24", "--charset", "abc123",
+		"--rotate", "monthly", "--info", "this is a secret.")
+	c.Assert(err, tc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, uri.String()+"\n")
+}
+
+func (s *addSuite) TestAddGeneratedKeyPair(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().CreateGeneratedSecret(
+		gomock.Any(), "my-secret", "", coresecrets.GeneratorSpec{Format: coresecrets.GenerateEd25519}, coresecrets.RotatePolicy(""),
+	).Return(uri.String(), nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI),
+		"my-secret", "--generate", "ed25519")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *addSuite) TestAddGeneratedInvalid(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	for _, t := range []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{"--generate", "password", "foo=bar"},
+		err:  `secret values cannot be specified for a generated secret`,
+	}, {
+		args: []string{"--generate", "password", "--external", "myvault:kv/app/db"},
+		err:  `--generate and --external cannot be used together`,
+	}, {
+		args: []string{"--length", "10", "foo=bar"},
+		err:  `--length, --charset and --rotate are only valid with --generate`,
+	}, {
+		args: []string{"--generate", "uuid"},
+		err:  `generator format "uuid" not valid`,
+	}, {
+		args: []string{"--generate", "hex", "--charset", "abc"},
+		err:  `charset for "hex" generator not valid`,
+	}, {
+		args: []string{"--generate", "rsa", "--length", "1024"},
+		err:  `RSA key size 1024, must be between 2048 and 8192 bits not valid`,
+	}, {
+		args: []string{"--generate", "password", "--rotate", "fortnightly"},
+		err:  `rotate policy "fortnightly" not valid`,
+	}} {
+		_, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI),
+			append([]string{"my-secret"}, t.args...)...)
+		c.Check(err, tc.ErrorMatches, t.err)
+	}
+}
@@ -127,6 +127,7 @@ type secretDisplayDetails struct {
 	Value                  *secretValueDetails     `json:"content,omitempty" yaml:"content,omitempty"`
 	Revisions              []secretRevisionDetails `json:"revisions,omitempty" yaml:"revisions,omitempty"`
 	Access                 []AccessInfo            `yaml:"access,omitempty" json:"access,omitempty"`
+	AccessLog              []secretAccessLogEntry  `yaml:"access-log,omitempty" json:"access-log,omitempty"`
 }
 
 // AccessInfo holds info about a secret access information.
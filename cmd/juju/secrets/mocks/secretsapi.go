@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/cmd/juju/secrets (interfaces: ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI)
+// Source: github.com/juju/juju/cmd/juju/secrets (interfaces: ListSecretsAPI,ShowSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI)
 //
 // Generated by this command:
 //
-//	mockgen -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
+//	mockgen -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,ShowSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
 //
 
 // Package mocks is a generated GoMock package.
@@ -79,6 +79,87 @@ func (mr *MockListSecretsAPIMockRecorder) ListSecrets(arg0, arg1, arg2 any) *Moc
 // MockListSecretsAPIListSecretsCall is the typed call wrapper for ListSecrets.
 type MockListSecretsAPIListSecretsCall = gomock.Call3_2[context.Context, bool, secrets0.Filter, []secrets.SecretDetails, error]
 
+// MockShowSecretsAPI is a mock of ShowSecretsAPI interface.
+type MockShowSecretsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockShowSecretsAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockShowSecretsAPIMockRecorder is the mock recorder for MockShowSecretsAPI.
+type MockShowSecretsAPIMockRecorder struct {
+	mock                    *MockShowSecretsAPI
+	closeExpects            []*gomock.Call0_1[error]
+	listSecretAccessExpects []*gomock.Call2_2[context.Context, *secrets0.URI, []secrets.SecretAccessRecord, error]
+	listSecretsExpects      []*gomock.Call3_2[context.Context, bool, secrets0.Filter, []secrets.SecretDetails, error]
+}
+
+// NewMockShowSecretsAPI creates a new mock instance.
+func NewMockShowSecretsAPI(ctrl *gomock.Controller) *MockShowSecretsAPI {
+	mock := &MockShowSecretsAPI{ctrl: ctrl}
+	mock.recorder = &MockShowSecretsAPIMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShowSecretsAPI) EXPECT() *MockShowSecretsAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockShowSecretsAPI) Close() error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.closeExpects, m.ctrl, m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockShowSecretsAPIMockRecorder) Close() *MockShowSecretsAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[error](mr.mock.ctrl.T, mr.mock, "Close")
+	mr.closeExpects = append(mr.closeExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockShowSecretsAPICloseCall is the typed call wrapper for Close.
+type MockShowSecretsAPICloseCall = gomock.Call0_1[error]
+
+// ListSecretAccess mocks base method.
+func (m *MockShowSecretsAPI) ListSecretAccess(ctx context.Context, uri *secrets0.URI) ([]secrets.SecretAccessRecord, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.listSecretAccessExpects, m.ctrl, m, "ListSecretAccess", ctx, uri)
+}
+
+// ListSecretAccess indicates an expected call of ListSecretAccess.
+func (mr *MockShowSecretsAPIMockRecorder) ListSecretAccess(ctx, uri any) *MockShowSecretsAPIListSecretAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, *secrets0.URI, []secrets.SecretAccessRecord, error](mr.mock.ctrl.T, mr.mock, "ListSecretAccess", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(uri))
+	mr.listSecretAccessExpects = append(mr.listSecretAccessExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockShowSecretsAPIListSecretAccessCall is the typed call wrapper for ListSecretAccess.
+type MockShowSecretsAPIListSecretAccessCall = gomock.Call2_2[context.Context, *secrets0.URI, []secrets.SecretAccessRecord, error]
+
+// ListSecrets mocks base method.
+func (m *MockShowSecretsAPI) ListSecrets(arg0 context.Context, arg1 bool, arg2 secrets0.Filter) ([]secrets.SecretDetails, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.listSecretsExpects, m.ctrl, m, "ListSecrets", arg0, arg1, arg2)
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MockShowSecretsAPIMockRecorder) ListSecrets(arg0, arg1, arg2 any) *MockShowSecretsAPIListSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, bool, secrets0.Filter, []secrets.SecretDetails, error](mr.mock.ctrl.T, mr.mock, "ListSecrets", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1), gomock.EnsureMatcher(arg2))
+	mr.listSecretsExpects = append(mr.listSecretsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockShowSecretsAPIListSecretsCall is the typed call wrapper for ListSecrets.
+type MockShowSecretsAPIListSecretsCall = gomock.Call3_2[context.Context, bool, secrets0.Filter, []secrets.SecretDetails, error]
+
 // MockAddSecretsAPI is a mock of AddSecretsAPI interface.
 type MockAddSecretsAPI struct {
 	ctrl     *gomock.Controller
@@ -88,9 +169,11 @@ type MockAddSecretsAPI struct {
 
 // MockAddSecretsAPIMockRecorder is the mock recorder for MockAddSecretsAPI.
 type MockAddSecretsAPIMockRecorder struct {
-	mock                *MockAddSecretsAPI
-	closeExpects        []*gomock.Call0_1[error]
-	createSecretExpects []*gomock.Call4_2[context.Context, string, string, map[string]string, string, error]
+	mock                         *MockAddSecretsAPI
+	closeExpects                 []*gomock.Call0_1[error]
+	createExternalSecretExpects  []*gomock.Call6_2[context.Context, string, string, string, string, string, string, error]
+	createGeneratedSecretExpects []*gomock.Call5_2[context.Context, string, string, secrets0.GeneratorSpec, secrets0.RotatePolicy, string, error]
+	createSecretExpects          []*gomock.Call4_2[context.Context, string, string, map[string]string, string, error]
 }
 
 // NewMockAddSecretsAPI creates a new mock instance.
@@ -123,6 +206,42 @@ func (mr *MockAddSecretsAPIMockRecorder) Close() *MockAddSecretsAPICloseCall {
 // MockAddSecretsAPICloseCall is the typed call wrapper for Close.
 type MockAddSecretsAPICloseCall = gomock.Call0_1[error]
 
+// CreateExternalSecret mocks base method.
+func (m *MockAddSecretsAPI) CreateExternalSecret(ctx context.Context, name, description, backendName, path, key string) (string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch6_2(&m.recorder.createExternalSecretExpects, m.ctrl, m, "CreateExternalSecret", ctx, name, description, backendName, path, key)
+}
+
+// CreateExternalSecret indicates an expected call of CreateExternalSecret.
+func (mr *MockAddSecretsAPIMockRecorder) CreateExternalSecret(ctx, name, description, backendName, path, key any) *MockAddSecretsAPICreateExternalSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall6_2[context.Context, string, string, string, string, string, string, error](mr.mock.ctrl.T, mr.mock, "CreateExternalSecret", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name), gomock.EnsureMatcher(description), gomock.EnsureMatcher(backendName), gomock.EnsureMatcher(path), gomock.EnsureMatcher(key))
+	mr.createExternalSecretExpects = append(mr.createExternalSecretExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAddSecretsAPICreateExternalSecretCall is the typed call wrapper for CreateExternalSecret.
+type MockAddSecretsAPICreateExternalSecretCall = gomock.Call6_2[context.Context, string, string, string, string, string, string, error]
+
+// CreateGeneratedSecret mocks base method.
+func (m *MockAddSecretsAPI) CreateGeneratedSecret(ctx context.Context, name, description string, generator secrets0.GeneratorSpec, rotatePolicy secrets0.RotatePolicy) (string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch5_2(&m.recorder.createGeneratedSecretExpects, m.ctrl, m, "CreateGeneratedSecret", ctx, name, description, generator, rotatePolicy)
+}
+
+// CreateGeneratedSecret indicates an expected call of CreateGeneratedSecret.
+func (mr *MockAddSecretsAPIMockRecorder) CreateGeneratedSecret(ctx, name, description, generator, rotatePolicy any) *MockAddSecretsAPICreateGeneratedSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall5_2[context.Context, string, string, secrets0.GeneratorSpec, secrets0.RotatePolicy, string, error](mr.mock.ctrl.T, mr.mock, "CreateGeneratedSecret", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name), gomock.EnsureMatcher(description), gomock.EnsureMatcher(generator), gomock.EnsureMatcher(rotatePolicy))
+	mr.createGeneratedSecretExpects = append(mr.createGeneratedSecretExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAddSecretsAPICreateGeneratedSecretCall is the typed call wrapper for CreateGeneratedSecret.
+type MockAddSecretsAPICreateGeneratedSecretCall = gomock.Call5_2[context.Context, string, string, secrets0.GeneratorSpec, secrets0.RotatePolicy, string, error]
+
 // CreateSecret mocks base method.
 func (m *MockAddSecretsAPI) CreateSecret(ctx context.Context, name, description string, data map[string]string) (string, error) {
 	m.ctrl.T.Helper()
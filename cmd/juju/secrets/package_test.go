@@ -9,7 +9,7 @@ import (
 	"github.com/juju/juju/api/jujuclient"
 )
 
-//go:generate go run github.com/canonical/gomock/mockgen -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
+//go:generate go run github.com/canonical/gomock/mockgen -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,ShowSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
 
 // NewAddCommandForTest returns a secrets command for testing.
 func NewAddCommandForTest(store jujuclient.ClientStore, api AddSecretsAPI) *addSecretCommand {
@@ -66,9 +66,9 @@ func NewListCommandForTest(store jujuclient.ClientStore, listSecretsAPI ListSecr
 }
 
 // NewShowCommandForTest returns a list-secrets command for testing.
-func NewShowCommandForTest(store jujuclient.ClientStore, listSecretsAPI ListSecretsAPI) *showSecretsCommand {
+func NewShowCommandForTest(store jujuclient.ClientStore, showSecretsAPI ShowSecretsAPI) *showSecretsCommand {
 	c := &showSecretsCommand{
-		listSecretsAPIFunc: func(ctx context.Context) (ListSecretsAPI, error) { return listSecretsAPI, nil },
+		listSecretsAPIFunc: func(ctx context.Context) (ShowSecretsAPI, error) { return showSecretsAPI, nil },
 	}
 	c.SetClientStore(store)
 	return c
@@ -5,6 +5,7 @@ package secrets
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
@@ -20,12 +21,29 @@ type showSecretsCommand struct {
 	modelcmd.ModelCommandBase
 	out cmd.Output
 
-	listSecretsAPIFunc func(ctx context.Context) (ListSecretsAPI, error)
+	listSecretsAPIFunc func(ctx context.Context) (ShowSecretsAPI, error)
 	uri                *coresecrets.URI
 	name               string
 	revealSecrets      bool
 	revisions          bool
 	revision           int
+	accessLog          bool
+}
+
+// ShowSecretsAPI is the secrets client API used by show-secret.
+type ShowSecretsAPI interface {
+	ListSecretsAPI
+	ListSecretAccess(ctx context.Context, uri *coresecrets.URI) ([]apisecrets.SecretAccessRecord, error)
+}
+
+// secretAccessLogEntry holds the display details of reads of a
+// secret revision's content by a single accessor.
+type secretAccessLogEntry struct {
+	Revision    int       `json:"revision" yaml:"revision"`
+	Accessor    string    `json:"accessor" yaml:"accessor"`
+	FirstAccess time.Time `json:"first-access" yaml:"first-access"`
+	LastAccess  time.Time `json:"last-access" yaml:"last-access"`
+	Count       int       `json:"count" yaml:"count"`
 }
 
 var showSecretsDoc = `
@@ -36,6 +54,12 @@ with the ` + "`--reveal`" + ` option in the ` + "`json`" + ` or ` + "`yaml`" + `
 
 Use ` + "`--revision`" + ` to inspect a particular revision, else latest is used.
 Use ` + "`--revisions`" + ` to see the metadata for each revision.
+
+For controller/model admins, the ` + "`--access-log`" + ` option shows which
+units, applications and users have read the content of each revision, when
+they first and last did so, and how many times. Repeated reads by a unit or
+application within five minutes are counted once. Entries older than the
+` + "`max-secret-access-log-age`" + ` model config value are removed.
 `
 
 const showSecretsExamples = `
@@ -45,6 +69,7 @@ const showSecretsExamples = `
     juju show-secret 9m4e2mr0ui3e8a215n4g --revision 2 --reveal
     juju show-secret 9m4e2mr0ui3e8a215n4g --revisions
     juju show-secret 9m4e2mr0ui3e8a215n4g --reveal
+    juju show-secret 9m4e2mr0ui3e8a215n4g --access-log
 `
 
 // NewShowSecretsCommand returns a command to list secrets metadata.
@@ -55,7 +80,7 @@ func NewShowSecretsCommand() cmd.Command {
 	return modelcmd.Wrap(c)
 }
 
-func (c *showSecretsCommand) secretsAPI(ctx context.Context) (ListSecretsAPI, error) {
+func (c *showSecretsCommand) secretsAPI(ctx context.Context) (ShowSecretsAPI, error) {
 	root, err := c.NewAPIRoot(ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -86,6 +111,7 @@ func (c *showSecretsCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.revisions, "revisions", false, "Show the secret revisions metadata")
 	f.IntVar(&c.revision, "revision", 0, "Show a specific revision (defaults to latest)")
 	f.IntVar(&c.revision, "r", 0, "")
+	f.BoolVar(&c.accessLog, "access-log", false, "Show which units, applications and users have read the secret content")
 	c.out.AddFlags(f, "yaml", map[string]cmd.Formatter{
 		"yaml": cmd.FormatYaml,
 		"json": cmd.FormatJson,
@@ -149,6 +175,30 @@ func (c *showSecretsCommand) Run(ctxt *cmd.Context) error {
 		}
 		return errors.NotFoundf("secret %q", c.name)
 	}
+	if c.accessLog {
+		for id, d := range details {
+			access, err := api.ListSecretAccess(ctxt, d.URI)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			d.AccessLog = toAccessLog(access)
+			details[id] = d
+		}
+	}
 
 	return c.out.Write(ctxt, details)
 }
+
+func toAccessLog(access []apisecrets.SecretAccessRecord) []secretAccessLogEntry {
+	result := make([]secretAccessLogEntry, len(access))
+	for i, a := range access {
+		result[i] = secretAccessLogEntry{
+			Revision:    a.Revision,
+			Accessor:    a.Accessor,
+			FirstAccess: a.FirstAccessTime,
+			LastAccess:  a.LastAccessTime,
+			Count:       a.AccessCount,
+		}
+	}
+	return result
+}
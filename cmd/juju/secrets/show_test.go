@@ -6,8 +6,10 @@ package secrets_test
 import (
 	"fmt"
 	stdtesting "testing"
+	"time"
 
 	"github.com/canonical/gomock/gomock"
+	"github.com/juju/errors"
 	"github.com/juju/tc"
 
 	apisecrets "github.com/juju/juju/api/client/secrets"
@@ -23,7 +25,7 @@ import (
 type ShowSuite struct {
 	testhelpers.IsolationSuite
 	store      *jujuclient.MemStore
-	secretsAPI *mocks.MockListSecretsAPI
+	secretsAPI *mocks.MockShowSecretsAPI
 }
 
 func TestShowSuite(t *stdtesting.T) {
@@ -41,7 +43,7 @@ func (s *ShowSuite) SetUpTest(c *tc.C) {
 func (s *ShowSuite) setup(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
-	s.secretsAPI = mocks.NewMockListSecretsAPI(ctrl)
+	s.secretsAPI = mocks.NewMockShowSecretsAPI(ctrl)
 
 	return ctrl
 }
@@ -224,3 +226,75 @@ func (s *ShowSuite) TestShowRevisions(c *tc.C) {
     updated: 0001-01-01T00:00:00Z
 `[1:], uri.ID))
 }
+
+func (s *ShowSuite) TestShowAccessLog(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	first := testing.NonZeroTime().UTC()
+	last := first.Add(time.Hour)
+	s.secretsAPI.EXPECT().ListSecrets(gomock.Any(), false, coresecrets.Filter{
+		URI: uri,
+	}).Return(
+		[]apisecrets.SecretDetails{{
+			Metadata: coresecrets.SecretMetadata{
+				URI: uri, Version: 1, LatestRevision: 2,
+				Owner: coresecrets.Owner{Kind: coresecrets.ModelOwner, ID: testing.ModelTag.Id()},
+				Label: "my-secret",
+			},
+		}}, nil)
+	s.secretsAPI.EXPECT().ListSecretAccess(gomock.Any(), uri).Return([]apisecrets.SecretAccessRecord{{
+		Revision:        2,
+		Accessor:        "unit-gitlab-0",
+		FirstAccessTime: first,
+		LastAccessTime:  last,
+		AccessCount:     5,
+	}, {
+		Revision:        1,
+		Accessor:        "user-admin",
+		FirstAccessTime: first,
+		LastAccessTime:  first,
+		AccessCount:     1,
+	}}, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewShowCommandForTest(s.store, s.secretsAPI), uri.ID, "--access-log")
+	c.Assert(err, tc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, fmt.Sprintf(`
+%s:
+  revision: 2
+  owner: <model>
+  name: my-secret
+  created: 0001-01-01T00:00:00Z
+  updated: 0001-01-01T00:00:00Z
+  access-log:
+  - revision: 2
+    accessor: unit-gitlab-0
+    first-access: 1970-01-01T00:00:00.000000001Z
+    last-access: 1970-01-01T01:00:00.000000001Z
+    count: 5
+  - revision: 1
+    accessor: user-admin
+    first-access: 1970-01-01T00:00:00.000000001Z
+    last-access: 1970-01-01T00:00:00.000000001Z
+    count: 1
+`[1:], uri.ID))
+}
+
+func (s *ShowSuite) TestShowAccessLogError(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().ListSecrets(gomock.Any(), false, coresecrets.Filter{
+		URI: uri,
+	}).Return(
+		[]apisecrets.SecretDetails{{
+			Metadata: coresecrets.SecretMetadata{URI: uri, Version: 1, LatestRevision: 1},
+		}}, nil)
+	s.secretsAPI.EXPECT().ListSecretAccess(gomock.Any(), uri).Return(nil, errors.NotSupportedf("secret access log"))
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewShowCommandForTest(s.store, s.secretsAPI), uri.ID, "--access-log")
+	c.Assert(err, tc.ErrorMatches, "secret access log not supported")
+}
@@ -77,6 +77,7 @@ type machineStatus struct {
 	Hardware           string                        `json:"hardware,omitempty" yaml:"hardware,omitempty"`
 	LXDProfiles        map[string]lxdProfileContents `json:"lxd-profiles,omitempty" yaml:"lxd-profiles,omitempty"`
 	HAClusterRole      *string                       `json:"controller-cluster-role,omitempty" yaml:"controller-cluster-role,omitempty"`
+	Recovery           *machineRecoveryStatus        `json:"recovery,omitempty" yaml:"recovery,omitempty"`
 
 	// These fields should be deprecated in favour of HAClusterRole. Remove
 	// them in the next version of the API client version.
@@ -110,6 +111,23 @@ func (s machineStatus) machineName() string {
 	return s.DisplayName
 }
 
+// machineRecoveryStatus holds status info about the automatic recovery of a
+// machine whose cloud instance has failed.
+type machineRecoveryStatus struct {
+	Policy      string                   `json:"policy" yaml:"policy"`
+	Attempts    int                      `json:"attempts" yaml:"attempts"`
+	MaxAttempts int                      `json:"max-attempts" yaml:"max-attempts"`
+	NextAttempt string                   `json:"next-attempt,omitempty" yaml:"next-attempt,omitempty"`
+	History     []machineRecoveryAttempt `json:"history,omitempty" yaml:"history,omitempty"`
+}
+
+type machineRecoveryAttempt struct {
+	AttemptedAt string `json:"attempted-at" yaml:"attempted-at"`
+	InstanceId  string `json:"instance-id" yaml:"instance-id"`
+	Reason      string `json:"reason" yaml:"reason"`
+	Error       string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
 // LXDProfile holds status info about a LXDProfile
 type lxdProfileContents struct {
 	Config      map[string]string            `json:"config" yaml:"config"`
@@ -139,6 +157,8 @@ type applicationStatus struct {
 	Units            map[string]unitStatus                  `json:"units,omitempty" yaml:"units,omitempty"`
 	Version          string                                 `json:"version,omitempty" yaml:"version,omitempty"`
 	EndpointBindings map[string]string                      `json:"endpoint-bindings,omitempty" yaml:"endpoint-bindings,omitempty"`
+
+	PlacementViolations []string `json:"placement-violations,omitempty" yaml:"placement-violations,omitempty"`
 }
 
 type applicationStatusRelation struct {
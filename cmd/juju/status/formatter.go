@@ -193,6 +193,23 @@ func (sf *statusFormatter) formatMachine(machine params.MachineStatus) machineSt
 		}
 	}
 
+	if recovery := machine.Recovery; recovery != nil {
+		out.Recovery = &machineRecoveryStatus{
+			Policy:      recovery.Policy,
+			Attempts:    recovery.Attempts,
+			MaxAttempts: recovery.MaxAttempts,
+			NextAttempt: common.FormatTime(recovery.NextAttempt, sf.isoTime),
+		}
+		for _, attempt := range recovery.History {
+			out.Recovery.History = append(out.Recovery.History, machineRecoveryAttempt{
+				AttemptedAt: common.FormatTime(&attempt.AttemptedAt, sf.isoTime),
+				InstanceId:  attempt.InstanceId,
+				Reason:      attempt.Reason,
+				Error:       attempt.Error,
+			})
+		}
+	}
+
 	return out
 }
 
@@ -250,6 +267,8 @@ func (sf *statusFormatter) formatApplication(name string, application params.App
 		StatusInfo:       sf.getApplicationStatusInfo(application),
 		Version:          application.WorkloadVersion,
 		EndpointBindings: application.EndpointBindings,
+
+		PlacementViolations: application.PlacementViolations,
 	}
 
 	for k, m := range application.Units {
@@ -108,6 +108,12 @@ func FormatTabular(writer io.Writer, forceColor bool, value any) error {
 		printRelations(tw, fs.Relations)
 	}
 
+	printPlacementViolations(tw, fs.Applications)
+
+	if fs.Model.Type != caasModelType {
+		printMachineRecovery(tw, fs.Machines)
+	}
+
 	if fs.Storage != nil {
 		_ = storage.FormatStorageListForStatusTabular(tw, *fs.Storage)
 	}
@@ -482,6 +488,57 @@ func printRelations(tw *ansiterm.TabWriter, relations []relationStatus) {
 	endSection(tw)
 }
 
+// printPlacementViolations prints the units which break the placement rules
+// of their application, if there are any.
+func printPlacementViolations(tw *ansiterm.TabWriter, applications map[string]applicationStatus) {
+	var w *output.Wrapper
+	for _, appName := range naturalsort.Sort(stringKeysFromMap(applications)) {
+		for _, violation := range applications[appName].PlacementViolations {
+			if w == nil {
+				w = startSection(tw, false, "App", "Placement violation")
+			}
+			w.Print(appName)
+			w.PrintColorNoTab(output.WarningHighlight, violation)
+			w.Println()
+		}
+	}
+	if w != nil {
+		endSection(tw)
+	}
+}
+
+// printMachineRecovery prints the automatic recovery status of the machines
+// whose cloud instance has failed, if there are any.
+func printMachineRecovery(tw *ansiterm.TabWriter, machines map[string]machineStatus) {
+	var w *output.Wrapper
+	for _, name := range naturalsort.Sort(stringKeysFromMap(machines)) {
+		recovery := machines[name].Recovery
+		if recovery == nil || len(recovery.History) == 0 {
+			continue
+		}
+		if w == nil {
+			w = startSection(tw, false, "Machine", "Recovery", "Attempts", "Next attempt", "Last attempt")
+		}
+
+		last := recovery.History[len(recovery.History)-1]
+		message := fmt.Sprintf("%s %s", last.InstanceId, last.Reason)
+		nextAttempt := recovery.NextAttempt
+		if recovery.Attempts >= recovery.MaxAttempts {
+			nextAttempt = "gave up"
+		}
+		w.Print(name, recovery.Policy, fmt.Sprintf("%d/%d", recovery.Attempts, recovery.MaxAttempts), nextAttempt)
+		if last.Error != "" {
+			w.PrintColorNoTab(output.WarningHighlight, fmt.Sprintf("%s: %s", message, last.Error))
+		} else {
+			w.PrintNoTab(message)
+		}
+		w.Println()
+	}
+	if w != nil {
+		endSection(tw)
+	}
+}
+
 // printOffers prints a tabular summary of the offers.
 func printOffers(tw *ansiterm.TabWriter, offers map[string]offerStatus) error {
 	if len(offers) == 0 {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"github.com/juju/juju/cmd/juju/storage"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/core/watcher"
 	internallogger "github.com/juju/juju/internal/logger"
 	"github.com/juju/juju/juju/osenv"
 	"github.com/juju/juju/rpc/params"
@@ -32,6 +34,7 @@ var logger = internallogger.GetLogger("juju.cmd.juju.status")
 
 type statusAPI interface {
 	Status(context.Context, *client.StatusArgs) (*params.FullStatus, error)
+	WatchStatus(context.Context) (watcher.NotifyWatcher, error)
 	Close() error
 }
 
@@ -52,6 +55,7 @@ type statusCommand struct {
 	modelcmd.ModelCommandBase
 	out       cmd.Output
 	patterns  []string
+	statuses  []string
 	isoTime   bool
 	statusAPI statusAPI
 	clock     Clock
@@ -71,19 +75,43 @@ type statusCommand struct {
 
 	// storage indicates if 'storage' section is displayed
 	storage bool
+
+	// watch indicates if the status is reported again each time it changes.
+	watch bool
 }
 
 var usageSummary = `
 Report the status of the model, its machines, applications and units.`[1:]
 
 var usageDetails = `
-Report the model's status, optionally filtered by exact machine,
-application, or unit names.
+Report the model's status, optionally filtered by selectors.
 
     juju status [<selector> [...]]
 
 When selectors are present, filter the report to exclude entities that do not
-match.
+match any of them. A selector is one of:
+
+- the name of a machine, application or unit, which may contain the wildcards
+` + "`*`" + `, ` + "`?`" + ` and ` + "`[...]`" + `, such as ` + "`mysql/*`" + ` or ` + "`0/lxd/*`" + `. A selector of this form
+also matches the applications deployed from a charm of that name, and
+` + "`<application>/leader`" + ` matches the leader unit of the application;
+- a status value, such as ` + "`error`" + ` or ` + "`blocked`" + `, which matches the applications,
+units and machines in that status;
+- a relation endpoint of the form ` + "`<application>:<endpoint>`" + `, which matches the
+applications related over that endpoint.
+
+The ` + "`--status`" + ` option further restricts the report to the entities in one of the
+given statuses. Filtering is done by the controller, so only the matching
+entities are sent to the client.
+
+### Watching the status
+
+The ` + "`--watch`" + ` option keeps the command running and reports the status again
+each time it changes, until interrupted. The controller notifies the client of
+changes to the applications, units and machines of the model, so the status is
+only read when something has changed. With the default tabular format the
+report is redrawn in place. With ` + "`--format=json`" + `, each report is written as a
+single line of JSON, which is suitable for processing by other tools.
 
 
 ### Altering the output format
@@ -117,6 +145,14 @@ Report the status of the ` + "`mysql/0`" + ` unit:
 
     juju status mysql/0
 
+Report the units of the ` + "`mysql`" + ` application which are in error:
+
+    juju status mysql/* --status=error
+
+Report the applications related over the ` + "`db`" + ` endpoint of ` + "`wordpress`" + `:
+
+    juju status wordpress:db
+
 Include information about storage and relations in output:
 
     juju status --storage --relations
@@ -124,6 +160,10 @@ Include information about storage and relations in output:
 Provide output as valid ` + "`JSON`" + `:
 
     juju status --format=json
+
+Keep reporting the status as it changes, one line of ` + "`JSON`" + ` per change:
+
+    juju status --watch --format=json
 `
 
 func (c *statusCommand) Info() *cmd.Info {
@@ -151,6 +191,8 @@ func (c *statusCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.integrations, "integrations", false, "Same as `--relations`")
 	f.BoolVar(&c.relations, "relations", false, "Show relations section in tabular output")
 	f.BoolVar(&c.storage, "storage", false, "Show storage section in tabular output")
+	f.Var(cmd.NewStringsValue(nil, &c.statuses), "status", "Only report entities in one of the given statuses, separated by commas")
+	f.BoolVar(&c.watch, "watch", false, "Report the status again each time it changes, until interrupted")
 
 	f.IntVar(&c.retryCount, "retry-count", 3, "Number of times to retry API failures")
 	f.DurationVar(&c.retryDelay, "retry-delay", 100*time.Millisecond, "Time to wait between retry attempts")
@@ -204,6 +246,9 @@ func (c *statusCommand) Init(args []string) error {
 	if c.color && c.noColor {
 		return errors.Errorf("cannot mix --no-color and --color")
 	}
+	if c.watch && c.out.Name() != "tabular" && c.out.Name() != "json" {
+		return errors.Errorf("--watch is only supported with the tabular and json formats")
+	}
 
 	return nil
 }
@@ -235,12 +280,14 @@ func (c *statusCommand) getStatus(ctx context.Context, includeStorage bool) (*pa
 	return apiclient.Status(ctx, &client.StatusArgs{
 		Patterns:       c.patterns,
 		IncludeStorage: includeStorage,
+		Statuses:       c.statuses,
 	})
 }
 
-func (c *statusCommand) runStatus(ctx *cmd.Context) error {
-	showIntegrations := c.integrations || c.relations
-	showStorage := c.storage
+// sections returns whether the relations and storage sections are shown.
+func (c *statusCommand) sections(ctx *cmd.Context) (showIntegrations bool, showStorage bool) {
+	showIntegrations = c.integrations || c.relations
+	showStorage = c.storage
 	if c.out.Name() != "tabular" {
 		showIntegrations = true
 		showStorage = true
@@ -256,9 +303,19 @@ func (c *statusCommand) runStatus(ctx *cmd.Context) error {
 			ctx.Infof("provided %s always enabled in non tabular formats", joinedMsg)
 		}
 	}
+	return showIntegrations, showStorage
+}
+
+func (c *statusCommand) runStatus(ctx *cmd.Context) error {
+	showIntegrations, showStorage := c.sections(ctx)
 
 	// Always attempt to get the status at least once, and retry if it fails.
 	status, err := c.getStatus(ctx, showStorage)
+	if errors.Is(err, errors.NotImplemented) {
+		// Retrying won't help if the controller doesn't support the
+		// requested filtering.
+		return errors.Trace(err)
+	}
 	if err != nil && !modelcmd.IsModelMigratedError(err) {
 		for i := 0; i < c.retryCount; i++ {
 			// fun bit - make sure a new api connection is used for each new call
@@ -283,11 +340,26 @@ func (c *statusCommand) runStatus(ctx *cmd.Context) error {
 		return errors.Errorf("unable to obtain the current status")
 	}
 
-	controllerName, err := c.ControllerName()
+	formatted, err := c.formatStatus(status, showIntegrations, showStorage)
 	if err != nil {
 		return errors.Trace(err)
 	}
 
+	if err = c.out.Write(ctx, formatted); err != nil {
+		return err
+	}
+	return c.reportEmpty(ctx, status)
+}
+
+// formatStatus converts the status into the structure used by the formatters.
+func (c *statusCommand) formatStatus(
+	status *params.FullStatus, showIntegrations, showStorage bool,
+) (formattedStatus, error) {
+	controllerName, err := c.ControllerName()
+	if err != nil {
+		return formattedStatus{}, errors.Trace(err)
+	}
+
 	formatterParams := NewStatusFormatterParams{
 		Status:         status,
 		ControllerName: controllerName,
@@ -299,7 +371,7 @@ func (c *statusCommand) runStatus(ctx *cmd.Context) error {
 		// TODO: move this into StatusFormatter
 		storageInfo, err := storage.CombinedStorageFromParams(status.Storage, status.Filesystems, status.Volumes)
 		if err != nil {
-			return errors.Trace(err)
+			return formattedStatus{}, errors.Trace(err)
 		}
 		formatterParams.Storage = storageInfo
 		if storageInfo == nil || storageInfo.Empty() {
@@ -310,19 +382,92 @@ func (c *statusCommand) runStatus(ctx *cmd.Context) error {
 		}
 	}
 
-	formatted, err := NewStatusFormatter(formatterParams).Format()
+	return NewStatusFormatter(formatterParams).Format()
+}
+
+// watchStatus reports the status each time the controller notifies that it
+// has changed, until the command is interrupted.
+func (c *statusCommand) watchStatus(ctx *cmd.Context) error {
+	showIntegrations, showStorage := c.sections(ctx)
+
+	apiclient, err := c.getStatusAPI(ctx)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	w, err := apiclient.WatchStatus(ctx)
+	if errors.Is(err, errors.NotImplemented) {
+		return errors.New("--watch is not supported by this controller, upgrade the controller to use it")
+	} else if err != nil {
+		return errors.Annotate(err, "watching status")
+	}
+	defer func() {
+		w.Kill()
+		_ = w.Wait()
+	}()
+
+	interrupted := make(chan os.Signal, 1)
+	ctx.InterruptNotify(interrupted)
+	defer ctx.StopInterruptNotify(interrupted)
+
+	// Each report is written as a single line of JSON, so that the output
+	// can be processed as JSON lines.
+	formatter := cmd.FormatJson
+	if c.out.Name() == "tabular" {
+		formatter = c.FormatTabular
+	}
+	redraw := func(writer io.Writer, value any) error {
+		if c.out.Name() == "tabular" && isTerminal(writer) {
+			// Move the cursor home and clear the screen.
+			if _, err := io.WriteString(writer, "\x1b[H\x1b[2J"); err != nil {
+				return err
+			}
+		}
+		return formatter(writer, value)
+	}
+
+	var last formattedStatus
+	for first := true; ; first = false {
+		select {
+		case <-interrupted:
+			return nil
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-w.Changes():
+			if !ok {
+				return errors.Annotate(w.Wait(), "watching status")
+			}
+		}
 
-	if err = c.out.Write(ctx, formatted); err != nil {
-		return err
+		status, err := c.getStatus(ctx, showStorage)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		formatted, err := c.formatStatus(status, showIntegrations, showStorage)
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		// The controller timestamp changes with every report, so it is
+		// ignored when deciding whether anything has changed.
+		current := formatted
+		current.Controller = nil
+		if !first && reflect.DeepEqual(current, last) {
+			continue
+		}
+		last = current
+
+		if err := c.out.WriteFormatter(ctx, redraw, formatted); err != nil {
+			return errors.Trace(err)
+		}
 	}
+}
 
+// reportEmpty tells the user when the status has nothing to report.
+func (c *statusCommand) reportEmpty(ctx *cmd.Context, status *params.FullStatus) error {
 	if !status.IsEmpty() {
 		return nil
 	}
-	if len(c.patterns) == 0 {
+	if len(c.patterns) == 0 && len(c.statuses) == 0 {
 		modelName, err := c.ModelIdentifier()
 		if err != nil {
 			return err
@@ -333,7 +478,7 @@ func (c *statusCommand) runStatus(ctx *cmd.Context) error {
 		ctx.Infof("\nModel %q is empty.", modelName)
 	} else {
 		plural := func() string {
-			if len(c.patterns) == 1 {
+			if len(c.patterns)+len(c.statuses) == 1 {
 				return ""
 			}
 			return "s"
@@ -357,6 +502,9 @@ func (c *statusCommand) statusCommandAllArgs(args []string) []string {
 func (c *statusCommand) Run(ctx *cmd.Context) error {
 	defer c.close()
 
+	if c.watch {
+		return c.watchStatus(ctx)
+	}
 	err := c.runStatus(ctx)
 	if err != nil {
 		return err
@@ -4876,6 +4876,108 @@ foo/0  waiting   allocating  10.0.0.1  80/TCP
 `[1:])
 }
 
+func (s *StatusSuite) TestFormatTabularPlacementViolations(c *tc.C) {
+	fStatus := formattedStatus{
+		Applications: map[string]applicationStatus{
+			"foo": {
+				PlacementViolations: []string{
+					"foo/1 on machine 0: more than 1 unit(s) per machine",
+				},
+			},
+			"bar": {},
+		},
+	}
+	out := &bytes.Buffer{}
+	err := FormatTabular(out, false, fStatus)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(out.String(), tc.Equals, `
+Model  Controller  Cloud/Region  Version
+                                 
+
+App  Version  Status  Scale  Charm  Channel  Rev  Exposed  Message
+bar                       0                    0  no       
+foo                       0                    0  no       
+
+App  Placement violation
+foo  foo/1 on machine 0: more than 1 unit(s) per machine
+`[1:])
+}
+
+func (s *StatusSuite) TestFormatTabularMachineRecovery(c *tc.C) {
+	fStatus := formattedStatus{
+		Machines: map[string]machineStatus{
+			"0": {
+				Id: "0",
+				Recovery: &machineRecoveryStatus{
+					Policy:      "reprovision",
+					Attempts:    2,
+					MaxAttempts: 5,
+					NextAttempt: "01 Aug 2026 12:10:00Z",
+					History: []machineRecoveryAttempt{{
+						AttemptedAt: "01 Aug 2026 12:00:00Z",
+						InstanceId:  "i-1",
+						Reason:      "instance not found",
+					}, {
+						AttemptedAt: "01 Aug 2026 12:05:00Z",
+						InstanceId:  "i-2",
+						Reason:      "instance stopped",
+						Error:       "model-scoped storage is attached",
+					}},
+				},
+			},
+			"1": {Id: "1"},
+		},
+	}
+	out := &bytes.Buffer{}
+	err := FormatTabular(out, false, fStatus)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(out.String(), tc.Equals, `
+Model  Controller  Cloud/Region  Version
+                                 
+
+Machine  State  Address  Inst id  Base  AZ  Message
+0                                           
+1                                           
+
+Machine  Recovery     Attempts  Next attempt           Last attempt
+0        reprovision  2/5       01 Aug 2026 12:10:00Z  i-2 instance stopped: model-scoped storage is attached
+`[1:])
+}
+
+func (s *StatusSuite) TestFormatMachineRecovery(c *tc.C) {
+	attemptedAt := time.Date(2026, 8, 1, 12, 0, 0, 0, time.UTC)
+	nextAttempt := attemptedAt.Add(5 * time.Minute)
+	formatter := NewStatusFormatter(NewStatusFormatterParams{
+		Status:  &params.FullStatus{},
+		ISOTime: true,
+	})
+	formatted := formatter.formatMachine(params.MachineStatus{
+		Id: "0",
+		Recovery: &params.MachineRecoveryStatus{
+			Policy:      "reprovision",
+			Attempts:    1,
+			MaxAttempts: 5,
+			NextAttempt: &nextAttempt,
+			History: []params.MachineRecoveryAttempt{{
+				AttemptedAt: attemptedAt,
+				InstanceId:  "i-1",
+				Reason:      "instance not found",
+			}},
+		},
+	})
+	c.Check(formatted.Recovery, tc.DeepEquals, &machineRecoveryStatus{
+		Policy:      "reprovision",
+		Attempts:    1,
+		MaxAttempts: 5,
+		NextAttempt: "2026-08-01 12:05:00Z",
+		History: []machineRecoveryAttempt{{
+			AttemptedAt: "2026-08-01 12:00:00Z",
+			InstanceId:  "i-1",
+			Reason:      "instance not found",
+		}},
+	})
+}
+
 func (s *StatusSuite) TestFormatTabularManyPorts(c *tc.C) {
 	fStatus := formattedStatus{
 		Model: modelStatus{
@@ -6,9 +6,11 @@ package status
 import (
 	"context"
 	"errors"
+	"strings"
 	stdtesting "testing"
 	"time"
 
+	jujuerrors "github.com/juju/errors"
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/api/client/client"
@@ -17,6 +19,8 @@ import (
 	"github.com/juju/juju/cmd/cmd/cmdtesting"
 	coremodel "github.com/juju/juju/core/model"
 	corestatus "github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/watchertest"
 	"github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 )
@@ -151,11 +155,75 @@ func (s *MinimalStatusSuite) TestRetryCountOfZero(c *tc.C) {
 	c.Assert(s.clock.waits, tc.HasLen, 0)
 }
 
+func (s *MinimalStatusSuite) TestStatusFilter(c *tc.C) {
+	_, err := s.runStatus(c, "--no-color", "mysql/*", "--status", "error,blocked")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.statusapi.patterns, tc.DeepEquals, []string{"mysql/*"})
+	c.Check(s.statusapi.statuses, tc.DeepEquals, []string{"error", "blocked"})
+}
+
+func (s *MinimalStatusSuite) TestStatusFilterNotSupported(c *tc.C) {
+	s.statusapi.errors = []error{
+		jujuerrors.NotImplementedf("filtering status by status value on this version of Juju"),
+	}
+
+	_, err := s.runStatus(c, "--no-color", "--status", "error")
+	c.Assert(err, tc.ErrorIs, jujuerrors.NotImplemented)
+	c.Check(s.clock.waits, tc.HasLen, 0)
+}
+
+func (s *MinimalStatusSuite) TestWatchFormats(c *tc.C) {
+	_, err := s.runStatus(c, "--watch", "--format", "yaml")
+	c.Assert(err, tc.ErrorMatches, `--watch is only supported with the tabular and json formats`)
+}
+
+func (s *MinimalStatusSuite) TestWatchJSONLines(c *tc.C) {
+	active := func(appStatus string) *params.FullStatus {
+		return &params.FullStatus{
+			Model: params.ModelStatusInfo{Name: "test", CloudTag: "cloud-foo"},
+			Applications: map[string]params.ApplicationStatus{
+				"mysql": {Status: params.DetailedStatus{Status: appStatus}},
+			},
+		}
+	}
+	s.statusapi.watchResults = []*params.FullStatus{
+		active("waiting"),
+		// Only the controller timestamp has changed, so nothing is
+		// reported.
+		active("waiting"),
+		active("active"),
+	}
+	now := time.Now()
+	s.statusapi.watchResults[1].ControllerTimestamp = &now
+	s.statusapi.expectIncludeStorage = true
+
+	ctx, err := s.runStatus(c, "--watch", "--format", "json")
+	c.Assert(err, tc.ErrorIsNil)
+
+	lines := strings.Split(strings.TrimSuffix(cmdtesting.Stdout(ctx), "\n"), "\n")
+	c.Assert(lines, tc.HasLen, 2)
+	c.Check(lines[0], tc.Matches, `\{"model":.*"applications":\{"mysql":\{.*"current":"waiting".*`)
+	c.Check(lines[1], tc.Matches, `\{"model":.*"applications":\{"mysql":\{.*"current":"active".*`)
+}
+
+func (s *MinimalStatusSuite) TestWatchNotSupported(c *tc.C) {
+	s.statusapi.watchErr = jujuerrors.NotImplementedf("watching status on this version of Juju")
+
+	_, err := s.runStatus(c, "--watch")
+	c.Assert(err, tc.ErrorMatches, `--watch is not supported by this controller, upgrade the controller to use it`)
+}
+
 type fakeStatusAPI struct {
 	expectIncludeStorage bool
 	result               *params.FullStatus
 	patterns             []string
+	statuses             []string
 	errors               []error
+
+	// watchResults are returned in order by Status, one for each change
+	// of the status watcher.
+	watchResults []*params.FullStatus
+	watchErr     error
 }
 
 func (f *fakeStatusAPI) Status(ctx context.Context, args *client.StatusArgs) (*params.FullStatus, error) {
@@ -163,6 +231,12 @@ func (f *fakeStatusAPI) Status(ctx context.Context, args *client.StatusArgs) (*p
 		return nil, errors.New("IncludeStorage arg mismatch")
 	}
 	f.patterns = args.Patterns
+	f.statuses = args.Statuses
+	if len(f.watchResults) > 0 {
+		result := f.watchResults[0]
+		f.watchResults = f.watchResults[1:]
+		return result, nil
+	}
 	if len(f.errors) > 0 {
 		err, rest := f.errors[0], f.errors[1:]
 		f.errors = rest
@@ -174,6 +248,22 @@ func (f *fakeStatusAPI) Status(ctx context.Context, args *client.StatusArgs) (*p
 	return f.result, nil
 }
 
+func (f *fakeStatusAPI) WatchStatus(context.Context) (watcher.NotifyWatcher, error) {
+	if f.watchErr != nil {
+		return nil, f.watchErr
+	}
+	// Send one change for each result, then stop the watcher so that the
+	// command returns once they are reported.
+	ch := make(chan struct{}, len(f.watchResults))
+	for range f.watchResults {
+		ch <- struct{}{}
+	}
+	close(ch)
+	w := watchertest.NewMockNotifyWatcher(ch)
+	w.Kill()
+	return w, nil
+}
+
 func (*fakeStatusAPI) Close() error {
 	return nil
 }
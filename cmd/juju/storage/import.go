@@ -32,16 +32,57 @@ func NewImportFilesystemCommand(
 	newStorageImporter NewStorageImporterFunc,
 	store jujuclient.ClientStore,
 ) cmd.Command {
-	cmd := &importFilesystemCommand{}
-	cmd.newAPIFunc = newStorageImporter
+	c := &importStorageCommand{
+		kind: internalstorage.StorageKindFilesystem,
+		info: &cmd.Info{
+			Name:     "import-filesystem",
+			Purpose:  "Imports a filesystem into the model.",
+			Doc:      importFilesystemCommandDoc,
+			Args:     importStorageCommandArgs,
+			Examples: importFilesystemCommandExamples,
+			SeeAlso:  []string{"import-volume", "storage"},
+		},
+	}
+	c.newAPIFunc = newStorageImporter
+	if store != nil {
+		c.SetClientStore(store)
+	}
+	return modelcmd.Wrap(c)
+}
+
+// NewImportVolumeCommand returns a command used to import a block volume.
+//
+// newStorageImporter is the function to use to acquire a StorageImporter.
+// A non-nil function must be provided.
+//
+// store is an optional ClientStore to use for interacting with the client
+// model/controller storage. If nil, the default file-based store will be
+// used.
+func NewImportVolumeCommand(
+	newStorageImporter NewStorageImporterFunc,
+	store jujuclient.ClientStore,
+) cmd.Command {
+	c := &importStorageCommand{
+		kind: internalstorage.StorageKindBlock,
+		info: &cmd.Info{
+			Name:     "import-volume",
+			Purpose:  "Imports a block volume into the model.",
+			Doc:      importVolumeCommandDoc,
+			Args:     importStorageCommandArgs,
+			Examples: importVolumeCommandExamples,
+			SeeAlso:  []string{"attach-storage", "import-filesystem", "storage"},
+		},
+	}
+	c.newAPIFunc = newStorageImporter
 	if store != nil {
-		cmd.SetClientStore(store)
+		c.SetClientStore(store)
 	}
-	return modelcmd.Wrap(cmd)
+	return modelcmd.Wrap(c)
 }
 
 // NewStorageImporterFunc is the type of a function passed to
-// NewImportFilesystemCommand, in order to acquire a StorageImporter.
+// NewImportFilesystemCommand and NewImportVolumeCommand, in order to acquire
+// a StorageImporter.
 type NewStorageImporterFunc func(context.Context, *StorageCommandBase) (StorageImporter, error)
 
 // NewStorageImporter returns a new StorageImporter,
@@ -98,16 +139,52 @@ Import a PersistentVolume that is bound to a PVC not used by Juju:
     juju import-filesystem kubernetes pv-data-001 pgdata --force
 `
 
-	importFilesystemCommandAgs = `
+	importVolumeCommandDoc = `
+Import an existing block volume into the model. This will lead to the model
+taking ownership of the storage, so you must take care not to import storage
+that is in use by another Juju model.
+
+To import a volume, you must specify three things:
+
+ - the storage provider which manages the storage, and with
+   which the storage will be associated
+ - the storage provider ID for the volume
+ - the storage name to assign to the volume,
+   corresponding to the name of a block storage used by a charm
+
+Once a volume is imported, Juju will create an associated block storage
+instance using the given storage name. The storage instance is detached
+and can be attached to a unit with ` + "`juju attach-storage`" + `.
+
+Only storage providers that provision volumes independently of machines
+support importing volumes.
+
+`
+	importVolumeCommandExamples = `
+Import an existing EBS volume and assign it the ` + "`pgdata`" + ` storage
+name. Juju will associate a storage instance ID like ` + "`pgdata/0`" + ` with
+the volume:
+
+    juju import-volume ebs vol-123456 pgdata
+
+Attach the imported volume to a unit:
+
+    juju attach-storage postgresql/0 pgdata/0
+`
+
+	importStorageCommandArgs = `
 <storage-provider> <provider-id> <storage-name>
 `
 )
 
-// importFilesystemCommand imports filesystems into the model.
-type importFilesystemCommand struct {
+// importStorageCommand imports filesystems or block volumes into the model.
+type importStorageCommand struct {
 	StorageCommandBase
 	newAPIFunc NewStorageImporterFunc
 
+	kind internalstorage.StorageKind
+	info *cmd.Info
+
 	storagePool       string
 	storageProviderId string
 	storageName       string
@@ -115,9 +192,12 @@ type importFilesystemCommand struct {
 }
 
 // Init implements Command.Init.
-func (c *importFilesystemCommand) Init(args []string) error {
+func (c *importStorageCommand) Init(args []string) error {
 	if len(args) < 3 {
-		return errors.New("import-filesystem requires a storage provider, provider ID, and storage name")
+		return errors.Errorf(
+			"%s requires a storage provider, provider ID, and storage name",
+			c.info.Name,
+		)
 	}
 	c.storagePool = args[0]
 	c.storageProviderId = args[1]
@@ -138,25 +218,19 @@ func (c *importFilesystemCommand) Init(args []string) error {
 }
 
 // SetFlags implements Command.SetFlags.
-func (c *importFilesystemCommand) SetFlags(f *gnuflag.FlagSet) {
+func (c *importStorageCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.StorageCommandBase.SetFlags(f)
 	f.BoolVar(&c.force, "force", false, "import a volume even if otherwise prohibited (cloud specific)")
 }
 
 // Info implements Command.Info.
-func (c *importFilesystemCommand) Info() *cmd.Info {
-	return jujucmd.Info(&cmd.Info{
-		Name:     "import-filesystem",
-		Purpose:  "Imports a filesystem into the model.",
-		Doc:      importFilesystemCommandDoc,
-		Args:     importFilesystemCommandAgs,
-		Examples: importFilesystemCommandExamples,
-		SeeAlso:  []string{"storage"},
-	})
+func (c *importStorageCommand) Info() *cmd.Info {
+	info := *c.info
+	return jujucmd.Info(&info)
 }
 
 // Run implements Command.Run.
-func (c *importFilesystemCommand) Run(ctx *cmd.Context) (err error) {
+func (c *importStorageCommand) Run(ctx *cmd.Context) (err error) {
 	api, err := c.newAPIFunc(ctx, &c.StorageCommandBase)
 	if err != nil {
 		return err
@@ -169,7 +243,7 @@ func (c *importFilesystemCommand) Run(ctx *cmd.Context) (err error) {
 	)
 	storageTag, err := api.ImportStorage(
 		ctx,
-		internalstorage.StorageKindFilesystem,
+		c.kind,
 		c.storagePool, c.storageProviderId, c.storageName, c.force,
 	)
 	if err != nil {
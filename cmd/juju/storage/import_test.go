@@ -142,6 +142,57 @@ func (s *ImportFilesystemSuite) run(c *tc.C, args ...string) (*cmd.Context, erro
 	), args...)
 }
 
+type ImportVolumeSuite struct {
+	SubStorageSuite
+	importer mockStorageImporter
+}
+
+func TestImportVolumeSuite(t *testing.T) {
+	tc.Run(t, &ImportVolumeSuite{})
+}
+
+func (s *ImportVolumeSuite) SetUpTest(c *tc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+	s.importer = mockStorageImporter{}
+}
+
+func (s *ImportVolumeSuite) TestInitErrors(c *tc.C) {
+	_, err := s.run(c, "foo", "bar")
+	c.Assert(err, tc.ErrorMatches,
+		"import-volume requires a storage provider, provider ID, and storage name")
+
+	_, err = s.run(c, "foo", "abc123", "123")
+	c.Assert(err, tc.ErrorMatches, `"123" is not a valid storage name`)
+}
+
+func (s *ImportVolumeSuite) TestImportSuccess(c *tc.C) {
+	ctx, err := s.run(c, "--force", "ebs", "vol-123", "pgdata")
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Assert(cmdtesting.Stdout(ctx), tc.Equals, "")
+	c.Assert(cmdtesting.Stderr(ctx), tc.Equals, `
+importing "vol-123" from storage pool "ebs" as storage "pgdata"
+imported storage pgdata/0
+`[1:])
+
+	s.importer.CheckCalls(c, []testhelpers.StubCall{
+		{"ImportStorage", []any{
+			jujustorage.StorageKindBlock,
+			"ebs", "vol-123", "pgdata", true,
+		}},
+		{"Close", nil},
+	})
+}
+
+func (s *ImportVolumeSuite) run(c *tc.C, args ...string) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, storage.NewImportVolumeCommand(
+		func(context.Context, *storage.StorageCommandBase) (storage.StorageImporter, error) {
+			return &s.importer, nil
+		},
+		s.store,
+	), args...)
+}
+
 type mockStorageImporter struct {
 	testhelpers.Stub
 }
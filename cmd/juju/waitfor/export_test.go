@@ -0,0 +1,24 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewWaitForCommandForTest returns a wait-for command using the input API.
+func NewWaitForCommandForTest(store jujuclient.ClientStore, api WaitForAPI, clock clock.Clock) cmd.Command {
+	c := &waitForCommand{api: api, clock: clock}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c)
+}
+
+const (
+	TimeoutExitCode    = timeoutExitCode
+	ErrorStateExitCode = errorStateExitCode
+)
@@ -0,0 +1,198 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package query
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/juju/errors"
+)
+
+// tokenType is the type of a lexical token of a query.
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	tokenIdent
+	tokenString
+	tokenInt
+	tokenTrue
+	tokenFalse
+	tokenEq
+	tokenNotEq
+	tokenLT
+	tokenLE
+	tokenGT
+	tokenGE
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLParen
+	tokenRParen
+	tokenComma
+	tokenPeriod
+	tokenLambda
+)
+
+var tokenNames = map[tokenType]string{
+	tokenEOF:    "end of query",
+	tokenIdent:  "identifier",
+	tokenString: "string",
+	tokenInt:    "integer",
+	tokenTrue:   "true",
+	tokenFalse:  "false",
+	tokenEq:     "==",
+	tokenNotEq:  "!=",
+	tokenLT:     "<",
+	tokenLE:     "<=",
+	tokenGT:     ">",
+	tokenGE:     ">=",
+	tokenAnd:    "&&",
+	tokenOr:     "||",
+	tokenNot:    "!",
+	tokenLParen: "(",
+	tokenRParen: ")",
+	tokenComma:  ",",
+	tokenPeriod: ".",
+	tokenLambda: "=>",
+}
+
+func (t tokenType) String() string {
+	return tokenNames[t]
+}
+
+// token is a lexical token of a query, with the position in the query at
+// which it starts.
+type token struct {
+	typ   tokenType
+	value string
+	pos   int
+}
+
+func (t token) String() string {
+	switch t.typ {
+	case tokenIdent, tokenInt:
+		return fmt.Sprintf("%s %s", t.typ, t.value)
+	case tokenString:
+		return fmt.Sprintf("string %q", t.value)
+	}
+	return fmt.Sprintf("%q", t.typ.String())
+}
+
+// operators holds the operator tokens, longest first so that "==" is
+// matched before "=".
+var operators = []struct {
+	text string
+	typ  tokenType
+}{
+	{"==", tokenEq},
+	{"!=", tokenNotEq},
+	{"<=", tokenLE},
+	{">=", tokenGE},
+	{"&&", tokenAnd},
+	{"||", tokenOr},
+	{"=>", tokenLambda},
+	{"<", tokenLT},
+	{">", tokenGT},
+	{"!", tokenNot},
+	{"(", tokenLParen},
+	{")", tokenRParen},
+	{",", tokenComma},
+	{".", tokenPeriod},
+}
+
+// lex splits the input into tokens. The last token is always tokenEOF.
+func lex(input string) ([]token, error) {
+	var tokens []token
+	runes := []rune(input)
+	for pos := 0; pos < len(runes); {
+		r := runes[pos]
+		switch {
+		case unicode.IsSpace(r):
+			pos++
+
+		case r == '"' || r == '\'':
+			value, end, err := lexString(runes, pos)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{typ: tokenString, value: value, pos: pos})
+			pos = end
+
+		case unicode.IsDigit(r):
+			end := pos
+			for end < len(runes) && unicode.IsDigit(runes[end]) {
+				end++
+			}
+			tokens = append(tokens, token{typ: tokenInt, value: string(runes[pos:end]), pos: pos})
+			pos = end
+
+		case isIdentStart(r):
+			end := pos
+			for end < len(runes) && isIdentPart(runes[end]) {
+				end++
+			}
+			value := string(runes[pos:end])
+			typ := tokenIdent
+			switch value {
+			case "true":
+				typ = tokenTrue
+			case "false":
+				typ = tokenFalse
+			}
+			tokens = append(tokens, token{typ: typ, value: value, pos: pos})
+			pos = end
+
+		default:
+			matched := false
+			for _, op := range operators {
+				if strings.HasPrefix(string(runes[pos:]), op.text) {
+					tokens = append(tokens, token{typ: op.typ, value: op.text, pos: pos})
+					pos += len(op.text)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, errors.Errorf("unexpected character %q at position %d", r, pos)
+			}
+		}
+	}
+	return append(tokens, token{typ: tokenEOF, pos: len(runes)}), nil
+}
+
+// lexString reads a quoted string starting at pos, returning the unquoted
+// value and the position after the closing quote. A backslash escapes the
+// character that follows it.
+func lexString(runes []rune, pos int) (string, int, error) {
+	quote := runes[pos]
+	var value strings.Builder
+	for i := pos + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+				value.WriteRune(runes[i])
+			}
+		case quote:
+			return value.String(), i + 1, nil
+		default:
+			value.WriteRune(runes[i])
+		}
+	}
+	return "", 0, errors.Errorf("unterminated string starting at position %d", pos)
+}
+
+func isIdentStart(r rune) bool {
+	return unicode.IsLetter(r) || r == '_'
+}
+
+// isIdentPart reports whether the rune can be part of an identifier. Dashes
+// are allowed so that identifiers match the keys of the status output, such
+// as workload-status.
+func isIdentPart(r rune) bool {
+	return isIdentStart(r) || unicode.IsDigit(r) || r == '-'
+}
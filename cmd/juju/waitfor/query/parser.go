@@ -0,0 +1,235 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package query
+
+import (
+	"strconv"
+
+	"github.com/juju/errors"
+)
+
+// node is an expression of the query syntax tree.
+type node interface {
+	eval(scope Scope) (any, error)
+}
+
+type literal struct {
+	value any
+}
+
+type identifier struct {
+	name string
+}
+
+type member struct {
+	target node
+	name   string
+}
+
+type not struct {
+	operand node
+}
+
+type binary struct {
+	op          tokenType
+	left, right node
+}
+
+type call struct {
+	name string
+	args []node
+}
+
+type lambda struct {
+	param string
+	body  node
+}
+
+// parser is a recursive descent parser for queries. From the lowest to the
+// highest precedence the grammar is:
+//
+//	or         = and { "||" and }
+//	and        = comparison { "&&" comparison }
+//	comparison = unary [ ( "==" | "!=" | "<" | "<=" | ">" | ">=" ) unary ]
+//	unary      = "!" unary | postfix
+//	postfix    = primary { "." identifier }
+//	primary    = string | integer | "true" | "false" | identifier
+//	           | identifier "(" [ argument { "," argument } ] ")"
+//	           | "(" or ")"
+//	argument   = identifier "=>" or | or
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.typ != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) expect(typ tokenType) (token, error) {
+	t := p.next()
+	if t.typ != typ {
+		return t, errors.Errorf("expected %q, got %s at position %d", typ, t, t.pos)
+	}
+	return t, nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().typ == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = binary{op: tokenOr, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().typ == tokenAnd {
+		p.next()
+		right, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		left = binary{op: tokenAnd, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	switch op := p.peek().typ; op {
+	case tokenEq, tokenNotEq, tokenLT, tokenLE, tokenGT, tokenGE:
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return binary{op: op, left: left, right: right}, nil
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.peek().typ == tokenNot {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return not{operand: operand}, nil
+	}
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() (node, error) {
+	target, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().typ == tokenPeriod {
+		p.next()
+		name, err := p.expect(tokenIdent)
+		if err != nil {
+			return nil, err
+		}
+		target = member{target: target, name: name.value}
+	}
+	return target, nil
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+	switch t.typ {
+	case tokenString:
+		return literal{value: t.value}, nil
+	case tokenInt:
+		value, err := strconv.Atoi(t.value)
+		if err != nil {
+			return nil, errors.Errorf("invalid integer %s at position %d", t.value, t.pos)
+		}
+		return literal{value: value}, nil
+	case tokenTrue:
+		return literal{value: true}, nil
+	case tokenFalse:
+		return literal{value: false}, nil
+	case tokenIdent:
+		if p.peek().typ == tokenLParen {
+			return p.parseCall(t)
+		}
+		return identifier{name: t.value}, nil
+	case tokenLParen:
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRParen); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	}
+	return nil, errors.Errorf("unexpected %s at position %d", t, t.pos)
+}
+
+func (p *parser) parseCall(name token) (node, error) {
+	if _, ok := functions[name.value]; !ok {
+		return nil, errors.Errorf("unknown function %q at position %d", name.value, name.pos)
+	}
+	p.next()
+
+	var args []node
+	if p.peek().typ == tokenRParen {
+		p.next()
+		return call{name: name.value}, nil
+	}
+	for {
+		arg, err := p.parseArgument()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+
+		t := p.next()
+		if t.typ == tokenRParen {
+			return call{name: name.value, args: args}, nil
+		}
+		if t.typ != tokenComma {
+			return nil, errors.Errorf("expected \",\" or \")\", got %s at position %d", t, t.pos)
+		}
+	}
+}
+
+func (p *parser) parseArgument() (node, error) {
+	if p.peek().typ == tokenIdent && p.tokens[p.pos+1].typ == tokenLambda {
+		param := p.next()
+		p.next()
+		body, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return lambda{param: param.value, body: body}, nil
+	}
+	return p.parseOr()
+}
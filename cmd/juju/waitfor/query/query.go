@@ -0,0 +1,306 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package query implements the small expression language used by
+// `juju wait-for` to describe the goal state of an entity, for example:
+//
+//	status=="active" && len(units)==3
+//	forEach(units, unit => unit.workload-status=="active")
+//
+// Expressions are evaluated against a Scope, which maps identifiers to
+// strings, integers, booleans, lists of values and nested scopes.
+package query
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// ErrUnknownIdentifier is returned when a query references an identifier
+// which isn't in the scope it is evaluated against.
+const ErrUnknownIdentifier = errors.ConstError("unknown identifier")
+
+// Scope holds the values of the identifiers a query can reference. Values
+// must be a string, int, bool, []Scope or Scope.
+type Scope map[string]any
+
+// Query is a parsed query expression.
+type Query struct {
+	input string
+	root  node
+}
+
+// Parse parses the query expression.
+func Parse(input string) (*Query, error) {
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, errors.Annotate(err, "parsing query")
+	}
+	if tokens[0].typ == tokenEOF {
+		return nil, errors.New("parsing query: empty query")
+	}
+
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, errors.Annotate(err, "parsing query")
+	}
+	if t := p.peek(); t.typ != tokenEOF {
+		return nil, errors.Errorf("parsing query: unexpected %s at position %d", t, t.pos)
+	}
+	return &Query{input: input, root: root}, nil
+}
+
+// String returns the query as it was written.
+func (q *Query) String() string {
+	return q.input
+}
+
+// Run evaluates the query against the scope. The query must evaluate to a
+// boolean.
+func (q *Query) Run(scope Scope) (bool, error) {
+	value, err := q.root.eval(scope)
+	if err != nil {
+		return false, errors.Annotatef(err, "running query %q", q.input)
+	}
+	result, ok := value.(bool)
+	if !ok {
+		return false, errors.Errorf("running query %q: expected a boolean result, got %s", q.input, typeName(value))
+	}
+	return result, nil
+}
+
+func (n literal) eval(Scope) (any, error) {
+	return n.value, nil
+}
+
+func (n identifier) eval(scope Scope) (any, error) {
+	return lookup(scope, n.name)
+}
+
+func (n member) eval(scope Scope) (any, error) {
+	target, err := n.target.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+	inner, ok := target.(Scope)
+	if !ok {
+		return nil, errors.Errorf("cannot access %q of %s", n.name, typeName(target))
+	}
+	return lookup(inner, n.name)
+}
+
+func lookup(scope Scope, name string) (any, error) {
+	value, ok := scope[name]
+	if !ok {
+		return nil, errors.WithType(errors.Errorf("unknown identifier %q, expected one of %s",
+			name, strings.Join(identifiers(scope), ", ")), ErrUnknownIdentifier)
+	}
+	return value, nil
+}
+
+func identifiers(scope Scope) []string {
+	names := make([]string, 0, len(scope))
+	for name := range scope {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (n not) eval(scope Scope) (any, error) {
+	value, err := evalBool(n.operand, scope)
+	if err != nil {
+		return nil, err
+	}
+	return !value, nil
+}
+
+func (n binary) eval(scope Scope) (any, error) {
+	switch n.op {
+	case tokenAnd, tokenOr:
+		left, err := evalBool(n.left, scope)
+		if err != nil {
+			return nil, err
+		}
+		// Short circuit, so that the right hand side can depend on the
+		// left, for example len(units) > 0 && ...
+		if (n.op == tokenAnd && !left) || (n.op == tokenOr && left) {
+			return left, nil
+		}
+		return evalBool(n.right, scope)
+	}
+
+	left, err := n.left.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+	return compare(n.op, left, right)
+}
+
+func compare(op tokenType, left, right any) (bool, error) {
+	switch l := left.(type) {
+	case string:
+		if r, ok := right.(string); ok {
+			return compareOrdered(op, l, r), nil
+		}
+	case int:
+		if r, ok := right.(int); ok {
+			return compareOrdered(op, l, r), nil
+		}
+	case bool:
+		if r, ok := right.(bool); ok {
+			switch op {
+			case tokenEq:
+				return l == r, nil
+			case tokenNotEq:
+				return l != r, nil
+			}
+			return false, errors.Errorf("cannot use %q with booleans", op)
+		}
+	}
+	return false, errors.Errorf("cannot compare %s with %s", typeName(left), typeName(right))
+}
+
+func compareOrdered[T string | int](op tokenType, left, right T) bool {
+	switch op {
+	case tokenEq:
+		return left == right
+	case tokenNotEq:
+		return left != right
+	case tokenLT:
+		return left < right
+	case tokenLE:
+		return left <= right
+	case tokenGT:
+		return left > right
+	case tokenGE:
+		return left >= right
+	}
+	return false
+}
+
+func (n lambda) eval(Scope) (any, error) {
+	return nil, errors.Errorf("unexpected function argument %q", n.param+" => ...")
+}
+
+func (n call) eval(scope Scope) (any, error) {
+	return functions[n.name](scope, n.args)
+}
+
+func evalBool(n node, scope Scope) (bool, error) {
+	value, err := n.eval(scope)
+	if err != nil {
+		return false, err
+	}
+	result, ok := value.(bool)
+	if !ok {
+		return false, errors.Errorf("expected a boolean, got %s", typeName(value))
+	}
+	return result, nil
+}
+
+// function is a built in function of the query language. Arguments are
+// passed unevaluated so that functions can take lambdas.
+type function func(scope Scope, args []node) (any, error)
+
+var functions map[string]function
+
+func init() {
+	// The functions are set up here to break the initialisation cycle
+	// between the parser, which checks function names, and the functions,
+	// which evaluate their arguments.
+	functions = map[string]function{
+		"len":     lenFunc,
+		"forEach": forEachFunc,
+		"any":     anyFunc,
+	}
+}
+
+// lenFunc returns the length of a string or list.
+func lenFunc(scope Scope, args []node) (any, error) {
+	if len(args) != 1 {
+		return nil, errors.Errorf("len expects 1 argument, got %d", len(args))
+	}
+	value, err := args[0].eval(scope)
+	if err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case string:
+		return len(v), nil
+	case []Scope:
+		return len(v), nil
+	}
+	return nil, errors.Errorf("len expects a string or list, got %s", typeName(value))
+}
+
+// forEachFunc returns true if the lambda is true for every item of a list.
+func forEachFunc(scope Scope, args []node) (any, error) {
+	return matchItems("forEach", scope, args, func(item bool) bool { return !item }, true)
+}
+
+// anyFunc returns true if the lambda is true for at least one item of a list.
+func anyFunc(scope Scope, args []node) (any, error) {
+	return matchItems("any", scope, args, func(item bool) bool { return item }, false)
+}
+
+// matchItems evaluates the lambda in args for each item of the list in args,
+// returning !whenEmpty as soon as stop returns true for an item, and
+// whenEmpty if it never does.
+func matchItems(name string, scope Scope, args []node, stop func(bool) bool, whenEmpty bool) (any, error) {
+	if len(args) != 2 {
+		return nil, errors.Errorf("%s expects 2 arguments, got %d", name, len(args))
+	}
+	fn, ok := args[1].(lambda)
+	if !ok {
+		return nil, errors.Errorf("%s expects a function as its second argument, such as %s", name, "unit => unit.status==\"active\"")
+	}
+	value, err := args[0].eval(scope)
+	if err != nil {
+		return nil, err
+	}
+	items, ok := value.([]Scope)
+	if !ok {
+		return nil, errors.Errorf("%s expects a list, got %s", name, typeName(value))
+	}
+
+	inner := make(Scope, len(scope)+1)
+	for k, v := range scope {
+		inner[k] = v
+	}
+	for _, item := range items {
+		inner[fn.param] = item
+		result, err := evalBool(fn.body, inner)
+		if err != nil {
+			return nil, err
+		}
+		if stop(result) {
+			return !whenEmpty, nil
+		}
+	}
+	return whenEmpty, nil
+}
+
+func typeName(value any) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case int:
+		return "integer"
+	case bool:
+		return "boolean"
+	case []Scope:
+		return "list"
+	case Scope:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package query_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/juju/waitfor/query"
+)
+
+type querySuite struct{}
+
+func TestQuerySuite(t *testing.T) {
+	tc.Run(t, &querySuite{})
+}
+
+func (s *querySuite) scope() query.Scope {
+	return query.Scope{
+		"name":    "mysql",
+		"status":  "active",
+		"exposed": false,
+		"scale":   3,
+		"units": []query.Scope{{
+			"name":            "mysql/0",
+			"workload-status": "active",
+			"leader":          true,
+		}, {
+			"name":            "mysql/1",
+			"workload-status": "active",
+			"leader":          false,
+		}, {
+			"name":            "mysql/2",
+			"workload-status": "maintenance",
+			"leader":          false,
+		}},
+	}
+}
+
+func (s *querySuite) TestRun(c *tc.C) {
+	for i, test := range []struct {
+		query    string
+		expected bool
+	}{
+		{`status=="active"`, true},
+		{`status == 'active'`, true},
+		{`status!="active"`, false},
+		{`!exposed`, true},
+		{`exposed==false`, true},
+		{`scale>=3 && scale<4`, true},
+		{`scale>3 || scale<=2`, false},
+		{`len(units)==3`, true},
+		{`len(name)==5`, true},
+		{`status=="active" && len(units)==3`, true},
+		{`forEach(units, unit => unit.workload-status=="active")`, false},
+		{`any(units, unit => unit.workload-status=="maintenance")`, true},
+		{`any(units, u => u.leader && u.name=="mysql/0")`, true},
+		{`!(status=="blocked" || status=="error")`, true},
+		{`name > "a"`, true},
+		{`status=="a\"b"`, false},
+	} {
+		c.Logf("test %d: %s", i, test.query)
+		q, err := query.Parse(test.query)
+		c.Assert(err, tc.ErrorIsNil)
+		result, err := q.Run(s.scope())
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(result, tc.Equals, test.expected)
+	}
+}
+
+func (s *querySuite) TestShortCircuit(c *tc.C) {
+	q, err := query.Parse(`len(units)==0 || missing=="x"`)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = q.Run(query.Scope{"units": []query.Scope{}})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *querySuite) TestParseErrors(c *tc.C) {
+	for i, test := range []struct {
+		query string
+		err   string
+	}{
+		{``, `parsing query: empty query`},
+		{`status==`, `parsing query: unexpected "end of query" at position 8`},
+		{`status=="active`, `parsing query: unterminated string starting at position 8`},
+		{`status = "active"`, `parsing query: unexpected character '=' at position 7`},
+		{`(status=="active"`, `parsing query: expected "\)", got "end of query" at position 17`},
+		{`status=="active" scale`, `parsing query: unexpected identifier scale at position 17`},
+		{`size(units)`, `parsing query: unknown function "size" at position 0`},
+		{`len(units status)`, `parsing query: expected "," or "\)", got identifier status at position 10`},
+		{`unit.`, `parsing query: expected "identifier", got "end of query" at position 5`},
+	} {
+		c.Logf("test %d: %s", i, test.query)
+		_, err := query.Parse(test.query)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+}
+
+func (s *querySuite) TestRunErrors(c *tc.C) {
+	for i, test := range []struct {
+		query string
+		err   string
+	}{
+		{`status`, `running query "status": expected a boolean result, got string`},
+		{`scale=="3"`, `running query .*: cannot compare integer with string`},
+		{`exposed<true`, `running query .*: cannot use "<" with booleans`},
+		{`len(scale)==1`, `running query .*: len expects a string or list, got integer`},
+		{`forEach(units, true)`, `running query .*: forEach expects a function as its second argument, .*`},
+		{`forEach(units, u => u.name)`, `running query .*: expected a boolean, got string`},
+		{`name.first=="m"`, `running query .*: cannot access "first" of string`},
+		{`status && exposed`, `running query .*: expected a boolean, got string`},
+	} {
+		c.Logf("test %d: %s", i, test.query)
+		q, err := query.Parse(test.query)
+		c.Assert(err, tc.ErrorIsNil)
+		_, err = q.Run(s.scope())
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+}
+
+func (s *querySuite) TestUnknownIdentifier(c *tc.C) {
+	q, err := query.Parse(`life=="dead"`)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = q.Run(query.Scope{"name": "mysql", "status": "active"})
+	c.Assert(err, tc.ErrorIs, query.ErrUnknownIdentifier)
+	c.Check(err, tc.ErrorMatches, `running query .*: unknown identifier "life", expected one of name, status`)
+}
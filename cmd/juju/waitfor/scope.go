@@ -0,0 +1,216 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/juju/juju/cmd/juju/waitfor/query"
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/internal/naturalsort"
+	"github.com/juju/juju/rpc/params"
+)
+
+// entityKind describes how to wait for one kind of entity.
+type entityKind struct {
+	// defaultQuery is the goal used when no query is specified.
+	defaultQuery string
+
+	// validName reports whether the entity name is valid.
+	validName func(string) bool
+
+	// scope returns the query scope of the named entity, and a description
+	// of the error state the entity is in, if any. ok is false if the
+	// entity does not exist.
+	scope func(fs *params.FullStatus, name string) (scope query.Scope, errorState string, ok bool)
+}
+
+func modelScope(fs *params.FullStatus, _ string) (query.Scope, string, bool) {
+	modelLife := fs.Model.ModelStatus.Life
+	if modelLife == "" {
+		modelLife = life.Alive
+	}
+
+	var applications []query.Scope
+	for _, appName := range naturalsort.Sort(slices.Collect(maps.Keys(fs.Applications))) {
+		scope, _, _ := applicationScope(fs, appName)
+		applications = append(applications, scope)
+	}
+	var machines []query.Scope
+	for _, machineName := range naturalsort.Sort(slices.Collect(maps.Keys(allMachines(fs.Machines)))) {
+		scope, _, _ := machineScope(fs, machineName)
+		machines = append(machines, scope)
+	}
+
+	scope := query.Scope{
+		"name":         fs.Model.Name,
+		"type":         fs.Model.Type,
+		"life":         string(modelLife),
+		"status":       fs.Model.ModelStatus.Status,
+		"message":      fs.Model.ModelStatus.Info,
+		"version":      fs.Model.Version,
+		"applications": applications,
+		"machines":     machines,
+	}
+	var errorState string
+	if fs.Model.ModelStatus.Status == status.Error.String() {
+		errorState = fmt.Sprintf("model is in error: %s", fs.Model.ModelStatus.Info)
+	}
+	return scope, errorState, true
+}
+
+func applicationScope(fs *params.FullStatus, name string) (query.Scope, string, bool) {
+	app, ok := fs.Applications[name]
+	if !ok {
+		return missingScope(name), "", false
+	}
+
+	var units []query.Scope
+	allUnits := applicationUnits(fs, name)
+	for _, unitName := range naturalsort.Sort(slices.Collect(maps.Keys(allUnits))) {
+		units = append(units, newUnitScope(unitName, allUnits[unitName]))
+	}
+
+	scope := query.Scope{
+		"name":             name,
+		"exists":           true,
+		"life":             string(app.Life),
+		"status":           app.Status.Status,
+		"message":          app.Status.Info,
+		"charm":            app.Charm,
+		"charm-rev":        app.CharmRev,
+		"charm-channel":    app.CharmChannel,
+		"exposed":          app.Exposed,
+		"workload-version": app.WorkloadVersion,
+		"units":            units,
+	}
+	var errorState string
+	if app.Status.Status == status.Error.String() {
+		errorState = fmt.Sprintf("application is in error: %s", app.Status.Info)
+	}
+	return scope, errorState, true
+}
+
+func unitScope(fs *params.FullStatus, name string) (query.Scope, string, bool) {
+	appName, _, _ := strings.Cut(name, "/")
+	unit, ok := applicationUnits(fs, appName)[name]
+	if !ok {
+		return missingScope(name), "", false
+	}
+
+	var errorState string
+	switch status.Error.String() {
+	case unit.WorkloadStatus.Status:
+		errorState = fmt.Sprintf("unit workload is in error: %s", unit.WorkloadStatus.Info)
+	case unit.AgentStatus.Status:
+		errorState = fmt.Sprintf("unit agent is in error: %s", unit.AgentStatus.Info)
+	}
+	return newUnitScope(name, unit), errorState, true
+}
+
+func newUnitScope(name string, unit params.UnitStatus) query.Scope {
+	appName, _, _ := strings.Cut(name, "/")
+	return query.Scope{
+		"name":             name,
+		"exists":           true,
+		"application":      appName,
+		"life":             string(unitLife(unit)),
+		"status":           unit.WorkloadStatus.Status,
+		"message":          unit.WorkloadStatus.Info,
+		"workload-status":  unit.WorkloadStatus.Status,
+		"workload-message": unit.WorkloadStatus.Info,
+		"agent-status":     unit.AgentStatus.Status,
+		"agent-message":    unit.AgentStatus.Info,
+		"workload-version": unit.WorkloadVersion,
+		"machine":          unit.Machine,
+		"leader":           unit.Leader,
+		"address":          unit.PublicAddress,
+	}
+}
+
+func unitLife(unit params.UnitStatus) life.Value {
+	if unit.AgentStatus.Life != "" {
+		return unit.AgentStatus.Life
+	}
+	return life.Alive
+}
+
+func machineScope(fs *params.FullStatus, name string) (query.Scope, string, bool) {
+	machine, ok := allMachines(fs.Machines)[name]
+	if !ok {
+		return missingScope(name), "", false
+	}
+
+	machineLife := machine.AgentStatus.Life
+	if machineLife == "" {
+		machineLife = life.Alive
+	}
+	scope := query.Scope{
+		"name":             name,
+		"exists":           true,
+		"life":             string(machineLife),
+		"status":           machine.AgentStatus.Status,
+		"message":          machine.AgentStatus.Info,
+		"instance-status":  machine.InstanceStatus.Status,
+		"instance-message": machine.InstanceStatus.Info,
+		"instance-id":      string(machine.InstanceId),
+		"hostname":         machine.Hostname,
+		"dns-name":         machine.DNSName,
+	}
+	var errorState string
+	switch {
+	case machine.AgentStatus.Status == status.Error.String():
+		errorState = fmt.Sprintf("machine is in error: %s", machine.AgentStatus.Info)
+	case machine.InstanceStatus.Status == status.ProvisioningError.String():
+		errorState = fmt.Sprintf("machine failed to provision: %s", machine.InstanceStatus.Info)
+	}
+	return scope, errorState, true
+}
+
+// missingScope returns the scope of an entity which does not exist. Entities
+// are removed once they are dead, so a missing entity is reported as dead to
+// allow waiting for the removal of an entity.
+func missingScope(name string) query.Scope {
+	return query.Scope{
+		"name":   name,
+		"exists": false,
+		"life":   string(life.Dead),
+	}
+}
+
+// applicationUnits returns the units of the application, including the
+// subordinate units which are reported with their principals.
+func applicationUnits(fs *params.FullStatus, appName string) map[string]params.UnitStatus {
+	units := make(map[string]params.UnitStatus)
+	for name, unit := range fs.Applications[appName].Units {
+		units[name] = unit
+	}
+	for _, app := range fs.Applications {
+		for _, principal := range app.Units {
+			for name, unit := range principal.Subordinates {
+				if strings.HasPrefix(name, appName+"/") {
+					units[name] = unit
+				}
+			}
+		}
+	}
+	return units
+}
+
+// allMachines flattens the machines and their containers into a single map
+// indexed by machine name.
+func allMachines(machines map[string]params.MachineStatus) map[string]params.MachineStatus {
+	all := make(map[string]params.MachineStatus)
+	for name, machine := range machines {
+		all[name] = machine
+		for name, container := range allMachines(machine.Containers) {
+			all[name] = container
+		}
+	}
+	return all
+}
@@ -0,0 +1,304 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+	"github.com/juju/utils/v4"
+
+	"github.com/juju/juju/api/client/client"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/juju/waitfor/query"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/watcher"
+	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/rpc/params"
+)
+
+var logger = internallogger.GetLogger("juju.cmd.juju.waitfor")
+
+const (
+	// timeoutExitCode is the exit code used when the goal isn't reached
+	// before the timeout.
+	timeoutExitCode = 3
+
+	// errorStateExitCode is the exit code used when the entity enters an
+	// error state before the goal is reached.
+	errorStateExitCode = 4
+)
+
+var usageSummary = `
+Waits for a model, application, machine or unit to reach a goal state.`[1:]
+
+var usageDetails = `
+Blocks until the goal expression given with --query is true for the entity,
+the timeout passes, or the entity enters an error state. The status of the
+model is only read when the controller reports that an application, unit or
+machine has changed, rather than polling.
+
+The first argument is the kind of entity to wait for: model, application,
+machine or unit. The second is the name of the entity. For the model, the name
+is optional and defaults to the current model.
+
+The goal is an expression over the status of the entity, for example:
+
+    status=="active" && len(units)==3
+
+Expressions can compare strings, integers and booleans with ==, !=, <, <=, >
+and >=, combine them with &&, || and !, and group them with parentheses.
+len(x) returns the length of a string or list. forEach(list, x => expr) is
+true if expr is true for every item of the list, and any(list, x => expr) is
+true if it is true for at least one.
+
+The identifiers available for each kind of entity are:
+
+    model:        name, type, life, status, message, version,
+                  applications, machines
+    application:  name, exists, life, status, message, charm, charm-rev,
+                  charm-channel, exposed, workload-version, units
+    unit:         name, exists, application, life, status, message,
+                  workload-status, workload-message, agent-status,
+                  agent-message, workload-version, machine, leader, address
+    machine:      name, exists, life, status, message, instance-status,
+                  instance-message, instance-id, hostname, dns-name
+
+The applications, machines and units lists hold items with the identifiers of
+the corresponding entity. An application, machine or unit which does not
+exist has exists set to false and life set to "dead", so that the removal of
+an entity can be waited for with life=="dead".
+
+The command exits with code 0 when the goal is reached, ` + fmt.Sprint(timeoutExitCode) + ` when the timeout
+passes and ` + fmt.Sprint(errorStateExitCode) + ` when the entity enters an error state. An application, or
+the model, is in an error state when its status is error. A unit is in an
+error state when its workload or agent status is error, and a machine is when
+its status is error or it failed to provision. Other failures exit with code 1.
+`
+
+const usageExamples = `
+    juju wait-for application mysql
+    juju wait-for application mysql --query='status=="active" && len(units)==3'
+    juju wait-for unit mysql/0 --query='workload-status=="active" && leader'
+    juju wait-for machine 0 --query='status=="started"' --timeout=5m
+    juju wait-for application mysql --query='life=="dead"'
+    juju wait-for model --query='forEach(applications, app => app.status=="active")'
+`
+
+var entityKinds = map[string]entityKind{
+	"model": {
+		defaultQuery: `life=="alive" && status=="available"`,
+		validName:    func(string) bool { return true },
+		scope:        modelScope,
+	},
+	"application": {
+		defaultQuery: `life=="alive" && status=="active"`,
+		validName:    names.IsValidApplication,
+		scope:        applicationScope,
+	},
+	"unit": {
+		defaultQuery: `life=="alive" && workload-status=="active"`,
+		validName:    names.IsValidUnit,
+		scope:        unitScope,
+	},
+	"machine": {
+		defaultQuery: `life=="alive" && status=="started"`,
+		validName:    names.IsValidMachine,
+		scope:        machineScope,
+	},
+}
+
+// WaitForAPI defines the client API methods used by the wait-for command.
+type WaitForAPI interface {
+	Close() error
+	WatchStatus(ctx context.Context) (watcher.NotifyWatcher, error)
+	Status(ctx context.Context, args *client.StatusArgs) (*params.FullStatus, error)
+}
+
+// NewWaitForCommand returns a command which waits for an entity to reach a
+// goal state.
+func NewWaitForCommand() cmd.Command {
+	return modelcmd.Wrap(&waitForCommand{
+		clock: clock.WallClock,
+	})
+}
+
+type waitForCommand struct {
+	modelcmd.ModelCommandBase
+
+	api   WaitForAPI
+	clock clock.Clock
+
+	kindName string
+	kind     entityKind
+	name     string
+	query    string
+	timeout  time.Duration
+
+	goal *query.Query
+}
+
+// Info implements Command.Info.
+func (c *waitForCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "wait-for",
+		Args:     "<model|application|machine|unit> [<name>]",
+		Purpose:  usageSummary,
+		Doc:      usageDetails,
+		Examples: usageExamples,
+		SeeAlso: []string{
+			"status",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *waitForCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.StringVar(&c.query, "query", "", "The goal expression, defaults to the active state of the entity")
+	f.DurationVar(&c.timeout, "timeout", 10*time.Minute, "How long to wait for the goal, 0 waits forever")
+}
+
+// Init implements Command.Init.
+func (c *waitForCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no entity kind specified, expected one of model, application, machine or unit")
+	}
+	var ok bool
+	c.kindName = args[0]
+	c.kind, ok = entityKinds[c.kindName]
+	if !ok {
+		return errors.Errorf("unknown entity kind %q, expected one of model, application, machine or unit", c.kindName)
+	}
+	args = args[1:]
+
+	if len(args) > 0 {
+		c.name, args = args[0], args[1:]
+		if !c.kind.validName(c.name) {
+			return errors.Errorf("invalid %s name %q", c.kindName, c.name)
+		}
+		if c.kindName == "model" {
+			if err := c.SetModelIdentifier(c.name, false); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	} else if c.kindName != "model" {
+		return errors.Errorf("no %s name specified", c.kindName)
+	}
+	if err := cmd.CheckEmpty(args); err != nil {
+		return err
+	}
+
+	if c.timeout < 0 {
+		return errors.New("--timeout must not be negative")
+	}
+	if c.query == "" {
+		c.query = c.kind.defaultQuery
+	}
+	var err error
+	c.goal, err = query.Parse(c.query)
+	return err
+}
+
+func (c *waitForCommand) getAPI(ctx context.Context) (WaitForAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return client.NewClient(root, logger), nil
+}
+
+// Run implements Command.Run.
+func (c *waitForCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	w, err := api.WatchStatus(ctx)
+	if errors.Is(err, errors.NotImplemented) {
+		return errors.New("wait-for is not supported by this controller, upgrade the controller to use it")
+	} else if err != nil {
+		return errors.Annotate(err, "watching status")
+	}
+	defer func() {
+		w.Kill()
+		_ = w.Wait()
+	}()
+
+	var timeout <-chan time.Time
+	if c.timeout > 0 {
+		timeout = c.clock.After(c.timeout)
+	}
+
+	description := c.kindName
+	if c.name != "" {
+		description = fmt.Sprintf("%s %q", c.kindName, c.name)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+
+		case <-timeout:
+			cmd.WriteError(ctx.Stderr, errors.Errorf("timed out after %v waiting for %s to reach goal %q", c.timeout, description, c.goal))
+			return utils.NewRcPassthroughError(timeoutExitCode)
+
+		case _, ok := <-w.Changes():
+			if !ok {
+				return errors.Annotate(w.Wait(), "watching status")
+			}
+
+			done, errorState, err := c.check(ctx, api)
+			if err != nil {
+				return err
+			}
+			if done {
+				ctx.Infof("%s reached goal %q", strings.ToUpper(description[:1])+description[1:], c.goal)
+				return nil
+			}
+			if errorState != "" {
+				cmd.WriteError(ctx.Stderr, errors.Errorf("%s entered an error state: %s", description, errorState))
+				return utils.NewRcPassthroughError(errorStateExitCode)
+			}
+		}
+	}
+}
+
+// check reads the status of the entity and evaluates the goal against it,
+// returning whether the goal is reached and the error state of the entity,
+// if any.
+func (c *waitForCommand) check(ctx context.Context, api WaitForAPI) (bool, string, error) {
+	var patterns []string
+	if c.kindName != "model" {
+		patterns = []string{c.name}
+	}
+	fs, err := api.Status(ctx, &client.StatusArgs{Patterns: patterns})
+	if err != nil {
+		return false, "", errors.Annotate(err, "reading status")
+	}
+
+	scope, errorState, exists := c.kind.scope(fs, c.name)
+	done, err := c.goal.Run(scope)
+	if errors.Is(err, query.ErrUnknownIdentifier) && !exists {
+		// The goal refers to details of the entity which can only be
+		// known once it exists.
+		logger.Debugf(ctx, "%s %q does not exist yet", c.kindName, c.name)
+		return false, "", nil
+	} else if err != nil {
+		return false, "", err
+	}
+	return done, errorState, nil
+}
@@ -0,0 +1,237 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor_test
+
+import (
+	"context"
+	stdtesting "testing"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"github.com/juju/utils/v4"
+
+	"github.com/juju/juju/api/client/client"
+	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/waitfor"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/watchertest"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type waitForSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+}
+
+func TestWaitForSuite(t *stdtesting.T) {
+	tc.Run(t, &waitForSuite{})
+}
+
+func (s *waitForSuite) run(c *tc.C, api *fakeWaitForAPI, args ...string) (string, error) {
+	cmd := waitfor.NewWaitForCommandForTest(jujuclienttesting.MinimalStore(), api, clock.WallClock)
+	ctx, err := cmdtesting.RunCommand(c, cmd, args...)
+	return cmdtesting.Stderr(ctx), err
+}
+
+func (s *waitForSuite) TestInit(c *tc.C) {
+	for i, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{},
+		err:  `no entity kind specified, expected one of model, application, machine or unit`,
+	}, {
+		args: []string{"relation", "foo"},
+		err:  `unknown entity kind "relation", expected one of model, application, machine or unit`,
+	}, {
+		args: []string{"application"},
+		err:  `no application name specified`,
+	}, {
+		args: []string{"unit", "mysql"},
+		err:  `invalid unit name "mysql"`,
+	}, {
+		args: []string{"machine", "0", "1"},
+		err:  `unrecognized args: \["1"\]`,
+	}, {
+		args: []string{"application", "mysql", "--query", `status=`},
+		err:  `parsing query: unexpected character '=' at position 6`,
+	}, {
+		args: []string{"application", "mysql", "--timeout", "-1s"},
+		err:  `--timeout must not be negative`,
+	}, {
+		args: []string{"application", "mysql", "--query", `len(units)>1`, "--timeout", "1m"},
+	}, {
+		args: []string{"model"},
+	}} {
+		c.Logf("test %d: %v", i, test.args)
+		cmd := waitfor.NewWaitForCommandForTest(jujuclienttesting.MinimalStore(), &fakeWaitForAPI{}, clock.WallClock)
+		err := cmdtesting.InitCommand(cmd, test.args)
+		if test.err == "" {
+			c.Check(err, tc.ErrorIsNil)
+		} else {
+			c.Check(err, tc.ErrorMatches, test.err)
+		}
+	}
+}
+
+func (s *waitForSuite) TestApplicationGoalReached(c *tc.C) {
+	api := newFakeWaitForAPI(
+		applicationStatus("active", "active", "active"),
+		applicationStatus("active", "active", "active", "active"),
+	)
+
+	_, err := s.run(c, api, "application", "mysql", "--query", `status=="active" && len(units)==3`)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(api.statusCalls, tc.Equals, 2)
+	c.Check(api.patterns, tc.DeepEquals, []string{"mysql"})
+}
+
+func (s *waitForSuite) TestUnitDefaultGoal(c *tc.C) {
+	api := newFakeWaitForAPI(
+		applicationStatus("waiting", "maintenance", "active"),
+		applicationStatus("active", "active", "active"),
+	)
+
+	_, err := s.run(c, api, "unit", "mysql/0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(api.statusCalls, tc.Equals, 2)
+}
+
+func (s *waitForSuite) TestModelForEachUnit(c *tc.C) {
+	api := newFakeWaitForAPI(applicationStatus("active", "active", "active"))
+
+	_, err := s.run(c, api, "model", "--query", `forEach(applications, app => forEach(app.units, u => u.machine=="0"))`)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(api.patterns, tc.IsNil)
+}
+
+func (s *waitForSuite) TestTimeout(c *tc.C) {
+	api := newFakeWaitForAPI(applicationStatus("waiting", "waiting"))
+
+	stderr, err := s.run(c, api, "application", "mysql", "--timeout", "10ms")
+	c.Assert(err, tc.DeepEquals, utils.NewRcPassthroughError(waitfor.TimeoutExitCode))
+	c.Check(stderr, tc.Matches, `ERROR timed out after 10ms waiting for application "mysql" to reach goal "life==\\"alive\\" && status==\\"active\\""\n`)
+}
+
+func (s *waitForSuite) TestErrorState(c *tc.C) {
+	fs := applicationStatus("waiting", "waiting")
+	unit := fs.Applications["mysql"].Units["mysql/0"]
+	unit.WorkloadStatus = params.DetailedStatus{Status: "error", Info: "hook failed: install"}
+	fs.Applications["mysql"].Units["mysql/0"] = unit
+	api := newFakeWaitForAPI(fs)
+
+	stderr, err := s.run(c, api, "unit", "mysql/0")
+	c.Assert(err, tc.DeepEquals, utils.NewRcPassthroughError(waitfor.ErrorStateExitCode))
+	c.Check(stderr, tc.Equals, `ERROR unit "mysql/0" entered an error state: unit workload is in error: hook failed: install`+"\n")
+}
+
+func (s *waitForSuite) TestGoalCanMatchErrorState(c *tc.C) {
+	fs := applicationStatus("error")
+	api := newFakeWaitForAPI(fs)
+
+	_, err := s.run(c, api, "application", "mysql", "--query", `status=="error"`)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *waitForSuite) TestMissingEntity(c *tc.C) {
+	api := newFakeWaitForAPI(
+		&params.FullStatus{},
+		applicationStatus("active", "active"),
+	)
+
+	// The goal can't be evaluated until the application exists.
+	_, err := s.run(c, api, "application", "mysql", "--query", `len(units)==1`)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(api.statusCalls, tc.Equals, 2)
+}
+
+func (s *waitForSuite) TestRemoval(c *tc.C) {
+	api := newFakeWaitForAPI(
+		applicationStatus("active", "active"),
+		&params.FullStatus{},
+	)
+
+	_, err := s.run(c, api, "application", "mysql", "--query", `life=="dead"`)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(api.statusCalls, tc.Equals, 2)
+}
+
+func (s *waitForSuite) TestUnknownIdentifier(c *tc.C) {
+	api := newFakeWaitForAPI(applicationStatus("active", "active"))
+
+	_, err := s.run(c, api, "application", "mysql", "--query", `scale==1`)
+	c.Assert(err, tc.ErrorMatches, `running query "scale==1": unknown identifier "scale", expected one of .*`)
+}
+
+func (s *waitForSuite) TestNotSupported(c *tc.C) {
+	api := newFakeWaitForAPI()
+	api.watchErr = errors.NotImplementedf("watching status on this version of Juju")
+
+	_, err := s.run(c, api, "application", "mysql")
+	c.Assert(err, tc.ErrorMatches, `wait-for is not supported by this controller, upgrade the controller to use it`)
+}
+
+func applicationStatus(appStatus string, unitStatuses ...string) *params.FullStatus {
+	units := make(map[string]params.UnitStatus)
+	for i, unitStatus := range unitStatuses {
+		units["mysql/"+string(rune('0'+i))] = params.UnitStatus{
+			WorkloadStatus: params.DetailedStatus{Status: unitStatus},
+			AgentStatus:    params.DetailedStatus{Status: "idle", Life: "alive"},
+			Machine:        "0",
+		}
+	}
+	return &params.FullStatus{
+		Model: params.ModelStatusInfo{
+			Name:        "test",
+			ModelStatus: params.DetailedStatus{Status: "available"},
+		},
+		Machines: map[string]params.MachineStatus{
+			"0": {Id: "0", AgentStatus: params.DetailedStatus{Status: "started"}},
+		},
+		Applications: map[string]params.ApplicationStatus{
+			"mysql": {
+				Life:   "alive",
+				Status: params.DetailedStatus{Status: appStatus},
+				Units:  units,
+			},
+		},
+	}
+}
+
+// fakeWaitForAPI returns the statuses in order, one per change of the
+// status watcher.
+type fakeWaitForAPI struct {
+	statuses    []*params.FullStatus
+	statusCalls int
+	patterns    []string
+	watchErr    error
+}
+
+func newFakeWaitForAPI(statuses ...*params.FullStatus) *fakeWaitForAPI {
+	return &fakeWaitForAPI{statuses: statuses}
+}
+
+func (f *fakeWaitForAPI) Close() error {
+	return nil
+}
+
+func (f *fakeWaitForAPI) WatchStatus(context.Context) (watcher.NotifyWatcher, error) {
+	if f.watchErr != nil {
+		return nil, f.watchErr
+	}
+	ch := make(chan struct{}, len(f.statuses))
+	for range f.statuses {
+		ch <- struct{}{}
+	}
+	return watchertest.NewMockNotifyWatcher(ch), nil
+}
+
+func (f *fakeWaitForAPI) Status(_ context.Context, args *client.StatusArgs) (*params.FullStatus, error) {
+	f.patterns = args.Patterns
+	status := f.statuses[min(f.statusCalls, len(f.statuses)-1)]
+	f.statusCalls++
+	return status, nil
+}
@@ -44,6 +44,7 @@ import (
 	internallogger "github.com/juju/juju/internal/logger"
 	pkissh "github.com/juju/juju/internal/pki/ssh"
 	k8sconstants "github.com/juju/juju/internal/provider/kubernetes/constants"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/tools"
 )
 
@@ -306,6 +307,13 @@ func (c *BootstrapCommand) Run(ctx *cmd.Context) error {
 		return errors.Trace(err)
 	}
 
+	// Create the key-encryption key which wraps the data keys encrypting
+	// secret content. It is held on the controller machine, outside the
+	// controller database.
+	if err := ensureSecretKeyEncryptionKey(agentConfig.DataDir()); err != nil {
+		return errors.Annotate(err, "creating secret key-encryption key")
+	}
+
 	controllerModelCfg, err := env.Config().Apply(controllerModelConfigAttrs)
 	if err != nil {
 		return errors.Annotate(err, "failed to update model config")
@@ -348,6 +356,24 @@ func bootstrapControllerAddresses(
 
 // ensureSSHServerHostKey ensures that either a) a user has provided a host key
 // or b) one has been generated for the controller.
+// ensureSecretKeyEncryptionKey creates the controller's first secret
+// key-encryption key in the agent data directory, unless one exists.
+func ensureSecretKeyEncryptionKey(dataDir string) error {
+	keyStore := envelope.NewFileKeyStore(envelope.KeyStorePath(dataDir))
+	keys, err := keyStore.KeyEncryptionKeys()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(keys) > 0 {
+		return nil
+	}
+	kek, err := envelope.NewKeyEncryptionKey()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(keyStore.AddKeyEncryptionKeys(kek))
+}
+
 func ensureSSHServerHostKey(args *instancecfg.StateInitializationParams) error {
 	if args.SSHServerHostKey != "" {
 		return nil
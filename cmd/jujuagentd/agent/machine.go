@@ -77,6 +77,7 @@ import (
 	"github.com/juju/juju/internal/worker/introspection"
 	"github.com/juju/juju/internal/worker/logrouter"
 	"github.com/juju/juju/internal/worker/logsender"
+	"github.com/juju/juju/internal/worker/logsink"
 	"github.com/juju/juju/internal/worker/migrationmaster"
 	"github.com/juju/juju/internal/worker/modelworkermanager"
 	"github.com/juju/juju/internal/wrench"
@@ -165,6 +166,12 @@ func (p machineControllerStartupValueProvider) CurrentLokiConfig() (logrouter.Co
 	return logrouter.ConfigSnapshotFromAgentConfig(p.agent.CurrentConfig()), nil
 }
 
+// ModelLogFileConfig returns the current per-model log file settings from
+// agent config.
+func (p machineControllerStartupValueProvider) ModelLogFileConfig(modelUUID coremodel.UUID) (logsink.ModelLogFileConfig, error) {
+	return logsink.ModelLogFileConfigFromAgentConfig(p.agent.CurrentConfig(), modelUUID)
+}
+
 // machineModelStartupValueProvider supplies current model-local startup
 // values. It re-reads current agent config on each call so bounced workers
 // do not keep stale values. This is a temporary adapter that will be
@@ -886,6 +893,9 @@ func (a *MachineAgent) startModelWorkers(cfg modelworkermanager.NewModelConfig)
 		HTTPClientGetter:              cfg.HTTPClientGetter,
 		APIRemoteRelationClientGetter: cfg.APIRemoteRelationClientGetter,
 
+		ExternalSecretsRefreshInterval: 5 * time.Minute,
+		SecretAccessLogPruneInterval:   time.Hour,
+
 		ModelUUID:            cfg.ModelUUID,
 		AgentTag:             currentConfig.Tag(),
 		ModelTag:             names.NewModelTag(cfg.ModelUUID),
@@ -1092,5 +1102,19 @@ func logSinkConfigFromAgentConfig(cfg agent.Config) (coreapiserver.LogSinkConfig
 		}
 		result.RateLimitRefill = refill
 	}
+	if v := cfg.Value(agent.LogSinkEntityRateLimitBurst); v != "" {
+		burst, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return result, errors.Annotatef(err, "parsing %s", agent.LogSinkEntityRateLimitBurst)
+		}
+		result.EntityRateLimitBurst = burst
+	}
+	if v := cfg.Value(agent.LogSinkEntityRateLimitRefill); v != "" {
+		refill, err := time.ParseDuration(v)
+		if err != nil {
+			return result, errors.Annotatef(err, "parsing %s", agent.LogSinkEntityRateLimitRefill)
+		}
+		result.EntityRateLimitRefill = refill
+	}
 	return result, nil
 }
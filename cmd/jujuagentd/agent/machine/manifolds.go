@@ -719,6 +719,7 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			LogRouterName:  controllerLogRouterName,
 			NewWorker:      logsink.NewWorker,
 			NewModelLogger: logsink.NewModelLogger,
+			ModelLogFiles:  config.StartupValueProvider,
 		})),
 
 		// nonControllerLogSinkName is the non-controller log sink that
@@ -801,6 +802,7 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			Logger:                      internallogger.GetLogger("juju.worker.services"),
 			Clock:                       config.Clock,
 			LogDir:                      config.LogDir,
+			DataDir:                     config.DataDir,
 			NewWorker:                   workerdomainservices.NewWorker,
 			NewDomainServicesGetter:     workerdomainservices.NewDomainServicesGetter,
 			NewControllerDomainServices: workerdomainservices.NewControllerDomainServices,
@@ -1637,6 +1639,7 @@ type ControllerStartupValueProvider interface {
 	apiserver.LocalConfigReader
 	apiremotecaller.APIInfoProvider
 	logrouter.LokiConfigProvider
+	logsink.ModelLogFileConfigProvider
 }
 
 const (
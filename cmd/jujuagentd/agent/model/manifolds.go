@@ -37,6 +37,7 @@ import (
 	provisioner "github.com/juju/juju/internal/worker/computeprovisioner"
 	"github.com/juju/juju/internal/worker/controllerlogger"
 	"github.com/juju/juju/internal/worker/credentialvalidator"
+	"github.com/juju/juju/internal/worker/externalsecrets"
 	"github.com/juju/juju/internal/worker/firewaller"
 	"github.com/juju/juju/internal/worker/fortress"
 	"github.com/juju/juju/internal/worker/instancepoller"
@@ -51,6 +52,7 @@ import (
 	"github.com/juju/juju/internal/worker/remoterelationconsumer/offererrelations"
 	"github.com/juju/juju/internal/worker/remoterelationconsumer/offererunitrelations"
 	"github.com/juju/juju/internal/worker/removal"
+	"github.com/juju/juju/internal/worker/secretgenerator"
 	"github.com/juju/juju/internal/worker/secretsdrainworker"
 	"github.com/juju/juju/internal/worker/secretspruner"
 	"github.com/juju/juju/internal/worker/singular"
@@ -97,6 +99,14 @@ type ManifoldsConfig struct {
 	// OperationPrunerInterval determines how often the operations are pruned
 	OperationPrunerInterval time.Duration
 
+	// ExternalSecretsRefreshInterval determines how often the content of
+	// externally managed secrets is checked for upstream changes.
+	ExternalSecretsRefreshInterval time.Duration
+
+	// SecretAccessLogPruneInterval determines how often old entries are
+	// pruned from the secret access log.
+	SecretAccessLogPruneInterval time.Duration
+
 	// ProviderServicesGetter is used to access the provider service.
 	ProviderServicesGetter modelworkermanager.ProviderServicesGetter
 
@@ -380,10 +390,28 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 		})),
 
 		secretsPrunerName: ifNotMigrating(secretspruner.Manifold(secretspruner.ManifoldConfig{
-			DomainServicesName: domainServicesName,
-			Logger:             config.LoggingContext.GetLogger("juju.worker.secretspruner"),
-			NewWorker:          secretspruner.NewWorker,
+			DomainServicesName:     domainServicesName,
+			Logger:                 config.LoggingContext.GetLogger("juju.worker.secretspruner"),
+			Clock:                  config.Clock,
+			AccessLogPruneInterval: config.SecretAccessLogPruneInterval,
+			NewWorker:              secretspruner.NewWorker,
 		})),
+		// The externalSecretsName worker adds new revisions to externally
+		// managed secrets when their content changes upstream.
+		externalSecretsName: ifResponsible(ifNotMigrating(externalsecrets.Manifold(externalsecrets.ManifoldConfig{
+			DomainServicesName: domainServicesName,
+			Clock:              config.Clock,
+			Logger:             config.LoggingContext.GetLogger("juju.worker.externalsecrets"),
+			RefreshInterval:    config.ExternalSecretsRefreshInterval,
+		}))),
+		// The secretGeneratorName worker generates new content for user
+		// secrets generated by the controller when they are due to rotate.
+		secretGeneratorName: ifResponsible(ifNotMigrating(secretgenerator.Manifold(secretgenerator.ManifoldConfig{
+			DomainServicesName: domainServicesName,
+			ModelUUID:          config.ModelUUID,
+			Clock:              config.Clock,
+			Logger:             config.LoggingContext.GetLogger("juju.worker.secretgenerator"),
+		}))),
 		// The userSecretsDrainWorker is the worker that drains the user secrets
 		// from the inactive backend to the current active backend.
 		userSecretsDrainWorker: ifNotMigrating(secretsdrainworker.ModelManifold(secretsdrainworker.ModelManifoldConfig{
@@ -645,6 +673,8 @@ const (
 	caasmodelconfigmanagerName     = "caas-model-config-manager"
 	caasApplicationProvisionerName = "caas-application-provisioner"
 
+	externalSecretsName    = "external-secrets"
+	secretGeneratorName    = "secret-generator"
 	secretsPrunerName      = "secrets-pruner"
 	userSecretsDrainWorker = "user-secrets-drain-worker"
 
@@ -44,6 +44,7 @@ func (s *ManifoldsSuite) TestIAASNames(c *tc.C) {
 		"clock",
 		"compute-provisioner",
 		"domain-services",
+		"external-secrets",
 		"firewaller",
 		"http-client",
 		"instance-poller",
@@ -60,6 +61,7 @@ func (s *ManifoldsSuite) TestIAASNames(c *tc.C) {
 		"provider-tracker",
 		"remote-relation-consumer",
 		"removal",
+		"secret-generator",
 		"secrets-pruner",
 		"storage-provisioner",
 		"unitless",
@@ -87,6 +89,7 @@ func (s *ManifoldsSuite) TestCAASNames(c *tc.C) {
 		"charm-revisioner",
 		"clock",
 		"domain-services",
+		"external-secrets",
 		"http-client",
 		"is-responsible-flag",
 		"lease-manager",
@@ -101,6 +104,7 @@ func (s *ManifoldsSuite) TestCAASNames(c *tc.C) {
 		"provider-tracker",
 		"remote-relation-consumer",
 		"removal",
+		"secret-generator",
 		"secrets-pruner",
 		"storage-provisioner",
 		"unitless",
@@ -181,6 +185,24 @@ var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 		"not-dead-flag",
 	},
 
+	"external-secrets": {
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
+	"secret-generator": {
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"secrets-pruner": {
 		"domain-services",
 		"is-responsible-flag",
@@ -384,6 +406,24 @@ var expectedIAASModelManifoldsWithDependencies = map[string][]string{
 		"not-dead-flag",
 	},
 
+	"external-secrets": {
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
+	"secret-generator": {
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"secrets-pruner": {
 		"domain-services",
 		"is-responsible-flag",
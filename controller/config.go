@@ -6,6 +6,7 @@ package controller
 import (
 	"fmt"
 	"net/url"
+	"path/filepath"
 	"regexp"
 	"slices"
 	"strings"
@@ -262,6 +263,47 @@ const (
 	// HTTPServerWriteTimeout is the maximum duration before timing out writes of the HTTP response.
 	// A zero value means no timeout.
 	HTTPServerWriteTimeout = "http-server-write-timeout"
+
+	// SecretEncryptionKMS is the key management service holding the
+	// key-encryption key used to wrap the data keys that encrypt secret
+	// content stored in the controller database. It is either "internal",
+	// where the controller holds the key in a file on each controller machine,
+	// or "vault-transit". An internal key can't be rotated, or created, while
+	// the controller has more than one machine.
+	SecretEncryptionKMS = "secret-encryption-kms"
+
+	// SecretEncryptionVaultAddress is the URL of the Vault server used
+	// when secret-encryption-kms is "vault-transit".
+	SecretEncryptionVaultAddress = "secret-encryption-vault-address"
+
+	// SecretEncryptionVaultTokenFile is the path, on each controller
+	// machine, of a file holding the Vault token used to access the transit
+	// secrets engine. The token itself is not held in the controller
+	// configuration.
+	SecretEncryptionVaultTokenFile = "secret-encryption-vault-token-file"
+
+	// SecretEncryptionVaultCACert is the PEM encoded CA certificate of the
+	// Vault server.
+	SecretEncryptionVaultCACert = "secret-encryption-vault-ca-cert"
+
+	// SecretEncryptionVaultTransitMount is the path the Vault transit
+	// secrets engine is mounted on.
+	SecretEncryptionVaultTransitMount = "secret-encryption-vault-transit-mount"
+
+	// SecretEncryptionVaultTransitKey is the name of the Vault transit key
+	// used as the key-encryption key.
+	SecretEncryptionVaultTransitKey = "secret-encryption-vault-transit-key"
+)
+
+// Secret encryption key management services.
+const (
+	// SecretEncryptionKMSInternal holds the key-encryption key in the
+	// controller database.
+	SecretEncryptionKMSInternal = "internal"
+
+	// SecretEncryptionKMSVaultTransit holds the key-encryption key in a
+	// Vault transit secrets engine.
+	SecretEncryptionKMSVaultTransit = "vault-transit"
 )
 
 // Attribute Defaults
@@ -414,6 +456,14 @@ const (
 
 	// DefaultHTTPServerWriteTimeout is set to 0 (no timeout).
 	DefaultHTTPServerWriteTimeout = 0 * time.Second
+
+	// DefaultSecretEncryptionKMS is the default key management service
+	// for secret encryption keys.
+	DefaultSecretEncryptionKMS = SecretEncryptionKMSInternal
+
+	// DefaultSecretEncryptionVaultTransitMount is the default path of the
+	// Vault transit secrets engine.
+	DefaultSecretEncryptionVaultTransitMount = "transit"
 )
 
 var (
@@ -469,6 +519,13 @@ var (
 		JujudControllerSnapSource,
 		SSHMaxConcurrentConnections,
 		SSHServerPort,
+
+		SecretEncryptionKMS,
+		SecretEncryptionVaultAddress,
+		SecretEncryptionVaultTokenFile,
+		SecretEncryptionVaultCACert,
+		SecretEncryptionVaultTransitMount,
+		SecretEncryptionVaultTransitKey,
 	}
 
 	// For backwards compatibility, we must include "anything" and
@@ -518,6 +575,13 @@ var (
 		DqliteBusyTimeout,
 
 		SSHMaxConcurrentConnections,
+
+		SecretEncryptionKMS,
+		SecretEncryptionVaultAddress,
+		SecretEncryptionVaultTokenFile,
+		SecretEncryptionVaultCACert,
+		SecretEncryptionVaultTransitMount,
+		SecretEncryptionVaultTransitKey,
 	)
 
 	methodNameRE = regexp.MustCompile(`[[:alpha:]][[:alnum:]]*\.[[:alpha:]][[:alnum:]]*`)
@@ -986,6 +1050,24 @@ func (c Config) SSHMaxConcurrentConnections() int {
 	return c.intOrDefault(SSHMaxConcurrentConnections, DefaultSSHMaxConcurrentConnections)
 }
 
+// SecretEncryptionKMS returns the key management service holding the
+// key-encryption key for secret content.
+func (c Config) SecretEncryptionKMS() string {
+	if v := c.asString(SecretEncryptionKMS); v != "" {
+		return v
+	}
+	return DefaultSecretEncryptionKMS
+}
+
+// SecretEncryptionVaultTransitMount returns the path of the Vault transit
+// secrets engine used for secret encryption.
+func (c Config) SecretEncryptionVaultTransitMount() string {
+	if v := c.asString(SecretEncryptionVaultTransitMount); v != "" {
+		return v
+	}
+	return DefaultSecretEncryptionVaultTransitMount
+}
+
 // Validate ensures that config is a valid configuration.
 func Validate(c Config) error {
 	if v, ok := c[IdentityPublicKey].(string); ok {
@@ -1238,6 +1320,28 @@ func Validate(c Config) error {
 		}
 	}
 
+	switch kms := c.SecretEncryptionKMS(); kms {
+	case SecretEncryptionKMSInternal:
+	case SecretEncryptionKMSVaultTransit:
+		for _, key := range []string{SecretEncryptionVaultAddress, SecretEncryptionVaultTokenFile, SecretEncryptionVaultTransitKey} {
+			if c.asString(key) == "" {
+				return errors.NotValidf("%s %q without %s", SecretEncryptionKMS, kms, key)
+			}
+		}
+	default:
+		return errors.Errorf("%s value %q must be one of %s or %s",
+			SecretEncryptionKMS, kms, SecretEncryptionKMSInternal, SecretEncryptionKMSVaultTransit)
+	}
+	if v := c.asString(SecretEncryptionVaultAddress); v != "" {
+		u, err := url.Parse(v)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return errors.NotValidf("%s %q", SecretEncryptionVaultAddress, v)
+		}
+	}
+	if v := c.asString(SecretEncryptionVaultTokenFile); v != "" && !filepath.IsAbs(v) {
+		return errors.NotValidf("%s %q, expected an absolute path", SecretEncryptionVaultTokenFile, v)
+	}
+
 	return nil
 }
 
@@ -396,6 +396,48 @@ var newConfigTests = []struct {
 		controller.SSHServerPort: 17070,
 	},
 	expectError: `ssh-server-port matching api-port not valid`,
+}, {
+	about: "vault transit secret encryption",
+	config: controller.Config{
+		controller.SecretEncryptionKMS:             controller.SecretEncryptionKMSVaultTransit,
+		controller.SecretEncryptionVaultAddress:    "https://vault.example.com:8200",
+		controller.SecretEncryptionVaultTokenFile:  "/var/lib/juju/vault-token",
+		controller.SecretEncryptionVaultTransitKey: "juju",
+	},
+}, {
+	about: "vault transit secret encryption without key",
+	config: controller.Config{
+		controller.SecretEncryptionKMS:            controller.SecretEncryptionKMSVaultTransit,
+		controller.SecretEncryptionVaultAddress:   "https://vault.example.com:8200",
+		controller.SecretEncryptionVaultTokenFile: "/var/lib/juju/vault-token",
+	},
+	expectError: `secret-encryption-kms "vault-transit" without secret-encryption-vault-transit-key not valid`,
+}, {
+	about: "vault transit secret encryption without token file",
+	config: controller.Config{
+		controller.SecretEncryptionKMS:             controller.SecretEncryptionKMSVaultTransit,
+		controller.SecretEncryptionVaultAddress:    "https://vault.example.com:8200",
+		controller.SecretEncryptionVaultTransitKey: "juju",
+	},
+	expectError: `secret-encryption-kms "vault-transit" without secret-encryption-vault-token-file not valid`,
+}, {
+	about: "relative secret encryption vault token file",
+	config: controller.Config{
+		controller.SecretEncryptionVaultTokenFile: "vault-token",
+	},
+	expectError: `secret-encryption-vault-token-file "vault-token", expected an absolute path not valid`,
+}, {
+	about: "invalid secret encryption vault address",
+	config: controller.Config{
+		controller.SecretEncryptionVaultAddress: "vault.example.com",
+	},
+	expectError: `secret-encryption-vault-address "vault.example.com" not valid`,
+}, {
+	about: "unknown secret encryption kms",
+	config: controller.Config{
+		controller.SecretEncryptionKMS: "hsm",
+	},
+	expectError: `secret-encryption-kms value "hsm" must be one of internal or vault-transit`,
 }}
 
 func (s *ConfigSuite) TestNewConfig(c *tc.C) {
@@ -712,6 +754,8 @@ func (s *ConfigSuite) TestDefaults(c *tc.C) {
 	c.Assert(cfg.QueryTracingThreshold(), tc.Equals, controller.DefaultQueryTracingThreshold)
 	c.Assert(cfg.SSHServerPort(), tc.Equals, controller.DefaultSSHServerPort)
 	c.Assert(cfg.SSHMaxConcurrentConnections(), tc.Equals, controller.DefaultSSHMaxConcurrentConnections)
+	c.Assert(cfg.SecretEncryptionKMS(), tc.Equals, controller.DefaultSecretEncryptionKMS)
+	c.Assert(cfg.SecretEncryptionVaultTransitMount(), tc.Equals, controller.DefaultSecretEncryptionVaultTransitMount)
 }
 
 func (s *ConfigSuite) TestAgentLogfile(c *tc.C) {
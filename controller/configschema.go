@@ -12,99 +12,111 @@ import (
 )
 
 var configChecker = schema.FieldMap(schema.Fields{
-	AgentRateLimitMax:                schema.ForceInt(),
-	AgentRateLimitRate:               schema.TimeDurationString(),
-	AuditingEnabled:                  schema.Bool(),
-	AuditLogCaptureArgs:              schema.Bool(),
-	AuditLogMaxSize:                  schema.String(),
-	AuditLogMaxBackups:               schema.ForceInt(),
-	AuditLogExcludeMethods:           schema.String(),
-	APIPort:                          schema.ForceInt(),
-	ControllerName:                   schema.NonEmptyString(ControllerName),
-	LoginTokenRefreshURL:             schema.String(),
-	IdentityURL:                      schema.String(),
-	IdentityPublicKey:                schema.String(),
-	IdleConnectionTimeout:            schema.TimeDuration(),
-	HTTPServerReadTimeout:            schema.TimeDuration(),
-	HTTPServerWriteTimeout:           schema.TimeDuration(),
-	SetNUMAControlPolicyKey:          schema.Bool(),
-	AutocertURLKey:                   schema.String(),
-	AutocertDNSNameKey:               schema.String(),
-	AllowModelAccessKey:              schema.Bool(),
-	MaxDebugLogDuration:              schema.TimeDurationString(),
-	MaxTxnLogSize:                    schema.String(),
-	MaxPruneTxnBatchSize:             schema.ForceInt(),
-	MaxPruneTxnPasses:                schema.ForceInt(),
-	AgentLogfileMaxBackups:           schema.ForceInt(),
-	AgentLogfileMaxSize:              schema.String(),
-	ModelLogfileMaxBackups:           schema.ForceInt(),
-	ModelLogfileMaxSize:              schema.String(),
-	PruneTxnQueryCount:               schema.ForceInt(),
-	PruneTxnSleepTime:                schema.TimeDurationString(),
-	PublicDNSAddress:                 schema.String(),
-	JujuManagementSpace:              schema.String(),
-	CAASOperatorImagePath:            schema.String(),
-	CAASImageRepo:                    schema.String(),
-	Features:                         schema.String(),
-	MaxCharmStateSize:                schema.ForceInt(),
-	MaxAgentStateSize:                schema.ForceInt(),
-	MigrationMinionWaitMax:           schema.TimeDurationString(),
-	ApplicationResourceDownloadLimit: schema.ForceInt(),
-	ControllerResourceDownloadLimit:  schema.ForceInt(),
-	QueryTracingEnabled:              schema.Bool(),
-	QueryTracingThreshold:            schema.TimeDurationString(),
-	DqliteBusyTimeout:                schema.TimeDurationString(),
-	SystemSSHKeys:                    schema.String(),
-	JujudControllerSnapSource:        schema.String(),
-	SSHServerPort:                    schema.ForceInt(),
-	SSHMaxConcurrentConnections:      schema.ForceInt(),
+	AgentRateLimitMax:                 schema.ForceInt(),
+	AgentRateLimitRate:                schema.TimeDurationString(),
+	AuditingEnabled:                   schema.Bool(),
+	AuditLogCaptureArgs:               schema.Bool(),
+	AuditLogMaxSize:                   schema.String(),
+	AuditLogMaxBackups:                schema.ForceInt(),
+	AuditLogExcludeMethods:            schema.String(),
+	APIPort:                           schema.ForceInt(),
+	ControllerName:                    schema.NonEmptyString(ControllerName),
+	LoginTokenRefreshURL:              schema.String(),
+	IdentityURL:                       schema.String(),
+	IdentityPublicKey:                 schema.String(),
+	IdleConnectionTimeout:             schema.TimeDuration(),
+	HTTPServerReadTimeout:             schema.TimeDuration(),
+	HTTPServerWriteTimeout:            schema.TimeDuration(),
+	SetNUMAControlPolicyKey:           schema.Bool(),
+	AutocertURLKey:                    schema.String(),
+	AutocertDNSNameKey:                schema.String(),
+	AllowModelAccessKey:               schema.Bool(),
+	MaxDebugLogDuration:               schema.TimeDurationString(),
+	MaxTxnLogSize:                     schema.String(),
+	MaxPruneTxnBatchSize:              schema.ForceInt(),
+	MaxPruneTxnPasses:                 schema.ForceInt(),
+	AgentLogfileMaxBackups:            schema.ForceInt(),
+	AgentLogfileMaxSize:               schema.String(),
+	ModelLogfileMaxBackups:            schema.ForceInt(),
+	ModelLogfileMaxSize:               schema.String(),
+	PruneTxnQueryCount:                schema.ForceInt(),
+	PruneTxnSleepTime:                 schema.TimeDurationString(),
+	PublicDNSAddress:                  schema.String(),
+	JujuManagementSpace:               schema.String(),
+	CAASOperatorImagePath:             schema.String(),
+	CAASImageRepo:                     schema.String(),
+	Features:                          schema.String(),
+	MaxCharmStateSize:                 schema.ForceInt(),
+	MaxAgentStateSize:                 schema.ForceInt(),
+	MigrationMinionWaitMax:            schema.TimeDurationString(),
+	ApplicationResourceDownloadLimit:  schema.ForceInt(),
+	ControllerResourceDownloadLimit:   schema.ForceInt(),
+	QueryTracingEnabled:               schema.Bool(),
+	QueryTracingThreshold:             schema.TimeDurationString(),
+	DqliteBusyTimeout:                 schema.TimeDurationString(),
+	SystemSSHKeys:                     schema.String(),
+	JujudControllerSnapSource:         schema.String(),
+	SSHServerPort:                     schema.ForceInt(),
+	SSHMaxConcurrentConnections:       schema.ForceInt(),
+	SecretEncryptionKMS:               schema.String(),
+	SecretEncryptionVaultAddress:      schema.String(),
+	SecretEncryptionVaultTokenFile:    schema.String(),
+	SecretEncryptionVaultCACert:       schema.String(),
+	SecretEncryptionVaultTransitMount: schema.String(),
+	SecretEncryptionVaultTransitKey:   schema.String(),
 }, schema.Defaults{
-	AgentRateLimitMax:                schema.Omit,
-	AgentRateLimitRate:               schema.Omit,
-	APIPort:                          DefaultAPIPort,
-	ControllerName:                   schema.Omit,
-	AuditingEnabled:                  DefaultAuditingEnabled,
-	AuditLogCaptureArgs:              DefaultAuditLogCaptureArgs,
-	AuditLogMaxSize:                  fmt.Sprintf("%vM", DefaultAuditLogMaxSizeMB),
-	AuditLogMaxBackups:               DefaultAuditLogMaxBackups,
-	AuditLogExcludeMethods:           DefaultAuditLogExcludeMethods,
-	LoginTokenRefreshURL:             schema.Omit,
-	IdentityURL:                      schema.Omit,
-	IdentityPublicKey:                schema.Omit,
-	IdleConnectionTimeout:            DefaultIdleConnectionTimeout,
-	HTTPServerReadTimeout:            DefaultHTTPServerReadTimeout,
-	HTTPServerWriteTimeout:           DefaultHTTPServerWriteTimeout,
-	SetNUMAControlPolicyKey:          DefaultNUMAControlPolicy,
-	AutocertURLKey:                   schema.Omit,
-	AutocertDNSNameKey:               schema.Omit,
-	AllowModelAccessKey:              schema.Omit,
-	MaxDebugLogDuration:              DefaultMaxDebugLogDuration,
-	MaxTxnLogSize:                    fmt.Sprintf("%vM", DefaultMaxTxnLogCollectionMB),
-	MaxPruneTxnBatchSize:             DefaultMaxPruneTxnBatchSize,
-	MaxPruneTxnPasses:                DefaultMaxPruneTxnPasses,
-	AgentLogfileMaxBackups:           DefaultAgentLogfileMaxBackups,
-	AgentLogfileMaxSize:              fmt.Sprintf("%vM", DefaultAgentLogfileMaxSize),
-	ModelLogfileMaxBackups:           DefaultModelLogfileMaxBackups,
-	ModelLogfileMaxSize:              fmt.Sprintf("%vM", DefaultModelLogfileMaxSize),
-	PruneTxnQueryCount:               DefaultPruneTxnQueryCount,
-	PruneTxnSleepTime:                DefaultPruneTxnSleepTime,
-	PublicDNSAddress:                 schema.Omit,
-	JujuManagementSpace:              schema.Omit,
-	CAASOperatorImagePath:            schema.Omit,
-	CAASImageRepo:                    schema.Omit,
-	Features:                         schema.Omit,
-	MaxCharmStateSize:                DefaultMaxCharmStateSize,
-	MaxAgentStateSize:                DefaultMaxAgentStateSize,
-	MigrationMinionWaitMax:           DefaultMigrationMinionWaitMax,
-	ApplicationResourceDownloadLimit: schema.Omit,
-	ControllerResourceDownloadLimit:  schema.Omit,
-	QueryTracingEnabled:              DefaultQueryTracingEnabled,
-	QueryTracingThreshold:            DefaultQueryTracingThreshold,
-	DqliteBusyTimeout:                DefaultDqliteBusyTimeout,
-	SystemSSHKeys:                    schema.Omit,
-	JujudControllerSnapSource:        DefaultJujudControllerSnapSource,
-	SSHServerPort:                    DefaultSSHServerPort,
-	SSHMaxConcurrentConnections:      DefaultSSHMaxConcurrentConnections,
+	AgentRateLimitMax:                 schema.Omit,
+	AgentRateLimitRate:                schema.Omit,
+	APIPort:                           DefaultAPIPort,
+	ControllerName:                    schema.Omit,
+	AuditingEnabled:                   DefaultAuditingEnabled,
+	AuditLogCaptureArgs:               DefaultAuditLogCaptureArgs,
+	AuditLogMaxSize:                   fmt.Sprintf("%vM", DefaultAuditLogMaxSizeMB),
+	AuditLogMaxBackups:                DefaultAuditLogMaxBackups,
+	AuditLogExcludeMethods:            DefaultAuditLogExcludeMethods,
+	LoginTokenRefreshURL:              schema.Omit,
+	IdentityURL:                       schema.Omit,
+	IdentityPublicKey:                 schema.Omit,
+	IdleConnectionTimeout:             DefaultIdleConnectionTimeout,
+	HTTPServerReadTimeout:             DefaultHTTPServerReadTimeout,
+	HTTPServerWriteTimeout:            DefaultHTTPServerWriteTimeout,
+	SetNUMAControlPolicyKey:           DefaultNUMAControlPolicy,
+	AutocertURLKey:                    schema.Omit,
+	AutocertDNSNameKey:                schema.Omit,
+	AllowModelAccessKey:               schema.Omit,
+	MaxDebugLogDuration:               DefaultMaxDebugLogDuration,
+	MaxTxnLogSize:                     fmt.Sprintf("%vM", DefaultMaxTxnLogCollectionMB),
+	MaxPruneTxnBatchSize:              DefaultMaxPruneTxnBatchSize,
+	MaxPruneTxnPasses:                 DefaultMaxPruneTxnPasses,
+	AgentLogfileMaxBackups:            DefaultAgentLogfileMaxBackups,
+	AgentLogfileMaxSize:               fmt.Sprintf("%vM", DefaultAgentLogfileMaxSize),
+	ModelLogfileMaxBackups:            DefaultModelLogfileMaxBackups,
+	ModelLogfileMaxSize:               fmt.Sprintf("%vM", DefaultModelLogfileMaxSize),
+	PruneTxnQueryCount:                DefaultPruneTxnQueryCount,
+	PruneTxnSleepTime:                 DefaultPruneTxnSleepTime,
+	PublicDNSAddress:                  schema.Omit,
+	JujuManagementSpace:               schema.Omit,
+	CAASOperatorImagePath:             schema.Omit,
+	CAASImageRepo:                     schema.Omit,
+	Features:                          schema.Omit,
+	MaxCharmStateSize:                 DefaultMaxCharmStateSize,
+	MaxAgentStateSize:                 DefaultMaxAgentStateSize,
+	MigrationMinionWaitMax:            DefaultMigrationMinionWaitMax,
+	ApplicationResourceDownloadLimit:  schema.Omit,
+	ControllerResourceDownloadLimit:   schema.Omit,
+	QueryTracingEnabled:               DefaultQueryTracingEnabled,
+	QueryTracingThreshold:             DefaultQueryTracingThreshold,
+	DqliteBusyTimeout:                 DefaultDqliteBusyTimeout,
+	SystemSSHKeys:                     schema.Omit,
+	JujudControllerSnapSource:         DefaultJujudControllerSnapSource,
+	SSHServerPort:                     DefaultSSHServerPort,
+	SSHMaxConcurrentConnections:       DefaultSSHMaxConcurrentConnections,
+	SecretEncryptionKMS:               DefaultSecretEncryptionKMS,
+	SecretEncryptionVaultAddress:      schema.Omit,
+	SecretEncryptionVaultTokenFile:    schema.Omit,
+	SecretEncryptionVaultCACert:       schema.Omit,
+	SecretEncryptionVaultTransitMount: DefaultSecretEncryptionVaultTransitMount,
+	SecretEncryptionVaultTransitKey:   schema.Omit,
 })
 
 // ConfigSchema holds information on all the fields defined by
@@ -312,4 +324,30 @@ same database.`[1:],
 		Type:        configschema.Tint,
 		Description: `The maximum number of concurrent ssh connections to the controller`,
 	},
+	SecretEncryptionKMS: {
+		Type: configschema.Tstring,
+		Description: `The key management service holding the key used to encrypt secrets
+stored in the controller database, either "internal" or "vault-transit"`,
+	},
+	SecretEncryptionVaultAddress: {
+		Type:        configschema.Tstring,
+		Description: `The URL of the Vault server holding the secret encryption key`,
+	},
+	SecretEncryptionVaultTokenFile: {
+		Type: configschema.Tstring,
+		Description: `The path, on each controller machine, of a file holding the token
+used to access the Vault transit secrets engine`,
+	},
+	SecretEncryptionVaultCACert: {
+		Type:        configschema.Tstring,
+		Description: `The CA certificate of the Vault server holding the secret encryption key`,
+	},
+	SecretEncryptionVaultTransitMount: {
+		Type:        configschema.Tstring,
+		Description: `The path the Vault transit secrets engine is mounted on`,
+	},
+	SecretEncryptionVaultTransitKey: {
+		Type:        configschema.Tstring,
+		Description: `The name of the Vault transit key used to encrypt secret data keys`,
+	},
 }
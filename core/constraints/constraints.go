@@ -40,6 +40,15 @@ const (
 	ImageID          = "image-id"
 	IPFamily         = "ip-family"
 
+	Market              = "market"
+	MaxPrice            = "max-price"
+	CapacityReservation = "capacity-reservation"
+
+	PlacementGroup = "placement-group"
+
+	Gpus    = "gpus"
+	GpuType = "gpu-type"
+
 	// excludedPrefix is the prefix Juju expects to be in front of a value when
 	// it is to be considered excluded as part of constraints.
 	excludedPrefix = "^"
@@ -129,7 +138,67 @@ type Value struct {
 	// warning. If this constraint is not present, the default behavior is
 	// provider-specific.
 	IPFamily *ipfamily.IPFamily `json:"ip-family,omitempty" yaml:"ip-family,omitempty"`
-}
+
+	// Market, if not nil or empty, indicates the purchasing option for the
+	// machine, either "on-demand" or "spot". Only valid for clouds which
+	// support spot instances.
+	Market *string `json:"market,omitempty" yaml:"market,omitempty"`
+
+	// MaxPrice, if not nil or empty, indicates the maximum hourly price,
+	// as a decimal string in the cloud's billing currency, to pay for a
+	// spot instance. Setting a maximum price implies a spot market.
+	MaxPrice *string `json:"max-price,omitempty" yaml:"max-price,omitempty"`
+
+	// CapacityReservation, if not nil or empty, indicates the ID of a
+	// cloud capacity reservation that a machine must be started in.
+	// Only valid for clouds which support capacity reservations.
+	CapacityReservation *string `json:"capacity-reservation,omitempty" yaml:"capacity-reservation,omitempty"`
+
+	// PlacementGroup, if not nil or empty, indicates how the provider should
+	// place the machines of an application relative to each other: packed
+	// close together ("cluster"), spread across distinct hardware
+	// ("spread") or pinned to dedicated hosts ("dedicated-host"). Only
+	// valid for clouds which support placement groups.
+	PlacementGroup *string `json:"placement-group,omitempty" yaml:"placement-group,omitempty"`
+
+	// Gpus, if not nil, indicates that a machine must have at least that
+	// number of GPUs or other accelerators attached.
+	Gpus *uint64 `json:"gpus,omitempty" yaml:"gpus,omitempty"`
+
+	// GpuType, if not nil or empty, indicates the model of GPU that a
+	// machine must have attached, for example "a100". The value is
+	// matched case-insensitively against the GPU name reported by the
+	// cloud, and implies at least one GPU.
+	GpuType *string `json:"gpu-type,omitempty" yaml:"gpu-type,omitempty"`
+}
+
+// The following constants list the supported values of the market
+// constraint.
+const (
+	// MarketOnDemand indicates that machines are billed at the on-demand
+	// price and are not interrupted by the cloud.
+	MarketOnDemand = "on-demand"
+
+	// MarketSpot indicates that machines use spare cloud capacity at the
+	// spot price and may be interrupted when the capacity is needed back.
+	MarketSpot = "spot"
+)
+
+// The following constants list the supported values of the placement-group
+// constraint.
+const (
+	// PlacementGroupCluster packs machines close together to get low
+	// latency and high throughput between them.
+	PlacementGroupCluster = "cluster"
+
+	// PlacementGroupSpread places machines on distinct underlying hardware
+	// to reduce correlated failures.
+	PlacementGroupSpread = "spread"
+
+	// PlacementGroupDedicatedHost places machines on physical hosts that are
+	// dedicated to the cloud account.
+	PlacementGroupDedicatedHost = "dedicated-host"
+)
 
 var rawAliases = map[string]string{
 	cpuCores: Cores,
@@ -278,6 +347,61 @@ func (v *Value) HasIPFamily() bool {
 	return v.IPFamily != nil && *v.IPFamily != ""
 }
 
+// HasMarket returns true if the constraints.Value specifies a market.
+func (v *Value) HasMarket() bool {
+	return v.Market != nil && *v.Market != ""
+}
+
+// HasMaxPrice returns true if the constraints.Value specifies a maximum
+// spot price.
+func (v *Value) HasMaxPrice() bool {
+	return v.MaxPrice != nil && *v.MaxPrice != ""
+}
+
+// HasCapacityReservation returns true if the constraints.Value specifies
+// a capacity reservation.
+func (v *Value) HasCapacityReservation() bool {
+	return v.CapacityReservation != nil && *v.CapacityReservation != ""
+}
+
+// HasPlacementGroup returns true if the constraints.Value specifies a
+// placement group.
+func (v *Value) HasPlacementGroup() bool {
+	return v.PlacementGroup != nil && *v.PlacementGroup != ""
+}
+
+// HasGpus returns true if the constraints.Value specifies a minimum number
+// of GPUs.
+func (v *Value) HasGpus() bool {
+	return v.Gpus != nil && *v.Gpus > 0
+}
+
+// HasGpuType returns true if the constraints.Value specifies a GPU type.
+func (v *Value) HasGpuType() bool {
+	return v.GpuType != nil && *v.GpuType != ""
+}
+
+// MinGpus returns the minimum number of GPUs requested by the
+// constraints.Value. A GPU type on its own requests a single GPU.
+func (v *Value) MinGpus() uint64 {
+	if v.HasGpus() {
+		return *v.Gpus
+	}
+	if v.HasGpuType() {
+		return 1
+	}
+	return 0
+}
+
+// IsSpot returns true if the constraints.Value requests a spot market,
+// either explicitly or by specifying a maximum spot price.
+func (v *Value) IsSpot() bool {
+	if v.HasMarket() {
+		return *v.Market == MarketSpot
+	}
+	return v.HasMaxPrice()
+}
+
 // String expresses a constraints.Value in the language in which it was specified.
 func (v Value) String() string {
 	var strs []string
@@ -302,6 +426,24 @@ func (v Value) String() string {
 	if v.IPFamily != nil {
 		strs = append(strs, "ip-family="+v.IPFamily.String())
 	}
+	if v.Market != nil {
+		strs = append(strs, "market="+(*v.Market))
+	}
+	if v.MaxPrice != nil {
+		strs = append(strs, "max-price="+(*v.MaxPrice))
+	}
+	if v.CapacityReservation != nil {
+		strs = append(strs, "capacity-reservation="+(*v.CapacityReservation))
+	}
+	if v.PlacementGroup != nil {
+		strs = append(strs, "placement-group="+(*v.PlacementGroup))
+	}
+	if v.Gpus != nil {
+		strs = append(strs, "gpus="+uintStr(*v.Gpus))
+	}
+	if v.GpuType != nil {
+		strs = append(strs, "gpu-type="+(*v.GpuType))
+	}
 	if v.Mem != nil {
 		s := uintStr(*v.Mem)
 		if s != "" {
@@ -405,6 +547,24 @@ func (v Value) GoString() string {
 	if v.IPFamily != nil {
 		values = append(values, fmt.Sprintf("IPFamily: %q", *v.IPFamily))
 	}
+	if v.Market != nil {
+		values = append(values, fmt.Sprintf("Market: %q", *v.Market))
+	}
+	if v.MaxPrice != nil {
+		values = append(values, fmt.Sprintf("MaxPrice: %q", *v.MaxPrice))
+	}
+	if v.CapacityReservation != nil {
+		values = append(values, fmt.Sprintf("CapacityReservation: %q", *v.CapacityReservation))
+	}
+	if v.PlacementGroup != nil {
+		values = append(values, fmt.Sprintf("PlacementGroup: %q", *v.PlacementGroup))
+	}
+	if v.Gpus != nil {
+		values = append(values, fmt.Sprintf("Gpus: %v", *v.Gpus))
+	}
+	if v.GpuType != nil {
+		values = append(values, fmt.Sprintf("GpuType: %q", *v.GpuType))
+	}
 	return fmt.Sprintf("{%s}", strings.Join(values, ", "))
 }
 
@@ -582,6 +742,18 @@ func (v *Value) setRaw(name, str string) error {
 		err = v.setImageID(str)
 	case IPFamily:
 		err = v.setIPFamily(str)
+	case Market:
+		err = v.setMarket(str)
+	case MaxPrice:
+		err = v.setMaxPrice(str)
+	case CapacityReservation:
+		err = v.setCapacityReservation(str)
+	case PlacementGroup:
+		err = v.setPlacementGroup(str)
+	case Gpus:
+		err = v.setGpus(str)
+	case GpuType:
+		err = v.setGpuType(str)
 	default:
 		return errors.Errorf("unknown constraint %q", name)
 	}
@@ -662,6 +834,27 @@ func (v *Value) UnmarshalYAML(unmarshal func(any) error) error {
 			} else {
 				v.IPFamily = &parsed
 			}
+		case Market:
+			err = validateMarket(vstr)
+			if err == nil {
+				v.Market = &vstr
+			}
+		case MaxPrice:
+			err = validateMaxPrice(vstr)
+			if err == nil {
+				v.MaxPrice = &vstr
+			}
+		case CapacityReservation:
+			v.CapacityReservation = &vstr
+		case PlacementGroup:
+			err = validatePlacementGroup(vstr)
+			if err == nil {
+				v.PlacementGroup = &vstr
+			}
+		case Gpus:
+			v.Gpus, err = parseUint64(vstr)
+		case GpuType:
+			v.GpuType = &vstr
 		default:
 			return errors.Errorf("unknown constraint value: %v", k)
 		}
@@ -841,6 +1034,91 @@ func (v *Value) setIPFamily(str string) error {
 	return nil
 }
 
+func (v *Value) setMarket(str string) error {
+	if v.Market != nil {
+		return errors.Errorf("already set")
+	}
+	if err := validateMarket(str); err != nil {
+		return err
+	}
+	v.Market = &str
+	return nil
+}
+
+func (v *Value) setMaxPrice(str string) error {
+	if v.MaxPrice != nil {
+		return errors.Errorf("already set")
+	}
+	if err := validateMaxPrice(str); err != nil {
+		return err
+	}
+	v.MaxPrice = &str
+	return nil
+}
+
+func (v *Value) setCapacityReservation(str string) error {
+	if v.CapacityReservation != nil {
+		return errors.Errorf("already set")
+	}
+	v.CapacityReservation = &str
+	return nil
+}
+
+func (v *Value) setPlacementGroup(str string) error {
+	if v.PlacementGroup != nil {
+		return errors.Errorf("already set")
+	}
+	if err := validatePlacementGroup(str); err != nil {
+		return err
+	}
+	v.PlacementGroup = &str
+	return nil
+}
+
+func (v *Value) setGpus(str string) (err error) {
+	if v.Gpus != nil {
+		return errors.Errorf("already set")
+	}
+	v.Gpus, err = parseUint64(str)
+	return
+}
+
+func (v *Value) setGpuType(str string) error {
+	if v.GpuType != nil {
+		return errors.Errorf("already set")
+	}
+	v.GpuType = &str
+	return nil
+}
+
+func validatePlacementGroup(str string) error {
+	switch str {
+	case "", PlacementGroupCluster, PlacementGroupSpread, PlacementGroupDedicatedHost:
+		return nil
+	}
+	return errors.Errorf("%q not recognized, must be one of %q, %q or %q",
+		str, PlacementGroupCluster, PlacementGroupSpread, PlacementGroupDedicatedHost)
+}
+
+func validateMarket(str string) error {
+	switch str {
+	case "", MarketOnDemand, MarketSpot:
+		return nil
+	}
+	return errors.Errorf("%q not recognized, must be %q or %q", str, MarketOnDemand, MarketSpot)
+}
+
+func validateMaxPrice(str string) error {
+	if str == "" {
+		return nil
+	}
+	val, err := strconv.ParseFloat(str, 64)
+	if err != nil || val <= 0 || math.IsInf(val, 0) {
+		return errors.Errorf("must be a positive decimal price")
+	}
+	return nil
+}
+
 func parseBool(str string) (*bool, error) {
 	var value bool
 	if str != "" {
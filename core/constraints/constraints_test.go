@@ -424,6 +424,124 @@ var parseConstraintsTests = []struct {
 		err:     `bad "ip-family" constraint: already set`,
 	},
 
+	// Market
+	{
+		summary: "set market spot",
+		args:    []string{"market=spot"},
+		result:  &constraints.Value{Market: new("spot")},
+	}, {
+		summary: "set market on-demand",
+		args:    []string{"market=on-demand"},
+		result:  &constraints.Value{Market: new("on-demand")},
+	}, {
+		summary: "set market empty",
+		args:    []string{"market="},
+		result:  &constraints.Value{Market: new("")},
+	}, {
+		summary: "set market unknown value",
+		args:    []string{"market=reserved"},
+		err:     `bad "market" constraint: "reserved" not recognized, must be "on-demand" or "spot"`,
+	}, {
+		summary: "double set market",
+		args:    []string{"market=spot market=spot"},
+		err:     `bad "market" constraint: already set`,
+	},
+
+	// MaxPrice
+	{
+		summary: "set max-price",
+		args:    []string{"max-price=0.05"},
+		result:  &constraints.Value{MaxPrice: new("0.05")},
+	}, {
+		summary: "set max-price empty",
+		args:    []string{"max-price="},
+		result:  &constraints.Value{MaxPrice: new("")},
+	}, {
+		summary: "set max-price zero",
+		args:    []string{"max-price=0"},
+		err:     `bad "max-price" constraint: must be a positive decimal price`,
+	}, {
+		summary: "set max-price not a number",
+		args:    []string{"max-price=cheap"},
+		err:     `bad "max-price" constraint: must be a positive decimal price`,
+	}, {
+		summary: "double set max-price",
+		args:    []string{"max-price=1 max-price=2"},
+		err:     `bad "max-price" constraint: already set`,
+	},
+
+	// CapacityReservation
+	{
+		summary: "set capacity-reservation",
+		args:    []string{"capacity-reservation=cr-1234567890abcdef0"},
+		result:  &constraints.Value{CapacityReservation: new("cr-1234567890abcdef0")},
+	}, {
+		summary: "double set capacity-reservation",
+		args:    []string{"capacity-reservation=cr-1 capacity-reservation=cr-2"},
+		err:     `bad "capacity-reservation" constraint: already set`,
+	},
+
+	// PlacementGroup
+	{
+		summary: "set placement-group cluster",
+		args:    []string{"placement-group=cluster"},
+		result:  &constraints.Value{PlacementGroup: new("cluster")},
+	}, {
+		summary: "set placement-group spread",
+		args:    []string{"placement-group=spread"},
+		result:  &constraints.Value{PlacementGroup: new("spread")},
+	}, {
+		summary: "set placement-group dedicated-host",
+		args:    []string{"placement-group=dedicated-host"},
+		result:  &constraints.Value{PlacementGroup: new("dedicated-host")},
+	}, {
+		summary: "set placement-group empty",
+		args:    []string{"placement-group="},
+		result:  &constraints.Value{PlacementGroup: new("")},
+	}, {
+		summary: "set placement-group unknown value",
+		args:    []string{"placement-group=rack"},
+		err:     `bad "placement-group" constraint: "rack" not recognized, must be one of "cluster", "spread" or "dedicated-host"`,
+	}, {
+		summary: "double set placement-group",
+		args:    []string{"placement-group=cluster placement-group=spread"},
+		err:     `bad "placement-group" constraint: already set`,
+	},
+
+	// Gpus
+	{
+		summary: "set gpus",
+		args:    []string{"gpus=2"},
+		result:  &constraints.Value{Gpus: new(uint64(2))},
+	}, {
+		summary: "set gpus empty",
+		args:    []string{"gpus="},
+		result:  &constraints.Value{Gpus: new(uint64(0))},
+	}, {
+		summary: "set gpus negative",
+		args:    []string{"gpus=-1"},
+		err:     `bad "gpus" constraint: must be a non-negative integer`,
+	}, {
+		summary: "double set gpus",
+		args:    []string{"gpus=1 gpus=2"},
+		err:     `bad "gpus" constraint: already set`,
+	},
+
+	// GpuType
+	{
+		summary: "set gpu-type",
+		args:    []string{"gpu-type=a100"},
+		result:  &constraints.Value{GpuType: new("a100")},
+	}, {
+		summary: "set gpus and gpu-type",
+		args:    []string{"gpus=2 gpu-type=a100"},
+		result:  &constraints.Value{Gpus: new(uint64(2)), GpuType: new("a100")},
+	}, {
+		summary: "double set gpu-type",
+		args:    []string{"gpu-type=a100 gpu-type=t4"},
+		err:     `bad "gpu-type" constraint: already set`,
+	},
+
 	// Everything at once.
 	{
 		summary: "kitchen sink together",
@@ -684,6 +802,30 @@ func (s *ConstraintsSuite) TestHasIPFamily(c *tc.C) {
 	c.Check(con.HasIPFamily(), tc.IsFalse)
 }
 
+func (s *ConstraintsSuite) TestMinGpus(c *tc.C) {
+	con := constraints.MustParse("gpus=2 gpu-type=a100")
+	c.Check(con.MinGpus(), tc.Equals, uint64(2))
+	con = constraints.MustParse("gpu-type=a100")
+	c.Check(con.MinGpus(), tc.Equals, uint64(1))
+	con = constraints.MustParse("gpus=0")
+	c.Check(con.MinGpus(), tc.Equals, uint64(0))
+	con = constraints.Value{}
+	c.Check(con.MinGpus(), tc.Equals, uint64(0))
+}
+
+func (s *ConstraintsSuite) TestIsSpot(c *tc.C) {
+	con := constraints.MustParse("market=spot")
+	c.Check(con.IsSpot(), tc.IsTrue)
+	con = constraints.MustParse("max-price=0.1")
+	c.Check(con.IsSpot(), tc.IsTrue)
+	con = constraints.MustParse("market=on-demand")
+	c.Check(con.IsSpot(), tc.IsFalse)
+	con = constraints.MustParse("capacity-reservation=cr-1")
+	c.Check(con.IsSpot(), tc.IsFalse)
+	con = constraints.Value{}
+	c.Check(con.IsSpot(), tc.IsFalse)
+}
+
 func (s *ConstraintsSuite) TestIsEmpty(c *tc.C) {
 	con := constraints.Value{}
 	c.Check(&con, tc.Satisfies, constraints.IsEmpty)
@@ -772,6 +914,15 @@ var constraintsRoundtripTests = []roundTrip{
 	{"IPFamily2", constraints.Value{IPFamily: new(ipfamily.IPv4)}},
 	{"IPFamily3", constraints.Value{IPFamily: new(ipfamily.IPv6)}},
 	{"IPFamily4", constraints.Value{IPFamily: new(ipfamily.Dual)}},
+	{"Market1", constraints.Value{Market: new("spot")}},
+	{"Market2", constraints.Value{Market: new("on-demand")}},
+	{"MaxPrice1", constraints.Value{MaxPrice: new("0.25")}},
+	{"CapacityReservation1", constraints.Value{CapacityReservation: new("cr-1234")}},
+	{"PlacementGroup1", constraints.Value{PlacementGroup: new("cluster")}},
+	{"PlacementGroup2", constraints.Value{PlacementGroup: new("dedicated-host")}},
+	{"Gpus1", constraints.Value{Gpus: new(uint64(0))}},
+	{"Gpus2", constraints.Value{Gpus: new(uint64(8))}},
+	{"GpuType1", constraints.Value{GpuType: new("a100")}},
 	{"All", constraints.Value{
 		Arch:             new("arm64"),
 		Container:        ctypep("lxd"),
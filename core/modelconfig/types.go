@@ -49,3 +49,38 @@ func (c ContainerNetworkingMethod) Validate() error {
 		return errors.Errorf("container networking method value %q %w", c, coreerrors.NotValid)
 	}
 }
+
+// MachineRecoveryPolicy defines a strong type for setting and reading the
+// model config value for the machine recovery policy.
+type MachineRecoveryPolicy string
+
+const (
+	// MachineRecoveryPolicyNone indicates that machines whose cloud instance
+	// has stopped or disappeared are left in error for the operator to deal
+	// with. This is the default.
+	MachineRecoveryPolicyNone = MachineRecoveryPolicy("none")
+
+	// MachineRecoveryPolicyReprovision indicates that machines whose cloud
+	// instance has stopped or disappeared are automatically reprovisioned
+	// onto a new cloud instance.
+	MachineRecoveryPolicyReprovision = MachineRecoveryPolicy("reprovision")
+)
+
+// String implements the stringer interface returning a human readable string
+// representation of the machine recovery policy.
+func (p MachineRecoveryPolicy) String() string {
+	return string(p)
+}
+
+// Validate checks that the value of [MachineRecoveryPolicy] is an understood
+// value by the system. If the value is not valid an error satisfying
+// [errors.NotValid] will be returned.
+func (p MachineRecoveryPolicy) Validate() error {
+	switch p {
+	case MachineRecoveryPolicyNone,
+		MachineRecoveryPolicyReprovision:
+		return nil
+	default:
+		return errors.Errorf("machine recovery policy value %q %w", p, coreerrors.NotValid)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GeneratorFormat defines the format of a generated secret value.
+type GeneratorFormat string
+
+const (
+	// GeneratePassword generates a random string from a character set.
+	GeneratePassword = GeneratorFormat("password")
+	// GenerateHex generates a random hex encoded string.
+	GenerateHex = GeneratorFormat("hex")
+	// GenerateRSA generates an RSA key pair.
+	GenerateRSA = GeneratorFormat("rsa")
+	// GenerateEd25519 generates an Ed25519 key pair.
+	GenerateEd25519 = GeneratorFormat("ed25519")
+)
+
+const (
+	// GeneratedValueKey is the key holding a generated
+	// password or hex value.
+	GeneratedValueKey = "value"
+	// GeneratedPrivateKeyKey is the key holding the PEM encoded
+	// private key of a generated key pair.
+	GeneratedPrivateKeyKey = "private-key"
+	// GeneratedPublicKeyKey is the key holding the PEM encoded
+	// public key of a generated key pair.
+	GeneratedPublicKeyKey = "public-key"
+
+	// DefaultPasswordCharset is the set of characters used
+	// for generated passwords if none is specified.
+	DefaultPasswordCharset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+	defaultPasswordLength = 32
+	defaultHexLength      = 64
+	defaultRSABits        = 3072
+
+	minPasswordLength = 8
+	maxPasswordLength = 1024
+	minRSABits        = 2048
+	maxRSABits        = 8192
+)
+
+// GeneratorSpec describes how the content of a secret is generated.
+type GeneratorSpec struct {
+	// Format is the format of the generated value.
+	Format GeneratorFormat
+	// Length is the number of characters of a password or hex value,
+	// or the number of bits of an RSA key. If zero, a default is used.
+	// It must be zero for Ed25519 keys.
+	Length int
+	// Charset is the set of characters used for a password. If empty,
+	// DefaultPasswordCharset is used. It must be empty for other formats.
+	Charset string
+}
+
+// Validate returns an error satisfying [coreerrors.NotValid]
+// if the spec cannot be used to generate a value.
+func (s GeneratorSpec) Validate() error {
+	if s.Charset != "" && s.Format != GeneratePassword {
+		return errors.Errorf("charset for %q generator %w", s.Format, coreerrors.NotValid)
+	}
+	if s.Length < 0 {
+		return errors.Errorf("negative generator length %w", coreerrors.NotValid)
+	}
+	switch s.Format {
+	case GeneratePassword, GenerateHex:
+		if s.Length != 0 && (s.Length < minPasswordLength || s.Length > maxPasswordLength) {
+			return errors.Errorf("%q generator length %d, must be between %d and %d %w",
+				s.Format, s.Length, minPasswordLength, maxPasswordLength, coreerrors.NotValid)
+		}
+		for _, r := range s.Charset {
+			if r < '!' || r > '~' {
+				return errors.Errorf("charset %q, must only contain printable ASCII characters %w",
+					s.Charset, coreerrors.NotValid)
+			}
+		}
+	case GenerateRSA:
+		if s.Length != 0 && (s.Length < minRSABits || s.Length > maxRSABits) {
+			return errors.Errorf("RSA key size %d, must be between %d and %d bits %w",
+				s.Length, minRSABits, maxRSABits, coreerrors.NotValid)
+		}
+	case GenerateEd25519:
+		if s.Length != 0 {
+			return errors.Errorf("length for %q generator %w", s.Format, coreerrors.NotValid)
+		}
+	default:
+		return errors.Errorf("generator format %q %w", s.Format, coreerrors.NotValid)
+	}
+	return nil
+}
+
+// Generate returns new secret content as described by the spec, with base64
+// encoded values. Passwords and hex values are held under [GeneratedValueKey];
+// key pairs are held under [GeneratedPrivateKeyKey] and [GeneratedPublicKeyKey].
+func (s GeneratorSpec) Generate() (SecretData, error) {
+	if err := s.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var (
+		data map[string][]byte
+		err  error
+	)
+	switch s.Format {
+	case GeneratePassword:
+		data, err = s.generatePassword()
+	case GenerateHex:
+		data, err = s.generateHex()
+	case GenerateRSA:
+		data, err = s.generateRSA()
+	case GenerateEd25519:
+		data, err = s.generateEd25519()
+	}
+	if err != nil {
+		return nil, errors.Errorf("generating %q secret value: %w", s.Format, err)
+	}
+
+	result := make(SecretData, len(data))
+	for k, v := range data {
+		result[k] = base64.StdEncoding.EncodeToString(v)
+	}
+	return result, nil
+}
+
+func (s GeneratorSpec) generatePassword() (map[string][]byte, error) {
+	length := s.Length
+	if length == 0 {
+		length = defaultPasswordLength
+	}
+	charset := []rune(s.Charset)
+	if len(charset) == 0 {
+		charset = []rune(DefaultPasswordCharset)
+	}
+
+	size := big.NewInt(int64(len(charset)))
+	password := make([]rune, length)
+	for i := range password {
+		n, err := rand.Int(rand.Reader, size)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		password[i] = charset[n.Int64()]
+	}
+	return map[string][]byte{GeneratedValueKey: []byte(string(password))}, nil
+}
+
+func (s GeneratorSpec) generateHex() (map[string][]byte, error) {
+	length := s.Length
+	if length == 0 {
+		length = defaultHexLength
+	}
+	buf := make([]byte, (length+1)/2)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, errors.Capture(err)
+	}
+	value := hex.EncodeToString(buf)[:length]
+	return map[string][]byte{GeneratedValueKey: []byte(value)}, nil
+}
+
+func (s GeneratorSpec) generateRSA() (map[string][]byte, error) {
+	bits := s.Length
+	if bits == 0 {
+		bits = defaultRSABits
+	}
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return encodeKeyPair(key, &key.PublicKey)
+}
+
+func (s GeneratorSpec) generateEd25519() (map[string][]byte, error) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return encodeKeyPair(private, public)
+}
+
+// encodeKeyPair returns the PKCS #8 private key and
+// PKIX public key of a key pair, as PEM blocks.
+func encodeKeyPair(private, public any) (map[string][]byte, error) {
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return map[string][]byte{
+		GeneratedPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}),
+		GeneratedPublicKeyKey:  pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}),
+	}, nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package secrets_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"regexp"
+	"testing"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+)
+
+type GenerateSuite struct{}
+
+func TestGenerateSuite(t *testing.T) {
+	tc.Run(t, &GenerateSuite{})
+}
+
+func (s *GenerateSuite) TestValidate(c *tc.C) {
+	for _, spec := range []secrets.GeneratorSpec{
+		{Format: secrets.GeneratePassword},
+		{Format: secrets.GeneratePassword, Length: 8, Charset: "ab!"},
+		{Format: secrets.GenerateHex, Length: 1024},
+		{Format: secrets.GenerateRSA, Length: 2048},
+		{Format: secrets.GenerateEd25519},
+	} {
+		c.Check(spec.Validate(), tc.ErrorIsNil, tc.Commentf("%+v", spec))
+	}
+}
+
+func (s *GenerateSuite) TestValidateInvalid(c *tc.C) {
+	for _, t := range []struct {
+		spec secrets.GeneratorSpec
+		err  string
+	}{{
+		spec: secrets.GeneratorSpec{Format: "foo"},
+		err:  `generator format "foo" not valid`,
+	}, {
+		spec: secrets.GeneratorSpec{Format: secrets.GeneratePassword, Length: 7},
+		err:  `"password" generator length 7, must be between 8 and 1024 not valid`,
+	}, {
+		spec: secrets.GeneratorSpec{Format: secrets.GenerateHex, Length: -1},
+		err:  `negative generator length not valid`,
+	}, {
+		spec: secrets.GeneratorSpec{Format: secrets.GeneratePassword, Charset: "a b"},
+		err:  `charset "a b", must only contain printable ASCII characters not valid`,
+	}, {
+		spec: secrets.GeneratorSpec{Format: secrets.GenerateHex, Charset: "abc"},
+		err:  `charset for "hex" generator not valid`,
+	}, {
+		spec: secrets.GeneratorSpec{Format: secrets.GenerateRSA, Length: 1024},
+		err:  `RSA key size 1024, must be between 2048 and 8192 bits not valid`,
+	}, {
+		spec: secrets.GeneratorSpec{Format: secrets.GenerateEd25519, Length: 256},
+		err:  `length for "ed25519" generator not valid`,
+	}} {
+		err := t.spec.Validate()
+		c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+		c.Check(err, tc.ErrorMatches, t.err)
+	}
+}
+
+func (s *GenerateSuite) TestGeneratePassword(c *tc.C) {
+	data, err := secrets.GeneratorSpec{Format: secrets.GeneratePassword}.Generate()
+	c.Assert(err, tc.ErrorIsNil)
+	value, err := secrets.NewSecretValue(data).KeyValue(secrets.GeneratedValueKey)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(value, tc.Matches, "[a-zA-Z0-9]{32}")
+
+	data2, err := secrets.GeneratorSpec{Format: secrets.GeneratePassword}.Generate()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(data2, tc.Not(tc.DeepEquals), data)
+}
+
+func (s *GenerateSuite) TestGeneratePasswordCharset(c *tc.C) {
+	data, err := secrets.GeneratorSpec{Format: secrets.GeneratePassword, Length: 16, Charset: "xy!"}.Generate()
+	c.Assert(err, tc.ErrorIsNil)
+	value, err := secrets.NewSecretValue(data).KeyValue(secrets.GeneratedValueKey)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(value, tc.Matches, "[xy!]{16}")
+}
+
+func (s *GenerateSuite) TestGenerateHex(c *tc.C) {
+	data, err := secrets.GeneratorSpec{Format: secrets.GenerateHex, Length: 9}.Generate()
+	c.Assert(err, tc.ErrorIsNil)
+	value, err := secrets.NewSecretValue(data).KeyValue(secrets.GeneratedValueKey)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(regexp.MustCompile("^[0-9a-f]{9}$").MatchString(value), tc.IsTrue)
+}
+
+func (s *GenerateSuite) TestGenerateRSA(c *tc.C) {
+	data, err := secrets.GeneratorSpec{Format: secrets.GenerateRSA, Length: 2048}.Generate()
+	c.Assert(err, tc.ErrorIsNil)
+
+	private, public := s.parseKeyPair(c, data)
+	rsaKey, ok := private.(*rsa.PrivateKey)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(rsaKey.N.BitLen(), tc.Equals, 2048)
+	c.Check(rsaKey.Public().(*rsa.PublicKey).Equal(public), tc.IsTrue)
+}
+
+func (s *GenerateSuite) TestGenerateEd25519(c *tc.C) {
+	data, err := secrets.GeneratorSpec{Format: secrets.GenerateEd25519}.Generate()
+	c.Assert(err, tc.ErrorIsNil)
+
+	private, public := s.parseKeyPair(c, data)
+	edKey, ok := private.(ed25519.PrivateKey)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(edKey.Public().(ed25519.PublicKey).Equal(public), tc.IsTrue)
+}
+
+func (s *GenerateSuite) parseKeyPair(c *tc.C, data secrets.SecretData) (any, any) {
+	values, err := secrets.NewSecretValue(data).Values()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(values, tc.HasLen, 2)
+
+	block, _ := pem.Decode([]byte(values[secrets.GeneratedPrivateKeyKey]))
+	c.Assert(block, tc.NotNil)
+	c.Check(block.Type, tc.Equals, "PRIVATE KEY")
+	private, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	c.Assert(err, tc.ErrorIsNil)
+
+	block, _ = pem.Decode([]byte(values[secrets.GeneratedPublicKeyKey]))
+	c.Assert(block, tc.NotNil)
+	c.Check(block.Type, tc.Equals, "PUBLIC KEY")
+	public, err := x509.ParsePKIXPublicKey(block.Bytes)
+	c.Assert(err, tc.ErrorIsNil)
+	return private, public
+}
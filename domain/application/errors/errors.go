@@ -284,6 +284,15 @@ const (
 	// supported.
 	InvalidApplicationConstraints = errors.ConstError("invalid application constraints")
 
+	// PlacementRulesNotValid describes an error that occurs when the
+	// placement rules for an application are not valid.
+	PlacementRulesNotValid = errors.ConstError("placement rules not valid")
+
+	// PlacementRuleViolated describes an error that occurs when a unit can
+	// not be placed on the requested machine without breaking the placement
+	// rules of its application, or of another application on the machine.
+	PlacementRuleViolated = errors.ConstError("placement rule violated")
+
 	// InvalidUnitConstraints describes an error that occurs when the
 	// application constraints are not valid. This happens when if the
 	// provided space constraints do not exist or the container type is not
@@ -0,0 +1,67 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	coremachine "github.com/juju/juju/core/machine"
+	coreunit "github.com/juju/juju/core/unit"
+)
+
+// PlacementRules describes where the units of an application may be placed,
+// relative to each other and to the units of other applications.
+type PlacementRules struct {
+	// MaxUnitsPerMachine is the maximum number of units of the application
+	// that may share a machine, including any containers on the machine.
+	// Zero means there is no limit.
+	MaxUnitsPerMachine int
+
+	// MaxUnitsPerZone is the maximum number of units of the application
+	// that may share an availability zone. Zero means there is no limit.
+	MaxUnitsPerZone int
+
+	// AntiAffinity holds the names of the applications whose units must
+	// never share a machine with the units of the application.
+	AntiAffinity []string
+
+	// Affinity holds the names of the applications whose units must be
+	// present on every machine the units of the application are placed on.
+	Affinity []string
+}
+
+// IsEmpty returns true if the rules place no restrictions on the units of an
+// application.
+func (r PlacementRules) IsEmpty() bool {
+	return r.MaxUnitsPerMachine == 0 &&
+		r.MaxUnitsPerZone == 0 &&
+		len(r.AntiAffinity) == 0 &&
+		len(r.Affinity) == 0
+}
+
+// PlacementViolation describes a unit whose current placement breaks the
+// placement rules of its application.
+type PlacementViolation struct {
+	// Unit is the name of the unit that is misplaced.
+	Unit coreunit.Name
+
+	// Machine is the name of the machine the unit is placed on.
+	Machine coremachine.Name
+
+	// Reason describes the rule that is broken.
+	Reason string
+}
+
+// MachineZoneRules describes how the placement rules of the applications with
+// units on a machine restrict the availability zone the machine is started
+// in.
+type MachineZoneRules struct {
+	// ExcludedZones holds the names of the zones the machine must not be
+	// started in, as doing so would exceed the maximum number of units per
+	// zone of an application with units on the machine.
+	ExcludedZones []string
+
+	// AntiAffinityMachines holds the names of the machines hosting units
+	// that must not share a machine with the units on the machine. Zones
+	// hosting these machines are avoided where possible.
+	AntiAffinityMachines []coremachine.Name
+}
@@ -5,6 +5,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"maps"
 	"strconv"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/juju/juju/core/os/ostype"
 	"github.com/juju/juju/core/resource"
 	"github.com/juju/juju/core/secrets"
+	corestatus "github.com/juju/juju/core/status"
 	"github.com/juju/juju/core/trace"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/watcher/eventsource"
@@ -40,6 +42,7 @@ import (
 	charmresource "github.com/juju/juju/domain/deployment/charm/resource"
 	"github.com/juju/juju/domain/life"
 	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	"github.com/juju/juju/domain/status"
 	domainstorage "github.com/juju/juju/domain/storage"
 	"github.com/juju/juju/internal/errors"
 )
@@ -455,6 +458,19 @@ type ApplicationState interface {
 	// is returned if the application doesn't exist.
 	ShouldAllowCharmUpgradeOnError(ctx context.Context, appName string) (bool, error)
 
+	// RenameApplication changes the name of the application. The unit
+	// sequence and the units of the application move to the new name with
+	// it.
+	//
+	// The following errors may be returned:
+	//   - [applicationerrors.ApplicationNotFound] if the application doesn't
+	//     exist.
+	//   - [applicationerrors.ApplicationNotAlive] if the application is not
+	//     alive.
+	//   - [applicationerrors.ApplicationAlreadyExists] if an application with
+	//     the new name already exists.
+	RenameApplication(ctx context.Context, name, newName string) error
+
 	// IsControllerApplication returns true when the application is the controller.
 	IsControllerApplication(ctx context.Context, appUUID coreapplication.UUID) (bool, error)
 
@@ -470,6 +486,31 @@ type ApplicationState interface {
 
 	// GetModelType returns the model type for the current model.
 	GetModelType(ctx context.Context) (model.ModelType, error)
+
+	// SetApplicationPlacementRules replaces the placement rules of the
+	// application.
+	// The following errors may be returned:
+	//   - [applicationerrors.ApplicationNotFound] if the application, or one
+	//     of the applications referenced by the rules, does not exist.
+	SetApplicationPlacementRules(ctx context.Context, appUUID coreapplication.UUID, rules application.PlacementRules) error
+
+	// GetApplicationPlacementRules returns the placement rules of the
+	// application.
+	// The following errors may be returned:
+	//   - [applicationerrors.ApplicationNotFound] if the application does not
+	//     exist.
+	GetApplicationPlacementRules(ctx context.Context, appUUID coreapplication.UUID) (application.PlacementRules, error)
+
+	// GetAllApplicationPlacementViolations returns the units whose current
+	// placement breaks the placement rules, indexed by application name.
+	GetAllApplicationPlacementViolations(ctx context.Context) (map[string][]application.PlacementViolation, error)
+
+	// GetMachinePlacementZoneRules returns the restrictions the placement
+	// rules of the applications with units on the named machine place on
+	// the zone the machine is started in.
+	// The following errors may be returned:
+	//   - [machineerrors.MachineNotFound] if the machine does not exist.
+	GetMachinePlacementZoneRules(ctx context.Context, machineName string) (application.MachineZoneRules, error)
 }
 
 func validateCharmAndApplicationParams(
@@ -1004,6 +1045,59 @@ func (s *Service) SetApplicationScale(ctx context.Context, appName string, scale
 	return nil
 }
 
+// RenameApplication changes the name of an application. The application keeps
+// its uuid, charm and config, along with its relations, offers and secrets.
+// Its units are renamed with it, e.g. foo/0 becomes bar/0. The rename is
+// recorded in the status history of the application under its new name.
+//
+// Applications in kubernetes models can't be renamed, as resources in the
+// cluster are named after them.
+//
+// The following errors may be returned:
+//   - [applicationerrors.ApplicationNameNotValid] if either name is not valid.
+//   - [applicationerrors.ApplicationNotFound] if the application doesn't exist.
+//   - [applicationerrors.ApplicationNotAlive] if the application is not alive.
+//   - [applicationerrors.ApplicationAlreadyExists] if an application with the
+//     new name already exists.
+//   - [coreerrors.NotSupported] if the model is a kubernetes model.
+func (s *Service) RenameApplication(ctx context.Context, name, newName string) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if !application.IsValidApplicationName(name) {
+		return errors.Errorf("application name %q", name).Add(applicationerrors.ApplicationNameNotValid)
+	}
+	if !application.IsValidApplicationName(newName) {
+		return errors.Errorf("application name %q", newName).Add(applicationerrors.ApplicationNameNotValid)
+	}
+	if name == newName {
+		return nil
+	}
+
+	modelType, err := s.st.GetModelType(ctx)
+	if err != nil {
+		return errors.Errorf("getting model type: %w", err)
+	}
+	if modelType == model.CAAS {
+		return errors.Errorf(
+			"renaming application %q in a kubernetes model", name,
+		).Add(coreerrors.NotSupported)
+	}
+
+	if err := s.st.RenameApplication(ctx, name, newName); err != nil {
+		return errors.Errorf("renaming application %q to %q: %w", name, newName, err)
+	}
+
+	if err := s.statusHistory.RecordStatus(ctx, status.ApplicationNamespace.WithID(newName), corestatus.StatusInfo{
+		Status:  corestatus.Unknown,
+		Message: fmt.Sprintf("renamed from %q", name),
+		Since:   new(s.clock.Now()),
+	}); err != nil {
+		s.logger.Warningf(ctx, "recording rename of application %q: %v", newName, err)
+	}
+	return nil
+}
+
 // GetApplicationScale returns the desired scale of an application,
 // The following errors may be returned:
 // - [applicationerrors.ApplicationNotFound] if the application doesn't exist
@@ -30,6 +30,7 @@ import (
 	networktesting "github.com/juju/juju/core/network/testing"
 	objectstoretesting "github.com/juju/juju/core/objectstore/testing"
 	"github.com/juju/juju/core/os/ostype"
+	corestatus "github.com/juju/juju/core/status"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain"
 	"github.com/juju/juju/domain/application"
@@ -42,6 +43,7 @@ import (
 	"github.com/juju/juju/domain/deployment"
 	internalcharm "github.com/juju/juju/domain/deployment/charm"
 	"github.com/juju/juju/domain/life"
+	"github.com/juju/juju/domain/status"
 	domainstorage "github.com/juju/juju/domain/storage"
 	domaintesting "github.com/juju/juju/domain/testing"
 	"github.com/juju/juju/internal/errors"
@@ -115,6 +117,51 @@ func (s *applicationServiceSuite) TestGetApplicationNameNotFound(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
 }
 
+func (s *applicationServiceSuite) TestRenameApplication(c *tc.C) {
+	var statusHistory *MockStatusHistory
+	defer s.setupMocksWithStatusHistory(c, func(c *gomock.Controller) StatusHistory {
+		statusHistory = NewMockStatusHistory(c)
+		return statusHistory
+	}).Finish()
+
+	s.state.EXPECT().GetModelType(gomock.Any()).Return(model.IAAS, nil)
+	s.state.EXPECT().RenameApplication(gomock.Any(), "foo", "bar").Return(nil)
+	statusHistory.EXPECT().RecordStatus(gomock.Any(), status.ApplicationNamespace.WithID("bar"), corestatus.StatusInfo{
+		Status:  corestatus.Unknown,
+		Message: `renamed from "foo"`,
+		Since:   new(s.clock.Now()),
+	})
+
+	err := s.service.RenameApplication(c.Context(), "foo", "bar")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *applicationServiceSuite) TestRenameApplicationNameNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.RenameApplication(c.Context(), "foo", "Not_Valid")
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNameNotValid)
+}
+
+func (s *applicationServiceSuite) TestRenameApplicationCAAS(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetModelType(gomock.Any()).Return(model.CAAS, nil)
+
+	err := s.service.RenameApplication(c.Context(), "foo", "bar")
+	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
+}
+
+func (s *applicationServiceSuite) TestRenameApplicationNotAlive(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetModelType(gomock.Any()).Return(model.IAAS, nil)
+	s.state.EXPECT().RenameApplication(gomock.Any(), "foo", "bar").Return(applicationerrors.ApplicationNotAlive)
+
+	err := s.service.RenameApplication(c.Context(), "foo", "bar")
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotAlive)
+}
+
 func (s *applicationServiceSuite) TestGetApplicationUUIDByName(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
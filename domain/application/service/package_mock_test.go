@@ -377,6 +377,7 @@ type MockStateMockRecorder struct {
 	createIAASApplicationExpects                              []*gomock.Call4_3[context.Context, string, application0.AddIAASApplicationArg, []application0.AddIAASUnitArg, application.UUID, []machine.Name, error]
 	endpointsExistExpects                                     []*gomock.Call3_1[context.Context, application.UUID, set.Strings, error]
 	getAddressesHashExpects                                   []*gomock.Call3_2[context.Context, application.UUID, string, string, error]
+	getAllApplicationPlacementViolationsExpects               []*gomock.Call1_2[context.Context, map[string][]application0.PlacementViolation, error]
 	getAllEndpointBindingsExpects                             []*gomock.Call1_2[context.Context, map[string]map[string]string, error]
 	getAllExposedEndpointsExpects                             []*gomock.Call1_2[context.Context, map[string]map[string]application0.ExposedEndpoint, error]
 	getAllUnitK8sPodIDsForApplicationExpects                  []*gomock.Call2_2[context.Context, application.UUID, map[unit.Name]string, error]
@@ -394,6 +395,7 @@ type MockStateMockRecorder struct {
 	getApplicationLifeExpects                                 []*gomock.Call2_2[context.Context, application.UUID, life.Life, error]
 	getApplicationLifeByNameExpects                           []*gomock.Call2_3[context.Context, string, application.UUID, life.Life, error]
 	getApplicationNameExpects                                 []*gomock.Call2_2[context.Context, application.UUID, string, error]
+	getApplicationPlacementRulesExpects                       []*gomock.Call2_2[context.Context, application.UUID, application0.PlacementRules, error]
 	getApplicationScaleStateExpects                           []*gomock.Call2_2[context.Context, application.UUID, application0.ScaleState, error]
 	getApplicationTrustSettingExpects                         []*gomock.Call2_2[context.Context, application.UUID, bool, error]
 	getApplicationUUIDAndNameByUnitNameExpects                []*gomock.Call2_3[context.Context, unit.Name, application.UUID, string, error]
@@ -430,6 +432,7 @@ type MockStateMockRecorder struct {
 	getIAASUnitContextExpects                                 []*gomock.Call2_2[context.Context, string, internal.IAASUnitContext, error]
 	getLatestPendingCharmhubCharmExpects                      []*gomock.Call3_2[context.Context, string, architecture.Architecture, charm0.CharmLocator, error]
 	getMachineNetNodeUUIDFromNameExpects                      []*gomock.Call2_2[context.Context, machine.Name, string, error]
+	getMachinePlacementZoneRulesExpects                       []*gomock.Call2_2[context.Context, string, application0.MachineZoneRules, error]
 	getMachineUUIDAndNetNodeForNameExpects                    []*gomock.Call2_3[context.Context, string, machine.UUID, network0.NetNodeUUID, error]
 	getMachinesForApplicationExpects                          []*gomock.Call2_2[context.Context, string, []string, error]
 	getModelConstraintsExpects                                []*gomock.Call1_2[context.Context, constraints0.Constraints, error]
@@ -488,11 +491,13 @@ type MockStateMockRecorder struct {
 	namespaceForWatchNetNodeAddressExpects                    []*gomock.Call0_1[string]
 	namespaceForWatchUnitForLegacyUniterExpects               []*gomock.Call0_3[string, string, string]
 	registerCAASUnitExpects                                   []*gomock.Call3_1[context.Context, string, application0.RegisterCAASUnitArg, error]
+	renameApplicationExpects                                  []*gomock.Call3_1[context.Context, string, string, error]
 	resolveCharmDownloadExpects                               []*gomock.Call3_1[context.Context, charm.ID, application0.ResolvedCharmDownload, error]
 	resolveMigratingUploadedCharmExpects                      []*gomock.Call3_2[context.Context, charm.ID, charm0.ResolvedMigratingUploadedCharm, charm0.CharmLocator, error]
 	setApplicationCharmExpects                                []*gomock.Call4_1[context.Context, application.UUID, charm.ID, application0.SetCharmStateParams, error]
 	setApplicationConstraintsExpects                          []*gomock.Call3_1[context.Context, application.UUID, constraints0.Constraints, error]
 	setApplicationHasK8sResourcesExpects                      []*gomock.Call2_1[context.Context, application.UUID, error]
+	setApplicationPlacementRulesExpects                       []*gomock.Call3_1[context.Context, application.UUID, application0.PlacementRules, error]
 	setApplicationScalingStateExpects                         []*gomock.Call4_1[context.Context, string, int, bool, error]
 	setCharmAvailableExpects                                  []*gomock.Call2_1[context.Context, charm.ID, error]
 	setDesiredApplicationScaleExpects                         []*gomock.Call3_1[context.Context, application.UUID, int, error]
@@ -739,6 +744,24 @@ func (mr *MockStateMockRecorder) GetAddressesHash(ctx, appUUID, netNodeUUID any)
 // MockStateGetAddressesHashCall is the typed call wrapper for GetAddressesHash.
 type MockStateGetAddressesHashCall = gomock.Call3_2[context.Context, application.UUID, string, string, error]
 
+// GetAllApplicationPlacementViolations mocks base method.
+func (m *MockState) GetAllApplicationPlacementViolations(ctx context.Context) (map[string][]application0.PlacementViolation, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getAllApplicationPlacementViolationsExpects, m.ctrl, m, "GetAllApplicationPlacementViolations", ctx)
+}
+
+// GetAllApplicationPlacementViolations indicates an expected call of GetAllApplicationPlacementViolations.
+func (mr *MockStateMockRecorder) GetAllApplicationPlacementViolations(ctx any) *MockStateGetAllApplicationPlacementViolationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, map[string][]application0.PlacementViolation, error](mr.mock.ctrl.T, mr.mock, "GetAllApplicationPlacementViolations", gomock.EnsureMatcher(ctx))
+	mr.getAllApplicationPlacementViolationsExpects = append(mr.getAllApplicationPlacementViolationsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetAllApplicationPlacementViolationsCall is the typed call wrapper for GetAllApplicationPlacementViolations.
+type MockStateGetAllApplicationPlacementViolationsCall = gomock.Call1_2[context.Context, map[string][]application0.PlacementViolation, error]
+
 // GetAllEndpointBindings mocks base method.
 func (m *MockState) GetAllEndpointBindings(arg0 context.Context) (map[string]map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -1045,6 +1068,24 @@ func (mr *MockStateMockRecorder) GetApplicationName(arg0, arg1 any) *MockStateGe
 // MockStateGetApplicationNameCall is the typed call wrapper for GetApplicationName.
 type MockStateGetApplicationNameCall = gomock.Call2_2[context.Context, application.UUID, string, error]
 
+// GetApplicationPlacementRules mocks base method.
+func (m *MockState) GetApplicationPlacementRules(ctx context.Context, appUUID application.UUID) (application0.PlacementRules, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getApplicationPlacementRulesExpects, m.ctrl, m, "GetApplicationPlacementRules", ctx, appUUID)
+}
+
+// GetApplicationPlacementRules indicates an expected call of GetApplicationPlacementRules.
+func (mr *MockStateMockRecorder) GetApplicationPlacementRules(ctx, appUUID any) *MockStateGetApplicationPlacementRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, application.UUID, application0.PlacementRules, error](mr.mock.ctrl.T, mr.mock, "GetApplicationPlacementRules", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appUUID))
+	mr.getApplicationPlacementRulesExpects = append(mr.getApplicationPlacementRulesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetApplicationPlacementRulesCall is the typed call wrapper for GetApplicationPlacementRules.
+type MockStateGetApplicationPlacementRulesCall = gomock.Call2_2[context.Context, application.UUID, application0.PlacementRules, error]
+
 // GetApplicationScaleState mocks base method.
 func (m *MockState) GetApplicationScaleState(arg0 context.Context, arg1 application.UUID) (application0.ScaleState, error) {
 	m.ctrl.T.Helper()
@@ -1694,6 +1735,24 @@ func (mr *MockStateMockRecorder) GetMachineNetNodeUUIDFromName(arg0, arg1 any) *
 // MockStateGetMachineNetNodeUUIDFromNameCall is the typed call wrapper for GetMachineNetNodeUUIDFromName.
 type MockStateGetMachineNetNodeUUIDFromNameCall = gomock.Call2_2[context.Context, machine.Name, string, error]
 
+// GetMachinePlacementZoneRules mocks base method.
+func (m *MockState) GetMachinePlacementZoneRules(ctx context.Context, machineName string) (application0.MachineZoneRules, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getMachinePlacementZoneRulesExpects, m.ctrl, m, "GetMachinePlacementZoneRules", ctx, machineName)
+}
+
+// GetMachinePlacementZoneRules indicates an expected call of GetMachinePlacementZoneRules.
+func (mr *MockStateMockRecorder) GetMachinePlacementZoneRules(ctx, machineName any) *MockStateGetMachinePlacementZoneRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, application0.MachineZoneRules, error](mr.mock.ctrl.T, mr.mock, "GetMachinePlacementZoneRules", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(machineName))
+	mr.getMachinePlacementZoneRulesExpects = append(mr.getMachinePlacementZoneRulesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetMachinePlacementZoneRulesCall is the typed call wrapper for GetMachinePlacementZoneRules.
+type MockStateGetMachinePlacementZoneRulesCall = gomock.Call2_2[context.Context, string, application0.MachineZoneRules, error]
+
 // GetMachineUUIDAndNetNodeForName mocks base method.
 func (m *MockState) GetMachineUUIDAndNetNodeForName(arg0 context.Context, arg1 string) (machine.UUID, network0.NetNodeUUID, error) {
 	m.ctrl.T.Helper()
@@ -2740,6 +2799,24 @@ func (mr *MockStateMockRecorder) RegisterCAASUnit(arg0, arg1, arg2 any) *MockSta
 // MockStateRegisterCAASUnitCall is the typed call wrapper for RegisterCAASUnit.
 type MockStateRegisterCAASUnitCall = gomock.Call3_1[context.Context, string, application0.RegisterCAASUnitArg, error]
 
+// RenameApplication mocks base method.
+func (m *MockState) RenameApplication(ctx context.Context, name, newName string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.renameApplicationExpects, m.ctrl, m, "RenameApplication", ctx, name, newName)
+}
+
+// RenameApplication indicates an expected call of RenameApplication.
+func (mr *MockStateMockRecorder) RenameApplication(ctx, name, newName any) *MockStateRenameApplicationCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, string, string, error](mr.mock.ctrl.T, mr.mock, "RenameApplication", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name), gomock.EnsureMatcher(newName))
+	mr.renameApplicationExpects = append(mr.renameApplicationExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateRenameApplicationCall is the typed call wrapper for RenameApplication.
+type MockStateRenameApplicationCall = gomock.Call3_1[context.Context, string, string, error]
+
 // ResolveCharmDownload mocks base method.
 func (m *MockState) ResolveCharmDownload(ctx context.Context, charmID charm.ID, info application0.ResolvedCharmDownload) error {
 	m.ctrl.T.Helper()
@@ -2830,6 +2907,24 @@ func (mr *MockStateMockRecorder) SetApplicationHasK8sResources(ctx, appUUID any)
 // MockStateSetApplicationHasK8sResourcesCall is the typed call wrapper for SetApplicationHasK8sResources.
 type MockStateSetApplicationHasK8sResourcesCall = gomock.Call2_1[context.Context, application.UUID, error]
 
+// SetApplicationPlacementRules mocks base method.
+func (m *MockState) SetApplicationPlacementRules(ctx context.Context, appUUID application.UUID, rules application0.PlacementRules) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.setApplicationPlacementRulesExpects, m.ctrl, m, "SetApplicationPlacementRules", ctx, appUUID, rules)
+}
+
+// SetApplicationPlacementRules indicates an expected call of SetApplicationPlacementRules.
+func (mr *MockStateMockRecorder) SetApplicationPlacementRules(ctx, appUUID, rules any) *MockStateSetApplicationPlacementRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, application.UUID, application0.PlacementRules, error](mr.mock.ctrl.T, mr.mock, "SetApplicationPlacementRules", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appUUID), gomock.EnsureMatcher(rules))
+	mr.setApplicationPlacementRulesExpects = append(mr.setApplicationPlacementRulesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateSetApplicationPlacementRulesCall is the typed call wrapper for SetApplicationPlacementRules.
+type MockStateSetApplicationPlacementRulesCall = gomock.Call3_1[context.Context, application.UUID, application0.PlacementRules, error]
+
 // SetApplicationScalingState mocks base method.
 func (m *MockState) SetApplicationScalingState(ctx context.Context, appName string, targetScale int, scaling bool) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,134 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/juju/collections/set"
+
+	coremachine "github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// SetApplicationPlacementRules replaces the placement rules of the named
+// application. The rules are enforced when units of the application are added
+// to existing machines. Empty rules remove any existing rules.
+//
+// The following errors may be returned:
+//   - [applicationerrors.ApplicationNameNotValid] if the application name is
+//     not valid.
+//   - [applicationerrors.PlacementRulesNotValid] if the rules are not valid.
+//   - [applicationerrors.ApplicationNotFound] if the application, or one of
+//     the applications referenced by the rules, does not exist.
+func (s *Service) SetApplicationPlacementRules(ctx context.Context, appName string, rules application.PlacementRules) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if !application.IsValidApplicationName(appName) {
+		return applicationerrors.ApplicationNameNotValid
+	}
+	if err := validatePlacementRules(appName, rules); err != nil {
+		return errors.Capture(err)
+	}
+
+	appUUID, err := s.st.GetApplicationUUIDByName(ctx, appName)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	if err := s.st.SetApplicationPlacementRules(ctx, appUUID, rules); err != nil {
+		return errors.Errorf("setting placement rules for application %q: %w", appName, err)
+	}
+	return nil
+}
+
+// GetApplicationPlacementRules returns the placement rules of the named
+// application.
+//
+// The following errors may be returned:
+//   - [applicationerrors.ApplicationNameNotValid] if the application name is
+//     not valid.
+//   - [applicationerrors.ApplicationNotFound] if the application does not
+//     exist.
+func (s *Service) GetApplicationPlacementRules(ctx context.Context, appName string) (application.PlacementRules, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if !application.IsValidApplicationName(appName) {
+		return application.PlacementRules{}, applicationerrors.ApplicationNameNotValid
+	}
+
+	appUUID, err := s.st.GetApplicationUUIDByName(ctx, appName)
+	if err != nil {
+		return application.PlacementRules{}, errors.Capture(err)
+	}
+
+	rules, err := s.st.GetApplicationPlacementRules(ctx, appUUID)
+	return rules, errors.Capture(err)
+}
+
+// GetAllApplicationPlacementViolations returns the units whose current
+// placement breaks the placement rules of their application, or the
+// anti-affinity rules of another application, indexed by application name.
+// Units can be misplaced when the rules are changed after the units were
+// added.
+func (s *Service) GetAllApplicationPlacementViolations(ctx context.Context) (map[string][]application.PlacementViolation, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	violations, err := s.st.GetAllApplicationPlacementViolations(ctx)
+	return violations, errors.Capture(err)
+}
+
+// GetMachinePlacementZoneRules returns the restrictions the placement rules
+// of the applications with units on the named machine place on the
+// availability zone the machine is started in. Provisioners use this to
+// enforce the rules for units that were not explicitly placed.
+//
+// The following errors may be returned:
+//   - [machineerrors.MachineNotFound] if the machine does not exist.
+func (s *Service) GetMachinePlacementZoneRules(ctx context.Context, machineName coremachine.Name) (application.MachineZoneRules, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := machineName.Validate(); err != nil {
+		return application.MachineZoneRules{}, errors.Capture(err)
+	}
+
+	rules, err := s.st.GetMachinePlacementZoneRules(ctx, machineName.String())
+	return rules, errors.Capture(err)
+}
+
+func validatePlacementRules(appName string, rules application.PlacementRules) error {
+	if rules.MaxUnitsPerMachine < 0 {
+		return errors.Errorf("negative max units per machine %d", rules.MaxUnitsPerMachine).
+			Add(applicationerrors.PlacementRulesNotValid)
+	}
+	if rules.MaxUnitsPerZone < 0 {
+		return errors.Errorf("negative max units per zone %d", rules.MaxUnitsPerZone).
+			Add(applicationerrors.PlacementRulesNotValid)
+	}
+
+	seen := set.NewStrings()
+	for _, name := range slices.Concat(rules.AntiAffinity, rules.Affinity) {
+		switch {
+		case !application.IsValidApplicationName(name):
+			return errors.Errorf("application name %q not valid", name).
+				Add(applicationerrors.PlacementRulesNotValid)
+		case name == appName:
+			return errors.Errorf("application %q can not reference itself", name).
+				Add(applicationerrors.PlacementRulesNotValid)
+		case seen.Contains(name):
+			return errors.Errorf("application %q referenced more than once", name).
+				Add(applicationerrors.PlacementRulesNotValid)
+		}
+		seen.Add(name)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/tc"
+
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+)
+
+type placementServiceSuite struct {
+	baseSuite
+}
+
+func TestPlacementServiceSuite(t *testing.T) {
+	tc.Run(t, &placementServiceSuite{})
+}
+
+func (s *placementServiceSuite) TestSetApplicationPlacementRules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	rules := application.PlacementRules{
+		MaxUnitsPerMachine: 1,
+		AntiAffinity:       []string{"bar"},
+		Affinity:           []string{"baz"},
+	}
+	appUUID := tc.Must(c, coreapplication.NewUUID)
+	s.state.EXPECT().GetApplicationUUIDByName(gomock.Any(), "foo").Return(appUUID, nil)
+	s.state.EXPECT().SetApplicationPlacementRules(gomock.Any(), appUUID, rules).Return(nil)
+
+	err := s.service.SetApplicationPlacementRules(c.Context(), "foo", rules)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *placementServiceSuite) TestSetApplicationPlacementRulesNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	for i, rules := range []application.PlacementRules{
+		{MaxUnitsPerMachine: -1},
+		{MaxUnitsPerZone: -1},
+		{AntiAffinity: []string{"foo"}},
+		{AntiAffinity: []string{"bar"}, Affinity: []string{"bar"}},
+		{Affinity: []string{"!!"}},
+	} {
+		c.Logf("test %d", i)
+		err := s.service.SetApplicationPlacementRules(c.Context(), "foo", rules)
+		c.Check(err, tc.ErrorIs, applicationerrors.PlacementRulesNotValid)
+	}
+}
+
+func (s *placementServiceSuite) TestSetApplicationPlacementRulesApplicationNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationUUIDByName(gomock.Any(), "foo").Return("", applicationerrors.ApplicationNotFound)
+
+	err := s.service.SetApplicationPlacementRules(c.Context(), "foo", application.PlacementRules{})
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *placementServiceSuite) TestGetApplicationPlacementRules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	rules := application.PlacementRules{MaxUnitsPerZone: 2}
+	appUUID := tc.Must(c, coreapplication.NewUUID)
+	s.state.EXPECT().GetApplicationUUIDByName(gomock.Any(), "foo").Return(appUUID, nil)
+	s.state.EXPECT().GetApplicationPlacementRules(gomock.Any(), appUUID).Return(rules, nil)
+
+	result, err := s.service.GetApplicationPlacementRules(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, rules)
+}
+
+func (s *placementServiceSuite) TestGetAllApplicationPlacementViolations(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	violations := map[string][]application.PlacementViolation{
+		"foo": {{
+			Unit:    "foo/0",
+			Machine: "0",
+			Reason:  "more than 1 unit(s) per machine",
+		}},
+	}
+	s.state.EXPECT().GetAllApplicationPlacementViolations(gomock.Any()).Return(violations, nil)
+
+	result, err := s.service.GetAllApplicationPlacementViolations(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, violations)
+}
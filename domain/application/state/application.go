@@ -699,6 +699,135 @@ WHERE  a.name = $applicationDetails.name;
 	}, nil
 }
 
+// RenameApplication changes the name of the application. The unit sequence of
+// the application moves to the new name with it, and the units of the
+// application are renamed to match, e.g. foo/0 becomes bar/0.
+//
+// The records that hold the application or unit names are updated in the
+// same transaction: the archived relation settings of its units are moved to
+// the new unit names, and the offers of its endpoints are marked as modified
+// so that their watchers see the new application name. Relation keys, secret
+// owners and their labels refer to the application and its units by UUID, so
+// they follow the rename.
+//
+// The following errors may be returned:
+//   - [applicationerrors.ApplicationNotFound] if the application doesn't exist.
+//   - [applicationerrors.ApplicationNotAlive] if the application is not alive.
+//   - [applicationerrors.ApplicationAlreadyExists] if an application with the
+//     new name already exists.
+func (st *State) RenameApplication(ctx context.Context, name, newName string) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	type sequenceRename struct {
+		Namespace    string `db:"namespace"`
+		NewNamespace string `db:"new_namespace"`
+	}
+	// unitRename replaces the "<application>/" prefix of unit names.
+	type unitRename struct {
+		ApplicationUUID string `db:"application_uuid"`
+		Prefix          string `db:"prefix"`
+		PrefixLen       int    `db:"prefix_len"`
+		NewPrefix       string `db:"new_prefix"`
+	}
+
+	renameStmt, err := st.Prepare(`
+UPDATE application
+SET    name = $applicationUUIDAndName.name
+WHERE  uuid = $applicationUUIDAndName.uuid
+`, applicationUUIDAndName{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	renameSequenceStmt, err := st.Prepare(`
+UPDATE sequence
+SET    namespace = $sequenceRename.new_namespace
+WHERE  namespace = $sequenceRename.namespace
+`, sequenceRename{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	renameUnitsStmt, err := st.Prepare(`
+UPDATE unit
+SET    name = $unitRename.new_prefix || substr(name, $unitRename.prefix_len + 1)
+WHERE  application_uuid = $unitRename.application_uuid
+`, unitRename{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	renameArchivedSettingsStmt, err := st.Prepare(`
+UPDATE relation_unit_setting_archive
+SET    unit_name = $unitRename.new_prefix || substr(unit_name, $unitRename.prefix_len + 1)
+WHERE  substr(unit_name, 1, $unitRename.prefix_len) = $unitRename.prefix
+`, unitRename{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	touchOffersStmt, err := st.Prepare(`
+UPDATE offer
+SET    modified_version = modified_version + 1
+WHERE  uuid IN (
+    SELECT oe.offer_uuid
+    FROM   offer_endpoint AS oe
+    JOIN   application_endpoint AS ae ON oe.endpoint_uuid = ae.uuid
+    WHERE  ae.application_uuid = $unitRename.application_uuid
+)
+`, unitRename{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		app, err := st.getApplicationDetails(ctx, tx, name)
+		if err != nil {
+			return errors.Capture(err)
+		} else if app.IsApplicationSynthetic {
+			return errors.Errorf("cannot rename synthetic application %q", name)
+		} else if app.LifeID != life.Alive {
+			return errors.Errorf("application %q is not alive", name).Add(applicationerrors.ApplicationNotAlive)
+		}
+
+		rename := applicationUUIDAndName{ID: app.UUID, Name: newName}
+		err = tx.Query(ctx, renameStmt, rename).Run()
+		if internaldatabase.IsErrConstraintUnique(err) {
+			return errors.Errorf("application %q", newName).Add(applicationerrors.ApplicationAlreadyExists)
+		} else if err != nil {
+			return errors.Errorf("renaming application %q: %w", name, err)
+		}
+
+		seq := sequenceRename{
+			Namespace:    domainsequence.MakePrefixNamespace(application.ApplicationSequenceNamespace, name).String(),
+			NewNamespace: domainsequence.MakePrefixNamespace(application.ApplicationSequenceNamespace, newName).String(),
+		}
+		if err := tx.Query(ctx, renameSequenceStmt, seq).Run(); err != nil {
+			return errors.Errorf("renaming unit sequence of application %q: %w", name, err)
+		}
+
+		units := unitRename{
+			ApplicationUUID: app.UUID,
+			Prefix:          name + "/",
+			PrefixLen:       len(name) + 1,
+			NewPrefix:       newName + "/",
+		}
+		if err := tx.Query(ctx, renameUnitsStmt, units).Run(); err != nil {
+			return errors.Errorf("renaming units of application %q: %w", name, err)
+		}
+		if err := tx.Query(ctx, renameArchivedSettingsStmt, units).Run(); err != nil {
+			return errors.Errorf("renaming archived relation settings of application %q: %w", name, err)
+		}
+		if err := tx.Query(ctx, touchOffersStmt, units).Run(); err != nil {
+			return errors.Errorf("updating offers of application %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
 // IsControllerApplication returns true when the application is the controller.
 func (st *State) IsControllerApplication(ctx context.Context, appID coreapplication.UUID) (bool, error) {
 	db, err := st.DB(ctx)
@@ -3053,7 +3182,13 @@ ON CONFLICT (uuid) DO UPDATE SET
     virt_type = excluded.virt_type,
     allocate_public_ip = excluded.allocate_public_ip,
     image_id = excluded.image_id,
-    ip_family = excluded.ip_family
+    ip_family = excluded.ip_family,
+    market = excluded.market,
+    max_price = excluded.max_price,
+    capacity_reservation = excluded.capacity_reservation,
+    placement_group = excluded.placement_group,
+    gpus = excluded.gpus,
+    gpu_type = excluded.gpu_type
 `
 	insertConstraintsStmt, err := st.Prepare(insertConstraintsQuery, setConstraint{})
 	if err != nil {
@@ -3410,6 +3545,25 @@ func decodeConstraints(cons applicationConstraints) constraints.Constraints {
 			f := ipfamily.IPFamily(row.IPFamily.String)
 			res.IPFamily = &f
 		}
+		if row.Market.Valid {
+			res.Market = &row.Market.String
+		}
+		if row.MaxPrice.Valid {
+			res.MaxPrice = &row.MaxPrice.String
+		}
+		if row.CapacityReservation.Valid {
+			res.CapacityReservation = &row.CapacityReservation.String
+		}
+		if row.PlacementGroup.Valid {
+			res.PlacementGroup = &row.PlacementGroup.String
+		}
+		if row.Gpus.Valid {
+			gpus := uint64(row.Gpus.V)
+			res.Gpus = &gpus
+		}
+		if row.GpuType.Valid {
+			res.GpuType = &row.GpuType.String
+		}
 		if row.SpaceName.Valid {
 			if _, ok := seenSpaces[row.SpaceName.String]; !ok {
 				seenSpaces[row.SpaceName.String] = struct{}{}
@@ -3463,6 +3617,13 @@ func encodeConstraints(constraintUUID string, cons constraints.Constraints, cont
 		VirtType:         cons.VirtType,
 		ImageID:          cons.ImageID,
 		AllocatePublicIP: cons.AllocatePublicIP,
+
+		Market:              cons.Market,
+		MaxPrice:            cons.MaxPrice,
+		CapacityReservation: cons.CapacityReservation,
+		PlacementGroup:      cons.PlacementGroup,
+		Gpus:                cons.Gpus,
+		GpuType:             cons.GpuType,
 	}
 	if cons.IPFamily != nil {
 		s := cons.IPFamily.String()
@@ -42,6 +42,7 @@ import (
 	domainnetwork "github.com/juju/juju/domain/network"
 	removalstatemodel "github.com/juju/juju/domain/removal/state/model"
 	"github.com/juju/juju/domain/resource"
+	domainsequence "github.com/juju/juju/domain/sequence"
 	"github.com/juju/juju/domain/status"
 	statusstate "github.com/juju/juju/domain/status/state/model"
 	"github.com/juju/juju/internal/errors"
@@ -1540,6 +1541,209 @@ func (s *applicationStateSuite) TestGetApplicationScaleStateNotFound(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
 }
 
+func (s *applicationStateSuite) TestRenameApplication(c *tc.C) {
+	appUUID := s.createIAASApplication(c, "foo", life.Alive)
+	namespace := domainsequence.MakePrefixNamespace(application.ApplicationSequenceNamespace, "foo").String()
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "INSERT INTO sequence (namespace, value) VALUES (?, 3)", namespace)
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.RenameApplication(c.Context(), "foo", "bar")
+	c.Assert(err, tc.ErrorIsNil)
+
+	gotUUID, err := s.state.GetApplicationUUIDByName(c.Context(), "bar")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(gotUUID, tc.Equals, appUUID)
+	_, err = s.state.GetApplicationUUIDByName(c.Context(), "foo")
+	c.Check(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+
+	var value int
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, "SELECT value FROM sequence WHERE namespace = ?",
+			domainsequence.MakePrefixNamespace(application.ApplicationSequenceNamespace, "bar").String(),
+		).Scan(&value)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(value, tc.Equals, 3)
+}
+
+func (s *applicationStateSuite) TestRenameApplicationAlreadyExists(c *tc.C) {
+	s.createIAASApplication(c, "foo", life.Alive)
+	s.createIAASApplication(c, "bar", life.Alive)
+
+	err := s.state.RenameApplication(c.Context(), "foo", "bar")
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationAlreadyExists)
+}
+
+func (s *applicationStateSuite) TestRenameApplicationRenamesUnits(c *tc.C) {
+	s.createIAASApplicationWithNUnits(c, "foo", life.Alive, 2)
+	s.createIAASApplicationWithNUnits(c, "foobar", life.Alive, 1)
+
+	err := s.state.RenameApplication(c.Context(), "foo", "bar")
+	c.Assert(err, tc.ErrorIsNil)
+
+	var names []string
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, "SELECT name FROM unit ORDER BY name")
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+		for rows.Next() {
+			var name string
+			if err := rows.Scan(&name); err != nil {
+				return err
+			}
+			names = append(names, name)
+		}
+		return rows.Err()
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(names, tc.DeepEquals, []string{"bar/0", "bar/1", "foobar/0"})
+}
+
+func (s *applicationStateSuite) TestRenameApplicationWithRelations(c *tc.C) {
+	s.createIAASApplicationWithEndpointBindings(c, "foo", life.Alive, nil)
+	s.createIAASApplicationWithEndpointBindings(c, "baz", life.Alive, nil)
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO relation (uuid, life_id, relation_id, scope_id) VALUES ('relation-uuid', 0, 1, 0)`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO relation_endpoint (uuid, relation_uuid, endpoint_uuid)
+SELECT a.name, 'relation-uuid', ae.uuid
+FROM   application_endpoint AS ae
+JOIN   application AS a ON ae.application_uuid = a.uuid
+JOIN   charm_relation AS cr ON ae.charm_relation_uuid = cr.uuid
+WHERE  cr.name = 'endpoint'`); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO relation_unit_setting_archive (relation_uuid, unit_name, "key", value)
+VALUES ('relation-uuid', 'foo/0', 'key', 'foo'),
+       ('relation-uuid', 'baz/0', 'key', 'baz')`)
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.RenameApplication(c.Context(), "foo", "bar")
+	c.Assert(err, tc.ErrorIsNil)
+
+	var appNames, unitNames []string
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		for query, result := range map[string]*[]string{
+			"SELECT application_name FROM v_relation_endpoint_identifier ORDER BY application_name": &appNames,
+			"SELECT unit_name FROM relation_unit_setting_archive ORDER BY unit_name":                &unitNames,
+		} {
+			rows, err := tx.QueryContext(ctx, query)
+			if err != nil {
+				return err
+			}
+			for rows.Next() {
+				var name string
+				if err := rows.Scan(&name); err != nil {
+					_ = rows.Close()
+					return err
+				}
+				*result = append(*result, name)
+			}
+			if err := rows.Close(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(appNames, tc.DeepEquals, []string{"bar", "baz"})
+	c.Check(unitNames, tc.DeepEquals, []string{"bar/0", "baz/0"})
+}
+
+func (s *applicationStateSuite) TestRenameApplicationWithOffers(c *tc.C) {
+	s.createIAASApplicationWithEndpointBindings(c, "foo", life.Alive, nil)
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO offer (uuid, name) VALUES ('offer-uuid', 'foo')`); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO offer_endpoint (offer_uuid, endpoint_uuid)
+SELECT 'offer-uuid', ae.uuid
+FROM   application_endpoint AS ae
+JOIN   charm_relation AS cr ON ae.charm_relation_uuid = cr.uuid
+WHERE  cr.name = 'endpoint'`)
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.RenameApplication(c.Context(), "foo", "bar")
+	c.Assert(err, tc.ErrorIsNil)
+
+	var (
+		offerName, appName string
+		version            int
+	)
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		if err := tx.QueryRowContext(ctx,
+			"SELECT name, modified_version FROM offer WHERE uuid = 'offer-uuid'",
+		).Scan(&offerName, &version); err != nil {
+			return err
+		}
+		return tx.QueryRowContext(ctx,
+			"SELECT DISTINCT application_name FROM v_offer_detail WHERE offer_uuid = 'offer-uuid'",
+		).Scan(&appName)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	// The offer keeps its name, as consumers refer to it by its URL.
+	c.Check(offerName, tc.Equals, "foo")
+	c.Check(appName, tc.Equals, "bar")
+	c.Check(version, tc.Equals, 1)
+}
+
+func (s *applicationStateSuite) TestRenameApplicationWithSecrets(c *tc.C) {
+	appUUID := s.createIAASApplication(c, "foo", life.Alive)
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO secret (id) VALUES ('secret-id')`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO secret_metadata (secret_id, version, rotate_policy_id, create_time, update_time)
+VALUES ('secret-id', 1, 0, DATETIME('now'), DATETIME('now'))`); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO secret_application_owner (secret_id, application_uuid, label) VALUES ('secret-id', ?, 'label')`, appUUID)
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.RenameApplication(c.Context(), "foo", "bar")
+	c.Assert(err, tc.ErrorIsNil)
+
+	var ownerName, label string
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx,
+			"SELECT owner_name, label FROM v_secret_owner WHERE secret_id = 'secret-id'",
+		).Scan(&ownerName, &label)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ownerName, tc.Equals, "bar")
+	c.Check(label, tc.Equals, "label")
+}
+
+func (s *applicationStateSuite) TestRenameApplicationNotAlive(c *tc.C) {
+	s.createIAASApplication(c, "foo", life.Dying)
+
+	err := s.state.RenameApplication(c.Context(), "foo", "bar")
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotAlive)
+}
+
+func (s *applicationStateSuite) TestRenameApplicationNotFound(c *tc.C) {
+	err := s.state.RenameApplication(c.Context(), "foo", "bar")
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
 func (s *applicationStateSuite) TestSetDesiredApplicationScale(c *tc.C) {
 	appUUID := s.createCAASApplication(c, "foo", life.Alive)
 
@@ -3622,6 +3826,81 @@ func (s *applicationStateSuite) TestSetApplicationConstraintsIPFamily(c *tc.C) {
 	c.Check(*cons.IPFamily, tc.Equals, ipfamily.IPv4)
 }
 
+func (s *applicationStateSuite) TestSetApplicationConstraintsMarket(c *tc.C) {
+	id := s.createIAASApplication(c, "foo", life.Alive)
+
+	err := s.state.SetApplicationConstraints(c.Context(), id, constraints.Constraints{
+		Market:   new("spot"),
+		MaxPrice: new("0.25"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	cons, err := s.state.GetApplicationConstraints(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cons.Market, tc.DeepEquals, new("spot"))
+	c.Check(cons.MaxPrice, tc.DeepEquals, new("0.25"))
+	c.Check(cons.CapacityReservation, tc.IsNil)
+
+	// Overwriting the constraints replaces the market options.
+	err = s.state.SetApplicationConstraints(c.Context(), id, constraints.Constraints{
+		CapacityReservation: new("cr-0123456789abcdef0"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	cons, err = s.state.GetApplicationConstraints(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cons.Market, tc.IsNil)
+	c.Check(cons.MaxPrice, tc.IsNil)
+	c.Check(cons.CapacityReservation, tc.DeepEquals, new("cr-0123456789abcdef0"))
+}
+
+func (s *applicationStateSuite) TestSetApplicationConstraintsPlacementGroup(c *tc.C) {
+	id := s.createIAASApplication(c, "foo", life.Alive)
+
+	err := s.state.SetApplicationConstraints(c.Context(), id, constraints.Constraints{
+		PlacementGroup: new("cluster"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	cons, err := s.state.GetApplicationConstraints(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cons.PlacementGroup, tc.DeepEquals, new("cluster"))
+
+	err = s.state.SetApplicationConstraints(c.Context(), id, constraints.Constraints{
+		PlacementGroup: new("spread"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	cons, err = s.state.GetApplicationConstraints(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cons.PlacementGroup, tc.DeepEquals, new("spread"))
+}
+
+func (s *applicationStateSuite) TestSetApplicationConstraintsGpus(c *tc.C) {
+	id := s.createIAASApplication(c, "foo", life.Alive)
+
+	err := s.state.SetApplicationConstraints(c.Context(), id, constraints.Constraints{
+		Gpus:    new(uint64(2)),
+		GpuType: new("a100"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	cons, err := s.state.GetApplicationConstraints(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cons.Gpus, tc.DeepEquals, new(uint64(2)))
+	c.Check(cons.GpuType, tc.DeepEquals, new("a100"))
+
+	err = s.state.SetApplicationConstraints(c.Context(), id, constraints.Constraints{
+		Gpus: new(uint64(1)),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	cons, err = s.state.GetApplicationConstraints(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cons.Gpus, tc.DeepEquals, new(uint64(1)))
+	c.Check(cons.GpuType, tc.IsNil)
+}
+
 func (s *applicationStateSuite) TestSetConstraintsApplicationNotFound(c *tc.C) {
 	err := s.state.SetApplicationConstraints(c.Context(), "foo", constraints.Constraints{Mem: new(uint64(8))})
 	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
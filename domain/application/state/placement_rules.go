@@ -0,0 +1,692 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/canonical/sqlair"
+
+	coreapplication "github.com/juju/juju/core/application"
+	coremachine "github.com/juju/juju/core/machine"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/deployment"
+	"github.com/juju/juju/internal/errors"
+)
+
+// placementPolicy is the row of the application_placement_policy table.
+type placementPolicy struct {
+	ApplicationUUID    string        `db:"application_uuid"`
+	MaxUnitsPerMachine sql.NullInt64 `db:"max_units_per_machine"`
+	MaxUnitsPerZone    sql.NullInt64 `db:"max_units_per_zone"`
+}
+
+// placementAffinity is the row of the application_placement_affinity table.
+type placementAffinity struct {
+	ApplicationUUID       string `db:"application_uuid"`
+	TargetApplicationUUID string `db:"target_application_uuid"`
+	AntiAffinity          bool   `db:"anti_affinity"`
+}
+
+// placementAffinityTarget is an affinity rule along with the name of the
+// application that it targets.
+type placementAffinityTarget struct {
+	TargetApplicationUUID string `db:"target_application_uuid"`
+	TargetName            string `db:"name"`
+	AntiAffinity          bool   `db:"anti_affinity"`
+}
+
+// unitPlacement records the host machine of a unit. Units placed in a
+// container are attributed to the machine hosting the container.
+type unitPlacement struct {
+	UnitName        string         `db:"unit_name"`
+	ApplicationUUID string         `db:"application_uuid"`
+	HostUUID        string         `db:"host_uuid"`
+	HostName        string         `db:"host_name"`
+	ZoneUUID        sql.NullString `db:"zone_uuid"`
+}
+
+type applicationUUIDs []string
+
+// availabilityZone is the row of the availability_zone table.
+type availabilityZone struct {
+	UUID string `db:"uuid"`
+	Name string `db:"name"`
+}
+
+// placementRuleSet holds the rules that apply when placing the units of an
+// application, including the anti-affinity rules of other applications that
+// target it.
+type placementRuleSet struct {
+	appUUID            string
+	maxUnitsPerMachine int
+	maxUnitsPerZone    int
+	affinity           map[string]string
+	antiAffinity       map[string]string
+	// excludedBy holds the applications that have an anti-affinity rule
+	// targeting the application.
+	excludedBy map[string]string
+}
+
+func (r placementRuleSet) isEmpty() bool {
+	return r.maxUnitsPerMachine == 0 &&
+		r.maxUnitsPerZone == 0 &&
+		len(r.affinity) == 0 &&
+		len(r.antiAffinity) == 0 &&
+		len(r.excludedBy) == 0
+}
+
+// relatedApplications returns the UUIDs of the application and all the
+// applications referenced by its rules.
+func (r placementRuleSet) relatedApplications() applicationUUIDs {
+	uuids := applicationUUIDs{r.appUUID}
+	for _, m := range []map[string]string{r.affinity, r.antiAffinity, r.excludedBy} {
+		for uuid := range m {
+			uuids = append(uuids, uuid)
+		}
+	}
+	return uuids
+}
+
+// SetApplicationPlacementRules replaces the placement rules of the
+// application. Empty rules remove any existing rules.
+// The following errors may be returned:
+//   - [applicationerrors.ApplicationNotFound] if the application, or one of
+//     the applications referenced by the rules, does not exist.
+func (st *State) SetApplicationPlacementRules(
+	ctx context.Context,
+	appUUID coreapplication.UUID,
+	rules application.PlacementRules,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	ident := entityUUID{UUID: appUUID.String()}
+
+	deletePolicyStmt, err := st.Prepare(`
+DELETE FROM application_placement_policy
+WHERE application_uuid = $entityUUID.uuid
+`, ident)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	deleteAffinityStmt, err := st.Prepare(`
+DELETE FROM application_placement_affinity
+WHERE application_uuid = $entityUUID.uuid
+`, ident)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	insertPolicyStmt, err := st.Prepare(`
+INSERT INTO application_placement_policy (*)
+VALUES ($placementPolicy.*)
+`, placementPolicy{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	insertAffinityStmt, err := st.Prepare(`
+INSERT INTO application_placement_affinity (*)
+VALUES ($placementAffinity.*)
+`, placementAffinity{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if exists, err := st.checkApplicationExists(ctx, tx, appUUID); err != nil {
+			return errors.Errorf("checking application %q exists: %w", appUUID, err)
+		} else if !exists {
+			return errors.Errorf("application %q not found", appUUID).
+				Add(applicationerrors.ApplicationNotFound)
+		}
+
+		var affinities []placementAffinity
+		for _, targets := range []struct {
+			names []string
+			anti  bool
+		}{
+			{names: rules.Affinity},
+			{names: rules.AntiAffinity, anti: true},
+		} {
+			for _, name := range targets.names {
+				targetUUID, err := st.getApplicationUUID(ctx, tx, name)
+				if err != nil {
+					return errors.Capture(err)
+				}
+				affinities = append(affinities, placementAffinity{
+					ApplicationUUID:       appUUID.String(),
+					TargetApplicationUUID: targetUUID,
+					AntiAffinity:          targets.anti,
+				})
+			}
+		}
+
+		if err := tx.Query(ctx, deletePolicyStmt, ident).Run(); err != nil {
+			return errors.Errorf("deleting placement policy: %w", err)
+		}
+		if err := tx.Query(ctx, deleteAffinityStmt, ident).Run(); err != nil {
+			return errors.Errorf("deleting placement affinity: %w", err)
+		}
+
+		if rules.MaxUnitsPerMachine > 0 || rules.MaxUnitsPerZone > 0 {
+			policy := placementPolicy{
+				ApplicationUUID: appUUID.String(),
+				MaxUnitsPerMachine: sql.NullInt64{
+					Int64: int64(rules.MaxUnitsPerMachine),
+					Valid: rules.MaxUnitsPerMachine > 0,
+				},
+				MaxUnitsPerZone: sql.NullInt64{
+					Int64: int64(rules.MaxUnitsPerZone),
+					Valid: rules.MaxUnitsPerZone > 0,
+				},
+			}
+			if err := tx.Query(ctx, insertPolicyStmt, policy).Run(); err != nil {
+				return errors.Errorf("inserting placement policy: %w", err)
+			}
+		}
+
+		if len(affinities) > 0 {
+			if err := tx.Query(ctx, insertAffinityStmt, affinities).Run(); err != nil {
+				return errors.Errorf("inserting placement affinity: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// GetApplicationPlacementRules returns the placement rules of the
+// application.
+// The following errors may be returned:
+//   - [applicationerrors.ApplicationNotFound] if the application does not
+//     exist.
+func (st *State) GetApplicationPlacementRules(
+	ctx context.Context,
+	appUUID coreapplication.UUID,
+) (application.PlacementRules, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return application.PlacementRules{}, errors.Capture(err)
+	}
+
+	var ruleSet placementRuleSet
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if exists, err := st.checkApplicationExists(ctx, tx, appUUID); err != nil {
+			return errors.Errorf("checking application %q exists: %w", appUUID, err)
+		} else if !exists {
+			return errors.Errorf("application %q not found", appUUID).
+				Add(applicationerrors.ApplicationNotFound)
+		}
+
+		ruleSet, err = st.getPlacementRuleSet(ctx, tx, appUUID.String())
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return application.PlacementRules{}, errors.Capture(err)
+	}
+
+	return application.PlacementRules{
+		MaxUnitsPerMachine: ruleSet.maxUnitsPerMachine,
+		MaxUnitsPerZone:    ruleSet.maxUnitsPerZone,
+		Affinity:           sortedValues(ruleSet.affinity),
+		AntiAffinity:       sortedValues(ruleSet.antiAffinity),
+	}, nil
+}
+
+// GetAllApplicationPlacementViolations returns the units whose current
+// placement breaks the placement rules of their application, or the
+// anti-affinity rules of another application, indexed by application name.
+// Applications without misplaced units are omitted.
+func (st *State) GetAllApplicationPlacementViolations(
+	ctx context.Context,
+) (map[string][]application.PlacementViolation, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT a.uuid AS &applicationUUIDAndName.uuid,
+       a.name AS &applicationUUIDAndName.name
+FROM   application AS a
+WHERE  a.uuid IN (
+    SELECT application_uuid FROM application_placement_policy
+    UNION
+    SELECT application_uuid FROM application_placement_affinity
+    UNION
+    SELECT target_application_uuid FROM application_placement_affinity
+    WHERE  anti_affinity = TRUE
+)
+`, applicationUUIDAndName{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	violations := make(map[string][]application.PlacementViolation)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var apps []applicationUUIDAndName
+		err := tx.Query(ctx, stmt).GetAll(&apps)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("querying applications with placement rules: %w", err)
+		}
+
+		for _, app := range apps {
+			appViolations, err := st.getPlacementViolations(ctx, tx, app.ID)
+			if err != nil {
+				return errors.Errorf("getting placement violations for application %q: %w", app.Name, err)
+			}
+			if len(appViolations) > 0 {
+				violations[app.Name] = appViolations
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return violations, nil
+}
+
+func (st *State) getPlacementViolations(ctx context.Context, tx *sqlair.TX, appUUID string) ([]application.PlacementViolation, error) {
+	ruleSet, err := st.getPlacementRuleSet(ctx, tx, appUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	} else if ruleSet.isEmpty() {
+		return nil, nil
+	}
+
+	placements, err := st.getUnitPlacements(ctx, tx, ruleSet.relatedApplications())
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var violations []application.PlacementViolation
+	for _, p := range placements {
+		if p.ApplicationUUID != appUUID {
+			continue
+		}
+		reason := checkPlacement(ruleSet, placements, p.UnitName, p.HostUUID, p.ZoneUUID)
+		if reason == "" {
+			continue
+		}
+		violations = append(violations, application.PlacementViolation{
+			Unit:    coreunit.Name(p.UnitName),
+			Machine: coremachine.Name(p.HostName),
+			Reason:  reason,
+		})
+	}
+	return violations, nil
+}
+
+// checkUnitPlacementRules checks that placing a new unit of the application
+// as described by the placement does not break any placement rules.
+// Placements onto new machines can only break affinity rules, as the new
+// machine will not host the units of any other application.
+func (st *State) checkUnitPlacementRules(
+	ctx context.Context,
+	tx *sqlair.TX,
+	appUUID string,
+	placement deployment.Placement,
+) error {
+	ruleSet, err := st.getPlacementRuleSet(ctx, tx, appUUID)
+	if err != nil {
+		return errors.Capture(err)
+	} else if ruleSet.isEmpty() {
+		return nil
+	}
+
+	var hostName string
+	switch placement.Type {
+	case deployment.PlacementTypeMachine, deployment.PlacementTypeContainer:
+		hostName = placement.Directive
+	}
+	if hostName == "" {
+		if len(ruleSet.affinity) == 0 {
+			return nil
+		}
+		return errors.Errorf(
+			"unit must be co-located with units of %s, place it on an existing machine",
+			strings.Join(sortedValues(ruleSet.affinity), ", "),
+		).Add(applicationerrors.PlacementRuleViolated)
+	}
+
+	host, err := st.getPlacementHost(ctx, tx, hostName)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	placements, err := st.getUnitPlacements(ctx, tx, ruleSet.relatedApplications())
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	if reason := checkPlacement(ruleSet, placements, "", host.HostUUID, host.ZoneUUID); reason != "" {
+		return errors.Errorf("placing unit on machine %q: %s", host.HostName, reason).
+			Add(applicationerrors.PlacementRuleViolated)
+	}
+	return nil
+}
+
+// checkPlacement returns the reason why a unit of the application on the
+// given host and zone breaks the rules, or an empty string if it does not.
+// If unitName is empty, the unit is one that is about to be added, otherwise
+// it is an existing unit and it is excluded from the counts.
+func checkPlacement(
+	ruleSet placementRuleSet,
+	placements []unitPlacement,
+	unitName, hostUUID string,
+	zoneUUID sql.NullString,
+) string {
+	onHost := make(map[string]int)
+	inZone := 0
+	for _, p := range placements {
+		if p.UnitName == unitName {
+			continue
+		}
+		if p.HostUUID == hostUUID {
+			onHost[p.ApplicationUUID]++
+		}
+		if p.ApplicationUUID == ruleSet.appUUID && zoneUUID.Valid &&
+			p.ZoneUUID.Valid && p.ZoneUUID.String == zoneUUID.String {
+			inZone++
+		}
+	}
+
+	if max := ruleSet.maxUnitsPerMachine; max > 0 && onHost[ruleSet.appUUID] >= max {
+		return fmt.Sprintf("more than %d unit(s) per machine", max)
+	}
+	if max := ruleSet.maxUnitsPerZone; max > 0 && inZone >= max {
+		return fmt.Sprintf("more than %d unit(s) per availability zone", max)
+	}
+	for _, uuid := range slices.Sorted(maps.Keys(ruleSet.antiAffinity)) {
+		if onHost[uuid] > 0 {
+			return fmt.Sprintf("anti-affinity with %q", ruleSet.antiAffinity[uuid])
+		}
+	}
+	for _, uuid := range slices.Sorted(maps.Keys(ruleSet.excludedBy)) {
+		if onHost[uuid] > 0 {
+			return fmt.Sprintf("anti-affinity from %q", ruleSet.excludedBy[uuid])
+		}
+	}
+	for _, uuid := range slices.Sorted(maps.Keys(ruleSet.affinity)) {
+		if onHost[uuid] == 0 {
+			return fmt.Sprintf("affinity with %q", ruleSet.affinity[uuid])
+		}
+	}
+	return ""
+}
+
+func (st *State) getPlacementRuleSet(ctx context.Context, tx *sqlair.TX, appUUID string) (placementRuleSet, error) {
+	ident := entityUUID{UUID: appUUID}
+
+	policyStmt, err := st.Prepare(`
+SELECT &placementPolicy.*
+FROM   application_placement_policy
+WHERE  application_uuid = $entityUUID.uuid
+`, ident, placementPolicy{})
+	if err != nil {
+		return placementRuleSet{}, errors.Capture(err)
+	}
+
+	affinityStmt, err := st.Prepare(`
+SELECT apa.target_application_uuid AS &placementAffinityTarget.target_application_uuid,
+       apa.anti_affinity AS &placementAffinityTarget.anti_affinity,
+       a.name AS &placementAffinityTarget.name
+FROM   application_placement_affinity AS apa
+JOIN   application AS a ON a.uuid = apa.target_application_uuid
+WHERE  apa.application_uuid = $entityUUID.uuid
+`, ident, placementAffinityTarget{})
+	if err != nil {
+		return placementRuleSet{}, errors.Capture(err)
+	}
+
+	excludedByStmt, err := st.Prepare(`
+SELECT apa.application_uuid AS &placementAffinityTarget.target_application_uuid,
+       apa.anti_affinity AS &placementAffinityTarget.anti_affinity,
+       a.name AS &placementAffinityTarget.name
+FROM   application_placement_affinity AS apa
+JOIN   application AS a ON a.uuid = apa.application_uuid
+WHERE  apa.target_application_uuid = $entityUUID.uuid
+AND    apa.anti_affinity = TRUE
+`, ident, placementAffinityTarget{})
+	if err != nil {
+		return placementRuleSet{}, errors.Capture(err)
+	}
+
+	ruleSet := placementRuleSet{
+		appUUID:      appUUID,
+		affinity:     make(map[string]string),
+		antiAffinity: make(map[string]string),
+		excludedBy:   make(map[string]string),
+	}
+
+	var policy placementPolicy
+	err = tx.Query(ctx, policyStmt, ident).Get(&policy)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return placementRuleSet{}, errors.Errorf("querying placement policy: %w", err)
+	}
+	ruleSet.maxUnitsPerMachine = int(policy.MaxUnitsPerMachine.Int64)
+	ruleSet.maxUnitsPerZone = int(policy.MaxUnitsPerZone.Int64)
+
+	var affinities []placementAffinityTarget
+	err = tx.Query(ctx, affinityStmt, ident).GetAll(&affinities)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return placementRuleSet{}, errors.Errorf("querying placement affinity: %w", err)
+	}
+	for _, a := range affinities {
+		if a.AntiAffinity {
+			ruleSet.antiAffinity[a.TargetApplicationUUID] = a.TargetName
+		} else {
+			ruleSet.affinity[a.TargetApplicationUUID] = a.TargetName
+		}
+	}
+
+	var excludedBy []placementAffinityTarget
+	err = tx.Query(ctx, excludedByStmt, ident).GetAll(&excludedBy)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return placementRuleSet{}, errors.Errorf("querying placement anti-affinity: %w", err)
+	}
+	for _, a := range excludedBy {
+		ruleSet.excludedBy[a.TargetApplicationUUID] = a.TargetName
+	}
+	return ruleSet, nil
+}
+
+// getPlacementHost returns the machine that will host a unit placed on the
+// named machine. If the named machine is a container, this is the parent of
+// the container.
+func (st *State) getPlacementHost(ctx context.Context, tx *sqlair.TX, machineName string) (unitPlacement, error) {
+	machineUUID, err := st.getMachineUUIDFromName(ctx, tx, machineName)
+	if err != nil {
+		return unitPlacement{}, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT h.uuid AS &unitPlacement.host_uuid,
+       h.name AS &unitPlacement.host_name,
+       mci.availability_zone_uuid AS &unitPlacement.zone_uuid
+FROM   machine AS m
+LEFT JOIN machine_parent AS mp ON mp.machine_uuid = m.uuid
+JOIN   machine AS h ON h.uuid = COALESCE(mp.parent_uuid, m.uuid)
+LEFT JOIN machine_cloud_instance AS mci ON mci.machine_uuid = h.uuid
+WHERE  m.uuid = $entityUUID.uuid
+`, machineUUID, unitPlacement{})
+	if err != nil {
+		return unitPlacement{}, errors.Capture(err)
+	}
+
+	var host unitPlacement
+	if err := tx.Query(ctx, stmt, machineUUID).Get(&host); err != nil {
+		return unitPlacement{}, errors.Errorf("querying host of machine %q: %w", machineName, err)
+	}
+	return host, nil
+}
+
+// getUnitPlacements returns the host machines of all the units of the given
+// applications.
+func (st *State) getUnitPlacements(ctx context.Context, tx *sqlair.TX, appUUIDs applicationUUIDs) ([]unitPlacement, error) {
+	stmt, err := st.Prepare(`
+SELECT u.name AS &unitPlacement.unit_name,
+       u.application_uuid AS &unitPlacement.application_uuid,
+       h.uuid AS &unitPlacement.host_uuid,
+       h.name AS &unitPlacement.host_name,
+       mci.availability_zone_uuid AS &unitPlacement.zone_uuid
+FROM   unit AS u
+JOIN   machine AS m ON m.net_node_uuid = u.net_node_uuid
+LEFT JOIN machine_parent AS mp ON mp.machine_uuid = m.uuid
+JOIN   machine AS h ON h.uuid = COALESCE(mp.parent_uuid, m.uuid)
+LEFT JOIN machine_cloud_instance AS mci ON mci.machine_uuid = h.uuid
+WHERE  u.application_uuid IN ($applicationUUIDs[:])
+ORDER BY u.name
+`, appUUIDs, unitPlacement{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var placements []unitPlacement
+	err = tx.Query(ctx, stmt, appUUIDs).GetAll(&placements)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Errorf("querying unit placements: %w", err)
+	}
+	return placements, nil
+}
+
+// GetMachinePlacementZoneRules returns the restrictions the placement rules
+// of the applications with units on the named machine, or its containers,
+// place on the zone the machine is started in.
+// The following errors may be returned:
+//   - [machineerrors.MachineNotFound] if the machine does not exist.
+func (st *State) GetMachinePlacementZoneRules(
+	ctx context.Context,
+	machineName string,
+) (application.MachineZoneRules, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return application.MachineZoneRules{}, errors.Capture(err)
+	}
+
+	hostedStmt, err := st.Prepare(`
+SELECT u.name AS &unitPlacement.unit_name,
+       u.application_uuid AS &unitPlacement.application_uuid
+FROM   unit AS u
+JOIN   machine AS m ON m.net_node_uuid = u.net_node_uuid
+LEFT JOIN machine_parent AS mp ON mp.machine_uuid = m.uuid
+WHERE  COALESCE(mp.parent_uuid, m.uuid) = $entityUUID.uuid
+`, entityUUID{}, unitPlacement{})
+	if err != nil {
+		return application.MachineZoneRules{}, errors.Capture(err)
+	}
+
+	zoneStmt, err := st.Prepare(`
+SELECT &availabilityZone.*
+FROM   availability_zone
+`, availabilityZone{})
+	if err != nil {
+		return application.MachineZoneRules{}, errors.Capture(err)
+	}
+
+	var (
+		excludedZones = make(map[string]struct{})
+		antiMachines  = make(map[string]struct{})
+		zoneNames     = make(map[string]string)
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		machineUUID, err := st.getMachineUUIDFromName(ctx, tx, machineName)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		var hosted []unitPlacement
+		err = tx.Query(ctx, hostedStmt, machineUUID).GetAll(&hosted)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("querying units on machine %q: %w", machineName, err)
+		}
+		hostedCount := make(map[string]int)
+		for _, u := range hosted {
+			hostedCount[u.ApplicationUUID]++
+		}
+
+		for _, appUUID := range slices.Sorted(maps.Keys(hostedCount)) {
+			ruleSet, err := st.getPlacementRuleSet(ctx, tx, appUUID)
+			if err != nil {
+				return errors.Capture(err)
+			} else if ruleSet.isEmpty() {
+				continue
+			}
+
+			placements, err := st.getUnitPlacements(ctx, tx, ruleSet.relatedApplications())
+			if err != nil {
+				return errors.Capture(err)
+			}
+
+			inZone := make(map[string]int)
+			for _, p := range placements {
+				if p.HostUUID == machineUUID.UUID {
+					continue
+				}
+				_, anti := ruleSet.antiAffinity[p.ApplicationUUID]
+				_, excluded := ruleSet.excludedBy[p.ApplicationUUID]
+				if anti || excluded {
+					antiMachines[p.HostName] = struct{}{}
+				}
+				if p.ApplicationUUID == appUUID && p.ZoneUUID.Valid {
+					inZone[p.ZoneUUID.String]++
+				}
+			}
+			if max := ruleSet.maxUnitsPerZone; max > 0 {
+				for zoneUUID, count := range inZone {
+					if count+hostedCount[appUUID] > max {
+						excludedZones[zoneUUID] = struct{}{}
+					}
+				}
+			}
+		}
+
+		if len(excludedZones) == 0 {
+			return nil
+		}
+		var zones []availabilityZone
+		if err := tx.Query(ctx, zoneStmt).GetAll(&zones); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("querying availability zones: %w", err)
+		}
+		for _, z := range zones {
+			zoneNames[z.UUID] = z.Name
+		}
+		return nil
+	})
+	if err != nil {
+		return application.MachineZoneRules{}, errors.Capture(err)
+	}
+
+	var rules application.MachineZoneRules
+	for zoneUUID := range excludedZones {
+		if name, ok := zoneNames[zoneUUID]; ok {
+			rules.ExcludedZones = append(rules.ExcludedZones, name)
+		}
+	}
+	slices.Sort(rules.ExcludedZones)
+	for _, name := range slices.Sorted(maps.Keys(antiMachines)) {
+		rules.AntiAffinityMachines = append(rules.AntiAffinityMachines, coremachine.Name(name))
+	}
+	return rules, nil
+}
+
+func sortedValues(m map[string]string) []string {
+	return slices.Sorted(maps.Values(m))
+}
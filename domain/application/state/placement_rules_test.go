@@ -0,0 +1,276 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/juju/clock"
+	"github.com/juju/tc"
+
+	coreapplication "github.com/juju/juju/core/application"
+	coremachine "github.com/juju/juju/core/machine"
+	machinetesting "github.com/juju/juju/core/machine/testing"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/deployment"
+	"github.com/juju/juju/domain/life"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	domainnetwork "github.com/juju/juju/domain/network"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type placementRulesSuite struct {
+	baseSuite
+
+	state *State
+}
+
+func TestPlacementRulesSuite(t *testing.T) {
+	tc.Run(t, &placementRulesSuite{})
+}
+
+func (s *placementRulesSuite) SetUpTest(c *tc.C) {
+	s.baseSuite.SetUpTest(c)
+
+	s.state = NewState(s.TxnRunnerFactory(), s.modelUUID, clock.WallClock, loggertesting.WrapCheckLog(c))
+}
+
+func (s *placementRulesSuite) TestSetAndGetPlacementRules(c *tc.C) {
+	appID := s.createIAASApplication(c, "foo", life.Alive)
+	s.createIAASApplication(c, "bar", life.Alive)
+	s.createIAASApplication(c, "baz", life.Alive)
+
+	err := s.state.SetApplicationPlacementRules(c.Context(), appID, application.PlacementRules{
+		MaxUnitsPerMachine: 1,
+		MaxUnitsPerZone:    2,
+		AntiAffinity:       []string{"baz", "bar"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	rules, err := s.state.GetApplicationPlacementRules(c.Context(), appID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rules, tc.DeepEquals, application.PlacementRules{
+		MaxUnitsPerMachine: 1,
+		MaxUnitsPerZone:    2,
+		AntiAffinity:       []string{"bar", "baz"},
+		Affinity:           []string{},
+	})
+
+	// Setting the rules again replaces them.
+	err = s.state.SetApplicationPlacementRules(c.Context(), appID, application.PlacementRules{
+		Affinity: []string{"bar"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	rules, err = s.state.GetApplicationPlacementRules(c.Context(), appID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rules, tc.DeepEquals, application.PlacementRules{
+		AntiAffinity: []string{},
+		Affinity:     []string{"bar"},
+	})
+}
+
+func (s *placementRulesSuite) TestSetPlacementRulesApplicationNotFound(c *tc.C) {
+	err := s.state.SetApplicationPlacementRules(
+		c.Context(), tc.Must(c, coreapplication.NewUUID), application.PlacementRules{MaxUnitsPerMachine: 1},
+	)
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *placementRulesSuite) TestSetPlacementRulesTargetNotFound(c *tc.C) {
+	appID := s.createIAASApplication(c, "foo", life.Alive)
+
+	err := s.state.SetApplicationPlacementRules(c.Context(), appID, application.PlacementRules{
+		AntiAffinity: []string{"missing"},
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *placementRulesSuite) TestAddUnitMaxUnitsPerMachine(c *tc.C) {
+	appID := s.createIAASApplication(c, "foo", life.Alive)
+	err := s.state.SetApplicationPlacementRules(c.Context(), appID, application.PlacementRules{
+		MaxUnitsPerMachine: 1,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	machineUUID, netNodeUUID := s.addUnitOnNewMachine(c, appID)
+
+	_, _, err = s.state.AddIAASUnits(c.Context(), appID, s.unitOnMachineArg(c, machineUUID, netNodeUUID, "0"))
+	c.Assert(err, tc.ErrorIs, applicationerrors.PlacementRuleViolated)
+
+	// A unit in a container on the machine counts against the machine.
+	_, _, err = s.state.AddIAASUnits(c.Context(), appID, application.AddIAASUnitArg{
+		MachineUUID:        machinetesting.GenUUID(c),
+		MachineNetNodeUUID: tc.Must(c, domainnetwork.NewNetNodeUUID),
+		AddUnitArg: application.AddUnitArg{
+			UnitUUID:    tc.Must(c, coreunit.NewUUID),
+			NetNodeUUID: tc.Must(c, domainnetwork.NewNetNodeUUID),
+			Placement: deployment.Placement{
+				Type:      deployment.PlacementTypeContainer,
+				Container: deployment.ContainerTypeLXD,
+				Directive: "0",
+			},
+		},
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.PlacementRuleViolated)
+}
+
+func (s *placementRulesSuite) TestAddUnitAntiAffinity(c *tc.C) {
+	fooID := s.createIAASApplication(c, "foo", life.Alive)
+	barID := s.createIAASApplication(c, "bar", life.Alive)
+	err := s.state.SetApplicationPlacementRules(c.Context(), fooID, application.PlacementRules{
+		AntiAffinity: []string{"bar"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	barMachineUUID, barNetNodeUUID := s.addUnitOnNewMachine(c, barID)
+	fooMachineUUID, fooNetNodeUUID := s.addUnitOnNewMachine(c, fooID)
+
+	// foo can not be placed with bar.
+	_, _, err = s.state.AddIAASUnits(c.Context(), fooID, s.unitOnMachineArg(c, barMachineUUID, barNetNodeUUID, "0"))
+	c.Assert(err, tc.ErrorIs, applicationerrors.PlacementRuleViolated)
+
+	// The rule is honoured when placing bar with foo too.
+	_, _, err = s.state.AddIAASUnits(c.Context(), barID, s.unitOnMachineArg(c, fooMachineUUID, fooNetNodeUUID, "1"))
+	c.Assert(err, tc.ErrorIs, applicationerrors.PlacementRuleViolated)
+
+	// Units of bar can still share a machine.
+	_, _, err = s.state.AddIAASUnits(c.Context(), barID, s.unitOnMachineArg(c, barMachineUUID, barNetNodeUUID, "0"))
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *placementRulesSuite) TestAddUnitAffinity(c *tc.C) {
+	fooID := s.createIAASApplication(c, "foo", life.Alive)
+	barID := s.createIAASApplication(c, "bar", life.Alive)
+	err := s.state.SetApplicationPlacementRules(c.Context(), fooID, application.PlacementRules{
+		Affinity: []string{"bar"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// A unit with affinity rules can not be placed on a new machine.
+	_, _, err = s.state.AddIAASUnits(c.Context(), fooID, s.unitOnNewMachineArg(c))
+	c.Assert(err, tc.ErrorIs, applicationerrors.PlacementRuleViolated)
+
+	barMachineUUID, barNetNodeUUID := s.addUnitOnNewMachine(c, barID)
+
+	unitNames, _, err := s.state.AddIAASUnits(c.Context(), fooID, s.unitOnMachineArg(c, barMachineUUID, barNetNodeUUID, "0"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unitNames, tc.DeepEquals, []coreunit.Name{"foo/0"})
+}
+
+func (s *placementRulesSuite) TestGetAllApplicationPlacementViolations(c *tc.C) {
+	fooID := s.createIAASApplication(c, "foo", life.Alive)
+	barID := s.createIAASApplication(c, "bar", life.Alive)
+
+	machineUUID, netNodeUUID := s.addUnitOnNewMachine(c, fooID)
+	_, _, err := s.state.AddIAASUnits(c.Context(), barID, s.unitOnMachineArg(c, machineUUID, netNodeUUID, "0"))
+	c.Assert(err, tc.ErrorIsNil)
+	s.addUnitOnNewMachine(c, fooID)
+
+	violations, err := s.state.GetAllApplicationPlacementViolations(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(violations, tc.HasLen, 0)
+
+	// Rules added after the units were placed are reported as violations.
+	err = s.state.SetApplicationPlacementRules(c.Context(), barID, application.PlacementRules{
+		AntiAffinity: []string{"foo"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	violations, err = s.state.GetAllApplicationPlacementViolations(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(violations, tc.DeepEquals, map[string][]application.PlacementViolation{
+		"foo": {{
+			Unit:    "foo/0",
+			Machine: coremachine.Name("0"),
+			Reason:  `anti-affinity from "bar"`,
+		}},
+		"bar": {{
+			Unit:    "bar/0",
+			Machine: coremachine.Name("0"),
+			Reason:  `anti-affinity with "foo"`,
+		}},
+	})
+}
+
+func (s *placementRulesSuite) TestGetMachinePlacementZoneRules(c *tc.C) {
+	fooID := s.createIAASApplication(c, "foo", life.Alive)
+	barID := s.createIAASApplication(c, "bar", life.Alive)
+	err := s.state.SetApplicationPlacementRules(c.Context(), fooID, application.PlacementRules{
+		MaxUnitsPerZone: 1,
+		AntiAffinity:    []string{"bar"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	foo0MachineUUID, _ := s.addUnitOnNewMachine(c, fooID)
+	s.addUnitOnNewMachine(c, barID)
+	s.addUnitOnNewMachine(c, fooID)
+
+	_, err = s.DB().Exec(`INSERT INTO availability_zone (uuid, name) VALUES ('az1-uuid', 'az1'), ('az2-uuid', 'az2')`)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.DB().Exec(`
+INSERT INTO machine_cloud_instance (machine_uuid, life_id, availability_zone_uuid) VALUES (?, 0, 'az1-uuid')
+ON CONFLICT (machine_uuid) DO UPDATE SET availability_zone_uuid = excluded.availability_zone_uuid
+`, foo0MachineUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The second unit of foo can not be started in the zone hosting the
+	// first, and avoids the machine hosting bar.
+	rules, err := s.state.GetMachinePlacementZoneRules(c.Context(), "2")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rules, tc.DeepEquals, application.MachineZoneRules{
+		ExcludedZones:        []string{"az1"},
+		AntiAffinityMachines: []coremachine.Name{"1"},
+	})
+
+	// The anti-affinity rule of foo applies to bar too.
+	rules, err = s.state.GetMachinePlacementZoneRules(c.Context(), "1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rules, tc.DeepEquals, application.MachineZoneRules{
+		AntiAffinityMachines: []coremachine.Name{"0", "2"},
+	})
+}
+
+func (s *placementRulesSuite) TestGetMachinePlacementZoneRulesMachineNotFound(c *tc.C) {
+	_, err := s.state.GetMachinePlacementZoneRules(c.Context(), "42")
+	c.Assert(err, tc.ErrorIs, machineerrors.MachineNotFound)
+}
+
+func (s *placementRulesSuite) addUnitOnNewMachine(c *tc.C, appID coreapplication.UUID) (coremachine.UUID, domainnetwork.NetNodeUUID) {
+	arg := s.unitOnNewMachineArg(c)
+	_, _, err := s.state.AddIAASUnits(c.Context(), appID, arg)
+	c.Assert(err, tc.ErrorIsNil)
+	return arg.MachineUUID, arg.MachineNetNodeUUID
+}
+
+func (s *placementRulesSuite) unitOnNewMachineArg(c *tc.C) application.AddIAASUnitArg {
+	netNodeUUID := tc.Must(c, domainnetwork.NewNetNodeUUID)
+	return application.AddIAASUnitArg{
+		MachineUUID:        machinetesting.GenUUID(c),
+		MachineNetNodeUUID: netNodeUUID,
+		AddUnitArg: application.AddUnitArg{
+			UnitUUID:    tc.Must(c, coreunit.NewUUID),
+			NetNodeUUID: netNodeUUID,
+		},
+	}
+}
+
+func (s *placementRulesSuite) unitOnMachineArg(
+	c *tc.C, machineUUID coremachine.UUID, netNodeUUID domainnetwork.NetNodeUUID, machineName string,
+) application.AddIAASUnitArg {
+	return application.AddIAASUnitArg{
+		MachineUUID:        machineUUID,
+		MachineNetNodeUUID: netNodeUUID,
+		AddUnitArg: application.AddUnitArg{
+			UnitUUID:    tc.Must(c, coreunit.NewUUID),
+			NetNodeUUID: netNodeUUID,
+			Placement: deployment.Placement{
+				Type:      deployment.PlacementTypeMachine,
+				Directive: machineName,
+			},
+		},
+	}
+}
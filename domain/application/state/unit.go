@@ -465,6 +465,8 @@ WHERE  name = $nameWithNetNodeAndLife.name
 //     is returned.
 //   - If the application is not found, [applicationerrors.ApplicationNotFound]
 //     is returned.
+//   - If a unit can not be placed without breaking the placement rules of
+//     the application, [applicationerrors.PlacementRuleViolated] is returned.
 func (st *State) AddIAASUnits(
 	ctx context.Context,
 	appUUID coreapplication.UUID,
@@ -499,6 +501,10 @@ func (st *State) AddIAASUnits(
 		}
 
 		for i, arg := range args {
+			if err := st.checkUnitPlacementRules(ctx, tx, appUUID.String(), arg.Placement); err != nil {
+				return errors.Errorf("checking placement of unit %d: %w", i, err)
+			}
+
 			uName, mNames, err := st.InsertIAASUnit(ctx, tx, appUUID.String(), charmUUID, arg)
 			if err != nil {
 				return errors.Errorf("inserting unit %d: %w ", i, err)
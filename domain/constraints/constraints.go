@@ -90,6 +90,30 @@ type Constraints struct {
 	// warning. If this constraint is not present, the default behavior is
 	// provider-specific.
 	IPFamily *ipfamily.IPFamily
+
+	// Market, if not nil or empty, indicates the purchasing option for the
+	// machine, either "on-demand" or "spot".
+	Market *string
+
+	// MaxPrice, if not nil or empty, indicates the maximum hourly price to
+	// pay for a spot instance.
+	MaxPrice *string
+
+	// CapacityReservation, if not nil or empty, indicates the ID of a cloud
+	// capacity reservation that a machine must be started in.
+	CapacityReservation *string
+
+	// PlacementGroup, if not nil or empty, indicates how the provider should
+	// place the machines of an application relative to each other.
+	PlacementGroup *string
+
+	// Gpus, if not nil, indicates that a machine must have at least that
+	// number of GPUs attached.
+	Gpus *uint64
+
+	// GpuType, if not nil or empty, indicates the model of GPU that a
+	// machine must have attached.
+	GpuType *string
 }
 
 // SpaceConstraint represents a single space constraint for an application.
@@ -121,6 +145,13 @@ func DecodeConstraints(coreCons constraints.Value) Constraints {
 		AllocatePublicIP: coreCons.AllocatePublicIP,
 		ImageID:          coreCons.ImageID,
 		IPFamily:         coreCons.IPFamily,
+
+		Market:              coreCons.Market,
+		MaxPrice:            coreCons.MaxPrice,
+		CapacityReservation: coreCons.CapacityReservation,
+		PlacementGroup:      coreCons.PlacementGroup,
+		Gpus:                coreCons.Gpus,
+		GpuType:             coreCons.GpuType,
 	}
 
 	if coreCons.Spaces == nil {
@@ -167,6 +198,13 @@ func EncodeConstraints(cons Constraints) constraints.Value {
 		AllocatePublicIP: cons.AllocatePublicIP,
 		ImageID:          cons.ImageID,
 		IPFamily:         cons.IPFamily,
+
+		Market:              cons.Market,
+		MaxPrice:            cons.MaxPrice,
+		CapacityReservation: cons.CapacityReservation,
+		PlacementGroup:      cons.PlacementGroup,
+		Gpus:                cons.Gpus,
+		GpuType:             cons.GpuType,
 	}
 
 	if cons.Spaces == nil {
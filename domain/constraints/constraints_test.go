@@ -35,39 +35,51 @@ func (*constraintsSuite) TestFromCoreConstraints(c *tc.C) {
 		{
 			Comment: "Test every value get's set as described",
 			In: constraints.Value{
-				Arch:             new("test"),
-				Container:        new(instance.LXD),
-				CpuCores:         new(uint64(1)),
-				CpuPower:         new(uint64(1)),
-				Mem:              new(uint64(1024)),
-				RootDisk:         new(uint64(100)),
-				RootDiskSource:   new("source"),
-				Tags:             new([]string{"tag1", "tag2"}),
-				InstanceRole:     new("instance-role"),
-				InstanceType:     new("instance-type"),
-				VirtType:         new("kvm"),
-				Zones:            new([]string{"zone1", "zone2"}),
-				AllocatePublicIP: new(true),
-				ImageID:          new("image-123"),
-				IPFamily:         new(ipfamily.Dual),
-				Spaces:           new([]string{"space1", "space2", "^space3"}),
+				Arch:                new("test"),
+				Container:           new(instance.LXD),
+				CpuCores:            new(uint64(1)),
+				CpuPower:            new(uint64(1)),
+				Mem:                 new(uint64(1024)),
+				RootDisk:            new(uint64(100)),
+				RootDiskSource:      new("source"),
+				Tags:                new([]string{"tag1", "tag2"}),
+				InstanceRole:        new("instance-role"),
+				InstanceType:        new("instance-type"),
+				VirtType:            new("kvm"),
+				Zones:               new([]string{"zone1", "zone2"}),
+				AllocatePublicIP:    new(true),
+				ImageID:             new("image-123"),
+				IPFamily:            new(ipfamily.Dual),
+				Market:              new("spot"),
+				MaxPrice:            new("0.5"),
+				CapacityReservation: new("cr-123"),
+				PlacementGroup:      new("cluster"),
+				Gpus:                new(uint64(2)),
+				GpuType:             new("a100"),
+				Spaces:              new([]string{"space1", "space2", "^space3"}),
 			},
 			Out: Constraints{
-				Arch:             new("test"),
-				Container:        new(instance.LXD),
-				CpuCores:         new(uint64(1)),
-				CpuPower:         new(uint64(1)),
-				Mem:              new(uint64(1024)),
-				RootDisk:         new(uint64(100)),
-				RootDiskSource:   new("source"),
-				Tags:             new([]string{"tag1", "tag2"}),
-				InstanceRole:     new("instance-role"),
-				InstanceType:     new("instance-type"),
-				VirtType:         new("kvm"),
-				Zones:            new([]string{"zone1", "zone2"}),
-				AllocatePublicIP: new(true),
-				ImageID:          new("image-123"),
-				IPFamily:         new(ipfamily.Dual),
+				Arch:                new("test"),
+				Container:           new(instance.LXD),
+				CpuCores:            new(uint64(1)),
+				CpuPower:            new(uint64(1)),
+				Mem:                 new(uint64(1024)),
+				RootDisk:            new(uint64(100)),
+				RootDiskSource:      new("source"),
+				Tags:                new([]string{"tag1", "tag2"}),
+				InstanceRole:        new("instance-role"),
+				InstanceType:        new("instance-type"),
+				VirtType:            new("kvm"),
+				Zones:               new([]string{"zone1", "zone2"}),
+				AllocatePublicIP:    new(true),
+				ImageID:             new("image-123"),
+				IPFamily:            new(ipfamily.Dual),
+				Market:              new("spot"),
+				MaxPrice:            new("0.5"),
+				CapacityReservation: new("cr-123"),
+				PlacementGroup:      new("cluster"),
+				Gpus:                new(uint64(2)),
+				GpuType:             new("a100"),
 				Spaces: new([]SpaceConstraint{
 					{SpaceName: "space1", Exclude: false},
 					{SpaceName: "space2", Exclude: false},
@@ -145,21 +157,27 @@ func (*constraintsSuite) TestToCoreConstraints(c *tc.C) {
 		{
 			Comment: "Test every value get's set as described",
 			In: Constraints{
-				Arch:             new("test"),
-				Container:        new(instance.LXD),
-				CpuCores:         new(uint64(1)),
-				CpuPower:         new(uint64(1)),
-				Mem:              new(uint64(1024)),
-				RootDisk:         new(uint64(100)),
-				RootDiskSource:   new("source"),
-				Tags:             new([]string{"tag1", "tag2"}),
-				InstanceRole:     new("instance-role"),
-				InstanceType:     new("instance-type"),
-				VirtType:         new("kvm"),
-				Zones:            new([]string{"zone1", "zone2"}),
-				AllocatePublicIP: new(true),
-				ImageID:          new("image-123"),
-				IPFamily:         new(ipfamily.Dual),
+				Arch:                new("test"),
+				Container:           new(instance.LXD),
+				CpuCores:            new(uint64(1)),
+				CpuPower:            new(uint64(1)),
+				Mem:                 new(uint64(1024)),
+				RootDisk:            new(uint64(100)),
+				RootDiskSource:      new("source"),
+				Tags:                new([]string{"tag1", "tag2"}),
+				InstanceRole:        new("instance-role"),
+				InstanceType:        new("instance-type"),
+				VirtType:            new("kvm"),
+				Zones:               new([]string{"zone1", "zone2"}),
+				AllocatePublicIP:    new(true),
+				ImageID:             new("image-123"),
+				IPFamily:            new(ipfamily.Dual),
+				Market:              new("spot"),
+				MaxPrice:            new("0.5"),
+				CapacityReservation: new("cr-123"),
+				PlacementGroup:      new("cluster"),
+				Gpus:                new(uint64(2)),
+				GpuType:             new("a100"),
 				Spaces: new([]SpaceConstraint{
 					{SpaceName: "space1", Exclude: false},
 					{SpaceName: "space2", Exclude: false},
@@ -167,22 +185,28 @@ func (*constraintsSuite) TestToCoreConstraints(c *tc.C) {
 				}),
 			},
 			Out: constraints.Value{
-				Arch:             new("test"),
-				Container:        new(instance.LXD),
-				CpuCores:         new(uint64(1)),
-				CpuPower:         new(uint64(1)),
-				Mem:              new(uint64(1024)),
-				RootDisk:         new(uint64(100)),
-				RootDiskSource:   new("source"),
-				Tags:             new([]string{"tag1", "tag2"}),
-				InstanceRole:     new("instance-role"),
-				InstanceType:     new("instance-type"),
-				VirtType:         new("kvm"),
-				Zones:            new([]string{"zone1", "zone2"}),
-				AllocatePublicIP: new(true),
-				ImageID:          new("image-123"),
-				IPFamily:         new(ipfamily.Dual),
-				Spaces:           new([]string{"space1", "space2", "^space3"}),
+				Arch:                new("test"),
+				Container:           new(instance.LXD),
+				CpuCores:            new(uint64(1)),
+				CpuPower:            new(uint64(1)),
+				Mem:                 new(uint64(1024)),
+				RootDisk:            new(uint64(100)),
+				RootDiskSource:      new("source"),
+				Tags:                new([]string{"tag1", "tag2"}),
+				InstanceRole:        new("instance-role"),
+				InstanceType:        new("instance-type"),
+				VirtType:            new("kvm"),
+				Zones:               new([]string{"zone1", "zone2"}),
+				AllocatePublicIP:    new(true),
+				ImageID:             new("image-123"),
+				IPFamily:            new(ipfamily.Dual),
+				Market:              new("spot"),
+				MaxPrice:            new("0.5"),
+				CapacityReservation: new("cr-123"),
+				PlacementGroup:      new("cluster"),
+				Gpus:                new(uint64(2)),
+				GpuType:             new("a100"),
+				Spaces:              new([]string{"space1", "space2", "^space3"}),
 			},
 		},
 		{
@@ -26,6 +26,10 @@ const (
 	// create an offer that already exists.
 	OfferAlreadyExists = errors.ConstError("offer already exists")
 
+	// OfferEndpointHasRelations describes an error that occurs when trying
+	// to remove an endpoint with live relations from an offer.
+	OfferEndpointHasRelations = errors.ConstError("offer endpoint has relations")
+
 	// OfferNotFound describes an error that occurs when the offer
 	// being operated on does not exist.
 	OfferNotFound = errors.ConstError("offer not found")
@@ -338,6 +338,7 @@ func (s *importSuite) setupCoordinatorScopeAndService(c *tc.C) (*coremodelmigrat
 		controllerstate.NewState(controllerFactory, loggertesting.WrapCheckLog(c)),
 		modelstate.NewState(modelFactory, modelUUID, clock.WallClock, loggertesting.WrapCheckLog(c)),
 		nil,
+		nil,
 		clock.WallClock,
 		loggertesting.WrapCheckLog(c),
 	)
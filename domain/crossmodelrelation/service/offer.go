@@ -31,6 +31,12 @@ type ModelOfferState interface {
 		crossmodelrelation.CreateOfferArgs,
 	) error
 
+	// UpdateOffer updates the endpoints and description of an offer.
+	UpdateOffer(
+		context.Context,
+		crossmodelrelation.UpdateOfferArgs,
+	) error
+
 	// DeleteFailedOffer deletes the provided offer, used after adding
 	// permissions failed. Assumes that the offer is never used, no
 	// checking of relations is required.
@@ -127,8 +133,8 @@ func (s *Service) GetOfferUUIDByRelationUUID(ctx context.Context, relationUUID c
 // only. If the offer already exists and offers the same endpoints of the
 // same application, the call is a no-op and succeeds, keeping offer creation
 // idempotent. If the offer exists but differs, an error satisfying
-// [crossmodelrelationerrors.OfferAlreadyExists] is returned, as updating
-// offers is not supported.
+// [crossmodelrelationerrors.OfferAlreadyExists] is returned, the offer
+// must be changed with [Service.UpdateOffer] instead.
 func (s *Service) CreateOffer(
 	ctx context.Context,
 	args crossmodelrelation.ApplicationOfferArgs,
@@ -158,10 +164,10 @@ func (s *Service) CreateOffer(
 	if err != nil && !errors.Is(err, crossmodelrelationerrors.OfferNotFound) {
 		return errors.Errorf("creating offer: %w", err)
 	} else if err == nil {
-		// The offer exists. Since updating offers is not supported, only
-		// succeed if the existing offer is identical to the requested one,
-		// that is it offers the same endpoints of the same application.
-		// This keeps creating an offer idempotent.
+		// The offer exists. Updating offers is done by UpdateOffer, so
+		// only succeed if the existing offer is identical to the requested
+		// one, that is it offers the same endpoints of the same
+		// application. This keeps creating an offer idempotent.
 		//
 		// Resolve the requested endpoints on the application, so they can
 		// be compared against the ones the existing offer exposes.
@@ -229,6 +235,60 @@ func (s *Service) CreateOffer(
 	return errors.Capture(err)
 }
 
+// UpdateOffer updates an existing offer in place, so that it exposes the
+// requested endpoints of the offered application and, if provided, the new
+// description. Consumers of the offer are notified of the change through
+// the offer status watcher.
+// Returns [crossmodelrelationerrors.OfferNotFound] if the offer does not
+// exist, and [crossmodelrelationerrors.OfferEndpointHasRelations] if an
+// endpoint with live relations would be removed and the update is not
+// forced.
+func (s *Service) UpdateOffer(
+	ctx context.Context,
+	args crossmodelrelation.UpdateApplicationOfferArgs,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := args.Validate(); err != nil {
+		return errors.Capture(err)
+	}
+
+	existingOffer, err := s.modelState.GetConsumeDetails(ctx, args.OfferName)
+	if err != nil {
+		return errors.Errorf("updating offer %q: %w", args.OfferName, err)
+	}
+	offerUUID, err := offer.ParseUUID(existingOffer.OfferUUID)
+	if err != nil {
+		return errors.Errorf("parsing offer UUID: %w", err)
+	}
+
+	// Sort the endpoint names so the update is deterministic regardless of
+	// map iteration order.
+	endpoints := slices.Sorted(maps.Keys(args.Endpoints))
+
+	// The endpoints of an offer all belong to the same application, so the
+	// requested endpoints are resolved on the application already offered.
+	applicationUUID, err := s.modelState.ValidateApplicationAndEndpointsForOffer(
+		ctx, existingOffer.ApplicationName, endpoints,
+	)
+	if err != nil {
+		return errors.Errorf("updating offer %q: %w", args.OfferName, err)
+	}
+
+	err = s.modelState.UpdateOffer(ctx, crossmodelrelation.UpdateOfferArgs{
+		UUID:            offerUUID,
+		ApplicationUUID: applicationUUID,
+		Endpoints:       endpoints,
+		Description:     args.Description,
+		Force:           args.Force,
+	})
+	if err != nil {
+		return errors.Errorf("updating offer %q: %w", args.OfferName, err)
+	}
+	return nil
+}
+
 // isSameOffer returns true if the existing offer is for the same
 // application and offers the same endpoints as the requested ones.
 // Requested endpoint aliases are not persisted as part of the offer and
@@ -207,7 +207,7 @@ func (s *offerServiceSuite) TestOfferAlreadyExistsIdentical(c *tc.C) {
 
 // TestOfferAlreadyExistsDifferentEndpoints tests that Offer returns an error
 // when an offer with the same name already exists but offers different
-// endpoints, since updating offers is not supported.
+// endpoints, since CreateOffer does not update offers.
 func (s *offerServiceSuite) TestOfferAlreadyExistsDifferentEndpoints(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -242,7 +242,7 @@ func (s *offerServiceSuite) TestOfferAlreadyExistsDifferentEndpoints(c *tc.C) {
 
 // TestOfferAlreadyExistsDifferentApplication tests that Offer returns an
 // error when an offer with the same name already exists for a different
-// application, since updating offers is not supported.
+// application, since CreateOffer does not update offers.
 func (s *offerServiceSuite) TestOfferAlreadyExistsDifferentApplication(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -314,7 +314,7 @@ func (s *offerServiceSuite) TestOfferAlreadyExistsDifferentInterface(c *tc.C) {
 // TestOfferAlreadyExistsEndpointsNoLongerExist tests that Offer returns an
 // error when an offer with the same name already exists and the requested
 // endpoints no longer resolve on the application, since the offers differ
-// and updating offers is not supported.
+// and CreateOffer does not update offers.
 func (s *offerServiceSuite) TestOfferAlreadyExistsEndpointsNoLongerExist(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -564,6 +564,103 @@ func (s *offerServiceSuite) TestCreateOfferAccessAndDeleteFail(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, `creating access for offer "test-offer": access boom\ndelete boom`)
 }
 
+// TestUpdateOffer tests that UpdateOffer resolves the requested endpoints on
+// the offered application and updates the offer.
+func (s *offerServiceSuite) TestUpdateOffer(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange
+	offerUUID := tc.Must(c, offer.NewUUID)
+	applicationUUID := uuid.MustNewUUID().String()
+	description := "a new description"
+
+	s.modelState.EXPECT().GetConsumeDetails(gomock.Any(), "test-offer").Return(crossmodelrelation.ConsumeDetails{
+		OfferUUID:       offerUUID.String(),
+		ApplicationName: "test-application",
+	}, nil)
+	s.modelState.EXPECT().ValidateApplicationAndEndpointsForOffer(
+		gomock.Any(), "test-application", []string{"admin", "db"},
+	).Return(applicationUUID, nil)
+	s.modelState.EXPECT().UpdateOffer(gomock.Any(), crossmodelrelation.UpdateOfferArgs{
+		UUID:            offerUUID,
+		ApplicationUUID: applicationUUID,
+		Endpoints:       []string{"admin", "db"},
+		Description:     &description,
+		Force:           true,
+	}).Return(nil)
+
+	// Act
+	err := s.service(c).UpdateOffer(c.Context(), crossmodelrelation.UpdateApplicationOfferArgs{
+		OfferName:   "test-offer",
+		Endpoints:   map[string]string{"db": "db", "admin": "admin"},
+		Description: &description,
+		Force:       true,
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+// TestUpdateOfferNotFound tests that UpdateOffer returns an error satisfying
+// OfferNotFound when the offer does not exist.
+func (s *offerServiceSuite) TestUpdateOfferNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange
+	s.modelState.EXPECT().GetConsumeDetails(gomock.Any(), "test-offer").
+		Return(crossmodelrelation.ConsumeDetails{}, crossmodelrelationerrors.OfferNotFound)
+
+	// Act
+	err := s.service(c).UpdateOffer(c.Context(), crossmodelrelation.UpdateApplicationOfferArgs{
+		OfferName: "test-offer",
+		Endpoints: map[string]string{"db": "db"},
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorIs, crossmodelrelationerrors.OfferNotFound)
+}
+
+// TestUpdateOfferEndpointHasRelations tests that the error from the state
+// is returned when an endpoint with live relations would be removed.
+func (s *offerServiceSuite) TestUpdateOfferEndpointHasRelations(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange
+	offerUUID := tc.Must(c, offer.NewUUID)
+	applicationUUID := uuid.MustNewUUID().String()
+
+	s.modelState.EXPECT().GetConsumeDetails(gomock.Any(), "test-offer").Return(crossmodelrelation.ConsumeDetails{
+		OfferUUID:       offerUUID.String(),
+		ApplicationName: "test-application",
+	}, nil)
+	s.modelState.EXPECT().ValidateApplicationAndEndpointsForOffer(
+		gomock.Any(), "test-application", []string{"admin"},
+	).Return(applicationUUID, nil)
+	s.modelState.EXPECT().UpdateOffer(gomock.Any(), gomock.Any()).
+		Return(crossmodelrelationerrors.OfferEndpointHasRelations)
+
+	// Act
+	err := s.service(c).UpdateOffer(c.Context(), crossmodelrelation.UpdateApplicationOfferArgs{
+		OfferName: "test-offer",
+		Endpoints: map[string]string{"admin": "admin"},
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorIs, crossmodelrelationerrors.OfferEndpointHasRelations)
+}
+
+// TestUpdateOfferValidateArgsEmptyEndpoints tests that UpdateOffer returns
+// an error when the endpoints are empty.
+func (s *offerServiceSuite) TestUpdateOfferValidateArgsEmptyEndpoints(c *tc.C) {
+	// Act
+	err := s.service(c).UpdateOffer(c.Context(), crossmodelrelation.UpdateApplicationOfferArgs{
+		OfferName: "test-offer",
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
 func (s *offerServiceSuite) TestGetOffersEmptyFilters(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
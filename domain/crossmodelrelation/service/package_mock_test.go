@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/domain/crossmodelrelation/service (interfaces: ControllerState,ModelState,ModelMigrationState,ModelRelationNetworkState)
+// Source: github.com/juju/juju/domain/crossmodelrelation/service (interfaces: ControllerState,ModelState,ModelMigrationState,ModelRelationNetworkState,SecretContentDecrypter)
 //
 // Generated by this command:
 //
-//	mockgen -package service -destination package_mock_test.go github.com/juju/juju/domain/crossmodelrelation/service ControllerState,ModelState,ModelMigrationState,ModelRelationNetworkState
+//	mockgen -package service -destination package_mock_test.go github.com/juju/juju/domain/crossmodelrelation/service ControllerState,ModelState,ModelMigrationState,ModelRelationNetworkState,SecretContentDecrypter
 //
 
 // Package service is a generated GoMock package.
@@ -188,6 +188,7 @@ type MockModelStateMockRecorder struct {
 	saveSecretRemoteConsumerExpects                                             []*gomock.Call4_1[context.Context, *secrets.URI, string, secrets.SecretConsumerMetadata, error]
 	setOffererControllerForOffererModelExpects                                  []*gomock.Call3_1[context.Context, string, string, error]
 	setOffererControllerForOffererModelsExpects                                 []*gomock.Call3_1[context.Context, []string, string, error]
+	updateOfferExpects                                                          []*gomock.Call2_1[context.Context, crossmodelrelation.UpdateOfferArgs, error]
 	updateRemoteSecretRevisionExpects                                           []*gomock.Call4_1[context.Context, *secrets.URI, int, string, error]
 	validateApplicationAndEndpointsForOfferExpects                              []*gomock.Call3_2[context.Context, string, []string, string, error]
 }
@@ -1125,6 +1126,24 @@ func (mr *MockModelStateMockRecorder) SetOffererControllerForOffererModels(ctx,
 // MockModelStateSetOffererControllerForOffererModelsCall is the typed call wrapper for SetOffererControllerForOffererModels.
 type MockModelStateSetOffererControllerForOffererModelsCall = gomock.Call3_1[context.Context, []string, string, error]
 
+// UpdateOffer mocks base method.
+func (m *MockModelState) UpdateOffer(arg0 context.Context, arg1 crossmodelrelation.UpdateOfferArgs) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.updateOfferExpects, m.ctrl, m, "UpdateOffer", arg0, arg1)
+}
+
+// UpdateOffer indicates an expected call of UpdateOffer.
+func (mr *MockModelStateMockRecorder) UpdateOffer(arg0, arg1 any) *MockModelStateUpdateOfferCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, crossmodelrelation.UpdateOfferArgs, error](mr.mock.ctrl.T, mr.mock, "UpdateOffer", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1))
+	mr.updateOfferExpects = append(mr.updateOfferExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelStateUpdateOfferCall is the typed call wrapper for UpdateOffer.
+type MockModelStateUpdateOfferCall = gomock.Call2_1[context.Context, crossmodelrelation.UpdateOfferArgs, error]
+
 // UpdateRemoteSecretRevision mocks base method.
 func (m *MockModelState) UpdateRemoteSecretRevision(ctx context.Context, uri *secrets.URI, latestRevision int, applicationUUID string) error {
 	m.ctrl.T.Helper()
@@ -1569,3 +1588,46 @@ func (mr *MockModelRelationNetworkStateMockRecorder) NamespacesForRelationEgress
 
 // MockModelRelationNetworkStateNamespacesForRelationEgressNetworksWatcherCall is the typed call wrapper for NamespacesForRelationEgressNetworksWatcher.
 type MockModelRelationNetworkStateNamespacesForRelationEgressNetworksWatcherCall = gomock.Call0_3[string, string, string]
+
+// MockSecretContentDecrypter is a mock of SecretContentDecrypter interface.
+type MockSecretContentDecrypter struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretContentDecrypterMockRecorder
+	isgomock struct{}
+}
+
+// MockSecretContentDecrypterMockRecorder is the mock recorder for MockSecretContentDecrypter.
+type MockSecretContentDecrypterMockRecorder struct {
+	mock                        *MockSecretContentDecrypter
+	decryptSecretContentExpects []*gomock.Call2_2[context.Context, map[string]string, map[string]string, error]
+}
+
+// NewMockSecretContentDecrypter creates a new mock instance.
+func NewMockSecretContentDecrypter(ctrl *gomock.Controller) *MockSecretContentDecrypter {
+	mock := &MockSecretContentDecrypter{ctrl: ctrl}
+	mock.recorder = &MockSecretContentDecrypterMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretContentDecrypter) EXPECT() *MockSecretContentDecrypterMockRecorder {
+	return m.recorder
+}
+
+// DecryptSecretContent mocks base method.
+func (m *MockSecretContentDecrypter) DecryptSecretContent(ctx context.Context, data map[string]string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.decryptSecretContentExpects, m.ctrl, m, "DecryptSecretContent", ctx, data)
+}
+
+// DecryptSecretContent indicates an expected call of DecryptSecretContent.
+func (mr *MockSecretContentDecrypterMockRecorder) DecryptSecretContent(ctx, data any) *MockSecretContentDecrypterDecryptSecretContentCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, map[string]string, map[string]string, error](mr.mock.ctrl.T, mr.mock, "DecryptSecretContent", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(data))
+	mr.decryptSecretContentExpects = append(mr.decryptSecretContentExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretContentDecrypterDecryptSecretContentCall is the typed call wrapper for DecryptSecretContent.
+type MockSecretContentDecrypterDecryptSecretContentCall = gomock.Call2_2[context.Context, map[string]string, map[string]string, error]
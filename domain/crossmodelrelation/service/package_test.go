@@ -13,21 +13,24 @@ import (
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 )
 
-//go:generate go run github.com/canonical/gomock/mockgen -package service -destination package_mock_test.go github.com/juju/juju/domain/crossmodelrelation/service ControllerState,ModelState,ModelMigrationState,ModelRelationNetworkState
+//go:generate go run github.com/canonical/gomock/mockgen -package service -destination package_mock_test.go github.com/juju/juju/domain/crossmodelrelation/service ControllerState,ModelState,ModelMigrationState,ModelRelationNetworkState,SecretContentDecrypter
 
 type baseSuite struct {
 	controllerState *MockControllerState
 	modelState      *MockModelState
+	secretDecrypter *MockSecretContentDecrypter
 }
 
 func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.controllerState = NewMockControllerState(ctrl)
 	s.modelState = NewMockModelState(ctrl)
+	s.secretDecrypter = NewMockSecretContentDecrypter(ctrl)
 
 	c.Cleanup(func() {
 		s.controllerState = nil
 		s.modelState = nil
+		s.secretDecrypter = nil
 	})
 	return ctrl
 }
@@ -37,6 +40,7 @@ func (s *baseSuite) service(c *tc.C) *Service {
 		controllerState: s.controllerState,
 		modelState:      s.modelState,
 		statusHistory:   domain.NewStatusHistory(loggertesting.WrapCheckLog(c), clock.WallClock),
+		secretDecrypter: s.secretDecrypter,
 		clock:           clock.WallClock,
 		logger:          loggertesting.WrapCheckLog(c),
 	}
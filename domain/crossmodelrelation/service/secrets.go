@@ -18,6 +18,14 @@ import (
 	"github.com/juju/juju/internal/errors"
 )
 
+// SecretContentDecrypter decrypts secret content read from the model
+// database.
+type SecretContentDecrypter interface {
+	// DecryptSecretContent returns secret content read from the model
+	// database decrypted.
+	DecryptSecretContent(ctx context.Context, data map[string]string) (map[string]string, error)
+}
+
 // ModelSecretsState describes retrieval and persistence methods for
 // cross model relations secrets related functionality in the model database.
 type ModelSecretsState interface {
@@ -171,7 +179,15 @@ func (s *Service) ProcessRemoteConsumerGetSecret(
 	}
 
 	data, valueRef, err := s.modelState.GetSecretValue(ctx, uri, wantRevision)
-	return secrets.NewSecretValue(data), valueRef, latestRevision, err
+	if err != nil {
+		return nil, nil, 0, errors.Capture(err)
+	}
+	if len(data) > 0 {
+		if data, err = s.secretDecrypter.DecryptSecretContent(ctx, data); err != nil {
+			return nil, nil, 0, errors.Errorf("decrypting secret content: %w", err)
+		}
+	}
+	return secrets.NewSecretValue(data), valueRef, latestRevision, nil
 }
 
 func (s *Service) updateConsumedRevision(ctx context.Context, consumer unit.Name, uri *secrets.URI, refresh bool) (int, error) {
@@ -113,13 +113,15 @@ func (s *secretsServiceSuite) TestProcessRemoteConsumerGetSecretNoPeekOrRefresh(
 
 	uri := coresecrets.NewURI()
 	consumer := unittesting.GenNewName(c, "consumer/0")
+	stored := map[string]string{"foo": "juju-enc:v1:dek:YmFy"}
 	data := map[string]string{"foo": "bar"}
 
 	s.modelState.EXPECT().GetSecretAccess(gomock.Any(), uri, secret.AccessParams{
 		SubjectTypeID: secret.SubjectApplication,
 		SubjectID:     consumer.Application(),
 	}).Return(secret.RoleView.String(), nil)
-	s.modelState.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(data, nil, nil)
+	s.modelState.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(stored, nil, nil)
+	s.secretDecrypter.EXPECT().DecryptSecretContent(gomock.Any(), stored).Return(data, nil)
 
 	service := s.service(c)
 
@@ -148,6 +150,7 @@ func (s *secretsServiceSuite) TestProcessRemoteConsumerGetSecretPeek(c *tc.C) {
 			CurrentRevision: 665,
 		}, 666, nil)
 	s.modelState.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(data, nil, nil)
+	s.secretDecrypter.EXPECT().DecryptSecretContent(gomock.Any(), data).Return(data, nil)
 
 	service := s.service(c)
 
@@ -177,6 +180,7 @@ func (s *secretsServiceSuite) TestProcessRemoteConsumerGetSecretRefresh(c *tc.C)
 			Label:           "foo",
 		}, 666, nil)
 	s.modelState.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(data, nil, nil)
+	s.secretDecrypter.EXPECT().DecryptSecretContent(gomock.Any(), data).Return(data, nil)
 	s.modelState.EXPECT().SaveSecretRemoteConsumer(gomock.Any(), uri, consumer.String(), coresecrets.SecretConsumerMetadata{
 		CurrentRevision: 666,
 		Label:           "foo",
@@ -120,6 +120,7 @@ type Service struct {
 	controllerState ControllerState
 	modelState      ModelState
 	statusHistory   StatusHistory
+	secretDecrypter SecretContentDecrypter
 	clock           clock.Clock
 	logger          logger.Logger
 }
@@ -129,6 +130,7 @@ func NewService(
 	controllerState ControllerState,
 	modelState ModelState,
 	statusHistory StatusHistory,
+	secretDecrypter SecretContentDecrypter,
 	clock clock.Clock,
 	logger logger.Logger,
 ) *Service {
@@ -136,6 +138,7 @@ func NewService(
 		controllerState: controllerState,
 		modelState:      modelState,
 		statusHistory:   statusHistory,
+		secretDecrypter: secretDecrypter,
 		clock:           clock,
 		logger:          logger,
 	}
@@ -152,6 +155,7 @@ func NewWatchableService(
 	controllerState ControllerState,
 	modelState ModelState,
 	statusHistory StatusHistory,
+	secretDecrypter SecretContentDecrypter,
 	watcherFactory WatcherFactory,
 	clock clock.Clock,
 	logger logger.Logger,
@@ -161,6 +165,7 @@ func NewWatchableService(
 			controllerState: controllerState,
 			modelState:      modelState,
 			statusHistory:   statusHistory,
+			secretDecrypter: secretDecrypter,
 			clock:           clock,
 			logger:          logger,
 		},
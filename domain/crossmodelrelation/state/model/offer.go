@@ -5,7 +5,9 @@ package state
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/canonical/sqlair"
@@ -75,6 +77,153 @@ INSERT INTO offer (*) VALUES ($nameAndUUID.*)`, nameAndUUID{})
 	return errors.Capture(err)
 }
 
+// UpdateOffer updates the endpoints and, if provided, the description of an
+// existing offer. Endpoints no longer offered are removed from the offer and
+// new ones added to it. The modified version of the offer is incremented so
+// that watchers of the offer are notified of the change.
+// Returns [crossmodelrelationerrors.OfferNotFound] if the offer does not
+// exist, and [crossmodelrelationerrors.OfferEndpointHasRelations] if any of
+// the removed endpoints have live relations through the offer, unless forced.
+func (st *State) UpdateOffer(
+	ctx context.Context,
+	args crossmodelrelation.UpdateOfferArgs,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	offerEndpointsStmt, err := st.Prepare(`
+SELECT endpoint_uuid AS &uuid.uuid
+FROM   offer_endpoint
+WHERE  offer_uuid = $uuid.uuid
+`, uuid{})
+	if err != nil {
+		return errors.Errorf("preparing offer endpoints query: %w", err)
+	}
+
+	// Only relations made through the offer, that is with an offer
+	// connection, are considered.
+	relatedEndpointsStmt, err := st.Prepare(`
+SELECT DISTINCT ae.endpoint_name AS &name.name
+FROM   offer_connection AS oc
+JOIN   relation AS r ON oc.remote_relation_uuid = r.uuid
+JOIN   relation_endpoint AS re ON r.uuid = re.relation_uuid
+JOIN   v_application_endpoint AS ae ON re.endpoint_uuid = ae.application_endpoint_uuid
+WHERE  oc.offer_uuid = $uuid.uuid
+AND    re.endpoint_uuid IN ($uuids[:])
+AND    r.life_id = 0
+ORDER BY ae.endpoint_name
+`, name{}, uuid{}, uuids{})
+	if err != nil {
+		return errors.Errorf("preparing related offer endpoints query: %w", err)
+	}
+
+	deleteOfferEndpointsStmt, err := st.Prepare(`
+DELETE FROM offer_endpoint
+WHERE  offer_uuid = $uuid.uuid
+AND    endpoint_uuid IN ($uuids[:])
+`, uuid{}, uuids{})
+	if err != nil {
+		return errors.Errorf("preparing delete offer_endpoint query: %w", err)
+	}
+
+	insertOfferEndpointStmt, err := st.Prepare(`
+INSERT INTO offer_endpoint (*) VALUES ($offerEndpoint.*)`, offerEndpoint{})
+	if err != nil {
+		return errors.Errorf("preparing insert offer_endpoint query: %w", err)
+	}
+
+	updateOfferStmt, err := st.Prepare(`
+UPDATE offer
+SET    modified_version = modified_version + 1
+WHERE  uuid = $uuid.uuid
+`, uuid{})
+	if err != nil {
+		return errors.Errorf("preparing update offer query: %w", err)
+	}
+
+	updateOfferDescriptionStmt, err := st.Prepare(`
+UPDATE offer
+SET    description = $offerDescription.description,
+       modified_version = modified_version + 1
+WHERE  uuid = $offerDescription.uuid
+`, offerDescription{})
+	if err != nil {
+		return errors.Errorf("preparing update offer description query: %w", err)
+	}
+
+	offerUUID := uuid{UUID: args.UUID.String()}
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var current []uuid
+		err := tx.Query(ctx, offerEndpointsStmt, offerUUID).GetAll(&current)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("%q: %w", args.UUID, crossmodelrelationerrors.OfferNotFound)
+		} else if err != nil {
+			return errors.Errorf("getting offer endpoints: %w", err)
+		}
+
+		requested, err := st.getEndpointUUIDs(ctx, tx, args.ApplicationUUID, args.Endpoints)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		var removed uuids
+		for _, endpoint := range current {
+			if !slices.Contains(requested, endpoint.UUID) {
+				removed = append(removed, endpoint.UUID)
+			}
+		}
+		var added []offerEndpoint
+		for _, endpointUUID := range requested {
+			if !slices.ContainsFunc(current, func(u uuid) bool { return u.UUID == endpointUUID }) {
+				added = append(added, offerEndpoint{OfferUUID: args.UUID.String(), EndpointUUID: endpointUUID})
+			}
+		}
+
+		if len(removed) > 0 && !args.Force {
+			var related []name
+			err := tx.Query(ctx, relatedEndpointsStmt, offerUUID, removed).GetAll(&related)
+			if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+				return errors.Errorf("checking offer endpoint relations: %w", err)
+			} else if len(related) > 0 {
+				return errors.Errorf("cannot remove endpoints %q with live relations",
+					strings.Join(transform.Slice(related, func(n name) string { return n.Name }), ", "),
+				).Add(crossmodelrelationerrors.OfferEndpointHasRelations)
+			}
+		}
+
+		if len(removed) > 0 {
+			if err := tx.Query(ctx, deleteOfferEndpointsStmt, offerUUID, removed).Run(); err != nil {
+				return errors.Errorf("deleting offer_endpoint rows: %w", err)
+			}
+		}
+		if len(added) > 0 {
+			if err := tx.Query(ctx, insertOfferEndpointStmt, added).Run(); err != nil {
+				return errors.Errorf("inserting offer_endpoint rows: %w", err)
+			}
+		}
+
+		if args.Description == nil {
+			err = tx.Query(ctx, updateOfferStmt, offerUUID).Run()
+		} else {
+			err = tx.Query(ctx, updateOfferDescriptionStmt, offerDescription{
+				UUID: args.UUID.String(),
+				Description: sql.NullString{
+					String: *args.Description,
+					Valid:  *args.Description != "",
+				},
+			}).Run()
+		}
+		if err != nil {
+			return errors.Errorf("updating offer: %w", err)
+		}
+		return nil
+	})
+
+	return errors.Capture(err)
+}
+
 // ValidateApplicationAndEndpointsForOffer checks that the application exists
 // and is not dead, and that the endpoints are valid.
 func (st *State) ValidateApplicationAndEndpointsForOffer(
@@ -235,6 +235,139 @@ func (s *modelOfferSuite) TestDeleteFailedOffer(c *tc.C) {
 	c.Check(s.readOfferEndpoints(c), tc.HasLen, 0)
 }
 
+// addUpdateOfferApplication adds an application with the "db", "log" and
+// "admin" endpoints, returning the application UUID and the endpoint UUIDs
+// keyed by endpoint name.
+func (s *modelOfferSuite) addUpdateOfferApplication(c *tc.C) (string, map[string]string) {
+	charmUUID := s.addCharm(c)
+	s.addCharmMetadataWithDescription(c, charmUUID, "charm description")
+	appUUID := s.addApplication(c, charmUUID, "test-application")
+
+	endpointUUIDs := make(map[string]string)
+	for _, name := range []string{"db", "log", "admin"} {
+		relationUUID := s.addCharmRelation(c, charmUUID, charm.Relation{
+			Name:      name,
+			Role:      charm.RoleProvider,
+			Interface: name,
+			Scope:     charm.ScopeGlobal,
+		})
+		endpointUUIDs[name] = s.addApplicationEndpoint(c, appUUID, relationUUID)
+	}
+	return appUUID.String(), endpointUUIDs
+}
+
+func (s *modelOfferSuite) TestUpdateOffer(c *tc.C) {
+	// Arrange
+	appUUID, endpointUUIDs := s.addUpdateOfferApplication(c)
+	offerUUID := s.addOffer(c, "test-offer", []string{endpointUUIDs["db"], endpointUUIDs["log"]})
+	description := "offer description"
+
+	// Act
+	err := s.state.UpdateOffer(c.Context(), crossmodelrelation.UpdateOfferArgs{
+		UUID:            offerUUID,
+		ApplicationUUID: appUUID,
+		Endpoints:       []string{"admin", "db"},
+		Description:     &description,
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.readOfferEndpoints(c), tc.SameContents, []offerEndpoint{
+		{OfferUUID: offerUUID.String(), EndpointUUID: endpointUUIDs["admin"]},
+		{OfferUUID: offerUUID.String(), EndpointUUID: endpointUUIDs["db"]},
+	})
+	var modifiedVersion int
+	err = s.DB().QueryRowContext(c.Context(),
+		`SELECT modified_version FROM offer WHERE uuid = ?`, offerUUID).Scan(&modifiedVersion)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(modifiedVersion, tc.Equals, 1)
+
+	details, err := s.state.GetOfferDetails(c.Context(), crossmodelrelation.OfferFilter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(details, tc.HasLen, 1)
+	c.Check(details[0].ApplicationDescription, tc.Equals, description)
+}
+
+func (s *modelOfferSuite) TestUpdateOfferResetDescription(c *tc.C) {
+	// Arrange
+	appUUID, endpointUUIDs := s.addUpdateOfferApplication(c)
+	offerUUID := s.addOffer(c, "test-offer", []string{endpointUUIDs["db"]})
+	s.query(c, `UPDATE offer SET description = 'offer description' WHERE uuid = ?`, offerUUID)
+	description := ""
+
+	// Act
+	err := s.state.UpdateOffer(c.Context(), crossmodelrelation.UpdateOfferArgs{
+		UUID:            offerUUID,
+		ApplicationUUID: appUUID,
+		Endpoints:       []string{"db"},
+		Description:     &description,
+	})
+
+	// Assert: the charm description is used again.
+	c.Assert(err, tc.ErrorIsNil)
+	details, err := s.state.GetOfferDetails(c.Context(), crossmodelrelation.OfferFilter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(details, tc.HasLen, 1)
+	c.Check(details[0].ApplicationDescription, tc.Equals, "charm description")
+}
+
+func (s *modelOfferSuite) TestUpdateOfferNotFound(c *tc.C) {
+	// Arrange
+	appUUID, _ := s.addUpdateOfferApplication(c)
+
+	// Act
+	err := s.state.UpdateOffer(c.Context(), crossmodelrelation.UpdateOfferArgs{
+		UUID:            tc.Must(c, offer.NewUUID),
+		ApplicationUUID: appUUID,
+		Endpoints:       []string{"db"},
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorIs, crossmodelrelationerrors.OfferNotFound)
+}
+
+func (s *modelOfferSuite) TestUpdateOfferRemoveEndpointWithRelations(c *tc.C) {
+	// Arrange
+	appUUID, endpointUUIDs := s.addUpdateOfferApplication(c)
+	offerUUID := s.addOffer(c, "test-offer", []string{endpointUUIDs["db"], endpointUUIDs["log"]})
+	relationUUID := s.addOfferConnection(c, offerUUID, domainstatus.RelationStatusTypeJoined)
+	s.addRelationEndpoint(c, relationUUID, endpointUUIDs["db"])
+
+	// Act
+	err := s.state.UpdateOffer(c.Context(), crossmodelrelation.UpdateOfferArgs{
+		UUID:            offerUUID,
+		ApplicationUUID: appUUID,
+		Endpoints:       []string{"log"},
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorIs, crossmodelrelationerrors.OfferEndpointHasRelations)
+	c.Check(err, tc.ErrorMatches, `cannot remove endpoints "db" with live relations`)
+	c.Check(s.readOfferEndpoints(c), tc.HasLen, 2)
+}
+
+func (s *modelOfferSuite) TestUpdateOfferRemoveEndpointWithRelationsForce(c *tc.C) {
+	// Arrange
+	appUUID, endpointUUIDs := s.addUpdateOfferApplication(c)
+	offerUUID := s.addOffer(c, "test-offer", []string{endpointUUIDs["db"], endpointUUIDs["log"]})
+	relationUUID := s.addOfferConnection(c, offerUUID, domainstatus.RelationStatusTypeJoined)
+	s.addRelationEndpoint(c, relationUUID, endpointUUIDs["db"])
+
+	// Act
+	err := s.state.UpdateOffer(c.Context(), crossmodelrelation.UpdateOfferArgs{
+		UUID:            offerUUID,
+		ApplicationUUID: appUUID,
+		Endpoints:       []string{"log"},
+		Force:           true,
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.readOfferEndpoints(c), tc.DeepEquals, []offerEndpoint{
+		{OfferUUID: offerUUID.String(), EndpointUUID: endpointUUIDs["log"]},
+	})
+}
+
 func (s *modelOfferSuite) TestCreateOfferEndpointsInsertedInNameOrder(c *tc.C) {
 	// Arrange
 	charmUUID := s.addCharm(c)
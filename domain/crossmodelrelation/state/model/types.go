@@ -30,6 +30,12 @@ type nameAndUUID struct {
 }
 
 // offerEndpoint represent a row in the offer_endpoint table.
+// offerDescription is used to set the description of an offer.
+type offerDescription struct {
+	UUID        string         `db:"uuid"`
+	Description sql.NullString `db:"description"`
+}
+
 type offerEndpoint struct {
 	OfferUUID    string `db:"offer_uuid"`
 	EndpointUUID string `db:"endpoint_uuid"`
@@ -48,6 +48,37 @@ func (a ApplicationOfferArgs) Validate() error {
 	return nil
 }
 
+// UpdateApplicationOfferArgs contains parameters used to update an existing
+// application offer in place.
+type UpdateApplicationOfferArgs struct {
+	// OfferName is the name of the offer to update.
+	OfferName string
+
+	// Endpoints is the collection of endpoint names the offer exposes after
+	// the update. Endpoints of the application not in the collection are
+	// removed from the offer, any others are added to it.
+	// The map allows for advertised endpoint names to be aliased.
+	Endpoints map[string]string
+
+	// Description, if not nil, replaces the description of the offer. An
+	// empty description reverts to the description of the offered charm.
+	Description *string
+
+	// Force allows endpoints with live relations to be removed from the
+	// offer. The relations themselves are left in place.
+	Force bool
+}
+
+func (a UpdateApplicationOfferArgs) Validate() error {
+	if a.OfferName == "" {
+		return errors.Errorf("offer name cannot be empty").Add(coreerrors.NotValid)
+	}
+	if len(a.Endpoints) == 0 {
+		return errors.Errorf("endpoints cannot be empty").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
 // EndpointFilterTerm represents a remote endpoint filter.
 type EndpointFilterTerm struct {
 	// Name is an endpoint name.
@@ -396,6 +427,26 @@ type CreateOfferArgs struct {
 	OfferName string
 }
 
+// UpdateOfferArgs contains parameters used to update an offer.
+type UpdateOfferArgs struct {
+	// UUID is the unique identifier of the offer.
+	UUID offer.UUID
+
+	// ApplicationUUID is the UUID of the application to which the offer
+	// pertains.
+	ApplicationUUID string
+
+	// Endpoints is the collection of endpoint names offered after the
+	// update.
+	Endpoints []string
+
+	// Description, if not nil, replaces the description of the offer.
+	Description *string
+
+	// Force allows endpoints with live relations to be removed.
+	Force bool
+}
+
 // OfferFilter is used to query applications offered
 // by this model.
 type OfferFilter struct {
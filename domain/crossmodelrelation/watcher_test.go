@@ -190,6 +190,7 @@ func (s *watcherSuite) setupService(c *tc.C, factory domain.WatchableDBFactory)
 		controllerState,
 		modelState,
 		domain.NewStatusHistory(loggertesting.WrapCheckLog(c), clock.WallClock),
+		nil,
 		domain.NewWatcherFactory(factory, loggertesting.WrapCheckLog(c)),
 		clock.WallClock,
 		loggertesting.WrapCheckLog(c),
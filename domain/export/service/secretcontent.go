@@ -0,0 +1,56 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/juju/domain/export/types/v4_1_0"
+	"github.com/juju/juju/internal/errors"
+)
+
+// SecretContentDecrypter decrypts secret content read from the model
+// database.
+type SecretContentDecrypter interface {
+	// DecryptSecretContent returns secret content read from the model
+	// database decrypted.
+	DecryptSecretContent(ctx context.Context, data map[string]string) (map[string]string, error)
+}
+
+// NewSecretDecryptingState returns export state which decrypts the secret
+// content in the exported payload. Secret content is encrypted with keys
+// held by the exporting controller, so it is exported in the clear and
+// encrypted again with the importing controller's keys. The model's data
+// keys are not exported.
+func NewSecretDecryptingState(st State, decrypter SecretContentDecrypter) State {
+	return secretDecryptingState{
+		st:        st,
+		decrypter: decrypter,
+	}
+}
+
+type secretDecryptingState struct {
+	st        State
+	decrypter SecretContentDecrypter
+}
+
+// Export is part of the [State] interface.
+func (s secretDecryptingState) Export(ctx context.Context) (*v4_1_0.ModelExport, error) {
+	payload, err := s.st.Export(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	for i, content := range payload.SecretContent {
+		decrypted, err := s.decrypter.DecryptSecretContent(ctx, map[string]string{content.Name: content.Content})
+		if err != nil {
+			return nil, errors.Errorf("decrypting content %q of secret revision %q: %w", content.Name, content.RevisionUUID, err)
+		}
+		payload.SecretContent[i].Content = decrypted[content.Name]
+	}
+	// The data keys are wrapped with the exporting controller's
+	// key-encryption key, which the importing controller does not hold.
+	// The importing controller creates its own data keys.
+	payload.SecretDataKey = nil
+	return payload, nil
+}
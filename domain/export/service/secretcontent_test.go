@@ -0,0 +1,73 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/domain/export/types/v4_1_0"
+	"github.com/juju/juju/internal/errors"
+)
+
+type secretContentSuite struct{}
+
+func TestSecretContentSuite(t *testing.T) {
+	tc.Run(t, &secretContentSuite{})
+}
+
+func (s *secretContentSuite) TestExportDecryptsSecretContent(c *tc.C) {
+	st := NewSecretDecryptingState(&stubStateV4_1_0{
+		export: func(context.Context) (*v4_1_0.ModelExport, error) {
+			return &v4_1_0.ModelExport{
+				SecretContent: []v4_1_0.SecretContent{
+					{RevisionUUID: "rev-1", Name: "password", Content: "enc:s3cret"},
+					{RevisionUUID: "rev-1", Name: "user", Content: "admin"},
+				},
+				SecretDataKey: []v4_1_0.SecretDataKey{
+					{UUID: "dek-1", WrappedKey: "local:kek-1:wrapped"},
+				},
+			}, nil
+		},
+	}, stubDecrypter{})
+
+	payload, err := st.Export(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(payload.SecretContent, tc.DeepEquals, []v4_1_0.SecretContent{
+		{RevisionUUID: "rev-1", Name: "password", Content: "s3cret"},
+		{RevisionUUID: "rev-1", Name: "user", Content: "admin"},
+	})
+	c.Check(payload.SecretDataKey, tc.HasLen, 0)
+}
+
+func (s *secretContentSuite) TestExportDecryptError(c *tc.C) {
+	st := NewSecretDecryptingState(&stubStateV4_1_0{
+		export: func(context.Context) (*v4_1_0.ModelExport, error) {
+			return &v4_1_0.ModelExport{
+				SecretContent: []v4_1_0.SecretContent{
+					{RevisionUUID: "rev-1", Name: "password", Content: "bad"},
+				},
+			}, nil
+		},
+	}, stubDecrypter{})
+
+	_, err := st.Export(c.Context())
+	c.Assert(err, tc.ErrorMatches, `decrypting content "password" of secret revision "rev-1": boom`)
+}
+
+type stubDecrypter struct{}
+
+func (stubDecrypter) DecryptSecretContent(_ context.Context, data map[string]string) (map[string]string, error) {
+	result := make(map[string]string, len(data))
+	for k, v := range data {
+		if v == "bad" {
+			return nil, errors.New("boom")
+		}
+		result[k] = strings.TrimPrefix(v, "enc:")
+	}
+	return result, nil
+}
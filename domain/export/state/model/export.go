@@ -113,6 +113,14 @@ func (st *State) Export(ctx context.Context) (*v4_1_0.ModelExport, error) {
 	if err != nil {
 		return nil, fmt.Errorf("preparing ApplicationK8sResourcesManaged statement: %w", err)
 	}
+	stmtApplicationPlacementAffinity, err := sqlair.Prepare(`SELECT &ApplicationPlacementAffinity.* FROM "application_placement_affinity"`, v4_1_0.ApplicationPlacementAffinity{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing ApplicationPlacementAffinity statement: %w", err)
+	}
+	stmtApplicationPlacementPolicy, err := sqlair.Prepare(`SELECT &ApplicationPlacementPolicy.* FROM "application_placement_policy"`, v4_1_0.ApplicationPlacementPolicy{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing ApplicationPlacementPolicy statement: %w", err)
+	}
 	stmtApplicationPlatform, err := sqlair.Prepare(`SELECT &ApplicationPlatform.* FROM "application_platform"`, v4_1_0.ApplicationPlatform{})
 	if err != nil {
 		return nil, fmt.Errorf("preparing ApplicationPlatform statement: %w", err)
@@ -481,6 +489,10 @@ func (st *State) Export(ctx context.Context) (*v4_1_0.ModelExport, error) {
 	if err != nil {
 		return nil, fmt.Errorf("preparing MachinePlatform statement: %w", err)
 	}
+	stmtMachineRecoveryAttempt, err := sqlair.Prepare(`SELECT &MachineRecoveryAttempt.* FROM "machine_recovery_attempt"`, v4_1_0.MachineRecoveryAttempt{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing MachineRecoveryAttempt statement: %w", err)
+	}
 	stmtMachineReprovision, err := sqlair.Prepare(`SELECT &MachineReprovision.* FROM "machine_reprovision"`, v4_1_0.MachineReprovision{})
 	if err != nil {
 		return nil, fmt.Errorf("preparing MachineReprovision statement: %w", err)
@@ -717,6 +729,10 @@ func (st *State) Export(ctx context.Context) (*v4_1_0.ModelExport, error) {
 	if err != nil {
 		return nil, fmt.Errorf("preparing Removal statement: %w", err)
 	}
+	stmtRemovalAttempt, err := sqlair.Prepare(`SELECT &RemovalAttempt.* FROM "removal_attempt"`, v4_1_0.RemovalAttempt{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing RemovalAttempt statement: %w", err)
+	}
 	stmtRemovalType, err := sqlair.Prepare(`SELECT &RemovalType.* FROM "removal_type"`, v4_1_0.RemovalType{})
 	if err != nil {
 		return nil, fmt.Errorf("preparing RemovalType statement: %w", err)
@@ -765,6 +781,10 @@ func (st *State) Export(ctx context.Context) (*v4_1_0.ModelExport, error) {
 	if err != nil {
 		return nil, fmt.Errorf("preparing Secret statement: %w", err)
 	}
+	stmtSecretAccessorType, err := sqlair.Prepare(`SELECT &SecretAccessorType.* FROM "secret_accessor_type"`, v4_1_0.SecretAccessorType{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing SecretAccessorType statement: %w", err)
+	}
 	stmtSecretApplicationOwner, err := sqlair.Prepare(`SELECT &SecretApplicationOwner.* FROM "secret_application_owner"`, v4_1_0.SecretApplicationOwner{})
 	if err != nil {
 		return nil, fmt.Errorf("preparing SecretApplicationOwner statement: %w", err)
@@ -773,10 +793,22 @@ func (st *State) Export(ctx context.Context) (*v4_1_0.ModelExport, error) {
 	if err != nil {
 		return nil, fmt.Errorf("preparing SecretContent statement: %w", err)
 	}
+	stmtSecretDataKey, err := sqlair.Prepare(`SELECT &SecretDataKey.* FROM "secret_data_key"`, v4_1_0.SecretDataKey{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing SecretDataKey statement: %w", err)
+	}
 	stmtSecretDeletedValueRef, err := sqlair.Prepare(`SELECT &SecretDeletedValueRef.* FROM "secret_deleted_value_ref"`, v4_1_0.SecretDeletedValueRef{})
 	if err != nil {
 		return nil, fmt.Errorf("preparing SecretDeletedValueRef statement: %w", err)
 	}
+	stmtSecretExternalRef, err := sqlair.Prepare(`SELECT &SecretExternalRef.* FROM "secret_external_ref"`, v4_1_0.SecretExternalRef{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing SecretExternalRef statement: %w", err)
+	}
+	stmtSecretGenerator, err := sqlair.Prepare(`SELECT &SecretGenerator.* FROM "secret_generator"`, v4_1_0.SecretGenerator{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing SecretGenerator statement: %w", err)
+	}
 	stmtSecretGrantScopeType, err := sqlair.Prepare(`SELECT &SecretGrantScopeType.* FROM "secret_grant_scope_type"`, v4_1_0.SecretGrantScopeType{})
 	if err != nil {
 		return nil, fmt.Errorf("preparing SecretGrantScopeType statement: %w", err)
@@ -813,10 +845,18 @@ func (st *State) Export(ctx context.Context) (*v4_1_0.ModelExport, error) {
 	if err != nil {
 		return nil, fmt.Errorf("preparing SecretRevision statement: %w", err)
 	}
+	stmtSecretRevisionAccess, err := sqlair.Prepare(`SELECT &SecretRevisionAccess.* FROM "secret_revision_access"`, v4_1_0.SecretRevisionAccess{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing SecretRevisionAccess statement: %w", err)
+	}
 	stmtSecretRevisionExpire, err := sqlair.Prepare(`SELECT &SecretRevisionExpire.* FROM "secret_revision_expire"`, v4_1_0.SecretRevisionExpire{})
 	if err != nil {
 		return nil, fmt.Errorf("preparing SecretRevisionExpire statement: %w", err)
 	}
+	stmtSecretRevisionExternalVersion, err := sqlair.Prepare(`SELECT &SecretRevisionExternalVersion.* FROM "secret_revision_external_version"`, v4_1_0.SecretRevisionExternalVersion{})
+	if err != nil {
+		return nil, fmt.Errorf("preparing SecretRevisionExternalVersion statement: %w", err)
+	}
 	stmtSecretRevisionObsolete, err := sqlair.Prepare(`SELECT &SecretRevisionObsolete.* FROM "secret_revision_obsolete"`, v4_1_0.SecretRevisionObsolete{})
 	if err != nil {
 		return nil, fmt.Errorf("preparing SecretRevisionObsolete statement: %w", err)
@@ -1097,6 +1137,12 @@ func (st *State) Export(ctx context.Context) (*v4_1_0.ModelExport, error) {
 		if err := tx.Query(ctx, stmtApplicationK8sResourcesManaged).GetAll(&modelExport.ApplicationK8sResourcesManaged); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying ApplicationK8sResourcesManaged (table application_k8s_resources_managed): %w", err)
 		}
+		if err := tx.Query(ctx, stmtApplicationPlacementAffinity).GetAll(&modelExport.ApplicationPlacementAffinity); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying ApplicationPlacementAffinity (table application_placement_affinity): %w", err)
+		}
+		if err := tx.Query(ctx, stmtApplicationPlacementPolicy).GetAll(&modelExport.ApplicationPlacementPolicy); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying ApplicationPlacementPolicy (table application_placement_policy): %w", err)
+		}
 		if err := tx.Query(ctx, stmtApplicationPlatform).GetAll(&modelExport.ApplicationPlatform); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying ApplicationPlatform (table application_platform): %w", err)
 		}
@@ -1373,6 +1419,9 @@ func (st *State) Export(ctx context.Context) (*v4_1_0.ModelExport, error) {
 		if err := tx.Query(ctx, stmtMachinePlatform).GetAll(&modelExport.MachinePlatform); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying MachinePlatform (table machine_platform): %w", err)
 		}
+		if err := tx.Query(ctx, stmtMachineRecoveryAttempt).GetAll(&modelExport.MachineRecoveryAttempt); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying MachineRecoveryAttempt (table machine_recovery_attempt): %w", err)
+		}
 		if err := tx.Query(ctx, stmtMachineReprovision).GetAll(&modelExport.MachineReprovision); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying MachineReprovision (table machine_reprovision): %w", err)
 		}
@@ -1550,6 +1599,9 @@ func (st *State) Export(ctx context.Context) (*v4_1_0.ModelExport, error) {
 		if err := tx.Query(ctx, stmtRemoval).GetAll(&modelExport.Removal); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying Removal (table removal): %w", err)
 		}
+		if err := tx.Query(ctx, stmtRemovalAttempt).GetAll(&modelExport.RemovalAttempt); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying RemovalAttempt (table removal_attempt): %w", err)
+		}
 		if err := tx.Query(ctx, stmtRemovalType).GetAll(&modelExport.RemovalType); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying RemovalType (table removal_type): %w", err)
 		}
@@ -1586,15 +1638,27 @@ func (st *State) Export(ctx context.Context) (*v4_1_0.ModelExport, error) {
 		if err := tx.Query(ctx, stmtSecret).GetAll(&modelExport.Secret); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying Secret (table secret): %w", err)
 		}
+		if err := tx.Query(ctx, stmtSecretAccessorType).GetAll(&modelExport.SecretAccessorType); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying SecretAccessorType (table secret_accessor_type): %w", err)
+		}
 		if err := tx.Query(ctx, stmtSecretApplicationOwner).GetAll(&modelExport.SecretApplicationOwner); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying SecretApplicationOwner (table secret_application_owner): %w", err)
 		}
 		if err := tx.Query(ctx, stmtSecretContent).GetAll(&modelExport.SecretContent); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying SecretContent (table secret_content): %w", err)
 		}
+		if err := tx.Query(ctx, stmtSecretDataKey).GetAll(&modelExport.SecretDataKey); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying SecretDataKey (table secret_data_key): %w", err)
+		}
 		if err := tx.Query(ctx, stmtSecretDeletedValueRef).GetAll(&modelExport.SecretDeletedValueRef); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying SecretDeletedValueRef (table secret_deleted_value_ref): %w", err)
 		}
+		if err := tx.Query(ctx, stmtSecretExternalRef).GetAll(&modelExport.SecretExternalRef); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying SecretExternalRef (table secret_external_ref): %w", err)
+		}
+		if err := tx.Query(ctx, stmtSecretGenerator).GetAll(&modelExport.SecretGenerator); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying SecretGenerator (table secret_generator): %w", err)
+		}
 		if err := tx.Query(ctx, stmtSecretGrantScopeType).GetAll(&modelExport.SecretGrantScopeType); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying SecretGrantScopeType (table secret_grant_scope_type): %w", err)
 		}
@@ -1622,9 +1686,15 @@ func (st *State) Export(ctx context.Context) (*v4_1_0.ModelExport, error) {
 		if err := tx.Query(ctx, stmtSecretRevision).GetAll(&modelExport.SecretRevision); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying SecretRevision (table secret_revision): %w", err)
 		}
+		if err := tx.Query(ctx, stmtSecretRevisionAccess).GetAll(&modelExport.SecretRevisionAccess); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying SecretRevisionAccess (table secret_revision_access): %w", err)
+		}
 		if err := tx.Query(ctx, stmtSecretRevisionExpire).GetAll(&modelExport.SecretRevisionExpire); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying SecretRevisionExpire (table secret_revision_expire): %w", err)
 		}
+		if err := tx.Query(ctx, stmtSecretRevisionExternalVersion).GetAll(&modelExport.SecretRevisionExternalVersion); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("querying SecretRevisionExternalVersion (table secret_revision_external_version): %w", err)
+		}
 		if err := tx.Query(ctx, stmtSecretRevisionObsolete).GetAll(&modelExport.SecretRevisionObsolete); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return fmt.Errorf("querying SecretRevisionObsolete (table secret_revision_obsolete): %w", err)
 		}
@@ -146,6 +146,18 @@ type ApplicationK8sResourcesManaged struct {
 	ApplicationUUID string `db:"application_uuid" json:"application_uuid" yaml:"application_uuid"`
 }
 
+type ApplicationPlacementAffinity struct {
+	ApplicationUUID       string `db:"application_uuid" json:"application_uuid" yaml:"application_uuid"`
+	TargetApplicationUUID string `db:"target_application_uuid" json:"target_application_uuid" yaml:"target_application_uuid"`
+	AntiAffinity          bool   `db:"anti_affinity" json:"anti_affinity" yaml:"anti_affinity"`
+}
+
+type ApplicationPlacementPolicy struct {
+	ApplicationUUID    string `db:"application_uuid" json:"application_uuid" yaml:"application_uuid"`
+	MaxUnitsPerMachine *int64 `db:"max_units_per_machine" json:"max_units_per_machine" yaml:"max_units_per_machine"`
+	MaxUnitsPerZone    *int64 `db:"max_units_per_zone" json:"max_units_per_zone" yaml:"max_units_per_zone"`
+}
+
 type ApplicationPlatform struct {
 	ApplicationUUID string  `db:"application_uuid" json:"application_uuid" yaml:"application_uuid"`
 	OsID            string  `db:"os_id" json:"os_id" yaml:"os_id"`
@@ -501,20 +513,26 @@ type CharmTerm struct {
 }
 
 type Constraint struct {
-	UUID             string  `db:"uuid" json:"uuid" yaml:"uuid"`
-	Arch             *string `db:"arch" json:"arch" yaml:"arch"`
-	CpuCores         *int64  `db:"cpu_cores" json:"cpu_cores" yaml:"cpu_cores"`
-	CpuPower         *int64  `db:"cpu_power" json:"cpu_power" yaml:"cpu_power"`
-	Mem              *int64  `db:"mem" json:"mem" yaml:"mem"`
-	RootDisk         *int64  `db:"root_disk" json:"root_disk" yaml:"root_disk"`
-	RootDiskSource   *string `db:"root_disk_source" json:"root_disk_source" yaml:"root_disk_source"`
-	InstanceRole     *string `db:"instance_role" json:"instance_role" yaml:"instance_role"`
-	InstanceType     *string `db:"instance_type" json:"instance_type" yaml:"instance_type"`
-	ContainerTypeID  *int64  `db:"container_type_id" json:"container_type_id" yaml:"container_type_id"`
-	VirtType         *string `db:"virt_type" json:"virt_type" yaml:"virt_type"`
-	AllocatePublicIp *int64  `db:"allocate_public_ip" json:"allocate_public_ip" yaml:"allocate_public_ip"`
-	ImageID          *string `db:"image_id" json:"image_id" yaml:"image_id"`
-	IpFamily         *string `db:"ip_family" json:"ip_family" yaml:"ip_family"`
+	UUID                string  `db:"uuid" json:"uuid" yaml:"uuid"`
+	Arch                *string `db:"arch" json:"arch" yaml:"arch"`
+	CpuCores            *int64  `db:"cpu_cores" json:"cpu_cores" yaml:"cpu_cores"`
+	CpuPower            *int64  `db:"cpu_power" json:"cpu_power" yaml:"cpu_power"`
+	Mem                 *int64  `db:"mem" json:"mem" yaml:"mem"`
+	RootDisk            *int64  `db:"root_disk" json:"root_disk" yaml:"root_disk"`
+	RootDiskSource      *string `db:"root_disk_source" json:"root_disk_source" yaml:"root_disk_source"`
+	InstanceRole        *string `db:"instance_role" json:"instance_role" yaml:"instance_role"`
+	InstanceType        *string `db:"instance_type" json:"instance_type" yaml:"instance_type"`
+	ContainerTypeID     *int64  `db:"container_type_id" json:"container_type_id" yaml:"container_type_id"`
+	VirtType            *string `db:"virt_type" json:"virt_type" yaml:"virt_type"`
+	AllocatePublicIp    *int64  `db:"allocate_public_ip" json:"allocate_public_ip" yaml:"allocate_public_ip"`
+	ImageID             *string `db:"image_id" json:"image_id" yaml:"image_id"`
+	IpFamily            *string `db:"ip_family" json:"ip_family" yaml:"ip_family"`
+	Market              *string `db:"market" json:"market" yaml:"market"`
+	MaxPrice            *string `db:"max_price" json:"max_price" yaml:"max_price"`
+	CapacityReservation *string `db:"capacity_reservation" json:"capacity_reservation" yaml:"capacity_reservation"`
+	PlacementGroup      *string `db:"placement_group" json:"placement_group" yaml:"placement_group"`
+	Gpus                *int64  `db:"gpus" json:"gpus" yaml:"gpus"`
+	GpuType             *string `db:"gpu_type" json:"gpu_type" yaml:"gpu_type"`
 }
 
 type ConstraintSpace struct {
@@ -786,6 +804,14 @@ type MachinePlatform struct {
 	ArchitectureID int64   `db:"architecture_id" json:"architecture_id" yaml:"architecture_id"`
 }
 
+type MachineRecoveryAttempt struct {
+	MachineUUID string    `db:"machine_uuid" json:"machine_uuid" yaml:"machine_uuid"`
+	AttemptedAt time.Time `db:"attempted_at" json:"attempted_at" yaml:"attempted_at"`
+	InstanceID  string    `db:"instance_id" json:"instance_id" yaml:"instance_id"`
+	Reason      string    `db:"reason" json:"reason" yaml:"reason"`
+	Error       *string   `db:"error" json:"error" yaml:"error"`
+}
+
 type MachineReprovision struct {
 	MachineName string    `db:"machine_name" json:"machine_name" yaml:"machine_name"`
 	RequestedAt time.Time `db:"requested_at" json:"requested_at" yaml:"requested_at"`
@@ -908,8 +934,10 @@ type ObjectStorePlacement struct {
 }
 
 type Offer struct {
-	UUID string `db:"uuid" json:"uuid" yaml:"uuid"`
-	Name string `db:"name" json:"name" yaml:"name"`
+	UUID            string  `db:"uuid" json:"uuid" yaml:"uuid"`
+	Name            string  `db:"name" json:"name" yaml:"name"`
+	Description     *string `db:"description" json:"description" yaml:"description"`
+	ModifiedVersion int64   `db:"modified_version" json:"modified_version" yaml:"modified_version"`
 }
 
 type OfferConnection struct {
@@ -1137,6 +1165,13 @@ type Removal struct {
 	Arg           *string   `db:"arg" json:"arg" yaml:"arg"`
 }
 
+type RemovalAttempt struct {
+	RemovalUUID     string     `db:"removal_uuid" json:"removal_uuid" yaml:"removal_uuid"`
+	Attempts        int64      `db:"attempts" json:"attempts" yaml:"attempts"`
+	LastError       *string    `db:"last_error" json:"last_error" yaml:"last_error"`
+	LastAttemptedAt *time.Time `db:"last_attempted_at" json:"last_attempted_at" yaml:"last_attempted_at"`
+}
+
 type RemovalType struct {
 	ID   int64  `db:"id" json:"id" yaml:"id"`
 	Name string `db:"name" json:"name" yaml:"name"`
@@ -1211,6 +1246,11 @@ type Secret struct {
 	ID string `db:"id" json:"id" yaml:"id"`
 }
 
+type SecretAccessorType struct {
+	ID   *int64  `db:"id" json:"id" yaml:"id"`
+	Type *string `db:"type" json:"type" yaml:"type"`
+}
+
 type SecretApplicationOwner struct {
 	SecretID        string  `db:"secret_id" json:"secret_id" yaml:"secret_id"`
 	ApplicationUUID string  `db:"application_uuid" json:"application_uuid" yaml:"application_uuid"`
@@ -1223,12 +1263,32 @@ type SecretContent struct {
 	Content      string `db:"content" json:"content" yaml:"content"`
 }
 
+type SecretDataKey struct {
+	UUID       string    `db:"uuid" json:"uuid" yaml:"uuid"`
+	WrappedKey string    `db:"wrapped_key" json:"wrapped_key" yaml:"wrapped_key"`
+	CreatedAt  time.Time `db:"created_at" json:"created_at" yaml:"created_at"`
+}
+
 type SecretDeletedValueRef struct {
 	RevisionUUID string `db:"revision_uuid" json:"revision_uuid" yaml:"revision_uuid"`
 	BackendUUID  string `db:"backend_uuid" json:"backend_uuid" yaml:"backend_uuid"`
 	RevisionID   string `db:"revision_id" json:"revision_id" yaml:"revision_id"`
 }
 
+type SecretExternalRef struct {
+	SecretID    string  `db:"secret_id" json:"secret_id" yaml:"secret_id"`
+	BackendUUID string  `db:"backend_uuid" json:"backend_uuid" yaml:"backend_uuid"`
+	Path        string  `db:"path" json:"path" yaml:"path"`
+	ContentKey  *string `db:"content_key" json:"content_key" yaml:"content_key"`
+}
+
+type SecretGenerator struct {
+	SecretID string  `db:"secret_id" json:"secret_id" yaml:"secret_id"`
+	Format   string  `db:"format" json:"format" yaml:"format"`
+	Length   int64   `db:"length" json:"length" yaml:"length"`
+	Charset  *string `db:"charset" json:"charset" yaml:"charset"`
+}
+
 type SecretGrantScopeType struct {
 	ID   *int64  `db:"id" json:"id" yaml:"id"`
 	Type *string `db:"type" json:"type" yaml:"type"`
@@ -1292,11 +1352,26 @@ type SecretRevision struct {
 	UpdateTime *time.Time `db:"update_time" json:"update_time" yaml:"update_time"`
 }
 
+type SecretRevisionAccess struct {
+	SecretID        string    `db:"secret_id" json:"secret_id" yaml:"secret_id"`
+	Revision        int64     `db:"revision" json:"revision" yaml:"revision"`
+	AccessorTypeID  int64     `db:"accessor_type_id" json:"accessor_type_id" yaml:"accessor_type_id"`
+	AccessorName    string    `db:"accessor_name" json:"accessor_name" yaml:"accessor_name"`
+	FirstAccessTime time.Time `db:"first_access_time" json:"first_access_time" yaml:"first_access_time"`
+	LastAccessTime  time.Time `db:"last_access_time" json:"last_access_time" yaml:"last_access_time"`
+	AccessCount     int64     `db:"access_count" json:"access_count" yaml:"access_count"`
+}
+
 type SecretRevisionExpire struct {
 	RevisionUUID string    `db:"revision_uuid" json:"revision_uuid" yaml:"revision_uuid"`
 	ExpireTime   time.Time `db:"expire_time" json:"expire_time" yaml:"expire_time"`
 }
 
+type SecretRevisionExternalVersion struct {
+	RevisionUUID string `db:"revision_uuid" json:"revision_uuid" yaml:"revision_uuid"`
+	Version      string `db:"version" json:"version" yaml:"version"`
+}
+
 type SecretRevisionObsolete struct {
 	RevisionUUID  string `db:"revision_uuid" json:"revision_uuid" yaml:"revision_uuid"`
 	Obsolete      bool   `db:"obsolete" json:"obsolete" yaml:"obsolete"`
@@ -1660,6 +1735,8 @@ type ModelExport struct {
 	ApplicationExposedEndpointSpace          []ApplicationExposedEndpointSpace          `json:"application_exposed_endpoint_space" yaml:"application_exposed_endpoint_space"`
 	ApplicationExtraEndpoint                 []ApplicationExtraEndpoint                 `json:"application_extra_endpoint" yaml:"application_extra_endpoint"`
 	ApplicationK8sResourcesManaged           []ApplicationK8sResourcesManaged           `json:"application_k8s_resources_managed" yaml:"application_k8s_resources_managed"`
+	ApplicationPlacementAffinity             []ApplicationPlacementAffinity             `json:"application_placement_affinity" yaml:"application_placement_affinity"`
+	ApplicationPlacementPolicy               []ApplicationPlacementPolicy               `json:"application_placement_policy" yaml:"application_placement_policy"`
 	ApplicationPlatform                      []ApplicationPlatform                      `json:"application_platform" yaml:"application_platform"`
 	ApplicationRemoteConsumer                []ApplicationRemoteConsumer                `json:"application_remote_consumer" yaml:"application_remote_consumer"`
 	ApplicationRemoteOfferer                 []ApplicationRemoteOfferer                 `json:"application_remote_offerer" yaml:"application_remote_offerer"`
@@ -1752,6 +1829,7 @@ type ModelExport struct {
 	MachinePlacement                         []MachinePlacement                         `json:"machine_placement" yaml:"machine_placement"`
 	MachinePlacementScope                    []MachinePlacementScope                    `json:"machine_placement_scope" yaml:"machine_placement_scope"`
 	MachinePlatform                          []MachinePlatform                          `json:"machine_platform" yaml:"machine_platform"`
+	MachineRecoveryAttempt                   []MachineRecoveryAttempt                   `json:"machine_recovery_attempt" yaml:"machine_recovery_attempt"`
 	MachineReprovision                       []MachineReprovision                       `json:"machine_reprovision" yaml:"machine_reprovision"`
 	MachineRequiresReboot                    []MachineRequiresReboot                    `json:"machine_requires_reboot" yaml:"machine_requires_reboot"`
 	MachineSshHostKey                        []MachineSshHostKey                        `json:"machine_ssh_host_key" yaml:"machine_ssh_host_key"`
@@ -1811,6 +1889,7 @@ type ModelExport struct {
 	RelationUnitSettingArchive               []RelationUnitSettingArchive               `json:"relation_unit_setting_archive" yaml:"relation_unit_setting_archive"`
 	RelationUnitSettingsHash                 []RelationUnitSettingsHash                 `json:"relation_unit_settings_hash" yaml:"relation_unit_settings_hash"`
 	Removal                                  []Removal                                  `json:"removal" yaml:"removal"`
+	RemovalAttempt                           []RemovalAttempt                           `json:"removal_attempt" yaml:"removal_attempt"`
 	RemovalType                              []RemovalType                              `json:"removal_type" yaml:"removal_type"`
 	ResolveMode                              []ResolveMode                              `json:"resolve_mode" yaml:"resolve_mode"`
 	Resource                                 []Resource                                 `json:"resource" yaml:"resource"`
@@ -1823,9 +1902,13 @@ type ModelExport struct {
 	ResourceState                            []ResourceState                            `json:"resource_state" yaml:"resource_state"`
 	Schema                                   []Schema                                   `json:"schema" yaml:"schema"`
 	Secret                                   []Secret                                   `json:"secret" yaml:"secret"`
+	SecretAccessorType                       []SecretAccessorType                       `json:"secret_accessor_type" yaml:"secret_accessor_type"`
 	SecretApplicationOwner                   []SecretApplicationOwner                   `json:"secret_application_owner" yaml:"secret_application_owner"`
 	SecretContent                            []SecretContent                            `json:"secret_content" yaml:"secret_content"`
+	SecretDataKey                            []SecretDataKey                            `json:"secret_data_key" yaml:"secret_data_key"`
 	SecretDeletedValueRef                    []SecretDeletedValueRef                    `json:"secret_deleted_value_ref" yaml:"secret_deleted_value_ref"`
+	SecretExternalRef                        []SecretExternalRef                        `json:"secret_external_ref" yaml:"secret_external_ref"`
+	SecretGenerator                          []SecretGenerator                          `json:"secret_generator" yaml:"secret_generator"`
 	SecretGrantScopeType                     []SecretGrantScopeType                     `json:"secret_grant_scope_type" yaml:"secret_grant_scope_type"`
 	SecretGrantSubjectType                   []SecretGrantSubjectType                   `json:"secret_grant_subject_type" yaml:"secret_grant_subject_type"`
 	SecretMetadata                           []SecretMetadata                           `json:"secret_metadata" yaml:"secret_metadata"`
@@ -1835,7 +1918,9 @@ type ModelExport struct {
 	SecretRemoteUnitConsumer                 []SecretRemoteUnitConsumer                 `json:"secret_remote_unit_consumer" yaml:"secret_remote_unit_consumer"`
 	SecretReservation                        []SecretReservation                        `json:"secret_reservation" yaml:"secret_reservation"`
 	SecretRevision                           []SecretRevision                           `json:"secret_revision" yaml:"secret_revision"`
+	SecretRevisionAccess                     []SecretRevisionAccess                     `json:"secret_revision_access" yaml:"secret_revision_access"`
 	SecretRevisionExpire                     []SecretRevisionExpire                     `json:"secret_revision_expire" yaml:"secret_revision_expire"`
+	SecretRevisionExternalVersion            []SecretRevisionExternalVersion            `json:"secret_revision_external_version" yaml:"secret_revision_external_version"`
 	SecretRevisionObsolete                   []SecretRevisionObsolete                   `json:"secret_revision_obsolete" yaml:"secret_revision_obsolete"`
 	SecretRole                               []SecretRole                               `json:"secret_role" yaml:"secret_role"`
 	SecretRotatePolicy                       []SecretRotatePolicy                       `json:"secret_rotate_policy" yaml:"secret_rotate_policy"`
@@ -19,6 +19,9 @@ type State interface {
 	// Controller returns the controller record.
 	Controller(ctx context.Context, controllerUUID string) (*crossmodel.ControllerInfo, error)
 
+	// ControllerForAlias returns the controller record with the given alias.
+	ControllerForAlias(ctx context.Context, alias string) (*crossmodel.ControllerInfo, error)
+
 	// UpdateExternalController persists the input controller
 	// record.
 	UpdateExternalController(ctx context.Context, ec crossmodel.ControllerInfo) error
@@ -76,6 +79,28 @@ func (s *Service) Controller(
 	return controllerInfo, nil
 }
 
+// ControllerForAlias returns the controller record with the given alias, as
+// used in the source of an offer URL.
+// The following errors may be returned:
+// - [externalcontrollererrors.NotFound] if no external controller has the
+// alias.
+func (s *Service) ControllerForAlias(
+	ctx context.Context,
+	alias string,
+) (*crossmodel.ControllerInfo, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if alias == "" {
+		return nil, errors.Errorf("%w for empty alias", externalcontrollererrors.NotFound)
+	}
+	controllerInfo, err := s.st.ControllerForAlias(ctx, alias)
+	if err != nil {
+		return nil, errors.Errorf("retrieving external controller %q: %w", alias, err)
+	}
+	return controllerInfo, nil
+}
+
 // ControllerForModel returns the controller record that's associated
 // with the modelUUID.
 func (s *Service) ControllerForModel(
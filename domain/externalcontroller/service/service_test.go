@@ -10,6 +10,7 @@ import (
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/core/crossmodel"
+	externalcontrollererrors "github.com/juju/juju/domain/externalcontroller/errors"
 	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/testhelpers"
 	"github.com/juju/juju/internal/uuid"
@@ -129,6 +130,39 @@ func (s *serviceSuite) TestRetrieveExternalControllerForModelNotFound(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, `external controller not found for model "model1"`)
 }
 
+func (s *serviceSuite) TestRetrieveExternalControllerForAliasSuccess(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ec := crossmodel.ControllerInfo{
+		ControllerUUID: uuid.MustNewUUID().String(),
+		Alias:          "that-other-controller",
+		Addrs:          []string{"10.10.10.10"},
+		CACert:         "random-cert-string",
+	}
+
+	s.state.EXPECT().ControllerForAlias(gomock.Any(), "that-other-controller").Return(&ec, nil)
+
+	res, err := NewService(s.state).ControllerForAlias(c.Context(), "that-other-controller")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res, tc.Equals, &ec)
+}
+
+func (s *serviceSuite) TestRetrieveExternalControllerForAliasEmpty(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := NewService(s.state).ControllerForAlias(c.Context(), "")
+	c.Assert(err, tc.ErrorIs, externalcontrollererrors.NotFound)
+}
+
+func (s *serviceSuite) TestRetrieveExternalControllerForAliasError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().ControllerForAlias(gomock.Any(), "ctrl").Return(nil, errors.New("boom"))
+
+	_, err := NewService(s.state).ControllerForAlias(c.Context(), "ctrl")
+	c.Assert(err, tc.ErrorMatches, `retrieving external controller "ctrl": boom`)
+}
+
 func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
@@ -27,6 +27,7 @@ type MockState struct {
 type MockStateMockRecorder struct {
 	mock                                       *MockState
 	controllerExpects                          []*gomock.Call2_2[context.Context, string, *crossmodel.ControllerInfo, error]
+	controllerForAliasExpects                  []*gomock.Call2_2[context.Context, string, *crossmodel.ControllerInfo, error]
 	controllersForModelsExpects                []*gomock.Call1V_2[context.Context, string, []crossmodel.ControllerInfo, error]
 	importExternalControllersExpects           []*gomock.Call2_1[context.Context, []crossmodel.ControllerInfo, error]
 	modelsForControllerExpects                 []*gomock.Call2_2[context.Context, string, []string, error]
@@ -64,6 +65,24 @@ func (mr *MockStateMockRecorder) Controller(ctx, controllerUUID any) *MockStateC
 // MockStateControllerCall is the typed call wrapper for Controller.
 type MockStateControllerCall = gomock.Call2_2[context.Context, string, *crossmodel.ControllerInfo, error]
 
+// ControllerForAlias mocks base method.
+func (m *MockState) ControllerForAlias(ctx context.Context, alias string) (*crossmodel.ControllerInfo, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.controllerForAliasExpects, m.ctrl, m, "ControllerForAlias", ctx, alias)
+}
+
+// ControllerForAlias indicates an expected call of ControllerForAlias.
+func (mr *MockStateMockRecorder) ControllerForAlias(ctx, alias any) *MockStateControllerForAliasCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, *crossmodel.ControllerInfo, error](mr.mock.ctrl.T, mr.mock, "ControllerForAlias", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(alias))
+	mr.controllerForAliasExpects = append(mr.controllerForAliasExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateControllerForAliasCall is the typed call wrapper for ControllerForAlias.
+type MockStateControllerForAliasCall = gomock.Call2_2[context.Context, string, *crossmodel.ControllerInfo, error]
+
 // ControllersForModels mocks base method.
 func (m *MockState) ControllersForModels(ctx context.Context, modelUUIDs ...string) ([]crossmodel.ControllerInfo, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +71,55 @@ WHERE  ctrl.uuid = $Controller.uuid`
 	return &rows.ToControllerInfo()[0], nil
 }
 
+// ControllerForAlias returns the external controller with the given alias.
+// If no controller has the alias, an error satisfying
+// [externalcontrollererrors.NotFound] is returned.
+func (st *State) ControllerForAlias(
+	ctx context.Context,
+	alias string,
+) (*crossmodel.ControllerInfo, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	controller := Controller{Alias: sql.NullString{String: alias, Valid: true}}
+
+	q := `
+SELECT (ctrl.uuid,
+       alias,
+       ca_cert,
+       address) as (&Controller.*),
+       model.uuid as &Controller.model
+FROM   external_controller AS ctrl
+       LEFT JOIN external_model AS model
+       ON        ctrl.uuid = model.controller_uuid
+       LEFT JOIN external_controller_address AS addrs
+       ON        ctrl.uuid = addrs.controller_uuid
+WHERE  ctrl.alias = $Controller.alias`
+	s, err := st.Prepare(q, controller)
+	if err != nil {
+		return nil, errors.Errorf("preparing %q: %w", q, err)
+	}
+
+	var rows Controllers
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return errors.Capture(tx.Query(ctx, s, controller).GetAll(&rows))
+	}); errors.Is(err, sqlair.ErrNoRows) || len(rows) == 0 {
+		return nil, errors.Errorf("%w for alias %q", externalcontrollererrors.NotFound, alias)
+	} else if err != nil {
+		return nil, errors.Errorf("querying external controller: %w", err)
+	}
+
+	controllers := rows.ToControllerInfo()
+	if len(controllers) > 1 {
+		return nil, errors.Errorf(
+			"alias %q matches %d external controllers", alias, len(controllers),
+		)
+	}
+	return &controllers[0], nil
+}
+
 // ControllersForModels returns the external controllers for the given model
 // UUIDs. If no model UUIDs are provided, then no controllers are returned.
 func (st *State) ControllersForModels(ctx context.Context, modelUUIDs ...string) ([]crossmodel.ControllerInfo, error) {
@@ -12,6 +12,7 @@ import (
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/core/crossmodel"
+	externalcontrollererrors "github.com/juju/juju/domain/externalcontroller/errors"
 	schematesting "github.com/juju/juju/domain/schema/testing"
 	"github.com/juju/juju/internal/uuid"
 )
@@ -49,6 +50,39 @@ func (s *stateSuite) TestRetrieveExternalController(c *tc.C) {
 	c.Assert(controllerInfo.Addrs, tc.SameContents, []string{"192.168.1.1", "10.0.0.1"})
 }
 
+func (s *stateSuite) TestRetrieveExternalControllerForAlias(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	db := s.DB()
+
+	_, err := db.Exec(`INSERT INTO external_controller VALUES
+("ctrl1", "my-controller", "test-cert"),
+("ctrl2", "other-controller", "other-cert")`)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = db.Exec(`INSERT INTO external_controller_address VALUES
+("addr1", "ctrl1", "192.168.1.1"),
+("addr2", "ctrl2", "10.0.0.1")`)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = db.Exec(`INSERT INTO external_model VALUES
+("model1", "ctrl1")`)
+	c.Assert(err, tc.ErrorIsNil)
+
+	controllerInfo, err := st.ControllerForAlias(c.Context(), "my-controller")
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(controllerInfo.ControllerUUID, tc.Equals, "ctrl1")
+	c.Check(controllerInfo.Alias, tc.Equals, "my-controller")
+	c.Check(controllerInfo.CACert, tc.Equals, "test-cert")
+	c.Check(controllerInfo.Addrs, tc.DeepEquals, []string{"192.168.1.1"})
+	c.Check(controllerInfo.ModelUUIDs, tc.DeepEquals, []string{"model1"})
+}
+
+func (s *stateSuite) TestRetrieveExternalControllerForAliasNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	_, err := st.ControllerForAlias(c.Context(), "missing")
+	c.Assert(err, tc.ErrorIs, externalcontrollererrors.NotFound)
+}
+
 func (s *stateSuite) TestRetrieveExternalControllerWithoutAddresses(c *tc.C) {
 	st := NewState(s.TxnRunnerFactory())
 	db := s.DB()
@@ -3,6 +3,32 @@
 
 package logging
 
+import (
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// Protocol identifies the wire protocol spoken by the configured logging
+// endpoint.
+type Protocol string
+
+const (
+	// ProtocolLoki pushes log records using the Loki push API.
+	ProtocolLoki Protocol = "loki"
+	// ProtocolOTLP exports log records using the OTLP/HTTP logs protocol.
+	ProtocolOTLP Protocol = "otlp"
+)
+
+// Validate returns an error if the protocol is not recognised. An empty
+// protocol is treated as [ProtocolLoki].
+func (p Protocol) Validate() error {
+	switch p {
+	case "", ProtocolLoki, ProtocolOTLP:
+		return nil
+	}
+	return errors.Errorf("unknown logging protocol %q", p).Add(coreerrors.NotValid)
+}
+
 // LokiConfig holds the controller-wide Loki push API configuration.
 type LokiConfig struct {
 	// Endpoint is the Loki push API URL.
@@ -18,4 +44,7 @@ type LokiConfig struct {
 	// OrgID is the organization/tenant ID for multi-tenant Loki
 	// deployments. When empty, no X-Scope-OrgID header is sent.
 	OrgID string
+	// Protocol is the protocol spoken by the endpoint. When empty, the
+	// endpoint is assumed to be a Loki push API.
+	Protocol Protocol
 }
@@ -95,15 +95,18 @@ func (s *Service) SetLokiConfig(ctx context.Context, config logging.LokiConfig)
 	defer span.End()
 
 	if config.Endpoint == "" {
-		return errors.Errorf("empty loki endpoint").Add(coreerrors.NotValid)
+		return errors.Errorf("empty log forwarding endpoint").Add(coreerrors.NotValid)
 	}
 
 	u, err := url.Parse(config.Endpoint)
 	if err != nil {
-		return errors.Errorf("loki endpoint %q: %w", config.Endpoint, err).Add(coreerrors.NotValid)
+		return errors.Errorf("log forwarding endpoint %q: %w", config.Endpoint, err).Add(coreerrors.NotValid)
 	}
 	if u.Scheme == "" || u.Host == "" {
-		return errors.Errorf("loki endpoint %q missing scheme or host", config.Endpoint).Add(coreerrors.NotValid)
+		return errors.Errorf("log forwarding endpoint %q missing scheme or host", config.Endpoint).Add(coreerrors.NotValid)
+	}
+	if err := config.Protocol.Validate(); err != nil {
+		return errors.Capture(err)
 	}
 
 	id, err := uuid.NewUUID()
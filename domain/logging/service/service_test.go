@@ -54,6 +54,16 @@ func (s *serviceSuite) TestSetLokiConfigInvalidURLReturnsError(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
 }
 
+func (s *serviceSuite) TestSetLokiConfigUnknownProtocolReturnsError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewWatchableService(s.st, s.watcherFactory).SetLokiConfig(c.Context(), logging.LokiConfig{
+		Endpoint: "http://loki:3100/loki/api/v1/push",
+		Protocol: "syslog",
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
 func (s *serviceSuite) TestSetLokiConfigInsecureSkipVerifyTrue(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -41,8 +41,8 @@ func (st *State) SetLokiConfig(ctx context.Context, id string, config logging.Lo
 	}
 
 	insertStmt, err := st.Prepare(`
-INSERT INTO logging_loki_config (uuid, endpoint, ca_cert, insecure_skip_verify, org_id)
-VALUES ($lokiConfig.uuid, $lokiConfig.endpoint, $lokiConfig.ca_cert, $lokiConfig.insecure_skip_verify, $lokiConfig.org_id)`,
+INSERT INTO logging_loki_config (uuid, endpoint, ca_cert, insecure_skip_verify, org_id, protocol)
+VALUES ($lokiConfig.uuid, $lokiConfig.endpoint, $lokiConfig.ca_cert, $lokiConfig.insecure_skip_verify, $lokiConfig.org_id, $lokiConfig.protocol)`,
 		lokiConfig{})
 	if err != nil {
 		return errors.Errorf("preparing insert statement: %w", err)
@@ -54,6 +54,10 @@ VALUES ($lokiConfig.uuid, $lokiConfig.endpoint, $lokiConfig.ca_cert, $lokiConfig
 		CACertificate:      &config.CACertificate,
 		InsecureSkipVerify: nsBoolToNil(config.InsecureSkipVerify),
 		OrgID:              config.OrgID,
+		Protocol:           string(config.Protocol),
+	}
+	if dbConfig.Protocol == "" {
+		dbConfig.Protocol = string(logging.ProtocolLoki)
 	}
 
 	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
@@ -107,6 +111,7 @@ SELECT &lokiConfig.* FROM logging_loki_config
 		CACertificate:      caCert,
 		InsecureSkipVerify: nsBoolToPtr(config.InsecureSkipVerify),
 		OrgID:              config.OrgID,
+		Protocol:           logging.Protocol(config.Protocol),
 	}, nil
 }
 
@@ -34,6 +34,22 @@ func (s *stateSuite) TestSetLokiConfig(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	c.Check(config.Endpoint, tc.Equals, "http://loki:3100/loki/api/v1/push")
 	c.Check(config.CACertificate, tc.Equals, "ca-cert")
+	c.Check(config.Protocol, tc.Equals, logging.ProtocolLoki)
+}
+
+func (s *stateSuite) TestSetLokiConfigProtocolOTLP(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.SetLokiConfig(c.Context(), "some-uuid-1", logging.LokiConfig{
+		Endpoint: "https://otel-collector:4318/v1/logs",
+		Protocol: logging.ProtocolOTLP,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	config, err := st.GetLokiConfig(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(config.Endpoint, tc.Equals, "https://otel-collector:4318/v1/logs")
+	c.Check(config.Protocol, tc.Equals, logging.ProtocolOTLP)
 }
 
 func (s *stateSuite) TestSetLokiConfigInsecureSkipVerifyTrue(c *tc.C) {
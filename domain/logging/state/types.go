@@ -11,6 +11,7 @@ type lokiConfig struct {
 	CACertificate      *string      `db:"ca_cert"`
 	InsecureSkipVerify sql.NullBool `db:"insecure_skip_verify"`
 	OrgID              string       `db:"org_id"`
+	Protocol           string       `db:"protocol"`
 }
 
 // lokiExistsRow is used to query whether any Loki config row exists.
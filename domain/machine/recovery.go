@@ -0,0 +1,106 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machine
+
+import (
+	"time"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/modelconfig"
+)
+
+const (
+	// MaxRecoveryAttempts is the number of recovery attempts made for a
+	// machine within [RecoveryAttemptWindow] before automatic recovery gives
+	// up on it.
+	MaxRecoveryAttempts = 5
+
+	// InitialRecoveryBackoff is the time to wait after the first recovery
+	// attempt for a machine before making another. The wait doubles with
+	// each further attempt, up to [MaxRecoveryBackoff].
+	InitialRecoveryBackoff = 5 * time.Minute
+
+	// MaxRecoveryBackoff is the longest time to wait between recovery
+	// attempts for a machine.
+	MaxRecoveryBackoff = time.Hour
+
+	// RecoveryAttemptWindow is how long a recovery attempt counts towards
+	// [MaxRecoveryAttempts].
+	RecoveryAttemptWindow = 24 * time.Hour
+
+	// RecoveryHistoryLimit is the number of recovery attempts kept in the
+	// history of each machine.
+	RecoveryHistoryLimit = 10
+)
+
+// RecoveryAttempt describes an attempt to automatically recover a machine
+// whose cloud instance has stopped or disappeared.
+type RecoveryAttempt struct {
+	// AttemptedAt is when the attempt was made.
+	AttemptedAt time.Time
+
+	// InstanceID is the failed cloud instance of the machine.
+	InstanceID instance.Id
+
+	// Reason describes how the cloud instance had failed.
+	Reason string
+
+	// Error describes why the attempt failed. It is empty when a replacement
+	// cloud instance was requested for the machine.
+	Error string
+}
+
+// RecoveryStatus describes the automatic recovery of a machine.
+type RecoveryStatus struct {
+	// Policy is the model's machine recovery policy.
+	Policy modelconfig.MachineRecoveryPolicy
+
+	// Attempts is the number of recent attempts that count towards
+	// [MaxRecoveryAttempts].
+	Attempts int
+
+	// NextAttemptAt is the earliest time at which another attempt will be
+	// made if the machine's cloud instance has failed. It is zero if an
+	// attempt can be made straight away, or if no more attempts will be
+	// made.
+	NextAttemptAt time.Time
+
+	// History holds the most recent attempts, oldest first.
+	History []RecoveryAttempt
+}
+
+// NewRecoveryStatus returns the recovery status at the given time of a
+// machine with the given recovery history, ordered oldest first.
+func NewRecoveryStatus(
+	policy modelconfig.MachineRecoveryPolicy, history []RecoveryAttempt, now time.Time,
+) RecoveryStatus {
+	status := RecoveryStatus{
+		Policy:  policy,
+		History: history,
+	}
+	for _, attempt := range history {
+		if now.Sub(attempt.AttemptedAt) < RecoveryAttemptWindow {
+			status.Attempts++
+		}
+	}
+	if policy != modelconfig.MachineRecoveryPolicyReprovision ||
+		status.Attempts == 0 || status.Exhausted() {
+		return status
+	}
+
+	backoff := InitialRecoveryBackoff << (status.Attempts - 1)
+	if backoff > MaxRecoveryBackoff {
+		backoff = MaxRecoveryBackoff
+	}
+	if next := history[len(history)-1].AttemptedAt.Add(backoff); next.After(now) {
+		status.NextAttemptAt = next
+	}
+	return status
+}
+
+// Exhausted reports whether automatic recovery has given up on the machine
+// until its recent attempts fall outside of [RecoveryAttemptWindow].
+func (s RecoveryStatus) Exhausted() bool {
+	return s.Attempts >= MaxRecoveryAttempts
+}
@@ -37,6 +37,9 @@ type Provider interface {
 	environs.InstanceLister
 	environs.InstanceTypesFetcher
 	environs.InstancePrechecker
+
+	// StopInstances shuts down the instances with the specified IDs.
+	StopInstances(context.Context, ...instance.Id) error
 }
 
 // ProviderService provides the API for working with machines using the
@@ -137,12 +140,20 @@ func (s *ProviderService) detachLostMachineCloudInstance(
 		return errors.Errorf("detaching lost cloud instance for machine %q: %w", machineName, err)
 	}
 
-	statusInfo := corestatus.StatusInfo{
+	s.recordReprovisionStatusHistory(ctx, machineName, corestatus.StatusInfo{
 		Status:  corestatus.Pending,
 		Message: reprovisioningStatusMessage,
 		Data:    statusData,
 		Since:   &now,
-	}
+	})
+	return nil
+}
+
+// recordReprovisionStatusHistory records the pending machine and instance
+// status of a machine whose cloud instance has been detached.
+func (s *ProviderService) recordReprovisionStatusHistory(
+	ctx context.Context, machineName machine.Name, statusInfo corestatus.StatusInfo,
+) {
 	for _, namespace := range []statushistory.Namespace{
 		domainstatus.MachineNamespace.WithID(machineName.String()),
 		domainstatus.MachineInstanceNamespace.WithID(machineName.String()),
@@ -151,8 +162,6 @@ func (s *ProviderService) detachLostMachineCloudInstance(
 			s.logger.Warningf(ctx, "recording reprovisioning status history: %w", err)
 		}
 	}
-
-	return nil
 }
 
 // AddMachine creates the net node and machines if required, depending
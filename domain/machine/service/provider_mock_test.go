@@ -42,6 +42,7 @@ type MockProviderMockRecorder struct {
 	prepareForBootstrapExpects    []*gomock.Call2_1[environs.BootstrapContext, string, error]
 	recommendedPoolForKindExpects []*gomock.Call1_1[storage.StorageKind, *storage.Config]
 	setConfigExpects              []*gomock.Call2_1[context.Context, *config.Config, error]
+	stopInstancesExpects          []*gomock.Call1V_1[context.Context, instance.Id, error]
 	storageProviderExpects        []*gomock.Call1_2[storage.ProviderType, storage.Provider, error]
 	storageProviderTypesExpects   []*gomock.Call0_2[[]storage.ProviderType, error]
 }
@@ -256,6 +257,25 @@ func (mr *MockProviderMockRecorder) SetConfig(ctx, cfg any) *MockProviderSetConf
 // MockProviderSetConfigCall is the typed call wrapper for SetConfig.
 type MockProviderSetConfigCall = gomock.Call2_1[context.Context, *config.Config, error]
 
+// StopInstances mocks base method.
+func (m *MockProvider) StopInstances(arg0 context.Context, arg1 ...instance.Id) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1V_1(&m.recorder.stopInstancesExpects, m.ctrl, m, "StopInstances", arg0, arg1...)
+}
+
+// StopInstances indicates an expected call of StopInstances.
+func (mr *MockProviderMockRecorder) StopInstances(arg0 any, arg1 ...any) *MockProviderStopInstancesCall {
+	mr.mock.ctrl.T.Helper()
+	varArgs := gomock.EnsureVariadicMatcher(arg1)
+	call := gomock.NewCall1V_1[context.Context, instance.Id, error](mr.mock.ctrl.T, mr.mock, "StopInstances", gomock.EnsureMatcher(arg0), varArgs)
+	mr.stopInstancesExpects = append(mr.stopInstancesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockProviderStopInstancesCall is the typed call wrapper for StopInstances.
+type MockProviderStopInstancesCall = gomock.Call1V_1[context.Context, instance.Id, error]
+
 // StorageProvider mocks base method.
 func (m *MockProvider) StorageProvider(arg0 storage.ProviderType) (storage.Provider, error) {
 	m.ctrl.T.Helper()
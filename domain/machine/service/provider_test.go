@@ -904,8 +904,9 @@ func (s *providerServiceSuite) expectMachineDetached() {
 }
 
 type reprovisionInstance struct {
-	id     instance.Id
-	status status.Status
+	id      instance.Id
+	status  status.Status
+	message string
 }
 
 func (i reprovisionInstance) Id() instance.Id {
@@ -913,7 +914,7 @@ func (i reprovisionInstance) Id() instance.Id {
 }
 
 func (i reprovisionInstance) Status(context.Context) instance.Status {
-	return instance.Status{Status: i.status}
+	return instance.Status{Status: i.status, Message: i.message}
 }
 
 func (i reprovisionInstance) Addresses(context.Context) (corenetwork.ProviderAddresses, error) {
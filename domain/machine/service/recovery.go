@@ -0,0 +1,258 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/modelconfig"
+	corestatus "github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/trace"
+	domainmachine "github.com/juju/juju/domain/machine"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetAllMachineRecoveryStatuses returns the automatic recovery status of all
+// the machines in the model which have a recovery history, keyed by machine
+// name.
+func (s *Service) GetAllMachineRecoveryStatuses(ctx context.Context) (map[machine.Name]domainmachine.RecoveryStatus, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	policy, err := s.getMachineRecoveryPolicy(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	attempts, err := s.st.GetAllMachineRecoveryAttempts(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting machine recovery attempts: %w", err)
+	}
+
+	now := s.clock.Now().UTC()
+	result := make(map[machine.Name]domainmachine.RecoveryStatus, len(attempts))
+	for name, history := range attempts {
+		result[name] = domainmachine.NewRecoveryStatus(policy, history, now)
+	}
+	return result, nil
+}
+
+func (s *Service) getMachineRecoveryPolicy(ctx context.Context) (modelconfig.MachineRecoveryPolicy, error) {
+	value, err := s.st.GetMachineRecoveryPolicy(ctx)
+	if err != nil {
+		return "", errors.Errorf("getting machine recovery policy: %w", err)
+	} else if value == "" {
+		return modelconfig.MachineRecoveryPolicyNone, nil
+	}
+
+	policy := modelconfig.MachineRecoveryPolicy(value)
+	if err := policy.Validate(); err != nil {
+		return "", errors.Capture(err)
+	}
+	return policy, nil
+}
+
+// RecoverFailedMachine makes an attempt to automatically recover a machine
+// whose cloud instance has stopped or disappeared, as directed by the model's
+// machine recovery policy. Nothing is done if the policy does not allow it,
+// if the machine is backing off from a previous attempt, if the machine agent
+// is still present or if the cloud instance is running.
+//
+// Otherwise the failed cloud instance is shut down, so that it cannot come
+// back alongside its replacement, and the machine is reprovisioned onto a
+// replacement cloud instance. The units on the machine are installed again
+// on the replacement, and any model-scoped storage is re-attached to it, as
+// the failed instance has been shut down. Each attempt, and why it failed, is
+// recorded in the machine's recovery history.
+func (s *ProviderService) RecoverFailedMachine(ctx context.Context, machineName machine.Name) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	policy, err := s.getMachineRecoveryPolicy(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	} else if policy != modelconfig.MachineRecoveryPolicyReprovision {
+		return nil
+	}
+
+	history, err := s.st.GetMachineRecoveryAttempts(ctx, machineName)
+	if errors.Is(err, machineerrors.MachineNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Errorf("getting recovery attempts for machine %q: %w", machineName, err)
+	}
+	now := s.clock.Now().UTC()
+	recovery := domainmachine.NewRecoveryStatus(policy, history, now)
+	if recovery.Exhausted() || now.Before(recovery.NextAttemptAt) {
+		return nil
+	}
+
+	// Ineligible machines are still checked so that the failed attempt can
+	// be recorded against them.
+	var ineligible error
+	switch err := s.st.CheckMachineReprovisioningEligibility(ctx, machineName); {
+	case err == nil:
+	case errors.Is(err, machineerrors.MachineNotFound),
+		errors.Is(err, machineerrors.MachineNotAlive),
+		errors.Is(err, machineerrors.MachineIsContainer),
+		errors.Is(err, machineerrors.MachineReprovisionAlreadyExists):
+		// There is nothing for recovery to do for these machines.
+		return nil
+	case errors.Is(err, machineerrors.ModelScopedStorageAttached):
+		// Model-scoped storage is free to be re-attached to a replacement
+		// once the failed instance is shut down below.
+	case errors.Is(err, machineerrors.MachineIsController),
+		errors.Is(err, machineerrors.MachineIsManual),
+		errors.Is(err, machineerrors.MachineHasChildContainers):
+		ineligible = err
+	default:
+		return errors.Errorf("checking machine %q can be recovered: %w", machineName, err)
+	}
+
+	instanceID, err := s.st.GetInstanceIDByMachineName(ctx, machineName)
+	if errors.Is(err, machineerrors.NotProvisioned) {
+		return nil
+	} else if err != nil {
+		return errors.Errorf("machine %q: %w", machineName, err)
+	}
+
+	// The agent is still present while it has not missed its heartbeats,
+	// so the instance is given a chance to come back before it is replaced.
+	present, err := s.st.IsMachineAgentPresent(ctx, machineName)
+	if err != nil {
+		return errors.Errorf("checking machine %q agent presence: %w", machineName, err)
+	} else if present {
+		return nil
+	}
+
+	reason, err := s.failedInstanceReason(ctx, instance.Id(instanceID))
+	if err != nil {
+		return errors.Errorf("checking provider instance %q for machine %q: %w", instanceID, machineName, err)
+	} else if reason == "" {
+		return nil
+	}
+
+	attempt := domainmachine.RecoveryAttempt{
+		AttemptedAt: now,
+		InstanceID:  instance.Id(instanceID),
+		Reason:      reason,
+	}
+	if ineligible != nil {
+		attempt.Error = ineligible.Error()
+		return s.recordFailedRecoveryAttempt(ctx, machineName, attempt)
+	}
+
+	// A stopped or errored instance still exists, and some providers do not
+	// list stopped instances at all, so the failed instance is always shut
+	// down before a replacement is requested.
+	if err := s.releaseFailedInstance(ctx, instance.Id(instanceID)); err != nil {
+		attempt.Error = err.Error()
+		if recordErr := s.recordFailedRecoveryAttempt(ctx, machineName, attempt); recordErr != nil {
+			s.logger.Warningf(ctx, "%v", recordErr)
+		}
+		return errors.Errorf("recovering machine %q: %w", machineName, err)
+	}
+
+	statusMessage := fmt.Sprintf("recovering failed instance (attempt %d of %d)",
+		recovery.Attempts+1, domainmachine.MaxRecoveryAttempts)
+	statusData := map[string]any{
+		"old-instance-id":  instanceID,
+		"recovery-attempt": recovery.Attempts + 1,
+		"recovery-reason":  reason,
+	}
+	encodedStatusData, err := json.Marshal(statusData)
+	if err != nil {
+		return errors.Errorf("encoding recovery status data: %w", err)
+	}
+
+	// The failed instance has been shut down, so any model-scoped storage
+	// is kept to be re-attached to the replacement.
+	err = s.st.RecoverLostMachineCloudInstance(
+		ctx, machineName, instance.Id(instanceID), true, attempt, statusMessage, encodedStatusData,
+	)
+	switch {
+	case err == nil:
+	case errors.Is(err, machineerrors.MachineNotFound),
+		errors.Is(err, machineerrors.MachineNotAlive),
+		errors.Is(err, machineerrors.MachineAgentPresent),
+		errors.Is(err, machineerrors.MachineCloudInstanceChanged),
+		errors.Is(err, machineerrors.MachineReprovisionAlreadyExists):
+		// The machine changed since it was checked; it is looked at again
+		// the next time its instance is seen to have failed.
+		return nil
+	default:
+		attempt.Error = err.Error()
+		if recordErr := s.recordFailedRecoveryAttempt(ctx, machineName, attempt); recordErr != nil {
+			s.logger.Warningf(ctx, "%v", recordErr)
+		}
+		return errors.Errorf("recovering machine %q: %w", machineName, err)
+	}
+
+	s.logger.Infof(ctx, "machine %q instance %q %s: requested a replacement instance", machineName, instanceID, reason)
+	s.recordReprovisionStatusHistory(ctx, machineName, corestatus.StatusInfo{
+		Status:  corestatus.Pending,
+		Message: statusMessage,
+		Data:    statusData,
+		Since:   &now,
+	})
+	return nil
+}
+
+// failedInstanceReason asks the provider about the instance and describes
+// how it has failed. An empty reason is returned if the instance has not
+// failed.
+func (s *ProviderService) failedInstanceReason(ctx context.Context, instanceID instance.Id) (string, error) {
+	provider, err := s.providerGetter(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	instances, err := provider.Instances(ctx, []instance.Id{instanceID})
+	if err != nil && !errors.Is(err, environs.ErrNoInstances) && !errors.Is(err, environs.ErrPartialInstances) {
+		return "", errors.Capture(err)
+	}
+	if len(instances) == 0 || instances[0] == nil {
+		return "instance not found", nil
+	}
+
+	instStatus := instances[0].Status(ctx)
+	switch instStatus.Status {
+	case corestatus.Running, corestatus.Allocating, corestatus.Pending, corestatus.Unknown:
+		return "", nil
+	}
+	if instStatus.Message != "" {
+		return fmt.Sprintf("instance %s", instStatus.Message), nil
+	}
+	return fmt.Sprintf("instance status %q", instStatus.Status), nil
+}
+
+// releaseFailedInstance asks the provider to shut down the failed instance.
+// Shutting down an instance which no longer exists is not an error.
+func (s *ProviderService) releaseFailedInstance(ctx context.Context, instanceID instance.Id) error {
+	provider, err := s.providerGetter(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := provider.StopInstances(ctx, instanceID); err != nil {
+		return errors.Errorf("shutting down failed instance %q: %w", instanceID, err)
+	}
+	return nil
+}
+
+// recordFailedRecoveryAttempt records a recovery attempt which did not
+// request a replacement cloud instance, so that the machine backs off before
+// it is tried again.
+func (s *ProviderService) recordFailedRecoveryAttempt(
+	ctx context.Context, machineName machine.Name, attempt domainmachine.RecoveryAttempt,
+) error {
+	s.logger.Warningf(ctx, "cannot recover machine %q: %s", machineName, attempt.Error)
+	if err := s.st.RecordMachineRecoveryAttempt(ctx, machineName, attempt); err != nil {
+		return errors.Errorf("recording recovery attempt for machine %q: %w", machineName, err)
+	}
+	return nil
+}
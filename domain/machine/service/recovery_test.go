@@ -0,0 +1,364 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/modelconfig"
+	"github.com/juju/juju/core/status"
+	domainmachine "github.com/juju/juju/domain/machine"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	domainstatus "github.com/juju/juju/domain/status"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/instances"
+	"github.com/juju/juju/internal/errors"
+)
+
+var recoveryNow = time.Date(2026, 7, 23, 12, 0, 0, 0, time.UTC)
+
+func (s *providerServiceSuite) TestGetAllMachineRecoveryStatuses(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.service.clock = testclock.NewClock(recoveryNow)
+	history := []domainmachine.RecoveryAttempt{{
+		AttemptedAt: recoveryNow.Add(-25 * time.Hour),
+		InstanceID:  "i-1",
+		Reason:      "instance not found",
+	}, {
+		AttemptedAt: recoveryNow.Add(-2 * time.Minute),
+		InstanceID:  "i-2",
+		Reason:      "instance stopped",
+		Error:       "machine is a controller",
+	}}
+	s.state.EXPECT().GetMachineRecoveryPolicy(gomock.Any()).Return("reprovision", nil)
+	s.state.EXPECT().GetAllMachineRecoveryAttempts(gomock.Any()).Return(
+		map[machine.Name][]domainmachine.RecoveryAttempt{"0": history}, nil,
+	)
+
+	statuses, err := s.service.GetAllMachineRecoveryStatuses(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(statuses, tc.DeepEquals, map[machine.Name]domainmachine.RecoveryStatus{
+		"0": {
+			Policy:        modelconfig.MachineRecoveryPolicyReprovision,
+			Attempts:      1,
+			NextAttemptAt: recoveryNow.Add(3 * time.Minute),
+			History:       history,
+		},
+	})
+}
+
+func (s *providerServiceSuite) TestGetAllMachineRecoveryStatusesInvalidPolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetMachineRecoveryPolicy(gomock.Any()).Return("rebuild", nil)
+
+	_, err := s.service.GetAllMachineRecoveryStatuses(c.Context())
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *providerServiceSuite) TestRecoverFailedMachinePolicyNone(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetMachineRecoveryPolicy(gomock.Any()).Return("", nil)
+
+	err := s.service.RecoverFailedMachine(c.Context(), "0")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerServiceSuite) TestRecoverFailedMachineBackingOff(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.service.clock = testclock.NewClock(recoveryNow)
+	s.expectRecoveryPolicy([]domainmachine.RecoveryAttempt{{
+		AttemptedAt: recoveryNow.Add(-9 * time.Minute),
+	}, {
+		AttemptedAt: recoveryNow.Add(-8 * time.Minute),
+	}})
+
+	err := s.service.RecoverFailedMachine(c.Context(), "0")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerServiceSuite) TestRecoverFailedMachineExhausted(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.service.clock = testclock.NewClock(recoveryNow)
+	history := make([]domainmachine.RecoveryAttempt, domainmachine.MaxRecoveryAttempts)
+	for i := range history {
+		history[i].AttemptedAt = recoveryNow.Add(-time.Duration(10-i) * time.Hour)
+	}
+	s.expectRecoveryPolicy(history)
+
+	err := s.service.RecoverFailedMachine(c.Context(), "0")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerServiceSuite) TestRecoverFailedMachineAgentPresent(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectRecoveryPolicy(nil)
+	s.expectReprovisionMachineValidated(c, "i-1234")
+	s.state.EXPECT().IsMachineAgentPresent(gomock.Any(), machine.Name("0")).Return(true, nil)
+
+	err := s.service.RecoverFailedMachine(c.Context(), "0")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerServiceSuite) TestRecoverFailedMachineInstanceRunning(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	instanceID := instance.Id("i-1234")
+	s.expectRecoveryPolicy(nil)
+	s.expectReprovisionMachineValidated(c, instanceID)
+	s.expectMachineAgentAbsent()
+	s.provider.EXPECT().Instances(gomock.Any(), []instance.Id{instanceID}).Return([]instances.Instance{
+		reprovisionInstance{id: instanceID, status: status.Running},
+	}, nil)
+
+	err := s.service.RecoverFailedMachine(c.Context(), "0")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerServiceSuite) TestRecoverFailedMachineInstanceNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.service.clock = testclock.NewClock(recoveryNow)
+	instanceID := instance.Id("i-1234")
+	s.expectRecoveryPolicy([]domainmachine.RecoveryAttempt{{
+		AttemptedAt: recoveryNow.Add(-time.Hour),
+		Reason:      "instance stopped",
+	}})
+	s.state.EXPECT().CheckMachineReprovisioningEligibility(
+		gomock.Any(), machine.Name("0"),
+	).Return(machineerrors.ModelScopedStorageAttached)
+	s.state.EXPECT().GetInstanceIDByMachineName(gomock.Any(), machine.Name("0")).Return(instanceID.String(), nil)
+	s.expectMachineAgentAbsent()
+	s.provider.EXPECT().Instances(gomock.Any(), []instance.Id{instanceID}).Return(nil, environs.ErrNoInstances)
+	s.provider.EXPECT().StopInstances(gomock.Any(), instanceID).Return(nil)
+
+	statusMessage := "recovering failed instance (attempt 2 of 5)"
+	s.state.EXPECT().RecoverLostMachineCloudInstance(
+		gomock.Any(), machine.Name("0"), instanceID, true,
+		domainmachine.RecoveryAttempt{
+			AttemptedAt: recoveryNow,
+			InstanceID:  instanceID,
+			Reason:      "instance not found",
+		},
+		statusMessage,
+		[]byte(`{"old-instance-id":"i-1234","recovery-attempt":2,"recovery-reason":"instance not found"}`),
+	).Return(nil)
+	statusInfo := status.StatusInfo{
+		Status:  status.Pending,
+		Message: statusMessage,
+		Data: map[string]any{
+			"old-instance-id":  "i-1234",
+			"recovery-attempt": 2,
+			"recovery-reason":  "instance not found",
+		},
+		Since: &recoveryNow,
+	}
+	s.statusHistory.EXPECT().RecordStatus(
+		gomock.Any(), domainstatus.MachineNamespace.WithID("0"), statusInfo,
+	).Return(nil)
+	s.statusHistory.EXPECT().RecordStatus(
+		gomock.Any(), domainstatus.MachineInstanceNamespace.WithID("0"), statusInfo,
+	).Return(nil)
+
+	err := s.service.RecoverFailedMachine(c.Context(), "0")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerServiceSuite) TestRecoverFailedMachineInstanceStopped(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	instanceID := instance.Id("i-1234")
+	s.expectRecoveryPolicy(nil)
+	s.expectReprovisionMachineValidated(c, instanceID)
+	s.expectMachineAgentAbsent()
+	s.provider.EXPECT().Instances(gomock.Any(), []instance.Id{instanceID}).Return([]instances.Instance{
+		reprovisionInstance{id: instanceID, status: status.Empty, message: "stopped"},
+	}, nil)
+	s.provider.EXPECT().StopInstances(gomock.Any(), instanceID).Return(nil)
+	s.state.EXPECT().RecoverLostMachineCloudInstance(
+		gomock.Any(), machine.Name("0"), instanceID, true,
+		gomock.Any(), "recovering failed instance (attempt 1 of 5)", gomock.Any(),
+	).DoAndReturn(func(
+		_ context.Context, _ machine.Name, _ instance.Id, _ bool,
+		attempt domainmachine.RecoveryAttempt, _ string, _ []byte,
+	) error {
+		c.Check(attempt.Reason, tc.Equals, "instance stopped")
+		return nil
+	})
+	s.statusHistory.EXPECT().RecordStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	err := s.service.RecoverFailedMachine(c.Context(), "0")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerServiceSuite) TestRecoverFailedMachineStoppedWithModelScopedStorage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.service.clock = testclock.NewClock(recoveryNow)
+	instanceID := instance.Id("i-1234")
+	s.expectRecoveryPolicy(nil)
+	s.state.EXPECT().CheckMachineReprovisioningEligibility(
+		gomock.Any(), machine.Name("0"),
+	).Return(machineerrors.ModelScopedStorageAttached)
+	s.state.EXPECT().GetInstanceIDByMachineName(gomock.Any(), machine.Name("0")).Return(instanceID.String(), nil)
+	s.expectMachineAgentAbsent()
+	s.provider.EXPECT().Instances(gomock.Any(), []instance.Id{instanceID}).Return([]instances.Instance{
+		reprovisionInstance{id: instanceID, status: status.Empty, message: "stopped"},
+	}, nil)
+	// Once the stopped instance has been shut down, the model-scoped
+	// storage is kept for the replacement.
+	s.provider.EXPECT().StopInstances(gomock.Any(), instanceID).Return(nil)
+	s.state.EXPECT().RecoverLostMachineCloudInstance(
+		gomock.Any(), machine.Name("0"), instanceID, true,
+		domainmachine.RecoveryAttempt{
+			AttemptedAt: recoveryNow,
+			InstanceID:  instanceID,
+			Reason:      "instance stopped",
+		}, "recovering failed instance (attempt 1 of 5)", gomock.Any(),
+	).Return(nil)
+	s.statusHistory.EXPECT().RecordStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	err := s.service.RecoverFailedMachine(c.Context(), "0")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerServiceSuite) TestRecoverFailedMachineModelScopedStorageStopInstanceError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.service.clock = testclock.NewClock(recoveryNow)
+	instanceID := instance.Id("i-1234")
+	s.expectRecoveryPolicy(nil)
+	s.state.EXPECT().CheckMachineReprovisioningEligibility(
+		gomock.Any(), machine.Name("0"),
+	).Return(machineerrors.ModelScopedStorageAttached)
+	s.state.EXPECT().GetInstanceIDByMachineName(gomock.Any(), machine.Name("0")).Return(instanceID.String(), nil)
+	s.expectMachineAgentAbsent()
+	s.provider.EXPECT().Instances(gomock.Any(), []instance.Id{instanceID}).Return([]instances.Instance{
+		reprovisionInstance{id: instanceID, status: status.Empty, message: "stopped"},
+	}, nil)
+	s.provider.EXPECT().StopInstances(gomock.Any(), instanceID).Return(errors.New("boom"))
+	s.state.EXPECT().RecordMachineRecoveryAttempt(gomock.Any(), machine.Name("0"), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ machine.Name, attempt domainmachine.RecoveryAttempt) error {
+			c.Check(attempt.Reason, tc.Equals, "instance stopped")
+			c.Check(attempt.Error, tc.Equals, `shutting down failed instance "i-1234": boom`)
+			return nil
+		})
+
+	err := s.service.RecoverFailedMachine(c.Context(), "0")
+	c.Assert(err, tc.ErrorMatches, `recovering machine "0": shutting down failed instance "i-1234": boom`)
+}
+
+func (s *providerServiceSuite) TestRecoverFailedMachineController(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	instanceID := instance.Id("i-1234")
+	s.expectRecoveryPolicy(nil)
+	s.state.EXPECT().CheckMachineReprovisioningEligibility(
+		gomock.Any(), machine.Name("0"),
+	).Return(machineerrors.MachineIsController)
+	s.state.EXPECT().GetInstanceIDByMachineName(gomock.Any(), machine.Name("0")).Return(instanceID.String(), nil)
+	s.expectMachineAgentAbsent()
+	s.provider.EXPECT().Instances(gomock.Any(), []instance.Id{instanceID}).Return(nil, environs.ErrNoInstances)
+	s.state.EXPECT().RecordMachineRecoveryAttempt(gomock.Any(), machine.Name("0"), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ machine.Name, attempt domainmachine.RecoveryAttempt) error {
+			c.Check(attempt.Error, tc.Equals, machineerrors.MachineIsController.Error())
+			return nil
+		})
+
+	err := s.service.RecoverFailedMachine(c.Context(), "0")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerServiceSuite) TestRecoverFailedMachineContainer(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectRecoveryPolicy(nil)
+	s.state.EXPECT().CheckMachineReprovisioningEligibility(
+		gomock.Any(), machine.Name("0"),
+	).Return(machineerrors.MachineIsContainer)
+
+	err := s.service.RecoverFailedMachine(c.Context(), "0")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerServiceSuite) TestRecoverFailedMachineRaced(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	instanceID := instance.Id("i-1234")
+	s.expectRecoveryPolicy(nil)
+	s.expectReprovisionMachineValidated(c, instanceID)
+	s.expectMachineAgentAbsent()
+	s.provider.EXPECT().Instances(gomock.Any(), []instance.Id{instanceID}).Return(nil, environs.ErrNoInstances)
+	s.provider.EXPECT().StopInstances(gomock.Any(), instanceID).Return(nil)
+	s.state.EXPECT().RecoverLostMachineCloudInstance(
+		gomock.Any(), machine.Name("0"), instanceID, true,
+		gomock.Any(), gomock.Any(), gomock.Any(),
+	).Return(machineerrors.MachineCloudInstanceChanged)
+
+	err := s.service.RecoverFailedMachine(c.Context(), "0")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerServiceSuite) TestRecoverFailedMachineError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	instanceID := instance.Id("i-1234")
+	s.expectRecoveryPolicy(nil)
+	s.expectReprovisionMachineValidated(c, instanceID)
+	s.expectMachineAgentAbsent()
+	s.provider.EXPECT().Instances(gomock.Any(), []instance.Id{instanceID}).Return(nil, environs.ErrNoInstances)
+	s.provider.EXPECT().StopInstances(gomock.Any(), instanceID).Return(nil)
+	s.state.EXPECT().RecoverLostMachineCloudInstance(
+		gomock.Any(), machine.Name("0"), instanceID, true,
+		gomock.Any(), gomock.Any(), gomock.Any(),
+	).Return(errors.New("boom"))
+	s.state.EXPECT().RecordMachineRecoveryAttempt(gomock.Any(), machine.Name("0"), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ machine.Name, attempt domainmachine.RecoveryAttempt) error {
+			c.Check(attempt.Error, tc.Equals, "boom")
+			return nil
+		})
+
+	err := s.service.RecoverFailedMachine(c.Context(), "0")
+	c.Assert(err, tc.ErrorMatches, `recovering machine "0": boom`)
+}
+
+func (s *providerServiceSuite) TestRecoverFailedMachineStopInstanceError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	instanceID := instance.Id("i-1234")
+	s.expectRecoveryPolicy(nil)
+	s.expectReprovisionMachineValidated(c, instanceID)
+	s.expectMachineAgentAbsent()
+	s.provider.EXPECT().Instances(gomock.Any(), []instance.Id{instanceID}).Return([]instances.Instance{
+		reprovisionInstance{id: instanceID, status: status.Empty, message: "stopped"},
+	}, nil)
+	s.provider.EXPECT().StopInstances(gomock.Any(), instanceID).Return(errors.New("boom"))
+	s.state.EXPECT().RecordMachineRecoveryAttempt(gomock.Any(), machine.Name("0"), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ machine.Name, attempt domainmachine.RecoveryAttempt) error {
+			c.Check(attempt.Reason, tc.Equals, "instance stopped")
+			c.Check(attempt.Error, tc.Equals, `shutting down failed instance "i-1234": boom`)
+			return nil
+		})
+
+	err := s.service.RecoverFailedMachine(c.Context(), "0")
+	c.Assert(err, tc.ErrorMatches, `recovering machine "0": shutting down failed instance "i-1234": boom`)
+}
+
+func (s *providerServiceSuite) expectRecoveryPolicy(history []domainmachine.RecoveryAttempt) {
+	s.state.EXPECT().GetMachineRecoveryPolicy(gomock.Any()).Return("reprovision", nil)
+	s.state.EXPECT().GetMachineRecoveryAttempts(gomock.Any(), machine.Name("0")).Return(history, nil)
+}
@@ -88,6 +88,29 @@ type State interface {
 		context.Context, string, string, string, []byte, time.Time,
 	) error
 
+	// RecoverLostMachineCloudInstance detaches the failed cloud instance of a
+	// machine as DetachLostMachineCloudInstance does, clears the uniter state
+	// of the units on the machine and records the recovery attempt.
+	RecoverLostMachineCloudInstance(
+		context.Context, machine.Name, instance.Id, bool, domainmachine.RecoveryAttempt, string, []byte,
+	) error
+
+	// RecordMachineRecoveryAttempt records a recovery attempt for the machine
+	// which did not request a replacement cloud instance.
+	RecordMachineRecoveryAttempt(context.Context, machine.Name, domainmachine.RecoveryAttempt) error
+
+	// GetMachineRecoveryAttempts returns the recorded recovery attempts for
+	// the machine, oldest first.
+	GetMachineRecoveryAttempts(context.Context, machine.Name) ([]domainmachine.RecoveryAttempt, error)
+
+	// GetAllMachineRecoveryAttempts returns the recorded recovery attempts of
+	// all the machines which have any, keyed by machine name.
+	GetAllMachineRecoveryAttempts(context.Context) (map[machine.Name][]domainmachine.RecoveryAttempt, error)
+
+	// GetMachineRecoveryPolicy returns the value of the model's machine
+	// recovery policy config key, or an empty string if it has not been set.
+	GetMachineRecoveryPolicy(context.Context) (string, error)
+
 	// SetRunningAgentBinaryVersion sets the running agent version for the
 	// machine. A MachineNotFound error will be returned if the machine does not
 	// exist.
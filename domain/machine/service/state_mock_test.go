@@ -44,6 +44,7 @@ type MockStateMockRecorder struct {
 	clearMachineRebootExpects                                 []*gomock.Call2_1[context.Context, machine.UUID, error]
 	countMachinesInSpaceExpects                               []*gomock.Call2_2[context.Context, string, int64, error]
 	detachLostMachineCloudInstanceExpects                     []*gomock.Call6_1[context.Context, string, string, string, []byte, time.Time, error]
+	getAllMachineRecoveryAttemptsExpects                      []*gomock.Call1_2[context.Context, map[machine.Name][]machine0.RecoveryAttempt, error]
 	getAllProvisionedMachineInstanceIDExpects                 []*gomock.Call1_2[context.Context, map[machine.Name]string, error]
 	getHardwareCharacteristicsExpects                         []*gomock.Call2_2[context.Context, string, instance.HardwareCharacteristics, error]
 	getInstanceIDExpects                                      []*gomock.Call2_2[context.Context, string, string, error]
@@ -57,6 +58,8 @@ type MockStateMockRecorder struct {
 	getMachineParentUUIDExpects                               []*gomock.Call2_2[context.Context, string, machine.UUID, error]
 	getMachinePrincipalApplicationsExpects                    []*gomock.Call2_2[context.Context, machine.Name, []string, error]
 	getMachineProvisioningInfoExpects                         []*gomock.Call2_4[context.Context, string, base.Base, *string, constraints.Constraints, error]
+	getMachineRecoveryAttemptsExpects                         []*gomock.Call2_2[context.Context, machine.Name, []machine0.RecoveryAttempt, error]
+	getMachineRecoveryPolicyExpects                           []*gomock.Call1_2[context.Context, string, error]
 	getMachineUUIDExpects                                     []*gomock.Call2_2[context.Context, machine.Name, machine.UUID, error]
 	getModelConstraintsExpects                                []*gomock.Call1_2[context.Context, constraints.Constraints, error]
 	getNamesForUUIDsExpects                                   []*gomock.Call2_2[context.Context, []string, map[machine.UUID]machine.Name, error]
@@ -76,6 +79,8 @@ type MockStateMockRecorder struct {
 	namespaceForWatchMachineCloudInstanceExpects              []*gomock.Call0_1[string]
 	namespaceForWatchMachineRebootExpects                     []*gomock.Call0_1[string]
 	namespaceForWatchMachineReprovisionExpects                []*gomock.Call0_1[string]
+	recordMachineRecoveryAttemptExpects                       []*gomock.Call3_1[context.Context, machine.Name, machine0.RecoveryAttempt, error]
+	recoverLostMachineCloudInstanceExpects                    []*gomock.Call7_1[context.Context, machine.Name, instance.Id, bool, machine0.RecoveryAttempt, string, []byte, error]
 	requireMachineRebootExpects                               []*gomock.Call2_1[context.Context, machine.UUID, error]
 	setKeepInstanceExpects                                    []*gomock.Call3_1[context.Context, machine.Name, bool, error]
 	setMachineCloudInstanceExpects                            []*gomock.Call6_1[context.Context, string, instance.Id, string, string, *instance.HardwareCharacteristics, error]
@@ -242,6 +247,24 @@ func (mr *MockStateMockRecorder) DetachLostMachineCloudInstance(arg0, arg1, arg2
 // MockStateDetachLostMachineCloudInstanceCall is the typed call wrapper for DetachLostMachineCloudInstance.
 type MockStateDetachLostMachineCloudInstanceCall = gomock.Call6_1[context.Context, string, string, string, []byte, time.Time, error]
 
+// GetAllMachineRecoveryAttempts mocks base method.
+func (m *MockState) GetAllMachineRecoveryAttempts(arg0 context.Context) (map[machine.Name][]machine0.RecoveryAttempt, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getAllMachineRecoveryAttemptsExpects, m.ctrl, m, "GetAllMachineRecoveryAttempts", arg0)
+}
+
+// GetAllMachineRecoveryAttempts indicates an expected call of GetAllMachineRecoveryAttempts.
+func (mr *MockStateMockRecorder) GetAllMachineRecoveryAttempts(arg0 any) *MockStateGetAllMachineRecoveryAttemptsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, map[machine.Name][]machine0.RecoveryAttempt, error](mr.mock.ctrl.T, mr.mock, "GetAllMachineRecoveryAttempts", gomock.EnsureMatcher(arg0))
+	mr.getAllMachineRecoveryAttemptsExpects = append(mr.getAllMachineRecoveryAttemptsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetAllMachineRecoveryAttemptsCall is the typed call wrapper for GetAllMachineRecoveryAttempts.
+type MockStateGetAllMachineRecoveryAttemptsCall = gomock.Call1_2[context.Context, map[machine.Name][]machine0.RecoveryAttempt, error]
+
 // GetAllProvisionedMachineInstanceID mocks base method.
 func (m *MockState) GetAllProvisionedMachineInstanceID(ctx context.Context) (map[machine.Name]string, error) {
 	m.ctrl.T.Helper()
@@ -476,6 +499,42 @@ func (mr *MockStateMockRecorder) GetMachineProvisioningInfo(ctx, mName any) *Moc
 // MockStateGetMachineProvisioningInfoCall is the typed call wrapper for GetMachineProvisioningInfo.
 type MockStateGetMachineProvisioningInfoCall = gomock.Call2_4[context.Context, string, base.Base, *string, constraints.Constraints, error]
 
+// GetMachineRecoveryAttempts mocks base method.
+func (m *MockState) GetMachineRecoveryAttempts(arg0 context.Context, arg1 machine.Name) ([]machine0.RecoveryAttempt, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getMachineRecoveryAttemptsExpects, m.ctrl, m, "GetMachineRecoveryAttempts", arg0, arg1)
+}
+
+// GetMachineRecoveryAttempts indicates an expected call of GetMachineRecoveryAttempts.
+func (mr *MockStateMockRecorder) GetMachineRecoveryAttempts(arg0, arg1 any) *MockStateGetMachineRecoveryAttemptsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, machine.Name, []machine0.RecoveryAttempt, error](mr.mock.ctrl.T, mr.mock, "GetMachineRecoveryAttempts", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1))
+	mr.getMachineRecoveryAttemptsExpects = append(mr.getMachineRecoveryAttemptsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetMachineRecoveryAttemptsCall is the typed call wrapper for GetMachineRecoveryAttempts.
+type MockStateGetMachineRecoveryAttemptsCall = gomock.Call2_2[context.Context, machine.Name, []machine0.RecoveryAttempt, error]
+
+// GetMachineRecoveryPolicy mocks base method.
+func (m *MockState) GetMachineRecoveryPolicy(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getMachineRecoveryPolicyExpects, m.ctrl, m, "GetMachineRecoveryPolicy", arg0)
+}
+
+// GetMachineRecoveryPolicy indicates an expected call of GetMachineRecoveryPolicy.
+func (mr *MockStateMockRecorder) GetMachineRecoveryPolicy(arg0 any) *MockStateGetMachineRecoveryPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, string, error](mr.mock.ctrl.T, mr.mock, "GetMachineRecoveryPolicy", gomock.EnsureMatcher(arg0))
+	mr.getMachineRecoveryPolicyExpects = append(mr.getMachineRecoveryPolicyExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetMachineRecoveryPolicyCall is the typed call wrapper for GetMachineRecoveryPolicy.
+type MockStateGetMachineRecoveryPolicyCall = gomock.Call1_2[context.Context, string, error]
+
 // GetMachineUUID mocks base method.
 func (m *MockState) GetMachineUUID(arg0 context.Context, arg1 machine.Name) (machine.UUID, error) {
 	m.ctrl.T.Helper()
@@ -818,6 +877,42 @@ func (mr *MockStateMockRecorder) NamespaceForWatchMachineReprovision() *MockStat
 // MockStateNamespaceForWatchMachineReprovisionCall is the typed call wrapper for NamespaceForWatchMachineReprovision.
 type MockStateNamespaceForWatchMachineReprovisionCall = gomock.Call0_1[string]
 
+// RecordMachineRecoveryAttempt mocks base method.
+func (m *MockState) RecordMachineRecoveryAttempt(arg0 context.Context, arg1 machine.Name, arg2 machine0.RecoveryAttempt) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.recordMachineRecoveryAttemptExpects, m.ctrl, m, "RecordMachineRecoveryAttempt", arg0, arg1, arg2)
+}
+
+// RecordMachineRecoveryAttempt indicates an expected call of RecordMachineRecoveryAttempt.
+func (mr *MockStateMockRecorder) RecordMachineRecoveryAttempt(arg0, arg1, arg2 any) *MockStateRecordMachineRecoveryAttemptCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, machine.Name, machine0.RecoveryAttempt, error](mr.mock.ctrl.T, mr.mock, "RecordMachineRecoveryAttempt", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1), gomock.EnsureMatcher(arg2))
+	mr.recordMachineRecoveryAttemptExpects = append(mr.recordMachineRecoveryAttemptExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateRecordMachineRecoveryAttemptCall is the typed call wrapper for RecordMachineRecoveryAttempt.
+type MockStateRecordMachineRecoveryAttemptCall = gomock.Call3_1[context.Context, machine.Name, machine0.RecoveryAttempt, error]
+
+// RecoverLostMachineCloudInstance mocks base method.
+func (m *MockState) RecoverLostMachineCloudInstance(arg0 context.Context, arg1 machine.Name, arg2 instance.Id, arg3 bool, arg4 machine0.RecoveryAttempt, arg5 string, arg6 []byte) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch7_1(&m.recorder.recoverLostMachineCloudInstanceExpects, m.ctrl, m, "RecoverLostMachineCloudInstance", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// RecoverLostMachineCloudInstance indicates an expected call of RecoverLostMachineCloudInstance.
+func (mr *MockStateMockRecorder) RecoverLostMachineCloudInstance(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *MockStateRecoverLostMachineCloudInstanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall7_1[context.Context, machine.Name, instance.Id, bool, machine0.RecoveryAttempt, string, []byte, error](mr.mock.ctrl.T, mr.mock, "RecoverLostMachineCloudInstance", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1), gomock.EnsureMatcher(arg2), gomock.EnsureMatcher(arg3), gomock.EnsureMatcher(arg4), gomock.EnsureMatcher(arg5), gomock.EnsureMatcher(arg6))
+	mr.recoverLostMachineCloudInstanceExpects = append(mr.recoverLostMachineCloudInstanceExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateRecoverLostMachineCloudInstanceCall is the typed call wrapper for RecoverLostMachineCloudInstance.
+type MockStateRecoverLostMachineCloudInstanceCall = gomock.Call7_1[context.Context, machine.Name, instance.Id, bool, machine0.RecoveryAttempt, string, []byte, error]
+
 // RequireMachineReboot mocks base method.
 func (m *MockState) RequireMachineReboot(ctx context.Context, uuid machine.UUID) error {
 	m.ctrl.T.Helper()
@@ -839,6 +839,13 @@ func encodeConstraints(constraintUUID string, cons constraints.Constraints, cont
 		ImageID:          cons.ImageID,
 		IPFamily:         cons.IPFamily,
 		AllocatePublicIP: cons.AllocatePublicIP,
+
+		Market:              cons.Market,
+		MaxPrice:            cons.MaxPrice,
+		CapacityReservation: cons.CapacityReservation,
+		PlacementGroup:      cons.PlacementGroup,
+		Gpus:                cons.Gpus,
+		GpuType:             cons.GpuType,
 	}
 	if cons.Container != nil {
 		res.ContainerTypeID = &containerTypeID
@@ -0,0 +1,319 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/machine"
+	domainmachine "github.com/juju/juju/domain/machine"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetMachineRecoveryPolicy returns the value of the model's machine recovery
+// policy config key, or an empty string if it has not been set.
+func (st *State) GetMachineRecoveryPolicy(ctx context.Context) (string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	key := modelConfigKey{Key: config.MachineRecoveryPolicyKey}
+	var value modelConfigValue
+	stmt, err := st.Prepare(`
+SELECT &modelConfigValue.*
+FROM   model_config
+WHERE  "key" = $modelConfigKey.key
+`, key, value)
+	if err != nil {
+		return "", errors.Errorf("preparing model config statement: %w", err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, key).Get(&value)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("querying model config: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	return value.Value, nil
+}
+
+// GetMachineRecoveryAttempts returns the recorded recovery attempts for the
+// machine, oldest first.
+//
+// The following errors may be returned:
+// - [machineerrors.MachineNotFound] if the machine does not exist.
+func (st *State) GetMachineRecoveryAttempts(
+	ctx context.Context, mName machine.Name,
+) ([]domainmachine.RecoveryAttempt, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	name := machineName{Name: mName.String()}
+	machineStmt, err := st.Prepare(`
+SELECT &entityUUID.uuid
+FROM   machine
+WHERE  name = $machineName.name
+`, name, entityUUID{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	attemptsStmt, err := st.Prepare(`
+SELECT &machineRecoveryAttempt.*
+FROM   machine_recovery_attempt
+WHERE  machine_uuid = $entityUUID.uuid
+ORDER BY attempted_at
+`, entityUUID{}, machineRecoveryAttempt{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var attempts []machineRecoveryAttempt
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var uuid entityUUID
+		if err := tx.Query(ctx, machineStmt, name).Get(&uuid); errors.Is(err, sqlair.ErrNoRows) {
+			return machineerrors.MachineNotFound
+		} else if err != nil {
+			return errors.Errorf("querying machine %q: %w", mName, err)
+		}
+		err := tx.Query(ctx, attemptsStmt, uuid).GetAll(&attempts)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("querying recovery attempts: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Errorf("getting recovery attempts for machine %q: %w", mName, err)
+	}
+
+	result := make([]domainmachine.RecoveryAttempt, len(attempts))
+	for i, attempt := range attempts {
+		result[i] = attempt.decode()
+	}
+	return result, nil
+}
+
+// GetAllMachineRecoveryAttempts returns the recorded recovery attempts of all
+// the machines in the model which have any, oldest first, keyed by machine
+// name.
+func (st *State) GetAllMachineRecoveryAttempts(
+	ctx context.Context,
+) (map[machine.Name][]domainmachine.RecoveryAttempt, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT    (m.name) AS (&machineName.*),
+          mra.* AS &machineRecoveryAttempt.*
+FROM      machine_recovery_attempt AS mra
+JOIN      machine AS m ON mra.machine_uuid = m.uuid
+ORDER BY  m.name, mra.attempted_at
+`, machineName{}, machineRecoveryAttempt{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var (
+		names    []machineName
+		attempts []machineRecoveryAttempt
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&names, &attempts)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("querying recovery attempts: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	result := make(map[machine.Name][]domainmachine.RecoveryAttempt)
+	for i, attempt := range attempts {
+		name := machine.Name(names[i].Name)
+		result[name] = append(result[name], attempt.decode())
+	}
+	return result, nil
+}
+
+// RecordMachineRecoveryAttempt records a recovery attempt for the machine
+// which did not request a replacement cloud instance. Only the most recent
+// [domainmachine.RecoveryHistoryLimit] attempts are kept.
+//
+// The following errors may be returned:
+// - [machineerrors.MachineNotFound] if the machine does not exist.
+func (st *State) RecordMachineRecoveryAttempt(
+	ctx context.Context, mName machine.Name, attempt domainmachine.RecoveryAttempt,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	name := machineName{Name: mName.String()}
+	machineStmt, err := st.Prepare(`
+SELECT &entityUUID.uuid
+FROM   machine
+WHERE  name = $machineName.name
+`, name, entityUUID{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	stmts, err := st.prepareMachineRecoveryStatements()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var uuid entityUUID
+		if err := tx.Query(ctx, machineStmt, name).Get(&uuid); errors.Is(err, sqlair.ErrNoRows) {
+			return machineerrors.MachineNotFound
+		} else if err != nil {
+			return errors.Errorf("querying machine %q: %w", mName, err)
+		}
+		dbAttempt := encodeMachineRecoveryAttempt(attempt)
+		dbAttempt.MachineUUID = uuid.UUID
+		return insertMachineRecoveryAttempt(ctx, tx, stmts, dbAttempt)
+	})
+	if err != nil {
+		return errors.Errorf("recording recovery attempt for machine %q: %w", mName, err)
+	}
+	return nil
+}
+
+// RecoverLostMachineCloudInstance detaches the failed cloud instance of the
+// machine as [State.DetachLostMachineCloudInstance] does, so that a
+// replacement is provisioned. In the same transaction the uniter and storage
+// state of the units on the machine, and their local relation state, are
+// cleared so that the units are installed again on the replacement, and the
+// attempt is recorded in the machine's recovery history.
+//
+// If retainModelStorage is true, model-scoped storage attached to the machine
+// keeps its provider state so that it is re-attached to the replacement
+// instance. This is only safe once the failed instance no longer exists.
+func (st *State) RecoverLostMachineCloudInstance(
+	ctx context.Context,
+	mName machine.Name,
+	expectedInstanceID instance.Id,
+	retainModelStorage bool,
+	attempt domainmachine.RecoveryAttempt,
+	statusMessage string,
+	statusData []byte,
+) error {
+	return st.detachMachineCloudInstance(
+		ctx, mName.String(), expectedInstanceID.String(), statusMessage, statusData,
+		attempt.AttemptedAt, &reprovisionRecovery{
+			attempt:            encodeMachineRecoveryAttempt(attempt),
+			retainModelStorage: retainModelStorage,
+		},
+	)
+}
+
+type machineRecoveryStatements struct {
+	unitStateResets []*sqlair.Statement
+	insertAttempt   *sqlair.Statement
+	pruneAttempts   *sqlair.Statement
+}
+
+func (st *State) prepareMachineRecoveryStatements() (machineRecoveryStatements, error) {
+	var statements machineRecoveryStatements
+	var err error
+	statements.unitStateResets, err = st.prepareReprovisionStatements([]string{
+		// Clear the uniter and storage state of the units on the machine so
+		// that their charms are installed again on the replacement instance.
+		// Charm and secret state are kept.
+		`
+UPDATE unit_state
+SET uniter_state = NULL,
+    storage_state = NULL
+WHERE unit_uuid IN (
+    SELECT u.uuid FROM unit AS u
+    WHERE u.net_node_uuid = $netNode.net_node_uuid
+)`,
+		// Clear the local relation state of the units on the machine so that
+		// their relations are joined again.
+		`
+DELETE FROM unit_state_relation
+WHERE unit_uuid IN (
+    SELECT u.uuid FROM unit AS u
+    WHERE u.net_node_uuid = $netNode.net_node_uuid
+)`,
+	}, netNode{})
+	if err != nil {
+		return machineRecoveryStatements{}, errors.Capture(err)
+	}
+	statements.insertAttempt, err = st.Prepare(`
+INSERT INTO machine_recovery_attempt (*)
+VALUES ($machineRecoveryAttempt.*)
+`, machineRecoveryAttempt{})
+	if err != nil {
+		return machineRecoveryStatements{}, errors.Capture(err)
+	}
+	statements.pruneAttempts, err = st.Prepare(fmt.Sprintf(`
+DELETE FROM machine_recovery_attempt
+WHERE machine_uuid = $machineRecoveryAttempt.machine_uuid
+AND attempted_at NOT IN (
+    SELECT mra.attempted_at
+    FROM machine_recovery_attempt AS mra
+    WHERE mra.machine_uuid = $machineRecoveryAttempt.machine_uuid
+    ORDER BY mra.attempted_at DESC
+    LIMIT %d
+)`, domainmachine.RecoveryHistoryLimit), machineRecoveryAttempt{})
+	if err != nil {
+		return machineRecoveryStatements{}, errors.Capture(err)
+	}
+	return statements, nil
+}
+
+// insertMachineRecoveryAttempt records the attempt and discards any attempts
+// for the machine beyond the history limit.
+func insertMachineRecoveryAttempt(
+	ctx context.Context, tx *sqlair.TX,
+	statements machineRecoveryStatements,
+	attempt machineRecoveryAttempt,
+) error {
+	if err := tx.Query(ctx, statements.insertAttempt, attempt).Run(); err != nil {
+		return errors.Errorf("recording recovery attempt: %w", err)
+	}
+	if err := tx.Query(ctx, statements.pruneAttempts, attempt).Run(); err != nil {
+		return errors.Errorf("pruning recovery attempts: %w", err)
+	}
+	return nil
+}
+
+func encodeMachineRecoveryAttempt(attempt domainmachine.RecoveryAttempt) machineRecoveryAttempt {
+	return machineRecoveryAttempt{
+		AttemptedAt: attempt.AttemptedAt,
+		InstanceID:  attempt.InstanceID.String(),
+		Reason:      attempt.Reason,
+		Error: sql.NullString{
+			String: attempt.Error,
+			Valid:  attempt.Error != "",
+		},
+	}
+}
+
+func (a machineRecoveryAttempt) decode() domainmachine.RecoveryAttempt {
+	return domainmachine.RecoveryAttempt{
+		AttemptedAt: a.AttemptedAt,
+		InstanceID:  instance.Id(a.InstanceID),
+		Reason:      a.Reason,
+		Error:       a.Error.String,
+	}
+}
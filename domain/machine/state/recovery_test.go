@@ -0,0 +1,170 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/machine"
+	domainmachine "github.com/juju/juju/domain/machine"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+)
+
+func (s *stateSuite) TestGetMachineRecoveryPolicy(c *tc.C) {
+	policy, err := s.state.GetMachineRecoveryPolicy(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(policy, tc.Equals, "")
+
+	s.runQuery(c, `INSERT INTO model_config ("key", value) VALUES (?, ?)`,
+		"machine-recovery-policy", "reprovision")
+
+	policy, err = s.state.GetMachineRecoveryPolicy(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(policy, tc.Equals, "reprovision")
+}
+
+func (s *stateSuite) TestRecordMachineRecoveryAttempt(c *tc.C) {
+	_, machineName := s.ensureInstance(c)
+
+	attemptedAt := time.Date(2026, 7, 23, 12, 0, 0, 0, time.UTC)
+	for i := range domainmachine.RecoveryHistoryLimit + 2 {
+		err := s.state.RecordMachineRecoveryAttempt(c.Context(), machineName, domainmachine.RecoveryAttempt{
+			AttemptedAt: attemptedAt.Add(time.Duration(i) * time.Minute),
+			InstanceID:  "123",
+			Reason:      "instance not found",
+			Error:       "machine agent is still present",
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	attempts, err := s.state.GetMachineRecoveryAttempts(c.Context(), machineName)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(attempts, tc.HasLen, domainmachine.RecoveryHistoryLimit)
+	c.Check(attempts[0].AttemptedAt.Equal(attemptedAt.Add(2*time.Minute)), tc.IsTrue)
+	c.Check(attempts[0], tc.DeepEquals, domainmachine.RecoveryAttempt{
+		AttemptedAt: attempts[0].AttemptedAt,
+		InstanceID:  "123",
+		Reason:      "instance not found",
+		Error:       "machine agent is still present",
+	})
+	last := attempts[len(attempts)-1]
+	c.Check(last.AttemptedAt.Equal(attemptedAt.Add(11*time.Minute)), tc.IsTrue)
+
+	all, err := s.state.GetAllMachineRecoveryAttempts(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(all, tc.DeepEquals, map[machine.Name][]domainmachine.RecoveryAttempt{
+		machineName: attempts,
+	})
+}
+
+func (s *stateSuite) TestRecordMachineRecoveryAttemptMachineNotFound(c *tc.C) {
+	err := s.state.RecordMachineRecoveryAttempt(c.Context(), "666", domainmachine.RecoveryAttempt{
+		AttemptedAt: time.Now(),
+		InstanceID:  "123",
+		Reason:      "instance not found",
+	})
+	c.Assert(err, tc.ErrorIs, machineerrors.MachineNotFound)
+
+	_, err = s.state.GetMachineRecoveryAttempts(c.Context(), "666")
+	c.Assert(err, tc.ErrorIs, machineerrors.MachineNotFound)
+}
+
+func (s *stateSuite) TestRecoverLostMachineCloudInstance(c *tc.C) {
+	machineUUID, machineName := s.ensureInstance(c)
+	netNodeUUID := s.machineNetNodeUUID(c, machineUUID.String())
+	s.addReprovisionUnit(c, netNodeUUID)
+	s.runQuery(c, "INSERT INTO unit_state VALUES (?, ?, ?, ?)",
+		"reprovision-unit", "uniter", "storage", "secret")
+	s.runQuery(c, "INSERT INTO unit_state_charm VALUES (?, ?, ?)",
+		"reprovision-unit", "key", "value")
+	s.runQuery(c, "INSERT INTO unit_state_relation VALUES (?, ?, ?)",
+		"reprovision-unit", "1", "relation")
+
+	attempt := domainmachine.RecoveryAttempt{
+		AttemptedAt: time.Date(2026, 7, 23, 12, 0, 0, 0, time.UTC),
+		InstanceID:  "123",
+		Reason:      "instance not found",
+	}
+	err := s.state.RecoverLostMachineCloudInstance(
+		c.Context(), machineName, "123", true, attempt,
+		"recovering", []byte(`{"old-instance-id":"123"}`),
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	s.checkInstanceID(c, machineUUID.String(), "")
+	c.Check(s.rowCount(c, "machine_reprovision"), tc.Equals, 1)
+
+	var uniterState, storageState, secretState sql.Null[string]
+	err = s.DB().QueryRowContext(c.Context(), `
+SELECT uniter_state, storage_state, secret_state
+FROM unit_state
+WHERE unit_uuid = ?`, "reprovision-unit").Scan(&uniterState, &storageState, &secretState)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(uniterState.Valid, tc.IsFalse)
+	c.Check(storageState.Valid, tc.IsFalse)
+	c.Check(secretState.V, tc.Equals, "secret")
+	c.Check(s.rowCount(c, "unit_state_charm"), tc.Equals, 1)
+	c.Check(s.rowCount(c, "unit_state_relation"), tc.Equals, 0)
+
+	attempts, err := s.state.GetMachineRecoveryAttempts(c.Context(), machineName)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(attempts, tc.HasLen, 1)
+	c.Check(attempts[0].InstanceID, tc.Equals, instance.Id("123"))
+	c.Check(attempts[0].Error, tc.Equals, "")
+}
+
+func (s *stateSuite) TestRecoverLostMachineCloudInstanceRetainsModelScopedStorage(c *tc.C) {
+	machineUUID, machineName := s.ensureInstance(c)
+	netNodeUUID := s.machineNetNodeUUID(c, machineUUID.String())
+	s.addReprovisionUnit(c, netNodeUUID)
+	s.addReprovisionVolumeStorage(c, machineUUID.String(), netNodeUUID, 0, 0)
+
+	err := s.state.RecoverLostMachineCloudInstance(
+		c.Context(), machineName, "123", true, domainmachine.RecoveryAttempt{
+			AttemptedAt: time.Now().UTC(),
+			InstanceID:  "123",
+			Reason:      "instance not found",
+		}, "recovering", nil,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var providerID, attachmentProviderID, blockDeviceUUID sql.Null[string]
+	var volumeStatus int
+	err = s.DB().QueryRowContext(c.Context(), `
+SELECT sv.provider_id, sva.provider_id, sva.block_device_uuid, svs.status_id
+FROM storage_volume AS sv
+JOIN storage_volume_attachment AS sva ON sv.uuid = sva.storage_volume_uuid
+JOIN storage_volume_status AS svs ON sv.uuid = svs.volume_uuid
+WHERE sv.uuid = ?`, "storage-volume").Scan(
+		&providerID, &attachmentProviderID, &blockDeviceUUID, &volumeStatus,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(providerID.V, tc.Equals, "old-volume-provider")
+	c.Check(attachmentProviderID.Valid, tc.IsFalse)
+	c.Check(blockDeviceUUID.Valid, tc.IsFalse)
+	c.Check(volumeStatus, tc.Equals, 3)
+	c.Check(s.rowCount(c, "storage_volume_attachment_plan"), tc.Equals, 0)
+	c.Check(s.rowCountWhere(c, "block_device", "uuid = ?", "storage-block"), tc.Equals, 0)
+}
+
+func (s *stateSuite) TestRecoverLostMachineCloudInstanceRejectsModelScopedStorage(c *tc.C) {
+	machineUUID, machineName := s.ensureInstance(c)
+	netNodeUUID := s.machineNetNodeUUID(c, machineUUID.String())
+	s.addReprovisionUnit(c, netNodeUUID)
+	s.addReprovisionVolumeStorage(c, machineUUID.String(), netNodeUUID, 0, 0)
+
+	err := s.state.RecoverLostMachineCloudInstance(
+		c.Context(), machineName, "123", false, domainmachine.RecoveryAttempt{
+			AttemptedAt: time.Now().UTC(),
+			InstanceID:  "123",
+			Reason:      "instance stopped",
+		}, "recovering", nil,
+	)
+	c.Assert(err, tc.ErrorIs, machineerrors.ModelScopedStorageAttached)
+	s.checkInstanceID(c, machineUUID.String(), "123")
+	c.Check(s.rowCount(c, "machine_recovery_attempt"), tc.Equals, 0)
+}
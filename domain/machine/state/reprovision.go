@@ -31,6 +31,32 @@ func (st *State) DetachLostMachineCloudInstance(
 	statusMessage string,
 	statusData []byte,
 	updatedAt time.Time,
+) error {
+	return st.detachMachineCloudInstance(
+		ctx, mName, expectedInstanceID, statusMessage, statusData, updatedAt, nil,
+	)
+}
+
+// reprovisionRecovery holds the additional work done when a machine is
+// reprovisioned by automatic recovery rather than by the operator.
+type reprovisionRecovery struct {
+	// attempt is recorded in the machine's recovery history.
+	attempt machineRecoveryAttempt
+
+	// retainModelStorage allows model-scoped storage to be attached to the
+	// machine. Its provider state is kept so that it is re-attached to the
+	// replacement instance.
+	retainModelStorage bool
+}
+
+func (st *State) detachMachineCloudInstance(
+	ctx context.Context,
+	mName string,
+	expectedInstanceID string,
+	statusMessage string,
+	statusData []byte,
+	updatedAt time.Time,
+	recovery *reprovisionRecovery,
 ) error {
 	db, err := st.DB(ctx)
 	if err != nil {
@@ -105,6 +131,12 @@ VALUES ($machineReprovision.*)
 	if err != nil {
 		return errors.Errorf("preparing storage reset statements: %w", err)
 	}
+	var recoveryStmts machineRecoveryStatements
+	if recovery != nil {
+		if recoveryStmts, err = st.prepareMachineRecoveryStatements(); err != nil {
+			return errors.Errorf("preparing recovery statements: %w", err)
+		}
+	}
 
 	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		var existingReprovision machineReprovision
@@ -138,6 +170,7 @@ VALUES ($machineReprovision.*)
 		}
 		storageTargets, err := st.getReprovisionStorageTargets(
 			ctx, tx, storageTargetStmts, storageParams,
+			recovery != nil && recovery.retainModelStorage,
 		)
 		if err != nil {
 			return errors.Capture(err)
@@ -184,6 +217,20 @@ VALUES ($machineReprovision.*)
 		}).Run(); err != nil {
 			return errors.Errorf("recording reprovision wake-up: %w", err)
 		}
+		if recovery == nil {
+			return nil
+		}
+
+		if err := runReprovisionStatements(
+			ctx, tx, recoveryStmts.unitStateResets, netNode{UUID: target.NetNodeUUID},
+		); err != nil {
+			return errors.Errorf("resetting unit state: %w", err)
+		}
+		attempt := recovery.attempt
+		attempt.MachineUUID = target.UUID
+		if err := insertMachineRecoveryAttempt(ctx, tx, recoveryStmts, attempt); err != nil {
+			return errors.Capture(err)
+		}
 		return nil
 	})
 }
@@ -713,9 +760,11 @@ func validateReprovisionStorageLives(
 }
 
 // getReprovisionStorageTargets captures storage associated with the machine
-// after lifecycle validation. Model-scoped, inconsistent, or incomplete
-// storage fails closed. Maps ensure each reset statement targets a UUID only
-// once.
+// after lifecycle validation. Inconsistent or incomplete storage fails closed,
+// as does model-scoped storage unless retainModelStorage is set. Retained
+// model-scoped volumes and filesystems keep their provider state; only their
+// attachments are targeted. Maps ensure each reset statement targets a UUID
+// only once.
 //
 // storage_attachment, machine_volume, and machine_filesystem are retained as
 // Juju associations. They participate in target discovery so incomplete
@@ -724,6 +773,7 @@ func (st *State) getReprovisionStorageTargets(
 	ctx context.Context, tx *sqlair.TX,
 	statements reprovisionStorageTargetStatements,
 	params reprovisionStorageTargetParams,
+	retainModelStorage bool,
 ) (reprovisionStorageTargets, error) {
 	var volumeRows []reprovisionStorageEntityTarget
 	if err := tx.Query(ctx, statements.volumes, params).GetAll(&volumeRows); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
@@ -789,10 +839,13 @@ func (st *State) getReprovisionStorageTargets(
 		plans := volumePlans[row.EntityUUID]
 		if err := validateReprovisionStorageEntity(
 			"volume", row, volumeLogical[row.EntityUUID], attachments, plans, params,
+			retainModelStorage,
 		); err != nil {
 			return reprovisionStorageTargets{}, errors.Capture(err)
 		}
-		targets.volumes[row.EntityUUID] = struct{}{}
+		if row.ScopeID != params.ModelScopeID {
+			targets.volumes[row.EntityUUID] = struct{}{}
+		}
 		for _, attachment := range attachments {
 			targets.volumeAttachments[attachment.UUID] = struct{}{}
 			if attachment.BlockDeviceUUID.Valid {
@@ -807,10 +860,13 @@ func (st *State) getReprovisionStorageTargets(
 		attachments := filesystemAttachments[row.EntityUUID]
 		if err := validateReprovisionStorageEntity(
 			"filesystem", row, filesystemLogical[row.EntityUUID], attachments, nil, params,
+			retainModelStorage,
 		); err != nil {
 			return reprovisionStorageTargets{}, errors.Capture(err)
 		}
-		targets.filesystems[row.EntityUUID] = struct{}{}
+		if row.ScopeID != params.ModelScopeID {
+			targets.filesystems[row.EntityUUID] = struct{}{}
+		}
 		for _, attachment := range attachments {
 			targets.filesystemAttachments[attachment.UUID] = struct{}{}
 		}
@@ -825,6 +881,7 @@ func validateReprovisionStorageEntity(
 	attachments []reprovisionStoragePhysicalAttachment,
 	plans []reprovisionStoragePlanTarget,
 	params reprovisionStorageTargetParams,
+	retainModelStorage bool,
 ) error {
 	if !entity.StorageInstanceUUID.Valid {
 		return errors.Errorf(
@@ -860,7 +917,7 @@ func validateReprovisionStorageEntity(
 			)
 		}
 	}
-	if entity.ScopeID == params.ModelScopeID {
+	if entity.ScopeID == params.ModelScopeID && !retainModelStorage {
 		return errors.Errorf(
 			"%s %q: %w", entityType, entity.EntityUUID,
 			machineerrors.ModelScopedStorageAttached,
@@ -1324,10 +1324,18 @@ WHERE m.name = $machineName.name
 			AllocatePublicIP: row.AllocatePublicIP,
 			ImageID:          row.ImageID,
 			IPFamily:         row.IPFamily,
-			SpaceName:        row.SpaceName,
-			SpaceExclude:     row.SpaceExclude,
-			Tag:              row.Tag,
-			Zone:             row.Zone,
+
+			Market:              row.Market,
+			MaxPrice:            row.MaxPrice,
+			CapacityReservation: row.CapacityReservation,
+			PlacementGroup:      row.PlacementGroup,
+			Gpus:                row.Gpus,
+			GpuType:             row.GpuType,
+
+			SpaceName:    row.SpaceName,
+			SpaceExclude: row.SpaceExclude,
+			Tag:          row.Tag,
+			Zone:         row.Zone,
 		}
 	}
 
@@ -1611,6 +1619,25 @@ func decodeConstraints(cons machineConstraints) constraints.Constraints {
 			f := ipfamily.IPFamily(row.IPFamily.String)
 			res.IPFamily = &f
 		}
+		if row.Market.Valid {
+			res.Market = &row.Market.String
+		}
+		if row.MaxPrice.Valid {
+			res.MaxPrice = &row.MaxPrice.String
+		}
+		if row.CapacityReservation.Valid {
+			res.CapacityReservation = &row.CapacityReservation.String
+		}
+		if row.PlacementGroup.Valid {
+			res.PlacementGroup = &row.PlacementGroup.String
+		}
+		if row.Gpus.Valid {
+			gpus := uint64(row.Gpus.V)
+			res.Gpus = &gpus
+		}
+		if row.GpuType.Valid {
+			res.GpuType = &row.GpuType.String
+		}
 		if row.SpaceName.Valid {
 			var exclude bool
 			if row.SpaceExclude.Valid {
@@ -295,24 +295,30 @@ type acquireParentMachineForContainerArgs struct {
 // constraint table with the constraint_space, constraint_tag and
 // constraint_zone.
 type machineConstraint struct {
-	MachineUUID      string          `db:"machine_uuid"`
-	Arch             sql.NullString  `db:"arch"`
-	CPUCores         sql.Null[int64] `db:"cpu_cores"`
-	CPUPower         sql.Null[int64] `db:"cpu_power"`
-	Mem              sql.Null[int64] `db:"mem"`
-	RootDisk         sql.Null[int64] `db:"root_disk"`
-	RootDiskSource   sql.NullString  `db:"root_disk_source"`
-	InstanceRole     sql.NullString  `db:"instance_role"`
-	InstanceType     sql.NullString  `db:"instance_type"`
-	ContainerType    sql.NullString  `db:"container_type"`
-	VirtType         sql.NullString  `db:"virt_type"`
-	AllocatePublicIP sql.NullBool    `db:"allocate_public_ip"`
-	ImageID          sql.NullString  `db:"image_id"`
-	IPFamily         sql.NullString  `db:"ip_family"`
-	SpaceName        sql.NullString  `db:"space_name"`
-	SpaceExclude     sql.NullBool    `db:"space_exclude"`
-	Tag              sql.NullString  `db:"tag"`
-	Zone             sql.NullString  `db:"zone"`
+	MachineUUID         string          `db:"machine_uuid"`
+	Arch                sql.NullString  `db:"arch"`
+	CPUCores            sql.Null[int64] `db:"cpu_cores"`
+	CPUPower            sql.Null[int64] `db:"cpu_power"`
+	Mem                 sql.Null[int64] `db:"mem"`
+	RootDisk            sql.Null[int64] `db:"root_disk"`
+	RootDiskSource      sql.NullString  `db:"root_disk_source"`
+	InstanceRole        sql.NullString  `db:"instance_role"`
+	InstanceType        sql.NullString  `db:"instance_type"`
+	ContainerType       sql.NullString  `db:"container_type"`
+	VirtType            sql.NullString  `db:"virt_type"`
+	AllocatePublicIP    sql.NullBool    `db:"allocate_public_ip"`
+	ImageID             sql.NullString  `db:"image_id"`
+	IPFamily            sql.NullString  `db:"ip_family"`
+	Market              sql.NullString  `db:"market"`
+	MaxPrice            sql.NullString  `db:"max_price"`
+	CapacityReservation sql.NullString  `db:"capacity_reservation"`
+	PlacementGroup      sql.NullString  `db:"placement_group"`
+	Gpus                sql.Null[int64] `db:"gpus"`
+	GpuType             sql.NullString  `db:"gpu_type"`
+	SpaceName           sql.NullString  `db:"space_name"`
+	SpaceExclude        sql.NullBool    `db:"space_exclude"`
+	Tag                 sql.NullString  `db:"tag"`
+	Zone                sql.NullString  `db:"zone"`
 }
 
 type machineConstraints []machineConstraint
@@ -330,23 +336,29 @@ type machineProvisioningRow struct {
 
 	// From v_machine_constraint (one row per space/tag/zone combination;
 	// scalar fields are repeated across rows):
-	Arch             sql.NullString  `db:"arch"`
-	CPUCores         sql.Null[int64] `db:"cpu_cores"`
-	CPUPower         sql.Null[int64] `db:"cpu_power"`
-	Mem              sql.Null[int64] `db:"mem"`
-	RootDisk         sql.Null[int64] `db:"root_disk"`
-	RootDiskSource   sql.NullString  `db:"root_disk_source"`
-	InstanceRole     sql.NullString  `db:"instance_role"`
-	InstanceType     sql.NullString  `db:"instance_type"`
-	ContainerType    sql.NullString  `db:"container_type"`
-	VirtType         sql.NullString  `db:"virt_type"`
-	AllocatePublicIP sql.NullBool    `db:"allocate_public_ip"`
-	ImageID          sql.NullString  `db:"image_id"`
-	IPFamily         sql.NullString  `db:"ip_family"`
-	SpaceName        sql.NullString  `db:"space_name"`
-	SpaceExclude     sql.NullBool    `db:"space_exclude"`
-	Tag              sql.NullString  `db:"tag"`
-	Zone             sql.NullString  `db:"zone"`
+	Arch                sql.NullString  `db:"arch"`
+	CPUCores            sql.Null[int64] `db:"cpu_cores"`
+	CPUPower            sql.Null[int64] `db:"cpu_power"`
+	Mem                 sql.Null[int64] `db:"mem"`
+	RootDisk            sql.Null[int64] `db:"root_disk"`
+	RootDiskSource      sql.NullString  `db:"root_disk_source"`
+	InstanceRole        sql.NullString  `db:"instance_role"`
+	InstanceType        sql.NullString  `db:"instance_type"`
+	ContainerType       sql.NullString  `db:"container_type"`
+	VirtType            sql.NullString  `db:"virt_type"`
+	AllocatePublicIP    sql.NullBool    `db:"allocate_public_ip"`
+	ImageID             sql.NullString  `db:"image_id"`
+	IPFamily            sql.NullString  `db:"ip_family"`
+	Market              sql.NullString  `db:"market"`
+	MaxPrice            sql.NullString  `db:"max_price"`
+	CapacityReservation sql.NullString  `db:"capacity_reservation"`
+	PlacementGroup      sql.NullString  `db:"placement_group"`
+	Gpus                sql.Null[int64] `db:"gpus"`
+	GpuType             sql.NullString  `db:"gpu_type"`
+	SpaceName           sql.NullString  `db:"space_name"`
+	SpaceExclude        sql.NullBool    `db:"space_exclude"`
+	Tag                 sql.NullString  `db:"tag"`
+	Zone                sql.NullString  `db:"zone"`
 }
 
 type machineProvisioningRows []machineProvisioningRow
@@ -363,20 +375,26 @@ type setMachineConstraint struct {
 }
 
 type setConstraint struct {
-	UUID             string             `db:"uuid"`
-	Arch             *string            `db:"arch"`
-	CPUCores         *uint64            `db:"cpu_cores"`
-	CPUPower         *uint64            `db:"cpu_power"`
-	Mem              *uint64            `db:"mem"`
-	RootDisk         *uint64            `db:"root_disk"`
-	RootDiskSource   *string            `db:"root_disk_source"`
-	InstanceRole     *string            `db:"instance_role"`
-	InstanceType     *string            `db:"instance_type"`
-	ContainerTypeID  *uint64            `db:"container_type_id"`
-	VirtType         *string            `db:"virt_type"`
-	AllocatePublicIP *bool              `db:"allocate_public_ip"`
-	ImageID          *string            `db:"image_id"`
-	IPFamily         *ipfamily.IPFamily `db:"ip_family"`
+	UUID                string             `db:"uuid"`
+	Arch                *string            `db:"arch"`
+	CPUCores            *uint64            `db:"cpu_cores"`
+	CPUPower            *uint64            `db:"cpu_power"`
+	Mem                 *uint64            `db:"mem"`
+	RootDisk            *uint64            `db:"root_disk"`
+	RootDiskSource      *string            `db:"root_disk_source"`
+	InstanceRole        *string            `db:"instance_role"`
+	InstanceType        *string            `db:"instance_type"`
+	ContainerTypeID     *uint64            `db:"container_type_id"`
+	VirtType            *string            `db:"virt_type"`
+	AllocatePublicIP    *bool              `db:"allocate_public_ip"`
+	ImageID             *string            `db:"image_id"`
+	IPFamily            *ipfamily.IPFamily `db:"ip_family"`
+	Market              *string            `db:"market"`
+	MaxPrice            *string            `db:"max_price"`
+	CapacityReservation *string            `db:"capacity_reservation"`
+	PlacementGroup      *string            `db:"placement_group"`
+	Gpus                *uint64            `db:"gpus"`
+	GpuType             *string            `db:"gpu_type"`
 }
 
 type setConstraintTag struct {
@@ -405,19 +423,25 @@ type containerTypeVal struct {
 
 // dbConstraint represents a single row within the v_model_constraint view.
 type dbConstraint struct {
-	Arch             sql.NullString  `db:"arch"`
-	CPUCores         sql.Null[int64] `db:"cpu_cores"`
-	CPUPower         sql.Null[int64] `db:"cpu_power"`
-	Mem              sql.Null[int64] `db:"mem"`
-	RootDisk         sql.Null[int64] `db:"root_disk"`
-	RootDiskSource   sql.NullString  `db:"root_disk_source"`
-	InstanceRole     sql.NullString  `db:"instance_role"`
-	InstanceType     sql.NullString  `db:"instance_type"`
-	ContainerType    sql.NullString  `db:"container_type"`
-	VirtType         sql.NullString  `db:"virt_type"`
-	AllocatePublicIP sql.NullBool    `db:"allocate_public_ip"`
-	ImageID          sql.NullString  `db:"image_id"`
-	IPFamily         sql.NullString  `db:"ip_family"`
+	Arch                sql.NullString  `db:"arch"`
+	CPUCores            sql.Null[int64] `db:"cpu_cores"`
+	CPUPower            sql.Null[int64] `db:"cpu_power"`
+	Mem                 sql.Null[int64] `db:"mem"`
+	RootDisk            sql.Null[int64] `db:"root_disk"`
+	RootDiskSource      sql.NullString  `db:"root_disk_source"`
+	InstanceRole        sql.NullString  `db:"instance_role"`
+	InstanceType        sql.NullString  `db:"instance_type"`
+	ContainerType       sql.NullString  `db:"container_type"`
+	VirtType            sql.NullString  `db:"virt_type"`
+	AllocatePublicIP    sql.NullBool    `db:"allocate_public_ip"`
+	ImageID             sql.NullString  `db:"image_id"`
+	IPFamily            sql.NullString  `db:"ip_family"`
+	Market              sql.NullString  `db:"market"`
+	MaxPrice            sql.NullString  `db:"max_price"`
+	CapacityReservation sql.NullString  `db:"capacity_reservation"`
+	PlacementGroup      sql.NullString  `db:"placement_group"`
+	Gpus                sql.Null[int64] `db:"gpus"`
+	GpuType             sql.NullString  `db:"gpu_type"`
 }
 
 func (c dbConstraint) toValue(
@@ -466,6 +490,24 @@ func (c dbConstraint) toValue(
 		f := ipfamily.IPFamily(c.IPFamily.String)
 		rval.IPFamily = &f
 	}
+	if c.Market.Valid {
+		rval.Market = &c.Market.String
+	}
+	if c.MaxPrice.Valid {
+		rval.MaxPrice = &c.MaxPrice.String
+	}
+	if c.CapacityReservation.Valid {
+		rval.CapacityReservation = &c.CapacityReservation.String
+	}
+	if c.PlacementGroup.Valid {
+		rval.PlacementGroup = &c.PlacementGroup.String
+	}
+	if c.Gpus.Valid {
+		rval.Gpus = new(uint64(c.Gpus.V))
+	}
+	if c.GpuType.Valid {
+		rval.GpuType = &c.GpuType.String
+	}
 	if c.ContainerType.Valid {
 		containerType := instance.ContainerType(c.ContainerType.String)
 		rval.Container = &containerType
@@ -593,3 +635,19 @@ type machineReprovision struct {
 	MachineName string    `db:"machine_name"`
 	RequestedAt time.Time `db:"requested_at"`
 }
+
+type machineRecoveryAttempt struct {
+	MachineUUID string         `db:"machine_uuid"`
+	AttemptedAt time.Time      `db:"attempted_at"`
+	InstanceID  string         `db:"instance_id"`
+	Reason      string         `db:"reason"`
+	Error       sql.NullString `db:"error"`
+}
+
+type modelConfigKey struct {
+	Key string `db:"key"`
+}
+
+type modelConfigValue struct {
+	Value string `db:"value"`
+}
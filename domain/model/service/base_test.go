@@ -23,6 +23,7 @@ type baseSuite struct {
 	mockModelResourceProvider  *MockModelResourcesProvider
 	mockProviderRegistry       *MockProviderRegistry
 	mockRegionProvider         *MockRegionProvider
+	mockStatusHistory          *MockStatusHistory
 }
 
 // storageProviderRegistryGetterFunc provides a func type that implements
@@ -57,6 +58,7 @@ func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.mockModelResourceProvider = NewMockModelResourcesProvider(ctrl)
 	s.mockProviderRegistry = NewMockProviderRegistry(ctrl)
 	s.mockRegionProvider = NewMockRegionProvider(ctrl)
+	s.mockStatusHistory = NewMockStatusHistory(ctrl)
 
 	c.Cleanup(func() {
 		s.mockControllerState = nil
@@ -67,6 +69,7 @@ func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 		s.mockModelResourceProvider = nil
 		s.mockProviderRegistry = nil
 		s.mockRegionProvider = nil
+		s.mockStatusHistory = nil
 	})
 	return ctrl
 }
@@ -9,6 +9,7 @@ import (
 	"slices"
 
 	"github.com/juju/clock"
+	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/core/agentbinary"
 	coreconstraints "github.com/juju/juju/core/constraints"
@@ -26,6 +27,7 @@ import (
 	"github.com/juju/juju/domain/model"
 	modelerrors "github.com/juju/juju/domain/model/errors"
 	modelinternal "github.com/juju/juju/domain/model/internal"
+	domainstatus "github.com/juju/juju/domain/status"
 	"github.com/juju/juju/domain/storage"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/simplestreams"
@@ -91,6 +93,14 @@ type ControllerState interface {
 	// The following errors may be returned:
 	// - [modelerrors.NotFound] when the model no longer exists.
 	HasValidCredential(context.Context, coremodel.UUID) (bool, error)
+
+	// RenameModel changes the name of the activated model with the given
+	// uuid.
+	// The following errors may be returned:
+	// - [modelerrors.NotFound] when no activated model exists for the uuid.
+	// - [modelerrors.AlreadyExists] when a model with the new name already
+	// exists for the same qualifier.
+	RenameModel(context.Context, coremodel.UUID, string) error
 }
 
 // ModelResourcesProvider mirrors the [environs.ModelResources] interface that is
@@ -199,6 +209,13 @@ type ModelState interface {
 	// - [modelerrors.NotFound] when the model does not exist.
 	IsControllerModel(context.Context) (bool, error)
 
+	// SetModelName updates the name of the model recorded in the model
+	// database, together with the name held in the model's config. Setting
+	// the name the model already has is not an error.
+	// The following errors may be returned:
+	// - [modelerrors.NotFound] when the model does not exist.
+	SetModelName(context.Context, string) error
+
 	// IsImportingModel returns true if this model is being imported.
 	IsImportingModel(ctx context.Context) (bool, error)
 }
@@ -864,6 +881,7 @@ type ProviderModelService struct {
 	cloudInfoGetter               providertracker.ProviderGetter[CloudInfoProvider]
 	environRegionGetter           providertracker.ProviderGetter[RegionProvider]
 	logger                        logger.Logger
+	statusHistory                 StatusHistory
 	storageProviderRegistryGetter StorageProviderRegistryGetter
 }
 
@@ -878,6 +896,7 @@ func NewProviderModelService(
 	environRegionGetter providertracker.ProviderGetter[RegionProvider],
 	storageProviderRegistryGetter StorageProviderRegistryGetter,
 	agentBinaryFinder AgentBinaryFinder,
+	statusHistory StatusHistory,
 	logger logger.Logger,
 ) *ProviderModelService {
 	return &ProviderModelService{
@@ -893,6 +912,7 @@ func NewProviderModelService(
 		cloudInfoGetter:               cloudInfoGetter,
 		environRegionGetter:           environRegionGetter,
 		storageProviderRegistryGetter: storageProviderRegistryGetter,
+		statusHistory:                 statusHistory,
 		logger:                        logger,
 	}
 }
@@ -1090,6 +1110,78 @@ func (s *ProviderModelService) GetRegionCloudSpec(ctx context.Context) (simplest
 	return provider.Region()
 }
 
+// RenameModel changes the name of the model. The model keeps its uuid and
+// qualifier, so everything in the model is unaffected. The model is renamed
+// on the controller first, which fails if the name is already taken, and
+// then in the model database and its config. If the model database can't be
+// updated, the model is given its old name on the controller again. Both
+// writes can safely be repeated, so a failed rename can be retried. The
+// rename is recorded in the model's status history.
+//
+// Kubernetes models and the controller model can't be renamed, as resources
+// outside of Juju are named after them.
+//
+// The following errors may be returned:
+// - [coreerrors.NotValid] when the new name is not a valid model name.
+// - [coreerrors.NotSupported] when the model can't be renamed.
+// - [modelerrors.AlreadyExists] when a model with the new name already exists
+// for the same qualifier.
+// - [modelerrors.NotFound] when the model no longer exists.
+func (s *ProviderModelService) RenameModel(ctx context.Context, name string) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if !names.IsValidModelName(name) {
+		return errors.Errorf("model name %q not valid", name).Add(coreerrors.NotValid)
+	}
+
+	info, err := s.modelSt.GetModel(ctx)
+	if err != nil {
+		return errors.Errorf("getting model: %w", err)
+	}
+	if info.Name == name {
+		return nil
+	}
+	if info.IsControllerModel {
+		return errors.Errorf("renaming the controller model").Add(coreerrors.NotSupported)
+	}
+	if info.Type == coremodel.CAAS {
+		return errors.Errorf(
+			"renaming model %q, the kubernetes namespace is named after the model", info.Name,
+		).Add(coreerrors.NotSupported)
+	}
+
+	modelState, err := s.controllerSt.GetModelState(ctx, s.modelUUID)
+	if err != nil {
+		return errors.Errorf("getting model %q state: %w", info.Name, err)
+	}
+	if modelState.Destroying || modelState.Migrating {
+		return errors.Errorf(
+			"renaming model %q while it is being destroyed or migrated", info.Name,
+		).Add(coreerrors.NotSupported)
+	}
+
+	if err := s.controllerSt.RenameModel(ctx, s.modelUUID, name); err != nil {
+		return errors.Errorf("renaming model %q to %q: %w", info.Name, name, err)
+	}
+	if err := s.modelSt.SetModelName(ctx, name); err != nil {
+		if undoErr := s.controllerSt.RenameModel(ctx, s.modelUUID, info.Name); undoErr != nil {
+			s.logger.Errorf(ctx, "restoring name of model %q after failed rename to %q: %v", info.Name, name, undoErr)
+		}
+		return errors.Errorf("renaming model %q to %q: %w", info.Name, name, err)
+	}
+
+	status := s.statusFromModelState(modelState)
+	if err := s.statusHistory.RecordStatus(ctx, domainstatus.ModelNamespace.WithID(s.modelUUID.String()), corestatus.StatusInfo{
+		Status:  status.Status,
+		Message: fmt.Sprintf("renamed from %q", info.Name),
+		Since:   &status.Since,
+	}); err != nil {
+		s.logger.Warningf(ctx, "recording rename of model %q: %v", name, err)
+	}
+	return nil
+}
+
 // agentBinaryFinderFn is func type for the AgentBinaryFinder interface.
 type agentBinaryFinderFn func(semversion.Number) (bool, error)
 
@@ -30,10 +30,12 @@ import (
 	modelerrors "github.com/juju/juju/domain/model/errors"
 	modelinternal "github.com/juju/juju/domain/model/internal"
 	networkerrors "github.com/juju/juju/domain/network/errors"
+	domainstatus "github.com/juju/juju/domain/status"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/simplestreams"
 	"github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/statushistory"
 	internalstorage "github.com/juju/juju/internal/storage"
 	"github.com/juju/juju/internal/uuid"
 )
@@ -997,6 +999,7 @@ func (s *providerModelServiceSuite) providerService(
 		func(context.Context) (RegionProvider, error) { return s.mockRegionProvider, nil },
 		s.mockStorageProviderRegistryGetter,
 		DefaultAgentBinaryFinder(),
+		s.mockStatusHistory,
 		loggertesting.WrapCheckLog(c),
 	)
 }
@@ -1311,3 +1314,131 @@ func (s *providerModelServiceSuite) TestGetModelRegionNotSupported(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(spec, tc.DeepEquals, simplestreams.CloudSpec{})
 }
+
+func (s *providerModelServiceSuite) TestRenameModel(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	modelUUID := tc.Must0(c, coremodel.NewUUID)
+	s.mockModelState.EXPECT().GetModel(gomock.Any()).Return(coremodel.ModelInfo{
+		UUID: modelUUID,
+		Name: "staging",
+		Type: coremodel.IAAS,
+	}, nil)
+	s.mockControllerState.EXPECT().GetModelState(gomock.Any(), modelUUID).Return(model.ModelState{}, nil)
+	s.mockControllerState.EXPECT().RenameModel(gomock.Any(), modelUUID, "production").Return(nil)
+	s.mockModelState.EXPECT().SetModelName(gomock.Any(), "production").Return(nil)
+	s.mockStatusHistory.EXPECT().RecordStatus(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, ns statushistory.Namespace, info corestatus.StatusInfo) error {
+			c.Check(ns.String(), tc.Equals, domainstatus.ModelNamespace.WithID(modelUUID.String()).String())
+			c.Check(info.Status, tc.Equals, corestatus.Available)
+			c.Check(info.Message, tc.Equals, `renamed from "staging"`)
+			return nil
+		})
+
+	err := s.providerService(c, modelUUID).RenameModel(c.Context(), "production")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerModelServiceSuite) TestRenameModelSameName(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.mockModelState.EXPECT().GetModel(gomock.Any()).Return(coremodel.ModelInfo{
+		Name: "staging",
+		Type: coremodel.IAAS,
+	}, nil)
+
+	err := s.providerService(c, tc.Must0(c, coremodel.NewUUID)).RenameModel(c.Context(), "staging")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerModelServiceSuite) TestRenameModelNameNotValid(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	err := s.providerService(c, tc.Must0(c, coremodel.NewUUID)).RenameModel(c.Context(), "Not_Valid")
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *providerModelServiceSuite) TestRenameModelCAAS(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.mockModelState.EXPECT().GetModel(gomock.Any()).Return(coremodel.ModelInfo{
+		Name: "staging",
+		Type: coremodel.CAAS,
+	}, nil)
+
+	err := s.providerService(c, tc.Must0(c, coremodel.NewUUID)).RenameModel(c.Context(), "production")
+	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
+}
+
+func (s *providerModelServiceSuite) TestRenameModelControllerModel(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.mockModelState.EXPECT().GetModel(gomock.Any()).Return(coremodel.ModelInfo{
+		Name:              "controller",
+		Type:              coremodel.IAAS,
+		IsControllerModel: true,
+	}, nil)
+
+	err := s.providerService(c, tc.Must0(c, coremodel.NewUUID)).RenameModel(c.Context(), "production")
+	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
+}
+
+func (s *providerModelServiceSuite) TestRenameModelMigrating(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	modelUUID := tc.Must0(c, coremodel.NewUUID)
+	s.mockModelState.EXPECT().GetModel(gomock.Any()).Return(coremodel.ModelInfo{
+		Name: "staging",
+		Type: coremodel.IAAS,
+	}, nil)
+	s.mockControllerState.EXPECT().GetModelState(gomock.Any(), modelUUID).Return(model.ModelState{
+		Migrating: true,
+	}, nil)
+
+	err := s.providerService(c, modelUUID).RenameModel(c.Context(), "production")
+	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
+}
+
+func (s *providerModelServiceSuite) TestRenameModelAlreadyExists(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	modelUUID := tc.Must0(c, coremodel.NewUUID)
+	s.mockModelState.EXPECT().GetModel(gomock.Any()).Return(coremodel.ModelInfo{
+		Name: "staging",
+		Type: coremodel.IAAS,
+	}, nil)
+	s.mockControllerState.EXPECT().GetModelState(gomock.Any(), modelUUID).Return(model.ModelState{}, nil)
+	s.mockControllerState.EXPECT().RenameModel(gomock.Any(), modelUUID, "production").Return(modelerrors.AlreadyExists)
+
+	err := s.providerService(c, modelUUID).RenameModel(c.Context(), "production")
+	c.Assert(err, tc.ErrorIs, modelerrors.AlreadyExists)
+}
+
+// TestRenameModelModelStateFails asserts that the model is given its old name
+// on the controller again when the model database can't be updated.
+func (s *providerModelServiceSuite) TestRenameModelModelStateFails(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	modelUUID := tc.Must0(c, coremodel.NewUUID)
+	s.mockModelState.EXPECT().GetModel(gomock.Any()).Return(coremodel.ModelInfo{
+		Name: "staging",
+		Type: coremodel.IAAS,
+	}, nil)
+	s.mockControllerState.EXPECT().GetModelState(gomock.Any(), modelUUID).Return(model.ModelState{}, nil)
+	gomock.InOrder(
+		s.mockControllerState.EXPECT().RenameModel(gomock.Any(), modelUUID, "production").Return(nil),
+		s.mockModelState.EXPECT().SetModelName(gomock.Any(), "production").Return(errors.New("boom")),
+		s.mockControllerState.EXPECT().RenameModel(gomock.Any(), modelUUID, "staging").Return(nil),
+	)
+
+	err := s.providerService(c, modelUUID).RenameModel(c.Context(), "production")
+	c.Assert(err, tc.ErrorMatches, `renaming model "staging" to "production": boom`)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/domain/model/service (interfaces: CloudInfoProvider,ControllerState,EnvironVersionProvider,ModelState,ModelResourcesProvider,RegionProvider,State,StorageProviderRegistryGetter,WatcherFactory)
+// Source: github.com/juju/juju/domain/model/service (interfaces: CloudInfoProvider,ControllerState,EnvironVersionProvider,ModelState,ModelResourcesProvider,RegionProvider,State,StatusHistory,StorageProviderRegistryGetter,WatcherFactory)
 //
 // Generated by this command:
 //
-//	mockgen -package service -destination package_mock_test.go github.com/juju/juju/domain/model/service CloudInfoProvider,ControllerState,EnvironVersionProvider,ModelState,ModelResourcesProvider,RegionProvider,State,StorageProviderRegistryGetter,WatcherFactory
+//	mockgen -package service -destination package_mock_test.go github.com/juju/juju/domain/model/service CloudInfoProvider,ControllerState,EnvironVersionProvider,ModelState,ModelResourcesProvider,RegionProvider,State,StatusHistory,StorageProviderRegistryGetter,WatcherFactory
 //
 
 // Package service is a generated GoMock package.
@@ -18,6 +18,7 @@ import (
 	constraints "github.com/juju/juju/core/constraints"
 	credential "github.com/juju/juju/core/credential"
 	model "github.com/juju/juju/core/model"
+	status "github.com/juju/juju/core/status"
 	user "github.com/juju/juju/core/user"
 	watcher "github.com/juju/juju/core/watcher"
 	eventsource "github.com/juju/juju/core/watcher/eventsource"
@@ -27,6 +28,7 @@ import (
 	internal "github.com/juju/juju/domain/model/internal"
 	environs "github.com/juju/juju/environs"
 	simplestreams "github.com/juju/juju/environs/simplestreams"
+	statushistory "github.com/juju/juju/internal/statushistory"
 	storage "github.com/juju/juju/internal/storage"
 	uuid "github.com/juju/juju/internal/uuid"
 )
@@ -89,6 +91,7 @@ type MockControllerStateMockRecorder struct {
 	getModelSummaryExpects         []*gomock.Call2_2[context.Context, model.UUID, model0.ModelSummary, error]
 	getUserModelSummaryExpects     []*gomock.Call3_2[context.Context, user.UUID, model.UUID, model0.UserModelSummary, error]
 	hasValidCredentialExpects      []*gomock.Call2_2[context.Context, model.UUID, bool, error]
+	renameModelExpects             []*gomock.Call3_1[context.Context, model.UUID, string, error]
 }
 
 // NewMockControllerState creates a new mock instance.
@@ -193,6 +196,24 @@ func (mr *MockControllerStateMockRecorder) HasValidCredential(arg0, arg1 any) *M
 // MockControllerStateHasValidCredentialCall is the typed call wrapper for HasValidCredential.
 type MockControllerStateHasValidCredentialCall = gomock.Call2_2[context.Context, model.UUID, bool, error]
 
+// RenameModel mocks base method.
+func (m *MockControllerState) RenameModel(arg0 context.Context, arg1 model.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.renameModelExpects, m.ctrl, m, "RenameModel", arg0, arg1, arg2)
+}
+
+// RenameModel indicates an expected call of RenameModel.
+func (mr *MockControllerStateMockRecorder) RenameModel(arg0, arg1, arg2 any) *MockControllerStateRenameModelCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, model.UUID, string, error](mr.mock.ctrl.T, mr.mock, "RenameModel", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1), gomock.EnsureMatcher(arg2))
+	mr.renameModelExpects = append(mr.renameModelExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockControllerStateRenameModelCall is the typed call wrapper for RenameModel.
+type MockControllerStateRenameModelCall = gomock.Call3_1[context.Context, model.UUID, string, error]
+
 // MockEnvironVersionProvider is a mock of EnvironVersionProvider interface.
 type MockEnvironVersionProvider struct {
 	ctrl     *gomock.Controller
@@ -259,6 +280,7 @@ type MockModelStateMockRecorder struct {
 	isControllerModelExpects         []*gomock.Call1_2[context.Context, bool, error]
 	isImportingModelExpects          []*gomock.Call1_2[context.Context, bool, error]
 	setModelConstraintsExpects       []*gomock.Call2_1[context.Context, constraints0.Constraints, error]
+	setModelNameExpects              []*gomock.Call2_1[context.Context, string, error]
 	setModelStoragePoolsExpects      []*gomock.Call2_1[context.Context, []internal.SetModelStoragePoolArg, error]
 }
 
@@ -508,6 +530,24 @@ func (mr *MockModelStateMockRecorder) SetModelConstraints(arg0, arg1 any) *MockM
 // MockModelStateSetModelConstraintsCall is the typed call wrapper for SetModelConstraints.
 type MockModelStateSetModelConstraintsCall = gomock.Call2_1[context.Context, constraints0.Constraints, error]
 
+// SetModelName mocks base method.
+func (m *MockModelState) SetModelName(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.setModelNameExpects, m.ctrl, m, "SetModelName", arg0, arg1)
+}
+
+// SetModelName indicates an expected call of SetModelName.
+func (mr *MockModelStateMockRecorder) SetModelName(arg0, arg1 any) *MockModelStateSetModelNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, string, error](mr.mock.ctrl.T, mr.mock, "SetModelName", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1))
+	mr.setModelNameExpects = append(mr.setModelNameExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelStateSetModelNameCall is the typed call wrapper for SetModelName.
+type MockModelStateSetModelNameCall = gomock.Call2_1[context.Context, string, error]
+
 // SetModelStoragePools mocks base method.
 func (m *MockModelState) SetModelStoragePools(arg0 context.Context, arg1 []internal.SetModelStoragePoolArg) error {
 	m.ctrl.T.Helper()
@@ -1244,6 +1284,49 @@ func (mr *MockStateMockRecorder) UpdateCredential(arg0, arg1, arg2 any) *MockSta
 // MockStateUpdateCredentialCall is the typed call wrapper for UpdateCredential.
 type MockStateUpdateCredentialCall = gomock.Call3_1[context.Context, model.UUID, credential.Key, error]
 
+// MockStatusHistory is a mock of StatusHistory interface.
+type MockStatusHistory struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusHistoryMockRecorder
+	isgomock struct{}
+}
+
+// MockStatusHistoryMockRecorder is the mock recorder for MockStatusHistory.
+type MockStatusHistoryMockRecorder struct {
+	mock                *MockStatusHistory
+	recordStatusExpects []*gomock.Call3_1[context.Context, statushistory.Namespace, status.StatusInfo, error]
+}
+
+// NewMockStatusHistory creates a new mock instance.
+func NewMockStatusHistory(ctrl *gomock.Controller) *MockStatusHistory {
+	mock := &MockStatusHistory{ctrl: ctrl}
+	mock.recorder = &MockStatusHistoryMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatusHistory) EXPECT() *MockStatusHistoryMockRecorder {
+	return m.recorder
+}
+
+// RecordStatus mocks base method.
+func (m *MockStatusHistory) RecordStatus(arg0 context.Context, arg1 statushistory.Namespace, arg2 status.StatusInfo) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.recordStatusExpects, m.ctrl, m, "RecordStatus", arg0, arg1, arg2)
+}
+
+// RecordStatus indicates an expected call of RecordStatus.
+func (mr *MockStatusHistoryMockRecorder) RecordStatus(arg0, arg1, arg2 any) *MockStatusHistoryRecordStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, statushistory.Namespace, status.StatusInfo, error](mr.mock.ctrl.T, mr.mock, "RecordStatus", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1), gomock.EnsureMatcher(arg2))
+	mr.recordStatusExpects = append(mr.recordStatusExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStatusHistoryRecordStatusCall is the typed call wrapper for RecordStatus.
+type MockStatusHistoryRecordStatusCall = gomock.Call3_1[context.Context, statushistory.Namespace, status.StatusInfo, error]
+
 // MockStorageProviderRegistryGetter is a mock of StorageProviderRegistryGetter interface.
 type MockStorageProviderRegistryGetter struct {
 	ctrl     *gomock.Controller
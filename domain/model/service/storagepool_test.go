@@ -39,6 +39,7 @@ func (s *storagePoolSuite) providerService(
 		func(context.Context) (RegionProvider, error) { return s.mockRegionProvider, nil },
 		s.storageProviderRegistryGetter(),
 		DefaultAgentBinaryFinder(),
+		s.mockStatusHistory,
 		loggertesting.WrapCheckLog(c),
 	)
 }
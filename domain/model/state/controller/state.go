@@ -438,6 +438,45 @@ AND    qualifier = $dbNames.qualifier
 	return model.toCoreModel()
 }
 
+// RenameModel changes the name of the activated model with the given uuid.
+// The model keeps its qualifier. The following errors may be returned:
+// - [modelerrors.NotFound] when no activated model exists for the uuid.
+// - [modelerrors.AlreadyExists] when a model with the new name already exists
+// for the same qualifier.
+func (s *State) RenameModel(ctx context.Context, uuid coremodel.UUID, name string) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	rename := dbModelRename{UUID: uuid.String(), Name: name}
+	stmt, err := s.Prepare(`
+UPDATE model
+SET    name = $dbModelRename.name
+WHERE  uuid = $dbModelRename.uuid
+AND    activated = TRUE
+`, rename)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		err := tx.Query(ctx, stmt, rename).Get(&outcome)
+		if jujudb.IsErrConstraintUnique(err) {
+			return errors.Errorf("model with name %q already exists", name).Add(modelerrors.AlreadyExists)
+		} else if err != nil {
+			return errors.Errorf("renaming model %q: %w", uuid, err)
+		}
+		if affected, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Errorf("renaming model %q: %w", uuid, err)
+		} else if affected == 0 {
+			return errors.Errorf("model %q does not exist", uuid).Add(modelerrors.NotFound)
+		}
+		return nil
+	})
+}
+
 // GetModelState is responsible for returning a set of boolean indicators for
 // key aspects about a model so that a model's status can be derived from this
 // information. If no model exists for the provided UUID then an error
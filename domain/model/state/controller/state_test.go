@@ -1713,6 +1713,44 @@ func (m *stateSuite) TestGetModelUsersModelNotFound(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, modelerrors.NotFound)
 }
 
+func (m *stateSuite) TestRenameModel(c *tc.C) {
+	m.createControllerModel(c, m.controllerModelUUID, m.userUUID)
+	m.createModel(c, m.uuid, m.userUUID)
+
+	err := m.modelState.RenameModel(c.Context(), m.uuid, "renamed")
+	c.Assert(err, tc.ErrorIsNil)
+
+	model, err := m.modelState.GetModel(c.Context(), m.uuid)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(model.Name, tc.Equals, "renamed")
+	c.Check(model.Qualifier, tc.Equals, coremodel.Qualifier("prod"))
+
+	_, err = m.modelState.GetModelByName(c.Context(), "prod", "my-test-model")
+	c.Check(err, tc.ErrorIs, modelerrors.NotFound)
+}
+
+func (m *stateSuite) TestRenameModelAlreadyExists(c *tc.C) {
+	m.createControllerModel(c, m.controllerModelUUID, m.userUUID)
+	m.createModel(c, m.uuid, m.userUUID)
+	m.createModelWithName(c, "other-model", tc.Must(c, coremodel.NewUUID), m.userUUID)
+
+	err := m.modelState.RenameModel(c.Context(), m.uuid, "other-model")
+	c.Check(err, tc.ErrorIs, modelerrors.AlreadyExists)
+}
+
+func (m *stateSuite) TestRenameModelNotActivated(c *tc.C) {
+	m.createControllerModel(c, m.controllerModelUUID, m.userUUID)
+	m.createModelWithoutActivation(c, "my-test-model", m.uuid, m.userUUID)
+
+	err := m.modelState.RenameModel(c.Context(), m.uuid, "renamed")
+	c.Check(err, tc.ErrorIs, modelerrors.NotFound)
+}
+
+func (m *stateSuite) TestRenameModelNotFound(c *tc.C) {
+	err := m.modelState.RenameModel(c.Context(), tc.Must(c, coremodel.NewUUID), "renamed")
+	c.Check(err, tc.ErrorIs, modelerrors.NotFound)
+}
+
 func (m *stateSuite) TestGetModelStateModelNotFound(c *tc.C) {
 	uuid := tc.Must(c, coremodel.NewUUID)
 
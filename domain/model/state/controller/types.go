@@ -249,6 +249,12 @@ type dbName struct {
 	Name string `db:"name"`
 }
 
+// dbModelRename represents the new name for the model with the given uuid.
+type dbModelRename struct {
+	UUID string `db:"uuid"`
+	Name string `db:"name"`
+}
+
 type dbModelActivated struct {
 	Activated bool `db:"activated"`
 }
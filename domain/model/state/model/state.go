@@ -1355,6 +1355,52 @@ func InsertModelInfo(
 	return nil
 }
 
+// SetModelName updates the name of the model recorded in the model database,
+// together with the name held in the model's config. Setting the name the
+// model already has is not an error.
+// The following errors may be returned:
+// - [modelerrors.NotFound] when the model does not exist.
+func (s *ModelState) SetModelName(ctx context.Context, name string) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	m := dbReadOnlyModel{Name: name}
+	modelStmt, err := s.Prepare(`UPDATE model SET name = $dbReadOnlyModel.name`, m)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	// The name key is the model name held in the model's config.
+	cfg := dbModelConfigValue{Key: "name", Value: name}
+	configStmt, err := s.Prepare(`
+UPDATE model_config
+SET    value = $dbModelConfigValue.value
+WHERE  key = $dbModelConfigValue.key
+`, cfg)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, modelStmt, m).Get(&outcome); err != nil {
+			return errors.Errorf("setting model name: %w", err)
+		}
+		if affected, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Errorf("setting model name: %w", err)
+		} else if affected == 0 {
+			return errors.Errorf("model does not exist").Add(modelerrors.NotFound)
+		}
+
+		if err := tx.Query(ctx, configStmt, cfg).Run(); err != nil {
+			return errors.Errorf("setting model config name: %w", err)
+		}
+		return nil
+	})
+}
+
 // IsControllerModel returns true if the model is the controller model.
 // The following errors may be returned:
 // - [modelerrors.NotFound] when the model does not exist.
@@ -225,8 +225,57 @@ func (s *modelSuite) TestCreateModelAndUpdate(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 
 	db := s.DB()
-	_, err = db.ExecContext(c.Context(), "UPDATE model SET name = 'new-name' WHERE uuid = $1", id)
-	c.Assert(err, tc.ErrorMatches, `model table is immutable, only insertions are allowed`)
+	_, err = db.ExecContext(c.Context(), "UPDATE model SET qualifier = 'staging' WHERE uuid = $1", id)
+	c.Assert(err, tc.ErrorMatches, `model table is immutable, only insertions and name updates are allowed`)
+}
+
+func (s *modelSuite) TestSetModelName(c *tc.C) {
+	runner := s.TxnRunnerFactory()
+	state := NewState(runner, loggertesting.WrapCheckLog(c))
+
+	id := tc.Must0(c, coremodel.NewUUID)
+	err := state.Create(c.Context(), model.ModelDetailArgs{
+		UUID:               id,
+		AgentStream:        domainagentbinary.AgentStreamReleased,
+		AgentVersion:       jujuversion.Current,
+		LatestAgentVersion: jujuversion.Current,
+		ControllerUUID:     s.controllerUUID,
+		Name:               "my-awesome-model",
+		Qualifier:          "prod",
+		Type:               coremodel.IAAS,
+		Cloud:              "aws",
+		CloudType:          "ec2",
+		CloudRegion:        "myregion",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.DB().ExecContext(c.Context(), `INSERT INTO model_config (key, value) VALUES ('name', 'my-awesome-model')`)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = state.SetModelName(c.Context(), "renamed")
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Setting the same name again is not an error.
+	err = state.SetModelName(c.Context(), "renamed")
+	c.Assert(err, tc.ErrorIsNil)
+
+	info, err := state.GetModel(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(info.Name, tc.Equals, "renamed")
+	c.Check(info.Qualifier, tc.Equals, coremodel.Qualifier("prod"))
+
+	var configName string
+	err = s.DB().QueryRowContext(c.Context(), `SELECT value FROM model_config WHERE key = 'name'`).Scan(&configName)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(configName, tc.Equals, "renamed")
+}
+
+func (s *modelSuite) TestSetModelNameNotFound(c *tc.C) {
+	runner := s.TxnRunnerFactory()
+	state := NewState(runner, loggertesting.WrapCheckLog(c))
+
+	err := state.SetModelName(c.Context(), "renamed")
+	c.Check(err, tc.ErrorIs, modelerrors.NotFound)
 }
 
 func (s *modelSuite) TestCreateModelAndDelete(c *tc.C) {
@@ -252,7 +301,7 @@ func (s *modelSuite) TestCreateModelAndDelete(c *tc.C) {
 
 	db := s.DB()
 	_, err = db.ExecContext(c.Context(), "DELETE FROM model WHERE uuid = $1", id)
-	c.Assert(err, tc.ErrorMatches, `model table is immutable, only insertions are allowed`)
+	c.Assert(err, tc.ErrorMatches, `model table is immutable, only insertions and name updates are allowed`)
 }
 
 func (s *modelSuite) TestModelNotFound(c *tc.C) {
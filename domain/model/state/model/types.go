@@ -88,6 +88,12 @@ type dbReadOnlyModel struct {
 	IsControllerModel bool   `db:"is_controller_model"`
 }
 
+// dbModelConfigValue represents a single row from the model_config table.
+type dbModelConfigValue struct {
+	Key   string `db:"key"`
+	Value string `db:"value"`
+}
+
 type dbModelMetrics struct {
 	ApplicationCount int `db:"application_count"`
 	MachineCount     int `db:"machine_count"`
@@ -126,37 +132,49 @@ type dbModelConstraint struct {
 
 // dbConstraint represents a single row within the v_model_constraint view.
 type dbConstraint struct {
-	Arch             sql.NullString `db:"arch"`
-	CPUCores         sql.NullInt64  `db:"cpu_cores"`
-	CPUPower         sql.NullInt64  `db:"cpu_power"`
-	Mem              sql.NullInt64  `db:"mem"`
-	RootDisk         sql.NullInt64  `db:"root_disk"`
-	RootDiskSource   sql.NullString `db:"root_disk_source"`
-	InstanceRole     sql.NullString `db:"instance_role"`
-	InstanceType     sql.NullString `db:"instance_type"`
-	ContainerType    sql.NullString `db:"container_type"`
-	VirtType         sql.NullString `db:"virt_type"`
-	AllocatePublicIP sql.NullBool   `db:"allocate_public_ip"`
-	ImageID          sql.NullString `db:"image_id"`
-	IPFamily         sql.NullString `db:"ip_family"`
+	Arch                sql.NullString `db:"arch"`
+	CPUCores            sql.NullInt64  `db:"cpu_cores"`
+	CPUPower            sql.NullInt64  `db:"cpu_power"`
+	Mem                 sql.NullInt64  `db:"mem"`
+	RootDisk            sql.NullInt64  `db:"root_disk"`
+	RootDiskSource      sql.NullString `db:"root_disk_source"`
+	InstanceRole        sql.NullString `db:"instance_role"`
+	InstanceType        sql.NullString `db:"instance_type"`
+	ContainerType       sql.NullString `db:"container_type"`
+	VirtType            sql.NullString `db:"virt_type"`
+	AllocatePublicIP    sql.NullBool   `db:"allocate_public_ip"`
+	ImageID             sql.NullString `db:"image_id"`
+	IPFamily            sql.NullString `db:"ip_family"`
+	Market              sql.NullString `db:"market"`
+	MaxPrice            sql.NullString `db:"max_price"`
+	CapacityReservation sql.NullString `db:"capacity_reservation"`
+	PlacementGroup      sql.NullString `db:"placement_group"`
+	Gpus                sql.NullInt64  `db:"gpus"`
+	GpuType             sql.NullString `db:"gpu_type"`
 }
 
 // dbConstraintInsert is used to supply insert values into the constraint table.
 type dbConstraintInsert struct {
-	UUID             string         `db:"uuid"`
-	Arch             sql.NullString `db:"arch"`
-	CPUCores         sql.NullInt64  `db:"cpu_cores"`
-	CPUPower         sql.NullInt64  `db:"cpu_power"`
-	Mem              sql.NullInt64  `db:"mem"`
-	RootDisk         sql.NullInt64  `db:"root_disk"`
-	RootDiskSource   sql.NullString `db:"root_disk_source"`
-	InstanceRole     sql.NullString `db:"instance_role"`
-	InstanceType     sql.NullString `db:"instance_type"`
-	ContainerTypeId  sql.NullInt64  `db:"container_type_id"`
-	VirtType         sql.NullString `db:"virt_type"`
-	AllocatePublicIP sql.NullBool   `db:"allocate_public_ip"`
-	ImageID          sql.NullString `db:"image_id"`
-	IPFamily         sql.NullString `db:"ip_family"`
+	UUID                string         `db:"uuid"`
+	Arch                sql.NullString `db:"arch"`
+	CPUCores            sql.NullInt64  `db:"cpu_cores"`
+	CPUPower            sql.NullInt64  `db:"cpu_power"`
+	Mem                 sql.NullInt64  `db:"mem"`
+	RootDisk            sql.NullInt64  `db:"root_disk"`
+	RootDiskSource      sql.NullString `db:"root_disk_source"`
+	InstanceRole        sql.NullString `db:"instance_role"`
+	InstanceType        sql.NullString `db:"instance_type"`
+	ContainerTypeId     sql.NullInt64  `db:"container_type_id"`
+	VirtType            sql.NullString `db:"virt_type"`
+	AllocatePublicIP    sql.NullBool   `db:"allocate_public_ip"`
+	ImageID             sql.NullString `db:"image_id"`
+	IPFamily            sql.NullString `db:"ip_family"`
+	Market              sql.NullString `db:"market"`
+	MaxPrice            sql.NullString `db:"max_price"`
+	CapacityReservation sql.NullString `db:"capacity_reservation"`
+	PlacementGroup      sql.NullString `db:"placement_group"`
+	Gpus                sql.NullInt64  `db:"gpus"`
+	GpuType             sql.NullString `db:"gpu_type"`
 }
 
 // constraintsToDBInsert is responsible for taking a constraints value and
@@ -215,6 +233,30 @@ func constraintsToDBInsert(
 			String: deref(constraints.IPFamily).String(),
 			Valid:  constraints.IPFamily != nil,
 		},
+		Market: sql.NullString{
+			String: deref(constraints.Market),
+			Valid:  constraints.Market != nil,
+		},
+		MaxPrice: sql.NullString{
+			String: deref(constraints.MaxPrice),
+			Valid:  constraints.MaxPrice != nil,
+		},
+		CapacityReservation: sql.NullString{
+			String: deref(constraints.CapacityReservation),
+			Valid:  constraints.CapacityReservation != nil,
+		},
+		PlacementGroup: sql.NullString{
+			String: deref(constraints.PlacementGroup),
+			Valid:  constraints.PlacementGroup != nil,
+		},
+		Gpus: sql.NullInt64{
+			Int64: int64(deref(constraints.Gpus)),
+			Valid: constraints.Gpus != nil,
+		},
+		GpuType: sql.NullString{
+			String: deref(constraints.GpuType),
+			Valid:  constraints.GpuType != nil,
+		},
 	}
 }
 
@@ -274,6 +316,24 @@ func (c dbConstraint) toValue(
 		f := ipfamily.IPFamily(c.IPFamily.String)
 		rval.IPFamily = &f
 	}
+	if c.Market.Valid {
+		rval.Market = &c.Market.String
+	}
+	if c.MaxPrice.Valid {
+		rval.MaxPrice = &c.MaxPrice.String
+	}
+	if c.CapacityReservation.Valid {
+		rval.CapacityReservation = &c.CapacityReservation.String
+	}
+	if c.PlacementGroup.Valid {
+		rval.PlacementGroup = &c.PlacementGroup.String
+	}
+	if c.Gpus.Valid {
+		rval.Gpus = new(uint64(c.Gpus.Int64))
+	}
+	if c.GpuType.Valid {
+		rval.GpuType = &c.GpuType.String
+	}
 	if c.ContainerType.Valid {
 		containerType := instance.ContainerType(c.ContainerType.String)
 		rval.Container = &containerType
@@ -475,6 +475,7 @@ func (s *Service) validatorForSetModelConfig(
 // when it is being updated. The validator returned will check that:
 // - Agent version is not being changed.
 // - CharmhubURL is not being changed.
+// - Name is not being changed.
 // - Network space exists.
 // - Container networking method is not being changed.
 func (s *Service) validatorForUpdateModelConfig(
@@ -485,6 +486,7 @@ func (s *Service) validatorForUpdateModelConfig(
 			validators.AgentStreamChange(),
 			validators.AgentVersionChange(),
 			validators.CharmhubURLChange(),
+			validators.NameChange(),
 			validators.SpaceChecker(&spaceValidator{
 				st: s.st,
 			}),
@@ -30,6 +30,20 @@ func CharmhubURLChange() config.ValidatorFunc {
 	}
 }
 
+// NameChange returns a config validator that will check to make sure the
+// model name has not changed. The name is only changed by renaming the model.
+func NameChange() config.ValidatorFunc {
+	return func(ctx context.Context, cfg, old *config.Config) (*config.Config, error) {
+		if cfg.Name() != old.Name() {
+			return cfg, &config.ValidationError{
+				InvalidAttrs: []string{config.NameKey},
+				Reason:       "name cannot be changed, use rename-model instead",
+			}
+		}
+		return cfg, nil
+	}
+}
+
 // AgentStreamChange returns a config validator that will check to make sure the
 // agent stream does not change and also remove it from config so that it does
 // not get committed back to state.
@@ -70,6 +70,39 @@ func (*validatorsSuite) TestCharmhubURLNoChange(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (*validatorsSuite) TestNameChange(c *tc.C) {
+	oldCfg, err := config.New(config.NoDefaults, map[string]any{
+		"name": "wallyworld",
+		"uuid": testing.ModelTag.Id(),
+		"type": "sometype",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	newCfg, err := config.New(config.NoDefaults, map[string]any{
+		"name": "worldwally",
+		"uuid": testing.ModelTag.Id(),
+		"type": "sometype",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	var validationError *config.ValidationError
+	_, err = NameChange()(c.Context(), newCfg, oldCfg)
+	c.Assert(errors.As(err, &validationError), tc.IsTrue)
+	c.Assert(validationError.InvalidAttrs, tc.DeepEquals, []string{"name"})
+}
+
+func (*validatorsSuite) TestNameNoChange(c *tc.C) {
+	cfg, err := config.New(config.NoDefaults, map[string]any{
+		"name": "wallyworld",
+		"uuid": testing.ModelTag.Id(),
+		"type": "sometype",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = NameChange()(c.Context(), cfg, cfg)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 // TestAgentStreamChange is testing that the agent stream variable can't change.
 func (*validatorsSuite) TestAgentStreamChanged(c *tc.C) {
 	oldCfg, err := config.New(config.NoDefaults, map[string]any{
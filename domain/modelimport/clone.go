@@ -0,0 +1,344 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelimport
+
+import (
+	"slices"
+	"strings"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/export/types/latest"
+	"github.com/juju/juju/domain/export/types/v4_1_0"
+	"github.com/juju/juju/domain/machine"
+	"github.com/juju/juju/domain/operation"
+	domainsequence "github.com/juju/juju/domain/sequence"
+	"github.com/juju/juju/domain/storage"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+const (
+	// modelConfigUUIDKey and modelConfigNameKey are the model_config row
+	// keys holding the model's identity. They mirror environs/config.UUIDKey
+	// and environs/config.NameKey.
+	modelConfigUUIDKey = "uuid"
+	modelConfigNameKey = "name"
+
+	// secretSubjectUnit and secretScopeUnit are the
+	// secret_grant_subject_type and secret_grant_scope_type ids of a unit.
+	secretSubjectUnit = 0
+	secretScopeUnit   = "0"
+)
+
+// CloneArgs describes the new model a payload is cloned into.
+type CloneArgs struct {
+	// ModelUUID is the UUID of the clone.
+	ModelUUID string
+
+	// ModelName is the name of the clone.
+	ModelName string
+
+	// WithoutUnits scales every application in the clone to zero.
+	WithoutUnits bool
+}
+
+// ClonedPayload is a model-DB payload prepared for import as a clone of the
+// model it was exported from.
+type ClonedPayload struct {
+	// Payload is the payload to import into the clone.
+	Payload latest.ModelExport
+
+	// OfferUUIDs maps the UUID of each offer in the source model to the UUID
+	// of the corresponding offer in the clone.
+	OfferUUIDs map[string]string
+}
+
+// PrepareClone returns a copy of the payload holding only what a clone of
+// the model carries over: its applications, charms, config, relations,
+// constraints, offers and application or model owned secrets. Machines,
+// units, storage instances and operations are dropped, so that the clone
+// provisions everything from scratch, and the model and its offers are given
+// fresh identities.
+//
+// Cross-model relations are bound to the source model, so a payload with
+// any remote applications is rejected with an error satisfying
+// [coreerrors.NotSupported].
+func PrepareClone(payload latest.ModelExport, args CloneArgs) (ClonedPayload, error) {
+	if len(payload.ApplicationRemoteOfferer) > 0 ||
+		len(payload.ApplicationRemoteConsumer) > 0 ||
+		len(payload.OfferConnection) > 0 {
+		return ClonedPayload{}, errors.Errorf(
+			"cloning a model with cross-model relations %w", coreerrors.NotSupported,
+		)
+	}
+
+	clone := payload
+	clone.ModelAgent = slices.Clone(payload.ModelAgent)
+	for i := range clone.ModelAgent {
+		clone.ModelAgent[i].ModelUUID = args.ModelUUID
+	}
+	clone.ModelConstraint = slices.Clone(payload.ModelConstraint)
+	for i := range clone.ModelConstraint {
+		clone.ModelConstraint[i].ModelUUID = args.ModelUUID
+	}
+	clone.ModelConfig = slices.Clone(payload.ModelConfig)
+	for i, row := range clone.ModelConfig {
+		switch row.Key {
+		case modelConfigUUIDKey:
+			clone.ModelConfig[i].Value = args.ModelUUID
+		case modelConfigNameKey:
+			clone.ModelConfig[i].Value = args.ModelName
+		}
+	}
+
+	dropMachines(&clone)
+	dropUnits(&clone)
+	dropStorage(&clone)
+	dropOperations(&clone)
+	dropUnitSecrets(&clone)
+	clone.Removal = nil
+	clone.Sequence = filter(payload.Sequence, keepSequence(payload.Application))
+
+	if args.WithoutUnits {
+		clone.ApplicationScale = slices.Clone(payload.ApplicationScale)
+		var zero int64
+		scaling := false
+		for i := range clone.ApplicationScale {
+			clone.ApplicationScale[i].Scale = &zero
+			clone.ApplicationScale[i].ScaleTarget = &zero
+			clone.ApplicationScale[i].Scaling = &scaling
+		}
+	}
+
+	offerUUIDs, err := renameOffers(&clone)
+	if err != nil {
+		return ClonedPayload{}, errors.Capture(err)
+	}
+	return ClonedPayload{
+		Payload:    clone,
+		OfferUUIDs: offerUUIDs,
+	}, nil
+}
+
+// dropMachines removes the machines, and the network nodes and provider
+// entities tied to them, from the payload.
+func dropMachines(p *latest.ModelExport) {
+	machineConstraints := make(map[string]bool)
+	for _, row := range p.MachineConstraint {
+		machineConstraints[row.ConstraintUUID] = true
+	}
+	p.Constraint = filter(p.Constraint, func(r v4_1_0.Constraint) bool { return !machineConstraints[r.UUID] })
+	p.ConstraintSpace = filter(p.ConstraintSpace, func(r v4_1_0.ConstraintSpace) bool { return !machineConstraints[r.ConstraintUUID] })
+	p.ConstraintTag = filter(p.ConstraintTag, func(r v4_1_0.ConstraintTag) bool { return !machineConstraints[r.ConstraintUUID] })
+	p.ConstraintZone = filter(p.ConstraintZone, func(r v4_1_0.ConstraintZone) bool { return !machineConstraints[r.ConstraintUUID] })
+
+	p.AnnotationMachine = nil
+	p.BlockDevice = nil
+	p.BlockDeviceLinkDevice = nil
+	p.InstanceTag = nil
+	p.Machine = nil
+	p.MachineAgentPresence = nil
+	p.MachineAgentVersion = nil
+	p.MachineCloudInstance = nil
+	p.MachineCloudInstanceStatus = nil
+	p.MachineConstraint = nil
+	p.MachineContainerType = nil
+	p.MachineFilesystem = nil
+	p.MachineLxdProfile = nil
+	p.MachineManual = nil
+	p.MachineParent = nil
+	p.MachinePlacement = nil
+	p.MachinePlatform = nil
+	p.MachineReprovision = nil
+	p.MachineRequiresReboot = nil
+	p.MachineSshHostKey = nil
+	p.MachineStatus = nil
+	p.MachineVirtualSshHostKey = nil
+	p.MachineVolume = nil
+
+	// Every network node belongs to a machine or to a Kubernetes service,
+	// both of which are recreated by the clone's provisioners.
+	p.FqdnAddress = nil
+	p.HostnameAddress = nil
+	p.IpAddress = nil
+	p.K8sService = nil
+	p.LinkLayerDevice = nil
+	p.LinkLayerDeviceDnsAddress = nil
+	p.LinkLayerDeviceDnsDomain = nil
+	p.LinkLayerDeviceParent = nil
+	p.LinkLayerDeviceRoute = nil
+	p.NetNode = nil
+	p.NetNodeFqdnAddress = nil
+	p.NetNodeHostnameAddress = nil
+	p.ProviderIpAddress = nil
+	p.ProviderLinkLayerDevice = nil
+	p.SshConnectionRequest = nil
+	p.SshConnectionRequestAddress = nil
+}
+
+// dropUnits removes the units, and everything recorded against them, from
+// the payload.
+func dropUnits(p *latest.ModelExport) {
+	p.AnnotationUnit = nil
+	p.K8sPod = nil
+	p.K8sPodPort = nil
+	p.K8sPodStatus = nil
+	p.PortRange = nil
+	p.RelationUnit = nil
+	p.RelationUnitSetting = nil
+	p.RelationUnitSettingArchive = nil
+	p.RelationUnitSettingsHash = nil
+	p.Unit = nil
+	p.UnitAgentPresence = nil
+	p.UnitAgentStatus = nil
+	p.UnitAgentVersion = nil
+	p.UnitPrincipal = nil
+	p.UnitResolved = nil
+	p.UnitResource = nil
+	p.UnitState = nil
+	p.UnitStateCharm = nil
+	p.UnitStateRelation = nil
+	p.UnitStorageDirective = nil
+	p.UnitVirtualSshHostKey = nil
+	p.UnitWorkloadStatus = nil
+	p.UnitWorkloadVersion = nil
+}
+
+// dropStorage removes the storage instances and the filesystems and volumes
+// backing them from the payload. Storage pools and the applications'
+// storage directives are kept, so the clone's units are given new storage.
+func dropStorage(p *latest.ModelExport) {
+	p.AnnotationStorageFilesystem = nil
+	p.AnnotationStorageInstance = nil
+	p.AnnotationStorageVolume = nil
+	p.StorageAttachment = nil
+	p.StorageFilesystem = nil
+	p.StorageFilesystemAttachment = nil
+	p.StorageFilesystemStatus = nil
+	p.StorageInstance = nil
+	p.StorageInstanceFilesystem = nil
+	p.StorageInstanceVolume = nil
+	p.StorageUnitOwner = nil
+	p.StorageVolume = nil
+	p.StorageVolumeAttachment = nil
+	p.StorageVolumeAttachmentPlan = nil
+	p.StorageVolumeAttachmentPlanAttr = nil
+	p.StorageVolumeStatus = nil
+}
+
+// dropOperations removes the operation history, whose tasks ran on the
+// source model's machines and units, from the payload.
+func dropOperations(p *latest.ModelExport) {
+	p.Operation = nil
+	p.OperationAction = nil
+	p.OperationMachineTask = nil
+	p.OperationParameter = nil
+	p.OperationTask = nil
+	p.OperationTaskLog = nil
+	p.OperationTaskOutput = nil
+	p.OperationTaskStatus = nil
+	p.OperationUnitTask = nil
+}
+
+// dropUnitSecrets removes the secrets owned by units, the grants to units
+// and the secret consumers from the payload. Revisions held in an external
+// secret backend lose their value references: the backend content belongs
+// to the source model, so only the metadata of those revisions is cloned.
+func dropUnitSecrets(p *latest.ModelExport) {
+	unitOwned := make(map[string]bool)
+	for _, row := range p.SecretUnitOwner {
+		unitOwned[row.SecretID] = true
+	}
+	// Only secrets with metadata are owned by the model; the rest are
+	// references to secrets in other models.
+	owned := make(map[string]bool)
+	for _, row := range p.SecretMetadata {
+		owned[row.SecretID] = !unitOwned[row.SecretID]
+	}
+	droppedRevisions := make(map[string]bool)
+	for _, row := range p.SecretRevision {
+		droppedRevisions[row.UUID] = !owned[row.SecretID]
+	}
+
+	p.Secret = filter(p.Secret, func(r v4_1_0.Secret) bool { return owned[r.ID] })
+	p.SecretMetadata = filter(p.SecretMetadata, func(r v4_1_0.SecretMetadata) bool { return owned[r.SecretID] })
+	p.SecretRotation = filter(p.SecretRotation, func(r v4_1_0.SecretRotation) bool { return owned[r.SecretID] })
+	p.SecretPermission = filter(p.SecretPermission, func(r v4_1_0.SecretPermission) bool {
+		return owned[r.SecretID] && r.SubjectTypeID != secretSubjectUnit && r.ScopeTypeID != secretScopeUnit
+	})
+	p.SecretRevision = filter(p.SecretRevision, func(r v4_1_0.SecretRevision) bool { return !droppedRevisions[r.UUID] })
+	p.SecretContent = filter(p.SecretContent, func(r v4_1_0.SecretContent) bool { return !droppedRevisions[r.RevisionUUID] })
+	p.SecretRevisionExpire = filter(p.SecretRevisionExpire, func(r v4_1_0.SecretRevisionExpire) bool { return !droppedRevisions[r.RevisionUUID] })
+	p.SecretRevisionObsolete = filter(p.SecretRevisionObsolete, func(r v4_1_0.SecretRevisionObsolete) bool { return !droppedRevisions[r.RevisionUUID] })
+
+	p.SecretDeletedValueRef = nil
+	p.SecretReference = nil
+	p.SecretRemoteUnitConsumer = nil
+	p.SecretReservation = nil
+	p.SecretUnitConsumer = nil
+	p.SecretUnitOwner = nil
+	p.SecretValueRef = nil
+}
+
+// keepSequence returns a predicate reporting whether a sequence survives
+// the clone. The sequences numbering the dropped machines, units, storage
+// and operations are reset, so the clone numbers them from the start.
+func keepSequence(applications []v4_1_0.Application) func(v4_1_0.Sequence) bool {
+	reset := map[string]bool{
+		machine.MachineSequenceNamespace.String():         true,
+		operation.OperationSequenceNamespace.String():     true,
+		storage.FilesystemSequenceNamespace.String():      true,
+		storage.VolumeSequenceNamespace.String():          true,
+		storage.StorageInstanceSequenceNamespace.String(): true,
+	}
+	for _, app := range applications {
+		reset[domainsequence.MakePrefixNamespace(
+			application.ApplicationSequenceNamespace, app.Name,
+		).String()] = true
+	}
+	// Containers are numbered per parent machine.
+	containerPrefix := domainsequence.MakePrefixNamespace(machine.ContainerSequenceNamespace, "").String()
+	return func(r v4_1_0.Sequence) bool {
+		return !reset[r.Namespace] && !strings.HasPrefix(r.Namespace, containerPrefix)
+	}
+}
+
+// renameOffers gives every offer in the payload a fresh UUID, returning the
+// mapping from the old UUIDs to the new ones.
+func renameOffers(p *latest.ModelExport) (map[string]string, error) {
+	offerUUIDs := make(map[string]string, len(p.Offer))
+	offers := make([]v4_1_0.Offer, len(p.Offer))
+	for i, offer := range p.Offer {
+		newUUID, err := uuid.NewUUID()
+		if err != nil {
+			return nil, errors.Errorf("generating offer UUID: %w", err)
+		}
+		offerUUIDs[offer.UUID] = newUUID.String()
+		offer.UUID = newUUID.String()
+		offers[i] = offer
+	}
+	p.Offer = offers
+
+	endpoints := make([]v4_1_0.OfferEndpoint, len(p.OfferEndpoint))
+	for i, endpoint := range p.OfferEndpoint {
+		endpoint.OfferUUID = offerUUIDs[endpoint.OfferUUID]
+		endpoints[i] = endpoint
+	}
+	p.OfferEndpoint = endpoints
+	return offerUUIDs, nil
+}
+
+// filter returns a new slice holding the rows for which keep returns true.
+// The input slice is never modified, as it is shared with the source
+// payload.
+func filter[T any](rows []T, keep func(T) bool) []T {
+	var out []T
+	for _, row := range rows {
+		if keep(row) {
+			out = append(out, row)
+		}
+	}
+	return out
+}
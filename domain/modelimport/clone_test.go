@@ -0,0 +1,153 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelimport_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/domain/export/types/latest"
+	"github.com/juju/juju/domain/export/types/v4_1_0"
+	"github.com/juju/juju/domain/modelimport"
+)
+
+type cloneSuite struct{}
+
+func TestCloneSuite(t *testing.T) {
+	tc.Run(t, &cloneSuite{})
+}
+
+func (s *cloneSuite) payload() latest.ModelExport {
+	three := int64(3)
+	scaling := true
+	return latest.ModelExport{
+		ModelAgent: []v4_1_0.ModelAgent{{ModelUUID: "source-uuid"}},
+		ModelConfig: []v4_1_0.ModelConfig{
+			{Key: "uuid", Value: "source-uuid"},
+			{Key: "name", Value: "source"},
+			{Key: "logging-config", Value: "<root>=INFO"},
+		},
+		ModelConstraint:  []v4_1_0.ModelConstraint{{ModelUUID: "source-uuid", ConstraintUUID: "model-cons"}},
+		Application:      []v4_1_0.Application{{UUID: "app-uuid", Name: "app"}},
+		ApplicationScale: []v4_1_0.ApplicationScale{{ApplicationUUID: "app-uuid", Scale: &three, ScaleTarget: &three, Scaling: &scaling}},
+		Constraint: []v4_1_0.Constraint{
+			{UUID: "model-cons"},
+			{UUID: "machine-cons"},
+		},
+		Machine:           []v4_1_0.Machine{{UUID: "machine-uuid", Name: "0"}},
+		MachineConstraint: []v4_1_0.MachineConstraint{{MachineUUID: "machine-uuid", ConstraintUUID: "machine-cons"}},
+		NetNode:           []v4_1_0.NetNode{{UUID: "net-node-uuid"}},
+		Unit:              []v4_1_0.Unit{{UUID: "unit-uuid", Name: "app/0", ApplicationUUID: "app-uuid"}},
+		Offer:             []v4_1_0.Offer{{UUID: "offer-uuid", Name: "db"}},
+		OfferEndpoint:     []v4_1_0.OfferEndpoint{{OfferUUID: "offer-uuid", EndpointUUID: "endpoint-uuid"}},
+		Sequence: []v4_1_0.Sequence{
+			{Namespace: "machine", Value: 1},
+			{Namespace: "machine_container_0", Value: 2},
+			{Namespace: "application_app", Value: 1},
+			{Namespace: "secret", Value: 4},
+		},
+		Secret: []v4_1_0.Secret{{ID: "app-secret"}, {ID: "unit-secret"}, {ID: "remote-secret"}},
+		SecretMetadata: []v4_1_0.SecretMetadata{
+			{SecretID: "app-secret"},
+			{SecretID: "unit-secret"},
+		},
+		SecretUnitOwner: []v4_1_0.SecretUnitOwner{{SecretID: "unit-secret", UnitUUID: "unit-uuid"}},
+		SecretRevision: []v4_1_0.SecretRevision{
+			{UUID: "app-rev", SecretID: "app-secret", Revision: 1},
+			{UUID: "unit-rev", SecretID: "unit-secret", Revision: 1},
+		},
+		SecretContent: []v4_1_0.SecretContent{
+			{RevisionUUID: "app-rev", Name: "password"},
+			{RevisionUUID: "unit-rev", Name: "password"},
+		},
+		SecretPermission: []v4_1_0.SecretPermission{
+			{SecretID: "app-secret", SubjectUUID: "app-uuid", SubjectTypeID: 1, ScopeUUID: "app-uuid", ScopeTypeID: "1"},
+			{SecretID: "app-secret", SubjectUUID: "unit-uuid", SubjectTypeID: 0, ScopeUUID: "unit-uuid", ScopeTypeID: "0"},
+		},
+		SecretValueRef: []v4_1_0.SecretValueRef{{RevisionUUID: "app-rev", BackendUUID: "vault"}},
+	}
+}
+
+func (s *cloneSuite) TestPrepareClone(c *tc.C) {
+	payload := s.payload()
+
+	cloned, err := modelimport.PrepareClone(payload, modelimport.CloneArgs{
+		ModelUUID: "clone-uuid",
+		ModelName: "clone",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	p := cloned.Payload
+	c.Check(p.ModelAgent, tc.DeepEquals, []v4_1_0.ModelAgent{{ModelUUID: "clone-uuid"}})
+	c.Check(p.ModelConstraint, tc.DeepEquals, []v4_1_0.ModelConstraint{{ModelUUID: "clone-uuid", ConstraintUUID: "model-cons"}})
+	c.Check(p.ModelConfig, tc.DeepEquals, []v4_1_0.ModelConfig{
+		{Key: "uuid", Value: "clone-uuid"},
+		{Key: "name", Value: "clone"},
+		{Key: "logging-config", Value: "<root>=INFO"},
+	})
+
+	c.Check(p.Application, tc.DeepEquals, payload.Application)
+	c.Check(p.ApplicationScale, tc.DeepEquals, payload.ApplicationScale)
+	c.Check(p.Constraint, tc.DeepEquals, []v4_1_0.Constraint{{UUID: "model-cons"}})
+	c.Check(p.Machine, tc.HasLen, 0)
+	c.Check(p.MachineConstraint, tc.HasLen, 0)
+	c.Check(p.NetNode, tc.HasLen, 0)
+	c.Check(p.Unit, tc.HasLen, 0)
+	c.Check(p.Sequence, tc.DeepEquals, []v4_1_0.Sequence{{Namespace: "secret", Value: 4}})
+
+	c.Check(p.Secret, tc.DeepEquals, []v4_1_0.Secret{{ID: "app-secret"}})
+	c.Check(p.SecretRevision, tc.DeepEquals, []v4_1_0.SecretRevision{{UUID: "app-rev", SecretID: "app-secret", Revision: 1}})
+	c.Check(p.SecretContent, tc.DeepEquals, []v4_1_0.SecretContent{{RevisionUUID: "app-rev", Name: "password"}})
+	c.Check(p.SecretPermission, tc.DeepEquals, payload.SecretPermission[:1])
+	c.Check(p.SecretUnitOwner, tc.HasLen, 0)
+	c.Check(p.SecretValueRef, tc.HasLen, 0)
+
+	// The source payload is left untouched.
+	c.Check(payload, tc.DeepEquals, s.payload())
+}
+
+func (s *cloneSuite) TestPrepareCloneRenamesOffers(c *tc.C) {
+	cloned, err := modelimport.PrepareClone(s.payload(), modelimport.CloneArgs{
+		ModelUUID: "clone-uuid",
+		ModelName: "clone",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	newUUID := cloned.OfferUUIDs["offer-uuid"]
+	c.Assert(newUUID, tc.Not(tc.Equals), "")
+	c.Check(newUUID, tc.Not(tc.Equals), "offer-uuid")
+	c.Check(cloned.Payload.Offer, tc.DeepEquals, []v4_1_0.Offer{{UUID: newUUID, Name: "db"}})
+	c.Check(cloned.Payload.OfferEndpoint, tc.DeepEquals, []v4_1_0.OfferEndpoint{{OfferUUID: newUUID, EndpointUUID: "endpoint-uuid"}})
+}
+
+func (s *cloneSuite) TestPrepareCloneWithoutUnits(c *tc.C) {
+	cloned, err := modelimport.PrepareClone(s.payload(), modelimport.CloneArgs{
+		ModelUUID:    "clone-uuid",
+		ModelName:    "clone",
+		WithoutUnits: true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	zero := int64(0)
+	scaling := false
+	c.Check(cloned.Payload.ApplicationScale, tc.DeepEquals, []v4_1_0.ApplicationScale{{
+		ApplicationUUID: "app-uuid",
+		Scale:           &zero,
+		ScaleTarget:     &zero,
+		Scaling:         &scaling,
+	}})
+}
+
+func (s *cloneSuite) TestPrepareCloneCrossModelRelations(c *tc.C) {
+	payload := s.payload()
+	payload.ApplicationRemoteOfferer = []v4_1_0.ApplicationRemoteOfferer{{UUID: "remote-uuid"}}
+
+	_, err := modelimport.PrepareClone(payload, modelimport.CloneArgs{
+		ModelUUID: "clone-uuid",
+		ModelName: "clone",
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
+}
@@ -114,6 +114,14 @@ func (st *State) Import(ctx context.Context, p *v4_1_0.ModelExport) error {
 	if err != nil {
 		return errors.Errorf("preparing ApplicationK8sResourcesManaged insert statement: %w", err)
 	}
+	stmtApplicationPlacementAffinity, err := sqlair.Prepare(`INSERT INTO "application_placement_affinity" (*) VALUES ($ApplicationPlacementAffinity.*)`, v4_1_0.ApplicationPlacementAffinity{})
+	if err != nil {
+		return errors.Errorf("preparing ApplicationPlacementAffinity insert statement: %w", err)
+	}
+	stmtApplicationPlacementPolicy, err := sqlair.Prepare(`INSERT INTO "application_placement_policy" (*) VALUES ($ApplicationPlacementPolicy.*)`, v4_1_0.ApplicationPlacementPolicy{})
+	if err != nil {
+		return errors.Errorf("preparing ApplicationPlacementPolicy insert statement: %w", err)
+	}
 	stmtApplicationPlatform, err := sqlair.Prepare(`INSERT INTO "application_platform" (*) VALUES ($ApplicationPlatform.*)`, v4_1_0.ApplicationPlatform{})
 	if err != nil {
 		return errors.Errorf("preparing ApplicationPlatform insert statement: %w", err)
@@ -462,6 +470,10 @@ func (st *State) Import(ctx context.Context, p *v4_1_0.ModelExport) error {
 	if err != nil {
 		return errors.Errorf("preparing MachinePlatform insert statement: %w", err)
 	}
+	stmtMachineRecoveryAttempt, err := sqlair.Prepare(`INSERT INTO "machine_recovery_attempt" (*) VALUES ($MachineRecoveryAttempt.*)`, v4_1_0.MachineRecoveryAttempt{})
+	if err != nil {
+		return errors.Errorf("preparing MachineRecoveryAttempt insert statement: %w", err)
+	}
 	stmtMachineReprovision, err := sqlair.Prepare(`INSERT INTO "machine_reprovision" (*) VALUES ($MachineReprovision.*)`, v4_1_0.MachineReprovision{})
 	if err != nil {
 		return errors.Errorf("preparing MachineReprovision insert statement: %w", err)
@@ -666,6 +678,10 @@ func (st *State) Import(ctx context.Context, p *v4_1_0.ModelExport) error {
 	if err != nil {
 		return errors.Errorf("preparing Removal insert statement: %w", err)
 	}
+	stmtRemovalAttempt, err := sqlair.Prepare(`INSERT INTO "removal_attempt" (*) VALUES ($RemovalAttempt.*)`, v4_1_0.RemovalAttempt{})
+	if err != nil {
+		return errors.Errorf("preparing RemovalAttempt insert statement: %w", err)
+	}
 	stmtRemovalType, err := sqlair.Prepare(`INSERT INTO "removal_type" (*) VALUES ($RemovalType.*) ON CONFLICT DO NOTHING`, v4_1_0.RemovalType{})
 	if err != nil {
 		return errors.Errorf("preparing RemovalType insert statement: %w", err)
@@ -702,6 +718,10 @@ func (st *State) Import(ctx context.Context, p *v4_1_0.ModelExport) error {
 	if err != nil {
 		return errors.Errorf("preparing Secret insert statement: %w", err)
 	}
+	stmtSecretAccessorType, err := sqlair.Prepare(`INSERT INTO "secret_accessor_type" (*) VALUES ($SecretAccessorType.*) ON CONFLICT DO NOTHING`, v4_1_0.SecretAccessorType{})
+	if err != nil {
+		return errors.Errorf("preparing SecretAccessorType insert statement: %w", err)
+	}
 	stmtSecretApplicationOwner, err := sqlair.Prepare(`INSERT INTO "secret_application_owner" (*) VALUES ($SecretApplicationOwner.*)`, v4_1_0.SecretApplicationOwner{})
 	if err != nil {
 		return errors.Errorf("preparing SecretApplicationOwner insert statement: %w", err)
@@ -710,10 +730,22 @@ func (st *State) Import(ctx context.Context, p *v4_1_0.ModelExport) error {
 	if err != nil {
 		return errors.Errorf("preparing SecretContent insert statement: %w", err)
 	}
+	stmtSecretDataKey, err := sqlair.Prepare(`INSERT INTO "secret_data_key" (*) VALUES ($SecretDataKey.*)`, v4_1_0.SecretDataKey{})
+	if err != nil {
+		return errors.Errorf("preparing SecretDataKey insert statement: %w", err)
+	}
 	stmtSecretDeletedValueRef, err := sqlair.Prepare(`INSERT INTO "secret_deleted_value_ref" (*) VALUES ($SecretDeletedValueRef.*)`, v4_1_0.SecretDeletedValueRef{})
 	if err != nil {
 		return errors.Errorf("preparing SecretDeletedValueRef insert statement: %w", err)
 	}
+	stmtSecretExternalRef, err := sqlair.Prepare(`INSERT INTO "secret_external_ref" (*) VALUES ($SecretExternalRef.*)`, v4_1_0.SecretExternalRef{})
+	if err != nil {
+		return errors.Errorf("preparing SecretExternalRef insert statement: %w", err)
+	}
+	stmtSecretGenerator, err := sqlair.Prepare(`INSERT INTO "secret_generator" (*) VALUES ($SecretGenerator.*)`, v4_1_0.SecretGenerator{})
+	if err != nil {
+		return errors.Errorf("preparing SecretGenerator insert statement: %w", err)
+	}
 	stmtSecretGrantScopeType, err := sqlair.Prepare(`INSERT INTO "secret_grant_scope_type" (*) VALUES ($SecretGrantScopeType.*) ON CONFLICT DO NOTHING`, v4_1_0.SecretGrantScopeType{})
 	if err != nil {
 		return errors.Errorf("preparing SecretGrantScopeType insert statement: %w", err)
@@ -750,10 +782,18 @@ func (st *State) Import(ctx context.Context, p *v4_1_0.ModelExport) error {
 	if err != nil {
 		return errors.Errorf("preparing SecretRevision insert statement: %w", err)
 	}
+	stmtSecretRevisionAccess, err := sqlair.Prepare(`INSERT INTO "secret_revision_access" (*) VALUES ($SecretRevisionAccess.*)`, v4_1_0.SecretRevisionAccess{})
+	if err != nil {
+		return errors.Errorf("preparing SecretRevisionAccess insert statement: %w", err)
+	}
 	stmtSecretRevisionExpire, err := sqlair.Prepare(`INSERT INTO "secret_revision_expire" (*) VALUES ($SecretRevisionExpire.*)`, v4_1_0.SecretRevisionExpire{})
 	if err != nil {
 		return errors.Errorf("preparing SecretRevisionExpire insert statement: %w", err)
 	}
+	stmtSecretRevisionExternalVersion, err := sqlair.Prepare(`INSERT INTO "secret_revision_external_version" (*) VALUES ($SecretRevisionExternalVersion.*)`, v4_1_0.SecretRevisionExternalVersion{})
+	if err != nil {
+		return errors.Errorf("preparing SecretRevisionExternalVersion insert statement: %w", err)
+	}
 	stmtSecretRevisionObsolete, err := sqlair.Prepare(`INSERT INTO "secret_revision_obsolete" (*) VALUES ($SecretRevisionObsolete.*)`, v4_1_0.SecretRevisionObsolete{})
 	if err != nil {
 		return errors.Errorf("preparing SecretRevisionObsolete insert statement: %w", err)
@@ -1081,6 +1121,16 @@ func (st *State) Import(ctx context.Context, p *v4_1_0.ModelExport) error {
 				return errors.Errorf("inserting ApplicationK8sResourcesManaged (table application_k8s_resources_managed): %w", err)
 			}
 		}
+		if len(p.ApplicationPlacementAffinity) > 0 {
+			if err := tx.Query(ctx, stmtApplicationPlacementAffinity, p.ApplicationPlacementAffinity).Run(); err != nil {
+				return errors.Errorf("inserting ApplicationPlacementAffinity (table application_placement_affinity): %w", err)
+			}
+		}
+		if len(p.ApplicationPlacementPolicy) > 0 {
+			if err := tx.Query(ctx, stmtApplicationPlacementPolicy, p.ApplicationPlacementPolicy).Run(); err != nil {
+				return errors.Errorf("inserting ApplicationPlacementPolicy (table application_placement_policy): %w", err)
+			}
+		}
 		if len(p.ApplicationPlatform) > 0 {
 			if err := tx.Query(ctx, stmtApplicationPlatform, p.ApplicationPlatform).Run(); err != nil {
 				return errors.Errorf("inserting ApplicationPlatform (table application_platform): %w", err)
@@ -1516,6 +1566,11 @@ func (st *State) Import(ctx context.Context, p *v4_1_0.ModelExport) error {
 				return errors.Errorf("inserting MachinePlatform (table machine_platform): %w", err)
 			}
 		}
+		if len(p.MachineRecoveryAttempt) > 0 {
+			if err := tx.Query(ctx, stmtMachineRecoveryAttempt, p.MachineRecoveryAttempt).Run(); err != nil {
+				return errors.Errorf("inserting MachineRecoveryAttempt (table machine_recovery_attempt): %w", err)
+			}
+		}
 		if len(p.MachineReprovision) > 0 {
 			if err := tx.Query(ctx, stmtMachineReprovision, p.MachineReprovision).Run(); err != nil {
 				return errors.Errorf("inserting MachineReprovision (table machine_reprovision): %w", err)
@@ -1771,6 +1826,11 @@ func (st *State) Import(ctx context.Context, p *v4_1_0.ModelExport) error {
 				return errors.Errorf("inserting Removal (table removal): %w", err)
 			}
 		}
+		if len(p.RemovalAttempt) > 0 {
+			if err := tx.Query(ctx, stmtRemovalAttempt, p.RemovalAttempt).Run(); err != nil {
+				return errors.Errorf("inserting RemovalAttempt (table removal_attempt): %w", err)
+			}
+		}
 		if len(p.RemovalType) > 0 {
 			if err := tx.Query(ctx, stmtRemovalType, p.RemovalType).Run(); err != nil {
 				return errors.Errorf("inserting RemovalType (table removal_type): %w", err)
@@ -1816,6 +1876,11 @@ func (st *State) Import(ctx context.Context, p *v4_1_0.ModelExport) error {
 				return errors.Errorf("inserting Secret (table secret): %w", err)
 			}
 		}
+		if len(p.SecretAccessorType) > 0 {
+			if err := tx.Query(ctx, stmtSecretAccessorType, p.SecretAccessorType).Run(); err != nil {
+				return errors.Errorf("inserting SecretAccessorType (table secret_accessor_type): %w", err)
+			}
+		}
 		if len(p.SecretApplicationOwner) > 0 {
 			if err := tx.Query(ctx, stmtSecretApplicationOwner, p.SecretApplicationOwner).Run(); err != nil {
 				return errors.Errorf("inserting SecretApplicationOwner (table secret_application_owner): %w", err)
@@ -1826,11 +1891,26 @@ func (st *State) Import(ctx context.Context, p *v4_1_0.ModelExport) error {
 				return errors.Errorf("inserting SecretContent (table secret_content): %w", err)
 			}
 		}
+		if len(p.SecretDataKey) > 0 {
+			if err := tx.Query(ctx, stmtSecretDataKey, p.SecretDataKey).Run(); err != nil {
+				return errors.Errorf("inserting SecretDataKey (table secret_data_key): %w", err)
+			}
+		}
 		if len(p.SecretDeletedValueRef) > 0 {
 			if err := tx.Query(ctx, stmtSecretDeletedValueRef, p.SecretDeletedValueRef).Run(); err != nil {
 				return errors.Errorf("inserting SecretDeletedValueRef (table secret_deleted_value_ref): %w", err)
 			}
 		}
+		if len(p.SecretExternalRef) > 0 {
+			if err := tx.Query(ctx, stmtSecretExternalRef, p.SecretExternalRef).Run(); err != nil {
+				return errors.Errorf("inserting SecretExternalRef (table secret_external_ref): %w", err)
+			}
+		}
+		if len(p.SecretGenerator) > 0 {
+			if err := tx.Query(ctx, stmtSecretGenerator, p.SecretGenerator).Run(); err != nil {
+				return errors.Errorf("inserting SecretGenerator (table secret_generator): %w", err)
+			}
+		}
 		if len(p.SecretGrantScopeType) > 0 {
 			if err := tx.Query(ctx, stmtSecretGrantScopeType, p.SecretGrantScopeType).Run(); err != nil {
 				return errors.Errorf("inserting SecretGrantScopeType (table secret_grant_scope_type): %w", err)
@@ -1876,11 +1956,21 @@ func (st *State) Import(ctx context.Context, p *v4_1_0.ModelExport) error {
 				return errors.Errorf("inserting SecretRevision (table secret_revision): %w", err)
 			}
 		}
+		if len(p.SecretRevisionAccess) > 0 {
+			if err := tx.Query(ctx, stmtSecretRevisionAccess, p.SecretRevisionAccess).Run(); err != nil {
+				return errors.Errorf("inserting SecretRevisionAccess (table secret_revision_access): %w", err)
+			}
+		}
 		if len(p.SecretRevisionExpire) > 0 {
 			if err := tx.Query(ctx, stmtSecretRevisionExpire, p.SecretRevisionExpire).Run(); err != nil {
 				return errors.Errorf("inserting SecretRevisionExpire (table secret_revision_expire): %w", err)
 			}
 		}
+		if len(p.SecretRevisionExternalVersion) > 0 {
+			if err := tx.Query(ctx, stmtSecretRevisionExternalVersion, p.SecretRevisionExternalVersion).Run(); err != nil {
+				return errors.Errorf("inserting SecretRevisionExternalVersion (table secret_revision_external_version): %w", err)
+			}
+		}
 		if len(p.SecretRevisionObsolete) > 0 {
 			if err := tx.Query(ctx, stmtSecretRevisionObsolete, p.SecretRevisionObsolete).Run(); err != nil {
 				return errors.Errorf("inserting SecretRevisionObsolete (table secret_revision_obsolete): %w", err)
@@ -83,6 +83,34 @@ func (s *roundTripSuite) TestImportExportRoundTrip(c *tc.C) {
 	c.Check(alphaSpaces, tc.Equals, 1)
 }
 
+// TestImportExportRoundTripConstraint asserts that the instance market,
+// placement group and GPU columns of a constraint survive an import and
+// re-export.
+func (s *roundTripSuite) TestImportExportRoundTripConstraint(c *tc.C) {
+	s.bootstrapModel(c)
+
+	payload := &v4_1_0.ModelExport{
+		Constraint: []v4_1_0.Constraint{{
+			UUID:                "22222222-2222-2222-2222-222222222222",
+			Market:              new("spot"),
+			MaxPrice:            new("0.05"),
+			CapacityReservation: new("cr-0123456789abcdef0"),
+			PlacementGroup:      new("cluster"),
+			Gpus:                new(int64(2)),
+			GpuType:             new("nvidia-a100"),
+		}},
+	}
+
+	importSt := importstate.NewState(s.TxnRunnerFactory())
+	err := importSt.Import(c.Context(), payload)
+	c.Assert(err, tc.ErrorIsNil)
+
+	exportSt := exportstate.NewState(s.TxnRunnerFactory())
+	got, err := exportSt.Export(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got.Constraint, tc.SameContents, payload.Constraint)
+}
+
 func (s *roundTripSuite) bootstrapModel(c *tc.C) {
 	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
 		if _, err := tx.ExecContext(ctx, `
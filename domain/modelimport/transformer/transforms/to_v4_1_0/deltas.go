@@ -49,8 +49,9 @@ func (d deltas) Operation(_ context.Context, src []v4_0_12.Operation) ([]v4_1_0.
 	return result, nil
 }
 
-// Constraint copies all v4_0_12 fields and leaves IpFamily nil. Constraints
-// exported from a 4.0.12 model carry no IP family information.
+// Constraint copies all v4_0_12 fields and leaves the columns added in 4.1.0
+// nil. Constraints exported from a 4.0.12 model carry no IP family or
+// instance market information.
 func (d deltas) Constraint(_ context.Context, src []v4_0_12.Constraint) ([]v4_1_0.Constraint, error) {
 	result := make([]v4_1_0.Constraint, len(src))
 	for i, c := range src {
@@ -73,6 +74,20 @@ func (d deltas) Constraint(_ context.Context, src []v4_0_12.Constraint) ([]v4_1_
 	return result, nil
 }
 
+// Offer copies all v4_0_12 fields and leaves Description nil and
+// ModifiedVersion zero. Offers exported from a 4.0.12 model have never been
+// updated in place and carry no description.
+func (d deltas) Offer(_ context.Context, src []v4_0_12.Offer) ([]v4_1_0.Offer, error) {
+	result := make([]v4_1_0.Offer, len(src))
+	for i, o := range src {
+		result[i] = v4_1_0.Offer{
+			UUID: o.UUID,
+			Name: o.Name,
+		}
+	}
+	return result, nil
+}
+
 // RelationApplicationSetting copies all v4_0_12 fields into the 4.1.0 schema,
 // where the relation_application_setting.value column is NOT NULL and disallows
 // the empty string. A 4.0.12 row whose value is NULL (or empty) has no valid
@@ -165,3 +180,89 @@ func (d deltas) SshConnectionRequestAddress(_ context.Context, _ *v4_0_12.ModelE
 	// are no rows to transform from 4.0.12.
 	return nil, nil
 }
+
+// RemovalAttempt returns no rows for 4.0.12 payloads. The source schema has no
+// removal attempt table.
+func (d deltas) RemovalAttempt(_ context.Context, _ *v4_0_12.ModelExport) ([]v4_1_0.RemovalAttempt, error) {
+	// The removal_attempt table was added in 4.1.0, so there are no rows to
+	// transform from 4.0.12.
+	return nil, nil
+}
+
+// ApplicationPlacementAffinity returns no rows for 4.0.12 payloads. The source
+// schema has no application placement affinity table.
+func (d deltas) ApplicationPlacementAffinity(_ context.Context, _ *v4_0_12.ModelExport) ([]v4_1_0.ApplicationPlacementAffinity, error) {
+	// The application_placement_affinity table was added in 4.1.0, so there
+	// are no rows to transform from 4.0.12.
+	return nil, nil
+}
+
+// ApplicationPlacementPolicy returns no rows for 4.0.12 payloads. The source
+// schema has no application placement policy table.
+func (d deltas) ApplicationPlacementPolicy(_ context.Context, _ *v4_0_12.ModelExport) ([]v4_1_0.ApplicationPlacementPolicy, error) {
+	// The application_placement_policy table was added in 4.1.0, so there
+	// are no rows to transform from 4.0.12.
+	return nil, nil
+}
+
+// MachineRecoveryAttempt returns no rows for 4.0.12 payloads. The source schema
+// has no machine recovery attempt table.
+func (d deltas) MachineRecoveryAttempt(_ context.Context, _ *v4_0_12.ModelExport) ([]v4_1_0.MachineRecoveryAttempt, error) {
+	// The machine_recovery_attempt table was added in 4.1.0, so there are no
+	// rows to transform from 4.0.12.
+	return nil, nil
+}
+
+// SecretDataKey returns no rows for 4.0.12 payloads. The source schema has no
+// secret data key table.
+func (d deltas) SecretDataKey(_ context.Context, _ *v4_0_12.ModelExport) ([]v4_1_0.SecretDataKey, error) {
+	// The secret_data_key table was added in 4.1.0, so there are no rows to
+	// transform from 4.0.12. Secret content in a 4.0.12 payload is not
+	// encrypted; it is encrypted with new data keys once imported.
+	return nil, nil
+}
+
+// SecretExternalRef returns no rows for 4.0.12 payloads. The source schema
+// has no secret external reference table.
+func (d deltas) SecretExternalRef(_ context.Context, _ *v4_0_12.ModelExport) ([]v4_1_0.SecretExternalRef, error) {
+	// The secret_external_ref table was added in 4.1.0, so there are no rows
+	// to transform from 4.0.12.
+	return nil, nil
+}
+
+// SecretRevisionExternalVersion returns no rows for 4.0.12 payloads. The
+// source schema has no secret revision external version table.
+func (d deltas) SecretRevisionExternalVersion(_ context.Context, _ *v4_0_12.ModelExport) ([]v4_1_0.SecretRevisionExternalVersion, error) {
+	// The secret_revision_external_version table was added in 4.1.0, so there
+	// are no rows to transform from 4.0.12.
+	return nil, nil
+}
+
+// SecretGenerator returns no rows for 4.0.12 payloads. The source schema has
+// no secret generator table.
+func (d deltas) SecretGenerator(_ context.Context, _ *v4_0_12.ModelExport) ([]v4_1_0.SecretGenerator, error) {
+	// The secret_generator table was added in 4.1.0, so there are no rows to
+	// transform from 4.0.12.
+	return nil, nil
+}
+
+// SecretAccessorType synthesises the static lookup table introduced in
+// 4.1.0. The table is schema-owned data, so it is produced unconditionally.
+func (d deltas) SecretAccessorType(_ context.Context, _ *v4_0_12.ModelExport) ([]v4_1_0.SecretAccessorType, error) {
+	unit, application, model, user := int64(0), int64(1), int64(2), int64(3)
+	unitType, applicationType, modelType, userType := "unit", "application", "model", "user"
+	return []v4_1_0.SecretAccessorType{
+		{ID: &unit, Type: &unitType},
+		{ID: &application, Type: &applicationType},
+		{ID: &model, Type: &modelType},
+		{ID: &user, Type: &userType},
+	}, nil
+}
+
+// SecretRevisionAccess returns no rows for 4.0.12 payloads. The source schema
+// has no secret access log table.
+func (d deltas) SecretRevisionAccess(_ context.Context, _ *v4_0_12.ModelExport) ([]v4_1_0.SecretRevisionAccess, error) {
+	// The secret_revision_access table was added in 4.1.0, so there are no
+	// rows to transform from 4.0.12.
+	return nil, nil
+}
@@ -21,16 +21,38 @@ import (
 type Deltas interface {
 	// Constraint: struct shape changed in 4.1.0.
 	Constraint(ctx context.Context, src []v4_0_12.Constraint) ([]v4_1_0.Constraint, error)
+	// Offer: struct shape changed in 4.1.0.
+	Offer(ctx context.Context, src []v4_0_12.Offer) ([]v4_1_0.Offer, error)
 	// Operation: struct shape changed in 4.1.0.
 	Operation(ctx context.Context, src []v4_0_12.Operation) ([]v4_1_0.Operation, error)
 	// RelationApplicationSetting: struct shape changed in 4.1.0.
 	RelationApplicationSetting(ctx context.Context, src []v4_0_12.RelationApplicationSetting) ([]v4_1_0.RelationApplicationSetting, error)
 	// RelationUnitSetting: struct shape changed in 4.1.0.
 	RelationUnitSetting(ctx context.Context, src []v4_0_12.RelationUnitSetting) ([]v4_1_0.RelationUnitSetting, error)
+	// ApplicationPlacementAffinity: new table in 4.1.0; derive from *v4_0_12.ModelExport.
+	ApplicationPlacementAffinity(ctx context.Context, src *v4_0_12.ModelExport) ([]v4_1_0.ApplicationPlacementAffinity, error)
+	// ApplicationPlacementPolicy: new table in 4.1.0; derive from *v4_0_12.ModelExport.
+	ApplicationPlacementPolicy(ctx context.Context, src *v4_0_12.ModelExport) ([]v4_1_0.ApplicationPlacementPolicy, error)
+	// MachineRecoveryAttempt: new table in 4.1.0; derive from *v4_0_12.ModelExport.
+	MachineRecoveryAttempt(ctx context.Context, src *v4_0_12.ModelExport) ([]v4_1_0.MachineRecoveryAttempt, error)
 	// MachineReprovision: new table in 4.1.0; derive from *v4_0_12.ModelExport.
 	MachineReprovision(ctx context.Context, src *v4_0_12.ModelExport) ([]v4_1_0.MachineReprovision, error)
 	// MachineVirtualSshHostKey: new table in 4.1.0; derive from *v4_0_12.ModelExport.
 	MachineVirtualSshHostKey(ctx context.Context, src *v4_0_12.ModelExport) ([]v4_1_0.MachineVirtualSshHostKey, error)
+	// RemovalAttempt: new table in 4.1.0; derive from *v4_0_12.ModelExport.
+	RemovalAttempt(ctx context.Context, src *v4_0_12.ModelExport) ([]v4_1_0.RemovalAttempt, error)
+	// SecretAccessorType: new table in 4.1.0; derive from *v4_0_12.ModelExport.
+	SecretAccessorType(ctx context.Context, src *v4_0_12.ModelExport) ([]v4_1_0.SecretAccessorType, error)
+	// SecretDataKey: new table in 4.1.0; derive from *v4_0_12.ModelExport.
+	SecretDataKey(ctx context.Context, src *v4_0_12.ModelExport) ([]v4_1_0.SecretDataKey, error)
+	// SecretExternalRef: new table in 4.1.0; derive from *v4_0_12.ModelExport.
+	SecretExternalRef(ctx context.Context, src *v4_0_12.ModelExport) ([]v4_1_0.SecretExternalRef, error)
+	// SecretGenerator: new table in 4.1.0; derive from *v4_0_12.ModelExport.
+	SecretGenerator(ctx context.Context, src *v4_0_12.ModelExport) ([]v4_1_0.SecretGenerator, error)
+	// SecretRevisionAccess: new table in 4.1.0; derive from *v4_0_12.ModelExport.
+	SecretRevisionAccess(ctx context.Context, src *v4_0_12.ModelExport) ([]v4_1_0.SecretRevisionAccess, error)
+	// SecretRevisionExternalVersion: new table in 4.1.0; derive from *v4_0_12.ModelExport.
+	SecretRevisionExternalVersion(ctx context.Context, src *v4_0_12.ModelExport) ([]v4_1_0.SecretRevisionExternalVersion, error)
 	// SshConnectionRequest: new table in 4.1.0; derive from *v4_0_12.ModelExport.
 	SshConnectionRequest(ctx context.Context, src *v4_0_12.ModelExport) ([]v4_1_0.SshConnectionRequest, error)
 	// SshConnectionRequestAddress: new table in 4.1.0; derive from *v4_0_12.ModelExport.
@@ -715,11 +737,6 @@ func NewTransform(d Deltas) transformer.TransformationFunc[v4_0_12.ModelExport,
 			dst.ObjectStorePlacement[i] = v4_1_0.ObjectStorePlacement(src.ObjectStorePlacement[i])
 		}
 
-		dst.Offer = make([]v4_1_0.Offer, len(src.Offer))
-		for i := range src.Offer {
-			dst.Offer[i] = v4_1_0.Offer(src.Offer[i])
-		}
-
 		dst.OfferConnection = make([]v4_1_0.OfferConnection, len(src.OfferConnection))
 		for i := range src.OfferConnection {
 			dst.OfferConnection[i] = v4_1_0.OfferConnection(src.OfferConnection[i])
@@ -1254,6 +1271,10 @@ func NewTransform(d Deltas) transformer.TransformationFunc[v4_0_12.ModelExport,
 			return v4_1_0.ModelExport{}, errors.Errorf("Constraint delta: %w", err)
 		}
 
+		if dst.Offer, err = d.Offer(ctx, src.Offer); err != nil {
+			return v4_1_0.ModelExport{}, errors.Errorf("Offer delta: %w", err)
+		}
+
 		if dst.Operation, err = d.Operation(ctx, src.Operation); err != nil {
 			return v4_1_0.ModelExport{}, errors.Errorf("Operation delta: %w", err)
 		}
@@ -1266,6 +1287,18 @@ func NewTransform(d Deltas) transformer.TransformationFunc[v4_0_12.ModelExport,
 			return v4_1_0.ModelExport{}, errors.Errorf("RelationUnitSetting delta: %w", err)
 		}
 
+		if dst.ApplicationPlacementAffinity, err = d.ApplicationPlacementAffinity(ctx, &src); err != nil {
+			return v4_1_0.ModelExport{}, errors.Errorf("ApplicationPlacementAffinity delta: %w", err)
+		}
+
+		if dst.ApplicationPlacementPolicy, err = d.ApplicationPlacementPolicy(ctx, &src); err != nil {
+			return v4_1_0.ModelExport{}, errors.Errorf("ApplicationPlacementPolicy delta: %w", err)
+		}
+
+		if dst.MachineRecoveryAttempt, err = d.MachineRecoveryAttempt(ctx, &src); err != nil {
+			return v4_1_0.ModelExport{}, errors.Errorf("MachineRecoveryAttempt delta: %w", err)
+		}
+
 		if dst.MachineReprovision, err = d.MachineReprovision(ctx, &src); err != nil {
 			return v4_1_0.ModelExport{}, errors.Errorf("MachineReprovision delta: %w", err)
 		}
@@ -1274,6 +1307,34 @@ func NewTransform(d Deltas) transformer.TransformationFunc[v4_0_12.ModelExport,
 			return v4_1_0.ModelExport{}, errors.Errorf("MachineVirtualSshHostKey delta: %w", err)
 		}
 
+		if dst.RemovalAttempt, err = d.RemovalAttempt(ctx, &src); err != nil {
+			return v4_1_0.ModelExport{}, errors.Errorf("RemovalAttempt delta: %w", err)
+		}
+
+		if dst.SecretAccessorType, err = d.SecretAccessorType(ctx, &src); err != nil {
+			return v4_1_0.ModelExport{}, errors.Errorf("SecretAccessorType delta: %w", err)
+		}
+
+		if dst.SecretDataKey, err = d.SecretDataKey(ctx, &src); err != nil {
+			return v4_1_0.ModelExport{}, errors.Errorf("SecretDataKey delta: %w", err)
+		}
+
+		if dst.SecretExternalRef, err = d.SecretExternalRef(ctx, &src); err != nil {
+			return v4_1_0.ModelExport{}, errors.Errorf("SecretExternalRef delta: %w", err)
+		}
+
+		if dst.SecretGenerator, err = d.SecretGenerator(ctx, &src); err != nil {
+			return v4_1_0.ModelExport{}, errors.Errorf("SecretGenerator delta: %w", err)
+		}
+
+		if dst.SecretRevisionAccess, err = d.SecretRevisionAccess(ctx, &src); err != nil {
+			return v4_1_0.ModelExport{}, errors.Errorf("SecretRevisionAccess delta: %w", err)
+		}
+
+		if dst.SecretRevisionExternalVersion, err = d.SecretRevisionExternalVersion(ctx, &src); err != nil {
+			return v4_1_0.ModelExport{}, errors.Errorf("SecretRevisionExternalVersion delta: %w", err)
+		}
+
 		if dst.SshConnectionRequest, err = d.SshConnectionRequest(ctx, &src); err != nil {
 			return v4_1_0.ModelExport{}, errors.Errorf("SshConnectionRequest delta: %w", err)
 		}
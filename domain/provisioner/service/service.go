@@ -146,6 +146,7 @@ func (s *Service) GetPreludeProvisioningInfo(ctx context.Context) (provisioner.S
 		LokiCACert:             lokiConfig.CACertificate,
 		LokiInsecureSkipVerify: lokiConfig.InsecureSkipVerify,
 		LokiOrgID:              lokiConfig.OrgID,
+		LokiProtocol:           string(lokiConfig.Protocol),
 	}, nil
 }
 
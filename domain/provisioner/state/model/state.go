@@ -817,6 +817,25 @@ func decodeConstraintRows(rows []constraintRow) constraints.Value {
 		f := ipfamily.IPFamily(first.IPFamily.String)
 		cons.IPFamily = &f
 	}
+	if first.Market.Valid {
+		cons.Market = &first.Market.String
+	}
+	if first.MaxPrice.Valid {
+		cons.MaxPrice = &first.MaxPrice.String
+	}
+	if first.CapacityReservation.Valid {
+		cons.CapacityReservation = &first.CapacityReservation.String
+	}
+	if first.PlacementGroup.Valid {
+		cons.PlacementGroup = &first.PlacementGroup.String
+	}
+	if first.Gpus.Valid {
+		v := uint64(first.Gpus.V)
+		cons.Gpus = &v
+	}
+	if first.GpuType.Valid {
+		cons.GpuType = &first.GpuType.String
+	}
 
 	// Collect multi-valued fields from all rows (tags, spaces, zones).
 	var spaceConstraints []domainconstraints.SpaceConstraint
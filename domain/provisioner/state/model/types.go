@@ -26,23 +26,29 @@ type countResult struct {
 
 // constraintRow maps to v_machine_constraint view columns.
 type constraintRow struct {
-	Arch             sql.NullString  `db:"arch"`
-	CPUCores         sql.Null[int64] `db:"cpu_cores"`
-	CPUPower         sql.Null[int64] `db:"cpu_power"`
-	Mem              sql.Null[int64] `db:"mem"`
-	RootDisk         sql.Null[int64] `db:"root_disk"`
-	RootDiskSource   sql.NullString  `db:"root_disk_source"`
-	InstanceRole     sql.NullString  `db:"instance_role"`
-	InstanceType     sql.NullString  `db:"instance_type"`
-	ContainerType    sql.NullString  `db:"container_type"`
-	VirtType         sql.NullString  `db:"virt_type"`
-	AllocatePublicIP sql.NullBool    `db:"allocate_public_ip"`
-	ImageID          sql.NullString  `db:"image_id"`
-	IPFamily         sql.NullString  `db:"ip_family"`
-	SpaceName        sql.NullString  `db:"space_name"`
-	SpaceExclude     sql.NullBool    `db:"space_exclude"`
-	Tag              sql.NullString  `db:"tag"`
-	Zone             sql.NullString  `db:"zone"`
+	Arch                sql.NullString  `db:"arch"`
+	CPUCores            sql.Null[int64] `db:"cpu_cores"`
+	CPUPower            sql.Null[int64] `db:"cpu_power"`
+	Mem                 sql.Null[int64] `db:"mem"`
+	RootDisk            sql.Null[int64] `db:"root_disk"`
+	RootDiskSource      sql.NullString  `db:"root_disk_source"`
+	InstanceRole        sql.NullString  `db:"instance_role"`
+	InstanceType        sql.NullString  `db:"instance_type"`
+	ContainerType       sql.NullString  `db:"container_type"`
+	VirtType            sql.NullString  `db:"virt_type"`
+	AllocatePublicIP    sql.NullBool    `db:"allocate_public_ip"`
+	ImageID             sql.NullString  `db:"image_id"`
+	IPFamily            sql.NullString  `db:"ip_family"`
+	Market              sql.NullString  `db:"market"`
+	MaxPrice            sql.NullString  `db:"max_price"`
+	CapacityReservation sql.NullString  `db:"capacity_reservation"`
+	PlacementGroup      sql.NullString  `db:"placement_group"`
+	Gpus                sql.Null[int64] `db:"gpus"`
+	GpuType             sql.NullString  `db:"gpu_type"`
+	SpaceName           sql.NullString  `db:"space_name"`
+	SpaceExclude        sql.NullBool    `db:"space_exclude"`
+	Tag                 sql.NullString  `db:"tag"`
+	Zone                sql.NullString  `db:"zone"`
 }
 
 // unitRow maps to the unit query results.
@@ -88,6 +88,9 @@ type SharedProvisioningInfo struct {
 	// LokiOrgID is the organization/tenant ID for multi-tenant Loki
 	// deployments. Empty means no X-Scope-OrgID header is sent.
 	LokiOrgID string
+	// LokiProtocol is the protocol spoken by the logging endpoint, either
+	// "loki" or "otlp". Empty means "loki".
+	LokiProtocol string
 }
 
 // ProvisioningInfo holds the complete set of information required to
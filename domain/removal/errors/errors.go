@@ -31,6 +31,10 @@ const (
 	// we are processing a removal job is not dead.
 	EntityNotDead = errors.ConstError("entity not dead")
 
+	// RemovalJobNotFound indicates that a removal job
+	// with the requested UUID does not exist.
+	RemovalJobNotFound = errors.ConstError("removal job not found")
+
 	// RemovalJobIncomplete indicates that the job execution completed without
 	// errors, but that it is not complete and expected to be scheduled again
 	// later. It is not to be deleted from the removal table.
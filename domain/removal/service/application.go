@@ -73,6 +73,37 @@ type ApplicationState interface {
 	// the input application UUID.
 	// If the application does not exist, it returns an empty string.
 	GetCharmForApplication(ctx context.Context, appUUID string) (string, error)
+
+	// GetApplicationRemovalPreview returns the entities that would be removed
+	// along with the application with the input UUID, without removing them.
+	GetApplicationRemovalPreview(ctx context.Context, appUUID string, destroyStorage bool) (removal.Preview, error)
+}
+
+// PreviewApplicationRemoval returns the entities that would be removed, or
+// have their life advanced, if the application with the input UUID were
+// removed. If destroyStorage is true, storage instances attached to the
+// application's units are reported as destroyed rather than detached.
+// The model is not changed.
+// The following errors may be returned:
+// - [applicationerrors.ApplicationNotFound] if the application does not exist.
+func (s *Service) PreviewApplicationRemoval(
+	ctx context.Context, appUUID coreapplication.UUID, destroyStorage bool,
+) (removal.Preview, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	exists, err := s.modelState.ApplicationExists(ctx, appUUID.String())
+	if err != nil {
+		return removal.Preview{}, errors.Errorf("checking if application %q exists: %w", appUUID, err)
+	} else if !exists {
+		return removal.Preview{}, errors.Errorf("application %q does not exist", appUUID).Add(applicationerrors.ApplicationNotFound)
+	}
+
+	preview, err := s.modelState.GetApplicationRemovalPreview(ctx, appUUID.String(), destroyStorage)
+	if err != nil {
+		return removal.Preview{}, errors.Errorf("previewing removal of application %q: %w", appUUID, err)
+	}
+	return preview, nil
 }
 
 // RemoveApplication checks if a application with the input application UUID
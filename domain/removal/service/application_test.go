@@ -383,6 +383,7 @@ func (s *applicationSuite) TestExecuteJobForApplicationNotFound(c *tc.C) {
 
 func (s *applicationSuite) TestExecuteJobForApplicationError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newApplicationJob(c)
 
@@ -395,6 +396,7 @@ func (s *applicationSuite) TestExecuteJobForApplicationError(c *tc.C) {
 
 func (s *applicationSuite) TestExecuteJobForApplicationStillAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newApplicationJob(c)
 
@@ -655,6 +657,7 @@ func (s *applicationSuite) TestExecuteJobForApplicationDyingExternalSecretsDelet
 
 func (s *applicationSuite) TestExecuteJobForApplicationDyingDeleteApplicationError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newApplicationJob(c)
 
@@ -689,3 +692,35 @@ func newApplicationJob(c *tc.C) removal.Job {
 		EntityUUID:  tc.Must(c, coreapplication.NewUUID).String(),
 	}
 }
+
+func (s *applicationSuite) TestPreviewApplicationRemoval(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := tc.Must(c, coreapplication.NewUUID)
+
+	expected := removal.Preview{
+		Applications:     []string{"app"},
+		Units:            []string{"app/0"},
+		Relations:        []string{"app:db other:db"},
+		DestroyedStorage: []string{"data/0"},
+	}
+
+	exp := s.modelState.EXPECT()
+	exp.ApplicationExists(gomock.Any(), appUUID.String()).Return(true, nil)
+	exp.GetApplicationRemovalPreview(gomock.Any(), appUUID.String(), true).Return(expected, nil)
+
+	preview, err := s.newService(c).PreviewApplicationRemoval(c.Context(), appUUID, true)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(preview, tc.DeepEquals, expected)
+}
+
+func (s *applicationSuite) TestPreviewApplicationRemovalNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := tc.Must(c, coreapplication.NewUUID)
+
+	s.modelState.EXPECT().ApplicationExists(gomock.Any(), appUUID.String()).Return(false, nil)
+
+	_, err := s.newService(c).PreviewApplicationRemoval(c.Context(), appUUID, false)
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
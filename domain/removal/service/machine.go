@@ -63,6 +63,10 @@ type MachineState interface {
 	// machine with the input UUID. This is used to release any addresses
 	// that container machine has allocated.
 	GetMachineNetworkInterfaces(ctx context.Context, machineUUID string) ([]string, error)
+
+	// GetMachineRemovalPreview returns the entities that would be removed
+	// along with the machine with the input UUID, without removing them.
+	GetMachineRemovalPreview(ctx context.Context, machineUUID string, force bool) (removal.Preview, error)
 }
 
 // RemoveMachine checks if a machine with the input name exists.
@@ -241,6 +245,35 @@ func (s *Service) RemoveMachine(
 	return machineJobUUID, nil
 }
 
+// PreviewMachineRemoval returns the entities that would be removed, or have
+// their life advanced, if the machine with the input UUID were removed with
+// the input force qualification. The model is not changed.
+// The following errors may be returned:
+// - [machineerrors.MachineNotFound] if the machine does not exist.
+// - [removalerrors.MachineHasContainers] if the machine hosts containers
+// and force is false.
+// - [removalerrors.MachineHasUnits] if the machine hosts units and force is
+// false.
+func (s *Service) PreviewMachineRemoval(
+	ctx context.Context, machineUUID machine.UUID, force bool,
+) (removal.Preview, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	exists, err := s.modelState.MachineExists(ctx, machineUUID.String())
+	if err != nil {
+		return removal.Preview{}, errors.Errorf("checking if machine exists: %w", err)
+	} else if !exists {
+		return removal.Preview{}, errors.Errorf("machine does not exist").Add(machineerrors.MachineNotFound)
+	}
+
+	preview, err := s.modelState.GetMachineRemovalPreview(ctx, machineUUID.String(), force)
+	if err != nil {
+		return removal.Preview{}, errors.Errorf("previewing removal of machine %q: %w", machineUUID, err)
+	}
+	return preview, nil
+}
+
 // MarkMachineAsDead marks the machine as dead. It will not remove the machine as
 // that is a separate operation. This will advance the machines's life to dead
 // and will not allow it to be transitioned back to alive.
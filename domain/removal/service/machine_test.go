@@ -384,6 +384,7 @@ func (s *machineSuite) TestExecuteJobForMachineNotFound(c *tc.C) {
 
 func (s *machineSuite) TestExecuteJobForMachineError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newMachineJob(c)
 
@@ -396,6 +397,7 @@ func (s *machineSuite) TestExecuteJobForMachineError(c *tc.C) {
 
 func (s *machineSuite) TestExecuteJobForMachineStillAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newMachineJob(c)
 
@@ -408,6 +410,7 @@ func (s *machineSuite) TestExecuteJobForMachineStillAlive(c *tc.C) {
 
 func (s *machineSuite) TestExecuteJobForMachineInstanceDying(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newMachineJob(c)
 
@@ -421,6 +424,7 @@ func (s *machineSuite) TestExecuteJobForMachineInstanceDying(c *tc.C) {
 
 func (s *machineSuite) TestExecuteJobForMachineInstanceStillAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newMachineJob(c)
 
@@ -520,6 +524,7 @@ func (s *machineSuite) TestExecuteJobForMachineAlreadyRemoved(c *tc.C) {
 
 func (s *machineSuite) TestExecuteJobForMachineFailedRemoval(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newMachineJob(c)
 
@@ -545,3 +550,34 @@ func newMachineJob(c *tc.C) removal.Job {
 		EntityUUID:  machinetesting.GenUUID(c).String(),
 	}
 }
+
+func (s *machineSuite) TestPreviewMachineRemoval(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	mUUID := machinetesting.GenUUID(c)
+
+	expected := removal.Preview{
+		Machines:        []string{"0", "0/lxd/0"},
+		Units:           []string{"app/0"},
+		DetachedStorage: []string{"data/0"},
+	}
+
+	exp := s.modelState.EXPECT()
+	exp.MachineExists(gomock.Any(), mUUID.String()).Return(true, nil)
+	exp.GetMachineRemovalPreview(gomock.Any(), mUUID.String(), true).Return(expected, nil)
+
+	preview, err := s.newService(c).PreviewMachineRemoval(c.Context(), mUUID, true)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(preview, tc.DeepEquals, expected)
+}
+
+func (s *machineSuite) TestPreviewMachineRemovalNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	mUUID := machinetesting.GenUUID(c)
+
+	s.modelState.EXPECT().MachineExists(gomock.Any(), mUUID.String()).Return(false, nil)
+
+	_, err := s.newService(c).PreviewMachineRemoval(c.Context(), mUUID, false)
+	c.Assert(err, tc.ErrorIs, machineerrors.MachineNotFound)
+}
@@ -71,6 +71,10 @@ type ModelState interface {
 
 	// MarkModelAsDead marks the model with the input UUID as dead.
 	MarkModelAsDead(ctx context.Context, modelUUID string, force bool) error
+
+	// GetModelRemovalPreview returns the entities that would be removed along
+	// with the model, without removing them.
+	GetModelRemovalPreview(ctx context.Context) (removal.Preview, error)
 }
 
 // RemoveModel checks if a model with the input name exists.
@@ -101,6 +105,43 @@ func (s *Service) RemoveModel(
 	return s.removeModel(ctx, modelUUID, force, wait)
 }
 
+// PreviewModelRemoval returns the entities that would be removed if the model
+// with the input UUID were removed with the input force qualification.
+// The model is not changed.
+// The following errors may be returned:
+// - [modelerrors.NotFound] if the model does not exist.
+// - [removalerrors.ForceRequired] if the model is the controller model and
+// force is false.
+func (s *Service) PreviewModelRemoval(
+	ctx context.Context,
+	modelUUID model.UUID,
+	force bool,
+) (removal.Preview, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if controllerModel, err := s.modelState.IsControllerModel(ctx, modelUUID.String()); err != nil {
+		return removal.Preview{}, errors.Capture(err)
+	} else if controllerModel && !force {
+		return removal.Preview{}, errors.Errorf("cannot remove controller model %q without force", modelUUID).Add(
+			removalerrors.ForceRequired,
+		)
+	}
+
+	exists, err := s.modelState.ModelExists(ctx, modelUUID.String())
+	if err != nil {
+		return removal.Preview{}, errors.Errorf("checking if model exists: %w", err)
+	} else if !exists {
+		return removal.Preview{}, errors.Errorf("model does not exist").Add(modelerrors.NotFound)
+	}
+
+	preview, err := s.modelState.GetModelRemovalPreview(ctx)
+	if err != nil {
+		return removal.Preview{}, errors.Errorf("previewing removal of model %q: %w", modelUUID, err)
+	}
+	return preview, nil
+}
+
 // RemoveMigratingModel removes a model that is currently importing/migrating.
 // The model is guaranteed after this call to be dead.
 func (s *Service) RemoveMigratingModel(
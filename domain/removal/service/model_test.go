@@ -545,6 +545,7 @@ func (s *modelSuite) TestExecuteJobForModelNotFound(c *tc.C) {
 
 func (s *modelSuite) TestExecuteJobForModelError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newModelJob(c)
 
@@ -560,6 +561,7 @@ func (s *modelSuite) TestExecuteJobForModelError(c *tc.C) {
 
 func (s *modelSuite) TestExecuteJobForModelStillAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newModelJob(c)
 
@@ -617,6 +619,7 @@ func (s *modelSuite) TestExecuteJobForModelControllerModel(c *tc.C) {
 
 func (s *modelSuite) TestExecuteJobForModelControllerModelNotDead(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newModelJob(c)
 
@@ -662,6 +665,7 @@ func (s *modelSuite) TestExecuteJobForModelControllerModelNotFound(c *tc.C) {
 
 func (s *modelSuite) TestExecuteJobForModelControllerModelAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newModelJob(c)
 
@@ -682,6 +686,7 @@ func (s *modelSuite) TestExecuteJobForModelControllerModelAlive(c *tc.C) {
 
 func (s *modelSuite) TestExecuteJobForModelControllerModelAliveWithForce(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newModelJob(c)
 	j.Force = true
@@ -703,6 +708,7 @@ func (s *modelSuite) TestExecuteJobForModelControllerModelAliveWithForce(c *tc.C
 
 func (s *modelSuite) TestExecuteJobForModelControllerModelDying(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newModelJob(c)
 
@@ -812,3 +818,49 @@ func newModelJob(c *tc.C) removal.Job {
 		EntityUUID:  tc.Must0(c, coremodel.NewUUID).String(),
 	}
 }
+
+func (s *modelSuite) TestPreviewModelRemoval(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	mUUID := tc.Must0(c, coremodel.NewUUID)
+
+	expected := removal.Preview{
+		Machines:         []string{"0"},
+		Applications:     []string{"app"},
+		Units:            []string{"app/0"},
+		DestroyedStorage: []string{"data/0"},
+	}
+
+	mExp := s.modelState.EXPECT()
+	mExp.IsControllerModel(gomock.Any(), mUUID.String()).Return(false, nil)
+	mExp.ModelExists(gomock.Any(), mUUID.String()).Return(true, nil)
+	mExp.GetModelRemovalPreview(gomock.Any()).Return(expected, nil)
+
+	preview, err := s.newService(c).PreviewModelRemoval(c.Context(), mUUID, false)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(preview, tc.DeepEquals, expected)
+}
+
+func (s *modelSuite) TestPreviewModelRemovalControllerModel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	mUUID := tc.Must0(c, coremodel.NewUUID)
+
+	s.modelState.EXPECT().IsControllerModel(gomock.Any(), mUUID.String()).Return(true, nil)
+
+	_, err := s.newService(c).PreviewModelRemoval(c.Context(), mUUID, false)
+	c.Assert(err, tc.ErrorIs, removalerrors.ForceRequired)
+}
+
+func (s *modelSuite) TestPreviewModelRemovalNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	mUUID := tc.Must0(c, coremodel.NewUUID)
+
+	mExp := s.modelState.EXPECT()
+	mExp.IsControllerModel(gomock.Any(), mUUID.String()).Return(false, nil)
+	mExp.ModelExists(gomock.Any(), mUUID.String()).Return(false, nil)
+
+	_, err := s.newService(c).PreviewModelRemoval(c.Context(), mUUID, false)
+	c.Assert(err, tc.ErrorIs, modelerrors.NotFound)
+}
@@ -317,6 +317,7 @@ type MockModelDBStateMockRecorder struct {
 	getApplicationLifeExpects                               []*gomock.Call2_2[context.Context, string, life.Life, error]
 	getApplicationNameAndUnitNameByUnitUUIDExpects          []*gomock.Call2_3[context.Context, string, string, string, error]
 	getApplicationOwnedSecretRevisionRefsExpects            []*gomock.Call2_2[context.Context, string, []string, error]
+	getApplicationRemovalPreviewExpects                     []*gomock.Call3_2[context.Context, string, bool, removal.Preview, error]
 	getApplicationUnitAndRelationCountExpects               []*gomock.Call2_3[context.Context, string, int, int, error]
 	getCharmForApplicationExpects                           []*gomock.Call2_2[context.Context, string, string, error]
 	getCharmForUnitExpects                                  []*gomock.Call2_2[context.Context, string, string, error]
@@ -325,13 +326,17 @@ type MockModelDBStateMockRecorder struct {
 	getFilesystemLifeExpects                                []*gomock.Call2_2[context.Context, string, life.Life, error]
 	getFilesystemStatusExpects                              []*gomock.Call2_2[context.Context, string, int, error]
 	getInstanceLifeExpects                                  []*gomock.Call2_2[context.Context, string, life.Life, error]
+	getJobEntityNamesExpects                                []*gomock.Call1_2[context.Context, map[string]string, error]
 	getMachineLifeExpects                                   []*gomock.Call2_2[context.Context, string, life.Life, error]
 	getMachineNetworkInterfacesExpects                      []*gomock.Call2_2[context.Context, string, []string, error]
+	getMachineRemovalPreviewExpects                         []*gomock.Call3_2[context.Context, string, bool, removal.Preview, error]
 	getModelLifeExpects                                     []*gomock.Call2_2[context.Context, string, life.Life, error]
+	getModelRemovalPreviewExpects                           []*gomock.Call1_2[context.Context, removal.Preview, error]
 	getModelTypeExpects                                     []*gomock.Call1_2[context.Context, model.ModelType, error]
 	getRelationLifeExpects                                  []*gomock.Call2_2[context.Context, string, life.Life, error]
 	getRelationUnitsForUnitExpects                          []*gomock.Call2_2[context.Context, string, []string, error]
 	getRemoteApplicationOffererLifeExpects                  []*gomock.Call2_2[context.Context, string, life.Life, error]
+	getRemoteApplicationOffererRemovalPreviewExpects        []*gomock.Call2_2[context.Context, string, removal.Preview, error]
 	getRemoteApplicationOffererUUIDByApplicationUUIDExpects []*gomock.Call2_2[context.Context, string, string, error]
 	getStorageAttachmentLifeExpects                         []*gomock.Call2_2[context.Context, string, life.Life, error]
 	getStorageInstanceLifeExpects                           []*gomock.Call2_2[context.Context, string, life.Life, error]
@@ -362,6 +367,7 @@ type MockModelDBStateMockRecorder struct {
 	namespaceForWatchEntityRemovalsExpects                  []*gomock.Call0_2[eventsource.NamespaceQuery, map[string]string]
 	namespaceForWatchRemovalsExpects                        []*gomock.Call0_1[string]
 	offerExistsExpects                                      []*gomock.Call2_2[context.Context, string, bool, error]
+	recordJobAttemptExpects                                 []*gomock.Call4_1[context.Context, string, string, time.Time, error]
 	relationExistsExpects                                   []*gomock.Call2_2[context.Context, string, bool, error]
 	relationScheduleRemovalExpects                          []*gomock.Call5_1[context.Context, string, string, bool, time.Time, error]
 	relationWithRemoteConsumerExistsExpects                 []*gomock.Call2_2[context.Context, string, bool, error]
@@ -370,6 +376,7 @@ type MockModelDBStateMockRecorder struct {
 	relationWithRemoteOffererScheduleRemovalExpects         []*gomock.Call5_1[context.Context, string, string, bool, time.Time, error]
 	remoteApplicationOffererExistsExpects                   []*gomock.Call2_2[context.Context, string, bool, error]
 	remoteApplicationOffererScheduleRemovalExpects          []*gomock.Call5_1[context.Context, string, string, bool, time.Time, error]
+	rescheduleJobExpects                                    []*gomock.Call4_1[context.Context, string, time.Time, bool, error]
 	setFilesystemStatusExpects                              []*gomock.Call3_1[context.Context, string, int, error]
 	setVolumeStatusExpects                                  []*gomock.Call3_1[context.Context, string, int, error]
 	storageAttachmentExistsExpects                          []*gomock.Call2_2[context.Context, string, bool, error]
@@ -1295,6 +1302,24 @@ func (mr *MockModelDBStateMockRecorder) GetApplicationOwnedSecretRevisionRefs(ct
 // MockModelDBStateGetApplicationOwnedSecretRevisionRefsCall is the typed call wrapper for GetApplicationOwnedSecretRevisionRefs.
 type MockModelDBStateGetApplicationOwnedSecretRevisionRefsCall = gomock.Call2_2[context.Context, string, []string, error]
 
+// GetApplicationRemovalPreview mocks base method.
+func (m *MockModelDBState) GetApplicationRemovalPreview(ctx context.Context, appUUID string, destroyStorage bool) (removal.Preview, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.getApplicationRemovalPreviewExpects, m.ctrl, m, "GetApplicationRemovalPreview", ctx, appUUID, destroyStorage)
+}
+
+// GetApplicationRemovalPreview indicates an expected call of GetApplicationRemovalPreview.
+func (mr *MockModelDBStateMockRecorder) GetApplicationRemovalPreview(ctx, appUUID, destroyStorage any) *MockModelDBStateGetApplicationRemovalPreviewCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, string, bool, removal.Preview, error](mr.mock.ctrl.T, mr.mock, "GetApplicationRemovalPreview", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appUUID), gomock.EnsureMatcher(destroyStorage))
+	mr.getApplicationRemovalPreviewExpects = append(mr.getApplicationRemovalPreviewExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelDBStateGetApplicationRemovalPreviewCall is the typed call wrapper for GetApplicationRemovalPreview.
+type MockModelDBStateGetApplicationRemovalPreviewCall = gomock.Call3_2[context.Context, string, bool, removal.Preview, error]
+
 // GetApplicationUnitAndRelationCount mocks base method.
 func (m *MockModelDBState) GetApplicationUnitAndRelationCount(ctx context.Context, appUUID string) (int, int, error) {
 	m.ctrl.T.Helper()
@@ -1439,6 +1464,24 @@ func (mr *MockModelDBStateMockRecorder) GetInstanceLife(ctx, mUUID any) *MockMod
 // MockModelDBStateGetInstanceLifeCall is the typed call wrapper for GetInstanceLife.
 type MockModelDBStateGetInstanceLifeCall = gomock.Call2_2[context.Context, string, life.Life, error]
 
+// GetJobEntityNames mocks base method.
+func (m *MockModelDBState) GetJobEntityNames(ctx context.Context) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getJobEntityNamesExpects, m.ctrl, m, "GetJobEntityNames", ctx)
+}
+
+// GetJobEntityNames indicates an expected call of GetJobEntityNames.
+func (mr *MockModelDBStateMockRecorder) GetJobEntityNames(ctx any) *MockModelDBStateGetJobEntityNamesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, map[string]string, error](mr.mock.ctrl.T, mr.mock, "GetJobEntityNames", gomock.EnsureMatcher(ctx))
+	mr.getJobEntityNamesExpects = append(mr.getJobEntityNamesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelDBStateGetJobEntityNamesCall is the typed call wrapper for GetJobEntityNames.
+type MockModelDBStateGetJobEntityNamesCall = gomock.Call1_2[context.Context, map[string]string, error]
+
 // GetMachineLife mocks base method.
 func (m *MockModelDBState) GetMachineLife(ctx context.Context, mUUID string) (life.Life, error) {
 	m.ctrl.T.Helper()
@@ -1475,6 +1518,24 @@ func (mr *MockModelDBStateMockRecorder) GetMachineNetworkInterfaces(ctx, machine
 // MockModelDBStateGetMachineNetworkInterfacesCall is the typed call wrapper for GetMachineNetworkInterfaces.
 type MockModelDBStateGetMachineNetworkInterfacesCall = gomock.Call2_2[context.Context, string, []string, error]
 
+// GetMachineRemovalPreview mocks base method.
+func (m *MockModelDBState) GetMachineRemovalPreview(ctx context.Context, machineUUID string, force bool) (removal.Preview, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.getMachineRemovalPreviewExpects, m.ctrl, m, "GetMachineRemovalPreview", ctx, machineUUID, force)
+}
+
+// GetMachineRemovalPreview indicates an expected call of GetMachineRemovalPreview.
+func (mr *MockModelDBStateMockRecorder) GetMachineRemovalPreview(ctx, machineUUID, force any) *MockModelDBStateGetMachineRemovalPreviewCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, string, bool, removal.Preview, error](mr.mock.ctrl.T, mr.mock, "GetMachineRemovalPreview", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(machineUUID), gomock.EnsureMatcher(force))
+	mr.getMachineRemovalPreviewExpects = append(mr.getMachineRemovalPreviewExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelDBStateGetMachineRemovalPreviewCall is the typed call wrapper for GetMachineRemovalPreview.
+type MockModelDBStateGetMachineRemovalPreviewCall = gomock.Call3_2[context.Context, string, bool, removal.Preview, error]
+
 // GetModelLife mocks base method.
 func (m *MockModelDBState) GetModelLife(ctx context.Context, modelUUID string) (life.Life, error) {
 	m.ctrl.T.Helper()
@@ -1493,6 +1554,24 @@ func (mr *MockModelDBStateMockRecorder) GetModelLife(ctx, modelUUID any) *MockMo
 // MockModelDBStateGetModelLifeCall is the typed call wrapper for GetModelLife.
 type MockModelDBStateGetModelLifeCall = gomock.Call2_2[context.Context, string, life.Life, error]
 
+// GetModelRemovalPreview mocks base method.
+func (m *MockModelDBState) GetModelRemovalPreview(ctx context.Context) (removal.Preview, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getModelRemovalPreviewExpects, m.ctrl, m, "GetModelRemovalPreview", ctx)
+}
+
+// GetModelRemovalPreview indicates an expected call of GetModelRemovalPreview.
+func (mr *MockModelDBStateMockRecorder) GetModelRemovalPreview(ctx any) *MockModelDBStateGetModelRemovalPreviewCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, removal.Preview, error](mr.mock.ctrl.T, mr.mock, "GetModelRemovalPreview", gomock.EnsureMatcher(ctx))
+	mr.getModelRemovalPreviewExpects = append(mr.getModelRemovalPreviewExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelDBStateGetModelRemovalPreviewCall is the typed call wrapper for GetModelRemovalPreview.
+type MockModelDBStateGetModelRemovalPreviewCall = gomock.Call1_2[context.Context, removal.Preview, error]
+
 // GetModelType mocks base method.
 func (m *MockModelDBState) GetModelType(ctx context.Context) (model.ModelType, error) {
 	m.ctrl.T.Helper()
@@ -1565,6 +1644,24 @@ func (mr *MockModelDBStateMockRecorder) GetRemoteApplicationOffererLife(ctx, rUU
 // MockModelDBStateGetRemoteApplicationOffererLifeCall is the typed call wrapper for GetRemoteApplicationOffererLife.
 type MockModelDBStateGetRemoteApplicationOffererLifeCall = gomock.Call2_2[context.Context, string, life.Life, error]
 
+// GetRemoteApplicationOffererRemovalPreview mocks base method.
+func (m *MockModelDBState) GetRemoteApplicationOffererRemovalPreview(ctx context.Context, rUUID string) (removal.Preview, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getRemoteApplicationOffererRemovalPreviewExpects, m.ctrl, m, "GetRemoteApplicationOffererRemovalPreview", ctx, rUUID)
+}
+
+// GetRemoteApplicationOffererRemovalPreview indicates an expected call of GetRemoteApplicationOffererRemovalPreview.
+func (mr *MockModelDBStateMockRecorder) GetRemoteApplicationOffererRemovalPreview(ctx, rUUID any) *MockModelDBStateGetRemoteApplicationOffererRemovalPreviewCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, removal.Preview, error](mr.mock.ctrl.T, mr.mock, "GetRemoteApplicationOffererRemovalPreview", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(rUUID))
+	mr.getRemoteApplicationOffererRemovalPreviewExpects = append(mr.getRemoteApplicationOffererRemovalPreviewExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelDBStateGetRemoteApplicationOffererRemovalPreviewCall is the typed call wrapper for GetRemoteApplicationOffererRemovalPreview.
+type MockModelDBStateGetRemoteApplicationOffererRemovalPreviewCall = gomock.Call2_2[context.Context, string, removal.Preview, error]
+
 // GetRemoteApplicationOffererUUIDByApplicationUUID mocks base method.
 func (m *MockModelDBState) GetRemoteApplicationOffererUUIDByApplicationUUID(ctx context.Context, appUUID string) (string, error) {
 	m.ctrl.T.Helper()
@@ -2105,6 +2202,24 @@ func (mr *MockModelDBStateMockRecorder) OfferExists(ctx, offerUUID any) *MockMod
 // MockModelDBStateOfferExistsCall is the typed call wrapper for OfferExists.
 type MockModelDBStateOfferExistsCall = gomock.Call2_2[context.Context, string, bool, error]
 
+// RecordJobAttempt mocks base method.
+func (m *MockModelDBState) RecordJobAttempt(ctx context.Context, jUUID, reason string, at time.Time) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_1(&m.recorder.recordJobAttemptExpects, m.ctrl, m, "RecordJobAttempt", ctx, jUUID, reason, at)
+}
+
+// RecordJobAttempt indicates an expected call of RecordJobAttempt.
+func (mr *MockModelDBStateMockRecorder) RecordJobAttempt(ctx, jUUID, reason, at any) *MockModelDBStateRecordJobAttemptCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_1[context.Context, string, string, time.Time, error](mr.mock.ctrl.T, mr.mock, "RecordJobAttempt", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(jUUID), gomock.EnsureMatcher(reason), gomock.EnsureMatcher(at))
+	mr.recordJobAttemptExpects = append(mr.recordJobAttemptExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelDBStateRecordJobAttemptCall is the typed call wrapper for RecordJobAttempt.
+type MockModelDBStateRecordJobAttemptCall = gomock.Call4_1[context.Context, string, string, time.Time, error]
+
 // RelationExists mocks base method.
 func (m *MockModelDBState) RelationExists(ctx context.Context, rUUID string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -2249,6 +2364,24 @@ func (mr *MockModelDBStateMockRecorder) RemoteApplicationOffererScheduleRemoval(
 // MockModelDBStateRemoteApplicationOffererScheduleRemovalCall is the typed call wrapper for RemoteApplicationOffererScheduleRemoval.
 type MockModelDBStateRemoteApplicationOffererScheduleRemovalCall = gomock.Call5_1[context.Context, string, string, bool, time.Time, error]
 
+// RescheduleJob mocks base method.
+func (m *MockModelDBState) RescheduleJob(ctx context.Context, jUUID string, at time.Time, force bool) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_1(&m.recorder.rescheduleJobExpects, m.ctrl, m, "RescheduleJob", ctx, jUUID, at, force)
+}
+
+// RescheduleJob indicates an expected call of RescheduleJob.
+func (mr *MockModelDBStateMockRecorder) RescheduleJob(ctx, jUUID, at, force any) *MockModelDBStateRescheduleJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_1[context.Context, string, time.Time, bool, error](mr.mock.ctrl.T, mr.mock, "RescheduleJob", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(jUUID), gomock.EnsureMatcher(at), gomock.EnsureMatcher(force))
+	mr.rescheduleJobExpects = append(mr.rescheduleJobExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelDBStateRescheduleJobCall is the typed call wrapper for RescheduleJob.
+type MockModelDBStateRescheduleJobCall = gomock.Call4_1[context.Context, string, time.Time, bool, error]
+
 // SetFilesystemStatus mocks base method.
 func (m *MockModelDBState) SetFilesystemStatus(ctx context.Context, fsUUID string, status int) error {
 	m.ctrl.T.Helper()
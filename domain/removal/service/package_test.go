@@ -5,6 +5,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/canonical/gomock/gomock"
 	"github.com/juju/tc"
@@ -73,3 +74,10 @@ func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 
 	return ctrl
 }
+
+// expectJobAttempt sets up the expectation that an unsuccessful
+// attempt to execute a removal job is recorded.
+func (s *baseSuite) expectJobAttempt() {
+	s.clock.EXPECT().Now().Return(time.Now()).AnyTimes()
+	s.modelState.EXPECT().RecordJobAttempt(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+}
@@ -137,6 +137,7 @@ func (s *relationSuite) TestProcessRelationRemovalJobInvalidJobType(c *tc.C) {
 
 func (s *relationSuite) TestExecuteJobForRelationStillAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newRelationJob(c)
 
@@ -148,6 +149,7 @@ func (s *relationSuite) TestExecuteJobForRelationStillAlive(c *tc.C) {
 
 func (s *relationSuite) TestExecuteJobForRelationExistingScopes(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newRelationJob(c)
 
@@ -162,6 +164,7 @@ func (s *relationSuite) TestExecuteJobForRelationExistingScopes(c *tc.C) {
 
 func (s *relationSuite) TestExecuteJobForRelationExistingScopesMultipleUnits(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newRelationJob(c)
 
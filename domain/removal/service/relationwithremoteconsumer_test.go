@@ -147,6 +147,7 @@ func (s *relationWithRemoteConsumerSuite) TestExecuteJobForRelationWithRemoteCon
 
 func (s *relationWithRemoteConsumerSuite) TestExecuteJobForRelationWithRemoteConsumerError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newRelationWithRemoteConsumerJob(c)
 
@@ -159,6 +160,7 @@ func (s *relationWithRemoteConsumerSuite) TestExecuteJobForRelationWithRemoteCon
 
 func (s *relationWithRemoteConsumerSuite) TestExecuteJobForRelationWithRemoteConsumerStillAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newRelationWithRemoteConsumerJob(c)
 
@@ -171,6 +173,7 @@ func (s *relationWithRemoteConsumerSuite) TestExecuteJobForRelationWithRemoteCon
 
 func (s *relationWithRemoteConsumerSuite) TestExecuteJobForRelationWithRemoteConsumerUnitsInScope(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newRelationWithRemoteConsumerJob(c)
 
@@ -216,6 +219,7 @@ func (s *relationWithRemoteConsumerSuite) TestExecuteJobForRelationWithRemoteCon
 
 func (s *relationWithRemoteConsumerSuite) TestExecuteJobForRelationWithRemoteConsumerDyingDeleteRelationWithRemoteConsumerError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newRelationWithRemoteConsumerJob(c)
 
@@ -147,6 +147,7 @@ func (s *relationWithRemoteOffererSuite) TestExecuteJobForRelationWithRemoteOffe
 
 func (s *relationWithRemoteOffererSuite) TestExecuteJobForRelationWithRemoteOffererError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newRelationWithRemoteOffererJob(c)
 
@@ -159,6 +160,7 @@ func (s *relationWithRemoteOffererSuite) TestExecuteJobForRelationWithRemoteOffe
 
 func (s *relationWithRemoteOffererSuite) TestExecuteJobForRelationWithRemoteOffererStillAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newRelationWithRemoteOffererJob(c)
 
@@ -171,6 +173,7 @@ func (s *relationWithRemoteOffererSuite) TestExecuteJobForRelationWithRemoteOffe
 
 func (s *relationWithRemoteOffererSuite) TestExecuteJobForRelationWithRemoteOffererUnitsInScope(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newRelationWithRemoteOffererJob(c)
 
@@ -216,6 +219,7 @@ func (s *relationWithRemoteOffererSuite) TestExecuteJobForRelationWithRemoteOffe
 
 func (s *relationWithRemoteOffererSuite) TestExecuteJobForRelationWithRemoteOffererDyingDeleteRelationWithRemoteOffererError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newRelationWithRemoteOffererJob(c)
 
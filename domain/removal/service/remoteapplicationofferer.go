@@ -57,6 +57,39 @@ type RemoteApplicationOffererState interface {
 	GetRemoteApplicationOffererUUIDByApplicationUUID(
 		ctx context.Context, appUUID string,
 	) (string, error)
+
+	// GetRemoteApplicationOffererRemovalPreview returns the entities that
+	// would be removed along with the remote application offerer with the
+	// input UUID, without removing them.
+	GetRemoteApplicationOffererRemovalPreview(ctx context.Context, rUUID string) (removal.Preview, error)
+}
+
+// PreviewRemoteApplicationOffererRemoval returns the entities that would be
+// removed, or have their life advanced, if the remote application offerer
+// with the input UUID were removed. The model is not changed.
+// The following errors may be returned:
+// - [crossmodelrelationerrors.RemoteApplicationNotFound] if the remote
+// application offerer does not exist.
+func (s *Service) PreviewRemoteApplicationOffererRemoval(
+	ctx context.Context, remoteAppOffererUUID coreremoteapplication.UUID,
+) (removal.Preview, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	exists, err := s.modelState.RemoteApplicationOffererExists(ctx, remoteAppOffererUUID.String())
+	if err != nil {
+		return removal.Preview{}, errors.Errorf("checking if remote application offerer %q exists: %w", remoteAppOffererUUID, err)
+	}
+	if !exists {
+		return removal.Preview{}, errors.Errorf("remote application offerer %q does not exist", remoteAppOffererUUID).
+			Add(crossmodelrelationerrors.RemoteApplicationNotFound)
+	}
+
+	preview, err := s.modelState.GetRemoteApplicationOffererRemovalPreview(ctx, remoteAppOffererUUID.String())
+	if err != nil {
+		return removal.Preview{}, errors.Errorf("previewing removal of remote application offerer %q: %w", remoteAppOffererUUID, err)
+	}
+	return preview, nil
 }
 
 // RemoveRemoteApplicationOfferer checks if a remote application with the input
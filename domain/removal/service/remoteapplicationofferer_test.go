@@ -149,6 +149,7 @@ func (s *remoteApplicationOffererSuite) TestExecuteJobForRemoteApplicationOffere
 
 func (s *remoteApplicationOffererSuite) TestExecuteJobForRemoteApplicationOffererError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newRemoteApplicationOffererJob(c)
 
@@ -161,6 +162,7 @@ func (s *remoteApplicationOffererSuite) TestExecuteJobForRemoteApplicationOffere
 
 func (s *remoteApplicationOffererSuite) TestExecuteJobForRemoteApplicationOffererStillAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newRemoteApplicationOffererJob(c)
 
@@ -187,6 +189,7 @@ func (s *remoteApplicationOffererSuite) TestExecuteJobForRemoteApplicationOffere
 
 func (s *remoteApplicationOffererSuite) TestExecuteJobForRemoteApplicationOffererDyingDeleteRemoteApplicationOffererError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newRemoteApplicationOffererJob(c)
 
@@ -208,3 +211,33 @@ func newRemoteApplicationOffererJob(c *tc.C) removal.Job {
 		EntityUUID:  tc.Must(c, coreremoteapplication.NewUUID).String(),
 	}
 }
+
+func (s *remoteApplicationOffererSuite) TestPreviewRemoteApplicationOffererRemoval(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	remoteAppUUID := tc.Must(c, coreremoteapplication.NewUUID)
+
+	expected := removal.Preview{
+		RemoteApplications: []string{"remote-app"},
+		Relations:          []string{"app:db remote-app:db"},
+	}
+
+	exp := s.modelState.EXPECT()
+	exp.RemoteApplicationOffererExists(gomock.Any(), remoteAppUUID.String()).Return(true, nil)
+	exp.GetRemoteApplicationOffererRemovalPreview(gomock.Any(), remoteAppUUID.String()).Return(expected, nil)
+
+	preview, err := s.newService(c).PreviewRemoteApplicationOffererRemoval(c.Context(), remoteAppUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(preview, tc.DeepEquals, expected)
+}
+
+func (s *remoteApplicationOffererSuite) TestPreviewRemoteApplicationOffererRemovalNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	remoteAppUUID := tc.Must(c, coreremoteapplication.NewUUID)
+
+	s.modelState.EXPECT().RemoteApplicationOffererExists(gomock.Any(), remoteAppUUID.String()).Return(false, nil)
+
+	_, err := s.newService(c).PreviewRemoteApplicationOffererRemoval(c.Context(), remoteAppUUID)
+	c.Assert(err, tc.ErrorIs, crossmodelrelationerrors.RemoteApplicationNotFound)
+}
@@ -5,6 +5,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/clock"
 
@@ -60,6 +61,20 @@ type ModelDBState interface {
 	// that it was executed successfully.
 	DeleteJob(ctx context.Context, jUUID string) error
 
+	// GetJobEntityNames returns the names by which the entities being
+	// removed by the scheduled removal jobs are known to users, keyed by
+	// removal job UUID.
+	GetJobEntityNames(ctx context.Context) (map[string]string, error)
+
+	// RecordJobAttempt increments the attempt count for the removal job
+	// with the input UUID, and records the reason for it not completing.
+	RecordJobAttempt(ctx context.Context, jUUID, reason string, at time.Time) error
+
+	// RescheduleJob sets the time at which the removal job with the input
+	// UUID is next due. If force is true, the job is escalated to a forced
+	// removal. A job that is already forced remains so.
+	RescheduleJob(ctx context.Context, jUUID string, at time.Time, force bool) error
+
 	// NamespaceForWatchRemovals returns the table name whose UUIDs we
 	// are watching in order to be notified of new removal jobs.
 	NamespaceForWatchRemovals() string
@@ -145,6 +160,24 @@ func (s *Service) GetAllJobs(ctx context.Context) ([]removal.Job, error) {
 	return jobs, nil
 }
 
+// GetJobEntityNames returns the names by which the entities being removed by
+// the scheduled removal jobs are known to users, keyed by removal job UUID.
+// Jobs for entities that no longer exist are omitted.
+func (s *Service) GetJobEntityNames(ctx context.Context) (map[removal.UUID]string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	names, err := s.modelState.GetJobEntityNames(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	result := make(map[removal.UUID]string, len(names))
+	for jUUID, name := range names {
+		result[removal.UUID(jUUID)] = name
+	}
+	return result, nil
+}
+
 // ExecuteJob runs the appropriate removal logic for the input job.
 // If the job is determined to have run successfully, we ensure that
 // no removal job with the same UUID exists in the database.
@@ -215,9 +248,11 @@ func (s *Service) ExecuteJob(ctx context.Context, job removal.Job) error {
 
 	if errors.Is(err, removalerrors.RemovalJobIncomplete) {
 		s.logger.Debugf(ctx, "removal job for %s %q incomplete: %v", job.RemovalType, job.EntityUUID, err)
+		s.recordJobAttempt(ctx, job, err)
 		return nil
 	}
 	if err != nil && !errors.IsOneOf(err, nonRetryableErrors...) {
+		s.recordJobAttempt(ctx, job, err)
 		return errors.Capture(err)
 	}
 
@@ -241,6 +276,46 @@ func (s *Service) ExecuteJob(ctx context.Context, job removal.Job) error {
 	return nil
 }
 
+// RetryJob reschedules the removal job with the input UUID
+// so that it is run again as soon as possible.
+func (s *Service) RetryJob(ctx context.Context, jUUID removal.UUID) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.rescheduleJob(ctx, jUUID, false)
+}
+
+// ForceJob escalates the removal job with the input UUID to a forced
+// removal, and reschedules it so that it is run again as soon as possible.
+func (s *Service) ForceJob(ctx context.Context, jUUID removal.UUID) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.rescheduleJob(ctx, jUUID, true)
+}
+
+func (s *Service) rescheduleJob(ctx context.Context, jUUID removal.UUID, force bool) error {
+	if err := jUUID.Validate(); err != nil {
+		return errors.Errorf("validating removal job UUID %q: %w", jUUID, err).Add(
+			removalerrors.RemovalJobArgsInvalid)
+	}
+
+	if err := s.modelState.RescheduleJob(ctx, jUUID.String(), s.clock.Now().UTC(), force); err != nil {
+		return errors.Errorf("rescheduling removal job %q: %w", jUUID, err)
+	}
+	return nil
+}
+
+// recordJobAttempt records an unsuccessful attempt to execute the input job.
+// Failure to do so is logged rather than returned, so that it does not mask
+// the reason for the job not completing.
+func (s *Service) recordJobAttempt(ctx context.Context, job removal.Job, reason error) {
+	err := s.modelState.RecordJobAttempt(ctx, job.UUID.String(), reason.Error(), s.clock.Now().UTC())
+	if err != nil {
+		s.logger.Warningf(ctx, "recording attempt for removal job %q: %v", job.UUID, err)
+	}
+}
+
 // WatchableService provides the API for working with entity removal,
 // including the ability to create watchers.
 type WatchableService struct {
@@ -80,7 +80,24 @@ func (s *serviceSuite) TestGetAllJobsError(c *tc.C) {
 	c.Check(jobs, tc.IsNil)
 }
 
+func (s *serviceSuite) TestGetJobEntityNames(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.modelState.EXPECT().GetJobEntityNames(gomock.Any()).Return(map[string]string{
+		"job-uuid": "foo/0",
+	}, nil)
+
+	names, err := s.newService(c).GetJobEntityNames(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(names, tc.DeepEquals, map[removal.UUID]string{
+		"job-uuid": "foo/0",
+	})
+}
+
 func (s *serviceSuite) TestExecuteJobUnsupportedType(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
+
 	var unsupportedJobType removal.JobType = 500
 
 	job := removal.Job{
@@ -90,3 +107,69 @@ func (s *serviceSuite) TestExecuteJobUnsupportedType(c *tc.C) {
 	err := s.newService(c).ExecuteJob(c.Context(), job)
 	c.Check(err, tc.ErrorIs, removalerrors.RemovalJobTypeNotSupported)
 }
+
+func (s *serviceSuite) TestExecuteJobRecordsAttempt(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Now()
+	s.clock.EXPECT().Now().Return(now)
+
+	jUUID := tc.Must(c, removal.NewUUID)
+	s.modelState.EXPECT().RecordJobAttempt(
+		gomock.Any(), jUUID.String(), `removal job type "500" not supported`, now.UTC(),
+	).Return(nil)
+
+	job := removal.Job{
+		UUID:        jUUID,
+		RemovalType: 500,
+	}
+
+	err := s.newService(c).ExecuteJob(c.Context(), job)
+	c.Check(err, tc.ErrorIs, removalerrors.RemovalJobTypeNotSupported)
+}
+
+func (s *serviceSuite) TestRetryJob(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Now()
+	s.clock.EXPECT().Now().Return(now)
+
+	jUUID := tc.Must(c, removal.NewUUID)
+	s.modelState.EXPECT().RescheduleJob(gomock.Any(), jUUID.String(), now.UTC(), false).Return(nil)
+
+	err := s.newService(c).RetryJob(c.Context(), jUUID)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestRetryJobNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.clock.EXPECT().Now().Return(time.Now())
+
+	jUUID := tc.Must(c, removal.NewUUID)
+	s.modelState.EXPECT().RescheduleJob(gomock.Any(), jUUID.String(), gomock.Any(), false).Return(
+		removalerrors.RemovalJobNotFound)
+
+	err := s.newService(c).RetryJob(c.Context(), jUUID)
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotFound)
+}
+
+func (s *serviceSuite) TestRetryJobInvalidUUID(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.newService(c).RetryJob(c.Context(), "not-a-uuid")
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobArgsInvalid)
+}
+
+func (s *serviceSuite) TestForceJob(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Now()
+	s.clock.EXPECT().Now().Return(now)
+
+	jUUID := tc.Must(c, removal.NewUUID)
+	s.modelState.EXPECT().RescheduleJob(gomock.Any(), jUUID.String(), now.UTC(), true).Return(nil)
+
+	err := s.newService(c).ForceJob(c.Context(), jUUID)
+	c.Assert(err, tc.ErrorIsNil)
+}
@@ -1052,6 +1052,7 @@ func (s *storageSuite) TestExecuteJobForStorageInstanceNotFound(c *tc.C) {
 
 func (s *storageSuite) TestExecuteJobForStorageInstanceStillAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newStorageInstanceJob(c)
 
@@ -1065,6 +1066,7 @@ func (s *storageSuite) TestExecuteJobForStorageInstanceStillAlive(c *tc.C) {
 
 func (s *storageSuite) TestExecuteJobForStorageInstanceHasChildren(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newStorageInstanceJob(c)
 
@@ -1248,6 +1250,7 @@ func (s *storageSuite) TestExecuteJobForFilesystemAttachmentNotFound(c *tc.C) {
 
 func (s *storageSuite) TestExecuteJobForFilesystemAttachmentStillAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newFilesystemAttachmentJob(c)
 
@@ -1261,6 +1264,7 @@ func (s *storageSuite) TestExecuteJobForFilesystemAttachmentStillAlive(c *tc.C)
 
 func (s *storageSuite) TestExecuteJobForFilesystemAttachmentDying(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newFilesystemAttachmentJob(c)
 
@@ -1335,6 +1339,7 @@ func (s *storageSuite) TestExecuteJobForVolumeAttachmentNotFound(c *tc.C) {
 
 func (s *storageSuite) TestExecuteJobForVolumeAttachmentStillAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newVolumeAttachmentJob(c)
 
@@ -1348,6 +1353,7 @@ func (s *storageSuite) TestExecuteJobForVolumeAttachmentStillAlive(c *tc.C) {
 
 func (s *storageSuite) TestExecuteJobForVolumeAttachmentDying(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newVolumeAttachmentJob(c)
 
@@ -1471,6 +1477,7 @@ func (s *storageSuite) TestExecuteJobForVolumeAttachmentPlanNotFound(c *tc.C) {
 
 func (s *storageSuite) TestExecuteJobForVolumeAttachmentPlanStillAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newVolumeAttachmentPlanJob(c)
 
@@ -1484,6 +1491,7 @@ func (s *storageSuite) TestExecuteJobForVolumeAttachmentPlanStillAlive(c *tc.C)
 
 func (s *storageSuite) TestExecuteJobForVolumeAttachmentPlanDying(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newVolumeAttachmentPlanJob(c)
 
@@ -1556,6 +1564,7 @@ func (s *storageSuite) TestExecuteJobForStorageAttachmentNotFound(c *tc.C) {
 
 func (s *storageSuite) TestExecuteJobForStorageAttachmentStillAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newStorageAttachmentJob(c)
 
@@ -1567,6 +1576,7 @@ func (s *storageSuite) TestExecuteJobForStorageAttachmentStillAlive(c *tc.C) {
 
 func (s *storageSuite) TestExecuteJobForStorageAttachmentDying(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newStorageAttachmentJob(c)
 
@@ -1721,6 +1731,7 @@ func (s *storageSuite) TestExecuteJobForStorageFilesystemNotFound(c *tc.C) {
 
 func (s *storageSuite) TestExecuteJobForStorageFilesystemStillAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newStorageFilesystemJob(c)
 
@@ -1733,6 +1744,7 @@ func (s *storageSuite) TestExecuteJobForStorageFilesystemStillAlive(c *tc.C) {
 
 func (s *storageSuite) TestExecuteJobForStorageFilesystemDying(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newStorageFilesystemJob(c)
 
@@ -1745,6 +1757,7 @@ func (s *storageSuite) TestExecuteJobForStorageFilesystemDying(c *tc.C) {
 
 func (s *storageSuite) TestExecuteJobForStorageFilesystemNotTombstone(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newStorageFilesystemJob(c)
 
@@ -1885,6 +1898,7 @@ func (s *storageSuite) TestExecuteJobForStorageVolumeNotFound(c *tc.C) {
 
 func (s *storageSuite) TestExecuteJobForStorageVolumeStillAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newStorageVolumeJob(c)
 
@@ -1897,6 +1911,7 @@ func (s *storageSuite) TestExecuteJobForStorageVolumeStillAlive(c *tc.C) {
 
 func (s *storageSuite) TestExecuteJobForStorageVolumeDying(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newStorageVolumeJob(c)
 
@@ -1909,6 +1924,7 @@ func (s *storageSuite) TestExecuteJobForStorageVolumeDying(c *tc.C) {
 
 func (s *storageSuite) TestExecuteJobForStorageVolumeNotTombstone(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newStorageVolumeJob(c)
 
@@ -310,6 +310,7 @@ func (s *unitSuite) TestExecuteJobForUnitNotFound(c *tc.C) {
 
 func (s *unitSuite) TestExecuteJobForUnitError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newUnitJob(c)
 
@@ -322,6 +323,7 @@ func (s *unitSuite) TestExecuteJobForUnitError(c *tc.C) {
 
 func (s *unitSuite) TestExecuteJobForUnitStillAlive(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newUnitJob(c)
 
@@ -502,6 +504,7 @@ func (s *unitSuite) TestExecuteJobWithForceForUnitDyingDeleteUnit(c *tc.C) {
 
 func (s *unitSuite) TestExecuteJobForUnitDeadDeleteUnitError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newUnitJob(c)
 
@@ -560,6 +563,7 @@ func (s *unitSuite) TestDeleteCharmForUnitFails(c *tc.C) {
 
 func (s *unitSuite) TestExecuteJobForUnitNotDeadError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newUnitJob(c)
 
@@ -573,6 +577,7 @@ func (s *unitSuite) TestExecuteJobForUnitNotDeadError(c *tc.C) {
 
 func (s *unitSuite) TestExecuteJobForUnitRevokingUnitError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
+	s.expectJobAttempt()
 
 	j := newUnitJob(c)
 
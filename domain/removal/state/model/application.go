@@ -94,6 +94,37 @@ func (st *State) EnsureApplicationNotAliveCascade(
 		return res, errors.Capture(err)
 	}
 
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var err error
+		res, err = st.ensureApplicationNotAliveCascade(ctx, tx, aUUID, destroyStorage)
+		return err
+	})
+	if err != nil {
+		return res, errors.Capture(err)
+	}
+
+	res.RelationUUIDs = dedupeStrings(res.RelationUUIDs)
+	res.UnitUUIDs = dedupeStrings(res.UnitUUIDs)
+	res.MachineUUIDs = dedupeStrings(res.MachineUUIDs)
+	res.StorageAttachmentUUIDs = dedupeStrings(res.StorageAttachmentUUIDs)
+	res.FileSystemAttachmentUUIDs = dedupeStrings(res.FileSystemAttachmentUUIDs)
+	res.VolumeAttachmentUUIDs = dedupeStrings(res.VolumeAttachmentUUIDs)
+	res.VolumeAttachmentPlanUUIDs = dedupeStrings(res.VolumeAttachmentPlanUUIDs)
+	res.FileSystemUUIDs = dedupeStrings(res.FileSystemUUIDs)
+	res.VolumeUUIDs = dedupeStrings(res.VolumeUUIDs)
+	res.StorageInstanceUUIDs = dedupeStrings(res.StorageInstanceUUIDs)
+
+	return res, nil
+}
+
+// ensureApplicationNotAliveCascade advances the life of the application
+// identified by the input UUID, along with its relations and units, within the
+// input transaction. See [EnsureApplicationNotAliveCascade].
+func (st *State) ensureApplicationNotAliveCascade(
+	ctx context.Context, tx *sqlair.TX, aUUID string, destroyStorage bool,
+) (internal.CascadedApplicationLives, error) {
+	var res internal.CascadedApplicationLives
+
 	applicationUUID := entityUUID{UUID: aUUID}
 	updateApplicationStmt, err := st.Prepare(`
 UPDATE application
@@ -138,71 +169,52 @@ AND    life_id < 2`, applicationUUID)
 		return res, errors.Errorf("preparing unit uuids query: %w", err)
 	}
 
-	if err := errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		res = internal.CascadedApplicationLives{}
+	if err := tx.Query(ctx, updateApplicationStmt, applicationUUID).Run(); err != nil {
+		return res, errors.Errorf("advancing application life: %w", err)
+	}
 
-		if err := tx.Query(ctx, updateApplicationStmt, applicationUUID).Run(); err != nil {
-			return errors.Errorf("advancing application life: %w", err)
-		}
+	var relationUUIDs []entityUUID
+	if err := tx.Query(
+		ctx, selectRelationUUIDsStmt, applicationUUID,
+	).GetAll(&relationUUIDs); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return res, errors.Errorf("selecting relation UUIDs: %w", err)
+	}
+	res.RelationUUIDs = transform.Slice(relationUUIDs, func(e entityUUID) string { return e.UUID })
 
-		var relationUUIDs []entityUUID
-		if err := tx.Query(
-			ctx, selectRelationUUIDsStmt, applicationUUID,
-		).GetAll(&relationUUIDs); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
-			return errors.Errorf("selecting relation UUIDs: %w", err)
+	if len(res.RelationUUIDs) > 0 {
+		if err := tx.Query(ctx, updateRelationStmt, uuids(res.RelationUUIDs)).Run(); err != nil {
+			return res, errors.Errorf("advancing relation life: %w", err)
 		}
-		res.RelationUUIDs = transform.Slice(relationUUIDs, func(e entityUUID) string { return e.UUID })
+	}
 
-		if len(res.RelationUUIDs) > 0 {
-			if err := tx.Query(ctx, updateRelationStmt, uuids(res.RelationUUIDs)).Run(); err != nil {
-				return errors.Errorf("advancing relation life: %w", err)
-			}
-		}
+	var unitUUIDsRec []entityUUID
+	if err := tx.Query(
+		ctx, selectUnitUUIDsStmt, applicationUUID,
+	).GetAll(&unitUUIDsRec); errors.Is(err, sqlair.ErrNoRows) {
+		// If there are no units associated with the application,
+		// we can just return nil, as there is nothing to update.
+		return res, nil
+	} else if err != nil {
+		return res, errors.Errorf("selecting associated application unit lives: %w", err)
+	}
 
-		var unitUUIDsRec []entityUUID
-		if err := tx.Query(
-			ctx, selectUnitUUIDsStmt, applicationUUID,
-		).GetAll(&unitUUIDsRec); errors.Is(err, sqlair.ErrNoRows) {
-			// If there are no units associated with the application,
-			// we can just return nil, as there is nothing to update.
-			return nil
-		} else if err != nil {
-			return errors.Errorf("selecting associated application unit lives: %w", err)
+	const checkEmptyMachine = true
+	res.UnitUUIDs = transform.Slice(unitUUIDsRec, func(e entityUUID) string { return e.UUID })
+	for _, u := range res.UnitUUIDs {
+		cascaded, err := st.ensureUnitNotAliveCascade(
+			ctx, tx, u, checkEmptyMachine, destroyStorage,
+		)
+		if err != nil {
+			return res, errors.Errorf("cascading unit %q life advancement: %w", u, err)
 		}
 
-		const checkEmptyMachine = true
-		res.UnitUUIDs = transform.Slice(unitUUIDsRec, func(e entityUUID) string { return e.UUID })
-		for _, u := range res.UnitUUIDs {
-			cascaded, err := st.ensureUnitNotAliveCascade(
-				ctx, tx, u, checkEmptyMachine, destroyStorage,
-			)
-			if err != nil {
-				return errors.Errorf("cascading unit %q life advancement: %w", u, err)
-			}
-
-			if cascaded.MachineUUID != nil {
-				res.MachineUUIDs = append(res.MachineUUIDs, *cascaded.MachineUUID)
-			}
-
-			res.CascadedStorageLives = res.CascadedStorageLives.Merge(cascaded.CascadedStorageLives)
+		if cascaded.MachineUUID != nil {
+			res.MachineUUIDs = append(res.MachineUUIDs, *cascaded.MachineUUID)
 		}
 
-		return nil
-	})); err != nil {
-		return res, errors.Capture(err)
+		res.CascadedStorageLives = res.CascadedStorageLives.Merge(cascaded.CascadedStorageLives)
 	}
 
-	res.RelationUUIDs = dedupeStrings(res.RelationUUIDs)
-	res.UnitUUIDs = dedupeStrings(res.UnitUUIDs)
-	res.MachineUUIDs = dedupeStrings(res.MachineUUIDs)
-	res.StorageAttachmentUUIDs = dedupeStrings(res.StorageAttachmentUUIDs)
-	res.FileSystemAttachmentUUIDs = dedupeStrings(res.FileSystemAttachmentUUIDs)
-	res.VolumeAttachmentUUIDs = dedupeStrings(res.VolumeAttachmentUUIDs)
-	res.VolumeAttachmentPlanUUIDs = dedupeStrings(res.VolumeAttachmentPlanUUIDs)
-	res.FileSystemUUIDs = dedupeStrings(res.FileSystemUUIDs)
-	res.VolumeUUIDs = dedupeStrings(res.VolumeUUIDs)
-	res.StorageInstanceUUIDs = dedupeStrings(res.StorageInstanceUUIDs)
-
 	return res, nil
 }
 
@@ -535,6 +547,9 @@ func (st *State) deleteSimpleApplicationReferences(ctx context.Context, tx *sqla
 		"DELETE FROM application_constraint WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_controller WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_setting WHERE application_uuid = $entityUUID.uuid",
+		"DELETE FROM application_placement_policy WHERE application_uuid = $entityUUID.uuid",
+		"DELETE FROM application_placement_affinity WHERE application_uuid = $entityUUID.uuid",
+		"DELETE FROM application_placement_affinity WHERE target_application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_exposed_endpoint_space WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_exposed_endpoint_cidr WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_endpoint WHERE application_uuid = $entityUUID.uuid",
@@ -0,0 +1,445 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"context"
+	"slices"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/collections/transform"
+
+	corerelation "github.com/juju/juju/core/relation"
+	"github.com/juju/juju/domain/deployment/charm"
+	"github.com/juju/juju/domain/removal"
+	"github.com/juju/juju/internal/errors"
+)
+
+// errPreviewRollback is returned from the transactions used to preview
+// removals. The preview walks the same cascade as the real removal, so
+// returning this error ensures that every life advancement is rolled back
+// once the affected entities have been observed.
+const errPreviewRollback = errors.ConstError("rolling back removal preview")
+
+// previewUUIDs holds the UUIDs of entities affected by a removal cascade,
+// prior to being resolved to their names.
+type previewUUIDs struct {
+	machines               []string
+	applications           []string
+	remoteApplications     []string
+	units                  []string
+	relations              []string
+	destroyedStorage       []string
+	storageAttachments     []string
+	includeOffersOfRemoved bool
+}
+
+// GetMachineRemovalPreview returns the entities that would be removed along
+// with the machine identified by the input UUID. The same cascade as
+// [State.EnsureMachineNotAliveCascade] is walked, but no change is persisted.
+func (st *State) GetMachineRemovalPreview(ctx context.Context, mUUID string, force bool) (removal.Preview, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return removal.Preview{}, errors.Capture(err)
+	}
+
+	var preview removal.Preview
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		cascaded, err := st.ensureMachineNotAliveCascade(ctx, tx, mUUID, force)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		preview, err = st.resolvePreview(ctx, tx, previewUUIDs{
+			machines:           append([]string{mUUID}, cascaded.MachineUUIDs...),
+			units:              cascaded.UnitUUIDs,
+			destroyedStorage:   cascaded.StorageInstanceUUIDs,
+			storageAttachments: cascaded.StorageAttachmentUUIDs,
+		})
+		if err != nil {
+			return errors.Capture(err)
+		}
+		return errPreviewRollback
+	})
+	if err != nil && !errors.Is(err, errPreviewRollback) {
+		return removal.Preview{}, errors.Capture(err)
+	}
+	return preview, nil
+}
+
+// GetApplicationRemovalPreview returns the entities that would be removed
+// along with the application identified by the input UUID. The same cascade
+// as [State.EnsureApplicationNotAliveCascade] is walked, but no change is
+// persisted.
+func (st *State) GetApplicationRemovalPreview(
+	ctx context.Context, aUUID string, destroyStorage bool,
+) (removal.Preview, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return removal.Preview{}, errors.Capture(err)
+	}
+
+	var preview removal.Preview
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		cascaded, err := st.ensureApplicationNotAliveCascade(ctx, tx, aUUID, destroyStorage)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		preview, err = st.resolvePreview(ctx, tx, previewUUIDs{
+			machines:               cascaded.MachineUUIDs,
+			applications:           []string{aUUID},
+			units:                  cascaded.UnitUUIDs,
+			relations:              cascaded.RelationUUIDs,
+			destroyedStorage:       cascaded.StorageInstanceUUIDs,
+			storageAttachments:     cascaded.StorageAttachmentUUIDs,
+			includeOffersOfRemoved: true,
+		})
+		if err != nil {
+			return errors.Capture(err)
+		}
+		return errPreviewRollback
+	})
+	if err != nil && !errors.Is(err, errPreviewRollback) {
+		return removal.Preview{}, errors.Capture(err)
+	}
+	return preview, nil
+}
+
+// GetRemoteApplicationOffererRemovalPreview returns the entities that would be
+// removed along with the remote application offerer identified by the input
+// UUID. The same cascade as
+// [State.EnsureRemoteApplicationOffererNotAliveCascade] is walked, but no
+// change is persisted.
+func (st *State) GetRemoteApplicationOffererRemovalPreview(
+	ctx context.Context, rUUID string,
+) (removal.Preview, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return removal.Preview{}, errors.Capture(err)
+	}
+
+	var preview removal.Preview
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		cascaded, err := st.ensureRemoteApplicationOffererNotAliveCascade(ctx, tx, rUUID)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		preview, err = st.resolvePreview(ctx, tx, previewUUIDs{
+			remoteApplications: []string{rUUID},
+			relations:          cascaded.RelationUUIDs,
+		})
+		if err != nil {
+			return errors.Capture(err)
+		}
+		return errPreviewRollback
+	})
+	if err != nil && !errors.Is(err, errPreviewRollback) {
+		return removal.Preview{}, errors.Capture(err)
+	}
+	return preview, nil
+}
+
+// GetModelRemovalPreview returns the entities that would be removed along
+// with the model. Model removal cascades to every entity in the model that is
+// not yet dead, and always destroys storage, so no change needs to be made
+// in order to determine the affected entities.
+func (st *State) GetModelRemovalPreview(ctx context.Context) (removal.Preview, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return removal.Preview{}, errors.Capture(err)
+	}
+
+	selectMachines, err := st.Prepare(`
+SELECT m.uuid AS &entityUUID.uuid
+FROM   machine AS m
+WHERE  m.life_id < 2`, entityUUID{})
+	if err != nil {
+		return removal.Preview{}, errors.Errorf("preparing select machines query: %w", err)
+	}
+
+	// Synthetic applications representing either side of a cross model
+	// relation are excluded here; remote offerers are reported separately.
+	selectApplications, err := st.Prepare(`
+SELECT a.uuid AS &entityUUID.uuid
+FROM   application AS a
+LEFT JOIN application_remote_offerer AS aro ON a.uuid = aro.application_uuid
+LEFT JOIN application_remote_consumer AS arc ON a.uuid = arc.offer_connection_uuid
+WHERE  a.life_id < 2
+AND    aro.uuid IS NULL
+AND    arc.offer_connection_uuid IS NULL`, entityUUID{})
+	if err != nil {
+		return removal.Preview{}, errors.Errorf("preparing select applications query: %w", err)
+	}
+
+	selectRemoteApplications, err := st.Prepare(`
+SELECT aro.uuid AS &entityUUID.uuid
+FROM   application_remote_offerer AS aro
+WHERE  aro.life_id < 2`, entityUUID{})
+	if err != nil {
+		return removal.Preview{}, errors.Errorf("preparing select remote applications query: %w", err)
+	}
+
+	selectUnits, err := st.Prepare(`
+SELECT u.uuid AS &entityUUID.uuid
+FROM   unit AS u
+WHERE  u.life_id < 2`, entityUUID{})
+	if err != nil {
+		return removal.Preview{}, errors.Errorf("preparing select units query: %w", err)
+	}
+
+	selectRelations, err := st.Prepare(`
+SELECT r.uuid AS &entityUUID.uuid
+FROM   relation AS r
+WHERE  r.life_id < 2`, entityUUID{})
+	if err != nil {
+		return removal.Preview{}, errors.Errorf("preparing select relations query: %w", err)
+	}
+
+	selectStorage, err := st.Prepare(`
+SELECT s.uuid AS &entityUUID.uuid
+FROM   storage_instance AS s
+WHERE  s.life_id < 2`, entityUUID{})
+	if err != nil {
+		return removal.Preview{}, errors.Errorf("preparing select storage instances query: %w", err)
+	}
+
+	// Every secret owned by the model, or by its applications and units,
+	// is removed with it.
+	selectSecrets, err := st.Prepare(`
+SELECT sm.secret_id AS &entityName.name
+FROM   secret_metadata AS sm`, entityName{})
+	if err != nil {
+		return removal.Preview{}, errors.Errorf("preparing select secrets query: %w", err)
+	}
+
+	var preview removal.Preview
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		selectAll := func(stmt *sqlair.Statement) ([]string, error) {
+			var res entityUUIDs
+			if err := tx.Query(ctx, stmt).GetAll(&res); errors.Is(err, sqlair.ErrNoRows) {
+				return nil, nil
+			} else if err != nil {
+				return nil, errors.Capture(err)
+			}
+			return res.uuids(), nil
+		}
+
+		var (
+			uuids previewUUIDs
+			err   error
+		)
+		if uuids.machines, err = selectAll(selectMachines); err != nil {
+			return errors.Errorf("selecting machines: %w", err)
+		}
+		if uuids.applications, err = selectAll(selectApplications); err != nil {
+			return errors.Errorf("selecting applications: %w", err)
+		}
+		if uuids.remoteApplications, err = selectAll(selectRemoteApplications); err != nil {
+			return errors.Errorf("selecting remote applications: %w", err)
+		}
+		if uuids.units, err = selectAll(selectUnits); err != nil {
+			return errors.Errorf("selecting units: %w", err)
+		}
+		if uuids.relations, err = selectAll(selectRelations); err != nil {
+			return errors.Errorf("selecting relations: %w", err)
+		}
+		if uuids.destroyedStorage, err = selectAll(selectStorage); err != nil {
+			return errors.Errorf("selecting storage instances: %w", err)
+		}
+		uuids.includeOffersOfRemoved = true
+
+		preview, err = st.resolvePreview(ctx, tx, uuids)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		var secrets []entityName
+		if err := tx.Query(ctx, selectSecrets).GetAll(&secrets); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("selecting secrets: %w", err)
+		}
+		for _, secret := range secrets {
+			preview.Secrets = append(preview.Secrets, secret.Name)
+		}
+		slices.Sort(preview.Secrets)
+		return nil
+	})
+	if err != nil {
+		return removal.Preview{}, errors.Capture(err)
+	}
+	return preview, nil
+}
+
+// resolvePreview converts the UUIDs of entities affected by a removal into
+// the names by which they are known to users.
+func (st *State) resolvePreview(ctx context.Context, tx *sqlair.TX, in previewUUIDs) (removal.Preview, error) {
+	var (
+		preview removal.Preview
+		err     error
+	)
+
+	if preview.Machines, err = st.namesForUUIDs(ctx, tx, `
+SELECT m.name AS &entityName.name
+FROM   machine AS m
+WHERE  m.uuid IN ($uuids[:])`, in.machines); err != nil {
+		return preview, errors.Errorf("getting machine names: %w", err)
+	}
+
+	if preview.Applications, err = st.namesForUUIDs(ctx, tx, `
+SELECT a.name AS &entityName.name
+FROM   application AS a
+WHERE  a.uuid IN ($uuids[:])`, in.applications); err != nil {
+		return preview, errors.Errorf("getting application names: %w", err)
+	}
+
+	if preview.RemoteApplications, err = st.namesForUUIDs(ctx, tx, `
+SELECT a.name AS &entityName.name
+FROM   application_remote_offerer AS aro
+JOIN   application AS a ON aro.application_uuid = a.uuid
+WHERE  aro.uuid IN ($uuids[:])`, in.remoteApplications); err != nil {
+		return preview, errors.Errorf("getting remote application names: %w", err)
+	}
+
+	if preview.Units, err = st.namesForUUIDs(ctx, tx, `
+SELECT u.name AS &entityName.name
+FROM   unit AS u
+WHERE  u.uuid IN ($uuids[:])`, in.units); err != nil {
+		return preview, errors.Errorf("getting unit names: %w", err)
+	}
+
+	if in.includeOffersOfRemoved {
+		if preview.Offers, err = st.namesForUUIDs(ctx, tx, `
+SELECT DISTINCT o.name AS &entityName.name
+FROM   offer AS o
+JOIN   offer_endpoint AS oe ON o.uuid = oe.offer_uuid
+JOIN   application_endpoint AS ae ON oe.endpoint_uuid = ae.uuid
+WHERE  ae.application_uuid IN ($uuids[:])`, in.applications); err != nil {
+			return preview, errors.Errorf("getting offer names: %w", err)
+		}
+	}
+
+	if preview.DestroyedStorage, err = st.namesForUUIDs(ctx, tx, `
+SELECT s.storage_id AS &entityName.name
+FROM   storage_instance AS s
+WHERE  s.uuid IN ($uuids[:])`, in.destroyedStorage); err != nil {
+		return preview, errors.Errorf("getting destroyed storage IDs: %w", err)
+	}
+
+	detached, err := st.namesForUUIDs(ctx, tx, `
+SELECT DISTINCT s.storage_id AS &entityName.name
+FROM   storage_attachment AS sa
+JOIN   storage_instance AS s ON sa.storage_instance_uuid = s.uuid
+WHERE  sa.uuid IN ($uuids[:])`, in.storageAttachments)
+	if err != nil {
+		return preview, errors.Errorf("getting detached storage IDs: %w", err)
+	}
+	for _, id := range detached {
+		if !slices.Contains(preview.DestroyedStorage, id) {
+			preview.DetachedStorage = append(preview.DetachedStorage, id)
+		}
+	}
+
+	if preview.Relations, err = st.relationKeysForUUIDs(ctx, tx, in.relations); err != nil {
+		return preview, errors.Errorf("getting relation keys: %w", err)
+	}
+
+	return preview, nil
+}
+
+// namesForUUIDs runs the input query, which must accept a $uuids[:] slice
+// and return &entityName.name, for the input UUIDs. The result is sorted.
+func (st *State) namesForUUIDs(ctx context.Context, tx *sqlair.TX, query string, in []string) ([]string, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	stmt, err := st.Prepare(query, uuids{}, entityName{})
+	if err != nil {
+		return nil, errors.Errorf("preparing names query: %w", err)
+	}
+
+	var names []entityName
+	if err := tx.Query(ctx, stmt, uuids(in)).GetAll(&names); errors.Is(err, sqlair.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Errorf("running names query: %w", err)
+	}
+
+	res := transform.Slice(names, func(n entityName) string { return n.Name })
+	slices.Sort(res)
+	return res, nil
+}
+
+// relationKeysForUUIDs returns the natural keys of the relations identified
+// by the input UUIDs. The result is sorted.
+func (st *State) relationKeysForUUIDs(ctx context.Context, tx *sqlair.TX, in []string) ([]string, error) {
+	byRelation, err := st.relationKeysByUUID(ctx, tx, in)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if len(byRelation) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(byRelation))
+	for _, key := range byRelation {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+// relationKeysByUUID returns the natural keys of the relations identified by
+// the input UUIDs, keyed by relation UUID.
+func (st *State) relationKeysByUUID(ctx context.Context, tx *sqlair.TX, in []string) (map[string]string, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	stmt, err := st.Prepare(`
+SELECT re.relation_uuid AS &previewRelationEndpoint.relation_uuid,
+       re.application_name AS &previewRelationEndpoint.application_name,
+       re.endpoint_name AS &previewRelationEndpoint.endpoint_name,
+       re.role AS &previewRelationEndpoint.role
+FROM   v_relation_endpoint AS re
+WHERE  re.relation_uuid IN ($uuids[:])`, uuids{}, previewRelationEndpoint{})
+	if err != nil {
+		return nil, errors.Errorf("preparing relation endpoints query: %w", err)
+	}
+
+	var endpoints []previewRelationEndpoint
+	if err := tx.Query(ctx, stmt, uuids(in)).GetAll(&endpoints); errors.Is(err, sqlair.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Errorf("running relation endpoints query: %w", err)
+	}
+
+	// Keys are composed of the requirer endpoint followed by the provider,
+	// or of the single peer endpoint.
+	byRelation := make(map[string]corerelation.Key)
+	for _, ep := range endpoints {
+		byRelation[ep.RelationUUID] = append(byRelation[ep.RelationUUID], corerelation.EndpointIdentifier{
+			ApplicationName: ep.ApplicationName,
+			EndpointName:    ep.EndpointName,
+			Role:            charm.RelationRole(ep.Role),
+		})
+	}
+	keys := make(map[string]string, len(byRelation))
+	for relUUID, key := range byRelation {
+		slices.SortFunc(key, func(a, b corerelation.EndpointIdentifier) int {
+			return rolePosition(a.Role) - rolePosition(b.Role)
+		})
+		keys[relUUID] = key.String()
+	}
+	return keys, nil
+}
+
+// rolePosition returns the position of an endpoint with the input role within
+// a relation key.
+func rolePosition(role charm.RelationRole) int {
+	if role == charm.RoleRequirer {
+		return 0
+	}
+	return 1
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/instance"
+	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/life"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type previewSuite struct {
+	baseSuite
+}
+
+func TestPreviewSuite(t *testing.T) {
+	tc.Run(t, &previewSuite{})
+}
+
+func (s *previewSuite) TestGetMachineRemovalPreview(c *tc.C) {
+	svc := s.setupApplicationService(c)
+	appUUID := s.createIAASApplication(c, svc, "some-app",
+		applicationservice.AddIAASUnitArg{},
+		applicationservice.AddIAASUnitArg{
+			AddUnitArg: applicationservice.AddUnitArg{
+				Placement: instance.MustParsePlacement("lxd:0"),
+			},
+		})
+	unitUUIDs := s.getAllUnitUUIDs(c, appUUID)
+	c.Assert(unitUUIDs, tc.HasLen, 2)
+	machineUUID := s.getUnitMachineUUID(c, unitUUIDs[0])
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	preview, err := st.GetMachineRemovalPreview(c.Context(), machineUUID.String(), true)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(preview.Machines, tc.DeepEquals, []string{"0", "0/lxd/0"})
+	c.Check(preview.Units, tc.DeepEquals, []string{"some-app/0", "some-app/1"})
+	c.Check(preview.Applications, tc.HasLen, 0)
+
+	// Nothing may have changed as a result of the preview.
+	s.checkMachineLife(c, machineUUID.String(), life.Alive)
+	s.checkInstanceLife(c, machineUUID.String(), life.Alive)
+	s.checkUnitLife(c, unitUUIDs[0].String(), life.Alive)
+	s.checkUnitLife(c, unitUUIDs[1].String(), life.Alive)
+}
+
+func (s *previewSuite) TestGetMachineRemovalPreviewWithoutForce(c *tc.C) {
+	svc := s.setupApplicationService(c)
+	appUUID := s.createIAASApplication(c, svc, "some-app", applicationservice.AddIAASUnitArg{})
+	machineUUID := s.getMachineUUIDFromApp(c, appUUID)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	_, err := st.GetMachineRemovalPreview(c.Context(), machineUUID.String(), false)
+	c.Assert(err, tc.ErrorIs, removalerrors.MachineHasUnits)
+}
+
+func (s *previewSuite) TestGetApplicationRemovalPreview(c *tc.C) {
+	svc := s.setupApplicationService(c)
+	appUUID := s.createIAASApplication(c, svc, "some-app", applicationservice.AddIAASUnitArg{})
+	machineUUID := s.getMachineUUIDFromApp(c, appUUID)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	preview, err := st.GetApplicationRemovalPreview(c.Context(), appUUID.String(), false)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(preview.Applications, tc.DeepEquals, []string{"some-app"})
+	c.Check(preview.Units, tc.DeepEquals, []string{"some-app/0"})
+	c.Check(preview.Machines, tc.DeepEquals, []string{"0"})
+
+	s.checkApplicationLife(c, appUUID.String(), life.Alive)
+	s.checkMachineLife(c, machineUUID.String(), life.Alive)
+}
+
+func (s *previewSuite) TestGetModelRemovalPreview(c *tc.C) {
+	svc := s.setupApplicationService(c)
+	s.createIAASApplication(c, svc, "foo", applicationservice.AddIAASUnitArg{})
+	s.createIAASApplication(c, svc, "bar", applicationservice.AddIAASUnitArg{})
+
+	now := time.Now().UTC()
+	_, err := s.DB().Exec(`INSERT INTO secret (id) VALUES (?)`, "secret-id")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.DB().Exec(`
+INSERT INTO secret_metadata (secret_id, version, rotate_policy_id, create_time, update_time)
+VALUES (?, ?, ?, ?, ?)`, "secret-id", 1, 0, now, now)
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	preview, err := st.GetModelRemovalPreview(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(preview.Applications, tc.DeepEquals, []string{"bar", "foo"})
+	c.Check(preview.Units, tc.DeepEquals, []string{"bar/0", "foo/0"})
+	c.Check(preview.Machines, tc.DeepEquals, []string{"0", "1"})
+	c.Check(preview.Secrets, tc.DeepEquals, []string{"secret-id"})
+}
+
+func (s *previewSuite) TestGetModelRemovalPreviewEmpty(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	preview, err := st.GetModelRemovalPreview(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(preview.Empty(), tc.IsTrue)
+}
@@ -98,6 +98,27 @@ func (st *State) EnsureRemoteApplicationOffererNotAliveCascade(
 		return res, errors.Capture(err)
 	}
 
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var err error
+		res, err = st.ensureRemoteApplicationOffererNotAliveCascade(ctx, tx, rUUID)
+		return err
+	})
+	if err != nil {
+		return res, errors.Capture(err)
+	}
+
+	return res, nil
+}
+
+// ensureRemoteApplicationOffererNotAliveCascade advances the life of the
+// remote application offerer identified by the input UUID, along with its
+// relations, within the input transaction.
+// See [EnsureRemoteApplicationOffererNotAliveCascade].
+func (st *State) ensureRemoteApplicationOffererNotAliveCascade(
+	ctx context.Context, tx *sqlair.TX, rUUID string,
+) (internal.CascadedRemoteApplicationOffererLives, error) {
+	var res internal.CascadedRemoteApplicationOffererLives
+
 	remoteAppOffererUUID := entityUUID{UUID: rUUID}
 	updateRemoteAppOffererStmt, err := st.Prepare(`
 UPDATE application_remote_offerer
@@ -134,29 +155,21 @@ AND    life_id = 0`, uuids{})
 		return res, errors.Errorf("preparing relation life update: %w", err)
 	}
 
-	if err := errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		res = internal.CascadedRemoteApplicationOffererLives{}
-
-		if err := tx.Query(ctx, updateRemoteAppOffererStmt, remoteAppOffererUUID).Run(); err != nil {
-			return errors.Errorf("advancing remote application offerer life: %w", err)
-		}
+	if err := tx.Query(ctx, updateRemoteAppOffererStmt, remoteAppOffererUUID).Run(); err != nil {
+		return res, errors.Errorf("advancing remote application offerer life: %w", err)
+	}
 
-		var relationUUIDs []entityUUID
-		err := tx.Query(ctx, selectRelationUUIDsStmt, remoteAppOffererUUID).GetAll(&relationUUIDs)
-		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
-			return errors.Errorf("selecting relation UUIDs: %w", err)
-		}
-		res.RelationUUIDs = transform.Slice(relationUUIDs, func(e entityUUID) string { return e.UUID })
+	var relationUUIDs []entityUUID
+	err = tx.Query(ctx, selectRelationUUIDsStmt, remoteAppOffererUUID).GetAll(&relationUUIDs)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return res, errors.Errorf("selecting relation UUIDs: %w", err)
+	}
+	res.RelationUUIDs = transform.Slice(relationUUIDs, func(e entityUUID) string { return e.UUID })
 
-		if len(res.RelationUUIDs) > 0 {
-			if err := tx.Query(ctx, updateRelationStmt, uuids(res.RelationUUIDs)).Run(); err != nil {
-				return errors.Errorf("advancing relation life: %w", err)
-			}
+	if len(res.RelationUUIDs) > 0 {
+		if err := tx.Query(ctx, updateRelationStmt, uuids(res.RelationUUIDs)).Run(); err != nil {
+			return res, errors.Errorf("advancing relation life: %w", err)
 		}
-
-		return nil
-	})); err != nil {
-		return res, errors.Capture(err)
 	}
 
 	return res, nil
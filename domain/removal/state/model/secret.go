@@ -287,6 +287,7 @@ func (st *State) prepareSecretDeletions() ([]*sqlair.Statement, []*sqlair.Statem
 		"DELETE FROM secret_deleted_value_ref WHERE revision_uuid IN ($uuids[:])",
 		"DELETE FROM secret_revision_obsolete WHERE revision_uuid IN ($uuids[:])",
 		"DELETE FROM secret_revision_expire WHERE revision_uuid IN ($uuids[:])",
+		"DELETE FROM secret_revision_external_version WHERE revision_uuid IN ($uuids[:])",
 	}
 	rdStmts := make([]*sqlair.Statement, len(rds))
 	for i, q := range rds {
@@ -302,10 +303,13 @@ func (st *State) prepareSecretDeletions() ([]*sqlair.Statement, []*sqlair.Statem
 		"DELETE FROM secret_unit_consumer WHERE secret_id IN ($uuids[:])",
 		"DELETE FROM secret_remote_unit_consumer WHERE secret_id IN ($uuids[:])",
 		"DELETE FROM secret_rotation WHERE secret_id IN ($uuids[:])",
+		"DELETE FROM secret_generator WHERE secret_id IN ($uuids[:])",
+		"DELETE FROM secret_revision_access WHERE secret_id IN ($uuids[:])",
 		"DELETE FROM secret_reference WHERE secret_id IN ($uuids[:])",
 		"DELETE FROM secret_permission WHERE secret_id IN ($uuids[:])",
 		"DELETE FROM secret_application_owner WHERE secret_id IN ($uuids[:])",
 		"DELETE FROM secret_unit_owner WHERE secret_id IN ($uuids[:])",
+		"DELETE FROM secret_external_ref WHERE secret_id IN ($uuids[:])",
 		"DELETE FROM secret_metadata WHERE secret_id IN ($uuids[:])",
 		"DELETE FROM secret WHERE id IN ($uuids[:])",
 	}
@@ -455,6 +459,7 @@ FROM secret_value_ref
 WHERE revision_uuid IN ($uuids[:])`,
 		`DELETE FROM secret_value_ref WHERE revision_uuid IN ($uuids[:])`,
 		`DELETE FROM secret_revision_obsolete WHERE revision_uuid IN ($uuids[:])`,
+		`DELETE FROM secret_revision_external_version WHERE revision_uuid IN ($uuids[:])`,
 		`DELETE FROM secret_revision WHERE uuid IN ($uuids[:])`,
 	}
 
@@ -494,6 +499,8 @@ WHERE revision_uuid IN ($uuids[:])`,
 	// No revisions remain, delete the secret and all related records.
 	deleteSecretQueries := []string{
 		`DELETE FROM secret_rotation WHERE secret_id = $secretID.secret_id`,
+		`DELETE FROM secret_generator WHERE secret_id = $secretID.secret_id`,
+		`DELETE FROM secret_revision_access WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_unit_owner WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_application_owner WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_model_owner WHERE secret_id = $secretID.secret_id`,
@@ -501,6 +508,7 @@ WHERE revision_uuid IN ($uuids[:])`,
 		`DELETE FROM secret_remote_unit_consumer WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_reference WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_permission WHERE secret_id = $secretID.secret_id`,
+		`DELETE FROM secret_external_ref WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_metadata WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret WHERE id = $secretID.secret_id`,
 	}
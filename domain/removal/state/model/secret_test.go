@@ -182,6 +182,60 @@ func (s *secretSuite) assertDeleteAllRevisions(c *tc.C, revs []int) {
 	s.checkCount(c, "secret", 0)
 }
 
+func (s *secretSuite) TestDeleteExternalSecretRevisions(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	ctx := c.Context()
+
+	uri := coresecrets.NewURI()
+	_, err := s.DB().ExecContext(ctx, "INSERT INTO secret VALUES (?)", uri.ID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	q := `
+INSERT INTO secret_metadata (secret_id, version, rotate_policy_id, auto_prune, create_time, update_time)
+VALUES (?, ?, ?, ?, ?, ?)`
+	_, err = s.DB().ExecContext(ctx, q, uri.ID, 1, 0, false, s.now, s.now)
+	c.Assert(err, tc.ErrorIsNil)
+
+	q = "INSERT INTO secret_model_owner (secret_id) VALUES (?)"
+	_, err = s.DB().ExecContext(ctx, q, uri.ID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	q = "INSERT INTO secret_external_ref (secret_id, backend_uuid, path) VALUES (?, ?, ?)"
+	_, err = s.DB().ExecContext(ctx, q, uri.ID, "backend-uuid", "kv/app/db")
+	c.Assert(err, tc.ErrorIsNil)
+
+	for rev := 1; rev <= 2; rev++ {
+		revUUID := "revision_id_" + strconv.Itoa(rev)
+
+		q := "INSERT INTO secret_revision (uuid, secret_id, revision, create_time, update_time) VALUES (?, ?, ?, ?, ?)"
+		_, err := s.DB().ExecContext(ctx, q, revUUID, uri.ID, rev, s.now, s.now)
+		c.Assert(err, tc.ErrorIsNil)
+
+		q = "INSERT INTO secret_revision_external_version (revision_uuid, version) VALUES (?, ?)"
+		_, err = s.DB().ExecContext(ctx, q, revUUID, strconv.Itoa(rev))
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	deleted, err := st.DeleteSecretRevisions(ctx, uri, []int{1})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(deleted, tc.DeepEquals, []string{"revision_id_1"})
+
+	s.checkCount(c, "secret_revision", 1)
+	s.checkCount(c, "secret_revision_external_version", 1)
+	s.checkCount(c, "secret_external_ref", 1)
+
+	deleted, err = st.DeleteSecretRevisions(ctx, uri, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(deleted, tc.DeepEquals, []string{"revision_id_2"})
+
+	s.checkCount(c, "secret_revision", 0)
+	s.checkCount(c, "secret_revision_external_version", 0)
+	s.checkCount(c, "secret_external_ref", 0)
+	s.checkCount(c, "secret_metadata", 0)
+	s.checkCount(c, "secret", 0)
+}
+
 func (s *secretSuite) checkCount(c *tc.C, table string, expected int) {
 	row := s.DB().QueryRowContext(c.Context(), "SELECT count(*) FROM "+table)
 	var count int
@@ -5,7 +5,9 @@ package model
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/canonical/sqlair"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/juju/juju/core/watcher/eventsource"
 	"github.com/juju/juju/domain"
 	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
 	"github.com/juju/juju/internal/errors"
 )
 
@@ -38,14 +41,24 @@ func (st *State) GetAllJobs(ctx context.Context) ([]removal.Job, error) {
 		return nil, errors.Capture(err)
 	}
 
-	stmt, err := st.Prepare("SELECT &removalJob.* FROM removal", removalJob{})
+	stmt, err := st.Prepare(`
+SELECT    r.* AS &removalJob.*,
+          r.uuid AS &removalJobAttempt.removal_uuid,
+          COALESCE(ra.attempts, 0) AS &removalJobAttempt.attempts,
+          ra.last_error AS &removalJobAttempt.last_error,
+          ra.last_attempted_at AS &removalJobAttempt.last_attempted_at
+FROM      removal AS r
+LEFT JOIN removal_attempt AS ra ON r.uuid = ra.removal_uuid`, removalJob{}, removalJobAttempt{})
 	if err != nil {
 		return nil, errors.Errorf("preparing select jobs query: %w", err)
 	}
 
-	var dbJobs []removalJob
+	var (
+		dbJobs     []removalJob
+		dbAttempts []removalJobAttempt
+	)
 	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		err = tx.Query(ctx, stmt).GetAll(&dbJobs)
+		err = tx.Query(ctx, stmt).GetAll(&dbJobs, &dbAttempts)
 		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return errors.Errorf("running select jobs query: %w", err)
 		}
@@ -76,12 +89,162 @@ func (st *State) GetAllJobs(ctx context.Context) ([]removal.Job, error) {
 			Force:        job.Force,
 			ScheduledFor: job.ScheduledFor,
 			Arg:          arg,
+			Attempts:     dbAttempts[i].Attempts,
+			LastError:    dbAttempts[i].LastError.String,
+		}
+		if dbAttempts[i].LastAttemptedAt.Valid {
+			jobs[i].LastAttemptedAt = &dbAttempts[i].LastAttemptedAt.Time
 		}
 	}
 
 	return jobs, err
 }
 
+// entityNameQueries are the queries used to resolve the names of the
+// entities being removed by removal jobs. Each query returns the UUID of the
+// removal job along with the name of the entity that it removes. Relation
+// and secret jobs are handled separately.
+var entityNameQueries = []string{
+	`
+SELECT r.uuid AS &removalEntityName.removal_uuid, u.name AS &removalEntityName.name
+FROM   removal AS r
+JOIN   unit AS u ON r.entity_uuid = u.uuid
+WHERE  r.removal_type_id = 1`,
+	`
+SELECT r.uuid AS &removalEntityName.removal_uuid, a.name AS &removalEntityName.name
+FROM   removal AS r
+JOIN   application AS a ON r.entity_uuid = a.uuid
+WHERE  r.removal_type_id = 2`,
+	`
+SELECT r.uuid AS &removalEntityName.removal_uuid, m.name AS &removalEntityName.name
+FROM   removal AS r
+JOIN   machine AS m ON r.entity_uuid = m.uuid
+WHERE  r.removal_type_id = 3`,
+	`
+SELECT r.uuid AS &removalEntityName.removal_uuid, m.name AS &removalEntityName.name
+FROM   removal AS r
+JOIN   model AS m ON r.entity_uuid = m.uuid
+WHERE  r.removal_type_id IN (4, 15)`,
+	`
+SELECT r.uuid AS &removalEntityName.removal_uuid, s.storage_id AS &removalEntityName.name
+FROM   removal AS r
+JOIN   storage_instance AS s ON r.entity_uuid = s.uuid
+WHERE  r.removal_type_id = 5`,
+	`
+SELECT r.uuid AS &removalEntityName.removal_uuid, s.storage_id AS &removalEntityName.name
+FROM   removal AS r
+JOIN   storage_attachment AS sa ON r.entity_uuid = sa.uuid
+JOIN   storage_instance AS s ON sa.storage_instance_uuid = s.uuid
+WHERE  r.removal_type_id = 6`,
+	`
+SELECT r.uuid AS &removalEntityName.removal_uuid, sv.volume_id AS &removalEntityName.name
+FROM   removal AS r
+JOIN   storage_volume AS sv ON r.entity_uuid = sv.uuid
+WHERE  r.removal_type_id = 7`,
+	`
+SELECT r.uuid AS &removalEntityName.removal_uuid, sf.filesystem_id AS &removalEntityName.name
+FROM   removal AS r
+JOIN   storage_filesystem AS sf ON r.entity_uuid = sf.uuid
+WHERE  r.removal_type_id = 8`,
+	`
+SELECT r.uuid AS &removalEntityName.removal_uuid, sv.volume_id AS &removalEntityName.name
+FROM   removal AS r
+JOIN   storage_volume_attachment AS sva ON r.entity_uuid = sva.uuid
+JOIN   storage_volume AS sv ON sva.storage_volume_uuid = sv.uuid
+WHERE  r.removal_type_id = 9`,
+	`
+SELECT r.uuid AS &removalEntityName.removal_uuid, sv.volume_id AS &removalEntityName.name
+FROM   removal AS r
+JOIN   storage_volume_attachment_plan AS svap ON r.entity_uuid = svap.uuid
+JOIN   storage_volume AS sv ON svap.storage_volume_uuid = sv.uuid
+WHERE  r.removal_type_id = 10`,
+	`
+SELECT r.uuid AS &removalEntityName.removal_uuid, sf.filesystem_id AS &removalEntityName.name
+FROM   removal AS r
+JOIN   storage_filesystem_attachment AS sfa ON r.entity_uuid = sfa.uuid
+JOIN   storage_filesystem AS sf ON sfa.storage_filesystem_uuid = sf.uuid
+WHERE  r.removal_type_id = 11`,
+	`
+SELECT r.uuid AS &removalEntityName.removal_uuid, a.name AS &removalEntityName.name
+FROM   removal AS r
+JOIN   application_remote_offerer AS aro ON r.entity_uuid = aro.uuid
+JOIN   application AS a ON aro.application_uuid = a.uuid
+WHERE  r.removal_type_id = 12`,
+	// Secret removal jobs identify the secret by its URI.
+	`
+SELECT r.uuid AS &removalEntityName.removal_uuid, r.entity_uuid AS &removalEntityName.name
+FROM   removal AS r
+WHERE  r.removal_type_id IN (16, 18)`,
+}
+
+// GetJobEntityNames returns the names by which the entities being removed by
+// the scheduled removal jobs are known to users, keyed by removal job UUID.
+// Jobs for entities that no longer exist, or that have no such name, are
+// omitted.
+func (st *State) GetJobEntityNames(ctx context.Context) (map[string]string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmts := make([]*sqlair.Statement, len(entityNameQueries))
+	for i, query := range entityNameQueries {
+		if stmts[i], err = st.Prepare(query, removalEntityName{}); err != nil {
+			return nil, errors.Errorf("preparing entity name query: %w", err)
+		}
+	}
+
+	relationStmt, err := st.Prepare(`
+SELECT &removalJob.*
+FROM   removal
+WHERE  removal_type_id IN (0, 13, 14)`, removalJob{})
+	if err != nil {
+		return nil, errors.Errorf("preparing relation jobs query: %w", err)
+	}
+
+	result := make(map[string]string)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		for _, stmt := range stmts {
+			var names []removalEntityName
+			if err := tx.Query(ctx, stmt).GetAll(&names); errors.Is(err, sqlair.ErrNoRows) {
+				continue
+			} else if err != nil {
+				return errors.Errorf("running entity name query: %w", err)
+			}
+			for _, n := range names {
+				result[n.RemovalUUID] = n.Name
+			}
+		}
+
+		// Relations are known by their keys, which are composed from their
+		// endpoints.
+		var relationJobs []removalJob
+		if err := tx.Query(ctx, relationStmt).GetAll(&relationJobs); errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("running relation jobs query: %w", err)
+		}
+		relUUIDs := make([]string, len(relationJobs))
+		for i, job := range relationJobs {
+			relUUIDs[i] = job.EntityUUID
+		}
+		keys, err := st.relationKeysByUUID(ctx, tx, relUUIDs)
+		if err != nil {
+			return errors.Errorf("getting relation keys: %w", err)
+		}
+		for _, job := range relationJobs {
+			if key, ok := keys[job.EntityUUID]; ok {
+				result[job.UUID] = key
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return result, nil
+}
+
 // DeleteJob ensures that a job with the input
 // UUID is not present in the removal table.
 func (st *State) DeleteJob(ctx context.Context, jUUID string) error {
@@ -92,12 +255,20 @@ func (st *State) DeleteJob(ctx context.Context, jUUID string) error {
 
 	jobUUID := entityUUID{UUID: jUUID}
 
+	attemptStmt, err := st.Prepare("DELETE FROM removal_attempt WHERE removal_uuid=$entityUUID.uuid", jobUUID)
+	if err != nil {
+		return errors.Errorf("preparing job attempt deletion: %w", err)
+	}
+
 	stmt, err := st.Prepare("DELETE FROM removal WHERE uuid=$entityUUID.uuid", jobUUID)
 	if err != nil {
 		return errors.Errorf("preparing job deletion: %w", err)
 	}
 
 	return errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, attemptStmt, jobUUID).Run(); err != nil {
+			return errors.Errorf("deleting removal attempt row: %w", err)
+		}
 		if err := tx.Query(ctx, stmt, jobUUID).Run(); err != nil {
 			return errors.Errorf("deleting removal row: %w", err)
 		}
@@ -105,6 +276,92 @@ func (st *State) DeleteJob(ctx context.Context, jUUID string) error {
 	}))
 }
 
+// RecordJobAttempt records an unsuccessful attempt to execute the removal job
+// with the input UUID, along with a description of the reason that it did not
+// complete. If the job no longer exists, nothing is recorded.
+func (st *State) RecordJobAttempt(ctx context.Context, jUUID, reason string, at time.Time) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	jobUUID := entityUUID{UUID: jUUID}
+	attempt := removalJobAttempt{
+		RemovalUUID:     jUUID,
+		Attempts:        1,
+		LastError:       sql.NullString{String: reason, Valid: true},
+		LastAttemptedAt: sql.NullTime{Time: at, Valid: true},
+	}
+
+	existsStmt, err := st.Prepare("SELECT &entityUUID.* FROM removal WHERE uuid = $entityUUID.uuid", jobUUID)
+	if err != nil {
+		return errors.Errorf("preparing job existence query: %w", err)
+	}
+
+	upsertStmt, err := st.Prepare(`
+INSERT INTO removal_attempt (*) VALUES ($removalJobAttempt.*)
+ON CONFLICT (removal_uuid) DO UPDATE SET
+    attempts = attempts + 1,
+    last_error = excluded.last_error,
+    last_attempted_at = excluded.last_attempted_at`, attempt)
+	if err != nil {
+		return errors.Errorf("preparing job attempt upsert: %w", err)
+	}
+
+	return errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, existsStmt, jobUUID).Get(&jobUUID)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("checking removal job existence: %w", err)
+		}
+
+		if err := tx.Query(ctx, upsertStmt, attempt).Run(); err != nil {
+			return errors.Errorf("recording removal job attempt: %w", err)
+		}
+		return nil
+	}))
+}
+
+// RescheduleJob sets the removal job with the input UUID to be executed at the
+// input time. If force is true, the job is also escalated to a forced removal.
+// A job that is already forced is never de-escalated.
+// [removalerrors.RemovalJobNotFound] is returned if the job does not exist.
+func (st *State) RescheduleJob(ctx context.Context, jUUID string, at time.Time, force bool) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	schedule := removalJobSchedule{
+		UUID:         jUUID,
+		Force:        force,
+		ScheduledFor: at,
+	}
+
+	stmt, err := st.Prepare(`
+UPDATE removal
+SET    scheduled_for = $removalJobSchedule.scheduled_for,
+       force = MAX(force, $removalJobSchedule.force)
+WHERE  uuid = $removalJobSchedule.uuid`, schedule)
+	if err != nil {
+		return errors.Errorf("preparing job reschedule: %w", err)
+	}
+
+	return errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, schedule).Get(&outcome); err != nil {
+			return errors.Errorf("rescheduling removal job: %w", err)
+		}
+		if n, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Errorf("rescheduling removal job: %w", err)
+		} else if n == 0 {
+			return errors.Errorf("removal job %q", jUUID).Add(removalerrors.RemovalJobNotFound)
+		}
+		return nil
+	}))
+}
+
 // NamespaceForWatchRemovals returns the table name whose UUIDs we
 // are watching in order to be notified of new removal jobs.
 func (st *State) NamespaceForWatchRemovals() string {
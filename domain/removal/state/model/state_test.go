@@ -51,6 +51,7 @@ import (
 	relationservice "github.com/juju/juju/domain/relation/service"
 	relationstate "github.com/juju/juju/domain/relation/state"
 	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
 	schematesting "github.com/juju/juju/domain/schema/testing"
 	domainstorage "github.com/juju/juju/domain/storage"
 	storageservice "github.com/juju/juju/domain/storage/service"
@@ -68,7 +69,7 @@ import (
 )
 
 type stateSuite struct {
-	schematesting.ModelSuite
+	baseSuite
 }
 
 func TestStateSuite(t *testing.T) {
@@ -149,6 +150,153 @@ VALUES (?, ?, ?, ?, ?, ?)`
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *stateSuite) TestRecordJobAttempt(c *tc.C) {
+	ins := `
+INSERT INTO removal (uuid, removal_type_id, entity_uuid, force, scheduled_for, arg)
+VALUES (?, ?, ?, ?, ?, ?)`
+
+	jID, _ := removal.NewUUID()
+	now := time.Now().UTC()
+	_, err := s.DB().Exec(ins, jID, 1, "unit-1", 0, now, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	first := now.Add(time.Second)
+	err = st.RecordJobAttempt(c.Context(), jID.String(), "first failure", first)
+	c.Assert(err, tc.ErrorIsNil)
+
+	second := now.Add(2 * time.Second)
+	err = st.RecordJobAttempt(c.Context(), jID.String(), "second failure", second)
+	c.Assert(err, tc.ErrorIsNil)
+
+	jobs, err := st.GetAllJobs(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(jobs, tc.HasLen, 1)
+	c.Check(jobs[0], tc.DeepEquals, removal.Job{
+		UUID:            jID,
+		RemovalType:     removal.UnitJob,
+		EntityUUID:      "unit-1",
+		ScheduledFor:    now,
+		Attempts:        2,
+		LastError:       "second failure",
+		LastAttemptedAt: &second,
+	})
+
+	// Deleting the job also deletes the record of its attempts.
+	err = st.DeleteJob(c.Context(), jID.String())
+	c.Assert(err, tc.ErrorIsNil)
+
+	row := s.DB().QueryRow("SELECT count(*) FROM removal_attempt WHERE removal_uuid = ?", jID)
+	var count int
+	err = row.Scan(&count)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 0)
+}
+
+func (s *stateSuite) TestRecordJobAttemptJobNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	jID, _ := removal.NewUUID()
+	err := st.RecordJobAttempt(c.Context(), jID.String(), "failure", time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+
+	row := s.DB().QueryRow("SELECT count(*) FROM removal_attempt")
+	var count int
+	err = row.Scan(&count)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 0)
+}
+
+func (s *stateSuite) TestRescheduleJob(c *tc.C) {
+	ins := `
+INSERT INTO removal (uuid, removal_type_id, entity_uuid, force, scheduled_for, arg)
+VALUES (?, ?, ?, ?, ?, ?)`
+
+	jID, _ := removal.NewUUID()
+	now := time.Now().UTC()
+	_, err := s.DB().Exec(ins, jID, 1, "unit-1", 0, now.Add(time.Hour), nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err = st.RescheduleJob(c.Context(), jID.String(), now, false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	jobs, err := st.GetAllJobs(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(jobs, tc.HasLen, 1)
+	c.Check(jobs[0].ScheduledFor, tc.Equals, now)
+	c.Check(jobs[0].Force, tc.IsFalse)
+
+	later := now.Add(time.Minute)
+	err = st.RescheduleJob(c.Context(), jID.String(), later, true)
+	c.Assert(err, tc.ErrorIsNil)
+
+	jobs, err = st.GetAllJobs(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(jobs, tc.HasLen, 1)
+	c.Check(jobs[0].ScheduledFor, tc.Equals, later)
+	c.Check(jobs[0].Force, tc.IsTrue)
+
+	// A forced job is not de-escalated by a retry.
+	err = st.RescheduleJob(c.Context(), jID.String(), now, false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	jobs, err = st.GetAllJobs(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(jobs, tc.HasLen, 1)
+	c.Check(jobs[0].Force, tc.IsTrue)
+}
+
+func (s *stateSuite) TestRescheduleJobNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	jID, _ := removal.NewUUID()
+	err := st.RescheduleJob(c.Context(), jID.String(), time.Now().UTC(), true)
+	c.Assert(err, tc.ErrorIs, removalerrors.RemovalJobNotFound)
+}
+
+func (s *stateSuite) TestGetJobEntityNames(c *tc.C) {
+	relUUID := s.createRelation(c)
+	svc := s.setupApplicationService(c)
+	appUUID := s.createIAASApplication(c, svc, "foo", applicationservice.AddIAASUnitArg{})
+	unitUUID := s.getAllUnitUUIDs(c, appUUID)[0]
+	machineUUID := s.getUnitMachineUUID(c, unitUUID)
+
+	ins := `
+INSERT INTO removal (uuid, removal_type_id, entity_uuid, force, scheduled_for)
+VALUES (?, ?, ?, ?, ?)`
+	now := time.Now().UTC()
+	jobs := make(map[string]string)
+	for _, job := range []struct {
+		removalType removal.JobType
+		entityUUID  string
+		name        string
+	}{
+		{removal.RelationJob, relUUID.String(), "app2:bar app1:foo"},
+		{removal.UnitJob, unitUUID.String(), "foo/0"},
+		{removal.ApplicationJob, appUUID.String(), "foo"},
+		{removal.MachineJob, machineUUID.String(), "0"},
+		{removal.UserSecretJob, "secret:d0c8qlnmp25c76cdc2t0", "secret:d0c8qlnmp25c76cdc2t0"},
+		// The unit has already gone, so it has no name.
+		{removal.UnitJob, "gone-unit-uuid", ""},
+	} {
+		jID, _ := removal.NewUUID()
+		_, err := s.DB().Exec(ins, jID, job.removalType, job.entityUUID, 0, now)
+		c.Assert(err, tc.ErrorIsNil)
+		if job.name != "" {
+			jobs[jID.String()] = job.name
+		}
+	}
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	names, err := st.GetJobEntityNames(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(names, tc.DeepEquals, jobs)
+}
+
 type baseSuite struct {
 	schematesting.ModelSuite
 
@@ -32,6 +32,39 @@ type removalJob struct {
 	Arg sql.NullString `db:"arg"`
 }
 
+// removalJobAttempt represents a record in the removal_attempt table.
+type removalJobAttempt struct {
+	// RemovalUUID identifies the removal job that was attempted.
+	RemovalUUID string `db:"removal_uuid"`
+	// Attempts is the number of unsuccessful attempts to execute the job.
+	Attempts int `db:"attempts"`
+	// LastError is the error from the most recent unsuccessful attempt.
+	LastError sql.NullString `db:"last_error"`
+	// LastAttemptedAt is the time of the most recent unsuccessful attempt.
+	LastAttemptedAt sql.NullTime `db:"last_attempted_at"`
+}
+
+// removalJobSchedule is used to bring a removal job's schedule forward,
+// optionally escalating it to a forced removal.
+type removalJobSchedule struct {
+	// UUID identifies the removal job.
+	UUID string `db:"uuid"`
+	// Force indicates whether the job should be escalated to a forced
+	// removal.
+	Force bool `db:"force"`
+	// ScheduledFor is the new earliest time that the job should be executed.
+	ScheduledFor time.Time `db:"scheduled_for"`
+}
+
+// removalEntityName holds the name by which the entity being removed by a
+// removal job is known to users.
+type removalEntityName struct {
+	// RemovalUUID identifies the removal job.
+	RemovalUUID string `db:"removal_uuid"`
+	// Name is the name of the entity being removed.
+	Name string `db:"name"`
+}
+
 // objectStoreUUID holds the UUID of an object store item.
 type objectStoreUUID struct {
 	UUID sql.Null[string] `db:"uuid"`
@@ -215,3 +248,12 @@ type storageRemoval struct {
 type dbModelType struct {
 	Type string `db:"type"`
 }
+
+// previewRelationEndpoint identifies a single endpoint of a relation, used to
+// compose relation keys for removal previews.
+type previewRelationEndpoint struct {
+	RelationUUID    string `db:"relation_uuid"`
+	ApplicationName string `db:"application_name"`
+	EndpointName    string `db:"endpoint_name"`
+	Role            string `db:"role"`
+}
@@ -123,6 +123,16 @@ type Job struct {
 	ScheduledFor time.Time
 	// Arg is free form job configuration.
 	Arg map[string]any
+	// Attempts is the number of times that execution of this job has been
+	// attempted without it completing.
+	Attempts int
+	// LastError describes the outcome of the most recent attempt to
+	// execute this job that did not complete it.
+	LastError string
+	// LastAttemptedAt is the time of the most recent attempt to execute this
+	// job that did not complete it. It is nil if there has been no such
+	// attempt.
+	LastAttemptedAt *time.Time
 }
 
 // ModelArtifacts holds the artifacts associated with a model that is being
@@ -148,3 +158,53 @@ func (a ModelArtifacts) Empty() bool {
 		len(a.UnitUUIDs) == 0 &&
 		len(a.RelationUUIDs) == 0
 }
+
+// Preview describes the entities that would be removed, or that would have
+// their life advanced towards removal, as a result of removing an entity.
+// It is the result of a dry-run removal and does not reflect any change to
+// the model.
+type Preview struct {
+	// Machines are the names of machines, including containers, that would
+	// be removed.
+	Machines []string
+
+	// Applications are the names of applications that would be removed.
+	Applications []string
+
+	// RemoteApplications are the names of remote (consumed) applications
+	// that would be removed.
+	RemoteApplications []string
+
+	// Units are the names of units that would be removed.
+	Units []string
+
+	// Relations are the keys of relations that would be removed.
+	Relations []string
+
+	// Offers are the names of offers that would be removed.
+	Offers []string
+
+	// DestroyedStorage are the IDs of storage instances that would be
+	// destroyed.
+	DestroyedStorage []string
+
+	// DetachedStorage are the IDs of storage instances that would be detached
+	// from the removed units, but would otherwise remain in the model.
+	DetachedStorage []string
+
+	// Secrets are the IDs of secrets that would be removed.
+	Secrets []string
+}
+
+// Empty returns true if the preview indicates that nothing would be removed.
+func (p Preview) Empty() bool {
+	return len(p.Machines) == 0 &&
+		len(p.Applications) == 0 &&
+		len(p.RemoteApplications) == 0 &&
+		len(p.Units) == 0 &&
+		len(p.Relations) == 0 &&
+		len(p.Offers) == 0 &&
+		len(p.DestroyedStorage) == 0 &&
+		len(p.DetachedStorage) == 0 &&
+		len(p.Secrets) == 0
+}
@@ -3,7 +3,9 @@ CREATE TABLE logging_loki_config (
     endpoint TEXT NOT NULL,
     ca_cert TEXT NOT NULL DEFAULT '',
     insecure_skip_verify BOOLEAN NULL,
-    org_id TEXT NOT NULL DEFAULT ''
+    org_id TEXT NOT NULL DEFAULT '',
+    protocol TEXT NOT NULL DEFAULT 'loki',
+    CHECK (protocol IN ('loki', 'otlp'))
 );
 
 CREATE UNIQUE INDEX idx_singleton_logging_loki_config ON logging_loki_config ((1));
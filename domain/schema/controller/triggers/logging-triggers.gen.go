@@ -33,7 +33,8 @@ WHEN
 	NEW.endpoint != OLD.endpoint OR
 	NEW.ca_cert != OLD.ca_cert OR
 	(NEW.insecure_skip_verify != OLD.insecure_skip_verify OR (NEW.insecure_skip_verify IS NOT NULL AND OLD.insecure_skip_verify IS NULL) OR (NEW.insecure_skip_verify IS NULL AND OLD.insecure_skip_verify IS NOT NULL)) OR
-	NEW.org_id != OLD.org_id
+	NEW.org_id != OLD.org_id OR
+	NEW.protocol != OLD.protocol
 BEGIN
     INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
     VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now', 'utc'));
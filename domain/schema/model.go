@@ -27,7 +27,7 @@ import (
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/operation-triggers.gen.go -package=triggers -tables=operation_task_log
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/crossmodelrelation-triggers.gen.go -package=triggers -tables=application_remote_offerer,application_remote_consumer,relation_network_ingress,relation_network_egress
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/offer-triggers.gen.go -package=triggers -tables=offer
-//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/status-triggers.gen.go -package=triggers -tables=application_status
+//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/status-triggers.gen.go -package=triggers -tables=application_status,machine_status,machine_cloud_instance_status
 
 //go:embed model/sql/*.sql
 var modelSchemaDir embed.FS
@@ -110,6 +110,8 @@ const (
 	tableRelationNetworkEgress
 	tableModelMigrating
 	tableMachineReprovision
+	tableMachineStatus
+	tableMachineCloudInstanceStatus
 )
 
 // modelPostPatchFilesByVersion is used to categorise the post patch files
@@ -196,11 +198,18 @@ func ModelDDLForVersion(version semversion.Number) *schema.Schema {
 		triggers.ChangeLogTriggersForRelationNetworkIngress("relation_uuid", tableRelationNetworkIngress),
 		triggers.ChangeLogTriggersForRelationNetworkEgress("relation_uuid", tableRelationNetworkEgress),
 		triggers.ChangeLogTriggersForModelMigrating("model_uuid", tableModelMigrating),
+		triggers.ChangeLogTriggersForMachineStatus("machine_uuid", tableMachineStatus),
+		triggers.ChangeLogTriggersForMachineCloudInstanceStatus("machine_uuid", tableMachineCloudInstanceStatus),
 	)
 
 	// Generic triggers.
 	patches = append(patches,
-		triggersForImmutableTable("model", "", "model table is immutable, only insertions are allowed"),
+		// The model name is the only value that can change, when the model is
+		// renamed.
+		triggersForImmutableColumns("model", []string{
+			"uuid", "controller_uuid", "qualifier", "type", "cloud", "cloud_type",
+			"cloud_region", "credential_owner", "credential_name", "is_controller_model",
+		}, "model table is immutable, only insertions and name updates are allowed"),
 
 		// The charm is unmodifiable.
 		// There is a lot of assumptions in the code that the charm is immutable
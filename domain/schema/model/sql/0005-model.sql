@@ -40,7 +40,13 @@ SELECT
     c.virt_type,
     c.allocate_public_ip,
     c.image_id,
-    c.ip_family
+    c.ip_family,
+    c.market,
+    c.max_price,
+    c.capacity_reservation,
+    c.placement_group,
+    c.gpus,
+    c.gpu_type
 FROM model_constraint AS mc
 JOIN v_constraint AS c ON mc.constraint_uuid = c.uuid;
 
@@ -81,6 +81,22 @@ CREATE TABLE secret_rotation (
     REFERENCES secret_metadata (secret_id)
 );
 
+-- 1:1
+-- secret_generator records how Juju generates the content of each
+-- new revision of a user secret, instead of the content being supplied.
+CREATE TABLE secret_generator (
+    secret_id TEXT NOT NULL PRIMARY KEY,
+    format TEXT NOT NULL,
+    -- length is the size of the generated value, or 0 for the default.
+    length INT NOT NULL DEFAULT 0,
+    charset TEXT,
+    CONSTRAINT chk_secret_generator_format
+    CHECK (format IN ('password', 'hex', 'rsa', 'ed25519')),
+    CONSTRAINT fk_secret_generator_secret_metadata_id
+    FOREIGN KEY (secret_id)
+    REFERENCES secret_metadata (secret_id)
+);
+
 -- 1:1
 CREATE TABLE secret_value_ref (
     revision_uuid TEXT NOT NULL PRIMARY KEY,
@@ -106,6 +122,37 @@ CREATE TABLE secret_deleted_value_ref (
 CREATE INDEX idx_secret_deleted_value_ref_revision_id
 ON secret_deleted_value_ref (revision_id);
 
+-- secret_external_ref records the location of content for user secrets
+-- which are managed outside of Juju. The content is never copied into
+-- Juju, nor is it ever deleted or drained by Juju.
+CREATE TABLE secret_external_ref (
+    secret_id TEXT NOT NULL PRIMARY KEY,
+    -- backend_uuid is the UUID of the backend in the controller database.
+    backend_uuid TEXT NOT NULL,
+    path TEXT NOT NULL,
+    -- content_key, if set, selects a single key from the external content.
+    content_key TEXT,
+    CONSTRAINT chk_empty_path
+    CHECK (path != ''),
+    CONSTRAINT fk_secret_external_ref_secret_metadata_id
+    FOREIGN KEY (secret_id)
+    REFERENCES secret_metadata (secret_id)
+);
+
+CREATE INDEX idx_secret_external_ref_backend_uuid
+ON secret_external_ref (backend_uuid);
+
+-- 1:1
+-- secret_revision_external_version records the upstream version of
+-- the external content for each revision of an externally managed secret.
+CREATE TABLE secret_revision_external_version (
+    revision_uuid TEXT NOT NULL PRIMARY KEY,
+    version TEXT NOT NULL,
+    CONSTRAINT fk_secret_revision_external_version_revision_uuid
+    FOREIGN KEY (revision_uuid)
+    REFERENCES secret_revision (uuid)
+);
+
 -- 1:many
 CREATE TABLE secret_content (
     revision_uuid TEXT NOT NULL,
@@ -322,6 +369,48 @@ ON secret_permission (secret_id);
 CREATE INDEX idx_secret_permission_subject_uuid_subject_type_id
 ON secret_permission (subject_uuid, subject_type_id);
 
+CREATE TABLE secret_accessor_type (
+    id INT PRIMARY KEY,
+    type TEXT
+);
+
+INSERT INTO secret_accessor_type VALUES
+(0, 'unit'),
+(1, 'application'),
+(2, 'model'),
+(3, 'user');
+
+-- 1:many
+-- secret_revision_access is an audit trail of the entities which have read
+-- the content of each revision of a secret. Reads are deduplicated per
+-- accessor per revision; access_count counts the recorded reads, where
+-- repeated reads by a charm within a few minutes are recorded once. Rows
+-- are keyed by revision number rather than revision UUID so that the trail
+-- outlives pruned revisions, and are removed with the secret or once they
+-- are older than the model's max-secret-access-log-age.
+CREATE TABLE secret_revision_access (
+    secret_id TEXT NOT NULL,
+    revision INT NOT NULL,
+    accessor_type_id INT NOT NULL,
+    -- accessor_name is the unit, application or user name,
+    -- or the model UUID, of the entity which read the content.
+    accessor_name TEXT NOT NULL,
+    first_access_time DATETIME NOT NULL,
+    last_access_time DATETIME NOT NULL,
+    access_count INT NOT NULL DEFAULT 1,
+    CONSTRAINT pk_secret_revision_access
+    PRIMARY KEY (secret_id, revision, accessor_type_id, accessor_name),
+    CONSTRAINT fk_secret_revision_access_secret_id
+    FOREIGN KEY (secret_id)
+    REFERENCES secret_metadata (secret_id),
+    CONSTRAINT fk_secret_revision_access_secret_accessor_type_id
+    FOREIGN KEY (accessor_type_id)
+    REFERENCES secret_accessor_type (id)
+);
+
+CREATE INDEX idx_secret_revision_access_last_access_time
+ON secret_revision_access (last_access_time);
+
 -- v_secret_permission is used to query secrets which can
 -- be accessed by a subject of application, unit, or model.
 CREATE VIEW v_secret_permission AS
@@ -422,3 +511,14 @@ CREATE TABLE secret_reservation (
 
 CREATE INDEX idx_secret_reservation_unit_uuid
 ON secret_reservation (unit_uuid);
+
+-- secret_data_key holds the keys used to encrypt the content of secrets
+-- stored in the model database. The key material is wrapped by a
+-- controller key-encryption key and is never stored in the clear.
+-- Encrypted content records the uuid of the key it was encrypted with.
+-- The most recently created key is used to encrypt new content.
+CREATE TABLE secret_data_key (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    wrapped_key TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
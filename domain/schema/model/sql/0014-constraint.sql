@@ -19,6 +19,12 @@ CREATE TABLE "constraint" (
     allocate_public_ip INT,
     image_id TEXT,
     ip_family TEXT,
+    market TEXT,
+    max_price TEXT,
+    capacity_reservation TEXT,
+    placement_group TEXT,
+    gpus INT,
+    gpu_type TEXT,
     CONSTRAINT fk_constraint_container_type
     FOREIGN KEY (container_type_id)
     REFERENCES container_type (id)
@@ -41,7 +47,13 @@ SELECT
     c.virt_type,
     c.allocate_public_ip,
     c.image_id,
-    c.ip_family
+    c.ip_family,
+    c.market,
+    c.max_price,
+    c.capacity_reservation,
+    c.placement_group,
+    c.gpus,
+    c.gpu_type
 FROM "constraint" AS c
 LEFT JOIN container_type AS ct ON c.container_type_id = ct.id;
 
@@ -200,6 +200,24 @@ CREATE TABLE machine_reprovision (
     REFERENCES machine (name)
 );
 
+-- machine_recovery_attempt records the attempts made to automatically
+-- recover a machine whose cloud instance has stopped or disappeared, as
+-- directed by the model's machine-recovery-policy. An attempt with a NULL
+-- error requested a replacement instance for the machine. Only the most
+-- recent attempts for each machine are kept.
+CREATE TABLE machine_recovery_attempt (
+    machine_uuid TEXT NOT NULL,
+    attempted_at DATETIME NOT NULL,
+    instance_id TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    error TEXT,
+    CONSTRAINT pk_machine_recovery_attempt
+    PRIMARY KEY (machine_uuid, attempted_at),
+    CONSTRAINT fk_machine_recovery_attempt_machine
+    FOREIGN KEY (machine_uuid)
+    REFERENCES machine (uuid)
+);
+
 CREATE TABLE machine_status_value (
     id INT PRIMARY KEY,
     status TEXT NOT NULL
@@ -313,6 +331,12 @@ SELECT
     c.allocate_public_ip,
     c.image_id,
     c.ip_family,
+    c.market,
+    c.max_price,
+    c.capacity_reservation,
+    c.placement_group,
+    c.gpus,
+    c.gpu_type,
     ctag.tag,
     cspace.space AS space_name,
     cspace."exclude" AS space_exclude,
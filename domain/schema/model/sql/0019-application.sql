@@ -210,6 +210,39 @@ CREATE TABLE application_setting (
     REFERENCES application (uuid)
 );
 
+-- application_placement_policy holds the limits on how many units of an
+-- application may share a machine or an availability zone. A NULL limit
+-- means there is no limit.
+CREATE TABLE application_placement_policy (
+    application_uuid TEXT NOT NULL PRIMARY KEY,
+    max_units_per_machine INT CHECK (max_units_per_machine > 0),
+    max_units_per_zone INT CHECK (max_units_per_zone > 0),
+    CONSTRAINT fk_application_placement_policy_application
+    FOREIGN KEY (application_uuid)
+    REFERENCES application (uuid)
+);
+
+-- application_placement_affinity records whether the units of an application
+-- must (affinity) or must not (anti-affinity) share a machine with the units
+-- of another application.
+CREATE TABLE application_placement_affinity (
+    application_uuid TEXT NOT NULL,
+    target_application_uuid TEXT NOT NULL,
+    anti_affinity BOOLEAN NOT NULL,
+    CONSTRAINT fk_application_placement_affinity_application
+    FOREIGN KEY (application_uuid)
+    REFERENCES application (uuid),
+    CONSTRAINT fk_application_placement_affinity_target_application
+    FOREIGN KEY (target_application_uuid)
+    REFERENCES application (uuid),
+    CONSTRAINT chk_application_placement_affinity_self
+    CHECK (application_uuid != target_application_uuid),
+    PRIMARY KEY (application_uuid, target_application_uuid)
+);
+
+CREATE INDEX idx_application_placement_affinity_target
+ON application_placement_affinity (target_application_uuid);
+
 CREATE TABLE application_platform (
     application_uuid TEXT NOT NULL PRIMARY KEY,
     os_id TEXT NOT NULL,
@@ -310,6 +343,12 @@ SELECT
     c.allocate_public_ip,
     c.image_id,
     c.ip_family,
+    c.market,
+    c.max_price,
+    c.capacity_reservation,
+    c.placement_group,
+    c.gpus,
+    c.gpu_type,
     ctag.tag,
     ctag.rowid AS tag_order,
     cspace.space AS space_name,
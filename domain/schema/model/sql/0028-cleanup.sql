@@ -48,3 +48,17 @@ ON removal (removal_type_id, entity_uuid, arg);
 
 CREATE INDEX idx_removal_scheduled_for
 ON removal (scheduled_for);
+
+-- removal_attempt records the outcome of unsuccessful attempts to execute a
+-- removal job. It is kept apart from the removal table so that recording an
+-- attempt does not emit a change for the job and cause it to be re-run
+-- immediately.
+CREATE TABLE removal_attempt (
+    removal_uuid TEXT NOT NULL PRIMARY KEY,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    last_attempted_at DATETIME,
+    CONSTRAINT fk_removal_attempt_removal
+    FOREIGN KEY (removal_uuid)
+    REFERENCES removal (uuid)
+);
@@ -1,6 +1,13 @@
 CREATE TABLE offer (
     uuid TEXT NOT NULL PRIMARY KEY,
-    name TEXT NOT NULL
+    name TEXT NOT NULL,
+    -- description overrides the charm description of the offered
+    -- application, when set.
+    description TEXT,
+    -- modified_version is incremented every time the offer is updated, so
+    -- that watchers of the offer are notified even when only the offered
+    -- endpoints change.
+    modified_version INT NOT NULL DEFAULT 0
 );
 
 CREATE INDEX idx_offer_name
@@ -49,7 +56,7 @@ SELECT
     o.uuid AS offer_uuid,
     o.name AS offer_name,
     a.name AS application_name,
-    cm.description AS application_description,
+    COALESCE(o.description, cm.description) AS application_description,
     c.reference_name AS charm_name,
     c.revision AS charm_revision,
     cs.name AS charm_source,
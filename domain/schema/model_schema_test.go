@@ -93,6 +93,8 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"application_exposed_endpoint_cidr",
 		"application_exposed_endpoint_space",
 		"application_k8s_resources_managed",
+		"application_placement_affinity",
+		"application_placement_policy",
 		"application_platform",
 		"application_scale",
 		"application_setting",
@@ -222,6 +224,7 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"machine_placement_scope",
 		"machine_platform",
 		"machine_placement",
+		"machine_recovery_attempt",
 		"machine_reprovision",
 		"machine_requires_reboot",
 		"machine_ssh_host_key",
@@ -323,8 +326,11 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"secret_reference",
 		"secret_metadata",
 		"secret_rotation",
+		"secret_generator",
 		"secret_value_ref",
 		"secret_deleted_value_ref",
+		"secret_external_ref",
+		"secret_revision_external_version",
 		"secret_content",
 		"secret_revision",
 		"secret_revision_obsolete",
@@ -337,8 +343,11 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"secret_permission",
 		"secret_role",
 		"secret_reservation",
+		"secret_data_key",
 		"secret_grant_subject_type",
 		"secret_grant_scope_type",
+		"secret_accessor_type",
+		"secret_revision_access",
 
 		// Opened Ports
 		"protocol",
@@ -363,6 +372,7 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		// Cleanup
 		"removal_type",
 		"removal",
+		"removal_attempt",
 
 		// Sequence
 		"sequence",
@@ -775,6 +785,14 @@ func (s *modelSchemaSuite) TestModelTriggers(c *tc.C) {
 		"trg_log_relation_network_egress_delete",
 		"trg_log_relation_network_egress_insert",
 		"trg_log_relation_network_egress_update",
+
+		"trg_log_machine_status_delete",
+		"trg_log_machine_status_insert",
+		"trg_log_machine_status_update",
+
+		"trg_log_machine_cloud_instance_status_delete",
+		"trg_log_machine_cloud_instance_status_insert",
+		"trg_log_machine_cloud_instance_status_update",
 	)
 
 	// These are additional triggers that are not change log triggers, but
@@ -860,13 +878,19 @@ INSERT INTO model (uuid, controller_uuid, name, qualifier, type, cloud, cloud_ty
 VALUES (?, ?, 'my-model', 'prod', 'caas', 'cloud-1', 'kubernetes', 'cloud-region-1');`,
 		modelUUID, controllerUUID)
 
+	s.assertExecSQL(c, "UPDATE model SET name = 'new-name' WHERE uuid = ?", modelUUID)
+
+	s.assertExecSQLError(c,
+		"UPDATE model SET qualifier = 'staging' WHERE uuid = ?",
+		"model table is immutable, only insertions and name updates are allowed", modelUUID)
+
 	s.assertExecSQLError(c,
-		"UPDATE model SET name = 'new-name' WHERE uuid = ?",
-		"model table is immutable, only insertions are allowed", modelUUID)
+		"UPDATE model SET credential_name = 'cred' WHERE uuid = ?",
+		"model table is immutable, only insertions and name updates are allowed", modelUUID)
 
 	s.assertExecSQLError(c,
 		"DELETE FROM model WHERE uuid = ?;",
-		"model table is immutable, only insertions are allowed", modelUUID)
+		"model table is immutable, only insertions and name updates are allowed", modelUUID)
 }
 
 func (s *modelSchemaSuite) TestTriggersForUnmodifiableTables(c *tc.C) {
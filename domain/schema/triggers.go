@@ -5,6 +5,7 @@ package schema
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/juju/juju/core/database/schema"
 )
@@ -52,6 +53,35 @@ CREATE TRIGGER trg_%[1]s_immutable_delete
 	}
 }
 
+// triggersForImmutableColumns returns a function that creates triggers to
+// prevent deletes on the given table, and updates that change any of the given
+// columns. Columns that are not listed can be updated freely. The errMsg is the
+// error message that will be returned if the trigger is fired.
+func triggersForImmutableColumns(tableName string, columns []string, errMsg string) func() schema.Patch {
+	changed := make([]string, len(columns))
+	for i, column := range columns {
+		changed[i] = fmt.Sprintf("OLD.%[1]s IS NOT NEW.%[1]s", column)
+	}
+	return func() schema.Patch {
+		stmt := fmt.Sprintf(`
+CREATE TRIGGER trg_%[1]s_immutable_update
+    BEFORE UPDATE ON %[1]s
+    FOR EACH ROW
+    WHEN %[2]s
+    BEGIN
+        SELECT RAISE(FAIL, '%[3]s');
+    END;
+
+CREATE TRIGGER trg_%[1]s_immutable_delete
+    BEFORE DELETE ON %[1]s
+    FOR EACH ROW
+    BEGIN
+        SELECT RAISE(FAIL, '%[3]s');
+    END;`[1:], tableName, strings.Join(changed, " OR "), errMsg)
+		return schema.MakePatch(stmt)
+	}
+}
+
 // triggersForUnmodifiableTable returns a function that creates triggers to
 // prevent updates on the given table. The tableName is the name of the table to
 // create the triggers for. The errMsg is the error message that
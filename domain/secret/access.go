@@ -0,0 +1,62 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret
+
+import "time"
+
+// AccessorType represents the type of an entity which has
+// read the content of a secret, as recorded in the
+// secret_accessor_type lookup table.
+type AccessorType int
+
+const (
+	AccessorUnit AccessorType = iota
+	AccessorApplication
+	AccessorModel
+	AccessorUser
+)
+
+// String implements fmt.Stringer.
+func (a AccessorType) String() string {
+	switch a {
+	case AccessorUnit:
+		return "unit"
+	case AccessorApplication:
+		return "application"
+	case AccessorModel:
+		return "model"
+	case AccessorUser:
+		return "user"
+	}
+	return ""
+}
+
+// MarshallAccessorType converts a secret accessor kind to a db accessor type id.
+func MarshallAccessorType(kind SecretAccessorKind) (AccessorType, bool) {
+	switch kind {
+	case UnitAccessor:
+		return AccessorUnit, true
+	case ApplicationAccessor:
+		return AccessorApplication, true
+	case ModelAccessor:
+		return AccessorModel, true
+	case UserAccessor:
+		return AccessorUser, true
+	}
+	return 0, false
+}
+
+// SecretAccessRecord records the reads of a secret revision by an accessor.
+type SecretAccessRecord struct {
+	// Revision is the secret revision which was read.
+	Revision int
+	// Accessor is the entity which read the content.
+	Accessor SecretAccessor
+	// FirstAccessTime is when the accessor first read the revision.
+	FirstAccessTime time.Time
+	// LastAccessTime is when the accessor last read the revision.
+	LastAccessTime time.Time
+	// AccessCount is the number of times the accessor read the revision.
+	AccessCount int
+}
@@ -31,8 +31,10 @@ type MockSecretBackendStateMockRecorder struct {
 	mock                                *MockSecretBackendState
 	addSecretBackendReferenceExpects    []*gomock.Call5_2[context.Context, *secrets.ValueRef, model.UUID, string, string, func() error, error]
 	getActiveModelSecretBackendExpects  []*gomock.Call2_3[context.Context, model.UUID, string, *provider.ModelBackendConfig, error]
+	getControllerNodeCountExpects       []*gomock.Call1_2[context.Context, int, error]
 	getModelSecretBackendDetailsExpects []*gomock.Call2_2[context.Context, model.UUID, secretbackend.ModelSecretBackend, error]
 	getSecretBackendNamesByUUIDExpects  []*gomock.Call1_2[context.Context, map[string]string, error]
+	getSecretEncryptionConfigExpects    []*gomock.Call1_2[context.Context, secretbackend.EncryptionConfig, error]
 	listSecretBackendsForModelExpects   []*gomock.Call3_2[context.Context, model.UUID, bool, []*secretbackend.SecretBackend, error]
 	updateSecretBackendReferenceExpects []*gomock.Call5_2[context.Context, *secrets.ValueRef, model.UUID, string, string, func() error, error]
 }
@@ -85,6 +87,24 @@ func (mr *MockSecretBackendStateMockRecorder) GetActiveModelSecretBackend(ctx, m
 // MockSecretBackendStateGetActiveModelSecretBackendCall is the typed call wrapper for GetActiveModelSecretBackend.
 type MockSecretBackendStateGetActiveModelSecretBackendCall = gomock.Call2_3[context.Context, model.UUID, string, *provider.ModelBackendConfig, error]
 
+// GetControllerNodeCount mocks base method.
+func (m *MockSecretBackendState) GetControllerNodeCount(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getControllerNodeCountExpects, m.ctrl, m, "GetControllerNodeCount", ctx)
+}
+
+// GetControllerNodeCount indicates an expected call of GetControllerNodeCount.
+func (mr *MockSecretBackendStateMockRecorder) GetControllerNodeCount(ctx any) *MockSecretBackendStateGetControllerNodeCountCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, int, error](mr.mock.ctrl.T, mr.mock, "GetControllerNodeCount", gomock.EnsureMatcher(ctx))
+	mr.getControllerNodeCountExpects = append(mr.getControllerNodeCountExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretBackendStateGetControllerNodeCountCall is the typed call wrapper for GetControllerNodeCount.
+type MockSecretBackendStateGetControllerNodeCountCall = gomock.Call1_2[context.Context, int, error]
+
 // GetModelSecretBackendDetails mocks base method.
 func (m *MockSecretBackendState) GetModelSecretBackendDetails(ctx context.Context, modelUUID model.UUID) (secretbackend.ModelSecretBackend, error) {
 	m.ctrl.T.Helper()
@@ -121,6 +141,24 @@ func (mr *MockSecretBackendStateMockRecorder) GetSecretBackendNamesByUUID(ctx an
 // MockSecretBackendStateGetSecretBackendNamesByUUIDCall is the typed call wrapper for GetSecretBackendNamesByUUID.
 type MockSecretBackendStateGetSecretBackendNamesByUUIDCall = gomock.Call1_2[context.Context, map[string]string, error]
 
+// GetSecretEncryptionConfig mocks base method.
+func (m *MockSecretBackendState) GetSecretEncryptionConfig(ctx context.Context) (secretbackend.EncryptionConfig, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getSecretEncryptionConfigExpects, m.ctrl, m, "GetSecretEncryptionConfig", ctx)
+}
+
+// GetSecretEncryptionConfig indicates an expected call of GetSecretEncryptionConfig.
+func (mr *MockSecretBackendStateMockRecorder) GetSecretEncryptionConfig(ctx any) *MockSecretBackendStateGetSecretEncryptionConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, secretbackend.EncryptionConfig, error](mr.mock.ctrl.T, mr.mock, "GetSecretEncryptionConfig", gomock.EnsureMatcher(ctx))
+	mr.getSecretEncryptionConfigExpects = append(mr.getSecretEncryptionConfigExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretBackendStateGetSecretEncryptionConfigCall is the typed call wrapper for GetSecretEncryptionConfig.
+type MockSecretBackendStateGetSecretEncryptionConfigCall = gomock.Call1_2[context.Context, secretbackend.EncryptionConfig, error]
+
 // ListSecretBackendsForModel mocks base method.
 func (m *MockSecretBackendState) ListSecretBackendsForModel(ctx context.Context, modelUUID model.UUID, includeEmpty bool) ([]*secretbackend.SecretBackend, error) {
 	m.ctrl.T.Helper()
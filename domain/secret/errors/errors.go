@@ -41,4 +41,8 @@ const (
 
 	// MissingSecretBackendID describes an error that occurs when importing a secret and the backend doesn't exist.
 	MissingSecretBackendID = errors.ConstError("missing secret backend id")
+
+	// SecretNotExternal describes an error that occurs when an external reference is
+	// requested for a secret whose content is managed by Juju.
+	SecretNotExternal = errors.ConstError("secret is not externally managed")
 )
@@ -98,3 +98,27 @@ func (s *grantSuite) TestGrantScopeTypeDBValues(c *tc.C) {
 		ScopeRelation:    "relation",
 	})
 }
+
+func (s *grantSuite) TestAccessorTypeDBValues(c *tc.C) {
+	db := s.DB()
+	rows, err := db.Query("SELECT id, type FROM secret_accessor_type")
+	c.Assert(err, tc.ErrorIsNil)
+	defer rows.Close()
+
+	dbValues := make(map[AccessorType]string)
+	for rows.Next() {
+		var (
+			id    int
+			value string
+		)
+		err := rows.Scan(&id, &value)
+		c.Assert(err, tc.ErrorIsNil)
+		dbValues[AccessorType(id)] = value
+	}
+	c.Assert(dbValues, tc.DeepEquals, map[AccessorType]string{
+		AccessorUnit:        "unit",
+		AccessorApplication: "application",
+		AccessorModel:       "model",
+		AccessorUser:        "user",
+	})
+}
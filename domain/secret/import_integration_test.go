@@ -28,6 +28,7 @@ import (
 	domaintesting "github.com/juju/juju/domain/testing"
 	"github.com/juju/juju/environs/config"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/envelope"
 	jujutesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/uuid"
 )
@@ -35,6 +36,8 @@ import (
 type importSuite struct {
 	schematesting.ControllerSuite
 	schematesting.ModelSuite
+
+	keyStore envelope.KeyStore
 }
 
 func TestImportSuite(t *testing.T) {
@@ -54,6 +57,7 @@ func (s *importSuite) TearDownSuite(c *tc.C) {
 func (s *importSuite) SetUpTest(c *tc.C) {
 	s.ControllerSuite.SetUpTest(c)
 	s.ModelSuite.SetUpTest(c)
+	s.keyStore = newKeyStore(c)
 }
 
 func (s *importSuite) setupService(c *tc.C) *service.SecretService {
@@ -62,6 +66,7 @@ func (s *importSuite) setupService(c *tc.C) *service.SecretService {
 	return service.NewSecretService(
 		secretState,
 		secretBackendState,
+		s.keyStore,
 		domaintesting.NoopLeaderEnsurer(),
 		loggertesting.WrapCheckLog(c),
 	)
@@ -398,7 +403,7 @@ func (s *importSuite) TestImportSecretWithGrants(c *tc.C) {
 
 func (s *importSuite) doImport(c *tc.C, desc description.Model) {
 	coordinator := modelmigration.NewCoordinator(loggertesting.WrapCheckLog(c))
-	secretmodelmigration.RegisterImport(coordinator, loggertesting.WrapCheckLog(c))
+	secretmodelmigration.RegisterImport(coordinator, s.keyStore, loggertesting.WrapCheckLog(c))
 
 	err := coordinator.Perform(c.Context(), modelmigration.NewScope(s.ControllerSuite.TxnRunnerFactory(),
 		s.ModelSuite.TxnRunnerFactory(), nil, nil,
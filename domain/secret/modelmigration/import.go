@@ -26,6 +26,7 @@ import (
 	backendservice "github.com/juju/juju/domain/secretbackend/service"
 	secretbackendstate "github.com/juju/juju/domain/secretbackend/state"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/envelope"
 )
 
 // Coordinator is the interface that is used to add operations to a migration.
@@ -35,9 +36,12 @@ type Coordinator interface {
 }
 
 // RegisterImport registers the import operations with the given coordinator.
-func RegisterImport(coordinator Coordinator, logger logger.Logger) {
+// The key store holds the controller's key-encryption keys, used to
+// encrypt the imported secret content.
+func RegisterImport(coordinator Coordinator, keyStore envelope.KeyStore, logger logger.Logger) {
 	coordinator.Add(&importOperation{
-		logger: logger,
+		keyStore: keyStore,
+		logger:   logger,
 	})
 }
 
@@ -59,6 +63,7 @@ type importOperation struct {
 
 	service        ImportService
 	backendService SecretBackendService
+	keyStore       envelope.KeyStore
 	logger         logger.Logger
 
 	knownSecretBackends set.Strings
@@ -77,7 +82,7 @@ func (i *importOperation) Setup(scope modelmigration.Scope) error {
 	backendstate := secretbackendstate.NewState(scope.ControllerDB(), i.logger)
 	i.service = service.NewSecretService(
 		state.NewState(scope.ModelDB(), i.logger, clock.WallClock),
-		backendstate, nil, i.logger,
+		backendstate, i.keyStore, nil, i.logger,
 	)
 	i.backendService = backendservice.NewService(
 		backendstate, i.logger,
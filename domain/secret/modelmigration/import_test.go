@@ -52,7 +52,7 @@ func (s *importSuite) TestRegisterImport(c *tc.C) {
 
 	s.coordinator.EXPECT().Add(gomock.Any())
 
-	RegisterImport(s.coordinator, loggertesting.WrapCheckLog(c))
+	RegisterImport(s.coordinator, nil, loggertesting.WrapCheckLog(c))
 }
 
 // serialisedModel provides a model with secrets to import.
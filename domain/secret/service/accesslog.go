@@ -0,0 +1,168 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/trace"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+)
+
+// RecordSecretAccess records that the accessor has read the content of the
+// specified secret revision, for the secret access log. It is used to record
+// reads which are not made through [SecretService.GetSecretValue], such as
+// a user revealing the content of a secret.
+func (s *SecretService) RecordSecretAccess(
+	ctx context.Context, uri *secrets.URI, rev int, accessor domainsecret.SecretAccessor,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if _, ok := domainsecret.MarshallAccessorType(accessor.Kind); !ok {
+		return errors.Errorf("secret accessor kind %q %w", accessor.Kind, coreerrors.NotValid)
+	}
+	return s.secretState.RecordSecretAccess(ctx, uri, rev, accessor, s.clock.Now())
+}
+
+// recordAccess records a read of secret content by a charm. Charms may read
+// a secret in every hook, so repeated reads of a revision by the same
+// accessor are only recorded once per [secretAccessRecordInterval]. Failing
+// to record the read does not fail the read, so that an audit problem does
+// not stop charms from reading the secrets they need; the error is logged
+// instead.
+func (s *SecretService) recordAccess(
+	ctx context.Context, uri *secrets.URI, rev int, accessor domainsecret.SecretAccessor,
+) {
+	now := s.clock.Now()
+	var key *accessKey
+	if s.recentAccess != nil {
+		modelUUID, err := s.secretState.GetModelUUID(ctx)
+		if err != nil {
+			// Record the read, as it can't be told apart from reads of
+			// secrets with the same ID in other models.
+			s.logger.Warningf(ctx, "getting model of secret access: %v", err)
+		} else {
+			key = &accessKey{modelUUID: modelUUID.String(), secretID: uri.ID, revision: rev, accessor: accessor}
+			if !s.recentAccess.shouldRecord(*key, now) {
+				return
+			}
+		}
+	}
+	if err := s.secretState.RecordSecretAccess(ctx, uri, rev, accessor, now); err != nil {
+		if key != nil {
+			s.recentAccess.forget(*key)
+		}
+		s.logger.Warningf(ctx, "%v", err)
+	}
+}
+
+const (
+	// secretAccessRecordInterval is how long repeated reads of a secret
+	// revision by the same accessor go unrecorded after a recorded read.
+	secretAccessRecordInterval = 5 * time.Minute
+
+	// maxRecentAccess is the number of recorded reads held in memory,
+	// beyond which expired reads are evicted.
+	maxRecentAccess = 10000
+)
+
+// sharedRecentAccess holds the reads recorded by all secret services in the
+// process. A secret service is created for each use, so the reads cannot be
+// held by the service itself.
+var sharedRecentAccess = newRecentAccess()
+
+// accessKey identifies a read of secret content for the access log. Secret
+// IDs are not unique across models, as a model may be cloned with its
+// secrets, so reads are also keyed by the model.
+type accessKey struct {
+	modelUUID string
+	secretID  string
+	revision  int
+	accessor  domainsecret.SecretAccessor
+}
+
+// recentAccess holds the time each read of secret content was last recorded
+// in the access log. A nil recentAccess records every read.
+type recentAccess struct {
+	mu       sync.Mutex
+	recorded map[accessKey]time.Time
+}
+
+func newRecentAccess() *recentAccess {
+	return &recentAccess{recorded: make(map[accessKey]time.Time)}
+}
+
+// shouldRecord reports whether the read should be recorded at the given
+// time, noting it as recorded if so.
+func (r *recentAccess) shouldRecord(key accessKey, now time.Time) bool {
+	if r == nil {
+		return true
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if last, ok := r.recorded[key]; ok && now.Sub(last) < secretAccessRecordInterval {
+		return false
+	}
+	if len(r.recorded) >= maxRecentAccess {
+		for k, last := range r.recorded {
+			if now.Sub(last) >= secretAccessRecordInterval {
+				delete(r.recorded, k)
+			}
+		}
+		// If every read is recent, start again rather than grow without
+		// bound; the cost is some reads being recorded more often.
+		if len(r.recorded) >= maxRecentAccess {
+			clear(r.recorded)
+		}
+	}
+	r.recorded[key] = now
+	return true
+}
+
+// forget removes the read, so that it is recorded the next time it is made.
+func (r *recentAccess) forget(key accessKey) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.recorded, key)
+}
+
+// ListSecretAccess returns the recorded reads of the content of the
+// specified secret, most recent revision first.
+func (s *SecretService) ListSecretAccess(
+	ctx context.Context, uri *secrets.URI,
+) ([]domainsecret.SecretAccessRecord, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.secretState.ListSecretAccess(ctx, uri)
+}
+
+// PruneSecretAccessLog deletes the recorded reads of secret content
+// which were last read longer ago than maxAge.
+func (s *SecretService) PruneSecretAccessLog(ctx context.Context, maxAge time.Duration) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if maxAge <= 0 {
+		return errors.Errorf("secret access log max age %v %w", maxAge, coreerrors.NotValid)
+	}
+	deleted, err := s.secretState.PruneSecretAccess(ctx, s.clock.Now().Add(-maxAge))
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if deleted > 0 {
+		s.logger.Debugf(ctx, "pruned %d secret access log entries older than %v", deleted, maxAge)
+	}
+	return nil
+}
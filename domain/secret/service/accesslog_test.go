@@ -0,0 +1,209 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coremodel "github.com/juju/juju/core/model"
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type accessLogSuite struct {
+	clock   *testclock.Clock
+	state   *MockState
+	service *SecretService
+}
+
+func TestAccessLogSuite(t *testing.T) {
+	tc.Run(t, &accessLogSuite{})
+}
+
+func (s *accessLogSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.clock = testclock.NewClock(time.Now().Truncate(time.Second))
+	s.state = NewMockState(ctrl)
+	s.service = &SecretService{
+		secretState: s.state,
+		encrypter:   passthroughEncrypter{},
+		clock:       s.clock,
+		logger:      loggertesting.WrapCheckLog(c),
+	}
+	return ctrl
+}
+
+func (s *accessLogSuite) TestGetSecretValueRecordFailureNotFatal(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	accessor := domainsecret.SecretAccessor{Kind: domainsecret.ApplicationAccessor, ID: "mariadb"}
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectApplication,
+		SubjectID:     "mariadb",
+	}).Return("view", nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+	s.state.EXPECT().RecordSecretAccess(gomock.Any(), uri, 1, accessor, s.clock.Now()).Return(errors.New("boom"))
+
+	val, _, err := s.service.GetSecretValue(c.Context(), uri, 1, accessor)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(val, tc.DeepEquals, coresecrets.NewSecretValue(map[string]string{"foo": "bar"}))
+}
+
+func (s *accessLogSuite) TestGetSecretValueRepeatedReadsRecordedOnce(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.service.recentAccess = newRecentAccess()
+
+	uri := coresecrets.NewURI()
+	accessor := domainsecret.SecretAccessor{Kind: domainsecret.UnitAccessor, ID: "mariadb/0"}
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("view", nil).Times(3)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(tc.Must0(c, coremodel.NewUUID), nil).AnyTimes()
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil).Times(3)
+	start := s.clock.Now()
+	s.state.EXPECT().RecordSecretAccess(gomock.Any(), uri, 1, accessor, start).Return(nil)
+	s.state.EXPECT().RecordSecretAccess(gomock.Any(), uri, 1, accessor, start.Add(secretAccessRecordInterval)).Return(nil)
+
+	_, _, err := s.service.GetSecretValue(c.Context(), uri, 1, accessor)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// A read within the interval is not recorded.
+	s.clock.Advance(time.Minute)
+	_, _, err = s.service.GetSecretValue(c.Context(), uri, 1, accessor)
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.clock.Advance(secretAccessRecordInterval - time.Minute)
+	_, _, err = s.service.GetSecretValue(c.Context(), uri, 1, accessor)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *accessLogSuite) TestGetSecretValueRecordFailureRetried(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.service.recentAccess = newRecentAccess()
+
+	uri := coresecrets.NewURI()
+	accessor := domainsecret.SecretAccessor{Kind: domainsecret.ApplicationAccessor, ID: "mariadb"}
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectApplication,
+		SubjectID:     "mariadb",
+	}).Return("view", nil).Times(2)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(tc.Must0(c, coremodel.NewUUID), nil).AnyTimes()
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil).Times(2)
+	gomock.InOrder(
+		s.state.EXPECT().RecordSecretAccess(gomock.Any(), uri, 1, accessor, s.clock.Now()).Return(errors.New("boom")),
+		s.state.EXPECT().RecordSecretAccess(gomock.Any(), uri, 1, accessor, s.clock.Now()).Return(nil),
+	)
+
+	for range 2 {
+		_, _, err := s.service.GetSecretValue(c.Context(), uri, 1, accessor)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+}
+
+// TestGetSecretValueReadsInClonedModelsRecorded asserts that reads of a
+// secret are recorded in each model holding a secret with its ID.
+func (s *accessLogSuite) TestGetSecretValueReadsInClonedModelsRecorded(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.service.recentAccess = newRecentAccess()
+
+	uri := coresecrets.NewURI()
+	accessor := domainsecret.SecretAccessor{Kind: domainsecret.UnitAccessor, ID: "mariadb/0"}
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("view", nil).Times(2)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil).Times(2)
+	gomock.InOrder(
+		s.state.EXPECT().GetModelUUID(gomock.Any()).Return(tc.Must0(c, coremodel.NewUUID), nil),
+		s.state.EXPECT().RecordSecretAccess(gomock.Any(), uri, 1, accessor, s.clock.Now()).Return(nil),
+		s.state.EXPECT().GetModelUUID(gomock.Any()).Return(tc.Must0(c, coremodel.NewUUID), nil),
+		s.state.EXPECT().RecordSecretAccess(gomock.Any(), uri, 1, accessor, s.clock.Now()).Return(nil),
+	)
+
+	for range 2 {
+		_, _, err := s.service.GetSecretValue(c.Context(), uri, 1, accessor)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+}
+
+func (s *accessLogSuite) TestGetSecretValueModelAccessorNotRecorded(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     "model-uuid",
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+
+	_, _, err := s.service.GetSecretValue(c.Context(), uri, 1, domainsecret.SecretAccessor{
+		Kind: domainsecret.ModelAccessor,
+		ID:   "model-uuid",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *accessLogSuite) TestRecordSecretAccess(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	accessor := domainsecret.SecretAccessor{Kind: domainsecret.UserAccessor, ID: "fred"}
+	s.state.EXPECT().RecordSecretAccess(gomock.Any(), uri, 2, accessor, s.clock.Now()).Return(nil)
+
+	err := s.service.RecordSecretAccess(c.Context(), uri, 2, accessor)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *accessLogSuite) TestRecordSecretAccessInvalidKind(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.RecordSecretAccess(c.Context(), coresecrets.NewURI(), 2, domainsecret.SecretAccessor{
+		Kind: "machine", ID: "0",
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *accessLogSuite) TestListSecretAccess(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	records := []domainsecret.SecretAccessRecord{{
+		Revision:        1,
+		Accessor:        domainsecret.SecretAccessor{Kind: domainsecret.UnitAccessor, ID: "mariadb/0"},
+		FirstAccessTime: s.clock.Now(),
+		LastAccessTime:  s.clock.Now(),
+		AccessCount:     3,
+	}}
+	s.state.EXPECT().ListSecretAccess(gomock.Any(), uri).Return(records, nil)
+
+	result, err := s.service.ListSecretAccess(c.Context(), uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, records)
+}
+
+func (s *accessLogSuite) TestPruneSecretAccessLog(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().PruneSecretAccess(gomock.Any(), s.clock.Now().Add(-time.Hour)).Return(int64(2), nil)
+
+	err := s.service.PruneSecretAccessLog(c.Context(), time.Hour)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *accessLogSuite) TestPruneSecretAccessLogInvalidAge(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.PruneSecretAccessLog(c.Context(), 0)
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
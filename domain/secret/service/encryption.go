@@ -0,0 +1,366 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/trace"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/envelope"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// errNoKeyEncryptionKey is returned when the controller has no
+// key-encryption key and can't create one.
+const errNoKeyEncryptionKey = errors.ConstError("no secret key-encryption key on this controller")
+
+// contentEncrypter encrypts and decrypts secret content held in the model
+// database.
+type contentEncrypter interface {
+	// encrypt returns the data with each value encrypted.
+	encrypt(ctx context.Context, data map[string]string) (map[string]string, error)
+
+	// decrypt returns the data with each encrypted value decrypted. Values
+	// stored before encryption was enabled are returned unchanged.
+	decrypt(ctx context.Context, data map[string]string) (map[string]string, error)
+
+	// rewrap re-wraps the model's data keys with the controller's current
+	// key-encryption key, returning the number of keys changed.
+	rewrap(ctx context.Context) (int, error)
+}
+
+// envelopeEncrypter encrypts secret content with model data keys, which
+// are in turn wrapped by a key-encryption key held in the controller's key
+// store or by an external key management service.
+type envelopeEncrypter struct {
+	secretState        State
+	secretBackendState SecretBackendState
+	keyStore           envelope.KeyStore
+	uuidGenerator      func() (uuid.UUID, error)
+	clock              clock.Clock
+	logger             logger.Logger
+
+	mu sync.Mutex
+	// keys caches unwrapped data keys by UUID.
+	keys map[string]envelope.DataKey
+}
+
+func newEnvelopeEncrypter(
+	secretState State,
+	secretBackendState SecretBackendState,
+	keyStore envelope.KeyStore,
+	clock clock.Clock,
+	logger logger.Logger,
+) *envelopeEncrypter {
+	return &envelopeEncrypter{
+		secretState:        secretState,
+		secretBackendState: secretBackendState,
+		keyStore:           keyStore,
+		uuidGenerator:      uuid.NewUUID,
+		clock:              clock,
+		logger:             logger,
+		keys:               make(map[string]envelope.DataKey),
+	}
+}
+
+func (e *envelopeEncrypter) encrypt(ctx context.Context, data map[string]string) (map[string]string, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+	key, err := e.activeDataKey(ctx)
+	if errors.Is(err, errNoKeyEncryptionKey) {
+		// This is a known limitation of controllers upgraded with more
+		// than one machine: content is stored unencrypted, as it was
+		// before encryption was enabled, until a key is created.
+		e.logger.Warningf(ctx, "storing secret content unencrypted: %v", err)
+		return data, nil
+	} else if err != nil {
+		return nil, errors.Errorf("getting secret data key: %w", err)
+	}
+	result := make(map[string]string, len(data))
+	for name, value := range data {
+		if result[name], err = envelope.EncryptValue(key, value); err != nil {
+			return nil, errors.Errorf("encrypting secret content %q: %w", name, err)
+		}
+	}
+	return result, nil
+}
+
+func (e *envelopeEncrypter) decrypt(ctx context.Context, data map[string]string) (map[string]string, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+	result := make(map[string]string, len(data))
+	for name, value := range data {
+		if !envelope.IsEncrypted(value) {
+			result[name] = value
+			continue
+		}
+		keyID, err := envelope.DataKeyID(value)
+		if err != nil {
+			return nil, errors.Errorf("decrypting secret content %q: %w", name, err)
+		}
+		key, err := e.dataKey(ctx, keyID)
+		if err != nil {
+			return nil, errors.Errorf("getting secret data key %q: %w", keyID, err)
+		}
+		if result[name], err = envelope.DecryptValue(key, value); err != nil {
+			return nil, errors.Errorf("decrypting secret content %q: %w", name, err)
+		}
+	}
+	return result, nil
+}
+
+func (e *envelopeEncrypter) rewrap(ctx context.Context) (int, error) {
+	wrapper, err := e.keyWrapper(ctx)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	keys, err := e.secretState.GetSecretDataKeys(ctx)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	var changed []domainsecret.DataKey
+	for _, key := range keys {
+		rewrapped, ok, err := wrapper.Rewrap(ctx, key.WrappedKey)
+		if err != nil {
+			return 0, errors.Errorf("rewrapping secret data key %q: %w", key.UUID, err)
+		}
+		if ok {
+			key.WrappedKey = rewrapped
+			changed = append(changed, key)
+		}
+	}
+	if err := e.secretState.UpdateSecretDataKeys(ctx, changed); err != nil {
+		return 0, errors.Capture(err)
+	}
+	return len(changed), nil
+}
+
+// activeDataKey returns the most recently created data key for the model,
+// creating the first one if needed.
+func (e *envelopeEncrypter) activeDataKey(ctx context.Context) (envelope.DataKey, error) {
+	keys, err := e.secretState.GetSecretDataKeys(ctx)
+	if err != nil {
+		return envelope.DataKey{}, errors.Capture(err)
+	}
+	if len(keys) > 0 {
+		return e.unwrap(ctx, keys[len(keys)-1])
+	}
+
+	wrapper, err := e.keyWrapper(ctx)
+	if err != nil {
+		return envelope.DataKey{}, errors.Capture(err)
+	}
+	material, err := envelope.NewKey()
+	if err != nil {
+		return envelope.DataKey{}, errors.Capture(err)
+	}
+	wrapped, err := wrapper.Wrap(ctx, material)
+	if err != nil {
+		return envelope.DataKey{}, errors.Capture(err)
+	}
+	id, err := e.uuidGenerator()
+	if err != nil {
+		return envelope.DataKey{}, errors.Capture(err)
+	}
+	if err := e.secretState.AddSecretDataKey(ctx, domainsecret.DataKey{
+		UUID:       id.String(),
+		WrappedKey: wrapped,
+		CreatedAt:  e.clock.Now().UTC(),
+	}); err != nil {
+		return envelope.DataKey{}, errors.Capture(err)
+	}
+	key := envelope.DataKey{ID: id.String(), Key: material}
+	e.cache(key)
+	return key, nil
+}
+
+// dataKey returns the unwrapped data key with the given UUID.
+func (e *envelopeEncrypter) dataKey(ctx context.Context, id string) (envelope.DataKey, error) {
+	if key, ok := e.cached(id); ok {
+		return key, nil
+	}
+	keys, err := e.secretState.GetSecretDataKeys(ctx)
+	if err != nil {
+		return envelope.DataKey{}, errors.Capture(err)
+	}
+	for _, key := range keys {
+		if key.UUID == id {
+			return e.unwrap(ctx, key)
+		}
+	}
+	return envelope.DataKey{}, errors.Errorf("secret data key %q not found", id)
+}
+
+func (e *envelopeEncrypter) unwrap(ctx context.Context, key domainsecret.DataKey) (envelope.DataKey, error) {
+	if cached, ok := e.cached(key.UUID); ok {
+		return cached, nil
+	}
+	wrapper, err := e.keyWrapper(ctx)
+	if err != nil {
+		return envelope.DataKey{}, errors.Capture(err)
+	}
+	material, err := wrapper.Unwrap(ctx, key.WrappedKey)
+	if err != nil {
+		return envelope.DataKey{}, errors.Capture(err)
+	}
+	result := envelope.DataKey{ID: key.UUID, Key: material}
+	e.cache(result)
+	return result, nil
+}
+
+func (e *envelopeEncrypter) cached(id string) (envelope.DataKey, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	key, ok := e.keys[id]
+	return key, ok
+}
+
+func (e *envelopeEncrypter) cache(key envelope.DataKey) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.keys[key.ID] = key
+}
+
+// keyWrapper returns the key wrapper for the controller's secret
+// encryption config. Data keys are wrapped by the configured key management
+// service, and can be unwrapped by any key-encryption key the controller
+// has used.
+func (e *envelopeEncrypter) keyWrapper(ctx context.Context) (envelope.KeyWrapper, error) {
+	cfg, err := e.secretBackendState.GetSecretEncryptionConfig(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting secret encryption config: %w", err)
+	}
+
+	// Local key-encryption keys are always available to unwrap data keys
+	// wrapped before the controller was configured to use an external
+	// key management service.
+	keks, err := e.keyStore.KeyEncryptionKeys()
+	if err != nil {
+		return nil, errors.Errorf("getting key-encryption keys: %w", err)
+	}
+	useVault := cfg.KMS == controller.SecretEncryptionKMSVaultTransit
+	if len(keks) == 0 && !useVault {
+		if keks, err = e.addKeyEncryptionKey(ctx); err != nil {
+			return nil, errors.Capture(err)
+		}
+	}
+	var local envelope.KeyWrapper
+	if len(keks) > 0 {
+		last := len(keks) - 1
+		if local, err = envelope.NewLocalKeyWrapper(keks[last], keks[:last]...); err != nil {
+			return nil, errors.Capture(err)
+		}
+	}
+	if !useVault {
+		return local, nil
+	}
+
+	token, err := envelope.ReadVaultTokenFile(cfg.VaultTokenFile)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	vault, err := envelope.NewVaultTransitKeyWrapper(envelope.VaultTransitConfig{
+		Address: cfg.VaultAddress,
+		Token:   token,
+		CACert:  cfg.VaultCACert,
+		Mount:   cfg.VaultTransitMount,
+		Key:     cfg.VaultTransitKey,
+	})
+	if err != nil {
+		return nil, errors.Errorf("creating vault transit key wrapper: %w", err)
+	}
+	if local == nil {
+		return vault, nil
+	}
+	return envelope.NewMultiKeyWrapper(vault, local), nil
+}
+
+// addKeyEncryptionKey creates the controller's first key-encryption key.
+// Only a controller with a single node may do so; the keys of a controller
+// with several nodes are created by its first node and copied to the others,
+// so that every node can unwrap the same data keys. A controller which had
+// several nodes before secret content was encrypted has no key to copy, so
+// [errNoKeyEncryptionKey] is returned.
+func (e *envelopeEncrypter) addKeyEncryptionKey(ctx context.Context) ([]envelope.KeyEncryptionKey, error) {
+	nodes, err := e.secretBackendState.GetControllerNodeCount(ctx)
+	if err != nil {
+		return nil, errors.Errorf("counting controller nodes: %w", err)
+	}
+	if nodes > 1 {
+		return nil, errors.Errorf("controller with %d nodes: %w", nodes, errNoKeyEncryptionKey)
+	}
+	kek, err := envelope.NewKeyEncryptionKey()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if err := e.keyStore.AddKeyEncryptionKeys(kek); err != nil {
+		return nil, errors.Errorf("adding key-encryption key: %w", err)
+	}
+	e.logger.Infof(ctx, "created secret key-encryption key %q", kek.ID)
+	return e.keyStore.KeyEncryptionKeys()
+}
+
+// EncryptSecretContent returns the secret content encrypted for storage in
+// the model database.
+func (s *SecretService) EncryptSecretContent(ctx context.Context, data map[string]string) (map[string]string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.encrypter.encrypt(ctx, data)
+}
+
+// DecryptSecretContent returns secret content read from the model database
+// decrypted.
+func (s *SecretService) DecryptSecretContent(ctx context.Context, data map[string]string) (map[string]string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.encrypter.decrypt(ctx, data)
+}
+
+// RewrapSecretDataKeys re-wraps the model's secret data keys with the
+// controller's current key-encryption key, and encrypts any secret content
+// stored in the model database before encryption was enabled. The content
+// encrypted with the data keys is not re-encrypted.
+func (s *SecretService) RewrapSecretDataKeys(ctx context.Context) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	n, err := s.encrypter.rewrap(ctx)
+	if err != nil {
+		return errors.Errorf("rewrapping secret data keys: %w", err)
+	}
+	s.logger.Debugf(ctx, "rewrapped %d secret data keys", n)
+
+	plaintext, err := s.secretState.GetSecretContentWithoutPrefix(ctx, envelope.EncryptedPrefix)
+	if err != nil {
+		return errors.Errorf("getting unencrypted secret content: %w", err)
+	}
+	if len(plaintext) == 0 {
+		return nil
+	}
+	previous := make([]string, len(plaintext))
+	for i, value := range plaintext {
+		previous[i] = value.Content
+		encrypted, err := s.encrypter.encrypt(ctx, map[string]string{value.Name: value.Content})
+		if err != nil {
+			return errors.Capture(err)
+		}
+		plaintext[i].Content = encrypted[value.Name]
+	}
+	if err := s.secretState.UpdateSecretContentValues(ctx, plaintext, previous); err != nil {
+		return errors.Errorf("encrypting secret content: %w", err)
+	}
+	s.logger.Infof(ctx, "encrypted %d secret content values", len(plaintext))
+	return nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/controller"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/domain/secretbackend"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/envelope"
+)
+
+type encryptionSuite struct {
+	state              *MockState
+	secretBackendState *MockSecretBackendState
+	keyStore           *envelope.FileKeyStore
+	clock              *testclock.Clock
+}
+
+func TestEncryptionSuite(t *testing.T) {
+	tc.Run(t, &encryptionSuite{})
+}
+
+func (s *encryptionSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.secretBackendState = NewMockSecretBackendState(ctrl)
+	s.keyStore = envelope.NewFileKeyStore(envelope.KeyStorePath(c.MkDir()))
+	s.clock = testclock.NewClock(time.Now())
+	return ctrl
+}
+
+func (s *encryptionSuite) newService(c *tc.C) *SecretService {
+	return &SecretService{
+		secretState:        s.state,
+		secretBackendState: s.secretBackendState,
+		encrypter:          newEnvelopeEncrypter(s.state, s.secretBackendState, s.keyStore, s.clock, loggertesting.WrapCheckLog(c)),
+		clock:              s.clock,
+		logger:             loggertesting.WrapCheckLog(c),
+	}
+}
+
+func (s *encryptionSuite) newKEK(c *tc.C, id string) envelope.KeyEncryptionKey {
+	material, err := envelope.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	return envelope.KeyEncryptionKey{ID: id, Key: material}
+}
+
+func (s *encryptionSuite) wrapDataKey(c *tc.C, kek envelope.KeyEncryptionKey, material []byte) string {
+	wrapper, err := envelope.NewLocalKeyWrapper(kek)
+	c.Assert(err, tc.ErrorIsNil)
+	wrapped, err := wrapper.Wrap(c.Context(), material)
+	c.Assert(err, tc.ErrorIsNil)
+	return wrapped
+}
+
+func (s *encryptionSuite) TestEncryptCreatesKeys(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var dataKey domainsecret.DataKey
+	s.state.EXPECT().GetSecretDataKeys(gomock.Any()).Return(nil, nil)
+	s.secretBackendState.EXPECT().GetSecretEncryptionConfig(gomock.Any()).Return(secretbackend.EncryptionConfig{
+		KMS: controller.SecretEncryptionKMSInternal,
+	}, nil)
+	s.secretBackendState.EXPECT().GetControllerNodeCount(gomock.Any()).Return(1, nil)
+	s.state.EXPECT().AddSecretDataKey(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, k domainsecret.DataKey) error {
+			dataKey = k
+			return nil
+		})
+
+	encrypted, err := s.newService(c).EncryptSecretContent(c.Context(), map[string]string{"password": "s3cret"})
+	c.Assert(err, tc.ErrorIsNil)
+	keks, err := s.keyStore.KeyEncryptionKeys()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(keks, tc.HasLen, 1)
+	c.Check(encrypted["password"], tc.Matches, `juju-enc:v1:`+dataKey.UUID+`:.+`)
+	c.Check(dataKey.WrappedKey, tc.Matches, `local:`+keks[0].ID+`:.+`)
+	c.Check(dataKey.CreatedAt, tc.Equals, s.clock.Now().UTC())
+
+	// A new service reads the stored keys to decrypt the content.
+	s.state.EXPECT().GetSecretDataKeys(gomock.Any()).Return([]domainsecret.DataKey{dataKey}, nil)
+	s.secretBackendState.EXPECT().GetSecretEncryptionConfig(gomock.Any()).Return(secretbackend.EncryptionConfig{
+		KMS: controller.SecretEncryptionKMSInternal,
+	}, nil)
+
+	decrypted, err := s.newService(c).DecryptSecretContent(c.Context(), encrypted)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(decrypted, tc.DeepEquals, map[string]string{"password": "s3cret"})
+}
+
+// TestEncryptNoKeyHighAvailability asserts the known limitation of a
+// controller which had several machines before secret content was
+// encrypted: it has no key-encryption key to copy to its machines, so
+// content is stored unencrypted.
+func (s *encryptionSuite) TestEncryptNoKeyHighAvailability(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetSecretDataKeys(gomock.Any()).Return(nil, nil)
+	s.secretBackendState.EXPECT().GetSecretEncryptionConfig(gomock.Any()).Return(secretbackend.EncryptionConfig{
+		KMS: controller.SecretEncryptionKMSInternal,
+	}, nil)
+	s.secretBackendState.EXPECT().GetControllerNodeCount(gomock.Any()).Return(3, nil)
+
+	encrypted, err := s.newService(c).EncryptSecretContent(c.Context(), map[string]string{"password": "s3cret"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(encrypted, tc.DeepEquals, map[string]string{"password": "s3cret"})
+
+	keks, err := s.keyStore.KeyEncryptionKeys()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(keks, tc.HasLen, 0)
+}
+
+func (s *encryptionSuite) TestDecryptUnencrypted(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	decrypted, err := s.newService(c).DecryptSecretContent(c.Context(), map[string]string{"password": "s3cret"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(decrypted, tc.DeepEquals, map[string]string{"password": "s3cret"})
+}
+
+func (s *encryptionSuite) TestDecryptUnknownDataKey(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	material, err := envelope.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	encrypted, err := envelope.EncryptValue(envelope.DataKey{ID: "dek-1", Key: material}, "s3cret")
+	c.Assert(err, tc.ErrorIsNil)
+	s.state.EXPECT().GetSecretDataKeys(gomock.Any()).Return(nil, nil)
+
+	_, err = s.newService(c).DecryptSecretContent(c.Context(), map[string]string{"password": encrypted})
+	c.Assert(err, tc.ErrorMatches, `getting secret data key "dek-1": secret data key "dek-1" not found`)
+}
+
+func (s *encryptionSuite) TestRewrapSecretDataKeys(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	oldKEK := s.newKEK(c, "kek-1")
+	newKEK := s.newKEK(c, "kek-2")
+	material, err := envelope.NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	oldKey := domainsecret.DataKey{UUID: "dek-1", WrappedKey: s.wrapDataKey(c, oldKEK, material)}
+	currentKey := domainsecret.DataKey{UUID: "dek-2", WrappedKey: s.wrapDataKey(c, newKEK, material)}
+
+	err = s.keyStore.AddKeyEncryptionKeys(oldKEK, newKEK)
+	c.Assert(err, tc.ErrorIsNil)
+	s.secretBackendState.EXPECT().GetSecretEncryptionConfig(gomock.Any()).Return(secretbackend.EncryptionConfig{
+		KMS: controller.SecretEncryptionKMSInternal,
+	}, nil).Times(2)
+	s.state.EXPECT().GetSecretDataKeys(gomock.Any()).Return([]domainsecret.DataKey{oldKey, currentKey}, nil).Times(2)
+	s.state.EXPECT().UpdateSecretDataKeys(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, keys []domainsecret.DataKey) error {
+			c.Assert(keys, tc.HasLen, 1)
+			c.Check(keys[0].UUID, tc.Equals, "dek-1")
+			c.Check(keys[0].WrappedKey, tc.Matches, `local:kek-2:.+`)
+			return nil
+		})
+
+	// Content stored before encryption was enabled is encrypted with the
+	// active data key.
+	s.state.EXPECT().GetSecretContentWithoutPrefix(gomock.Any(), envelope.EncryptedPrefix).Return([]domainsecret.ContentValue{{
+		RevisionUUID: "rev-1",
+		Name:         "password",
+		Content:      "s3cret",
+	}}, nil)
+	s.state.EXPECT().UpdateSecretContentValues(gomock.Any(), gomock.Any(), []string{"s3cret"}).DoAndReturn(
+		func(_ context.Context, values []domainsecret.ContentValue, _ []string) error {
+			c.Assert(values, tc.HasLen, 1)
+			c.Check(values[0].RevisionUUID, tc.Equals, "rev-1")
+			decrypted, err := envelope.DecryptValue(envelope.DataKey{ID: "dek-2", Key: material}, values[0].Content)
+			c.Assert(err, tc.ErrorIsNil)
+			c.Check(decrypted, tc.Equals, "s3cret")
+			return nil
+		})
+
+	err = s.newService(c).RewrapSecretDataKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
@@ -0,0 +1,262 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/trace"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/domain/secretbackend"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+// CreateExternalUserSecret creates a user secret whose content is managed
+// outside of Juju, at the specified path in the named secret backend. The
+// content is never copied into Juju; it is read from the backend each time
+// the secret value is requested. It returns an error satisfying
+// [backenderrors.NotFound] if the backend does not exist, [coreerrors.NotSupported]
+// if the backend cannot read external content, [coreerrors.Forbidden] if the
+// backend's external-path-prefixes config does not allow the path, and
+// [secreterrors.SecretLabelAlreadyExists] if a user secret with the same
+// label already exists.
+func (s *SecretService) CreateExternalUserSecret(
+	ctx context.Context, uri *secrets.URI, params CreateExternalUserSecretParams,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if params.Path == "" {
+		return errors.Errorf("empty external secret path %w", coreerrors.NotValid)
+	}
+
+	backend, reader, err := s.getExternalContentReader(ctx, func(b *secretbackend.SecretBackend) bool {
+		return b.Name == params.BackendName
+	})
+	if err != nil {
+		return errors.Errorf("secret backend %q: %w", params.BackendName, err)
+	}
+	if err := checkExternalPath(backend, params.Path); err != nil {
+		return errors.Capture(err)
+	}
+	// Record the path the backend was allowed to read.
+	params.Path = strings.Trim(path.Clean("/"+params.Path), "/")
+
+	// Read the content now so that a bad reference is rejected up front,
+	// and to record the upstream version of the first revision.
+	value, version, err := reader.GetExternalContent(ctx, params.Path, "")
+	if err != nil {
+		return errors.Errorf("reading external secret content: %w", err)
+	}
+	if _, err := selectExternalKey(value, params.Key); err != nil {
+		return errors.Capture(err)
+	}
+
+	revisionID, err := s.uuidGenerator()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	now := s.clock.Now()
+	p := domainsecret.UpsertSecretParams{
+		Description:  params.Description,
+		Label:        params.Label,
+		RevisionUUID: new(revisionID.String()),
+		CreateTime:   now,
+		UpdateTime:   now,
+	}
+	ref := domainsecret.ExternalRef{
+		BackendID: backend.ID,
+		Path:      params.Path,
+		Key:       params.Key,
+	}
+	if err := s.secretState.CreateExternalUserSecret(ctx, params.Version, uri, p, ref, version); err != nil {
+		return errors.Errorf("creating external user secret: %w", err)
+	}
+	return nil
+}
+
+// RefreshExternalSecrets checks the upstream version of the content of each
+// externally managed secret in the model, and adds a new revision to any
+// secret whose content has changed, so that consumers are notified.
+// Secrets which cannot be read are skipped, and the errors for them are
+// returned together once all secrets have been checked.
+func (s *SecretService) RefreshExternalSecrets(ctx context.Context) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	externalSecrets, err := s.secretState.ListExternalSecrets(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	type backendReader struct {
+		backend *secretbackend.SecretBackend
+		reader  provider.ExternalContentReader
+	}
+	readers := make(map[string]backendReader)
+	var errs []error
+	for _, es := range externalSecrets {
+		br, ok := readers[es.BackendID]
+		if !ok {
+			br.backend, br.reader, err = s.getExternalContentReader(ctx, func(b *secretbackend.SecretBackend) bool {
+				return b.ID == es.BackendID
+			})
+			if err != nil {
+				errs = append(errs, errors.Errorf("secret %q: %w", es.URI.ID, err))
+				continue
+			}
+			readers[es.BackendID] = br
+		}
+		if err := checkExternalPath(br.backend, es.Path); err != nil {
+			errs = append(errs, errors.Errorf("secret %q: %w", es.URI.ID, err))
+			continue
+		}
+		reader := br.reader
+
+		_, version, err := reader.GetExternalContent(ctx, es.Path, "")
+		if err != nil {
+			errs = append(errs, errors.Errorf("secret %q: reading external content: %w", es.URI.ID, err))
+			continue
+		}
+		if version == es.LatestVersion {
+			continue
+		}
+
+		revisionID, err := s.uuidGenerator()
+		if err != nil {
+			return errors.Capture(err)
+		}
+		revision, err := s.secretState.AddExternalSecretRevision(
+			ctx, es.URI, revisionID.String(), version, s.clock.Now())
+		if err != nil {
+			errs = append(errs, errors.Errorf("secret %q: adding revision: %w", es.URI.ID, err))
+			continue
+		}
+		s.logger.Debugf(ctx, "external secret %q changed upstream, added revision %d", es.URI.ID, revision)
+	}
+	if len(errs) > 0 {
+		return errors.Errorf("refreshing external secrets: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// getExternalSecretValue returns the content of the specified revision of an
+// externally managed secret. It returns an error satisfying
+// [secreterrors.SecretNotExternal] if the secret content is managed by Juju.
+func (s *SecretService) getExternalSecretValue(
+	ctx context.Context, uri *secrets.URI, rev int,
+) (secrets.SecretValue, error) {
+	ref, err := s.secretState.GetSecretExternalRef(ctx, uri)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	version, err := s.secretState.GetSecretRevisionExternalVersion(ctx, uri, rev)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	backend, reader, err := s.getExternalContentReader(ctx, func(b *secretbackend.SecretBackend) bool {
+		return b.ID == ref.BackendID
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if err := checkExternalPath(backend, ref.Path); err != nil {
+		return nil, errors.Capture(err)
+	}
+	value, _, err := reader.GetExternalContent(ctx, ref.Path, version)
+	if err != nil {
+		return nil, errors.Errorf("reading external content of secret %q revision %d: %w", uri.ID, rev, err)
+	}
+	return selectExternalKey(value, ref.Key)
+}
+
+// getExternalContentReader returns the first secret backend matching the
+// specified predicate, and a reader for external content held in it.
+// The reader uses the admin config of the backend, so callers must check
+// the backend allows the content to be referenced with [checkExternalPath].
+func (s *SecretService) getExternalContentReader(
+	ctx context.Context, match func(*secretbackend.SecretBackend) bool,
+) (*secretbackend.SecretBackend, provider.ExternalContentReader, error) {
+	modelUUID, err := s.secretState.GetModelUUID(ctx)
+	if err != nil {
+		return nil, nil, errors.Errorf("getting model UUID: %w", err)
+	}
+	modelBackend, err := s.secretBackendState.GetModelSecretBackendDetails(ctx, modelUUID)
+	if err != nil {
+		return nil, nil, errors.Errorf("getting model secret backend: %w", err)
+	}
+	backends, err := s.secretBackendState.ListSecretBackendsForModel(ctx, modelUUID, true)
+	if err != nil {
+		return nil, nil, errors.Errorf("listing secret backends: %w", err)
+	}
+
+	for _, b := range backends {
+		if !match(b) {
+			continue
+		}
+		backend, err := s.getBackend(&provider.ModelBackendConfig{
+			ControllerUUID: modelBackend.ControllerUUID,
+			ModelUUID:      modelUUID.String(),
+			ModelName:      modelBackend.ModelName,
+			BackendConfig: provider.BackendConfig{
+				BackendType: b.BackendType,
+				Config:      b.Config,
+			},
+		})
+		if err != nil {
+			return nil, nil, errors.Errorf("acquiring secret backend %q: %w", b.Name, err)
+		}
+		reader, ok := backend.(provider.ExternalContentReader)
+		if !ok {
+			return nil, nil, errors.Errorf(
+				"%q secret backends do not support external secrets %w", b.BackendType, coreerrors.NotSupported)
+		}
+		return b, reader, nil
+	}
+	return nil, nil, errors.New("secret backend not found").Add(backenderrors.NotFound)
+}
+
+// checkExternalPath returns an error satisfying [coreerrors.Forbidden] if
+// the backend config does not allow secrets to reference external content at
+// the specified path.
+func checkExternalPath(backend *secretbackend.SecretBackend, path string) error {
+	if !provider.ExternalPathAllowed(backend.Config, path) {
+		return errors.Errorf(
+			"external secret path %q not allowed by secret backend %q %w", path, backend.Name, coreerrors.Forbidden)
+	}
+	return nil
+}
+
+// selectExternalKey returns the value of the specified key from external
+// content, or all of the content if no key is specified.
+func selectExternalKey(value secrets.SecretValue, key string) (secrets.SecretValue, error) {
+	if key == "" {
+		return value, nil
+	}
+	encoded, ok := value.EncodedValues()[key]
+	if !ok {
+		return nil, errors.Errorf("key %q in external secret content %w", key, coreerrors.NotFound)
+	}
+	return secrets.NewSecretValue(map[string]string{key: encoded}), nil
+}
+
+// isExternalSecret returns true if the content of the
+// specified secret is managed outside of Juju.
+func (s *SecretService) isExternalSecret(ctx context.Context, uri *secrets.URI) (bool, error) {
+	_, err := s.secretState.GetSecretExternalRef(ctx, uri)
+	if errors.Is(err, secreterrors.SecretNotExternal) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+	return true, nil
+}
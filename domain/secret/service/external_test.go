@@ -0,0 +1,335 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coremodel "github.com/juju/juju/core/model"
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/domain/secretbackend"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/provider"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type externalSuite struct {
+	clock                  *testclock.Clock
+	modelID                coremodel.UUID
+	fakeUUID               uuid.UUID
+	secretsBackend         *MockSecretsBackend
+	secretsBackendProvider *MockSecretBackendProvider
+	reader                 *MockExternalContentReader
+
+	state              *MockState
+	secretBackendState *MockSecretBackendState
+
+	service *SecretService
+}
+
+func TestExternalSuite(t *testing.T) {
+	tc.Run(t, &externalSuite{})
+}
+
+// externalBackend is a secrets backend which can read external content.
+type externalBackend struct {
+	*MockSecretsBackend
+	*MockExternalContentReader
+}
+
+func (s *externalSuite) SetUpTest(c *tc.C) {
+	s.modelID = tc.Must0(c, coremodel.NewUUID)
+	s.fakeUUID = tc.Must0(c, uuid.NewUUID)
+	s.clock = testclock.NewClock(time.Now().Truncate(24 * time.Hour))
+}
+
+func (s *externalSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.secretBackendState = NewMockSecretBackendState(ctrl)
+	s.secretsBackendProvider = NewMockSecretBackendProvider(ctrl)
+	s.secretsBackend = NewMockSecretsBackend(ctrl)
+	s.reader = NewMockExternalContentReader(ctrl)
+
+	s.service = &SecretService{
+		secretState:        s.state,
+		secretBackendState: s.secretBackendState,
+		encrypter:          passthroughEncrypter{},
+		providerGetter:     func(string) (provider.SecretBackendProvider, error) { return s.secretsBackendProvider, nil },
+		uuidGenerator:      func() (uuid.UUID, error) { return s.fakeUUID, nil },
+		clock:              s.clock,
+		logger:             loggertesting.WrapCheckLog(c),
+	}
+	return ctrl
+}
+
+func (s *externalSuite) expectBackends(readable bool) {
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil).AnyTimes()
+	s.secretBackendState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), s.modelID).Return(
+		secretbackend.ModelSecretBackend{
+			ControllerUUID: coretesting.ControllerTag.Id(),
+			ModelID:        s.modelID,
+			ModelName:      "some-model",
+		}, nil).AnyTimes()
+	s.secretBackendState.EXPECT().ListSecretBackendsForModel(gomock.Any(), s.modelID, true).Return(
+		[]*secretbackend.SecretBackend{{
+			ID:          "internal-id",
+			Name:        "internal",
+			BackendType: "controller",
+		}, {
+			ID:          "vault-id",
+			Name:        "myvault",
+			BackendType: "vault",
+			Config:      map[string]any{"endpoint": "http://vault", provider.ExternalPathPrefixesKey: "kv"},
+		}}, nil).AnyTimes()
+	s.secretsBackendProvider.EXPECT().NewBackend(&provider.ModelBackendConfig{
+		ControllerUUID: coretesting.ControllerTag.Id(),
+		ModelUUID:      s.modelID.String(),
+		ModelName:      "some-model",
+		BackendConfig: provider.BackendConfig{
+			BackendType: "vault",
+			Config:      map[string]any{"endpoint": "http://vault", provider.ExternalPathPrefixesKey: "kv"},
+		},
+	}).DoAndReturn(func(*provider.ModelBackendConfig) (provider.SecretsBackend, error) {
+		if !readable {
+			return s.secretsBackend, nil
+		}
+		return externalBackend{s.secretsBackend, s.reader}, nil
+	}).AnyTimes()
+}
+
+func (s *externalSuite) TestCreateExternalUserSecret(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectBackends(true)
+	s.reader.EXPECT().GetExternalContent(gomock.Any(), "kv/app/db", "").Return(
+		coresecrets.NewSecretValue(map[string]string{"password": "c2VjcmV0", "user": "cm9vdA=="}), "3", nil)
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().CreateExternalUserSecret(gomock.Any(), 1, uri, domainsecret.UpsertSecretParams{
+		Description:  new("a secret"),
+		Label:        new("my-secret"),
+		RevisionUUID: new(s.fakeUUID.String()),
+		CreateTime:   s.clock.Now(),
+		UpdateTime:   s.clock.Now(),
+	}, domainsecret.ExternalRef{
+		BackendID: "vault-id",
+		Path:      "kv/app/db",
+		Key:       "password",
+	}, "3").Return(nil)
+
+	err := s.service.CreateExternalUserSecret(c.Context(), uri, CreateExternalUserSecretParams{
+		Version:     1,
+		Description: new("a secret"),
+		Label:       new("my-secret"),
+		BackendName: "myvault",
+		Path:        "kv/app/db",
+		Key:         "password",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *externalSuite) TestCreateExternalUserSecretMissingKey(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectBackends(true)
+	s.reader.EXPECT().GetExternalContent(gomock.Any(), "kv/app/db", "").Return(
+		coresecrets.NewSecretValue(map[string]string{"user": "cm9vdA=="}), "3", nil)
+
+	err := s.service.CreateExternalUserSecret(c.Context(), coresecrets.NewURI(), CreateExternalUserSecretParams{
+		BackendName: "myvault",
+		Path:        "kv/app/db",
+		Key:         "password",
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotFound)
+}
+
+func (s *externalSuite) TestCreateExternalUserSecretBackendNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectBackends(true)
+
+	err := s.service.CreateExternalUserSecret(c.Context(), coresecrets.NewURI(), CreateExternalUserSecretParams{
+		BackendName: "other",
+		Path:        "kv/app/db",
+	})
+	c.Assert(err, tc.ErrorIs, backenderrors.NotFound)
+}
+
+func (s *externalSuite) TestCreateExternalUserSecretPathNotAllowed(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectBackends(true)
+
+	err := s.service.CreateExternalUserSecret(c.Context(), coresecrets.NewURI(), CreateExternalUserSecretParams{
+		BackendName: "myvault",
+		Path:        "secret/app/db",
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.Forbidden)
+}
+
+// TestCreateExternalUserSecretPathEscapes asserts that a path can't use ".."
+// to escape from an allowed prefix.
+func (s *externalSuite) TestCreateExternalUserSecretPathEscapes(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectBackends(true)
+
+	err := s.service.CreateExternalUserSecret(c.Context(), coresecrets.NewURI(), CreateExternalUserSecretParams{
+		BackendName: "myvault",
+		Path:        "kv/../secret/app/db",
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.Forbidden)
+}
+
+func (s *externalSuite) TestCreateExternalUserSecretNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectBackends(false)
+
+	err := s.service.CreateExternalUserSecret(c.Context(), coresecrets.NewURI(), CreateExternalUserSecretParams{
+		BackendName: "myvault",
+		Path:        "kv/app/db",
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
+}
+
+func (s *externalSuite) TestGetSecretValueExternal(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectBackends(true)
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("view", nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 2).Return(
+		nil, nil, errors.Errorf("boom %w", secreterrors.SecretRevisionNotFound))
+	s.state.EXPECT().GetSecretExternalRef(gomock.Any(), uri).Return(domainsecret.ExternalRef{
+		BackendID: "vault-id",
+		Path:      "kv/app/db",
+		Key:       "password",
+	}, nil)
+	s.state.EXPECT().GetSecretRevisionExternalVersion(gomock.Any(), uri, 2).Return("3", nil)
+	s.reader.EXPECT().GetExternalContent(gomock.Any(), "kv/app/db", "3").Return(
+		coresecrets.NewSecretValue(map[string]string{"password": "c2VjcmV0", "user": "cm9vdA=="}), "3", nil)
+	s.state.EXPECT().RecordSecretAccess(gomock.Any(), uri, 2, gomock.Any(), gomock.Any()).Return(nil)
+
+	val, ref, err := s.service.GetSecretValue(c.Context(), uri, 2, domainsecret.SecretAccessor{
+		Kind: domainsecret.UnitAccessor,
+		ID:   "mariadb/0",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(ref, tc.IsNil)
+	c.Assert(val, tc.DeepEquals, coresecrets.NewSecretValue(map[string]string{"password": "c2VjcmV0"}))
+}
+
+func (s *externalSuite) TestGetSecretValueRevisionNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("view", nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 2).Return(
+		nil, nil, errors.Errorf("boom %w", secreterrors.SecretRevisionNotFound))
+	s.state.EXPECT().GetSecretExternalRef(gomock.Any(), uri).Return(
+		domainsecret.ExternalRef{}, secreterrors.SecretNotExternal)
+
+	_, _, err := s.service.GetSecretValue(c.Context(), uri, 2, domainsecret.SecretAccessor{
+		Kind: domainsecret.UnitAccessor,
+		ID:   "mariadb/0",
+	})
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
+
+func (s *externalSuite) TestRefreshExternalSecrets(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectBackends(true)
+	unchanged := coresecrets.NewURI()
+	changed := coresecrets.NewURI()
+	s.state.EXPECT().ListExternalSecrets(gomock.Any()).Return([]domainsecret.ExternalSecret{{
+		URI:            unchanged,
+		ExternalRef:    domainsecret.ExternalRef{BackendID: "vault-id", Path: "kv/a"},
+		LatestRevision: 1,
+		LatestVersion:  "1",
+	}, {
+		URI:            changed,
+		ExternalRef:    domainsecret.ExternalRef{BackendID: "vault-id", Path: "kv/b"},
+		LatestRevision: 2,
+		LatestVersion:  "2",
+	}}, nil)
+	value := coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	s.reader.EXPECT().GetExternalContent(gomock.Any(), "kv/a", "").Return(value, "1", nil)
+	s.reader.EXPECT().GetExternalContent(gomock.Any(), "kv/b", "").Return(value, "5", nil)
+	s.state.EXPECT().AddExternalSecretRevision(gomock.Any(), changed, s.fakeUUID.String(), "5", s.clock.Now()).Return(3, nil)
+
+	err := s.service.RefreshExternalSecrets(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *externalSuite) TestRefreshExternalSecretsContinuesOnError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectBackends(true)
+	missing := coresecrets.NewURI()
+	changed := coresecrets.NewURI()
+	s.state.EXPECT().ListExternalSecrets(gomock.Any()).Return([]domainsecret.ExternalSecret{{
+		URI:            missing,
+		ExternalRef:    domainsecret.ExternalRef{BackendID: "vault-id", Path: "kv/a"},
+		LatestRevision: 1,
+		LatestVersion:  "1",
+	}, {
+		URI:            changed,
+		ExternalRef:    domainsecret.ExternalRef{BackendID: "vault-id", Path: "kv/b"},
+		LatestRevision: 1,
+		LatestVersion:  "1",
+	}}, nil)
+	s.reader.EXPECT().GetExternalContent(gomock.Any(), "kv/a", "").Return(
+		nil, "", errors.Errorf("gone %w", secreterrors.SecretNotFound))
+	s.reader.EXPECT().GetExternalContent(gomock.Any(), "kv/b", "").Return(
+		coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"}), "2", nil)
+	s.state.EXPECT().AddExternalSecretRevision(gomock.Any(), changed, s.fakeUUID.String(), "2", s.clock.Now()).Return(2, nil)
+
+	err := s.service.RefreshExternalSecrets(c.Context())
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretNotFound)
+	c.Assert(err, tc.ErrorMatches, `refreshing external secrets: secret "`+missing.ID+`": reading external content: gone.*`)
+}
+
+func (s *externalSuite) TestUpdateUserSecretExternalContent(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil).AnyTimes()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     s.modelID.String(),
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretExternalRef(gomock.Any(), uri).Return(domainsecret.ExternalRef{
+		BackendID: "vault-id",
+		Path:      "kv/app/db",
+	}, nil)
+
+	err := s.service.UpdateUserSecret(c.Context(), uri, UpdateUserSecretParams{
+		Accessor: domainsecret.SecretAccessor{
+			Kind: domainsecret.ModelAccessor,
+			ID:   s.modelID.String(),
+		},
+		Data: map[string]string{"foo": "bar"},
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
+}
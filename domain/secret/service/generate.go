@@ -0,0 +1,116 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/trace"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+)
+
+// generatedSecretRetryDelay is how long to wait before generating content
+// for a secret again, after failing to do so.
+const generatedSecretRetryDelay = 5 * time.Minute
+
+// RotateGeneratedSecrets generates new content for each of the specified
+// user secrets whose content is generated by Juju, adding a new revision so
+// that consumers are notified. Secrets which are no longer generated or
+// rotated are skipped. A secret which cannot be rotated is rescheduled to be
+// retried later, and the errors are returned together once all secrets have
+// been processed.
+func (s *SecretService) RotateGeneratedSecrets(ctx context.Context, uris ...*secrets.URI) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if len(uris) == 0 {
+		return nil
+	}
+	secretIDs := make([]string, len(uris))
+	for i, uri := range uris {
+		secretIDs[i] = uri.ID
+	}
+	generated, err := s.secretState.GetGeneratedSecrets(ctx, secretIDs...)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if len(generated) == 0 {
+		return nil
+	}
+
+	modelUUID, err := s.secretState.GetModelUUID(ctx)
+	if err != nil {
+		return errors.Errorf("getting model UUID: %w", err)
+	}
+	accessor := domainsecret.SecretAccessor{
+		Kind: domainsecret.ModelAccessor,
+		ID:   modelUUID.String(),
+	}
+
+	now := s.clock.Now()
+	var errs []error
+	for _, gs := range generated {
+		err := s.rotateGeneratedSecret(ctx, gs, accessor, now)
+		if err == nil {
+			continue
+		}
+		errs = append(errs, errors.Errorf("secret %q: %w", gs.URI.ID, err))
+		// Updating the next rotation time triggers the rotation
+		// watcher again once the retry delay has passed.
+		if err := s.secretState.SecretRotated(ctx, gs.URI, now.Add(generatedSecretRetryDelay)); err != nil {
+			errs = append(errs, errors.Errorf("secret %q: scheduling retry: %w", gs.URI.ID, err))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Errorf("rotating generated secrets: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func (s *SecretService) rotateGeneratedSecret(
+	ctx context.Context, gs domainsecret.GeneratedSecret, accessor domainsecret.SecretAccessor, now time.Time,
+) error {
+	data, checksum, err := generateSecretContent(gs.Generator)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	err = s.UpdateUserSecret(ctx, gs.URI, UpdateUserSecretParams{
+		Accessor: accessor,
+		Data:     data,
+		Checksum: checksum,
+	})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	// The next rotation is scheduled from now rather than from when the
+	// rotation was due, so that a controller which has been down for a
+	// while does not rotate the same secret repeatedly to catch up.
+	next := gs.RotatePolicy.NextRotateTime(now)
+	if next == nil {
+		return nil
+	}
+	if err := s.secretState.SecretRotated(ctx, gs.URI, *next); err != nil {
+		return errors.Errorf("setting next rotate time: %w", err)
+	}
+	s.logger.Debugf(ctx, "generated new content for secret %q, next rotation at %s", gs.URI.ID, next)
+	return nil
+}
+
+// generateSecretContent returns new secret content
+// generated as per the spec, and its checksum.
+func generateSecretContent(spec secrets.GeneratorSpec) (secrets.SecretData, string, error) {
+	data, err := spec.Generate()
+	if err != nil {
+		return nil, "", errors.Capture(err)
+	}
+	checksum, err := secrets.NewSecretValue(data).Checksum()
+	if err != nil {
+		return nil, "", errors.Errorf("calculating secret checksum: %w", err)
+	}
+	return data, checksum, nil
+}
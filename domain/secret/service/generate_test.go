@@ -0,0 +1,242 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coremodel "github.com/juju/juju/core/model"
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/provider"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type generateSuite struct {
+	clock                  *testclock.Clock
+	modelID                coremodel.UUID
+	fakeUUID               uuid.UUID
+	secretsBackend         *MockSecretsBackend
+	secretsBackendProvider *MockSecretBackendProvider
+
+	state              *MockState
+	secretBackendState *MockSecretBackendState
+
+	service *SecretService
+}
+
+func TestGenerateSuite(t *testing.T) {
+	tc.Run(t, &generateSuite{})
+}
+
+func (s *generateSuite) SetUpTest(c *tc.C) {
+	s.modelID = tc.Must0(c, coremodel.NewUUID)
+	s.fakeUUID = tc.Must0(c, uuid.NewUUID)
+	s.clock = testclock.NewClock(time.Now().Truncate(24 * time.Hour))
+}
+
+func (s *generateSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.secretBackendState = NewMockSecretBackendState(ctrl)
+	s.secretsBackendProvider = NewMockSecretBackendProvider(ctrl)
+	s.secretsBackend = NewMockSecretsBackend(ctrl)
+
+	s.service = &SecretService{
+		secretState:        s.state,
+		secretBackendState: s.secretBackendState,
+		encrypter:          passthroughEncrypter{},
+		providerGetter:     func(string) (provider.SecretBackendProvider, error) { return s.secretsBackendProvider, nil },
+		uuidGenerator:      func() (uuid.UUID, error) { return s.fakeUUID, nil },
+		clock:              s.clock,
+		logger:             loggertesting.WrapCheckLog(c),
+	}
+	return ctrl
+}
+
+func (s *generateSuite) modelAccessor() domainsecret.SecretAccessor {
+	return domainsecret.SecretAccessor{
+		Kind: domainsecret.ModelAccessor,
+		ID:   s.modelID.String(),
+	}
+}
+
+// expectInternalBackend sets up the model's secret backend as one which
+// stores content in the controller database.
+func (s *generateSuite) expectInternalBackend() {
+	cfg := &provider.ModelBackendConfig{
+		ControllerUUID: coretesting.ControllerTag.Id(),
+		ModelUUID:      s.modelID.String(),
+		ModelName:      "some-model",
+		BackendConfig: provider.BackendConfig{
+			BackendType: "controller",
+		},
+	}
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil).AnyTimes()
+	s.secretBackendState.EXPECT().GetActiveModelSecretBackend(gomock.Any(), s.modelID).Return("backend-id", cfg, nil)
+	s.secretsBackendProvider.EXPECT().Initialise(cfg).Return(nil)
+	s.state.EXPECT().ListGrantedSecretsForBackend(gomock.Any(), "backend-id", gomock.Any(), gomock.Any()).Return(nil, nil)
+	s.secretsBackendProvider.EXPECT().IssuesTokens().Return(false)
+	s.secretsBackendProvider.EXPECT().RestrictedConfig(
+		gomock.Any(), cfg, true, false, "", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+	).Return(&cfg.BackendConfig, nil)
+	s.secretsBackendProvider.EXPECT().NewBackend(gomock.Any()).Return(s.secretsBackend, nil)
+	s.secretBackendState.EXPECT().AddSecretBackendReference(
+		gomock.Any(), nil, s.modelID, s.fakeUUID.String(), gomock.Any(),
+	).Return(func() error { return nil }, nil)
+}
+
+func (s *generateSuite) TestCreateUserSecretGenerated(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.expectInternalBackend()
+	s.secretsBackend.EXPECT().SaveContent(gomock.Any(), uri, 1, gomock.Any()).
+		Return("", errors.Errorf("not supported %w", coreerrors.NotSupported))
+
+	var got domainsecret.UpsertSecretParams
+	s.state.EXPECT().CreateUserSecret(gomock.Any(), 1, uri, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int, _ *coresecrets.URI, p domainsecret.UpsertSecretParams) error {
+			got = p
+			return nil
+		})
+
+	spec := coresecrets.GeneratorSpec{Format: coresecrets.GeneratePassword, Length: 16}
+	err := s.service.CreateUserSecret(c.Context(), uri, CreateUserSecretParams{
+		UpdateUserSecretParams: UpdateUserSecretParams{
+			Accessor: s.modelAccessor(),
+			Label:    new("my secret"),
+		},
+		Generator:    &spec,
+		RotatePolicy: new(coresecrets.RotateDaily),
+		Version:      1,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(got.Generator, tc.DeepEquals, &spec)
+	c.Check(got.RotatePolicy, tc.DeepEquals, new(domainsecret.RotateDaily))
+	c.Check(got.NextRotateTime, tc.DeepEquals, coresecrets.RotateDaily.NextRotateTime(s.clock.Now()))
+	c.Check(got.Checksum, tc.Not(tc.Equals), "")
+
+	value := coresecrets.NewSecretValue(got.Data)
+	password, err := value.KeyValue(coresecrets.GeneratedValueKey)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(password, tc.HasLen, 16)
+}
+
+func (s *generateSuite) TestCreateUserSecretGeneratedWithData(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.CreateUserSecret(c.Context(), coresecrets.NewURI(), CreateUserSecretParams{
+		UpdateUserSecretParams: UpdateUserSecretParams{
+			Accessor: s.modelAccessor(),
+			Data:     map[string]string{"foo": "bar"},
+		},
+		Generator: &coresecrets.GeneratorSpec{Format: coresecrets.GenerateHex},
+		Version:   1,
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *generateSuite) TestCreateUserSecretInvalidGenerator(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.CreateUserSecret(c.Context(), coresecrets.NewURI(), CreateUserSecretParams{
+		UpdateUserSecretParams: UpdateUserSecretParams{
+			Accessor: s.modelAccessor(),
+		},
+		Generator: &coresecrets.GeneratorSpec{Format: "bad"},
+		Version:   1,
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *generateSuite) TestCreateUserSecretRotateWithoutGenerator(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.CreateUserSecret(c.Context(), coresecrets.NewURI(), CreateUserSecretParams{
+		UpdateUserSecretParams: UpdateUserSecretParams{
+			Accessor: s.modelAccessor(),
+			Data:     map[string]string{"foo": "bar"},
+		},
+		RotatePolicy: new(coresecrets.RotateDaily),
+		Version:      1,
+	})
+	c.Assert(err, tc.ErrorMatches, "rotate policy for user secret without a generator.*")
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *generateSuite) TestRotateGeneratedSecretsNotGenerated(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetGeneratedSecrets(gomock.Any(), uri.ID).Return(nil, nil)
+
+	err := s.service.RotateGeneratedSecrets(c.Context(), uri)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *generateSuite) TestRotateGeneratedSecrets(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetGeneratedSecrets(gomock.Any(), uri.ID).Return([]domainsecret.GeneratedSecret{{
+		URI:            uri,
+		Generator:      coresecrets.GeneratorSpec{Format: coresecrets.GenerateHex, Length: 20},
+		RotatePolicy:   coresecrets.RotateHourly,
+		NextRotateTime: s.clock.Now().Add(-time.Minute),
+	}}, nil)
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     s.modelID.String(),
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretExternalRef(gomock.Any(), uri).Return(domainsecret.ExternalRef{}, secreterrors.SecretNotExternal)
+	s.expectInternalBackend()
+	s.state.EXPECT().GetLatestRevision(gomock.Any(), uri).Return(1, nil)
+	s.secretsBackend.EXPECT().SaveContent(gomock.Any(), uri, 2, gomock.Any()).
+		Return("", errors.Errorf("not supported %w", coreerrors.NotSupported))
+
+	var got domainsecret.UpsertSecretParams
+	s.state.EXPECT().UpdateSecret(gomock.Any(), uri, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *coresecrets.URI, p domainsecret.UpsertSecretParams) error {
+			got = p
+			return nil
+		})
+	s.state.EXPECT().SecretRotated(gomock.Any(), uri, *coresecrets.RotateHourly.NextRotateTime(s.clock.Now())).Return(nil)
+
+	err := s.service.RotateGeneratedSecrets(c.Context(), uri)
+	c.Assert(err, tc.ErrorIsNil)
+
+	value, err := coresecrets.NewSecretValue(got.Data).KeyValue(coresecrets.GeneratedValueKey)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(value, tc.HasLen, 20)
+	c.Check(got.Checksum, tc.Not(tc.Equals), "")
+}
+
+func (s *generateSuite) TestRotateGeneratedSecretsRetriesOnError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetGeneratedSecrets(gomock.Any(), uri.ID).Return([]domainsecret.GeneratedSecret{{
+		URI:          uri,
+		Generator:    coresecrets.GeneratorSpec{Format: "bad"},
+		RotatePolicy: coresecrets.RotateHourly,
+	}}, nil)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil)
+	s.state.EXPECT().SecretRotated(gomock.Any(), uri, s.clock.Now().Add(generatedSecretRetryDelay)).Return(nil)
+
+	err := s.service.RotateGeneratedSecrets(c.Context(), uri)
+	c.Assert(err, tc.ErrorMatches, `rotating generated secrets: secret ".*": generator format "bad" not valid`)
+}
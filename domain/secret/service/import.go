@@ -126,7 +126,9 @@ func (s *SecretService) importSecretWithRevisions(
 
 		if rev.ValueRef == nil {
 			if data, ok := content[rev.Revision]; ok {
-				params.Data = data
+				if params.Data, err = s.encrypter.encrypt(ctx, data); err != nil {
+					return errors.Capture(err)
+				}
 			} else {
 				return errors.Errorf("missing content for secret %s/%d", md.URI.ID, rev.Revision)
 			}
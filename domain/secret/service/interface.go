@@ -52,6 +52,49 @@ type State interface {
 	// CreateUserSecret creates a new user-owned secret.
 	CreateUserSecret(ctx context.Context, version int, uri *secrets.URI, secret domainsecret.UpsertSecretParams) error
 
+	// CreateExternalUserSecret creates a new user-owned secret whose
+	// content is held at the specified external reference.
+	CreateExternalUserSecret(
+		ctx context.Context, version int, uri *secrets.URI, secret domainsecret.UpsertSecretParams,
+		ref domainsecret.ExternalRef, upstreamVersion string,
+	) error
+
+	// AddExternalSecretRevision adds a new revision to the externally
+	// managed secret, recording the upstream version of the content.
+	AddExternalSecretRevision(
+		ctx context.Context, uri *secrets.URI, revisionUUID string, upstreamVersion string, updateTime time.Time,
+	) (int, error)
+
+	// GetSecretExternalRef returns the external reference for the
+	// specified secret.
+	GetSecretExternalRef(ctx context.Context, uri *secrets.URI) (domainsecret.ExternalRef, error)
+
+	// GetSecretRevisionExternalVersion returns the upstream version of
+	// the content of the specified external secret revision.
+	GetSecretRevisionExternalVersion(ctx context.Context, uri *secrets.URI, revision int) (string, error)
+
+	// ListExternalSecrets returns all externally managed secrets in the
+	// model.
+	ListExternalSecrets(ctx context.Context) ([]domainsecret.ExternalSecret, error)
+
+	// GetGeneratedSecrets returns the specified user secrets whose content
+	// is generated by Juju and which have a rotation scheduled.
+	GetGeneratedSecrets(ctx context.Context, secretIDs ...string) ([]domainsecret.GeneratedSecret, error)
+
+	// RecordSecretAccess records that the accessor has read the content
+	// of the specified secret revision.
+	RecordSecretAccess(
+		ctx context.Context, uri *secrets.URI, revision int, accessor domainsecret.SecretAccessor, now time.Time,
+	) error
+
+	// ListSecretAccess returns the recorded reads of the
+	// content of the specified secret.
+	ListSecretAccess(ctx context.Context, uri *secrets.URI) ([]domainsecret.SecretAccessRecord, error)
+
+	// PruneSecretAccess deletes the recorded reads of secret content
+	// which were last read before the specified time.
+	PruneSecretAccess(ctx context.Context, before time.Time) (int64, error)
+
 	// GetSecret returns metadata for the secret identified by URI.
 	GetSecret(ctx context.Context, uri *secrets.URI) (*secrets.SecretMetadata, error)
 
@@ -237,6 +280,16 @@ type State interface {
 		ctx context.Context, appOwners domainsecret.ApplicationOwners, unitOwners domainsecret.UnitOwners, secretIDs ...string,
 	) ([]domainsecret.RotationInfo, error)
 
+	// InitialWatchStatementForGeneratedSecretsRotationChanges returns the
+	// table name and namespace query for watching the rotation of user
+	// secrets whose content is generated by Juju.
+	InitialWatchStatementForGeneratedSecretsRotationChanges() (string, eventsource.NamespaceQuery)
+
+	// GetGeneratedSecretsRotationChanges returns rotation info for user
+	// secrets whose content is generated by Juju, limited to the optional
+	// secret IDs.
+	GetGeneratedSecretsRotationChanges(ctx context.Context, secretIDs ...string) ([]domainsecret.RotationInfo, error)
+
 	// InitialWatchStatementForSecretsRevisionExpiryChanges returns the
 	// table name and namespace query for watching secret revision expiry changes.
 	InitialWatchStatementForSecretsRevisionExpiryChanges(
@@ -263,6 +316,26 @@ type State interface {
 	// AllRemoteSecrets returns all remote secrets.
 	AllRemoteSecrets(ctx context.Context) ([]domainsecret.RemoteSecretInfo, error)
 
+	// GetSecretDataKeys returns the keys used to encrypt secret content in
+	// the model, oldest first.
+	GetSecretDataKeys(ctx context.Context) ([]domainsecret.DataKey, error)
+
+	// AddSecretDataKey records a new key used to encrypt secret content in
+	// the model.
+	AddSecretDataKey(ctx context.Context, key domainsecret.DataKey) error
+
+	// UpdateSecretDataKeys replaces the wrapped key material of the given
+	// data keys.
+	UpdateSecretDataKeys(ctx context.Context, keys []domainsecret.DataKey) error
+
+	// GetSecretContentWithoutPrefix returns the secret content values
+	// which don't start with the given prefix.
+	GetSecretContentWithoutPrefix(ctx context.Context, prefix string) ([]domainsecret.ContentValue, error)
+
+	// UpdateSecretContentValues replaces the stored secret content values,
+	// provided they still hold the corresponding previous content.
+	UpdateSecretContentValues(ctx context.Context, values []domainsecret.ContentValue, previous []string) error
+
 	// NamespaceForWatchSecretMetadata returns namespace identifier for
 	// secret metadata watcher.
 	NamespaceForWatchSecretMetadata() string
@@ -317,4 +390,11 @@ type SecretBackendState interface {
 	// GetSecretBackendNamesByUUID returns a map of backend UUID to backend name for all backends.
 	// An empty map will be returned if there are no backends.
 	GetSecretBackendNamesByUUID(ctx context.Context) (map[string]string, error)
+
+	// GetSecretEncryptionConfig returns the controller configuration used
+	// to encrypt secret content stored in model databases.
+	GetSecretEncryptionConfig(ctx context.Context) (secretbackend.EncryptionConfig, error)
+
+	// GetControllerNodeCount returns the number of controller nodes.
+	GetControllerNodeCount(ctx context.Context) (int, error)
 }
@@ -36,64 +36,80 @@ type MockState struct {
 
 // MockStateMockRecorder is the mock recorder for MockState.
 type MockStateMockRecorder struct {
-	mock                                                        *MockState
-	allRemoteSecretsExpects                                     []*gomock.Call1_2[context.Context, []secret.RemoteSecretInfo, error]
-	allSecretConsumersExpects                                   []*gomock.Call1_2[context.Context, map[string][]secret.ConsumerInfo, error]
-	allSecretGrantsExpects                                      []*gomock.Call1_2[context.Context, map[string][]secret.GrantDetails, error]
-	allSecretRemoteConsumersExpects                             []*gomock.Call1_2[context.Context, map[string][]secret.ConsumerInfo, error]
-	changeSecretBackendExpects                                  []*gomock.Call4_1[context.Context, uuid.UUID, *secrets.ValueRef, secrets.SecretData, error]
-	createUserSecretExpects                                     []*gomock.Call4_1[context.Context, int, *secrets.URI, secret.UpsertSecretParams, error]
-	getApplicationUUIDExpects                                   []*gomock.Call2_2[context.Context, string, application.UUID, error]
-	getApplicationUUIDsForNamesExpects                          []*gomock.Call2_2[context.Context, secret.ApplicationOwners, []string, error]
-	getConsumedRemoteSecretURIsWithChangesExpects               []*gomock.Call2V_2[context.Context, unit.Name, string, []string, error]
-	getConsumedSecretURIsWithChangesExpects                     []*gomock.Call2V_2[context.Context, unit.Name, string, []string, error]
-	getLatestRevisionExpects                                    []*gomock.Call2_2[context.Context, *secrets.URI, int, error]
-	getLatestRevisionsExpects                                   []*gomock.Call2_2[context.Context, []*secrets.URI, map[string]int, error]
-	getModelUUIDExpects                                         []*gomock.Call1_2[context.Context, model.UUID, error]
-	getObsoleteUserSecretRevisionsReadyToPruneExpects           []*gomock.Call1_2[context.Context, []string, error]
-	getOwnedSecretIDsExpects                                    []*gomock.Call3_2[context.Context, []string, []string, []string, error]
-	getRegularRelationUUIDByEndpointIdentifiersExpects          []*gomock.Call3_2[context.Context, relation.EndpointIdentifier, relation.EndpointIdentifier, string, error]
-	getRelationEndpointsExpects                                 []*gomock.Call2_2[context.Context, string, []relation.EndpointIdentifier, error]
-	getRevisionIDsForObsoleteExpects                            []*gomock.Call4_2[context.Context, secret.ApplicationOwners, secret.UnitOwners, []string, []string, error]
-	getRotationExpiryInfoExpects                                []*gomock.Call2_2[context.Context, *secrets.URI, *secret.RotationExpiryInfo, error]
-	getSecretExpects                                            []*gomock.Call2_2[context.Context, *secrets.URI, *secrets.SecretMetadata, error]
-	getSecretAccessExpects                                      []*gomock.Call3_2[context.Context, *secrets.URI, secret.AccessParams, string, error]
-	getSecretAccessRelationScopeExpects                         []*gomock.Call3_2[context.Context, *secrets.URI, secret.AccessParams, string, error]
-	getSecretByURIExpects                                       []*gomock.Call3_3[context.Context, secrets.URI, *int, *secrets.SecretMetadata, []*secrets.SecretRevisionMetadata, error]
-	getSecretConsumerExpects                                    []*gomock.Call3_3[context.Context, *secrets.URI, unit.Name, *secrets.SecretConsumerMetadata, int, error]
-	getSecretGrantsExpects                                      []*gomock.Call3_2[context.Context, *secrets.URI, secrets.SecretRole, []secret.GrantDetails, error]
-	getSecretOwnerKindsExpects                                  []*gomock.Call2_2[context.Context, []*secrets.URI, []secret.SecretOwnerInfo, error]
-	getSecretRevisionUUIDExpects                                []*gomock.Call3_2[context.Context, *secrets.URI, int, string, error]
-	getSecretValueExpects                                       []*gomock.Call3_3[context.Context, *secrets.URI, int, secrets.SecretData, *secrets.ValueRef, error]
-	getSecretsRevisionExpiryChangesExpects                      []*gomock.Call3V_2[context.Context, secret.ApplicationOwners, secret.UnitOwners, string, []secret.ExpiryInfo, error]
-	getSecretsRotationChangesExpects                            []*gomock.Call3V_2[context.Context, secret.ApplicationOwners, secret.UnitOwners, string, []secret.RotationInfo, error]
-	getURIByConsumerLabelExpects                                []*gomock.Call3_2[context.Context, string, unit.Name, *secrets.URI, error]
-	getUnitReservedSecretIDsExpects                             []*gomock.Call2_2[context.Context, unit.UUID, []string, error]
-	getUnitUUIDExpects                                          []*gomock.Call2_2[context.Context, unit.Name, unit.UUID, error]
-	getUnitUUIDsForNamesExpects                                 []*gomock.Call2_2[context.Context, secret.UnitOwners, []string, error]
-	getUserSecretURIByLabelExpects                              []*gomock.Call2_2[context.Context, string, *secrets.URI, error]
-	grantAccessExpects                                          []*gomock.Call3_1[context.Context, *secrets.URI, secret.GrantParams, error]
-	importSecretWithRevisionsExpects                            []*gomock.Call6_1[context.Context, int, *secrets.URI, secret.Owner, secret.UpsertSecretParams, []secret.UpsertRevisionParams, error]
-	initialWatchStatementForConsumedRemoteSecretsChangeExpects  []*gomock.Call1_2[unit.Name, string, eventsource.NamespaceQuery]
-	initialWatchStatementForConsumedSecretsChangeExpects        []*gomock.Call1_2[unit.Name, string, eventsource.NamespaceQuery]
-	initialWatchStatementForObsoleteRevisionExpects             []*gomock.Call2_2[secret.ApplicationOwners, secret.UnitOwners, string, eventsource.NamespaceQuery]
-	initialWatchStatementForSecretsRevisionExpiryChangesExpects []*gomock.Call2_2[secret.ApplicationOwners, secret.UnitOwners, string, eventsource.NamespaceQuery]
-	initialWatchStatementForSecretsRotationChangesExpects       []*gomock.Call2_2[secret.ApplicationOwners, secret.UnitOwners, string, eventsource.NamespaceQuery]
-	listAllSecretsExpects                                       []*gomock.Call1_3[context.Context, []*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error]
-	listCharmSecretsExpects                                     []*gomock.Call3_3[context.Context, secret.ApplicationOwners, secret.UnitOwners, []*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error]
-	listCharmSecretsToDrainExpects                              []*gomock.Call3_2[context.Context, secret.ApplicationOwners, secret.UnitOwners, []*secrets.SecretMetadataForDrain, error]
-	listGrantedSecretsForBackendExpects                         []*gomock.Call4_2[context.Context, string, []secret.AccessParams, []secret.Role, []*secrets.SecretRevisionRef, error]
-	listSecretsByLabelsExpects                                  []*gomock.Call3_3[context.Context, secret.Labels, *int, []*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error]
-	listUserSecretsToDrainExpects                               []*gomock.Call1_2[context.Context, []*secrets.SecretMetadataForDrain, error]
-	namespaceForWatchSecretMetadataExpects                      []*gomock.Call0_1[string]
-	namespaceForWatchSecretRevisionObsoleteExpects              []*gomock.Call0_1[string]
-	reserveSecretURIsExpects                                    []*gomock.Call3_1[context.Context, unit.UUID, []string, error]
-	revokeAccessExpects                                         []*gomock.Call3_1[context.Context, *secrets.URI, secret.RevokeParams, error]
-	saveSecretConsumerExpects                                   []*gomock.Call4_1[context.Context, *secrets.URI, unit.Name, secrets.SecretConsumerMetadata, error]
-	scheduleObsoleteUserSecretRevisionsPruningExpects           []*gomock.Call3_1[context.Context, string, time.Time, error]
-	scheduleUserSecretRemovalExpects                            []*gomock.Call5_1[context.Context, string, *secrets.URI, []int, time.Time, error]
-	secretRotatedExpects                                        []*gomock.Call3_1[context.Context, *secrets.URI, time.Time, error]
-	updateSecretExpects                                         []*gomock.Call3_1[context.Context, *secrets.URI, secret.UpsertSecretParams, error]
+	mock                                                           *MockState
+	addExternalSecretRevisionExpects                               []*gomock.Call5_2[context.Context, *secrets.URI, string, string, time.Time, int, error]
+	addSecretDataKeyExpects                                        []*gomock.Call2_1[context.Context, secret.DataKey, error]
+	allRemoteSecretsExpects                                        []*gomock.Call1_2[context.Context, []secret.RemoteSecretInfo, error]
+	allSecretConsumersExpects                                      []*gomock.Call1_2[context.Context, map[string][]secret.ConsumerInfo, error]
+	allSecretGrantsExpects                                         []*gomock.Call1_2[context.Context, map[string][]secret.GrantDetails, error]
+	allSecretRemoteConsumersExpects                                []*gomock.Call1_2[context.Context, map[string][]secret.ConsumerInfo, error]
+	changeSecretBackendExpects                                     []*gomock.Call4_1[context.Context, uuid.UUID, *secrets.ValueRef, secrets.SecretData, error]
+	createExternalUserSecretExpects                                []*gomock.Call6_1[context.Context, int, *secrets.URI, secret.UpsertSecretParams, secret.ExternalRef, string, error]
+	createUserSecretExpects                                        []*gomock.Call4_1[context.Context, int, *secrets.URI, secret.UpsertSecretParams, error]
+	getApplicationUUIDExpects                                      []*gomock.Call2_2[context.Context, string, application.UUID, error]
+	getApplicationUUIDsForNamesExpects                             []*gomock.Call2_2[context.Context, secret.ApplicationOwners, []string, error]
+	getConsumedRemoteSecretURIsWithChangesExpects                  []*gomock.Call2V_2[context.Context, unit.Name, string, []string, error]
+	getConsumedSecretURIsWithChangesExpects                        []*gomock.Call2V_2[context.Context, unit.Name, string, []string, error]
+	getGeneratedSecretsExpects                                     []*gomock.Call1V_2[context.Context, string, []secret.GeneratedSecret, error]
+	getGeneratedSecretsRotationChangesExpects                      []*gomock.Call1V_2[context.Context, string, []secret.RotationInfo, error]
+	getLatestRevisionExpects                                       []*gomock.Call2_2[context.Context, *secrets.URI, int, error]
+	getLatestRevisionsExpects                                      []*gomock.Call2_2[context.Context, []*secrets.URI, map[string]int, error]
+	getModelUUIDExpects                                            []*gomock.Call1_2[context.Context, model.UUID, error]
+	getObsoleteUserSecretRevisionsReadyToPruneExpects              []*gomock.Call1_2[context.Context, []string, error]
+	getOwnedSecretIDsExpects                                       []*gomock.Call3_2[context.Context, []string, []string, []string, error]
+	getRegularRelationUUIDByEndpointIdentifiersExpects             []*gomock.Call3_2[context.Context, relation.EndpointIdentifier, relation.EndpointIdentifier, string, error]
+	getRelationEndpointsExpects                                    []*gomock.Call2_2[context.Context, string, []relation.EndpointIdentifier, error]
+	getRevisionIDsForObsoleteExpects                               []*gomock.Call4_2[context.Context, secret.ApplicationOwners, secret.UnitOwners, []string, []string, error]
+	getRotationExpiryInfoExpects                                   []*gomock.Call2_2[context.Context, *secrets.URI, *secret.RotationExpiryInfo, error]
+	getSecretExpects                                               []*gomock.Call2_2[context.Context, *secrets.URI, *secrets.SecretMetadata, error]
+	getSecretAccessExpects                                         []*gomock.Call3_2[context.Context, *secrets.URI, secret.AccessParams, string, error]
+	getSecretAccessRelationScopeExpects                            []*gomock.Call3_2[context.Context, *secrets.URI, secret.AccessParams, string, error]
+	getSecretByURIExpects                                          []*gomock.Call3_3[context.Context, secrets.URI, *int, *secrets.SecretMetadata, []*secrets.SecretRevisionMetadata, error]
+	getSecretConsumerExpects                                       []*gomock.Call3_3[context.Context, *secrets.URI, unit.Name, *secrets.SecretConsumerMetadata, int, error]
+	getSecretContentWithoutPrefixExpects                           []*gomock.Call2_2[context.Context, string, []secret.ContentValue, error]
+	getSecretDataKeysExpects                                       []*gomock.Call1_2[context.Context, []secret.DataKey, error]
+	getSecretExternalRefExpects                                    []*gomock.Call2_2[context.Context, *secrets.URI, secret.ExternalRef, error]
+	getSecretGrantsExpects                                         []*gomock.Call3_2[context.Context, *secrets.URI, secrets.SecretRole, []secret.GrantDetails, error]
+	getSecretOwnerKindsExpects                                     []*gomock.Call2_2[context.Context, []*secrets.URI, []secret.SecretOwnerInfo, error]
+	getSecretRevisionExternalVersionExpects                        []*gomock.Call3_2[context.Context, *secrets.URI, int, string, error]
+	getSecretRevisionUUIDExpects                                   []*gomock.Call3_2[context.Context, *secrets.URI, int, string, error]
+	getSecretValueExpects                                          []*gomock.Call3_3[context.Context, *secrets.URI, int, secrets.SecretData, *secrets.ValueRef, error]
+	getSecretsRevisionExpiryChangesExpects                         []*gomock.Call3V_2[context.Context, secret.ApplicationOwners, secret.UnitOwners, string, []secret.ExpiryInfo, error]
+	getSecretsRotationChangesExpects                               []*gomock.Call3V_2[context.Context, secret.ApplicationOwners, secret.UnitOwners, string, []secret.RotationInfo, error]
+	getURIByConsumerLabelExpects                                   []*gomock.Call3_2[context.Context, string, unit.Name, *secrets.URI, error]
+	getUnitReservedSecretIDsExpects                                []*gomock.Call2_2[context.Context, unit.UUID, []string, error]
+	getUnitUUIDExpects                                             []*gomock.Call2_2[context.Context, unit.Name, unit.UUID, error]
+	getUnitUUIDsForNamesExpects                                    []*gomock.Call2_2[context.Context, secret.UnitOwners, []string, error]
+	getUserSecretURIByLabelExpects                                 []*gomock.Call2_2[context.Context, string, *secrets.URI, error]
+	grantAccessExpects                                             []*gomock.Call3_1[context.Context, *secrets.URI, secret.GrantParams, error]
+	importSecretWithRevisionsExpects                               []*gomock.Call6_1[context.Context, int, *secrets.URI, secret.Owner, secret.UpsertSecretParams, []secret.UpsertRevisionParams, error]
+	initialWatchStatementForConsumedRemoteSecretsChangeExpects     []*gomock.Call1_2[unit.Name, string, eventsource.NamespaceQuery]
+	initialWatchStatementForConsumedSecretsChangeExpects           []*gomock.Call1_2[unit.Name, string, eventsource.NamespaceQuery]
+	initialWatchStatementForGeneratedSecretsRotationChangesExpects []*gomock.Call0_2[string, eventsource.NamespaceQuery]
+	initialWatchStatementForObsoleteRevisionExpects                []*gomock.Call2_2[secret.ApplicationOwners, secret.UnitOwners, string, eventsource.NamespaceQuery]
+	initialWatchStatementForSecretsRevisionExpiryChangesExpects    []*gomock.Call2_2[secret.ApplicationOwners, secret.UnitOwners, string, eventsource.NamespaceQuery]
+	initialWatchStatementForSecretsRotationChangesExpects          []*gomock.Call2_2[secret.ApplicationOwners, secret.UnitOwners, string, eventsource.NamespaceQuery]
+	listAllSecretsExpects                                          []*gomock.Call1_3[context.Context, []*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error]
+	listCharmSecretsExpects                                        []*gomock.Call3_3[context.Context, secret.ApplicationOwners, secret.UnitOwners, []*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error]
+	listCharmSecretsToDrainExpects                                 []*gomock.Call3_2[context.Context, secret.ApplicationOwners, secret.UnitOwners, []*secrets.SecretMetadataForDrain, error]
+	listExternalSecretsExpects                                     []*gomock.Call1_2[context.Context, []secret.ExternalSecret, error]
+	listGrantedSecretsForBackendExpects                            []*gomock.Call4_2[context.Context, string, []secret.AccessParams, []secret.Role, []*secrets.SecretRevisionRef, error]
+	listSecretAccessExpects                                        []*gomock.Call2_2[context.Context, *secrets.URI, []secret.SecretAccessRecord, error]
+	listSecretsByLabelsExpects                                     []*gomock.Call3_3[context.Context, secret.Labels, *int, []*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error]
+	listUserSecretsToDrainExpects                                  []*gomock.Call1_2[context.Context, []*secrets.SecretMetadataForDrain, error]
+	namespaceForWatchSecretMetadataExpects                         []*gomock.Call0_1[string]
+	namespaceForWatchSecretRevisionObsoleteExpects                 []*gomock.Call0_1[string]
+	pruneSecretAccessExpects                                       []*gomock.Call2_2[context.Context, time.Time, int64, error]
+	recordSecretAccessExpects                                      []*gomock.Call5_1[context.Context, *secrets.URI, int, secret.SecretAccessor, time.Time, error]
+	reserveSecretURIsExpects                                       []*gomock.Call3_1[context.Context, unit.UUID, []string, error]
+	revokeAccessExpects                                            []*gomock.Call3_1[context.Context, *secrets.URI, secret.RevokeParams, error]
+	saveSecretConsumerExpects                                      []*gomock.Call4_1[context.Context, *secrets.URI, unit.Name, secrets.SecretConsumerMetadata, error]
+	scheduleObsoleteUserSecretRevisionsPruningExpects              []*gomock.Call3_1[context.Context, string, time.Time, error]
+	scheduleUserSecretRemovalExpects                               []*gomock.Call5_1[context.Context, string, *secrets.URI, []int, time.Time, error]
+	secretRotatedExpects                                           []*gomock.Call3_1[context.Context, *secrets.URI, time.Time, error]
+	updateSecretExpects                                            []*gomock.Call3_1[context.Context, *secrets.URI, secret.UpsertSecretParams, error]
+	updateSecretContentValuesExpects                               []*gomock.Call3_1[context.Context, []secret.ContentValue, []string, error]
+	updateSecretDataKeysExpects                                    []*gomock.Call2_1[context.Context, []secret.DataKey, error]
 }
 
 // NewMockState creates a new mock instance.
@@ -108,6 +124,42 @@ func (m *MockState) EXPECT() *MockStateMockRecorder {
 	return m.recorder
 }
 
+// AddExternalSecretRevision mocks base method.
+func (m *MockState) AddExternalSecretRevision(ctx context.Context, uri *secrets.URI, revisionUUID, upstreamVersion string, updateTime time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch5_2(&m.recorder.addExternalSecretRevisionExpects, m.ctrl, m, "AddExternalSecretRevision", ctx, uri, revisionUUID, upstreamVersion, updateTime)
+}
+
+// AddExternalSecretRevision indicates an expected call of AddExternalSecretRevision.
+func (mr *MockStateMockRecorder) AddExternalSecretRevision(ctx, uri, revisionUUID, upstreamVersion, updateTime any) *MockStateAddExternalSecretRevisionCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall5_2[context.Context, *secrets.URI, string, string, time.Time, int, error](mr.mock.ctrl.T, mr.mock, "AddExternalSecretRevision", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(uri), gomock.EnsureMatcher(revisionUUID), gomock.EnsureMatcher(upstreamVersion), gomock.EnsureMatcher(updateTime))
+	mr.addExternalSecretRevisionExpects = append(mr.addExternalSecretRevisionExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateAddExternalSecretRevisionCall is the typed call wrapper for AddExternalSecretRevision.
+type MockStateAddExternalSecretRevisionCall = gomock.Call5_2[context.Context, *secrets.URI, string, string, time.Time, int, error]
+
+// AddSecretDataKey mocks base method.
+func (m *MockState) AddSecretDataKey(ctx context.Context, key secret.DataKey) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.addSecretDataKeyExpects, m.ctrl, m, "AddSecretDataKey", ctx, key)
+}
+
+// AddSecretDataKey indicates an expected call of AddSecretDataKey.
+func (mr *MockStateMockRecorder) AddSecretDataKey(ctx, key any) *MockStateAddSecretDataKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, secret.DataKey, error](mr.mock.ctrl.T, mr.mock, "AddSecretDataKey", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(key))
+	mr.addSecretDataKeyExpects = append(mr.addSecretDataKeyExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateAddSecretDataKeyCall is the typed call wrapper for AddSecretDataKey.
+type MockStateAddSecretDataKeyCall = gomock.Call2_1[context.Context, secret.DataKey, error]
+
 // AllRemoteSecrets mocks base method.
 func (m *MockState) AllRemoteSecrets(ctx context.Context) ([]secret.RemoteSecretInfo, error) {
 	m.ctrl.T.Helper()
@@ -198,6 +250,24 @@ func (mr *MockStateMockRecorder) ChangeSecretBackend(ctx, revisionID, valueRef,
 // MockStateChangeSecretBackendCall is the typed call wrapper for ChangeSecretBackend.
 type MockStateChangeSecretBackendCall = gomock.Call4_1[context.Context, uuid.UUID, *secrets.ValueRef, secrets.SecretData, error]
 
+// CreateExternalUserSecret mocks base method.
+func (m *MockState) CreateExternalUserSecret(ctx context.Context, version int, uri *secrets.URI, arg3 secret.UpsertSecretParams, ref secret.ExternalRef, upstreamVersion string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch6_1(&m.recorder.createExternalUserSecretExpects, m.ctrl, m, "CreateExternalUserSecret", ctx, version, uri, arg3, ref, upstreamVersion)
+}
+
+// CreateExternalUserSecret indicates an expected call of CreateExternalUserSecret.
+func (mr *MockStateMockRecorder) CreateExternalUserSecret(ctx, version, uri, arg3, ref, upstreamVersion any) *MockStateCreateExternalUserSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall6_1[context.Context, int, *secrets.URI, secret.UpsertSecretParams, secret.ExternalRef, string, error](mr.mock.ctrl.T, mr.mock, "CreateExternalUserSecret", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(version), gomock.EnsureMatcher(uri), gomock.EnsureMatcher(arg3), gomock.EnsureMatcher(ref), gomock.EnsureMatcher(upstreamVersion))
+	mr.createExternalUserSecretExpects = append(mr.createExternalUserSecretExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateCreateExternalUserSecretCall is the typed call wrapper for CreateExternalUserSecret.
+type MockStateCreateExternalUserSecretCall = gomock.Call6_1[context.Context, int, *secrets.URI, secret.UpsertSecretParams, secret.ExternalRef, string, error]
+
 // CreateUserSecret mocks base method.
 func (m *MockState) CreateUserSecret(ctx context.Context, version int, uri *secrets.URI, arg3 secret.UpsertSecretParams) error {
 	m.ctrl.T.Helper()
@@ -290,6 +360,44 @@ func (mr *MockStateMockRecorder) GetConsumedSecretURIsWithChanges(ctx, unitName
 // MockStateGetConsumedSecretURIsWithChangesCall is the typed call wrapper for GetConsumedSecretURIsWithChanges.
 type MockStateGetConsumedSecretURIsWithChangesCall = gomock.Call2V_2[context.Context, unit.Name, string, []string, error]
 
+// GetGeneratedSecrets mocks base method.
+func (m *MockState) GetGeneratedSecrets(ctx context.Context, secretIDs ...string) ([]secret.GeneratedSecret, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1V_2(&m.recorder.getGeneratedSecretsExpects, m.ctrl, m, "GetGeneratedSecrets", ctx, secretIDs...)
+}
+
+// GetGeneratedSecrets indicates an expected call of GetGeneratedSecrets.
+func (mr *MockStateMockRecorder) GetGeneratedSecrets(ctx any, secretIDs ...any) *MockStateGetGeneratedSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	varArgs := gomock.EnsureVariadicMatcher(secretIDs)
+	call := gomock.NewCall1V_2[context.Context, string, []secret.GeneratedSecret, error](mr.mock.ctrl.T, mr.mock, "GetGeneratedSecrets", gomock.EnsureMatcher(ctx), varArgs)
+	mr.getGeneratedSecretsExpects = append(mr.getGeneratedSecretsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetGeneratedSecretsCall is the typed call wrapper for GetGeneratedSecrets.
+type MockStateGetGeneratedSecretsCall = gomock.Call1V_2[context.Context, string, []secret.GeneratedSecret, error]
+
+// GetGeneratedSecretsRotationChanges mocks base method.
+func (m *MockState) GetGeneratedSecretsRotationChanges(ctx context.Context, secretIDs ...string) ([]secret.RotationInfo, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1V_2(&m.recorder.getGeneratedSecretsRotationChangesExpects, m.ctrl, m, "GetGeneratedSecretsRotationChanges", ctx, secretIDs...)
+}
+
+// GetGeneratedSecretsRotationChanges indicates an expected call of GetGeneratedSecretsRotationChanges.
+func (mr *MockStateMockRecorder) GetGeneratedSecretsRotationChanges(ctx any, secretIDs ...any) *MockStateGetGeneratedSecretsRotationChangesCall {
+	mr.mock.ctrl.T.Helper()
+	varArgs := gomock.EnsureVariadicMatcher(secretIDs)
+	call := gomock.NewCall1V_2[context.Context, string, []secret.RotationInfo, error](mr.mock.ctrl.T, mr.mock, "GetGeneratedSecretsRotationChanges", gomock.EnsureMatcher(ctx), varArgs)
+	mr.getGeneratedSecretsRotationChangesExpects = append(mr.getGeneratedSecretsRotationChangesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetGeneratedSecretsRotationChangesCall is the typed call wrapper for GetGeneratedSecretsRotationChanges.
+type MockStateGetGeneratedSecretsRotationChangesCall = gomock.Call1V_2[context.Context, string, []secret.RotationInfo, error]
+
 // GetLatestRevision mocks base method.
 func (m *MockState) GetLatestRevision(ctx context.Context, uri *secrets.URI) (int, error) {
 	m.ctrl.T.Helper()
@@ -542,6 +650,60 @@ func (mr *MockStateMockRecorder) GetSecretConsumer(ctx, uri, unitName any) *Mock
 // MockStateGetSecretConsumerCall is the typed call wrapper for GetSecretConsumer.
 type MockStateGetSecretConsumerCall = gomock.Call3_3[context.Context, *secrets.URI, unit.Name, *secrets.SecretConsumerMetadata, int, error]
 
+// GetSecretContentWithoutPrefix mocks base method.
+func (m *MockState) GetSecretContentWithoutPrefix(ctx context.Context, prefix string) ([]secret.ContentValue, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getSecretContentWithoutPrefixExpects, m.ctrl, m, "GetSecretContentWithoutPrefix", ctx, prefix)
+}
+
+// GetSecretContentWithoutPrefix indicates an expected call of GetSecretContentWithoutPrefix.
+func (mr *MockStateMockRecorder) GetSecretContentWithoutPrefix(ctx, prefix any) *MockStateGetSecretContentWithoutPrefixCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, []secret.ContentValue, error](mr.mock.ctrl.T, mr.mock, "GetSecretContentWithoutPrefix", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(prefix))
+	mr.getSecretContentWithoutPrefixExpects = append(mr.getSecretContentWithoutPrefixExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetSecretContentWithoutPrefixCall is the typed call wrapper for GetSecretContentWithoutPrefix.
+type MockStateGetSecretContentWithoutPrefixCall = gomock.Call2_2[context.Context, string, []secret.ContentValue, error]
+
+// GetSecretDataKeys mocks base method.
+func (m *MockState) GetSecretDataKeys(ctx context.Context) ([]secret.DataKey, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getSecretDataKeysExpects, m.ctrl, m, "GetSecretDataKeys", ctx)
+}
+
+// GetSecretDataKeys indicates an expected call of GetSecretDataKeys.
+func (mr *MockStateMockRecorder) GetSecretDataKeys(ctx any) *MockStateGetSecretDataKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []secret.DataKey, error](mr.mock.ctrl.T, mr.mock, "GetSecretDataKeys", gomock.EnsureMatcher(ctx))
+	mr.getSecretDataKeysExpects = append(mr.getSecretDataKeysExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetSecretDataKeysCall is the typed call wrapper for GetSecretDataKeys.
+type MockStateGetSecretDataKeysCall = gomock.Call1_2[context.Context, []secret.DataKey, error]
+
+// GetSecretExternalRef mocks base method.
+func (m *MockState) GetSecretExternalRef(ctx context.Context, uri *secrets.URI) (secret.ExternalRef, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getSecretExternalRefExpects, m.ctrl, m, "GetSecretExternalRef", ctx, uri)
+}
+
+// GetSecretExternalRef indicates an expected call of GetSecretExternalRef.
+func (mr *MockStateMockRecorder) GetSecretExternalRef(ctx, uri any) *MockStateGetSecretExternalRefCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, *secrets.URI, secret.ExternalRef, error](mr.mock.ctrl.T, mr.mock, "GetSecretExternalRef", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(uri))
+	mr.getSecretExternalRefExpects = append(mr.getSecretExternalRefExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetSecretExternalRefCall is the typed call wrapper for GetSecretExternalRef.
+type MockStateGetSecretExternalRefCall = gomock.Call2_2[context.Context, *secrets.URI, secret.ExternalRef, error]
+
 // GetSecretGrants mocks base method.
 func (m *MockState) GetSecretGrants(ctx context.Context, uri *secrets.URI, role secrets.SecretRole) ([]secret.GrantDetails, error) {
 	m.ctrl.T.Helper()
@@ -578,6 +740,24 @@ func (mr *MockStateMockRecorder) GetSecretOwnerKinds(ctx, uris any) *MockStateGe
 // MockStateGetSecretOwnerKindsCall is the typed call wrapper for GetSecretOwnerKinds.
 type MockStateGetSecretOwnerKindsCall = gomock.Call2_2[context.Context, []*secrets.URI, []secret.SecretOwnerInfo, error]
 
+// GetSecretRevisionExternalVersion mocks base method.
+func (m *MockState) GetSecretRevisionExternalVersion(ctx context.Context, uri *secrets.URI, revision int) (string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.getSecretRevisionExternalVersionExpects, m.ctrl, m, "GetSecretRevisionExternalVersion", ctx, uri, revision)
+}
+
+// GetSecretRevisionExternalVersion indicates an expected call of GetSecretRevisionExternalVersion.
+func (mr *MockStateMockRecorder) GetSecretRevisionExternalVersion(ctx, uri, revision any) *MockStateGetSecretRevisionExternalVersionCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, *secrets.URI, int, string, error](mr.mock.ctrl.T, mr.mock, "GetSecretRevisionExternalVersion", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(uri), gomock.EnsureMatcher(revision))
+	mr.getSecretRevisionExternalVersionExpects = append(mr.getSecretRevisionExternalVersionExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetSecretRevisionExternalVersionCall is the typed call wrapper for GetSecretRevisionExternalVersion.
+type MockStateGetSecretRevisionExternalVersionCall = gomock.Call3_2[context.Context, *secrets.URI, int, string, error]
+
 // GetSecretRevisionUUID mocks base method.
 func (m *MockState) GetSecretRevisionUUID(ctx context.Context, uri *secrets.URI, revision int) (string, error) {
 	m.ctrl.T.Helper()
@@ -814,6 +994,24 @@ func (mr *MockStateMockRecorder) InitialWatchStatementForConsumedSecretsChange(u
 // MockStateInitialWatchStatementForConsumedSecretsChangeCall is the typed call wrapper for InitialWatchStatementForConsumedSecretsChange.
 type MockStateInitialWatchStatementForConsumedSecretsChangeCall = gomock.Call1_2[unit.Name, string, eventsource.NamespaceQuery]
 
+// InitialWatchStatementForGeneratedSecretsRotationChanges mocks base method.
+func (m *MockState) InitialWatchStatementForGeneratedSecretsRotationChanges() (string, eventsource.NamespaceQuery) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_2(&m.recorder.initialWatchStatementForGeneratedSecretsRotationChangesExpects, m.ctrl, m, "InitialWatchStatementForGeneratedSecretsRotationChanges")
+}
+
+// InitialWatchStatementForGeneratedSecretsRotationChanges indicates an expected call of InitialWatchStatementForGeneratedSecretsRotationChanges.
+func (mr *MockStateMockRecorder) InitialWatchStatementForGeneratedSecretsRotationChanges() *MockStateInitialWatchStatementForGeneratedSecretsRotationChangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_2[string, eventsource.NamespaceQuery](mr.mock.ctrl.T, mr.mock, "InitialWatchStatementForGeneratedSecretsRotationChanges")
+	mr.initialWatchStatementForGeneratedSecretsRotationChangesExpects = append(mr.initialWatchStatementForGeneratedSecretsRotationChangesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateInitialWatchStatementForGeneratedSecretsRotationChangesCall is the typed call wrapper for InitialWatchStatementForGeneratedSecretsRotationChanges.
+type MockStateInitialWatchStatementForGeneratedSecretsRotationChangesCall = gomock.Call0_2[string, eventsource.NamespaceQuery]
+
 // InitialWatchStatementForObsoleteRevision mocks base method.
 func (m *MockState) InitialWatchStatementForObsoleteRevision(appOwnerUUIDs secret.ApplicationOwners, unitOwnerUUIDs secret.UnitOwners) (string, eventsource.NamespaceQuery) {
 	m.ctrl.T.Helper()
@@ -922,6 +1120,24 @@ func (mr *MockStateMockRecorder) ListCharmSecretsToDrain(ctx, appOwners, unitOwn
 // MockStateListCharmSecretsToDrainCall is the typed call wrapper for ListCharmSecretsToDrain.
 type MockStateListCharmSecretsToDrainCall = gomock.Call3_2[context.Context, secret.ApplicationOwners, secret.UnitOwners, []*secrets.SecretMetadataForDrain, error]
 
+// ListExternalSecrets mocks base method.
+func (m *MockState) ListExternalSecrets(ctx context.Context) ([]secret.ExternalSecret, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.listExternalSecretsExpects, m.ctrl, m, "ListExternalSecrets", ctx)
+}
+
+// ListExternalSecrets indicates an expected call of ListExternalSecrets.
+func (mr *MockStateMockRecorder) ListExternalSecrets(ctx any) *MockStateListExternalSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []secret.ExternalSecret, error](mr.mock.ctrl.T, mr.mock, "ListExternalSecrets", gomock.EnsureMatcher(ctx))
+	mr.listExternalSecretsExpects = append(mr.listExternalSecretsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateListExternalSecretsCall is the typed call wrapper for ListExternalSecrets.
+type MockStateListExternalSecretsCall = gomock.Call1_2[context.Context, []secret.ExternalSecret, error]
+
 // ListGrantedSecretsForBackend mocks base method.
 func (m *MockState) ListGrantedSecretsForBackend(ctx context.Context, backendID string, accessors []secret.AccessParams, roles []secret.Role) ([]*secrets.SecretRevisionRef, error) {
 	m.ctrl.T.Helper()
@@ -940,6 +1156,24 @@ func (mr *MockStateMockRecorder) ListGrantedSecretsForBackend(ctx, backendID, ac
 // MockStateListGrantedSecretsForBackendCall is the typed call wrapper for ListGrantedSecretsForBackend.
 type MockStateListGrantedSecretsForBackendCall = gomock.Call4_2[context.Context, string, []secret.AccessParams, []secret.Role, []*secrets.SecretRevisionRef, error]
 
+// ListSecretAccess mocks base method.
+func (m *MockState) ListSecretAccess(ctx context.Context, uri *secrets.URI) ([]secret.SecretAccessRecord, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.listSecretAccessExpects, m.ctrl, m, "ListSecretAccess", ctx, uri)
+}
+
+// ListSecretAccess indicates an expected call of ListSecretAccess.
+func (mr *MockStateMockRecorder) ListSecretAccess(ctx, uri any) *MockStateListSecretAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, *secrets.URI, []secret.SecretAccessRecord, error](mr.mock.ctrl.T, mr.mock, "ListSecretAccess", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(uri))
+	mr.listSecretAccessExpects = append(mr.listSecretAccessExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateListSecretAccessCall is the typed call wrapper for ListSecretAccess.
+type MockStateListSecretAccessCall = gomock.Call2_2[context.Context, *secrets.URI, []secret.SecretAccessRecord, error]
+
 // ListSecretsByLabels mocks base method.
 func (m *MockState) ListSecretsByLabels(ctx context.Context, labels secret.Labels, revision *int) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error) {
 	m.ctrl.T.Helper()
@@ -1012,6 +1246,42 @@ func (mr *MockStateMockRecorder) NamespaceForWatchSecretRevisionObsolete() *Mock
 // MockStateNamespaceForWatchSecretRevisionObsoleteCall is the typed call wrapper for NamespaceForWatchSecretRevisionObsolete.
 type MockStateNamespaceForWatchSecretRevisionObsoleteCall = gomock.Call0_1[string]
 
+// PruneSecretAccess mocks base method.
+func (m *MockState) PruneSecretAccess(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.pruneSecretAccessExpects, m.ctrl, m, "PruneSecretAccess", ctx, before)
+}
+
+// PruneSecretAccess indicates an expected call of PruneSecretAccess.
+func (mr *MockStateMockRecorder) PruneSecretAccess(ctx, before any) *MockStatePruneSecretAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, time.Time, int64, error](mr.mock.ctrl.T, mr.mock, "PruneSecretAccess", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(before))
+	mr.pruneSecretAccessExpects = append(mr.pruneSecretAccessExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStatePruneSecretAccessCall is the typed call wrapper for PruneSecretAccess.
+type MockStatePruneSecretAccessCall = gomock.Call2_2[context.Context, time.Time, int64, error]
+
+// RecordSecretAccess mocks base method.
+func (m *MockState) RecordSecretAccess(ctx context.Context, uri *secrets.URI, revision int, accessor secret.SecretAccessor, now time.Time) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch5_1(&m.recorder.recordSecretAccessExpects, m.ctrl, m, "RecordSecretAccess", ctx, uri, revision, accessor, now)
+}
+
+// RecordSecretAccess indicates an expected call of RecordSecretAccess.
+func (mr *MockStateMockRecorder) RecordSecretAccess(ctx, uri, revision, accessor, now any) *MockStateRecordSecretAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall5_1[context.Context, *secrets.URI, int, secret.SecretAccessor, time.Time, error](mr.mock.ctrl.T, mr.mock, "RecordSecretAccess", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(uri), gomock.EnsureMatcher(revision), gomock.EnsureMatcher(accessor), gomock.EnsureMatcher(now))
+	mr.recordSecretAccessExpects = append(mr.recordSecretAccessExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateRecordSecretAccessCall is the typed call wrapper for RecordSecretAccess.
+type MockStateRecordSecretAccessCall = gomock.Call5_1[context.Context, *secrets.URI, int, secret.SecretAccessor, time.Time, error]
+
 // ReserveSecretURIs mocks base method.
 func (m *MockState) ReserveSecretURIs(ctx context.Context, unitUUID unit.UUID, secretIDs []string) error {
 	m.ctrl.T.Helper()
@@ -1138,6 +1408,42 @@ func (mr *MockStateMockRecorder) UpdateSecret(ctx, uri, arg2 any) *MockStateUpda
 // MockStateUpdateSecretCall is the typed call wrapper for UpdateSecret.
 type MockStateUpdateSecretCall = gomock.Call3_1[context.Context, *secrets.URI, secret.UpsertSecretParams, error]
 
+// UpdateSecretContentValues mocks base method.
+func (m *MockState) UpdateSecretContentValues(ctx context.Context, values []secret.ContentValue, previous []string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.updateSecretContentValuesExpects, m.ctrl, m, "UpdateSecretContentValues", ctx, values, previous)
+}
+
+// UpdateSecretContentValues indicates an expected call of UpdateSecretContentValues.
+func (mr *MockStateMockRecorder) UpdateSecretContentValues(ctx, values, previous any) *MockStateUpdateSecretContentValuesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, []secret.ContentValue, []string, error](mr.mock.ctrl.T, mr.mock, "UpdateSecretContentValues", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(values), gomock.EnsureMatcher(previous))
+	mr.updateSecretContentValuesExpects = append(mr.updateSecretContentValuesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateUpdateSecretContentValuesCall is the typed call wrapper for UpdateSecretContentValues.
+type MockStateUpdateSecretContentValuesCall = gomock.Call3_1[context.Context, []secret.ContentValue, []string, error]
+
+// UpdateSecretDataKeys mocks base method.
+func (m *MockState) UpdateSecretDataKeys(ctx context.Context, keys []secret.DataKey) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.updateSecretDataKeysExpects, m.ctrl, m, "UpdateSecretDataKeys", ctx, keys)
+}
+
+// UpdateSecretDataKeys indicates an expected call of UpdateSecretDataKeys.
+func (mr *MockStateMockRecorder) UpdateSecretDataKeys(ctx, keys any) *MockStateUpdateSecretDataKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, []secret.DataKey, error](mr.mock.ctrl.T, mr.mock, "UpdateSecretDataKeys", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(keys))
+	mr.updateSecretDataKeysExpects = append(mr.updateSecretDataKeysExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateUpdateSecretDataKeysCall is the typed call wrapper for UpdateSecretDataKeys.
+type MockStateUpdateSecretDataKeysCall = gomock.Call2_1[context.Context, []secret.DataKey, error]
+
 // MockSecretBackendState is a mock of SecretBackendState interface.
 type MockSecretBackendState struct {
 	ctrl     *gomock.Controller
@@ -1150,8 +1456,10 @@ type MockSecretBackendStateMockRecorder struct {
 	mock                                *MockSecretBackendState
 	addSecretBackendReferenceExpects    []*gomock.Call5_2[context.Context, *secrets.ValueRef, model.UUID, string, string, func() error, error]
 	getActiveModelSecretBackendExpects  []*gomock.Call2_3[context.Context, model.UUID, string, *provider.ModelBackendConfig, error]
+	getControllerNodeCountExpects       []*gomock.Call1_2[context.Context, int, error]
 	getModelSecretBackendDetailsExpects []*gomock.Call2_2[context.Context, model.UUID, secretbackend.ModelSecretBackend, error]
 	getSecretBackendNamesByUUIDExpects  []*gomock.Call1_2[context.Context, map[string]string, error]
+	getSecretEncryptionConfigExpects    []*gomock.Call1_2[context.Context, secretbackend.EncryptionConfig, error]
 	listSecretBackendsForModelExpects   []*gomock.Call3_2[context.Context, model.UUID, bool, []*secretbackend.SecretBackend, error]
 	updateSecretBackendReferenceExpects []*gomock.Call5_2[context.Context, *secrets.ValueRef, model.UUID, string, string, func() error, error]
 }
@@ -1204,6 +1512,24 @@ func (mr *MockSecretBackendStateMockRecorder) GetActiveModelSecretBackend(ctx, m
 // MockSecretBackendStateGetActiveModelSecretBackendCall is the typed call wrapper for GetActiveModelSecretBackend.
 type MockSecretBackendStateGetActiveModelSecretBackendCall = gomock.Call2_3[context.Context, model.UUID, string, *provider.ModelBackendConfig, error]
 
+// GetControllerNodeCount mocks base method.
+func (m *MockSecretBackendState) GetControllerNodeCount(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getControllerNodeCountExpects, m.ctrl, m, "GetControllerNodeCount", ctx)
+}
+
+// GetControllerNodeCount indicates an expected call of GetControllerNodeCount.
+func (mr *MockSecretBackendStateMockRecorder) GetControllerNodeCount(ctx any) *MockSecretBackendStateGetControllerNodeCountCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, int, error](mr.mock.ctrl.T, mr.mock, "GetControllerNodeCount", gomock.EnsureMatcher(ctx))
+	mr.getControllerNodeCountExpects = append(mr.getControllerNodeCountExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretBackendStateGetControllerNodeCountCall is the typed call wrapper for GetControllerNodeCount.
+type MockSecretBackendStateGetControllerNodeCountCall = gomock.Call1_2[context.Context, int, error]
+
 // GetModelSecretBackendDetails mocks base method.
 func (m *MockSecretBackendState) GetModelSecretBackendDetails(ctx context.Context, modelUUID model.UUID) (secretbackend.ModelSecretBackend, error) {
 	m.ctrl.T.Helper()
@@ -1240,6 +1566,24 @@ func (mr *MockSecretBackendStateMockRecorder) GetSecretBackendNamesByUUID(ctx an
 // MockSecretBackendStateGetSecretBackendNamesByUUIDCall is the typed call wrapper for GetSecretBackendNamesByUUID.
 type MockSecretBackendStateGetSecretBackendNamesByUUIDCall = gomock.Call1_2[context.Context, map[string]string, error]
 
+// GetSecretEncryptionConfig mocks base method.
+func (m *MockSecretBackendState) GetSecretEncryptionConfig(ctx context.Context) (secretbackend.EncryptionConfig, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getSecretEncryptionConfigExpects, m.ctrl, m, "GetSecretEncryptionConfig", ctx)
+}
+
+// GetSecretEncryptionConfig indicates an expected call of GetSecretEncryptionConfig.
+func (mr *MockSecretBackendStateMockRecorder) GetSecretEncryptionConfig(ctx any) *MockSecretBackendStateGetSecretEncryptionConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, secretbackend.EncryptionConfig, error](mr.mock.ctrl.T, mr.mock, "GetSecretEncryptionConfig", gomock.EnsureMatcher(ctx))
+	mr.getSecretEncryptionConfigExpects = append(mr.getSecretEncryptionConfigExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretBackendStateGetSecretEncryptionConfigCall is the typed call wrapper for GetSecretEncryptionConfig.
+type MockSecretBackendStateGetSecretEncryptionConfigCall = gomock.Call1_2[context.Context, secretbackend.EncryptionConfig, error]
+
 // ListSecretBackendsForModel mocks base method.
 func (m *MockSecretBackendState) ListSecretBackendsForModel(ctx context.Context, modelUUID model.UUID, includeEmpty bool) ([]*secretbackend.SecretBackend, error) {
 	m.ctrl.T.Helper()
@@ -4,11 +4,13 @@
 package service
 
 import (
+	"context"
+
 	"github.com/juju/juju/internal/errors"
 )
 
 //go:generate go run github.com/canonical/gomock/mockgen -package service -destination package_mock_test.go github.com/juju/juju/domain/secret/service State,SecretBackendState,WatcherFactory
-//go:generate go run github.com/canonical/gomock/mockgen -package service -destination provider_mock_test.go github.com/juju/juju/internal/secrets/provider SecretBackendProvider,SecretsBackend
+//go:generate go run github.com/canonical/gomock/mockgen -package service -destination provider_mock_test.go github.com/juju/juju/internal/secrets/provider SecretBackendProvider,SecretsBackend,ExternalContentReader
 //go:generate go run github.com/canonical/gomock/mockgen -package service -destination watcher_mock_test.go github.com/juju/juju/core/watcher StringsWatcher,NotifyWatcher
 //go:generate go run github.com/canonical/gomock/mockgen -package service -destination leader_mock_test.go github.com/juju/juju/core/leadership Ensurer
 
@@ -23,3 +25,19 @@ type badToken struct{}
 func (t badToken) Check() error {
 	return errors.New("not leader")
 }
+
+// passthroughEncrypter stores secret content as given, so tests not
+// concerned with encryption can match content passed to state.
+type passthroughEncrypter struct{}
+
+func (passthroughEncrypter) encrypt(_ context.Context, data map[string]string) (map[string]string, error) {
+	return data, nil
+}
+
+func (passthroughEncrypter) decrypt(_ context.Context, data map[string]string) (map[string]string, error) {
+	return data, nil
+}
+
+func (passthroughEncrypter) rewrap(context.Context) (int, error) {
+	return 0, nil
+}
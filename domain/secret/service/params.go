@@ -14,6 +14,13 @@ import (
 type CreateUserSecretParams struct {
 	UpdateUserSecretParams
 	Version int
+
+	// Generator, if set, is used to generate the secret content
+	// instead of it being supplied.
+	Generator *secrets.GeneratorSpec
+	// RotatePolicy is how often new content is generated.
+	// It may only be set if Generator is set.
+	RotatePolicy *secrets.RotatePolicy
 }
 
 // UpdateUserSecretParams are used to update a user secret.
@@ -27,6 +34,21 @@ type UpdateUserSecretParams struct {
 	AutoPrune   *bool
 }
 
+// CreateExternalUserSecretParams are used to create a user secret
+// whose content is managed outside of Juju.
+type CreateExternalUserSecretParams struct {
+	Version     int
+	Description *string
+	Label       *string
+
+	// BackendName is the name of the secret backend holding the content.
+	BackendName string
+	// Path is the location of the content in the backend.
+	Path string
+	// Key, if set, selects a single key from the content.
+	Key string
+}
+
 // SecretRotatedParams are used to mark a secret as rotated.
 type SecretRotatedParams struct {
 	Accessor secret.SecretAccessor
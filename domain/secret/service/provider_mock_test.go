@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/secrets/provider (interfaces: SecretBackendProvider,SecretsBackend)
+// Source: github.com/juju/juju/internal/secrets/provider (interfaces: SecretBackendProvider,SecretsBackend,ExternalContentReader)
 //
 // Generated by this command:
 //
-//	mockgen -package service -destination provider_mock_test.go github.com/juju/juju/internal/secrets/provider SecretBackendProvider,SecretsBackend
+//	mockgen -package service -destination provider_mock_test.go github.com/juju/juju/internal/secrets/provider SecretBackendProvider,SecretsBackend,ExternalContentReader
 //
 
 // Package service is a generated GoMock package.
@@ -292,3 +292,46 @@ func (mr *MockSecretsBackendMockRecorder) SaveContent(arg0, uri, revision, value
 
 // MockSecretsBackendSaveContentCall is the typed call wrapper for SaveContent.
 type MockSecretsBackendSaveContentCall = gomock.Call4_2[context.Context, *secrets.URI, int, secrets.SecretValue, string, error]
+
+// MockExternalContentReader is a mock of ExternalContentReader interface.
+type MockExternalContentReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockExternalContentReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockExternalContentReaderMockRecorder is the mock recorder for MockExternalContentReader.
+type MockExternalContentReaderMockRecorder struct {
+	mock                      *MockExternalContentReader
+	getExternalContentExpects []*gomock.Call3_3[context.Context, string, string, secrets.SecretValue, string, error]
+}
+
+// NewMockExternalContentReader creates a new mock instance.
+func NewMockExternalContentReader(ctrl *gomock.Controller) *MockExternalContentReader {
+	mock := &MockExternalContentReader{ctrl: ctrl}
+	mock.recorder = &MockExternalContentReaderMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExternalContentReader) EXPECT() *MockExternalContentReaderMockRecorder {
+	return m.recorder
+}
+
+// GetExternalContent mocks base method.
+func (m *MockExternalContentReader) GetExternalContent(arg0 context.Context, path, version string) (secrets.SecretValue, string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_3(&m.recorder.getExternalContentExpects, m.ctrl, m, "GetExternalContent", arg0, path, version)
+}
+
+// GetExternalContent indicates an expected call of GetExternalContent.
+func (mr *MockExternalContentReaderMockRecorder) GetExternalContent(arg0, path, version any) *MockExternalContentReaderGetExternalContentCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_3[context.Context, string, string, secrets.SecretValue, string, error](mr.mock.ctrl.T, mr.mock, "GetExternalContent", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(path), gomock.EnsureMatcher(version))
+	mr.getExternalContentExpects = append(mr.getExternalContentExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockExternalContentReaderGetExternalContentCall is the typed call wrapper for GetExternalContent.
+type MockExternalContentReaderGetExternalContentCall = gomock.Call3_3[context.Context, string, string, secrets.SecretValue, string, error]
@@ -21,6 +21,7 @@ import (
 	secreterrors "github.com/juju/juju/domain/secret/errors"
 	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/secrets/provider"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	"github.com/juju/juju/internal/secrets/provider/kubernetes"
@@ -28,18 +29,23 @@ import (
 )
 
 // NewSecretService returns a new secret service wrapping the specified state.
+// The key store holds the controller's key-encryption keys, which protect
+// the secret content stored in the model database.
 func NewSecretService(
 	secretState State,
 	secretBackendState SecretBackendState,
+	keyStore envelope.KeyStore,
 	leaderEnsurer leadership.Ensurer,
 	logger logger.Logger,
 ) *SecretService {
 	return &SecretService{
 		secretState:        secretState,
 		secretBackendState: secretBackendState,
+		encrypter:          newEnvelopeEncrypter(secretState, secretBackendState, keyStore, clock.WallClock, logger),
 		providerGetter:     provider.Provider,
 		leaderEnsurer:      leaderEnsurer,
 		uuidGenerator:      uuid.NewUUID,
+		recentAccess:       sharedRecentAccess,
 		clock:              clock.WallClock,
 		logger:             logger,
 	}
@@ -52,6 +58,7 @@ type ProviderGetter func(backendType string) (provider.SecretBackendProvider, er
 type SecretService struct {
 	secretState        State
 	secretBackendState SecretBackendState
+	encrypter          contentEncrypter
 
 	providerGetter ProviderGetter
 
@@ -61,6 +68,9 @@ type SecretService struct {
 
 	leaderEnsurer leadership.Ensurer
 
+	// recentAccess deduplicates the reads recorded in the access log.
+	recentAccess *recentAccess
+
 	clock  clock.Clock
 	logger logger.Logger
 }
@@ -272,13 +282,6 @@ func (s *SecretService) CreateUserSecret(ctx context.Context, uri *secrets.URI,
 		span.End()
 	}()
 
-	if len(params.Data) == 0 {
-		return errors.Errorf("empty secret value %w", coreerrors.NotValid)
-	}
-
-	// TODO(secrets): Generate and reserve a secret URI, instead of accepting
-	// one via an argument.
-
 	now := s.clock.Now()
 	p := domainsecret.UpsertSecretParams{
 		Description: params.Description,
@@ -288,6 +291,36 @@ func (s *SecretService) CreateUserSecret(ctx context.Context, uri *secrets.URI,
 		CreateTime:  now,
 		UpdateTime:  now,
 	}
+	if params.Generator != nil {
+		if len(params.Data) > 0 {
+			return errors.Errorf("secret value for generated secret %w", coreerrors.NotValid)
+		}
+		data, checksum, err := generateSecretContent(*params.Generator)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		params.Data = data
+		p.Checksum = checksum
+		p.Generator = params.Generator
+	}
+	if params.RotatePolicy.WillRotate() {
+		if params.Generator == nil {
+			return errors.Errorf("rotate policy for user secret without a generator %w", coreerrors.NotValid)
+		}
+		if !params.RotatePolicy.IsValid() {
+			return errors.Errorf("rotate policy %q %w", *params.RotatePolicy, coreerrors.NotValid)
+		}
+		policy := domainsecret.MarshallRotatePolicy(params.RotatePolicy)
+		p.RotatePolicy = &policy
+		p.NextRotateTime = params.RotatePolicy.NextRotateTime(now)
+	}
+
+	if len(params.Data) == 0 {
+		return errors.Errorf("empty secret value %w", coreerrors.NotValid)
+	}
+
+	// TODO(secrets): Generate and reserve a secret URI, instead of accepting
+	// one via an argument.
 	// Take a copy as we may set it to nil below
 	// if the content is saved to a backend.
 	p.Data = make(map[string]string)
@@ -330,6 +363,9 @@ func (s *SecretService) CreateUserSecret(ctx context.Context, uri *secrets.URI,
 	if err != nil {
 		return errors.Errorf("getting model uuid: %w", err)
 	}
+	if p.Data, err = s.encrypter.encrypt(ctx, p.Data); err != nil {
+		return errors.Capture(err)
+	}
 	rollBack, err := s.secretBackendState.AddSecretBackendReference(ctx, p.ValueRef, modelID, revisionID.String(), uri.ID)
 	if err != nil {
 		return errors.Capture(err)
@@ -370,6 +406,17 @@ func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI,
 		UpdateTime:  s.clock.Now(),
 	}
 
+	if len(params.Data) > 0 {
+		external, err := s.isExternalSecret(ctx, uri)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if external {
+			return errors.Errorf(
+				"updating the content of externally managed secret %q %w", uri.ID, coreerrors.NotSupported)
+		}
+	}
+
 	return withCaveat(ctx, func(innerCtx context.Context) (errOut error) {
 		// Take a copy as we may set it to nil below
 		// if the content is saved to a backend.
@@ -436,7 +483,11 @@ func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI,
 			}()
 		}
 
-		err := s.secretState.UpdateSecret(innerCtx, uri, p)
+		var err error
+		if p.Data, err = s.encrypter.encrypt(innerCtx, p.Data); err != nil {
+			return errors.Capture(err)
+		}
+		err = s.secretState.UpdateSecret(innerCtx, uri, p)
 		if err != nil {
 			return errors.Errorf("updating user secret %q: %w", uri.ID, err)
 		}
@@ -638,8 +689,35 @@ func (s *SecretService) GetSecretValue(ctx context.Context, uri *secrets.URI, re
 	if err := s.canRead(ctx, uri, accessor); err != nil {
 		return nil, nil, errors.Capture(err)
 	}
+	val, ref, err := s.getSecretValue(ctx, uri, rev)
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	// Only reads by charms are recorded here; the model accessor is
+	// used internally by Juju, for example to drain secret content.
+	if accessor.Kind == domainsecret.UnitAccessor || accessor.Kind == domainsecret.ApplicationAccessor {
+		s.recordAccess(ctx, uri, rev, accessor)
+	}
+	return val, ref, nil
+}
+
+func (s *SecretService) getSecretValue(ctx context.Context, uri *secrets.URI, rev int) (secrets.SecretValue, *secrets.ValueRef, error) {
 	data, ref, err := s.secretState.GetSecretValue(ctx, uri, rev)
-	return secrets.NewSecretValue(data), ref, errors.Capture(err)
+	if errors.Is(err, secreterrors.SecretRevisionNotFound) {
+		// Externally managed content is read from the backend
+		// and returned inline.
+		val, extErr := s.getExternalSecretValue(ctx, uri, rev)
+		if !errors.Is(extErr, secreterrors.SecretNotExternal) {
+			return val, nil, errors.Capture(extErr)
+		}
+	}
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	if data, err = s.encrypter.decrypt(ctx, data); err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	return secrets.NewSecretValue(data), ref, nil
 }
 
 // GetSecretContentFromBackend retrieves the content for the specified secret revision.
@@ -658,7 +736,12 @@ func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *se
 	lastBackendID := ""
 	for {
 		data, ref, err := s.secretState.GetSecretValue(ctx, uri, rev)
-		val := secrets.NewSecretValue(data)
+		if errors.Is(err, secreterrors.SecretRevisionNotFound) {
+			val, extErr := s.getExternalSecretValue(ctx, uri, rev)
+			if !errors.Is(extErr, secreterrors.SecretNotExternal) {
+				return val, errors.Capture(extErr)
+			}
+		}
 		if err != nil {
 			notFound := errors.Is(err, secreterrors.SecretNotFound) || errors.Is(err, secreterrors.SecretRevisionNotFound)
 			if notFound {
@@ -667,7 +750,10 @@ func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *se
 			return nil, errors.Capture(err)
 		}
 		if ref == nil {
-			return val, nil
+			if data, err = s.encrypter.decrypt(ctx, data); err != nil {
+				return nil, errors.Capture(err)
+			}
+			return secrets.NewSecretValue(data), nil
 		}
 
 		backendID := ref.BackendID
@@ -675,7 +761,7 @@ func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *se
 		if !ok {
 			return nil, errors.Errorf("external secret backend %q not found, have %q", backendID, s.backends).Add(backenderrors.NotFound)
 		}
-		val, err = backend.GetContent(ctx, ref.RevisionID)
+		val, err := backend.GetContent(ctx, ref.RevisionID)
 		notFound := errors.Is(err, secreterrors.SecretNotFound) || errors.Is(err, secreterrors.SecretRevisionNotFound)
 		if err == nil || !notFound || lastBackendID == backendID {
 			if notFound {
@@ -866,7 +952,11 @@ func (s *SecretService) ChangeSecretBackend(
 			}
 		}()
 
-		err = s.secretState.ChangeSecretBackend(innerCtx, revisionID, params.ValueRef, params.Data)
+		data, err := s.encrypter.encrypt(innerCtx, params.Data)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		err = s.secretState.ChangeSecretBackend(innerCtx, revisionID, params.ValueRef, data)
 		if err != nil {
 			return errors.Capture(err)
 		}
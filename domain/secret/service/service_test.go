@@ -76,6 +76,7 @@ func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.service = &SecretService{
 		secretState:        s.state,
 		secretBackendState: s.secretBackendState,
+		encrypter:          passthroughEncrypter{},
 		providerGetter:     func(string) (provider.SecretBackendProvider, error) { return s.secretsBackendProvider, nil },
 		leaderEnsurer:      s.ensurer,
 		uuidGenerator:      func() (uuid.UUID, error) { return s.fakeUUID, nil },
@@ -557,6 +558,7 @@ func (s *serviceSuite) assertUpdateUserSecret(c *tc.C, isInternal, finalStepFail
 		SubjectTypeID: domainsecret.SubjectModel,
 		SubjectID:     s.modelID.String(),
 	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretExternalRef(gomock.Any(), uri).Return(domainsecret.ExternalRef{}, secreterrors.SecretNotExternal)
 	s.state.EXPECT().GetLatestRevision(gomock.Any(), uri).Return(2, nil)
 	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil).AnyTimes()
 	rollbackCalled := false
@@ -625,6 +627,10 @@ func (s *serviceSuite) TestGetSecretValue(c *tc.C) {
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
 	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+	s.state.EXPECT().RecordSecretAccess(gomock.Any(), uri, 666, domainsecret.SecretAccessor{
+		Kind: domainsecret.UnitAccessor,
+		ID:   "mariadb/0",
+	}, s.clock.Now()).Return(nil)
 
 	data, ref, err := s.service.GetSecretValue(c.Context(), uri, 666, domainsecret.SecretAccessor{
 		Kind: domainsecret.UnitAccessor,
@@ -2237,7 +2243,7 @@ func (s *serviceSuite) TestWatchObsolete(c *tc.C) {
 	).Return("secret_revision_obsolete", namespaceQuery)
 
 	svc := NewWatchableService(
-		s.state, s.secretBackendState, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
+		s.state, s.secretBackendState, nil, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
 	w, err := svc.WatchObsoleteSecrets(c.Context(),
 		domainsecret.CharmSecretOwner{
 			Kind: domainsecret.ApplicationCharmSecretOwner,
@@ -2298,7 +2304,7 @@ func (s *serviceSuite) TestWatchObsoleteUserSecretsToPrune(c *tc.C) {
 	)
 
 	svc := NewWatchableService(
-		s.state, s.secretBackendState, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
+		s.state, s.secretBackendState, nil, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
 	w, err := svc.WatchObsoleteUserSecretsToPrune(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(w, tc.NotNil)
@@ -2334,7 +2340,7 @@ func (s *serviceSuite) TestWatchConsumedSecretsChanges(c *tc.C) {
 	).Return(expectedWatcher, nil)
 
 	svc := NewWatchableService(
-		s.state, s.secretBackendState, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
+		s.state, s.secretBackendState, nil, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
 	w, err := svc.WatchConsumedSecretsChanges(c.Context(), "mysql/0")
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(w, tc.Equals, expectedWatcher)
@@ -2385,7 +2391,7 @@ func (s *serviceSuite) TestWatchSecretsRotationChanges(c *tc.C) {
 	)
 
 	svc := NewWatchableService(
-		s.state, s.secretBackendState, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
+		s.state, s.secretBackendState, nil, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
 	w, err := svc.WatchSecretsRotationChanges(c.Context(),
 		domainsecret.CharmSecretOwner{
 			Kind: domainsecret.ApplicationCharmSecretOwner,
@@ -2469,7 +2475,7 @@ func (s *serviceSuite) TestWatchSecretRevisionsExpiryChanges(c *tc.C) {
 	)
 
 	svc := NewWatchableService(
-		s.state, s.secretBackendState, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
+		s.state, s.secretBackendState, nil, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
 	w, err := svc.WatchSecretRevisionsExpiryChanges(c.Context(),
 		domainsecret.CharmSecretOwner{
 			Kind: domainsecret.ApplicationCharmSecretOwner,
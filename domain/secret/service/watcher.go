@@ -23,6 +23,7 @@ import (
 	"github.com/juju/juju/core/watcher/eventsource"
 	"github.com/juju/juju/domain/secret"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/envelope"
 )
 
 // WatcherFactory describes methods for creating watchers.
@@ -77,11 +78,12 @@ type WatchableService struct {
 func NewWatchableService(
 	secretState State,
 	secretBackendState SecretBackendState,
+	keyStore envelope.KeyStore,
 	leaderEnsurer leadership.Ensurer,
 	watcherFactory WatcherFactory,
 	logger logger.Logger,
 ) *WatchableService {
-	svc := NewSecretService(secretState, secretBackendState, leaderEnsurer, logger)
+	svc := NewSecretService(secretState, secretBackendState, keyStore, leaderEnsurer, logger)
 	return &WatchableService{
 		SecretService:  *svc,
 		watcherFactory: watcherFactory,
@@ -482,6 +484,41 @@ func (s *WatchableService) WatchSecretsRotationChanges(ctx context.Context, owne
 	return secret.NewSecretStringWatcher(w, s.logger, processChanges)
 }
 
+// WatchGeneratedSecretsRotationChanges returns a watcher that notifies when
+// the rotation time of a user secret whose content is generated by Juju
+// changes.
+func (s *WatchableService) WatchGeneratedSecretsRotationChanges(ctx context.Context) (watcher.SecretTriggerWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	table, query := s.secretState.InitialWatchStatementForGeneratedSecretsRotationChanges()
+	w, err := s.watcherFactory.NewNamespaceWatcher(
+		ctx,
+		query,
+		"generated secret rotation watcher",
+		eventsource.NamespaceFilter(table, changestream.All),
+	)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	processChanges := func(ctx context.Context, secretIDs ...string) ([]watcher.SecretTriggerChange, error) {
+		result, err := s.secretState.GetGeneratedSecretsRotationChanges(ctx, secretIDs...)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		changes := make([]watcher.SecretTriggerChange, len(result))
+		for i, r := range result {
+			changes[i] = watcher.SecretTriggerChange{
+				URI:             r.URI,
+				Revision:        r.Revision,
+				NextTriggerTime: r.NextTriggerTime,
+			}
+		}
+		return changes, nil
+	}
+	return secret.NewSecretStringWatcher(w, s.logger, processChanges)
+}
+
 // WatchObsoleteUserSecretsToPrune returns a watcher that notifies when a user secret revision is obsolete and ready to be pruned.
 func (s *WatchableService) WatchObsoleteUserSecretsToPrune(ctx context.Context) (watcher.NotifyWatcher, error) {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
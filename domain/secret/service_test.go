@@ -13,6 +13,7 @@ import (
 	"github.com/juju/clock"
 	"github.com/juju/tc"
 
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/database"
 	coremodel "github.com/juju/juju/core/model"
@@ -23,8 +24,10 @@ import (
 	"github.com/juju/juju/domain/secret"
 	"github.com/juju/juju/domain/secret/service"
 	"github.com/juju/juju/domain/secret/state"
+	"github.com/juju/juju/domain/secretbackend"
 	"github.com/juju/juju/environs"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/secrets/provider"
 	_ "github.com/juju/juju/internal/secrets/provider/all"
 	"github.com/juju/juju/internal/secrets/provider/juju"
@@ -85,6 +88,7 @@ func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
 			return s.ModelTxnRunner(c, s.modelUUID.String()), nil
 		}, loggertesting.WrapCheckLog(c), clock.WallClock),
 		s.secretBackendState,
+		newKeyStore(c),
 		nil,
 		loggertesting.WrapCheckLog(c),
 	)
@@ -92,6 +96,16 @@ func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
 	return ctrl
 }
 
+// newKeyStore returns a key store holding a single key-encryption key.
+func newKeyStore(c *tc.C) envelope.KeyStore {
+	keyStore := envelope.NewFileKeyStore(envelope.KeyStorePath(c.MkDir()))
+	kek, err := envelope.NewKeyEncryptionKey()
+	c.Assert(err, tc.ErrorIsNil)
+	err = keyStore.AddKeyEncryptionKeys(kek)
+	c.Assert(err, tc.ErrorIsNil)
+	return keyStore
+}
+
 func (s *serviceSuite) createSecret(c *tc.C, data map[string]string) *coresecrets.URI {
 	ctx := c.Context()
 
@@ -106,6 +120,9 @@ func (s *serviceSuite) createSecret(c *tc.C, data map[string]string) *coresecret
 			},
 		}, nil,
 	)
+	s.secretBackendState.EXPECT().GetSecretEncryptionConfig(gomock.Any()).Return(secretbackend.EncryptionConfig{
+		KMS: controller.SecretEncryptionKMSInternal,
+	}, nil)
 	s.secretBackendState.EXPECT().AddSecretBackendReference(
 		gomock.Any(), nil, s.modelUUID, gomock.Any(), gomock.Any(),
 	).Return(func() error { return nil }, nil)
@@ -123,9 +140,34 @@ func (s *serviceSuite) createSecret(c *tc.C, data map[string]string) *coresecret
 		Version: 1,
 	})
 	c.Assert(err, tc.ErrorIsNil)
+	s.verifySecretContentEncrypted(c)
 	return uri
 }
 
+func (s *serviceSuite) verifySecretContentEncrypted(c *tc.C) {
+	var contents []string
+	err := s.ModelTxnRunner(c, s.modelUUID.String()).StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, `SELECT content FROM secret_content`)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var content string
+			if err := rows.Scan(&content); err != nil {
+				return err
+			}
+			contents = append(contents, content)
+		}
+		return rows.Err()
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(contents, tc.Not(tc.HasLen), 0)
+	for _, content := range contents {
+		c.Check(content, tc.Matches, `juju-enc:v1:.+`)
+	}
+}
+
 func (s *serviceSuite) verifySecretRemovalJob(c *tc.C, uri *coresecrets.URI, revision int) {
 	q := `SELECT arg FROM removal WHERE entity_uuid = $1`
 	type jobArgs struct {
@@ -467,9 +467,31 @@ VALUES ($secretID.id)`
 			return errors.Errorf("inserting next rotate time: %w", err)
 		}
 	}
+	if secret.Generator != nil {
+		if err := st.insertSecretGenerator(ctx, tx, uri, *secret.Generator); err != nil {
+			return errors.Errorf("inserting secret generator: %w", err)
+		}
+	}
 	return nil
 }
 
+func (st State) insertSecretGenerator(
+	ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI, spec coresecrets.GeneratorSpec,
+) error {
+	stmt, err := st.Prepare(`
+INSERT INTO secret_generator (*)
+VALUES ($secretGenerator.*)`, secretGenerator{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return tx.Query(ctx, stmt, secretGenerator{
+		SecretID: uri.ID,
+		Format:   string(spec.Format),
+		Length:   spec.Length,
+		Charset:  spec.Charset,
+	}).Run()
+}
+
 func (st State) createSecretRevision(ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI, revision int,
 	secret domainsecret.UpsertRevisionParams) error {
 	if len(secret.Data) == 0 && secret.ValueRef == nil {
@@ -1984,7 +2006,9 @@ SELECT sm.secret_id AS &secretID.id,
 FROM      secret_metadata sm
 JOIN      secret_revision rev ON rev.secret_id = sm.secret_id
 LEFT JOIN secret_value_ref svr ON svr.revision_uuid = rev.uuid
-JOIN      secret_model_owner mso ON mso.secret_id = sm.secret_id`
+JOIN      secret_model_owner mso ON mso.secret_id = sm.secret_id
+-- Externally managed content is never drained.
+WHERE     sm.secret_id NOT IN (SELECT secret_id FROM secret_external_ref)`
 
 	queryStmt, err := st.Prepare(query, secretID{}, secretExternalRevision{})
 	if err != nil {
@@ -4064,3 +4088,756 @@ func (st State) ScheduleObsoleteUserSecretRevisionsPruning(ctx context.Context,
 		return nil
 	}))
 }
+
+// GetSecretDataKeys returns the keys used to encrypt secret content in the
+// model, oldest first.
+func (st State) GetSecretDataKeys(ctx context.Context) ([]domainsecret.DataKey, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &secretDataKey.*
+FROM   secret_data_key
+ORDER BY created_at, uuid`, secretDataKey{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []secretDataKey
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("querying secret data keys: %w", err)
+	}
+
+	result := make([]domainsecret.DataKey, len(rows))
+	for i, row := range rows {
+		result[i] = domainsecret.DataKey{
+			UUID:       row.UUID,
+			WrappedKey: row.WrappedKey,
+			CreatedAt:  row.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+// AddSecretDataKey records a new key used to encrypt secret content in the
+// model.
+func (st State) AddSecretDataKey(ctx context.Context, key domainsecret.DataKey) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := secretDataKey{
+		UUID:       key.UUID,
+		WrappedKey: key.WrappedKey,
+		CreatedAt:  key.CreatedAt,
+	}
+	stmt, err := st.Prepare(`
+INSERT INTO secret_data_key (*)
+VALUES ($secretDataKey.*)`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return tx.Query(ctx, stmt, row).Run()
+	})
+	if err != nil {
+		return errors.Errorf("adding secret data key %q: %w", key.UUID, err)
+	}
+	return nil
+}
+
+// UpdateSecretDataKeys replaces the wrapped key material of the given data
+// keys, as happens when they are re-wrapped with a new key-encryption key.
+// The secret content encrypted with the keys is unaffected.
+func (st State) UpdateSecretDataKeys(ctx context.Context, keys []domainsecret.DataKey) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+UPDATE secret_data_key
+SET    wrapped_key = $secretDataKey.wrapped_key
+WHERE  uuid = $secretDataKey.uuid`, secretDataKey{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		for _, key := range keys {
+			var outcome sqlair.Outcome
+			err := tx.Query(ctx, stmt, secretDataKey{
+				UUID:       key.UUID,
+				WrappedKey: key.WrappedKey,
+			}).Get(&outcome)
+			if err != nil {
+				return errors.Errorf("updating secret data key %q: %w", key.UUID, err)
+			}
+			if n, err := outcome.Result().RowsAffected(); err != nil {
+				return errors.Capture(err)
+			} else if n == 0 {
+				return errors.Errorf("secret data key %q %w", key.UUID, coreerrors.NotFound)
+			}
+		}
+		return nil
+	})
+	return errors.Capture(err)
+}
+
+// GetSecretContentWithoutPrefix returns the secret content values stored in
+// the model which don't start with the given prefix. It is used to find
+// content stored before encryption was enabled.
+func (st State) GetSecretContentWithoutPrefix(ctx context.Context, prefix string) ([]domainsecret.ContentValue, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	pattern := contentPrefix{Pattern: prefix + "%"}
+	stmt, err := st.Prepare(`
+SELECT &secretContent.*
+FROM   secret_content
+WHERE  content NOT LIKE $contentPrefix.pattern`, secretContent{}, pattern)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []secretContent
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, pattern).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("querying secret content: %w", err)
+	}
+
+	result := make([]domainsecret.ContentValue, len(rows))
+	for i, row := range rows {
+		result[i] = domainsecret.ContentValue{
+			RevisionUUID: row.RevisionUUID,
+			Name:         row.Name,
+			Content:      row.Content,
+		}
+	}
+	return result, nil
+}
+
+// UpdateSecretContentValues replaces the stored secret content values. Only
+// values which still hold the content they were read with are updated, so
+// content changed in the meantime is left alone.
+func (st State) UpdateSecretContentValues(ctx context.Context, values []domainsecret.ContentValue, previous []string) error {
+	if len(values) == 0 {
+		return nil
+	}
+	if len(values) != len(previous) {
+		return errors.Errorf("expected %d previous values, got %d", len(values), len(previous))
+	}
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	type previousContent struct {
+		Content string `db:"previous"`
+	}
+	stmt, err := st.Prepare(`
+UPDATE secret_content
+SET    content = $secretContent.content
+WHERE  revision_uuid = $secretContent.revision_uuid
+AND    name = $secretContent.name
+AND    content = $previousContent.previous`, secretContent{}, previousContent{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		for i, value := range values {
+			err := tx.Query(ctx, stmt, secretContent{
+				RevisionUUID: value.RevisionUUID,
+				Name:         value.Name,
+				Content:      value.Content,
+			}, previousContent{Content: previous[i]}).Run()
+			if err != nil {
+				return errors.Errorf("updating secret content %q of revision %q: %w", value.Name, value.RevisionUUID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// CreateExternalUserSecret creates a user secret whose content is held outside
+// of Juju at the specified external reference. The first revision records the
+// specified upstream version of the content. It returns an error satisfying
+// [secreterrors.SecretLabelAlreadyExists] if the optional label passed as
+// param already exists.
+func (st State) CreateExternalUserSecret(
+	ctx context.Context, version int, uri *coresecrets.URI, secret domainsecret.UpsertSecretParams,
+	ref domainsecret.ExternalRef, upstreamVersion string,
+) error {
+	if secret.RevisionUUID == nil {
+		return errors.Errorf("revision ID must be provided")
+	}
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	dbRef := secretExternalRef{
+		SecretID:    uri.ID,
+		BackendUUID: ref.BackendID,
+		Path:        ref.Path,
+		ContentKey:  ref.Key,
+	}
+	insertRefStmt, err := st.Prepare(`
+INSERT INTO secret_external_ref (*)
+VALUES ($secretExternalRef.*)`, dbRef)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		exists, err := st.checkUserSecretLabelExists(ctx, tx, secret.Label, uri.ID)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if exists {
+			return errors.Errorf("secret with label %q already exists",
+				*secret.Label).Add(secreterrors.SecretLabelAlreadyExists)
+		}
+		if err := st.createSecretMetadata(ctx, tx, version, uri, secret); err != nil {
+			return errors.Errorf("inserting secret records: %w", err)
+		}
+		if err := tx.Query(ctx, insertRefStmt, dbRef).Run(); err != nil {
+			return errors.Errorf("inserting external reference: %w", err)
+		}
+		dbRevision := &secretRevision{
+			UUID:       *secret.RevisionUUID,
+			SecretID:   uri.ID,
+			Revision:   1,
+			CreateTime: secret.UpdateTime.UTC(),
+			UpdateTime: secret.UpdateTime.UTC(),
+		}
+		if err := st.createExternalSecretRevision(ctx, tx, dbRevision, upstreamVersion); err != nil {
+			return errors.Capture(err)
+		}
+
+		label := ""
+		if secret.Label != nil {
+			label = *secret.Label
+		}
+		if err := st.setSecretModelOwner(ctx, tx, uri, label); err != nil {
+			return errors.Errorf("setting secret model owner: %w", err)
+		}
+		return nil
+	})
+	return errors.Capture(err)
+}
+
+// AddExternalSecretRevision adds a new revision to the specified externally
+// managed secret, recording the specified upstream version of the content, and
+// returns the new revision number. It returns an error satisfying
+// [secreterrors.SecretNotExternal] if the secret content is managed by Juju.
+func (st State) AddExternalSecretRevision(
+	ctx context.Context, uri *coresecrets.URI, revisionUUID string, upstreamVersion string, updateTime time.Time,
+) (int, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	info := secretInfo{ID: uri.ID}
+	latestStmt, err := st.Prepare(`
+SELECT MAX(sr.revision) AS &secretInfo.latest_revision
+FROM   secret_revision sr
+WHERE  sr.secret_id = $secretInfo.secret_id`, info)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	metadata := secretMetadata{ID: uri.ID, UpdateTime: updateTime.UTC()}
+	updateMetadataStmt, err := st.Prepare(`
+UPDATE secret_metadata
+SET    update_time = $secretMetadata.update_time
+WHERE  secret_id = $secretMetadata.secret_id`, metadata)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	var revision int
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if _, err := st.getSecretExternalRef(ctx, tx, uri); err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, latestStmt, info).Get(&info); err != nil {
+			return errors.Capture(err)
+		}
+		revision = info.LatestRevision + 1
+		dbRevision := &secretRevision{
+			UUID:       revisionUUID,
+			SecretID:   uri.ID,
+			Revision:   revision,
+			CreateTime: updateTime.UTC(),
+			UpdateTime: updateTime.UTC(),
+		}
+		if err := st.createExternalSecretRevision(ctx, tx, dbRevision, upstreamVersion); err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, updateMetadataStmt, metadata).Run(); err != nil {
+			return errors.Errorf("updating secret metadata: %w", err)
+		}
+		if err := st.markObsoleteRevisions(ctx, tx, uri); err != nil {
+			return errors.Errorf("marking obsolete revisions for secret %q: %w", uri, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	return revision, nil
+}
+
+func (st State) createExternalSecretRevision(
+	ctx context.Context, tx *sqlair.TX, dbRevision *secretRevision, upstreamVersion string,
+) error {
+	dbVersion := secretRevisionExternalVersion{
+		RevisionUUID: dbRevision.UUID,
+		Version:      upstreamVersion,
+	}
+	insertVersionStmt, err := st.Prepare(`
+INSERT INTO secret_revision_external_version (*)
+VALUES ($secretRevisionExternalVersion.*)`, dbVersion)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	if err := st.upsertSecretRevision(ctx, tx, dbRevision); err != nil {
+		return errors.Errorf("inserting revision: %w", err)
+	}
+	if err := tx.Query(ctx, insertVersionStmt, dbVersion).Run(); err != nil {
+		return errors.Errorf("inserting external version: %w", err)
+	}
+	return nil
+}
+
+// GetSecretExternalRef returns the external reference for the specified secret.
+// It returns an error satisfying [secreterrors.SecretNotExternal] if the
+// secret content is managed by Juju.
+func (st State) GetSecretExternalRef(ctx context.Context, uri *coresecrets.URI) (domainsecret.ExternalRef, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return domainsecret.ExternalRef{}, errors.Capture(err)
+	}
+
+	var ref domainsecret.ExternalRef
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		ref, err = st.getSecretExternalRef(ctx, tx, uri)
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return domainsecret.ExternalRef{}, errors.Capture(err)
+	}
+	return ref, nil
+}
+
+func (st State) getSecretExternalRef(
+	ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI,
+) (domainsecret.ExternalRef, error) {
+	dbRef := secretExternalRef{SecretID: uri.ID}
+	stmt, err := st.Prepare(`
+SELECT &secretExternalRef.*
+FROM   secret_external_ref
+WHERE  secret_id = $secretExternalRef.secret_id`, dbRef)
+	if err != nil {
+		return domainsecret.ExternalRef{}, errors.Capture(err)
+	}
+
+	err = tx.Query(ctx, stmt, dbRef).Get(&dbRef)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return domainsecret.ExternalRef{}, errors.Errorf(
+			"secret %q content is not externally managed", uri.ID).Add(secreterrors.SecretNotExternal)
+	}
+	if err != nil {
+		return domainsecret.ExternalRef{}, errors.Capture(err)
+	}
+	return domainsecret.ExternalRef{
+		BackendID: dbRef.BackendUUID,
+		Path:      dbRef.Path,
+		Key:       dbRef.ContentKey,
+	}, nil
+}
+
+// GetSecretRevisionExternalVersion returns the upstream version of the content
+// of the specified revision of an externally managed secret. It returns an error
+// satisfying [secreterrors.SecretRevisionNotFound] if the revision does not exist
+// or does not refer to external content.
+func (st State) GetSecretRevisionExternalVersion(ctx context.Context, uri *coresecrets.URI, revision int) (string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	want := secretRevision{SecretID: uri.ID, Revision: revision}
+	var dbVersion secretRevisionExternalVersion
+	stmt, err := st.Prepare(`
+SELECT sev.* AS &secretRevisionExternalVersion.*
+FROM   secret_revision_external_version sev
+JOIN   secret_revision sr ON sr.uuid = sev.revision_uuid
+WHERE  sr.secret_id = $secretRevision.secret_id
+AND    sr.revision = $secretRevision.revision`, want, dbVersion)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, want).Get(&dbVersion)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf(
+				"external secret %q revision %d not found", uri.ID, revision).Add(secreterrors.SecretRevisionNotFound)
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	return dbVersion.Version, nil
+}
+
+// ListExternalSecrets returns all externally managed secrets in the model,
+// together with the upstream version of their latest revision.
+func (st State) ListExternalSecrets(ctx context.Context) ([]domainsecret.ExternalSecret, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT ser.secret_id AS &externalSecret.secret_id,
+       ser.backend_uuid AS &externalSecret.backend_uuid,
+       ser.path AS &externalSecret.path,
+       ser.content_key AS &externalSecret.content_key,
+       sr.revision AS &externalSecret.revision,
+       sev.version AS &externalSecret.version
+FROM   secret_external_ref ser
+JOIN   secret_revision sr ON sr.secret_id = ser.secret_id
+JOIN   secret_revision_external_version sev ON sev.revision_uuid = sr.uuid
+WHERE  sr.revision = (
+    SELECT MAX(revision) FROM secret_revision WHERE secret_id = ser.secret_id
+)`, externalSecret{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var dbSecrets []externalSecret
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&dbSecrets)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("querying external secrets: %w", err)
+	}
+
+	result := make([]domainsecret.ExternalSecret, len(dbSecrets))
+	for i, s := range dbSecrets {
+		uri, err := coresecrets.ParseURI(s.SecretID)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		result[i] = domainsecret.ExternalSecret{
+			URI: uri,
+			ExternalRef: domainsecret.ExternalRef{
+				BackendID: s.BackendUUID,
+				Path:      s.Path,
+				Key:       s.ContentKey,
+			},
+			LatestRevision: s.LatestRevision,
+			LatestVersion:  s.LatestVersion,
+		}
+	}
+	return result, nil
+}
+
+// InitialWatchStatementForGeneratedSecretsRotationChanges returns the
+// namespace and the initial query for watching the rotation of user secrets
+// whose content is generated by Juju.
+func (st State) InitialWatchStatementForGeneratedSecretsRotationChanges() (string, eventsource.NamespaceQuery) {
+	queryFunc := func(ctx context.Context, runner coredatabase.TxnRunner) ([]string, error) {
+		result, err := st.getGeneratedSecretsRotationChanges(ctx, runner)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		secretIDs := make([]string, len(result))
+		for i, d := range result {
+			secretIDs[i] = d.URI.ID
+		}
+		return secretIDs, nil
+	}
+	return "secret_rotation", queryFunc
+}
+
+// GetGeneratedSecretsRotationChanges returns the rotation changes for the
+// user secrets whose content is generated by Juju. If no secret IDs are
+// specified, the changes for all such secrets are returned.
+func (st State) GetGeneratedSecretsRotationChanges(ctx context.Context, secretIDs ...string) ([]domainsecret.RotationInfo, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return st.getGeneratedSecretsRotationChanges(ctx, db, secretIDs...)
+}
+
+func (st State) getGeneratedSecretsRotationChanges(
+	ctx context.Context, runner domain.TxnRunner, secretIDs ...string,
+) ([]domainsecret.RotationInfo, error) {
+	q := `
+SELECT
+       sro.secret_id AS &secretRotationChange.secret_id,
+       sro.next_rotation_time AS &secretRotationChange.next_rotation_time,
+       MAX(sr.revision) AS &secretRotationChange.revision
+FROM   secret_rotation sro
+JOIN   secret_generator sg ON sg.secret_id = sro.secret_id
+JOIN   secret_revision sr ON sr.secret_id = sro.secret_id`
+	var queryParams []any
+	if len(secretIDs) > 0 {
+		queryParams = append(queryParams, dbSecretIDs(secretIDs))
+		q += `
+WHERE  sro.secret_id IN ($dbSecretIDs[:])`
+	}
+	q += `
+GROUP BY sro.secret_id`
+
+	stmt, err := st.Prepare(q, append(queryParams, secretRotationChange{})...)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	var data []secretRotationChange
+	err = runner.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, queryParams...).GetAll(&data)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			// It's ok because the secret or the rotation was just deleted.
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	result := make([]domainsecret.RotationInfo, len(data))
+	for i, d := range data {
+		uri, err := coresecrets.ParseURI(d.SecretID)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		result[i] = domainsecret.RotationInfo{
+			URI:             uri,
+			Revision:        d.Revision,
+			NextTriggerTime: d.NextRotateTime,
+		}
+	}
+	return result, nil
+}
+
+// GetGeneratedSecrets returns the specified user secrets whose content is
+// generated by Juju and which have a rotation scheduled. Secrets which are
+// not generated, or which are no longer rotated, are not returned.
+func (st State) GetGeneratedSecrets(ctx context.Context, secretIDs ...string) ([]domainsecret.GeneratedSecret, error) {
+	if len(secretIDs) == 0 {
+		return nil, nil
+	}
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	ids := dbSecretIDs(secretIDs)
+	stmt, err := st.Prepare(`
+SELECT sg.secret_id AS &generatedSecret.secret_id,
+       sg.format AS &generatedSecret.format,
+       sg.length AS &generatedSecret.length,
+       sg.charset AS &generatedSecret.charset,
+       rp.policy AS &generatedSecret.policy,
+       sro.next_rotation_time AS &generatedSecret.next_rotation_time
+FROM   secret_generator sg
+JOIN   secret_model_owner smo ON smo.secret_id = sg.secret_id
+JOIN   secret_metadata sm ON sm.secret_id = sg.secret_id
+JOIN   secret_rotate_policy rp ON rp.id = sm.rotate_policy_id
+JOIN   secret_rotation sro ON sro.secret_id = sg.secret_id
+WHERE  sg.secret_id IN ($dbSecretIDs[:])`, generatedSecret{}, ids)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var dbSecrets []generatedSecret
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, ids).GetAll(&dbSecrets)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("querying generated secrets: %w", err)
+	}
+
+	result := make([]domainsecret.GeneratedSecret, len(dbSecrets))
+	for i, s := range dbSecrets {
+		uri, err := coresecrets.ParseURI(s.SecretID)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		result[i] = domainsecret.GeneratedSecret{
+			URI: uri,
+			Generator: coresecrets.GeneratorSpec{
+				Format:  coresecrets.GeneratorFormat(s.Format),
+				Length:  s.Length,
+				Charset: s.Charset,
+			},
+			RotatePolicy:   coresecrets.RotatePolicy(s.RotatePolicy),
+			NextRotateTime: s.NextRotateTime,
+		}
+	}
+	return result, nil
+}
+
+// RecordSecretAccess records that the accessor has read the content of the
+// specified secret revision. Reads are deduplicated per accessor per
+// revision; the first and last read times and the number of reads are kept.
+func (st State) RecordSecretAccess(
+	ctx context.Context, uri *coresecrets.URI, revision int, accessor domainsecret.SecretAccessor, now time.Time,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	accessorType, ok := domainsecret.MarshallAccessorType(accessor.Kind)
+	if !ok {
+		return errors.Errorf("secret accessor kind %q %w", accessor.Kind, coreerrors.NotValid)
+	}
+	access := secretRevisionAccess{
+		SecretID:        uri.ID,
+		Revision:        revision,
+		AccessorTypeID:  int(accessorType),
+		AccessorName:    accessor.ID,
+		FirstAccessTime: now.UTC(),
+		LastAccessTime:  now.UTC(),
+		AccessCount:     1,
+	}
+	stmt, err := st.Prepare(`
+INSERT INTO secret_revision_access (*)
+VALUES ($secretRevisionAccess.*)
+ON CONFLICT (secret_id, revision, accessor_type_id, accessor_name) DO UPDATE SET
+    last_access_time = excluded.last_access_time,
+    access_count = access_count + 1`, access)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return errors.Capture(tx.Query(ctx, stmt, access).Run())
+	})
+	if err != nil {
+		return errors.Errorf("recording access to secret %q revision %d: %w", uri.ID, revision, err)
+	}
+	return nil
+}
+
+// ListSecretAccess returns the recorded reads of the content of the specified
+// secret, ordered by revision and then by last read time, most recent first.
+func (st State) ListSecretAccess(ctx context.Context, uri *coresecrets.URI) ([]domainsecret.SecretAccessRecord, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	input := secretID{ID: uri.ID}
+	stmt, err := st.Prepare(`
+SELECT &secretRevisionAccess.*
+FROM   secret_revision_access
+WHERE  secret_id = $secretID.id
+ORDER BY revision DESC, last_access_time DESC, accessor_type_id, accessor_name`, input, secretRevisionAccess{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var dbAccess []secretRevisionAccess
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, input).GetAll(&dbAccess)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("querying access to secret %q: %w", uri.ID, err)
+	}
+
+	result := make([]domainsecret.SecretAccessRecord, len(dbAccess))
+	for i, a := range dbAccess {
+		result[i] = domainsecret.SecretAccessRecord{
+			Revision: a.Revision,
+			Accessor: domainsecret.SecretAccessor{
+				Kind: domainsecret.SecretAccessorKind(domainsecret.AccessorType(a.AccessorTypeID).String()),
+				ID:   a.AccessorName,
+			},
+			FirstAccessTime: a.FirstAccessTime,
+			LastAccessTime:  a.LastAccessTime,
+			AccessCount:     a.AccessCount,
+		}
+	}
+	return result, nil
+}
+
+// PruneSecretAccess deletes the recorded reads of secret content which were
+// last read before the specified time, and returns the number deleted.
+func (st State) PruneSecretAccess(ctx context.Context, before time.Time) (int64, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	type cutoff struct {
+		Time time.Time `db:"time"`
+	}
+	input := cutoff{Time: before.UTC()}
+	stmt, err := st.Prepare(`
+DELETE FROM secret_revision_access
+WHERE  last_access_time < $cutoff.time`, input)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	var deleted int64
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, input).Get(&outcome); err != nil {
+			return errors.Capture(err)
+		}
+		deleted, err = outcome.Result().RowsAffected()
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return 0, errors.Errorf("pruning secret access log: %w", err)
+	}
+	return deleted, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/canonical/sqlair"
 	"github.com/juju/tc"
 
+	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/network"
 	corerelation "github.com/juju/juju/core/relation"
 	coresecrets "github.com/juju/juju/core/secrets"
@@ -4656,3 +4657,374 @@ func (s *stateSuite) TestGetUnitReservedSecretIDsNone(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	c.Check(got, tc.HasLen, 0)
 }
+
+func (s *stateSuite) TestSecretDataKeys(c *tc.C) {
+	ctx := c.Context()
+
+	keys, err := s.state.GetSecretDataKeys(ctx)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(keys, tc.HasLen, 0)
+
+	now := time.Now().UTC()
+	first := domainsecret.DataKey{UUID: "dek-1", WrappedKey: "local:kek-1:one", CreatedAt: now}
+	second := domainsecret.DataKey{UUID: "dek-2", WrappedKey: "local:kek-1:two", CreatedAt: now.Add(time.Minute)}
+	err = s.state.AddSecretDataKey(ctx, second)
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.AddSecretDataKey(ctx, first)
+	c.Assert(err, tc.ErrorIsNil)
+
+	keys, err = s.state.GetSecretDataKeys(ctx)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(keys, tc.HasLen, 2)
+	c.Check(keys[0].UUID, tc.Equals, "dek-1")
+	c.Check(keys[1].UUID, tc.Equals, "dek-2")
+
+	first.WrappedKey = "local:kek-2:one"
+	err = s.state.UpdateSecretDataKeys(ctx, []domainsecret.DataKey{first})
+	c.Assert(err, tc.ErrorIsNil)
+	keys, err = s.state.GetSecretDataKeys(ctx)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(keys[0].WrappedKey, tc.Equals, "local:kek-2:one")
+	c.Check(keys[1].WrappedKey, tc.Equals, "local:kek-1:two")
+
+	err = s.state.UpdateSecretDataKeys(ctx, []domainsecret.DataKey{{UUID: "dek-3", WrappedKey: "x"}})
+	c.Check(err, tc.ErrorIs, coreerrors.NotFound)
+}
+
+func (s *stateSuite) TestUpdateSecretContentValues(c *tc.C) {
+	s.setupUnits(c, "mysql")
+
+	sp := domainsecret.UpsertSecretParams{
+		RevisionUUID: new(uuid.MustNewUUID().String()),
+	}
+	fillDataForUpsertSecretParams(c, &sp, coresecrets.SecretData{"foo": "bar", "hello": "juju-enc:v1:dek-1:world"})
+	uri := coresecrets.NewURI()
+	ctx := c.Context()
+	err := s.createCharmUnitSecret(c, 1, uri, "mysql/0", sp)
+	c.Assert(err, tc.ErrorIsNil)
+
+	values, err := s.state.GetSecretContentWithoutPrefix(ctx, "juju-enc:v1:")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(values, tc.DeepEquals, []domainsecret.ContentValue{{
+		RevisionUUID: *sp.RevisionUUID,
+		Name:         "foo",
+		Content:      "bar",
+	}})
+
+	values[0].Content = "juju-enc:v1:dek-1:bar"
+	err = s.state.UpdateSecretContentValues(ctx, values, []string{"bar"})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Content changed since it was read is left alone.
+	values[0].Content = "stale"
+	err = s.state.UpdateSecretContentValues(ctx, values, []string{"bar"})
+	c.Assert(err, tc.ErrorIsNil)
+
+	content, _, err := s.state.GetSecretValue(ctx, uri, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(content, tc.DeepEquals, coresecrets.SecretData{
+		"foo":   "juju-enc:v1:dek-1:bar",
+		"hello": "juju-enc:v1:dek-1:world",
+	})
+	values, err = s.state.GetSecretContentWithoutPrefix(ctx, "juju-enc:v1:")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(values, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestCreateExternalUserSecret(c *tc.C) {
+	ctx := c.Context()
+	sp := domainsecret.UpsertSecretParams{
+		Description:  new("my secret"),
+		Label:        new("my label"),
+		RevisionUUID: new(uuid.MustNewUUID().String()),
+		UpdateTime:   time.Now(),
+	}
+	ref := domainsecret.ExternalRef{
+		BackendID: "backend-id",
+		Path:      "kv/app/db",
+		Key:       "password",
+	}
+	uri := coresecrets.NewURI()
+	err := s.state.CreateExternalUserSecret(ctx, 1, uri, sp, ref, "3")
+	c.Assert(err, tc.ErrorIsNil)
+
+	gotRef, err := s.state.GetSecretExternalRef(ctx, uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(gotRef, tc.DeepEquals, ref)
+	version, err := s.state.GetSecretRevisionExternalVersion(ctx, uri, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(version, tc.Equals, "3")
+
+	// There is no content or value reference for the revision.
+	_, _, err = s.state.GetSecretValue(ctx, uri, 1)
+	c.Check(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+
+	access, err := s.state.GetSecretAccess(ctx, uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     s.modelUUID,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(access, tc.Equals, "manage")
+
+	md, err := s.state.GetSecret(ctx, uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(md.Label, tc.Equals, "my label")
+	c.Check(md.LatestRevision, tc.Equals, 1)
+
+	// External content is never drained.
+	toDrain, err := s.state.ListUserSecretsToDrain(ctx)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(toDrain, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestCreateExternalUserSecretLabelExists(c *tc.C) {
+	ctx := c.Context()
+	sp := domainsecret.UpsertSecretParams{
+		Label:        new("my label"),
+		Data:         coresecrets.SecretData{"foo": "bar"},
+		RevisionUUID: new(uuid.MustNewUUID().String()),
+	}
+	err := s.createUserSecret(c, 1, coresecrets.NewURI(), sp)
+	c.Assert(err, tc.ErrorIsNil)
+
+	sp = domainsecret.UpsertSecretParams{
+		Label:        new("my label"),
+		RevisionUUID: new(uuid.MustNewUUID().String()),
+	}
+	err = s.state.CreateExternalUserSecret(ctx, 1, coresecrets.NewURI(), sp, domainsecret.ExternalRef{
+		BackendID: "backend-id",
+		Path:      "kv/app/db",
+	}, "1")
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretLabelAlreadyExists)
+}
+
+func (s *stateSuite) TestAddExternalSecretRevision(c *tc.C) {
+	ctx := c.Context()
+	sp := domainsecret.UpsertSecretParams{
+		RevisionUUID: new(uuid.MustNewUUID().String()),
+		UpdateTime:   time.Now(),
+	}
+	ref := domainsecret.ExternalRef{
+		BackendID: "backend-id",
+		Path:      "kv/app/db",
+	}
+	uri := coresecrets.NewURI()
+	err := s.state.CreateExternalUserSecret(ctx, 1, uri, sp, ref, "3")
+	c.Assert(err, tc.ErrorIsNil)
+
+	rev, err := s.state.AddExternalSecretRevision(ctx, uri, uuid.MustNewUUID().String(), "4", time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rev, tc.Equals, 2)
+
+	latest, err := s.state.GetLatestRevision(ctx, uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(latest, tc.Equals, 2)
+	version, err := s.state.GetSecretRevisionExternalVersion(ctx, uri, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(version, tc.Equals, "3")
+	version, err = s.state.GetSecretRevisionExternalVersion(ctx, uri, 2)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(version, tc.Equals, "4")
+
+	external, err := s.state.ListExternalSecrets(ctx)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(external, tc.HasLen, 1)
+	c.Check(external[0].URI.ID, tc.Equals, uri.ID)
+	c.Check(external[0].ExternalRef, tc.DeepEquals, ref)
+	c.Check(external[0].LatestRevision, tc.Equals, 2)
+	c.Check(external[0].LatestVersion, tc.Equals, "4")
+}
+
+func (s *stateSuite) TestAddExternalSecretRevisionNotExternal(c *tc.C) {
+	sp := domainsecret.UpsertSecretParams{
+		Data:         coresecrets.SecretData{"foo": "bar"},
+		RevisionUUID: new(uuid.MustNewUUID().String()),
+	}
+	uri := coresecrets.NewURI()
+	err := s.createUserSecret(c, 1, uri, sp)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.state.AddExternalSecretRevision(c.Context(), uri, uuid.MustNewUUID().String(), "4", time.Now())
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretNotExternal)
+	_, err = s.state.GetSecretExternalRef(c.Context(), uri)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretNotExternal)
+	_, err = s.state.GetSecretRevisionExternalVersion(c.Context(), uri, 1)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+
+	external, err := s.state.ListExternalSecrets(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(external, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestGetGeneratedSecrets(c *tc.C) {
+	ctx := c.Context()
+	now := time.Now().UTC().Truncate(time.Second)
+	policy := domainsecret.RotateDaily
+	spec := &coresecrets.GeneratorSpec{
+		Format:  coresecrets.GeneratePassword,
+		Length:  16,
+		Charset: "abc",
+	}
+
+	// A generated secret which rotates.
+	rotated := coresecrets.NewURI()
+	err := s.state.CreateUserSecret(ctx, 1, rotated, domainsecret.UpsertSecretParams{
+		RevisionUUID:   new(uuid.MustNewUUID().String()),
+		Data:           coresecrets.SecretData{"value": "YmFy"},
+		RotatePolicy:   &policy,
+		NextRotateTime: new(now.Add(-time.Minute)),
+		Generator:      spec,
+		UpdateTime:     now,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// A generated secret which never rotates.
+	unrotated := coresecrets.NewURI()
+	err = s.state.CreateUserSecret(ctx, 1, unrotated, domainsecret.UpsertSecretParams{
+		RevisionUUID: new(uuid.MustNewUUID().String()),
+		Data:         coresecrets.SecretData{"value": "YmFy"},
+		Generator:    &coresecrets.GeneratorSpec{Format: coresecrets.GenerateEd25519},
+		UpdateTime:   now,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// A secret which is not generated.
+	plain := coresecrets.NewURI()
+	err = s.state.CreateUserSecret(ctx, 1, plain, domainsecret.UpsertSecretParams{
+		RevisionUUID: new(uuid.MustNewUUID().String()),
+		Data:         coresecrets.SecretData{"value": "YmFy"},
+		UpdateTime:   now,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := s.state.GetGeneratedSecrets(ctx, rotated.ID, unrotated.ID, plain.ID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.HasLen, 1)
+	c.Check(result[0].URI, tc.DeepEquals, rotated)
+	c.Check(result[0].Generator, tc.DeepEquals, *spec)
+	c.Check(result[0].RotatePolicy, tc.Equals, coresecrets.RotateDaily)
+	c.Check(result[0].NextRotateTime.Equal(now.Add(-time.Minute)), tc.IsTrue)
+}
+
+func (s *stateSuite) TestGetGeneratedSecretsRotationChanges(c *tc.C) {
+	ctx := c.Context()
+	now := time.Now().UTC().Truncate(time.Second)
+	policy := domainsecret.RotateDaily
+
+	generated := coresecrets.NewURI()
+	err := s.state.CreateUserSecret(ctx, 1, generated, domainsecret.UpsertSecretParams{
+		RevisionUUID:   new(uuid.MustNewUUID().String()),
+		Data:           coresecrets.SecretData{"value": "YmFy"},
+		RotatePolicy:   &policy,
+		NextRotateTime: new(now.Add(time.Hour)),
+		Generator:      &coresecrets.GeneratorSpec{Format: coresecrets.GenerateHex},
+		UpdateTime:     now,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// A generated secret which never rotates.
+	err = s.state.CreateUserSecret(ctx, 1, coresecrets.NewURI(), domainsecret.UpsertSecretParams{
+		RevisionUUID: new(uuid.MustNewUUID().String()),
+		Data:         coresecrets.SecretData{"value": "YmFy"},
+		Generator:    &coresecrets.GeneratorSpec{Format: coresecrets.GenerateEd25519},
+		UpdateTime:   now,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := s.state.GetGeneratedSecretsRotationChanges(ctx)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.HasLen, 1)
+	c.Check(result[0].URI, tc.DeepEquals, generated)
+	c.Check(result[0].Revision, tc.Equals, 1)
+	c.Check(result[0].NextTriggerTime.Equal(now.Add(time.Hour)), tc.IsTrue)
+
+	err = s.state.SecretRotated(ctx, generated, now.AddDate(0, 0, 1))
+	c.Assert(err, tc.ErrorIsNil)
+	result, err = s.state.GetGeneratedSecretsRotationChanges(ctx, generated.ID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.HasLen, 1)
+	c.Check(result[0].NextTriggerTime.Equal(now.AddDate(0, 0, 1)), tc.IsTrue)
+}
+
+func (s *stateSuite) TestRecordAndListSecretAccess(c *tc.C) {
+	ctx := c.Context()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	uri := coresecrets.NewURI()
+	err := s.state.CreateUserSecret(ctx, 1, uri, domainsecret.UpsertSecretParams{
+		RevisionUUID: new(uuid.MustNewUUID().String()),
+		Data:         coresecrets.SecretData{"foo": "YmFy"},
+		UpdateTime:   now,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	unit := domainsecret.SecretAccessor{Kind: domainsecret.UnitAccessor, ID: "mariadb/0"}
+	user := domainsecret.SecretAccessor{Kind: domainsecret.UserAccessor, ID: "fred"}
+
+	// Repeated reads by the same accessor are deduplicated.
+	err = s.state.RecordSecretAccess(ctx, uri, 1, unit, now)
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.RecordSecretAccess(ctx, uri, 1, unit, now.Add(time.Minute))
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.RecordSecretAccess(ctx, uri, 1, user, now.Add(2*time.Minute))
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.RecordSecretAccess(ctx, uri, 2, unit, now.Add(3*time.Minute))
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := s.state.ListSecretAccess(ctx, uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.HasLen, 3)
+	c.Check(result[0], tc.DeepEquals, domainsecret.SecretAccessRecord{
+		Revision:        2,
+		Accessor:        unit,
+		FirstAccessTime: now.Add(3 * time.Minute),
+		LastAccessTime:  now.Add(3 * time.Minute),
+		AccessCount:     1,
+	})
+	c.Check(result[1], tc.DeepEquals, domainsecret.SecretAccessRecord{
+		Revision:        1,
+		Accessor:        user,
+		FirstAccessTime: now.Add(2 * time.Minute),
+		LastAccessTime:  now.Add(2 * time.Minute),
+		AccessCount:     1,
+	})
+	c.Check(result[2], tc.DeepEquals, domainsecret.SecretAccessRecord{
+		Revision:        1,
+		Accessor:        unit,
+		FirstAccessTime: now,
+		LastAccessTime:  now.Add(time.Minute),
+		AccessCount:     2,
+	})
+}
+
+func (s *stateSuite) TestPruneSecretAccess(c *tc.C) {
+	ctx := c.Context()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	uri := coresecrets.NewURI()
+	err := s.state.CreateUserSecret(ctx, 1, uri, domainsecret.UpsertSecretParams{
+		RevisionUUID: new(uuid.MustNewUUID().String()),
+		Data:         coresecrets.SecretData{"foo": "YmFy"},
+		UpdateTime:   now,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	old := domainsecret.SecretAccessor{Kind: domainsecret.UnitAccessor, ID: "mariadb/0"}
+	recent := domainsecret.SecretAccessor{Kind: domainsecret.UnitAccessor, ID: "mariadb/1"}
+	err = s.state.RecordSecretAccess(ctx, uri, 1, old, now.Add(-48*time.Hour))
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.RecordSecretAccess(ctx, uri, 1, recent, now.Add(-48*time.Hour))
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.RecordSecretAccess(ctx, uri, 1, recent, now)
+	c.Assert(err, tc.ErrorIsNil)
+
+	deleted, err := s.state.PruneSecretAccess(ctx, now.Add(-24*time.Hour))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(deleted, tc.Equals, int64(1))
+
+	result, err := s.state.ListSecretAccess(ctx, uri)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.HasLen, 1)
+	c.Check(result[0].Accessor, tc.DeepEquals, recent)
+	c.Check(result[0].AccessCount, tc.Equals, 2)
+}
@@ -173,6 +173,53 @@ type secretValueRef struct {
 	RevisionID   string `db:"revision_id"`
 }
 
+type secretExternalRef struct {
+	SecretID    string `db:"secret_id"`
+	BackendUUID string `db:"backend_uuid"`
+	Path        string `db:"path"`
+	ContentKey  string `db:"content_key"`
+}
+
+type secretGenerator struct {
+	SecretID string `db:"secret_id"`
+	Format   string `db:"format"`
+	Length   int    `db:"length"`
+	Charset  string `db:"charset"`
+}
+
+type generatedSecret struct {
+	SecretID       string    `db:"secret_id"`
+	Format         string    `db:"format"`
+	Length         int       `db:"length"`
+	Charset        string    `db:"charset"`
+	RotatePolicy   string    `db:"policy"`
+	NextRotateTime time.Time `db:"next_rotation_time"`
+}
+
+type secretRevisionAccess struct {
+	SecretID        string    `db:"secret_id"`
+	Revision        int       `db:"revision"`
+	AccessorTypeID  int       `db:"accessor_type_id"`
+	AccessorName    string    `db:"accessor_name"`
+	FirstAccessTime time.Time `db:"first_access_time"`
+	LastAccessTime  time.Time `db:"last_access_time"`
+	AccessCount     int       `db:"access_count"`
+}
+
+type secretRevisionExternalVersion struct {
+	RevisionUUID string `db:"revision_uuid"`
+	Version      string `db:"version"`
+}
+
+type externalSecret struct {
+	SecretID       string `db:"secret_id"`
+	BackendUUID    string `db:"backend_uuid"`
+	Path           string `db:"path"`
+	ContentKey     string `db:"content_key"`
+	LatestRevision int    `db:"revision"`
+	LatestVersion  string `db:"version"`
+}
+
 type secretExternalRevision struct {
 	Revision    int    `db:"revision"`
 	BackendUUID string `db:"backend_uuid"`
@@ -520,3 +567,13 @@ type count struct {
 func getRevisionID(secretID string, revision int) string {
 	return fmt.Sprintf("%s/%d", secretID, revision)
 }
+
+type secretDataKey struct {
+	UUID       string    `db:"uuid"`
+	WrappedKey string    `db:"wrapped_key"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+type contentPrefix struct {
+	Pattern string `db:"pattern"`
+}
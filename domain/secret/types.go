@@ -56,6 +56,10 @@ type UpsertSecretParams struct {
 	Data     secrets.SecretData
 	ValueRef *secrets.ValueRef
 	Checksum string
+
+	// Generator, if set when creating a secret, records
+	// how the content of each new revision is generated.
+	Generator *secrets.GeneratorSpec
 }
 
 // HasUpdate returns true if at least one attribute to update is not nil.
@@ -262,6 +266,10 @@ const (
 	ApplicationAccessor SecretAccessorKind = "application"
 	UnitAccessor        SecretAccessorKind = "unit"
 	ModelAccessor       SecretAccessorKind = "model"
+
+	// UserAccessor is only used to record reads of
+	// secret content by users in the access log.
+	UserAccessor SecretAccessorKind = "user"
 )
 
 // SecretAccessScope represents the scope of a secret permission.
@@ -280,3 +288,58 @@ const (
 	RelationAccessScope    SecretAccessScopeKind = "relation"
 	ModelAccessScope       SecretAccessScopeKind = "model"
 )
+
+// DataKey is a key used to encrypt the content of secrets stored in the
+// model database. Only the wrapped form of the key is ever stored.
+type DataKey struct {
+	// UUID identifies the data key.
+	UUID string
+	// WrappedKey is the key material, wrapped by a controller
+	// key-encryption key.
+	WrappedKey string
+	// CreatedAt is when the data key was created.
+	CreatedAt time.Time
+}
+
+// ContentValue is a single named value of a secret revision's content.
+type ContentValue struct {
+	// RevisionUUID identifies the secret revision.
+	RevisionUUID string
+	// Name is the name of the value.
+	Name string
+	// Content is the stored value.
+	Content string
+}
+
+// ExternalRef is the location of the content of a user secret
+// which is managed outside of Juju.
+type ExternalRef struct {
+	// BackendID is the ID of the secret backend holding the content.
+	BackendID string
+	// Path is the location of the content in the backend.
+	Path string
+	// Key, if set, selects a single key from the content.
+	Key string
+}
+
+// ExternalSecret holds the external reference of a secret,
+// together with the upstream version of its latest revision.
+type ExternalSecret struct {
+	URI *secrets.URI
+	ExternalRef
+	// LatestRevision is the latest revision of the secret.
+	LatestRevision int
+	// LatestVersion is the upstream version of the latest revision.
+	LatestVersion string
+}
+
+// GeneratedSecret holds the details of a user secret
+// whose content is generated by Juju.
+type GeneratedSecret struct {
+	URI       *secrets.URI
+	Generator secrets.GeneratorSpec
+	// RotatePolicy is how often new content is generated.
+	RotatePolicy secrets.RotatePolicy
+	// NextRotateTime is when new content is next due.
+	NextRotateTime time.Time
+}
@@ -877,6 +877,77 @@ func (s *watcherSuite) TestWatchSecretsRotationChanges(c *tc.C) {
 	harness1.Run(c, []corewatcher.SecretTriggerChange(nil))
 }
 
+func (s *watcherSuite) TestWatchGeneratedSecretsRotationChanges(c *tc.C) {
+	mysqlAppUUID := s.setupUnits(c, "mysql")
+
+	ctx := c.Context()
+	svc, st := s.setupServiceAndState(c)
+
+	generated := coresecrets.NewURI()
+	charmSecret := coresecrets.NewURI()
+
+	s.AssertChangeStreamIdle(c, "before watcher start")
+
+	w, err := svc.WatchGeneratedSecretsRotationChanges(c.Context())
+	c.Assert(err, tc.IsNil)
+	c.Assert(w, tc.NotNil)
+	defer watchertest.CleanKill(c, w)
+
+	now := time.Now()
+	harness := watchertest.NewHarness(s, watchertest.NewWatcherC(c, w))
+	harness.AddTest(c, func(c *tc.C) {
+		policy := secret.RotateDaily
+		err := st.CreateUserSecret(ctx, 1, generated, secret.UpsertSecretParams{
+			RevisionUUID:   new(uuid.MustNewUUID().String()),
+			Data:           coresecrets.SecretData{"value": "YmFy"},
+			RotatePolicy:   &policy,
+			NextRotateTime: new(now.Add(time.Hour)),
+			Generator:      &coresecrets.GeneratorSpec{Format: coresecrets.GenerateHex},
+			CreateTime:     now,
+			UpdateTime:     now,
+		})
+		c.Assert(err, tc.ErrorIsNil)
+
+		// The rotation of charm secrets is not reported.
+		err = st.CreateCharmApplicationSecret(ctx, 1, charmSecret, coreapplication.UUID(mysqlAppUUID), secret.UpsertSecretParams{
+			RevisionUUID: new(uuid.MustNewUUID().String()),
+			Data:         coresecrets.SecretData{"foo": "bar"},
+			CreateTime:   now,
+			UpdateTime:   now,
+		})
+		c.Assert(err, tc.ErrorIsNil)
+		err = st.SecretRotated(ctx, charmSecret, now.Add(time.Hour))
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[[]corewatcher.SecretTriggerChange]) {
+		w.Check(
+			watchertest.SecretTriggerSliceAssert(
+				corewatcher.SecretTriggerChange{
+					URI:             generated,
+					Revision:        1,
+					NextTriggerTime: now.Add(time.Hour),
+				},
+			),
+		)
+	})
+
+	harness.AddTest(c, func(c *tc.C) {
+		err = st.SecretRotated(ctx, generated, now.Add(2*time.Hour))
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[[]corewatcher.SecretTriggerChange]) {
+		w.Check(
+			watchertest.SecretTriggerSliceAssert(
+				corewatcher.SecretTriggerChange{
+					URI:             generated,
+					Revision:        1,
+					NextTriggerTime: now.Add(2 * time.Hour),
+				},
+			),
+		)
+	})
+
+	harness.Run(c, []corewatcher.SecretTriggerChange(nil))
+}
+
 func (s *watcherSuite) TestWatchSecretsRevisionExpiryChanges(c *tc.C) {
 	mysqlAppUUID := s.setupUnits(c, "mysql")
 	s.setupUnits(c, "mediawiki")
@@ -1065,7 +1136,7 @@ func (s *watcherSuite) setupServiceAndState(c *tc.C) (*service.WatchableService,
 		changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "secret_revision"),
 		logger,
 	)
-	return service.NewWatchableService(st, nil, nil, factory, logger), st
+	return service.NewWatchableService(st, nil, nil, nil, factory, logger), st
 }
 
 func revID(uri *coresecrets.URI, rev int) string {
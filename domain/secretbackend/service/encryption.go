@@ -0,0 +1,61 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/juju/controller"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/envelope"
+)
+
+// RotateKeyEncryptionKey starts a rotation of the key-encryption key used
+// to wrap the data keys which encrypt secret content stored in model
+// databases. When the controller holds the key-encryption key, a new one is
+// added to the controller's key store. When the key is held in Vault, it
+// must be rotated in Vault; the data keys are re-wrapped with its latest
+// version.
+//
+// Each controller machine holds its own copy of the key-encryption keys,
+// which are copied to new controller machines as they join. A new key can
+// not be copied to the other machines of a running controller, so rotating
+// the controller-held key returns an error satisfying
+// [coreerrors.NotSupported] when there is more than one controller machine.
+//
+// Existing data keys remain wrapped with the previous key until each model's
+// data keys are re-wrapped.
+func (s *WatchableService) RotateKeyEncryptionKey(ctx context.Context) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	cfg, err := s.st.GetSecretEncryptionConfig(ctx)
+	if err != nil {
+		return errors.Errorf("getting secret encryption config: %w", err)
+	}
+	if cfg.KMS != controller.SecretEncryptionKMSInternal {
+		s.logger.Infof(ctx, "secret key-encryption key is held by %q, not creating a new key", cfg.KMS)
+		return nil
+	}
+
+	nodes, err := s.st.GetControllerNodeCount(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if nodes > 1 {
+		return errors.Errorf(
+			"rotating the key-encryption key of a controller with %d machines %w", nodes, coreerrors.NotSupported)
+	}
+
+	kek, err := envelope.NewKeyEncryptionKey()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := s.keyStore.AddKeyEncryptionKeys(kek); err != nil {
+		return errors.Errorf("adding key-encryption key: %w", err)
+	}
+	return nil
+}
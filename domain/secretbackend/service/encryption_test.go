@@ -0,0 +1,64 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/controller"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/domain/secretbackend"
+	"github.com/juju/juju/internal/secrets/envelope"
+)
+
+func (s *serviceSuite) TestRotateKeyEncryptionKey(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.mockState.EXPECT().GetSecretEncryptionConfig(gomock.Any()).Return(secretbackend.EncryptionConfig{
+		KMS: controller.SecretEncryptionKMSInternal,
+	}, nil)
+	s.mockState.EXPECT().GetControllerNodeCount(gomock.Any()).Return(1, nil)
+
+	keyStore := envelope.NewFileKeyStore(envelope.KeyStorePath(c.MkDir()))
+	svc := newWatchableService(s.mockState, s.logger, nil, keyStore, s.clock, nil)
+	err := svc.RotateKeyEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	keys, err := keyStore.KeyEncryptionKeys()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(keys, tc.HasLen, 1)
+	c.Check(keys[0].ID, tc.Not(tc.Equals), "")
+	c.Check(keys[0].Key, tc.HasLen, 32)
+}
+
+func (s *serviceSuite) TestRotateKeyEncryptionKeyHighAvailability(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.mockState.EXPECT().GetSecretEncryptionConfig(gomock.Any()).Return(secretbackend.EncryptionConfig{
+		KMS: controller.SecretEncryptionKMSInternal,
+	}, nil)
+	s.mockState.EXPECT().GetControllerNodeCount(gomock.Any()).Return(3, nil)
+
+	keyStore := envelope.NewFileKeyStore(envelope.KeyStorePath(c.MkDir()))
+	svc := newWatchableService(s.mockState, s.logger, nil, keyStore, s.clock, nil)
+	err := svc.RotateKeyEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
+
+	keys, err := keyStore.KeyEncryptionKeys()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(keys, tc.HasLen, 0)
+}
+
+func (s *serviceSuite) TestRotateKeyEncryptionKeyVaultTransit(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.mockState.EXPECT().GetSecretEncryptionConfig(gomock.Any()).Return(secretbackend.EncryptionConfig{
+		KMS: controller.SecretEncryptionKMSVaultTransit,
+	}, nil)
+
+	svc := newWatchableService(s.mockState, s.logger, nil, nil, s.clock, nil)
+	err := svc.RotateKeyEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
@@ -78,6 +78,13 @@ type State interface {
 	// revision is stored in the specified backend and returns a rollback
 	// function to remove the reference if needed.
 	AddSecretBackendReference(ctx context.Context, valueRef *secrets.ValueRef, modelID coremodel.UUID, revisionID string, secretID string) (func() error, error)
+
+	// GetSecretEncryptionConfig returns the controller's configuration for
+	// encrypting secret content.
+	GetSecretEncryptionConfig(ctx context.Context) (secretbackend.EncryptionConfig, error)
+
+	// GetControllerNodeCount returns the number of controller nodes.
+	GetControllerNodeCount(ctx context.Context) (int, error)
 }
 
 // AdminBackendConfigGetterFunc returns a function that gets the
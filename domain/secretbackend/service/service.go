@@ -28,6 +28,7 @@ import (
 	secretbackenderrors "github.com/juju/juju/domain/secretbackend/errors"
 	"github.com/juju/juju/internal/errors"
 	internalsecrets "github.com/juju/juju/internal/secrets"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/secrets/provider"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	"github.com/juju/juju/internal/secrets/provider/kubernetes"
@@ -747,21 +748,25 @@ type WatcherFactory interface {
 type WatchableService struct {
 	Service
 	watcherFactory WatcherFactory
+	keyStore       envelope.KeyStore
 }
 
 // NewWatchableService creates a new WatchableService for interacting with the secret backend state and watching for changes.
+// The key store holds the controller's secret key-encryption keys.
 func NewWatchableService(
 	st State, logger logger.Logger,
 	wf WatcherFactory,
+	keyStore envelope.KeyStore,
 ) *WatchableService {
 	return newWatchableService(
-		st, logger, wf, clock.WallClock, provider.Provider,
+		st, logger, wf, keyStore, clock.WallClock, provider.Provider,
 	)
 }
 
 func newWatchableService(
 	st State, logger logger.Logger,
 	wf WatcherFactory,
+	keyStore envelope.KeyStore,
 	clk clock.Clock,
 	registry SecretProviderRegistry,
 ) *WatchableService {
@@ -773,6 +778,7 @@ func newWatchableService(
 			registry: registry,
 		},
 		watcherFactory: wf,
+		keyStore:       keyStore,
 	}
 }
 
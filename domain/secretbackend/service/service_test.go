@@ -1584,7 +1584,7 @@ func (s *serviceSuite) TestWatchSecretBackendRotationChanges(c *tc.C) {
 	nextRotateTime2 := time.Now().Add(24 * time.Hour)
 
 	svc := newWatchableService(
-		s.mockState, s.logger, s.mockWatcherFactory, s.clock,
+		s.mockState, s.logger, s.mockWatcherFactory, nil, s.clock,
 		func(backendType string) (provider.SecretBackendProvider, error) {
 			return providerWithConfig{
 				SecretBackendProvider: s.mockRegistry,
@@ -1852,7 +1852,7 @@ func (s *serviceSuite) TestWatchModelSecretBackendChanged(c *tc.C) {
 	defer ctrl.Finish()
 
 	svc := newWatchableService(
-		s.mockState, s.logger, s.mockWatcherFactory, s.clock,
+		s.mockState, s.logger, s.mockWatcherFactory, nil, s.clock,
 		func(backendType string) (provider.SecretBackendProvider, error) {
 			return providerWithConfig{
 				SecretBackendProvider: s.mockRegistry,
@@ -33,11 +33,13 @@ type MockStateMockRecorder struct {
 	addSecretBackendReferenceExpects                            []*gomock.Call5_2[context.Context, *secrets.ValueRef, model.UUID, string, string, func() error, error]
 	createSecretBackendExpects                                  []*gomock.Call2_2[context.Context, secretbackend.CreateSecretBackendParams, string, error]
 	deleteSecretBackendExpects                                  []*gomock.Call3_1[context.Context, secretbackend.BackendIdentifier, bool, error]
+	getControllerNodeCountExpects                               []*gomock.Call1_2[context.Context, int, error]
 	getInternalAndActiveBackendUUIDsExpects                     []*gomock.Call2_3[context.Context, model.UUID, string, string, error]
 	getModelSecretBackendDetailsExpects                         []*gomock.Call2_2[context.Context, model.UUID, secretbackend.ModelSecretBackend, error]
 	getModelTypeExpects                                         []*gomock.Call2_2[context.Context, model.UUID, model.ModelType, error]
 	getSecretBackendExpects                                     []*gomock.Call2_2[context.Context, secretbackend.BackendIdentifier, *secretbackend.SecretBackend, error]
 	getSecretBackendRotateChangesExpects                        []*gomock.Call1V_2[context.Context, string, []watcher.SecretBackendRotateChange, error]
+	getSecretEncryptionConfigExpects                            []*gomock.Call1_2[context.Context, secretbackend.EncryptionConfig, error]
 	initialWatchStatementForSecretBackendRotationChangesExpects []*gomock.Call0_2[string, string]
 	listSecretBackendIDsExpects                                 []*gomock.Call1_2[context.Context, []string, error]
 	listSecretBackendsExpects                                   []*gomock.Call1_2[context.Context, []*secretbackend.SecretBackend, error]
@@ -114,6 +116,24 @@ func (mr *MockStateMockRecorder) DeleteSecretBackend(ctx, arg1, deleteInUse any)
 // MockStateDeleteSecretBackendCall is the typed call wrapper for DeleteSecretBackend.
 type MockStateDeleteSecretBackendCall = gomock.Call3_1[context.Context, secretbackend.BackendIdentifier, bool, error]
 
+// GetControllerNodeCount mocks base method.
+func (m *MockState) GetControllerNodeCount(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getControllerNodeCountExpects, m.ctrl, m, "GetControllerNodeCount", ctx)
+}
+
+// GetControllerNodeCount indicates an expected call of GetControllerNodeCount.
+func (mr *MockStateMockRecorder) GetControllerNodeCount(ctx any) *MockStateGetControllerNodeCountCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, int, error](mr.mock.ctrl.T, mr.mock, "GetControllerNodeCount", gomock.EnsureMatcher(ctx))
+	mr.getControllerNodeCountExpects = append(mr.getControllerNodeCountExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetControllerNodeCountCall is the typed call wrapper for GetControllerNodeCount.
+type MockStateGetControllerNodeCountCall = gomock.Call1_2[context.Context, int, error]
+
 // GetInternalAndActiveBackendUUIDs mocks base method.
 func (m *MockState) GetInternalAndActiveBackendUUIDs(ctx context.Context, modelUUID model.UUID) (string, string, error) {
 	m.ctrl.T.Helper()
@@ -205,6 +225,24 @@ func (mr *MockStateMockRecorder) GetSecretBackendRotateChanges(ctx any, backendI
 // MockStateGetSecretBackendRotateChangesCall is the typed call wrapper for GetSecretBackendRotateChanges.
 type MockStateGetSecretBackendRotateChangesCall = gomock.Call1V_2[context.Context, string, []watcher.SecretBackendRotateChange, error]
 
+// GetSecretEncryptionConfig mocks base method.
+func (m *MockState) GetSecretEncryptionConfig(ctx context.Context) (secretbackend.EncryptionConfig, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getSecretEncryptionConfigExpects, m.ctrl, m, "GetSecretEncryptionConfig", ctx)
+}
+
+// GetSecretEncryptionConfig indicates an expected call of GetSecretEncryptionConfig.
+func (mr *MockStateMockRecorder) GetSecretEncryptionConfig(ctx any) *MockStateGetSecretEncryptionConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, secretbackend.EncryptionConfig, error](mr.mock.ctrl.T, mr.mock, "GetSecretEncryptionConfig", gomock.EnsureMatcher(ctx))
+	mr.getSecretEncryptionConfigExpects = append(mr.getSecretEncryptionConfigExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetSecretEncryptionConfigCall is the typed call wrapper for GetSecretEncryptionConfig.
+type MockStateGetSecretEncryptionConfigCall = gomock.Call1_2[context.Context, secretbackend.EncryptionConfig, error]
+
 // InitialWatchStatementForSecretBackendRotationChanges mocks base method.
 func (m *MockState) InitialWatchStatementForSecretBackendRotationChanges() (string, string) {
 	m.ctrl.T.Helper()
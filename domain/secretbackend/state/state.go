@@ -13,6 +13,7 @@ import (
 	"github.com/juju/collections/transform"
 
 	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/controller"
 	coredatabase "github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/logger"
 	coremodel "github.com/juju/juju/core/model"
@@ -1314,3 +1315,85 @@ FROM   secret_backend`, SecretBackend{})
 	}
 	return result, nil
 }
+
+// GetSecretEncryptionConfig returns the controller's configuration for
+// encrypting secret content.
+func (s *State) GetSecretEncryptionConfig(ctx context.Context) (secretbackend.EncryptionConfig, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return secretbackend.EncryptionConfig{}, errors.Capture(err)
+	}
+
+	keys := sqlair.S{
+		controller.SecretEncryptionKMS,
+		controller.SecretEncryptionVaultAddress,
+		controller.SecretEncryptionVaultTokenFile,
+		controller.SecretEncryptionVaultCACert,
+		controller.SecretEncryptionVaultTransitMount,
+		controller.SecretEncryptionVaultTransitKey,
+	}
+	configStmt, err := s.Prepare(`
+SELECT &controllerConfigItem.*
+FROM   v_controller_config
+WHERE  key IN ($S[:])`, controllerConfigItem{}, keys)
+	if err != nil {
+		return secretbackend.EncryptionConfig{}, errors.Capture(err)
+	}
+
+	var items []controllerConfigItem
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, configStmt, keys).GetAll(&items)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("querying secret encryption config: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return secretbackend.EncryptionConfig{}, errors.Capture(err)
+	}
+
+	result := secretbackend.EncryptionConfig{
+		KMS:               controller.DefaultSecretEncryptionKMS,
+		VaultTransitMount: controller.DefaultSecretEncryptionVaultTransitMount,
+	}
+	for _, item := range items {
+		switch item.Key {
+		case controller.SecretEncryptionKMS:
+			result.KMS = item.Value
+		case controller.SecretEncryptionVaultAddress:
+			result.VaultAddress = item.Value
+		case controller.SecretEncryptionVaultTokenFile:
+			result.VaultTokenFile = item.Value
+		case controller.SecretEncryptionVaultCACert:
+			result.VaultCACert = item.Value
+		case controller.SecretEncryptionVaultTransitMount:
+			result.VaultTransitMount = item.Value
+		case controller.SecretEncryptionVaultTransitKey:
+			result.VaultTransitKey = item.Value
+		}
+	}
+	return result, nil
+}
+
+// GetControllerNodeCount returns the number of controller nodes.
+func (s *State) GetControllerNodeCount(ctx context.Context) (int, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	var result Count
+	stmt, err := s.Prepare(`
+SELECT COUNT(*) AS &Count.num
+FROM   controller_node`, result)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return tx.Query(ctx, stmt).Get(&result)
+	})
+	if err != nil {
+		return 0, errors.Errorf("counting controller nodes: %w", err)
+	}
+	return result.Num, nil
+}
@@ -1842,3 +1842,52 @@ func (s *stateSuite) TestGetSecretBackendNamesByUUIDEmpty(c *tc.C) {
 	c.Assert(err, tc.IsNil)
 	c.Assert(names, tc.HasLen, 0)
 }
+
+func (s *stateSuite) TestGetSecretEncryptionConfigDefaults(c *tc.C) {
+	cfg, err := s.state.GetSecretEncryptionConfig(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cfg, tc.DeepEquals, secretbackend.EncryptionConfig{
+		KMS:               "internal",
+		VaultTransitMount: "transit",
+	})
+}
+
+func (s *stateSuite) TestGetSecretEncryptionConfig(c *tc.C) {
+	ccState := controllerconfigstate.NewState(s.TxnRunnerFactory())
+	err := ccState.UpdateControllerConfig(c.Context(), map[string]string{
+		"secret-encryption-kms":                 "vault-transit",
+		"secret-encryption-vault-address":       "https://vault.example.com:8200",
+		"secret-encryption-vault-token-file":    "/var/lib/juju/vault-token",
+		"secret-encryption-vault-ca-cert":       "ca-cert",
+		"secret-encryption-vault-transit-mount": "juju-transit",
+		"secret-encryption-vault-transit-key":   "juju",
+	}, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	cfg, err := s.state.GetSecretEncryptionConfig(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cfg, tc.DeepEquals, secretbackend.EncryptionConfig{
+		KMS:               "vault-transit",
+		VaultAddress:      "https://vault.example.com:8200",
+		VaultTokenFile:    "/var/lib/juju/vault-token",
+		VaultCACert:       "ca-cert",
+		VaultTransitMount: "juju-transit",
+		VaultTransitKey:   "juju",
+	})
+}
+
+func (s *stateSuite) TestGetControllerNodeCount(c *tc.C) {
+	n, err := s.state.GetControllerNodeCount(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(n, tc.Equals, 1)
+
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "INSERT INTO controller_node (controller_id) VALUES ('1')")
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	n, err = s.state.GetControllerNodeCount(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(n, tc.Equals, 2)
+}
@@ -79,6 +79,12 @@ type controllerName struct {
 	Name string `db:"name"`
 }
 
+// controllerConfigItem represents a controller config key and value.
+type controllerConfigItem struct {
+	Key   string `db:"key"`
+	Value string `db:"value"`
+}
+
 // upsertSecretBackendParams are used to upsert a secret backend.
 type upsertSecretBackendParams struct {
 	ID                  string
@@ -22,3 +22,22 @@ type ModelSecretBackend struct {
 	// SecretBackendName is the name of the secret backend configured for the model.
 	SecretBackendName string
 }
+
+// EncryptionConfig holds the controller's configuration for encrypting the
+// content of secrets stored in model databases.
+type EncryptionConfig struct {
+	// KMS is the key management service holding the key-encryption key.
+	KMS string
+
+	// VaultAddress is the URL of the Vault server holding the transit key.
+	VaultAddress string
+	// VaultTokenFile is the path of the file holding the token used to
+	// access the Vault transit engine.
+	VaultTokenFile string
+	// VaultCACert is the CA certificate of the Vault server.
+	VaultCACert string
+	// VaultTransitMount is the path the Vault transit engine is mounted on.
+	VaultTransitMount string
+	// VaultTransitKey is the name of the Vault transit key.
+	VaultTransitKey string
+}
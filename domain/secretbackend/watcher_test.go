@@ -45,6 +45,7 @@ func (s *watcherSuite) TestWatchSecretBackendRotationChanges(c *tc.C) {
 	svc := service.NewWatchableService(
 		state, logger,
 		domain.NewWatcherFactory(factory, logger),
+		nil,
 	)
 
 	watcher, err := svc.WatchSecretBackendRotationChanges(c.Context())
@@ -171,6 +172,7 @@ func (s *watcherSuite) TestWatchModelSecretBackendChanged(c *tc.C) {
 	svc := service.NewWatchableService(
 		state, logger,
 		domain.NewWatcherFactory(factory, logger),
+		nil,
 	)
 
 	modelUUID, internalBackendName, vaultBackendName := s.createModel(c, state, txnRunnerFactory, "test-model")
@@ -52,6 +52,7 @@ import (
 	upgradeservice "github.com/juju/juju/domain/upgrade/service"
 	upgradestate "github.com/juju/juju/domain/upgrade/state"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/envelope"
 )
 
 // ControllerServices provides access to the services required by the apiserver.
@@ -59,6 +60,7 @@ type ControllerServices struct {
 	serviceFactoryBase
 
 	controllerObjectStore objectstore.NamespacedObjectStoreGetter
+	secretKeyStore        envelope.KeyStore
 	clock                 clock.Clock
 	loggerContextGetter   logger.LoggerContextGetter
 }
@@ -68,6 +70,7 @@ type ControllerServices struct {
 func NewControllerServices(
 	controllerDB changestream.WatchableDBFactory,
 	controllerObjectStoreGetter objectstore.NamespacedObjectStoreGetter,
+	secretKeyStore envelope.KeyStore,
 	clock clock.Clock,
 	logger logger.Logger,
 	loggerContextGetter logger.LoggerContextGetter,
@@ -78,6 +81,7 @@ func NewControllerServices(
 			logger:       logger,
 		},
 		controllerObjectStore: controllerObjectStoreGetter,
+		secretKeyStore:        secretKeyStore,
 		clock:                 clock,
 		loggerContextGetter:   loggerContextGetter,
 	}
@@ -191,6 +195,7 @@ func (s *ControllerServices) SecretBackend() *secretbackendservice.WatchableServ
 		secretbackendstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB), log),
 		log,
 		s.controllerWatcherFactory("secretbackend"),
+		s.secretKeyStore,
 	)
 }
 
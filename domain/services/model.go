@@ -113,6 +113,7 @@ import (
 	"github.com/juju/juju/environs/config"
 	envtools "github.com/juju/juju/environs/tools"
 	"github.com/juju/juju/internal/resource/store"
+	"github.com/juju/juju/internal/secrets/envelope"
 )
 
 // PublicKeyImporter describes a service that is capable of fetching and
@@ -143,6 +144,7 @@ type ModelServices struct {
 	clusterDescriber            database.ClusterDescriber
 	simpleStreamsClient         http.HTTPClient
 	logDir                      string
+	secretKeyStore              envelope.KeyStore
 	clock                       clock.Clock
 }
 
@@ -161,6 +163,7 @@ func NewModelServices(
 	clusterDescriber database.ClusterDescriber,
 	simpleStreamsClient http.HTTPClient,
 	logDir string,
+	secretKeyStore envelope.KeyStore,
 	clock clock.Clock,
 	logger logger.Logger,
 ) *ModelServices {
@@ -181,6 +184,7 @@ func NewModelServices(
 		clusterDescriber:            clusterDescriber,
 		simpleStreamsClient:         simpleStreamsClient,
 		logDir:                      logDir,
+		secretKeyStore:              secretKeyStore,
 		clock:                       clock,
 		controllerObjectStoreGetter: controllerObjectStoreGetter,
 	}
@@ -414,6 +418,7 @@ func (s *ModelServices) Secret() *secretservice.WatchableService {
 	return secretservice.NewWatchableService(
 		secretstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB), log, s.clock),
 		secretbackendstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB), log),
+		s.secretKeyStore,
 		domain.NewLeaseService(s.leaseManager),
 		s.modelWatcherFactory("secret"),
 		log,
@@ -487,6 +492,7 @@ func (s *ModelServices) ModelInfo() *modelservice.ProviderModelService {
 		providertracker.ProviderRunner[modelservice.RegionProvider](s.providerFactory, s.modelUUID.String()),
 		s.storageRegistry,
 		modelservice.DefaultAgentBinaryFinder(),
+		domain.NewStatusHistory(s.logger.Child("modelinfo"), s.clock),
 		s.logger.Child("modelinfo"),
 	)
 }
@@ -494,7 +500,10 @@ func (s *ModelServices) ModelInfo() *modelservice.ProviderModelService {
 // Export returns the model export service.
 func (s *ModelServices) Export() *exportservice.Service {
 	return exportservice.NewService(
-		exportstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+		exportservice.NewSecretDecryptingState(
+			exportstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+			s.Secret(),
+		),
 		exportservice.ControllerInfoState{
 			Controller: modelmigrationstatecontroller.New(changestream.NewTxnRunnerFactory(s.controllerDB), s.clock),
 			Model:      modelmigrationstatemodel.New(changestream.NewTxnRunnerFactory(s.modelDB), s.modelUUID),
@@ -516,10 +525,12 @@ func (s *ModelServices) Proxy() *proxy.Service {
 // changes made by the charm while a hook was being run.
 func (s *ModelServices) UnitState() *unitstateservice.LeadershipService {
 	log := s.logger.Child("unitstate")
+	secretService := s.Secret()
 	return unitstateservice.NewLeadershipService(
 		unitstatestate.NewState(changestream.NewTxnRunnerFactory(s.modelDB), s.clock, log),
 		secretbackendstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB), log),
-		s.Secret(),
+		secretService,
+		secretService,
 		domain.NewLeaseService(s.leaseManager),
 		s.clock,
 		log,
@@ -656,6 +667,7 @@ func (s *ModelServices) CrossModelRelation() *crossmodelrelationservice.Watchabl
 		crossmodelrelationstatecontroller.NewState(changestream.NewTxnRunnerFactory(s.controllerDB), log),
 		crossmodelrelationstatemodel.NewState(changestream.NewTxnRunnerFactory(s.modelDB), s.modelUUID, s.clock, log),
 		domain.NewStatusHistory(log, s.clock),
+		s.Secret(),
 		s.modelWatcherFactory("crossmodelrelation"),
 		s.clock,
 		log,
@@ -47,6 +47,7 @@ import (
 	"github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	_ "github.com/juju/juju/internal/provider/dummy"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 	sshimporter "github.com/juju/juju/internal/ssh/importer"
 	"github.com/juju/juju/internal/storage"
@@ -268,6 +269,7 @@ type domainServices struct {
 // DomainServicesGetterWithStorageRegistry interface to use in tests with the
 // additional storage provider.
 func (s *DomainServicesSuite) DomainServicesGetterWithStorageRegistry(c *tc.C, objectStore objectstore.ObjectStore, leaseManager lease.LeaseManager, storageRegistry storage.ProviderRegistry) DomainServicesGetterFunc {
+	secretKeyStore := s.SecretKeyStore(c)
 	return func(modelUUID model.UUID) services.DomainServices {
 		clock := clock.WallClock
 		logger := loggertesting.WrapCheckLog(c)
@@ -280,6 +282,7 @@ func (s *DomainServicesSuite) DomainServicesGetterWithStorageRegistry(c *tc.C, o
 			modelObjectStoreGetter(func(ctx context.Context) (objectstore.ObjectStore, error) {
 				return objectStore, nil
 			}),
+			secretKeyStore,
 			clock,
 			logger,
 			loggertesting.WrapCheckLogForContextGetter(c),
@@ -307,6 +310,7 @@ func (s *DomainServicesSuite) DomainServicesGetterWithStorageRegistry(c *tc.C, o
 			stubClusterDescriber{},
 			&http.Client{},
 			c.MkDir(),
+			secretKeyStore,
 			clock,
 			logger,
 		)
@@ -317,6 +321,17 @@ func (s *DomainServicesSuite) DomainServicesGetterWithStorageRegistry(c *tc.C, o
 	}
 }
 
+// SecretKeyStore returns a key store holding a single secret key-encryption
+// key, as created when a controller is bootstrapped.
+func (s *DomainServicesSuite) SecretKeyStore(c *tc.C) envelope.KeyStore {
+	keyStore := envelope.NewFileKeyStore(envelope.KeyStorePath(c.MkDir()))
+	kek, err := envelope.NewKeyEncryptionKey()
+	c.Assert(err, tc.ErrorIsNil)
+	err = keyStore.AddKeyEncryptionKeys(kek)
+	c.Assert(err, tc.ErrorIsNil)
+	return keyStore
+}
+
 // ObjectStoreServicesGetter provides an implementation of the
 // ObjectStoreServicesGetter interface to use in tests.
 func (s *DomainServicesSuite) ObjectStoreServicesGetter(c *tc.C) ObjectStoreServicesGetterFunc {
@@ -10,6 +10,10 @@ const (
 	// valid.
 	InvalidStatus = errors.ConstError("invalid status")
 
+	// StatusFilterNotValid describes an error that occurs when the selectors
+	// or status values used to filter the status of a model are not valid.
+	StatusFilterNotValid = errors.ConstError("status filter not valid")
+
 	// RelationNotFound describes an error that occurs when the relation
 	// being operated on does not exist.
 	RelationNotFound = errors.ConstError("relation not found")
@@ -0,0 +1,461 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/juju/collections/set"
+
+	coremachine "github.com/juju/juju/core/machine"
+	corerelation "github.com/juju/juju/core/relation"
+	corestatus "github.com/juju/juju/core/status"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/status"
+	statuserrors "github.com/juju/juju/domain/status/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+type selectorKind int
+
+const (
+	// nameSelector matches the names of applications, units and machines,
+	// and the names of charms.
+	nameSelector selectorKind = iota
+
+	// statusSelector matches the status of applications, units and
+	// machines.
+	statusSelector
+
+	// relationSelector matches relation endpoints, of the form
+	// <application>:<endpoint>.
+	relationSelector
+)
+
+type selector struct {
+	kind selectorKind
+
+	// pattern is the glob for name selectors, the status value for status
+	// selectors, and the application glob for relation selectors.
+	pattern string
+
+	// endpoint is the endpoint glob of relation selectors.
+	endpoint string
+}
+
+// StatusFilter selects the entities reported in the status of a model. The
+// zero value selects everything.
+type StatusFilter struct {
+	selectors []selector
+	statuses  set.Strings
+}
+
+// ParseStatusFilter parses the selectors and status values used to filter
+// the status of a model. An entity is selected if it matches any of the
+// selectors, and, when statuses are given, is in one of the statuses.
+//
+// A selector is one of:
+//   - the name of an application, unit or machine, which may contain the
+//     wildcards supported by [path.Match], such as mysql, mysql/* or 0/lxd/*.
+//     A selector of this form also matches the applications deployed from a
+//     charm of that name, and <application>/leader selects the leader unit of
+//     the application;
+//   - a status value, such as error or blocked, which selects the
+//     applications, units and machines in that status;
+//   - a relation endpoint of the form <application>:<endpoint>, where both
+//     parts may contain wildcards, which selects the applications of the
+//     relations using that endpoint.
+//
+// The following errors may be returned:
+//   - [statuserrors.StatusFilterNotValid] if a selector or status is not
+//     valid.
+func ParseStatusFilter(patterns, statuses []string) (StatusFilter, error) {
+	var filter StatusFilter
+	for _, pattern := range patterns {
+		sel, err := parseSelector(pattern)
+		if err != nil {
+			return StatusFilter{}, err
+		}
+		filter.selectors = append(filter.selectors, sel)
+	}
+	for _, value := range statuses {
+		if !isKnownStatus(corestatus.Status(value)) {
+			return StatusFilter{}, errors.Errorf("unknown status %q: %w", value, statuserrors.StatusFilterNotValid)
+		}
+		if filter.statuses == nil {
+			filter.statuses = set.NewStrings()
+		}
+		filter.statuses.Add(value)
+	}
+	return filter, nil
+}
+
+func parseSelector(pattern string) (selector, error) {
+	if appName, endpoint, ok := strings.Cut(pattern, ":"); ok {
+		if appName == "" || endpoint == "" || !validGlob(appName) || !validGlob(endpoint) {
+			return selector{}, errors.Errorf(
+				"invalid relation selector %q, expected <application>:<endpoint>: %w",
+				pattern, statuserrors.StatusFilterNotValid)
+		}
+		return selector{kind: relationSelector, pattern: appName, endpoint: endpoint}, nil
+	}
+	if isKnownStatus(corestatus.Status(pattern)) {
+		return selector{kind: statusSelector, pattern: pattern}, nil
+	}
+	if pattern == "" || !validGlob(pattern) {
+		return selector{}, errors.Errorf("invalid selector %q: %w", pattern, statuserrors.StatusFilterNotValid)
+	}
+	return selector{kind: nameSelector, pattern: pattern}, nil
+}
+
+func isKnownStatus(s corestatus.Status) bool {
+	return s.KnownWorkloadStatus() || s.KnownAgentStatus() ||
+		s.KnownMachineStatus() || s.KnownInstanceStatus()
+}
+
+func validGlob(pattern string) bool {
+	_, err := path.Match(pattern, "")
+	return err == nil
+}
+
+func matchGlob(pattern, name string) bool {
+	// The pattern is validated when the filter is parsed.
+	ok, _ := path.Match(pattern, name)
+	return ok
+}
+
+// IsEmpty returns true if the filter selects everything.
+func (f StatusFilter) IsEmpty() bool {
+	return len(f.selectors) == 0 && f.statuses.IsEmpty()
+}
+
+// applicationSelectors returns the selectors to use to read only the
+// applications the filter can match from the database. It returns false if
+// the filter can match any application, because it has no selectors, or has
+// selectors which can match machines or status values.
+func (f StatusFilter) applicationSelectors() ([]status.ApplicationSelector, bool) {
+	if len(f.selectors) == 0 {
+		return nil, false
+	}
+	selectors := make([]status.ApplicationSelector, 0, len(f.selectors))
+	for _, sel := range f.selectors {
+		switch sel.kind {
+		case relationSelector:
+			if strings.ContainsRune(sel.pattern+sel.endpoint, '\\') {
+				return nil, false
+			}
+			selectors = append(selectors, status.ApplicationSelector{
+				Name:     sel.pattern,
+				Endpoint: sel.endpoint,
+			})
+		case nameSelector:
+			// Application names start with a letter, so a selector which
+			// doesn't may match machines. Escapes aren't supported by
+			// SQLite GLOB.
+			first := []rune(sel.pattern)[0]
+			if !unicode.IsLetter(first) || strings.ContainsRune(sel.pattern, '\\') {
+				return nil, false
+			}
+			appName, _, _ := strings.Cut(sel.pattern, "/")
+			selectors = append(selectors, status.ApplicationSelector{
+				Name: appName,
+			})
+		default:
+			return nil, false
+		}
+	}
+	return selectors, true
+}
+
+// StatusMatchResult records the entities that should be retained after
+// applying a status filter.
+type StatusMatchResult struct {
+	Applications map[string]struct{}
+	Units        map[coreunit.Name]struct{}
+	Machines     map[coremachine.Name]struct{}
+}
+
+// MatchStatus applies the filter to the supplied status snapshot, and expands
+// the result enough to keep status output coherent: the applications and
+// machines of selected units, and the principals and subordinates of selected
+// units, are retained.
+//
+// Selectors of the form "appname/leader" are resolved to the current leader
+// unit name using leaders before matching. Selectors whose application has no
+// known leader will not match any entity.
+func MatchStatus(
+	filter StatusFilter,
+	applications map[string]Application,
+	units map[coreunit.Name]Unit,
+	machines map[coremachine.Name]Machine,
+	leaders map[string]string,
+	relations []corerelation.Key,
+) StatusMatchResult {
+	result := newStatusMatchResult()
+	directMachines := make(map[coremachine.Name]struct{})
+	if len(filter.selectors) == 0 {
+		for appName, app := range applications {
+			result.addApplication(appName)
+			for unitName := range app.Units {
+				result.addUnit(unitName)
+			}
+		}
+		for unitName := range units {
+			result.addUnit(unitName)
+		}
+		for machineName := range machines {
+			result.addMachine(machineName)
+		}
+		if filter.statuses.IsEmpty() {
+			return result
+		}
+	}
+
+	for _, sel := range filter.selectors {
+		switch sel.kind {
+		case nameSelector:
+			pattern := sel.pattern
+			if appName, ok := strings.CutSuffix(pattern, "/leader"); ok {
+				// Resolve "appname/leader" selectors to the actual leader
+				// unit name. Selectors whose application has no known
+				// leader are left unchanged and will not match any entity.
+				if leaderUnit, ok := leaders[appName]; ok {
+					pattern = leaderUnit
+				}
+			}
+			for appName, app := range applications {
+				if matchGlob(pattern, appName) || matchGlob(pattern, app.CharmLocator.Name) {
+					result.addApplicationAndUnits(appName, app)
+				}
+			}
+			for unitName := range units {
+				if matchGlob(pattern, unitName.String()) {
+					result.addUnit(unitName)
+				}
+			}
+			for machineName := range machines {
+				if matchGlob(pattern, machineName.String()) {
+					directMachines[machineName] = struct{}{}
+				}
+			}
+
+		case statusSelector:
+			statuses := set.NewStrings(sel.pattern)
+			for appName, app := range applications {
+				if statuses.Contains(app.Status.Status.String()) {
+					result.addApplication(appName)
+				}
+			}
+			for unitName, unit := range units {
+				if unitHasStatus(unit, statuses) {
+					result.addUnit(unitName)
+				}
+			}
+			for machineName, machine := range machines {
+				if machineHasStatus(machine, statuses) {
+					directMachines[machineName] = struct{}{}
+				}
+			}
+
+		case relationSelector:
+			for _, key := range relations {
+				if !relationMatches(key, sel) {
+					continue
+				}
+				for _, ep := range key {
+					if app, ok := applications[ep.ApplicationName]; ok {
+						result.addApplicationAndUnits(ep.ApplicationName, app)
+					}
+				}
+			}
+		}
+	}
+
+	for machineName := range directMachines {
+		result.addMachine(machineName)
+		if machineName.IsContainer() {
+			result.addMachine(machineName.Parent())
+			continue
+		}
+		for candidate := range machines {
+			if candidate == machineName || candidate.Parent() == machineName {
+				result.addMachine(candidate)
+			}
+		}
+	}
+
+	for unitName := range units {
+		machineName, ok := machineNameForUnit(unitName, units)
+		if ok && result.hasMachine(machineName) {
+			result.addUnit(unitName)
+		}
+	}
+
+	if !filter.statuses.IsEmpty() {
+		result.retainStatuses(filter.statuses, applications, units, machines)
+	}
+
+	result.expandUnitClosure(units)
+	for unitName := range result.Units {
+		machineName, ok := machineNameForUnit(unitName, units)
+		if !ok {
+			continue
+		}
+		result.addMachine(machineName)
+		if machineName.IsContainer() {
+			result.addMachine(machineName.Parent())
+		}
+	}
+
+	return result
+}
+
+func relationMatches(key corerelation.Key, sel selector) bool {
+	for _, ep := range key {
+		if matchGlob(sel.pattern, ep.ApplicationName) && matchGlob(sel.endpoint, ep.EndpointName) {
+			return true
+		}
+	}
+	return false
+}
+
+func unitHasStatus(unit Unit, statuses set.Strings) bool {
+	return statuses.Contains(unit.WorkloadStatus.Status.String()) ||
+		statuses.Contains(unit.AgentStatus.Status.String()) ||
+		statuses.Contains(unit.K8sPodStatus.Status.String())
+}
+
+func machineHasStatus(machine Machine, statuses set.Strings) bool {
+	return statuses.Contains(machine.MachineStatus.Status.String()) ||
+		statuses.Contains(machine.InstanceStatus.Status.String())
+}
+
+// retainStatuses removes the selected entities which are not in one of the
+// statuses. Applications and machines are retained again afterwards if they
+// have retained units.
+func (r StatusMatchResult) retainStatuses(
+	statuses set.Strings,
+	applications map[string]Application,
+	units map[coreunit.Name]Unit,
+	machines map[coremachine.Name]Machine,
+) {
+	for unitName := range r.Units {
+		if !unitHasStatus(units[unitName], statuses) {
+			delete(r.Units, unitName)
+		}
+	}
+	for machineName := range r.Machines {
+		if !machineHasStatus(machines[machineName], statuses) {
+			delete(r.Machines, machineName)
+		}
+	}
+	for appName := range r.Applications {
+		if !statuses.Contains(applications[appName].Status.Status.String()) {
+			delete(r.Applications, appName)
+		}
+	}
+}
+
+func newStatusMatchResult() StatusMatchResult {
+	return StatusMatchResult{
+		Applications: make(map[string]struct{}),
+		Units:        make(map[coreunit.Name]struct{}),
+		Machines:     make(map[coremachine.Name]struct{}),
+	}
+}
+
+func (r StatusMatchResult) addApplication(name string) bool {
+	if _, ok := r.Applications[name]; ok {
+		return false
+	}
+	r.Applications[name] = struct{}{}
+	return true
+}
+
+func (r StatusMatchResult) addApplicationAndUnits(name string, app Application) {
+	r.addApplication(name)
+	for unitName := range app.Units {
+		r.addUnit(unitName)
+	}
+}
+
+func (r StatusMatchResult) addUnit(name coreunit.Name) bool {
+	if _, ok := r.Units[name]; ok {
+		return false
+	}
+	r.Units[name] = struct{}{}
+	return true
+}
+
+func (r StatusMatchResult) addMachine(name coremachine.Name) bool {
+	if _, ok := r.Machines[name]; ok {
+		return false
+	}
+	r.Machines[name] = struct{}{}
+	return true
+}
+
+func (r StatusMatchResult) hasMachine(name coremachine.Name) bool {
+	_, ok := r.Machines[name]
+	return ok
+}
+
+func (r StatusMatchResult) expandUnitClosure(units map[coreunit.Name]Unit) {
+	changed := true
+	for changed {
+		changed = false
+		for unitName := range r.Units {
+			unit, ok := units[unitName]
+			if !ok {
+				continue
+			}
+			if r.addApplication(unit.ApplicationName) {
+				changed = true
+			}
+			if unit.Subordinate {
+				if unit.PrincipalName != nil && r.addUnit(*unit.PrincipalName) {
+					changed = true
+				}
+				continue
+			}
+			for _, subordinateName := range unit.SubordinateNames {
+				if _, ok := units[subordinateName]; !ok {
+					continue
+				}
+				if r.addUnit(subordinateName) {
+					changed = true
+				}
+			}
+		}
+	}
+}
+
+func machineNameForUnit(unitName coreunit.Name, units map[coreunit.Name]Unit) (coremachine.Name, bool) {
+	return machineNameForUnitWithVisited(unitName, units, make(map[coreunit.Name]struct{}))
+}
+
+func machineNameForUnitWithVisited(
+	unitName coreunit.Name,
+	units map[coreunit.Name]Unit,
+	visited map[coreunit.Name]struct{},
+) (coremachine.Name, bool) {
+	if _, ok := visited[unitName]; ok {
+		return "", false
+	}
+	visited[unitName] = struct{}{}
+
+	unit, ok := units[unitName]
+	if !ok {
+		return "", false
+	}
+	if unit.MachineName != nil {
+		return *unit.MachineName, true
+	}
+	if unit.PrincipalName == nil {
+		return "", false
+	}
+	return machineNameForUnitWithVisited(*unit.PrincipalName, units, visited)
+}
@@ -0,0 +1,445 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/juju/tc"
+
+	coremachine "github.com/juju/juju/core/machine"
+	corerelation "github.com/juju/juju/core/relation"
+	corestatus "github.com/juju/juju/core/status"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application/charm"
+	"github.com/juju/juju/domain/status"
+	statuserrors "github.com/juju/juju/domain/status/errors"
+)
+
+type filterSuite struct{}
+
+func TestFilterSuite(t *testing.T) {
+	tc.Run(t, &filterSuite{})
+}
+
+func (s *filterSuite) TestMatchStatusApplicationIncludesUnitsAndMachines(c *tc.C) {
+	units := map[coreunit.Name]Unit{
+		"mysql/0": {
+			ApplicationName: "mysql",
+			MachineName:     new(coremachine.Name("1")),
+		},
+		"wordpress/0": {
+			ApplicationName: "wordpress",
+			MachineName:     new(coremachine.Name("2")),
+		},
+	}
+	applications := map[string]Application{
+		"mysql": {
+			Units: map[coreunit.Name]Unit{
+				"mysql/0": units["mysql/0"],
+			},
+		},
+		"wordpress": {
+			Units: map[coreunit.Name]Unit{
+				"wordpress/0": units["wordpress/0"],
+			},
+		},
+	}
+	machines := map[coremachine.Name]Machine{
+		"1": {Name: "1"},
+		"2": {Name: "2"},
+	}
+
+	result := MatchStatus(s.filter(c, []string{"mysql"}), applications, units, machines, nil, nil)
+
+	c.Check(sortedAppNames(result), tc.DeepEquals, []string{"mysql"})
+	c.Check(sortedUnitNames(result), tc.DeepEquals, []string{"mysql/0"})
+	c.Check(sortedMachineNames(result), tc.DeepEquals, []string{"1"})
+}
+
+func (s *filterSuite) TestMatchStatusPrincipalUnitIncludesSubordinate(c *tc.C) {
+	units := map[coreunit.Name]Unit{
+		"mysql/0": {
+			ApplicationName:  "mysql",
+			MachineName:      new(coremachine.Name("1")),
+			SubordinateNames: []coreunit.Name{"logging/0"},
+		},
+		"logging/0": {
+			ApplicationName: "logging",
+			Subordinate:     true,
+			PrincipalName:   new(coreunit.Name("mysql/0")),
+		},
+	}
+	applications := map[string]Application{
+		"mysql": {
+			Units: map[coreunit.Name]Unit{
+				"mysql/0": units["mysql/0"],
+			},
+		},
+		"logging": {
+			Units: map[coreunit.Name]Unit{
+				"logging/0": units["logging/0"],
+			},
+		},
+	}
+	machines := map[coremachine.Name]Machine{
+		"1": {Name: "1"},
+	}
+
+	result := MatchStatus(s.filter(c, []string{"mysql/0"}), applications, units, machines, nil, nil)
+
+	c.Check(sortedAppNames(result), tc.DeepEquals, []string{"logging", "mysql"})
+	c.Check(sortedUnitNames(result), tc.DeepEquals, []string{"logging/0", "mysql/0"})
+	c.Check(sortedMachineNames(result), tc.DeepEquals, []string{"1"})
+}
+
+func (s *filterSuite) TestMatchStatusSubordinateIncludesPrincipal(c *tc.C) {
+	units := map[coreunit.Name]Unit{
+		"mysql/0": {
+			ApplicationName:  "mysql",
+			MachineName:      new(coremachine.Name("1")),
+			SubordinateNames: []coreunit.Name{"logging/0"},
+		},
+		"logging/0": {
+			ApplicationName: "logging",
+			Subordinate:     true,
+			PrincipalName:   new(coreunit.Name("mysql/0")),
+		},
+	}
+	applications := map[string]Application{
+		"mysql": {
+			Units: map[coreunit.Name]Unit{
+				"mysql/0": units["mysql/0"],
+			},
+		},
+		"logging": {
+			Units: map[coreunit.Name]Unit{
+				"logging/0": units["logging/0"],
+			},
+		},
+	}
+	machines := map[coremachine.Name]Machine{
+		"1": {Name: "1"},
+	}
+
+	result := MatchStatus(s.filter(c, []string{"logging/0"}), applications, units, machines, nil, nil)
+
+	c.Check(sortedAppNames(result), tc.DeepEquals, []string{"logging", "mysql"})
+	c.Check(sortedUnitNames(result), tc.DeepEquals, []string{"logging/0", "mysql/0"})
+	c.Check(sortedMachineNames(result), tc.DeepEquals, []string{"1"})
+}
+
+func (s *filterSuite) TestMatchStatusMachineIncludesHostedUnitsAndContainers(c *tc.C) {
+	units := map[coreunit.Name]Unit{
+		"mysql/0": {
+			ApplicationName: "mysql",
+			MachineName:     new(coremachine.Name("0")),
+		},
+		"wordpress/0": {
+			ApplicationName: "wordpress",
+			MachineName:     new(coremachine.Name("0/lxd/0")),
+		},
+	}
+	applications := map[string]Application{
+		"mysql": {
+			Units: map[coreunit.Name]Unit{
+				"mysql/0": units["mysql/0"],
+			},
+		},
+		"wordpress": {
+			Units: map[coreunit.Name]Unit{
+				"wordpress/0": units["wordpress/0"],
+			},
+		},
+	}
+	machines := map[coremachine.Name]Machine{
+		"0":       {Name: "0"},
+		"0/lxd/0": {Name: "0/lxd/0"},
+		"1":       {Name: "1"},
+	}
+
+	result := MatchStatus(s.filter(c, []string{"0"}), applications, units, machines, nil, nil)
+
+	c.Check(sortedAppNames(result), tc.DeepEquals, []string{"mysql", "wordpress"})
+	c.Check(sortedUnitNames(result), tc.DeepEquals, []string{"mysql/0", "wordpress/0"})
+	c.Check(sortedMachineNames(result), tc.DeepEquals, []string{"0", "0/lxd/0"})
+}
+
+func (s *filterSuite) TestMatchStatusLeaderPatternResolvesToLeaderUnit(c *tc.C) {
+	units := map[coreunit.Name]Unit{
+		"mysql/0": {
+			ApplicationName: "mysql",
+			MachineName:     new(coremachine.Name("1")),
+		},
+		"mysql/1": {
+			ApplicationName: "mysql",
+			MachineName:     new(coremachine.Name("2")),
+		},
+	}
+	applications := map[string]Application{
+		"mysql": {
+			Units: map[coreunit.Name]Unit{
+				"mysql/0": units["mysql/0"],
+				"mysql/1": units["mysql/1"],
+			},
+		},
+	}
+	machines := map[coremachine.Name]Machine{
+		"1": {Name: "1"},
+		"2": {Name: "2"},
+	}
+	leaders := map[string]string{"mysql": "mysql/1"}
+
+	result := MatchStatus(s.filter(c, []string{"mysql/leader"}), applications, units, machines, leaders, nil)
+	c.Check(sortedAppNames(result), tc.DeepEquals, []string{"mysql"})
+	c.Check(sortedUnitNames(result), tc.DeepEquals, []string{"mysql/1"})
+	c.Check(sortedMachineNames(result), tc.DeepEquals, []string{"2"})
+}
+
+func (s *filterSuite) TestMatchStatusLeaderPatternUnresolvedMatchesNothing(c *tc.C) {
+	units := map[coreunit.Name]Unit{
+		"mysql/0": {
+			ApplicationName: "mysql",
+			MachineName:     new(coremachine.Name("1")),
+		},
+	}
+	applications := map[string]Application{
+		"mysql": {
+			Units: map[coreunit.Name]Unit{
+				"mysql/0": units["mysql/0"],
+			},
+		},
+	}
+	machines := map[coremachine.Name]Machine{
+		"1": {Name: "1"},
+	}
+
+	// No leader known — pattern is left unchanged and matches nothing.
+	result := MatchStatus(s.filter(c, []string{"mysql/leader"}), applications, units, machines, nil, nil)
+	c.Check(sortedAppNames(result), tc.HasLen, 0)
+	c.Check(sortedUnitNames(result), tc.HasLen, 0)
+	c.Check(sortedMachineNames(result), tc.HasLen, 0)
+}
+
+func (s *filterSuite) TestMatchStatusLeaderPatternMixedWithOtherPatterns(c *tc.C) {
+	units := map[coreunit.Name]Unit{
+		"mysql/0": {
+			ApplicationName: "mysql",
+			MachineName:     new(coremachine.Name("1")),
+		},
+		"mysql/1": {
+			ApplicationName: "mysql",
+			MachineName:     new(coremachine.Name("2")),
+		},
+		"wordpress/0": {
+			ApplicationName: "wordpress",
+			MachineName:     new(coremachine.Name("3")),
+		},
+	}
+	applications := map[string]Application{
+		"mysql": {
+			Units: map[coreunit.Name]Unit{
+				"mysql/0": units["mysql/0"],
+				"mysql/1": units["mysql/1"],
+			},
+		},
+		"wordpress": {
+			Units: map[coreunit.Name]Unit{
+				"wordpress/0": units["wordpress/0"],
+			},
+		},
+	}
+	machines := map[coremachine.Name]Machine{
+		"1": {Name: "1"},
+		"2": {Name: "2"},
+		"3": {Name: "3"},
+	}
+	leaders := map[string]string{"mysql": "mysql/1"}
+
+	// mysql/leader resolves to mysql/1; wordpress/leader has no leader and is
+	// dropped; mysql/0 is a direct unit pattern.
+	result := MatchStatus(
+		s.filter(c, []string{"mysql/leader", "wordpress/leader", "mysql/0"}),
+		applications, units, machines, leaders, nil,
+	)
+	c.Check(sortedAppNames(result), tc.DeepEquals, []string{"mysql"})
+	c.Check(sortedUnitNames(result), tc.DeepEquals, []string{"mysql/0", "mysql/1"})
+	c.Check(sortedMachineNames(result), tc.DeepEquals, []string{"1", "2"})
+}
+
+func (s *filterSuite) TestMatchStatusGlobs(c *tc.C) {
+	applications, units, machines := s.snapshot()
+
+	result := MatchStatus(s.filter(c, []string{"mysql/*"}), applications, units, machines, nil, nil)
+	c.Check(sortedAppNames(result), tc.DeepEquals, []string{"mysql"})
+	c.Check(sortedUnitNames(result), tc.DeepEquals, []string{"mysql/0", "mysql/1"})
+	c.Check(sortedMachineNames(result), tc.DeepEquals, []string{"0", "1"})
+
+	result = MatchStatus(s.filter(c, []string{"word*"}), applications, units, machines, nil, nil)
+	c.Check(sortedAppNames(result), tc.DeepEquals, []string{"wordpress"})
+	c.Check(sortedUnitNames(result), tc.DeepEquals, []string{"wordpress/0"})
+}
+
+func (s *filterSuite) TestMatchStatusCharmName(c *tc.C) {
+	applications, units, machines := s.snapshot()
+
+	result := MatchStatus(s.filter(c, []string{"percona"}), applications, units, machines, nil, nil)
+	c.Check(sortedAppNames(result), tc.DeepEquals, []string{"mysql"})
+	c.Check(sortedUnitNames(result), tc.DeepEquals, []string{"mysql/0", "mysql/1"})
+}
+
+func (s *filterSuite) TestMatchStatusStatusSelector(c *tc.C) {
+	applications, units, machines := s.snapshot()
+
+	result := MatchStatus(s.filter(c, []string{"error"}), applications, units, machines, nil, nil)
+	c.Check(sortedAppNames(result), tc.DeepEquals, []string{"mysql"})
+	c.Check(sortedUnitNames(result), tc.DeepEquals, []string{"mysql/1"})
+	c.Check(sortedMachineNames(result), tc.DeepEquals, []string{"1"})
+}
+
+func (s *filterSuite) TestMatchStatusRelationSelector(c *tc.C) {
+	applications, units, machines := s.snapshot()
+	applications["haproxy"] = Application{}
+	relations := []corerelation.Key{{
+		{ApplicationName: "wordpress", EndpointName: "db"},
+		{ApplicationName: "mysql", EndpointName: "server"},
+	}, {
+		{ApplicationName: "wordpress", EndpointName: "website"},
+		{ApplicationName: "haproxy", EndpointName: "reverseproxy"},
+	}}
+
+	result := MatchStatus(s.filter(c, []string{"mysql:ser*"}), applications, units, machines, nil, relations)
+	c.Check(sortedAppNames(result), tc.DeepEquals, []string{"mysql", "wordpress"})
+	c.Check(sortedUnitNames(result), tc.DeepEquals, []string{"mysql/0", "mysql/1", "wordpress/0"})
+}
+
+func (s *filterSuite) TestMatchStatusStatuses(c *tc.C) {
+	applications, units, machines := s.snapshot()
+
+	result := MatchStatus(s.filterStatuses(c, []string{"mysql/*"}, []string{"error"}),
+		applications, units, machines, nil, nil)
+	c.Check(sortedAppNames(result), tc.DeepEquals, []string{"mysql"})
+	c.Check(sortedUnitNames(result), tc.DeepEquals, []string{"mysql/1"})
+	c.Check(sortedMachineNames(result), tc.DeepEquals, []string{"1"})
+
+	result = MatchStatus(s.filterStatuses(c, nil, []string{"error", "down"}),
+		applications, units, machines, nil, nil)
+	c.Check(sortedAppNames(result), tc.DeepEquals, []string{"mysql"})
+	c.Check(sortedUnitNames(result), tc.DeepEquals, []string{"mysql/1"})
+	c.Check(sortedMachineNames(result), tc.DeepEquals, []string{"1", "2"})
+}
+
+func (s *filterSuite) TestParseStatusFilterNotValid(c *tc.C) {
+	for _, test := range []struct {
+		patterns []string
+		statuses []string
+	}{
+		{patterns: []string{"mysql:"}},
+		{patterns: []string{":db"}},
+		{patterns: []string{"mysql/["}},
+		{statuses: []string{"broken-ish"}},
+	} {
+		_, err := ParseStatusFilter(test.patterns, test.statuses)
+		c.Check(err, tc.ErrorIs, statuserrors.StatusFilterNotValid)
+	}
+}
+
+func (s *filterSuite) TestApplicationSelectors(c *tc.C) {
+	selectors, ok := s.filter(c, []string{"mysql/*", "word*", "mysql:db", "mysql/leader"}).applicationSelectors()
+	c.Assert(ok, tc.IsTrue)
+	c.Check(selectors, tc.DeepEquals, []status.ApplicationSelector{
+		{Name: "mysql"},
+		{Name: "word*"},
+		{Name: "mysql", Endpoint: "db"},
+		{Name: "mysql"},
+	})
+
+	for _, patterns := range [][]string{nil, {"0"}, {"*"}, {"mysql", "error"}} {
+		_, ok := s.filter(c, patterns).applicationSelectors()
+		c.Check(ok, tc.IsFalse, tc.Commentf("%v", patterns))
+	}
+}
+
+// snapshot returns a model with mysql/0 on machine 0, mysql/1 in error on
+// machine 1, wordpress/0 on machine 2 which is down.
+func (s *filterSuite) snapshot() (map[string]Application, map[coreunit.Name]Unit, map[coremachine.Name]Machine) {
+	units := map[coreunit.Name]Unit{
+		"mysql/0": {
+			ApplicationName: "mysql",
+			MachineName:     new(coremachine.Name("0")),
+			WorkloadStatus:  corestatus.StatusInfo{Status: corestatus.Active},
+		},
+		"mysql/1": {
+			ApplicationName: "mysql",
+			MachineName:     new(coremachine.Name("1")),
+			WorkloadStatus:  corestatus.StatusInfo{Status: corestatus.Error},
+		},
+		"wordpress/0": {
+			ApplicationName: "wordpress",
+			MachineName:     new(coremachine.Name("2")),
+			WorkloadStatus:  corestatus.StatusInfo{Status: corestatus.Active},
+		},
+	}
+	applications := map[string]Application{
+		"mysql": {
+			CharmLocator: charm.CharmLocator{Name: "percona"},
+			Status:       corestatus.StatusInfo{Status: corestatus.Active},
+			Units: map[coreunit.Name]Unit{
+				"mysql/0": units["mysql/0"],
+				"mysql/1": units["mysql/1"],
+			},
+		},
+		"wordpress": {
+			CharmLocator: charm.CharmLocator{Name: "wordpress"},
+			Status:       corestatus.StatusInfo{Status: corestatus.Active},
+			Units: map[coreunit.Name]Unit{
+				"wordpress/0": units["wordpress/0"],
+			},
+		},
+	}
+	machines := map[coremachine.Name]Machine{
+		"0": {Name: "0", MachineStatus: corestatus.StatusInfo{Status: corestatus.Started}},
+		"1": {Name: "1", MachineStatus: corestatus.StatusInfo{Status: corestatus.Started}},
+		"2": {Name: "2", MachineStatus: corestatus.StatusInfo{Status: corestatus.Down}},
+	}
+	return applications, units, machines
+}
+
+func (s *filterSuite) filter(c *tc.C, patterns []string) StatusFilter {
+	return s.filterStatuses(c, patterns, nil)
+}
+
+func (s *filterSuite) filterStatuses(c *tc.C, patterns, statuses []string) StatusFilter {
+	filter, err := ParseStatusFilter(patterns, statuses)
+	c.Assert(err, tc.ErrorIsNil)
+	return filter
+}
+
+func sortedAppNames(result StatusMatchResult) []string {
+	out := make([]string, 0, len(result.Applications))
+	for name := range result.Applications {
+		out = append(out, name)
+	}
+	slices.Sort(out)
+	return out
+}
+
+func sortedUnitNames(result StatusMatchResult) []string {
+	out := make([]string, 0, len(result.Units))
+	for name := range result.Units {
+		out = append(out, name.String())
+	}
+	slices.Sort(out)
+	return out
+}
+
+func sortedMachineNames(result StatusMatchResult) []string {
+	out := make([]string, 0, len(result.Machines))
+	for name := range result.Machines {
+		out = append(out, name.String())
+	}
+	slices.Sort(out)
+	return out
+}
@@ -32,65 +32,68 @@ type MockModelState struct {
 
 // MockModelStateMockRecorder is the mock recorder for MockModelState.
 type MockModelStateMockRecorder struct {
-	mock                                         *MockModelState
-	deleteMachinePresenceExpects                 []*gomock.Call2_1[context.Context, machine.Name, error]
-	deleteUnitPresenceExpects                    []*gomock.Call2_1[context.Context, unit.Name, error]
-	getAllApplicationStatusesExpects             []*gomock.Call1_2[context.Context, map[string]status.StatusInfo[status.WorkloadStatusType], error]
-	getAllAttachedBlockDeviceLinksExpects        []*gomock.Call1_2[context.Context, map[blockdevice.BlockDeviceUUID][]string, error]
-	getAllFilesystemAttachmentsExpects           []*gomock.Call1_2[context.Context, []status.FilesystemAttachment, error]
-	getAllFilesystemsExpects                     []*gomock.Call1_2[context.Context, []status.Filesystem, error]
-	getAllFullUnitStatusesForApplicationExpects  []*gomock.Call2_2[context.Context, application.UUID, status.FullUnitStatuses, error]
-	getAllInstanceStatusesExpects                []*gomock.Call1_2[context.Context, map[string]status.StatusInfo[status.InstanceStatusType], error]
-	getAllMachineStatusesExpects                 []*gomock.Call1_2[context.Context, map[string]status.MachineStatusInfo[status.MachineStatusType], error]
-	getAllRelationStatusesExpects                []*gomock.Call1_2[context.Context, []status.RelationStatusInfo, error]
-	getAllStorageInstanceAttachmentsExpects      []*gomock.Call1_2[context.Context, []status.StorageAttachment, error]
-	getAllStorageInstancesExpects                []*gomock.Call1_2[context.Context, []status.StorageInstance, error]
-	getAllUnitWorkloadAgentStatusesExpects       []*gomock.Call1_2[context.Context, status.UnitWorkloadAgentStatuses, error]
-	getAllVolumeAttachmentsExpects               []*gomock.Call1_2[context.Context, []status.VolumeAttachment, error]
-	getAllVolumesExpects                         []*gomock.Call1_2[context.Context, []status.Volume, error]
-	getApplicationAndUnitModelStatusesExpects    []*gomock.Call1_2[context.Context, map[string]int, error]
-	getApplicationAndUnitStatusesExpects         []*gomock.Call1_2[context.Context, map[string]status.Application, error]
-	getApplicationStatusExpects                  []*gomock.Call2_2[context.Context, application.UUID, status.StatusInfo[status.WorkloadStatusType], error]
-	getApplicationUUIDAndNameByUnitNameExpects   []*gomock.Call2_3[context.Context, unit.Name, application.UUID, string, error]
-	getApplicationUUIDByNameExpects              []*gomock.Call2_2[context.Context, string, application.UUID, error]
-	getApplicationUUIDForOfferExpects            []*gomock.Call2_2[context.Context, string, string, error]
-	getFilesystemAttachmentsExpects              []*gomock.Call2_2[context.Context, []storage.FilesystemUUID, []status.FilesystemAttachment, error]
-	getFilesystemUUIDByIDExpects                 []*gomock.Call2_2[context.Context, string, storage.FilesystemUUID, error]
-	getFilesystemsExpects                        []*gomock.Call2_2[context.Context, []storage.FilesystemUUID, []status.Filesystem, error]
-	getInstanceStatusExpects                     []*gomock.Call2_2[context.Context, string, status.StatusInfo[status.InstanceStatusType], error]
-	getMachineFullStatusesExpects                []*gomock.Call1_2[context.Context, map[machine.Name]status.Machine, error]
-	getMachineStatusExpects                      []*gomock.Call2_2[context.Context, string, status.MachineStatusInfo[status.MachineStatusType], error]
-	getModelStatusInfoExpects                    []*gomock.Call1_2[context.Context, status.ModelStatusInfo, error]
-	getRelationUUIDByIDExpects                   []*gomock.Call2_2[context.Context, int, relation.UUID, error]
-	getRemoteApplicationOffererStatusesExpects   []*gomock.Call1_2[context.Context, map[string]status.RemoteApplicationOfferer, error]
-	getRemoteApplicationOffererUUIDByNameExpects []*gomock.Call2_2[context.Context, string, remoteapplication.UUID, error]
-	getStorageInstanceAttachmentsExpects         []*gomock.Call2_2[context.Context, []storage.StorageInstanceUUID, []status.StorageAttachment, error]
-	getStorageInstancesExpects                   []*gomock.Call2_2[context.Context, []storage.StorageInstanceUUID, []status.StorageInstance, error]
-	getUnitAgentStatusExpects                    []*gomock.Call2_2[context.Context, unit.UUID, status.UnitStatusInfo[status.UnitAgentStatusType], error]
-	getUnitAgentStatusesForApplicationExpects    []*gomock.Call2_2[context.Context, application.UUID, status.UnitAgentStatuses, error]
-	getUnitK8sPodStatusExpects                   []*gomock.Call2_2[context.Context, unit.UUID, status.StatusInfo[status.K8sPodStatusType], error]
-	getUnitUUIDByNameExpects                     []*gomock.Call2_2[context.Context, unit.Name, unit.UUID, error]
-	getUnitWorkloadStatusExpects                 []*gomock.Call2_2[context.Context, unit.UUID, status.UnitStatusInfo[status.WorkloadStatusType], error]
-	getUnitWorkloadStatusesForApplicationExpects []*gomock.Call2_2[context.Context, application.UUID, status.UnitWorkloadStatuses, error]
-	getVolumeAttachmentsExpects                  []*gomock.Call2_2[context.Context, []storage.VolumeUUID, []status.VolumeAttachment, error]
-	getVolumeUUIDByIDExpects                     []*gomock.Call2_2[context.Context, string, storage.VolumeUUID, error]
-	getVolumesExpects                            []*gomock.Call2_2[context.Context, []storage.VolumeUUID, []status.Volume, error]
-	importRelationStatusExpects                  []*gomock.Call3_1[context.Context, relation.UUID, status.StatusInfo[status.RelationStatusType], error]
-	isControllerModelExpects                     []*gomock.Call1_2[context.Context, bool, error]
-	namespacesForWatchOfferStatusExpects         []*gomock.Call0_5[string, string, string, string, string]
-	setApplicationStatusExpects                  []*gomock.Call3_1[context.Context, application.UUID, status.StatusInfo[status.WorkloadStatusType], error]
-	setFilesystemStatusExpects                   []*gomock.Call3_1[context.Context, storage.FilesystemUUID, status.StatusInfo[status.StorageFilesystemStatusType], error]
-	setInstanceStatusExpects                     []*gomock.Call3_1[context.Context, string, status.StatusInfo[status.InstanceStatusType], error]
-	setMachinePresenceExpects                    []*gomock.Call2_1[context.Context, machine.Name, error]
-	setMachineStatusExpects                      []*gomock.Call3_1[context.Context, string, status.StatusInfo[status.MachineStatusType], error]
-	setOperatorStatusExpects                     []*gomock.Call3_1[context.Context, application.UUID, status.StatusInfo[status.WorkloadStatusType], error]
-	setRelationStatusExpects                     []*gomock.Call3_1[context.Context, relation.UUID, status.StatusInfo[status.RelationStatusType], error]
-	setRemoteApplicationOffererStatusExpects     []*gomock.Call3_1[context.Context, string, status.StatusInfo[status.WorkloadStatusType], error]
-	setRemoteRelationStatusExpects               []*gomock.Call3_1[context.Context, relation.UUID, status.StatusInfo[status.RelationStatusType], error]
-	setUnitAgentStatusExpects                    []*gomock.Call3_1[context.Context, unit.UUID, status.StatusInfo[status.UnitAgentStatusType], error]
-	setUnitPresenceExpects                       []*gomock.Call2_1[context.Context, unit.Name, error]
-	setUnitWorkloadStatusExpects                 []*gomock.Call3_1[context.Context, unit.UUID, status.StatusInfo[status.WorkloadStatusType], error]
-	setVolumeStatusExpects                       []*gomock.Call3_1[context.Context, storage.VolumeUUID, status.StatusInfo[status.StorageVolumeStatusType], error]
+	mock                                                *MockModelState
+	deleteMachinePresenceExpects                        []*gomock.Call2_1[context.Context, machine.Name, error]
+	deleteUnitPresenceExpects                           []*gomock.Call2_1[context.Context, unit.Name, error]
+	getAllApplicationStatusesExpects                    []*gomock.Call1_2[context.Context, map[string]status.StatusInfo[status.WorkloadStatusType], error]
+	getAllAttachedBlockDeviceLinksExpects               []*gomock.Call1_2[context.Context, map[blockdevice.BlockDeviceUUID][]string, error]
+	getAllFilesystemAttachmentsExpects                  []*gomock.Call1_2[context.Context, []status.FilesystemAttachment, error]
+	getAllFilesystemsExpects                            []*gomock.Call1_2[context.Context, []status.Filesystem, error]
+	getAllFullUnitStatusesForApplicationExpects         []*gomock.Call2_2[context.Context, application.UUID, status.FullUnitStatuses, error]
+	getAllInstanceStatusesExpects                       []*gomock.Call1_2[context.Context, map[string]status.StatusInfo[status.InstanceStatusType], error]
+	getAllMachineStatusesExpects                        []*gomock.Call1_2[context.Context, map[string]status.MachineStatusInfo[status.MachineStatusType], error]
+	getAllRelationStatusesExpects                       []*gomock.Call1_2[context.Context, []status.RelationStatusInfo, error]
+	getAllStorageInstanceAttachmentsExpects             []*gomock.Call1_2[context.Context, []status.StorageAttachment, error]
+	getAllStorageInstancesExpects                       []*gomock.Call1_2[context.Context, []status.StorageInstance, error]
+	getAllUnitWorkloadAgentStatusesExpects              []*gomock.Call1_2[context.Context, status.UnitWorkloadAgentStatuses, error]
+	getAllVolumeAttachmentsExpects                      []*gomock.Call1_2[context.Context, []status.VolumeAttachment, error]
+	getAllVolumesExpects                                []*gomock.Call1_2[context.Context, []status.Volume, error]
+	getApplicationAndUnitModelStatusesExpects           []*gomock.Call1_2[context.Context, map[string]int, error]
+	getApplicationAndUnitStatusesExpects                []*gomock.Call1_2[context.Context, map[string]status.Application, error]
+	getApplicationAndUnitStatusesForApplicationsExpects []*gomock.Call2_2[context.Context, []string, map[string]status.Application, error]
+	getApplicationNamesForSelectorsExpects              []*gomock.Call2_2[context.Context, []status.ApplicationSelector, []string, error]
+	getApplicationStatusExpects                         []*gomock.Call2_2[context.Context, application.UUID, status.StatusInfo[status.WorkloadStatusType], error]
+	getApplicationUUIDAndNameByUnitNameExpects          []*gomock.Call2_3[context.Context, unit.Name, application.UUID, string, error]
+	getApplicationUUIDByNameExpects                     []*gomock.Call2_2[context.Context, string, application.UUID, error]
+	getApplicationUUIDForOfferExpects                   []*gomock.Call2_2[context.Context, string, string, error]
+	getFilesystemAttachmentsExpects                     []*gomock.Call2_2[context.Context, []storage.FilesystemUUID, []status.FilesystemAttachment, error]
+	getFilesystemUUIDByIDExpects                        []*gomock.Call2_2[context.Context, string, storage.FilesystemUUID, error]
+	getFilesystemsExpects                               []*gomock.Call2_2[context.Context, []storage.FilesystemUUID, []status.Filesystem, error]
+	getInstanceStatusExpects                            []*gomock.Call2_2[context.Context, string, status.StatusInfo[status.InstanceStatusType], error]
+	getMachineFullStatusesExpects                       []*gomock.Call1_2[context.Context, map[machine.Name]status.Machine, error]
+	getMachineStatusExpects                             []*gomock.Call2_2[context.Context, string, status.MachineStatusInfo[status.MachineStatusType], error]
+	getModelStatusInfoExpects                           []*gomock.Call1_2[context.Context, status.ModelStatusInfo, error]
+	getRelationUUIDByIDExpects                          []*gomock.Call2_2[context.Context, int, relation.UUID, error]
+	getRemoteApplicationOffererStatusesExpects          []*gomock.Call1_2[context.Context, map[string]status.RemoteApplicationOfferer, error]
+	getRemoteApplicationOffererUUIDByNameExpects        []*gomock.Call2_2[context.Context, string, remoteapplication.UUID, error]
+	getStorageInstanceAttachmentsExpects                []*gomock.Call2_2[context.Context, []storage.StorageInstanceUUID, []status.StorageAttachment, error]
+	getStorageInstancesExpects                          []*gomock.Call2_2[context.Context, []storage.StorageInstanceUUID, []status.StorageInstance, error]
+	getUnitAgentStatusExpects                           []*gomock.Call2_2[context.Context, unit.UUID, status.UnitStatusInfo[status.UnitAgentStatusType], error]
+	getUnitAgentStatusesForApplicationExpects           []*gomock.Call2_2[context.Context, application.UUID, status.UnitAgentStatuses, error]
+	getUnitK8sPodStatusExpects                          []*gomock.Call2_2[context.Context, unit.UUID, status.StatusInfo[status.K8sPodStatusType], error]
+	getUnitUUIDByNameExpects                            []*gomock.Call2_2[context.Context, unit.Name, unit.UUID, error]
+	getUnitWorkloadStatusExpects                        []*gomock.Call2_2[context.Context, unit.UUID, status.UnitStatusInfo[status.WorkloadStatusType], error]
+	getUnitWorkloadStatusesForApplicationExpects        []*gomock.Call2_2[context.Context, application.UUID, status.UnitWorkloadStatuses, error]
+	getVolumeAttachmentsExpects                         []*gomock.Call2_2[context.Context, []storage.VolumeUUID, []status.VolumeAttachment, error]
+	getVolumeUUIDByIDExpects                            []*gomock.Call2_2[context.Context, string, storage.VolumeUUID, error]
+	getVolumesExpects                                   []*gomock.Call2_2[context.Context, []storage.VolumeUUID, []status.Volume, error]
+	importRelationStatusExpects                         []*gomock.Call3_1[context.Context, relation.UUID, status.StatusInfo[status.RelationStatusType], error]
+	isControllerModelExpects                            []*gomock.Call1_2[context.Context, bool, error]
+	namespacesForWatchModelStatusExpects                []*gomock.Call0_1[[]string]
+	namespacesForWatchOfferStatusExpects                []*gomock.Call0_5[string, string, string, string, string]
+	setApplicationStatusExpects                         []*gomock.Call3_1[context.Context, application.UUID, status.StatusInfo[status.WorkloadStatusType], error]
+	setFilesystemStatusExpects                          []*gomock.Call3_1[context.Context, storage.FilesystemUUID, status.StatusInfo[status.StorageFilesystemStatusType], error]
+	setInstanceStatusExpects                            []*gomock.Call3_1[context.Context, string, status.StatusInfo[status.InstanceStatusType], error]
+	setMachinePresenceExpects                           []*gomock.Call2_1[context.Context, machine.Name, error]
+	setMachineStatusExpects                             []*gomock.Call3_1[context.Context, string, status.StatusInfo[status.MachineStatusType], error]
+	setOperatorStatusExpects                            []*gomock.Call3_1[context.Context, application.UUID, status.StatusInfo[status.WorkloadStatusType], error]
+	setRelationStatusExpects                            []*gomock.Call3_1[context.Context, relation.UUID, status.StatusInfo[status.RelationStatusType], error]
+	setRemoteApplicationOffererStatusExpects            []*gomock.Call3_1[context.Context, string, status.StatusInfo[status.WorkloadStatusType], error]
+	setRemoteRelationStatusExpects                      []*gomock.Call3_1[context.Context, relation.UUID, status.StatusInfo[status.RelationStatusType], error]
+	setUnitAgentStatusExpects                           []*gomock.Call3_1[context.Context, unit.UUID, status.StatusInfo[status.UnitAgentStatusType], error]
+	setUnitPresenceExpects                              []*gomock.Call2_1[context.Context, unit.Name, error]
+	setUnitWorkloadStatusExpects                        []*gomock.Call3_1[context.Context, unit.UUID, status.StatusInfo[status.WorkloadStatusType], error]
+	setVolumeStatusExpects                              []*gomock.Call3_1[context.Context, storage.VolumeUUID, status.StatusInfo[status.StorageVolumeStatusType], error]
 }
 
 // NewMockModelState creates a new mock instance.
@@ -411,6 +414,42 @@ func (mr *MockModelStateMockRecorder) GetApplicationAndUnitStatuses(ctx any) *Mo
 // MockModelStateGetApplicationAndUnitStatusesCall is the typed call wrapper for GetApplicationAndUnitStatuses.
 type MockModelStateGetApplicationAndUnitStatusesCall = gomock.Call1_2[context.Context, map[string]status.Application, error]
 
+// GetApplicationAndUnitStatusesForApplications mocks base method.
+func (m *MockModelState) GetApplicationAndUnitStatusesForApplications(ctx context.Context, names []string) (map[string]status.Application, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getApplicationAndUnitStatusesForApplicationsExpects, m.ctrl, m, "GetApplicationAndUnitStatusesForApplications", ctx, names)
+}
+
+// GetApplicationAndUnitStatusesForApplications indicates an expected call of GetApplicationAndUnitStatusesForApplications.
+func (mr *MockModelStateMockRecorder) GetApplicationAndUnitStatusesForApplications(ctx, names any) *MockModelStateGetApplicationAndUnitStatusesForApplicationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, []string, map[string]status.Application, error](mr.mock.ctrl.T, mr.mock, "GetApplicationAndUnitStatusesForApplications", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(names))
+	mr.getApplicationAndUnitStatusesForApplicationsExpects = append(mr.getApplicationAndUnitStatusesForApplicationsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelStateGetApplicationAndUnitStatusesForApplicationsCall is the typed call wrapper for GetApplicationAndUnitStatusesForApplications.
+type MockModelStateGetApplicationAndUnitStatusesForApplicationsCall = gomock.Call2_2[context.Context, []string, map[string]status.Application, error]
+
+// GetApplicationNamesForSelectors mocks base method.
+func (m *MockModelState) GetApplicationNamesForSelectors(ctx context.Context, selectors []status.ApplicationSelector) ([]string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getApplicationNamesForSelectorsExpects, m.ctrl, m, "GetApplicationNamesForSelectors", ctx, selectors)
+}
+
+// GetApplicationNamesForSelectors indicates an expected call of GetApplicationNamesForSelectors.
+func (mr *MockModelStateMockRecorder) GetApplicationNamesForSelectors(ctx, selectors any) *MockModelStateGetApplicationNamesForSelectorsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, []status.ApplicationSelector, []string, error](mr.mock.ctrl.T, mr.mock, "GetApplicationNamesForSelectors", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(selectors))
+	mr.getApplicationNamesForSelectorsExpects = append(mr.getApplicationNamesForSelectorsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelStateGetApplicationNamesForSelectorsCall is the typed call wrapper for GetApplicationNamesForSelectors.
+type MockModelStateGetApplicationNamesForSelectorsCall = gomock.Call2_2[context.Context, []status.ApplicationSelector, []string, error]
+
 // GetApplicationStatus mocks base method.
 func (m *MockModelState) GetApplicationStatus(ctx context.Context, appID application.UUID) (status.StatusInfo[status.WorkloadStatusType], error) {
 	m.ctrl.T.Helper()
@@ -897,6 +936,24 @@ func (mr *MockModelStateMockRecorder) IsControllerModel(ctx any) *MockModelState
 // MockModelStateIsControllerModelCall is the typed call wrapper for IsControllerModel.
 type MockModelStateIsControllerModelCall = gomock.Call1_2[context.Context, bool, error]
 
+// NamespacesForWatchModelStatus mocks base method.
+func (m *MockModelState) NamespacesForWatchModelStatus() []string {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.namespacesForWatchModelStatusExpects, m.ctrl, m, "NamespacesForWatchModelStatus")
+}
+
+// NamespacesForWatchModelStatus indicates an expected call of NamespacesForWatchModelStatus.
+func (mr *MockModelStateMockRecorder) NamespacesForWatchModelStatus() *MockModelStateNamespacesForWatchModelStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[[]string](mr.mock.ctrl.T, mr.mock, "NamespacesForWatchModelStatus")
+	mr.namespacesForWatchModelStatusExpects = append(mr.namespacesForWatchModelStatusExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelStateNamespacesForWatchModelStatusCall is the typed call wrapper for NamespacesForWatchModelStatus.
+type MockModelStateNamespacesForWatchModelStatusCall = gomock.Call0_1[[]string]
+
 // NamespacesForWatchOfferStatus mocks base method.
 func (m *MockModelState) NamespacesForWatchOfferStatus() (string, string, string, string, string) {
 	m.ctrl.T.Helper()
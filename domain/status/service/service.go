@@ -148,6 +148,16 @@ type ModelState interface {
 	// applications in the model, indexed by application name.
 	GetApplicationAndUnitStatuses(ctx context.Context) (map[string]status.Application, error)
 
+	// GetApplicationAndUnitStatusesForApplications returns the application
+	// and unit statuses of the named applications, indexed by application
+	// name.
+	GetApplicationAndUnitStatusesForApplications(ctx context.Context, names []string) (map[string]status.Application, error)
+
+	// GetApplicationNamesForSelectors returns the names of the applications
+	// matching any of the selectors, along with the applications whose units
+	// are principals or subordinates of their units.
+	GetApplicationNamesForSelectors(ctx context.Context, selectors []status.ApplicationSelector) ([]string, error)
+
 	// GetApplicationAndUnitModelStatuses returns the application name and unit
 	// count for each model for the model status request.
 	GetApplicationAndUnitModelStatuses(ctx context.Context) (map[string]int, error)
@@ -198,6 +208,11 @@ type ModelState interface {
 	// for application status changes.
 	NamespacesForWatchOfferStatus() (offer, application, unitAgent, unitWorkload, unitPod string)
 
+	// NamespacesForWatchModelStatus returns the namespace string identifiers
+	// for changes to the applications, units and machines of the model, and
+	// to their statuses.
+	NamespacesForWatchModelStatus() []string
+
 	// IsControllerModel returns if the model is a controller model.
 	IsControllerModel(ctx context.Context) (bool, error)
 }
@@ -653,7 +668,37 @@ func (s *Service) GetApplicationAndUnitStatuses(ctx context.Context) (map[string
 	if err != nil {
 		return nil, errors.Capture(err)
 	}
+	return s.decodeApplicationStatuses(statuses)
+}
+
+// GetApplicationAndUnitStatusesForFilter returns the application statuses of
+// the applications the filter can match, indexed by application name. When
+// every selector of the filter names applications, units, charms or relation
+// endpoints, only the applications selected, and those whose units are the
+// principals or subordinates of their units, are read from the database.
+// Otherwise the statuses of all the applications are returned. In both cases
+// [MatchStatus] must still be used to apply the filter.
+func (s *Service) GetApplicationAndUnitStatusesForFilter(ctx context.Context, filter StatusFilter) (map[string]Application, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	selectors, ok := filter.applicationSelectors()
+	if !ok {
+		return s.GetApplicationAndUnitStatuses(ctx)
+	}
+
+	names, err := s.modelState.GetApplicationNamesForSelectors(ctx, selectors)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	statuses, err := s.modelState.GetApplicationAndUnitStatusesForApplications(ctx, names)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return s.decodeApplicationStatuses(statuses)
+}
 
+func (s *Service) decodeApplicationStatuses(statuses map[string]status.Application) (map[string]Application, error) {
 	results := make(map[string]Application, len(statuses))
 	for appName, app := range statuses {
 		decoded, err := s.decodeApplicationStatusDetails(app)
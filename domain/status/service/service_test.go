@@ -1430,6 +1430,41 @@ func (s *serviceSuite) TestGetApplicationAndUnitStatusesError(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "boom")
 }
 
+func (s *serviceSuite) TestGetApplicationAndUnitStatusesForFilter(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	filter, err := ParseStatusFilter([]string{"mysql/*", "word*"}, []string{"error"})
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.modelState.EXPECT().GetApplicationNamesForSelectors(gomock.Any(), []status.ApplicationSelector{
+		{Name: "mysql"},
+		{Name: "word*"},
+	}).Return([]string{"mysql", "wordpress"}, nil)
+	s.modelState.EXPECT().GetApplicationAndUnitStatusesForApplications(gomock.Any(), []string{"mysql", "wordpress"}).Return(
+		map[string]status.Application{}, nil,
+	)
+
+	statuses, err := s.modelService.GetApplicationAndUnitStatusesForFilter(c.Context(), filter)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(statuses, tc.DeepEquals, map[string]Application{})
+}
+
+func (s *serviceSuite) TestGetApplicationAndUnitStatusesForFilterAllApplications(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// A machine selector can match units of any application.
+	filter, err := ParseStatusFilter([]string{"mysql", "0"}, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.modelState.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(
+		map[string]status.Application{}, nil,
+	)
+
+	statuses, err := s.modelService.GetApplicationAndUnitStatusesForFilter(c.Context(), filter)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(statuses, tc.DeepEquals, map[string]Application{})
+}
+
 func (s *serviceSuite) TestGetApplicationAndUnitStatuses(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -36,6 +36,16 @@ type WatcherFactory interface {
 		filter eventsource.FilterOption,
 		filterOpts ...eventsource.FilterOption,
 	) (watcher.NotifyWatcher, error)
+
+	// NewNotifyWatcher returns a new watcher that filters changes from the
+	// input base watcher's db/queue. A single filter option is required, though
+	// additional filter options can be provided.
+	NewNotifyWatcher(
+		ctx context.Context,
+		summary string,
+		filter eventsource.FilterOption,
+		filterOpts ...eventsource.FilterOption,
+	) (watcher.NotifyWatcher, error)
 }
 
 // WatchableService is a status service that can be used to watch status changes.
@@ -101,8 +111,8 @@ func (s *WatchableService) WatchOfferStatus(ctx context.Context, offerUUID offer
 		})
 
 		// If any of our events are from the offer namespace, this means the
-		// offer has been deleted. In this case, we should always emit the
-		// events.
+		// offer has been updated or deleted. In this case, we should always
+		// emit the events, so that consumers of the offer learn of it.
 		for _, event := range events {
 			if event.Namespace() == offerNamespace {
 				return mappedEvents, nil
@@ -128,7 +138,7 @@ func (s *WatchableService) WatchOfferStatus(ctx context.Context, offerUUID offer
 		mapper,
 		eventsource.PredicateFilter(
 			offerNamespace,
-			changestream.Deleted,
+			changestream.All,
 			eventsource.EqualsPredicate(offerUUID.String()),
 		),
 		eventsource.PredicateFilter(
@@ -153,3 +163,21 @@ func (s *WatchableService) WatchOfferStatus(ctx context.Context, offerUUID offer
 		),
 	)
 }
+
+// WatchModelStatus returns a watcher that notifies when an application, unit
+// or machine is added to or removed from the model, or when the status of any
+// of them changes.
+func (s *WatchableService) WatchModelStatus(ctx context.Context) (watcher.NotifyWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	filters := transform.Slice(s.modelState.NamespacesForWatchModelStatus(), func(namespace string) eventsource.FilterOption {
+		return eventsource.NamespaceFilter(namespace, changestream.All)
+	})
+	return s.watcherFactory.NewNotifyWatcher(
+		ctx,
+		"model status watcher",
+		filters[0],
+		filters[1:]...,
+	)
+}
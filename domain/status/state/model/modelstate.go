@@ -13,6 +13,7 @@ import (
 
 	"github.com/canonical/sqlair"
 	"github.com/juju/clock"
+	"github.com/juju/collections/set"
 	"github.com/juju/collections/transform"
 
 	coreapplication "github.com/juju/juju/core/application"
@@ -1574,6 +1575,26 @@ ON CONFLICT(unit_uuid) DO UPDATE SET
 // GetApplicationAndUnitStatuses returns the application and unit statuses of
 // all the applications in the model, indexed by application name.
 func (st *ModelState) GetApplicationAndUnitStatuses(ctx context.Context) (map[string]status.Application, error) {
+	return st.getApplicationAndUnitStatuses(ctx, nil)
+}
+
+// GetApplicationAndUnitStatusesForApplications returns the application and
+// unit statuses of the named applications, indexed by application name.
+// Applications which don't exist are ignored.
+func (st *ModelState) GetApplicationAndUnitStatusesForApplications(
+	ctx context.Context, names []string,
+) (map[string]status.Application, error) {
+	if len(names) == 0 {
+		return map[string]status.Application{}, nil
+	}
+	return st.getApplicationAndUnitStatuses(ctx, names)
+}
+
+// getApplicationAndUnitStatuses returns the application and unit statuses of
+// the named applications, or of all the applications if names is nil.
+func (st *ModelState) getApplicationAndUnitStatuses(
+	ctx context.Context, names applicationNames,
+) (map[string]status.Application, error) {
 	db, err := st.DB(ctx)
 	if err != nil {
 		return nil, errors.Capture(err)
@@ -1584,11 +1605,11 @@ func (st *ModelState) GetApplicationAndUnitStatuses(ctx context.Context) (map[st
 		result = map[string]status.Application{}
 
 		var err error
-		if result, err = st.getApplicationsStatuses(ctx, tx); err != nil {
+		if result, err = st.getApplicationsStatuses(ctx, tx, names); err != nil {
 			return errors.Errorf("getting application statuses: %w", err)
 		}
 
-		unitStatues, err := st.getUnitsStatuses(ctx, tx)
+		unitStatues, err := st.getUnitsStatuses(ctx, tx, names)
 		if err != nil {
 			return errors.Errorf("getting unit statuses: %w", err)
 		}
@@ -1615,8 +1636,120 @@ func (st *ModelState) GetApplicationAndUnitStatuses(ctx context.Context) (map[st
 	return result, nil
 }
 
-func (st *ModelState) getApplicationsStatuses(ctx context.Context, tx *sqlair.TX) (map[string]status.Application, error) {
-	// Get all the applications.
+// applicationNameFilter returns the condition restricting a status query to
+// the named applications, along with its arguments. Both are empty if names is
+// nil.
+func applicationNameFilter(names applicationNames) (string, []any) {
+	if names == nil {
+		return "", nil
+	}
+	return "\nAND a.name IN ($applicationNames[:])", []any{names}
+}
+
+// GetApplicationNamesForSelectors returns the names of the applications
+// matching any of the selectors, along with the names of the applications
+// whose units are the principals or subordinates of their units, so that the
+// status of the selected units can be reported in full.
+func (st *ModelState) GetApplicationNamesForSelectors(
+	ctx context.Context, selectors []status.ApplicationSelector,
+) ([]string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	nameStmt, err := st.Prepare(`
+SELECT a.name AS &applicationName.name
+FROM   application AS a
+JOIN   charm AS c ON c.uuid = a.charm_uuid
+WHERE  a.name GLOB $applicationSelector.name
+OR     c.reference_name GLOB $applicationSelector.name
+`, applicationName{}, applicationSelector{})
+	if err != nil {
+		return nil, errors.Errorf("preparing application name query: %w", err)
+	}
+
+	endpointStmt, err := st.Prepare(`
+SELECT DISTINCT other.application_name AS &applicationName.name
+FROM   v_relation_endpoint AS re
+JOIN   v_relation_endpoint AS other ON other.relation_uuid = re.relation_uuid
+WHERE  re.application_name GLOB $applicationSelector.name
+AND    re.endpoint_name GLOB $applicationSelector.endpoint
+`, applicationName{}, applicationSelector{})
+	if err != nil {
+		return nil, errors.Errorf("preparing relation endpoint query: %w", err)
+	}
+
+	relatedStmt, err := st.Prepare(`
+SELECT a.name AS &applicationName.name
+FROM   application AS a
+WHERE  a.uuid IN (
+    SELECT su.application_uuid
+    FROM   unit_principal AS up
+    JOIN   unit AS su ON su.uuid = up.unit_uuid
+    JOIN   unit AS pu ON pu.uuid = up.principal_uuid
+    JOIN   application AS pa ON pa.uuid = pu.application_uuid
+    WHERE  pa.name IN ($applicationNames[:])
+    UNION
+    SELECT pu.application_uuid
+    FROM   unit_principal AS up
+    JOIN   unit AS su ON su.uuid = up.unit_uuid
+    JOIN   unit AS pu ON pu.uuid = up.principal_uuid
+    JOIN   application AS sa ON sa.uuid = su.application_uuid
+    WHERE  sa.name IN ($applicationNames[:])
+)
+`, applicationName{}, applicationNames{})
+	if err != nil {
+		return nil, errors.Errorf("preparing related application query: %w", err)
+	}
+
+	var result []string
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		selected := set.NewStrings()
+		for _, selector := range selectors {
+			stmt := nameStmt
+			if selector.Endpoint != "" {
+				stmt = endpointStmt
+			}
+			arg := applicationSelector{
+				Name:     selector.Name,
+				Endpoint: selector.Endpoint,
+			}
+			var names []applicationName
+			if err := tx.Query(ctx, stmt, arg).GetAll(&names); errors.Is(err, sqlair.ErrNoRows) {
+				continue
+			} else if err != nil {
+				return errors.Capture(err)
+			}
+			for _, name := range names {
+				selected.Add(name.Name)
+			}
+		}
+		if selected.IsEmpty() {
+			return nil
+		}
+
+		var related []applicationName
+		err := tx.Query(ctx, relatedStmt, applicationNames(selected.Values())).GetAll(&related)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Capture(err)
+		}
+		for _, name := range related {
+			selected.Add(name.Name)
+		}
+		result = selected.SortedValues()
+		return nil
+	}); err != nil {
+		return nil, errors.Errorf("getting application names for selectors: %w", err)
+	}
+	return result, nil
+}
+
+func (st *ModelState) getApplicationsStatuses(
+	ctx context.Context, tx *sqlair.TX, names applicationNames,
+) (map[string]status.Application, error) {
+	// Get all the applications, or only the named ones.
+	nameFilter, args := applicationNameFilter(names)
 	query, err := st.Prepare(`
 WITH selected_k8s_service_address AS (
   -- Pick one CAAS app address for status by display priority, then
@@ -1686,15 +1819,15 @@ LEFT JOIN selected_k8s_service_address AS svc_addr ON svc_addr.application_uuid
 LEFT JOIN application_scale AS aps ON aps.application_uuid = a.uuid
 LEFT JOIN v_relation_endpoint AS re ON re.application_uuid = a.uuid
 LEFT JOIN application_workload_version AS awv ON awv.application_uuid = a.uuid
-WHERE c.source_id < 2
+WHERE c.source_id < 2`+nameFilter+`
 ORDER BY a.name, re.relation_uuid;
-`, applicationStatusDetails{})
+`, append(args, applicationStatusDetails{})...)
 	if err != nil {
 		return nil, errors.Errorf("preparing application query: %w", err)
 	}
 
 	var appStatuses []applicationStatusDetails
-	if err := tx.Query(ctx, query).GetAll(&appStatuses); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+	if err := tx.Query(ctx, query, args...).GetAll(&appStatuses); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 		return nil, errors.Capture(err)
 	}
 
@@ -1799,8 +1932,11 @@ ORDER BY a.name, re.relation_uuid;
 	return result, nil
 }
 
-func (st *ModelState) getUnitsStatuses(ctx context.Context, tx *sqlair.TX) (map[string]map[coreunit.Name]status.Unit, error) {
-	// Get all the units.
+func (st *ModelState) getUnitsStatuses(
+	ctx context.Context, tx *sqlair.TX, names applicationNames,
+) (map[string]map[coreunit.Name]status.Unit, error) {
+	// Get all the units, or only the units of the named applications.
+	nameFilter, args := applicationNameFilter(names)
 	query, err := st.Prepare(`
 WITH unit_subordinate AS (
 	SELECT u.name AS subordinate_name, principal_uuid
@@ -1855,15 +1991,15 @@ LEFT JOIN unit AS upu ON up.principal_uuid = upu.uuid
 LEFT JOIN unit_subordinate AS us ON us.principal_uuid = u.uuid
 LEFT JOIN unit_agent_version AS uav ON uav.unit_uuid = u.uuid
 LEFT JOIN unit_workload_version AS awv ON awv.unit_uuid = u.uuid
-WHERE c.source_id < 2
+WHERE c.source_id < 2`+nameFilter+`
 ORDER BY u.name;
-`, unitStatusDetails{})
+`, append(args, unitStatusDetails{})...)
 	if err != nil {
 		return nil, errors.Errorf("preparing unit query: %w", err)
 	}
 
 	var unitStatuses []unitStatusDetails
-	if err := tx.Query(ctx, query).GetAll(&unitStatuses); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+	if err := tx.Query(ctx, query, args...).GetAll(&unitStatuses); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 		return nil, errors.Capture(err)
 	}
 
@@ -2621,6 +2757,23 @@ func (s *ModelState) NamespacesForWatchOfferStatus() (offer, application, unitAg
 	return "offer", "application_status", "custom_unit_agent_status", "custom_unit_workload_status", "custom_k8s_pod_status"
 }
 
+// NamespacesForWatchModelStatus returns the namespace string identifiers for
+// changes to the applications, units and machines of the model, and to their
+// statuses.
+func (s *ModelState) NamespacesForWatchModelStatus() []string {
+	return []string{
+		"application",
+		"application_status",
+		"unit",
+		"custom_unit_agent_status",
+		"custom_unit_workload_status",
+		"custom_k8s_pod_status",
+		"machine",
+		"machine_status",
+		"machine_cloud_instance_status",
+	}
+}
+
 func encodeIPAddress(address machineSpaceAddress) (corenetwork.SpaceAddress, error) {
 	spaceUUID := corenetwork.AlphaSpaceId
 	if address.SpaceUUID.Valid {
@@ -2287,6 +2287,79 @@ func (s *modelStateSuite) TestGetApplicationAndUnitStatusesWithRelations(c *tc.C
 	})
 }
 
+func (s *modelStateSuite) TestGetApplicationAndUnitStatusesForApplications(c *tc.C) {
+	appStatus := s.workloadStatus(time.Now())
+	s.createIAASApplication(c, "foo", life.Alive, appStatus, s.createIAASUnitArg(c))
+	s.createIAASApplication(c, "bar", life.Alive, appStatus, s.createIAASUnitArg(c), s.createIAASUnitArg(c))
+
+	statuses, err := s.state.GetApplicationAndUnitStatusesForApplications(c.Context(), []string{"bar", "missing"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(statuses, tc.HasLen, 1)
+	c.Check(statuses["bar"].Units, tc.HasLen, 2)
+
+	statuses, err = s.state.GetApplicationAndUnitStatusesForApplications(c.Context(), nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(statuses, tc.HasLen, 0)
+}
+
+func (s *modelStateSuite) TestGetApplicationNamesForSelectors(c *tc.C) {
+	appStatus := s.workloadStatus(time.Now())
+	fooUUID, fooUnits := s.createIAASApplication(c, "foo", life.Alive, appStatus, s.createIAASUnitArg(c))
+	barUUID, _ := s.createIAASApplication(c, "bar", life.Alive, appStatus, s.createIAASUnitArg(c))
+	s.createIAASApplication(c, "baz", life.Alive, appStatus)
+	_, subUnits := s.createSubordinateIAASApplication(c, "sub", life.Alive, appStatus, s.createIAASUnitArg(c))
+	s.setApplicationSubordinate(c, fooUnits[0], subUnits[0])
+
+	relationUUID := s.addRelationWithLifeAndID(c, corelife.Alive, 1)
+	s.addRelationEndpoint(c, fooUUID, relationUUID, "endpoint")
+	s.addRelationEndpoint(c, barUUID, relationUUID, "misc")
+
+	for i, test := range []struct {
+		selectors []status.ApplicationSelector
+		expected  []string
+	}{{
+		selectors: []status.ApplicationSelector{{Name: "foo"}},
+		expected:  []string{"foo", "sub"},
+	}, {
+		selectors: []status.ApplicationSelector{{Name: "sub"}},
+		expected:  []string{"foo", "sub"},
+	}, {
+		selectors: []status.ApplicationSelector{{Name: "ba*"}},
+		expected:  []string{"bar", "baz"},
+	}, {
+		selectors: []status.ApplicationSelector{{Name: "bar", Endpoint: "m*"}},
+		expected:  []string{"bar", "foo", "sub"},
+	}, {
+		selectors: []status.ApplicationSelector{{Name: "bar", Endpoint: "endpoint"}},
+	}, {
+		selectors: []status.ApplicationSelector{{Name: "missing"}, {Name: "baz"}},
+		expected:  []string{"baz"},
+	}} {
+		c.Logf("test %d: %v", i, test.selectors)
+		names, err := s.state.GetApplicationNamesForSelectors(c.Context(), test.selectors)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(names, tc.DeepEquals, test.expected)
+	}
+}
+
+// addRelationEndpoint adds the named endpoint of the application to the
+// relation.
+func (s *modelStateSuite) addRelationEndpoint(
+	c *tc.C, appUUID coreapplication.UUID, relationUUID corerelation.UUID, endpoint string,
+) {
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO relation_endpoint (uuid, relation_uuid, endpoint_uuid)
+SELECT ?, ?, ae.uuid
+FROM   application_endpoint AS ae
+JOIN   charm_relation AS cr ON cr.uuid = ae.charm_relation_uuid
+WHERE  ae.application_uuid = ? AND cr.name = ?
+`, uuid.MustNewUUID().String(), relationUUID, appUUID, endpoint)
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 func (s *modelStateSuite) TestGetApplicationAndUnitStatusesWithMultipleRelations(c *tc.C) {
 	now := time.Now()
 	appStatus := s.workloadStatus(now)
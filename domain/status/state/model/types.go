@@ -27,6 +27,13 @@ type applicationName struct {
 	Name string `db:"name"`
 }
 
+type applicationNames []string
+
+type applicationSelector struct {
+	Name     string `db:"name"`
+	Endpoint string `db:"endpoint"`
+}
+
 type applicationUUIDAndName struct {
 	ID   coreapplication.UUID `db:"uuid"`
 	Name string               `db:"name"`
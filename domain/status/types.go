@@ -169,6 +169,20 @@ type Endpoint struct {
 	Limit     int
 }
 
+// ApplicationSelector selects applications by glob patterns, as supported by
+// SQLite GLOB.
+type ApplicationSelector struct {
+	// Name is matched against the name of the application and the name of
+	// its charm.
+	Name string
+
+	// Endpoint, if not empty, is matched against the names of the endpoints
+	// of the relations of the application. The selector then selects all the
+	// applications of the matching relations, rather than the application
+	// itself.
+	Endpoint string
+}
+
 // ControllerNode represents the status of a controller node.
 type ControllerNode struct {
 	ControllerID string
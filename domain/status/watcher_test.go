@@ -220,6 +220,34 @@ func (s *watcherSuite) TestWatchOfferStatusOfferDeleted(c *tc.C) {
 	harness.Run(c, struct{}{})
 }
 
+func (s *watcherSuite) TestWatchOfferStatusOfferUpdated(c *tc.C) {
+	appUUID, _ := s.createIAASApplication(c, "foo", life.Alive)
+	offerUUID := s.createOffer(c, appUUID, "endpoint")
+
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "status")
+	svc := s.setupService(c, factory)
+
+	watcher, err := svc.WatchOfferStatus(c.Context(), offerUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	harness := watchertest.NewHarness(s, watchertest.NewWatcherC(c, watcher))
+
+	// Assert that updating the offer triggers the watcher, so that consumers
+	// of the offer are notified of the change.
+
+	harness.AddTest(c, func(c *tc.C) {
+		err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, `UPDATE offer SET modified_version = modified_version + 1 WHERE uuid = ?`, offerUUID)
+			return err
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	harness.Run(c, struct{}{})
+}
+
 func (s *watcherSuite) TestWatchOfferNotFound(c *tc.C) {
 	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "status")
 	svc := s.setupService(c, factory)
@@ -229,6 +257,73 @@ func (s *watcherSuite) TestWatchOfferNotFound(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, crossmodelrelationerrors.OfferNotFound)
 }
 
+func (s *watcherSuite) TestWatchModelStatus(c *tc.C) {
+	unitUUID := tc.Must(c, coreunit.NewUUID)
+	netNodeUUID := tc.Must(c, domainnetwork.NewNetNodeUUID)
+	s.createIAASApplication(c, "foo", life.Alive, application.AddIAASUnitArg{
+		MachineUUID:        tc.Must(c, coremachine.NewUUID),
+		MachineNetNodeUUID: netNodeUUID,
+		AddUnitArg: application.AddUnitArg{
+			UnitUUID:    unitUUID,
+			NetNodeUUID: netNodeUUID,
+		},
+	})
+
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "status")
+	svc := s.setupService(c, factory)
+
+	s.AssertChangeStreamIdle(c, "before watcher start")
+
+	watcher, err := svc.WatchModelStatus(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	harness := watchertest.NewHarness(s, watchertest.NewWatcherC(c, watcher))
+
+	// Assert that setting the status of a unit triggers the watcher.
+
+	harness.AddTest(c, func(c *tc.C) {
+		err := svc.SetUnitWorkloadStatus(c.Context(), "foo/0", status.StatusInfo{
+			Status:  status.Active,
+			Message: "it's active!",
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that setting the status of a machine triggers the watcher.
+
+	harness.AddTest(c, func(c *tc.C) {
+		err := svc.SetMachineStatus(c.Context(), "0", status.StatusInfo{
+			Status: status.Started,
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that setting the status of an application triggers the watcher.
+
+	harness.AddTest(c, func(c *tc.C) {
+		err := svc.SetApplicationStatus(c.Context(), "foo", status.StatusInfo{
+			Status: status.Blocked,
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that adding an application triggers the watcher.
+
+	harness.AddTest(c, func(c *tc.C) {
+		s.createIAASApplication(c, "bar", life.Alive)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	harness.Run(c, struct{}{})
+}
+
 func (s *watcherSuite) setupService(c *tc.C, factory domain.WatchableDBFactory) *service.WatchableService {
 	modelDB := func(ctx context.Context) (database.TxnRunner, error) {
 		return s.ModelTxnRunner(), nil
@@ -48,6 +48,14 @@ type AdoptState interface {
 		ctx context.Context,
 		args domainstorageinternal.CreateStorageInstanceWithExistingVolumeBackedFilesystem,
 	) (string, error)
+
+	// CreateStorageInstanceWithExistingVolume creates a new block storage
+	// instance, with a volume using existing provisioned volume details. It
+	// returns the new storage ID for the created storage instance.
+	CreateStorageInstanceWithExistingVolume(
+		ctx context.Context,
+		args domainstorageinternal.CreateStorageInstanceWithExistingVolume,
+	) (string, error)
 }
 
 // AdoptFilesystem adopts a filesystem by invoking the provider of the given
@@ -77,60 +85,10 @@ func (s *StorageService) AdoptFilesystem(
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
 
-	err := storageName.Validate()
-	if err != nil {
-		return "", errors.New(
-			"invalid storage name",
-		).Add(domainstorageerrors.InvalidStorageName)
-	}
-	err = poolUUID.Validate()
+	sp, poolConfig, err := s.getAdoptionStorageProvider(
+		ctx, storageName, poolUUID, providerID)
 	if err != nil {
-		return "", errors.Errorf(
-			"invalid storage pool uuid: %w", err,
-		).Add(coreerrors.NotValid)
-	}
-	if providerID == "" {
-		return "", errors.New(
-			"provider id cannot be empty",
-		).Add(coreerrors.NotValid)
-	}
-
-	pool, err := s.st.GetStoragePool(ctx, poolUUID)
-	if errors.Is(err, domainstorageerrors.StoragePoolNotFound) {
-		return "", errors.New(
-			"storage pool not found",
-		).Add(domainstorageerrors.StoragePoolNotFound)
-	} else if err != nil {
-		return "", errors.Errorf("getting storage pool: %w", err)
-	}
-
-	poolConfig, err := internalstorage.NewConfig(
-		pool.Name,
-		internalstorage.ProviderType(pool.Provider),
-		transform.Map(pool.Attrs, func(k string, v string) (string, any) {
-			return k, v
-		}),
-	)
-	if err != nil {
-		return "", errors.Errorf(
-			"storage pool %q is misconfigured: %w", pool.Name, err,
-		)
-	}
-
-	registry, err := s.registryGetter.GetStorageRegistry(ctx)
-	if err != nil {
-		return "", errors.Errorf("getting storage registry: %w", err)
-	}
-
-	sp, err := registry.StorageProvider(
-		internalstorage.ProviderType(pool.Provider))
-	if errors.Is(err, coreerrors.NotFound) {
-		return "", errors.Errorf(
-			"storage provider type %q not found for pool %q",
-			pool.Provider, pool.Name,
-		).Add(domainstorageerrors.ProviderTypeNotFound)
-	} else if err != nil {
-		return "", errors.Errorf("getting storage provider: %w", err)
+		return "", errors.Capture(err)
 	}
 
 	ic, err := domainstorageprovisioning.CalculateStorageInstanceComposition(
@@ -194,6 +152,130 @@ func (s *StorageService) AdoptFilesystem(
 		ctx, storageName, poolUUID, providerID, force, ic, imp)
 }
 
+// AdoptVolume adopts a block volume by invoking the provider of the given
+// storage pool to identify the volume specified by the provider ID. The result
+// of this call is the name of a new block storage instance using the given
+// storage name.
+// The following errors can be expected:
+// - [domainstorageerrors.StoragePoolNotFound] if the specified storage pool
+// does not exist.
+// - [domainstorageerrors.StorageEntityNotFoundInPool] if no pooled volume with
+// the given provider ID exists.
+// - [domainstorageerrors.InvalidStorageName] if the storage name is not valid.
+// - [coreerrors.NotValid] if the storage pool uuid is not valid.
+// - [domainstorageerrors.ProviderTypeNotFound] if the storage pool refers to a
+// missing storage provider type.
+// - [domainstorageerrors.AdoptionNotSupported] if the storage provider referred
+// to by the specified storage pool does not support adopting volumes or does
+// not support adopting the specified volume.
+func (s *StorageService) AdoptVolume(
+	ctx context.Context,
+	storageName domainstorage.Name,
+	poolUUID domainstorage.StoragePoolUUID,
+	providerID string,
+	force bool,
+) (corestorage.ID, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	sp, poolConfig, err := s.getAdoptionStorageProvider(
+		ctx, storageName, poolUUID, providerID)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	if !sp.Supports(internalstorage.StorageKindBlock) {
+		return "", errors.New(
+			"storage provider does not support block storage",
+		).Add(domainstorageerrors.AdoptionNotSupported)
+	}
+
+	ic, err := domainstorageprovisioning.CalculateStorageInstanceComposition(
+		domainstorage.StorageKindBlock, sp)
+	if err != nil {
+		return "", errors.Errorf(
+			"calculating storage instance composition: %w", err,
+		)
+	}
+	if !ic.VolumeRequired || ic.FilesystemRequired {
+		// This is not possible, since a block kind is always just a volume.
+		return "", errors.New(
+			"calculated storage instance composition is paradoxical",
+		)
+	} else if ic.VolumeProvisionScope == domainstorage.ProvisionScopeMachine {
+		return "", errors.New(
+			"adopting machine scoped volume is not possible",
+		).Add(domainstorageerrors.AdoptionNotSupported)
+	}
+
+	src, err := sp.VolumeSource(poolConfig)
+	if err != nil {
+		return "", errors.Errorf("getting volume source: %w", err)
+	}
+	imp, ok := src.(internalstorage.VolumeImporter)
+	if !ok {
+		return "", errors.New(
+			"storage provider does not support adopting a volume",
+		).Add(domainstorageerrors.AdoptionNotSupported)
+	}
+
+	tags, err := s.getStorageResourceTagsForModel(ctx)
+	if err != nil {
+		return "", errors.Errorf("getting resource tag info: %w", err)
+	}
+	volInfo, err := imp.ImportVolume(
+		ctx, providerID, storageName.String(), tags, force)
+	if errors.Is(err, coreerrors.NotSupported) {
+		return "", errors.Errorf(
+			"storage provider does not support adopting volume %q",
+			providerID,
+		).Add(domainstorageerrors.AdoptionNotSupported)
+	} else if errors.Is(err, coreerrors.NotFound) {
+		return "", errors.Errorf(
+			"pooled volume %q not found", providerID,
+		).Add(domainstorageerrors.StorageEntityNotFoundInPool)
+	} else if err != nil {
+		return "", errors.Errorf("importing volume: %w", err)
+	}
+
+	storageInstanceUUID, err := domainstorage.NewStorageInstanceUUID()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	volumeUUID, err := domainstorage.NewVolumeUUID()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	args := domainstorageinternal.CreateStorageInstanceWithExistingVolume{
+		Name:                  storageName,
+		RequestedSizeMiB:      volInfo.Size,
+		StoragePoolUUID:       poolUUID,
+		UUID:                  storageInstanceUUID,
+		VolumeUUID:            volumeUUID,
+		VolumeProvisionScope:  ic.VolumeProvisionScope,
+		VolumeSize:            volInfo.Size,
+		VolumeProviderID:      volInfo.VolumeId,
+		VolumeHardwareID:      volInfo.HardwareId,
+		VolumeWWN:             volInfo.WWN,
+		VolumePersistent:      volInfo.Persistent,
+		VolumeStatusID:        int(domainstatus.StorageVolumeStatusTypeDetached),
+		VolumeStatusMessage:   "volume imported",
+		VolumeStatusUpdatedAt: s.clock.Now().UTC(),
+	}
+
+	storageInstanceID, err := s.st.CreateStorageInstanceWithExistingVolume(
+		ctx, args,
+	)
+	if err != nil {
+		return "", errors.Errorf(
+			"creating adopted storage instance with volume: %w", err,
+		)
+	}
+
+	return corestorage.ID(storageInstanceID), nil
+}
+
 // adoptVolumeBackedFilesystem adopts a filesystem that is backed by a volume by
 // using the given [internalstorage.VolumeImporter] to import the volume
 // identified by providerID. On success, a new storage instance is persisted
@@ -361,6 +443,82 @@ func (s *StorageService) adoptFilesystem(
 	return corestorage.ID(storageInstanceID), nil
 }
 
+// getAdoptionStorageProvider validates the common arguments used to adopt a
+// storage entity and returns the storage provider and pool config for the
+// storage pool identified by poolUUID.
+// The following errors can be expected:
+// - [domainstorageerrors.StoragePoolNotFound] if the specified storage pool
+// does not exist.
+// - [domainstorageerrors.InvalidStorageName] if the storage name is not valid.
+// - [coreerrors.NotValid] if the storage pool uuid or provider id is not
+// valid.
+// - [domainstorageerrors.ProviderTypeNotFound] if the storage pool refers to a
+// missing storage provider type.
+func (s *StorageService) getAdoptionStorageProvider(
+	ctx context.Context,
+	storageName domainstorage.Name,
+	poolUUID domainstorage.StoragePoolUUID,
+	providerID string,
+) (internalstorage.Provider, *internalstorage.Config, error) {
+	err := storageName.Validate()
+	if err != nil {
+		return nil, nil, errors.New(
+			"invalid storage name",
+		).Add(domainstorageerrors.InvalidStorageName)
+	}
+	err = poolUUID.Validate()
+	if err != nil {
+		return nil, nil, errors.Errorf(
+			"invalid storage pool uuid: %w", err,
+		).Add(coreerrors.NotValid)
+	}
+	if providerID == "" {
+		return nil, nil, errors.New(
+			"provider id cannot be empty",
+		).Add(coreerrors.NotValid)
+	}
+
+	pool, err := s.st.GetStoragePool(ctx, poolUUID)
+	if errors.Is(err, domainstorageerrors.StoragePoolNotFound) {
+		return nil, nil, errors.New(
+			"storage pool not found",
+		).Add(domainstorageerrors.StoragePoolNotFound)
+	} else if err != nil {
+		return nil, nil, errors.Errorf("getting storage pool: %w", err)
+	}
+
+	poolConfig, err := internalstorage.NewConfig(
+		pool.Name,
+		internalstorage.ProviderType(pool.Provider),
+		transform.Map(pool.Attrs, func(k string, v string) (string, any) {
+			return k, v
+		}),
+	)
+	if err != nil {
+		return nil, nil, errors.Errorf(
+			"storage pool %q is misconfigured: %w", pool.Name, err,
+		)
+	}
+
+	registry, err := s.registryGetter.GetStorageRegistry(ctx)
+	if err != nil {
+		return nil, nil, errors.Errorf("getting storage registry: %w", err)
+	}
+
+	sp, err := registry.StorageProvider(
+		internalstorage.ProviderType(pool.Provider))
+	if errors.Is(err, coreerrors.NotFound) {
+		return nil, nil, errors.Errorf(
+			"storage provider type %q not found for pool %q",
+			pool.Provider, pool.Name,
+		).Add(domainstorageerrors.ProviderTypeNotFound)
+	} else if err != nil {
+		return nil, nil, errors.Errorf("getting storage provider: %w", err)
+	}
+
+	return sp, poolConfig, nil
+}
+
 // getStorageResourceTagsForModel returns the tags to apply to storage in this
 // model.
 func (s *StorageService) getStorageResourceTagsForModel(ctx context.Context) (
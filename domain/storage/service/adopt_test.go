@@ -23,7 +23,7 @@ import (
 )
 
 // adoptFilesystemSuite is a test suite for asserting the functionality of
-// [StorageService.AdoptFilesystem].
+// [StorageService.AdoptFilesystem] and [StorageService.AdoptVolume].
 type adoptFilesystemSuite struct {
 	state            *MockState
 	registry         *MockProviderRegistry
@@ -616,3 +616,204 @@ func (s *adoptFilesystemSuite) TestAdoptFilesystemFilesystemNotFoundOnProvider(c
 	_, err := svc.AdoptFilesystem(ctx, storageName, poolUUID, providerID, false)
 	c.Assert(err, tc.ErrorIs, domainstorageerrors.StorageEntityNotFoundInPool)
 }
+
+// TestAdoptVolumeSuccess tests that adopting a model scoped volume creates a
+// block storage instance with the imported volume details.
+func (s *adoptFilesystemSuite) TestAdoptVolumeSuccess(c *tc.C) {
+	ctx := c.Context()
+	defer s.setupMocks(c).Finish()
+
+	now := time.Now().UTC()
+
+	poolUUID := tc.Must(c, domainstorage.NewStoragePoolUUID)
+	pool := domainstorage.StoragePool{
+		Name:     "pool1",
+		Provider: "provider1",
+		Attrs:    map[string]string{},
+	}
+	providerID := "vol-123"
+	storageName := domainstorage.Name("mystorage")
+
+	s.state.EXPECT().GetStoragePool(ctx, poolUUID).Return(pool, nil)
+	s.registry.EXPECT().StorageProvider(
+		internalstorage.ProviderType("provider1"),
+	).Return(s.provider, nil)
+	s.provider.EXPECT().Supports(
+		internalstorage.StorageKindBlock).Return(true).AnyTimes()
+	s.provider.EXPECT().Scope().Return(internalstorage.ScopeEnviron)
+	s.provider.EXPECT().VolumeSource(gomock.Any()).Return(s.volumeSource, nil)
+	s.state.EXPECT().GetStorageResourceTagInfoForModel(
+		ctx, config.ResourceTagsKey,
+	).Return(domainstorageprovisioning.ModelResourceTagInfo{
+		ControllerUUID: "controller-uuid",
+		ModelUUID:      "model-uuid",
+	}, nil)
+
+	expectedTags := map[string]string{
+		tags.JujuController: "controller-uuid",
+		tags.JujuModel:      "model-uuid",
+	}
+	s.volumeSource.MockVolumeImporter.EXPECT().ImportVolume(
+		ctx, providerID, storageName.String(), expectedTags, true,
+	).Return(internalstorage.VolumeInfo{
+		VolumeId:   providerID,
+		Size:       2048,
+		HardwareId: "hw-id",
+		WWN:        "wwn-123",
+		Persistent: true,
+	}, nil)
+
+	args := domainstorageinternal.CreateStorageInstanceWithExistingVolume{
+		Name:                 storageName,
+		RequestedSizeMiB:     2048,
+		StoragePoolUUID:      poolUUID,
+		VolumeProvisionScope: domainstorage.ProvisionScopeModel,
+		VolumeProviderID:     providerID,
+		VolumeSize:           2048,
+		VolumeHardwareID:     "hw-id",
+		VolumeWWN:            "wwn-123",
+		VolumePersistent:     true,
+		VolumeStatusID:       5,
+		VolumeStatusMessage:  "volume imported",
+	}
+	mc := tc.NewMultiChecker()
+	mc.AddExpr(`_.UUID`, tc.IsNonZeroUUID)
+	mc.AddExpr(`_.VolumeUUID`, tc.IsNonZeroUUID)
+	mc.AddExpr(`_.VolumeStatusUpdatedAt`, tc.Or(tc.After, tc.Equals), now)
+	s.state.EXPECT().CreateStorageInstanceWithExistingVolume(
+		ctx, tc.Bind(mc, args),
+	).Return("mystorage/1", nil)
+
+	svc := s.makeService()
+	id, err := svc.AdoptVolume(ctx, storageName, poolUUID, providerID, true)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(id, tc.Equals, corestorage.ID("mystorage/1"))
+}
+
+// TestAdoptVolumeInvalidStorageName tests that an invalid storage name is
+// rejected before any pool lookup.
+func (s *adoptFilesystemSuite) TestAdoptVolumeInvalidStorageName(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	poolUUID := tc.Must(c, domainstorage.NewStoragePoolUUID)
+	svc := s.makeService()
+	_, err := svc.AdoptVolume(
+		c.Context(), "", poolUUID, "vol-123", false)
+	c.Assert(err, tc.ErrorIs, domainstorageerrors.InvalidStorageName)
+}
+
+// TestAdoptVolumeBlockNotSupported tests that adopting a volume from a
+// provider that does not support block storage is not supported.
+func (s *adoptFilesystemSuite) TestAdoptVolumeBlockNotSupported(c *tc.C) {
+	ctx := c.Context()
+	defer s.setupMocks(c).Finish()
+
+	poolUUID := tc.Must(c, domainstorage.NewStoragePoolUUID)
+	pool := domainstorage.StoragePool{
+		Name:     "pool1",
+		Provider: "provider1",
+		Attrs:    map[string]string{},
+	}
+
+	s.state.EXPECT().GetStoragePool(ctx, poolUUID).Return(pool, nil)
+	s.registry.EXPECT().StorageProvider(
+		internalstorage.ProviderType("provider1")).Return(s.provider, nil)
+	s.provider.EXPECT().Supports(
+		internalstorage.StorageKindBlock).Return(false)
+
+	svc := s.makeService()
+	_, err := svc.AdoptVolume(
+		ctx, domainstorage.Name("mystorage"), poolUUID, "vol-123", false)
+	c.Assert(err, tc.ErrorIs, domainstorageerrors.AdoptionNotSupported)
+}
+
+// TestAdoptVolumeMachineScopedNotSupported tests that adopting a machine
+// scoped volume is not supported.
+func (s *adoptFilesystemSuite) TestAdoptVolumeMachineScopedNotSupported(c *tc.C) {
+	ctx := c.Context()
+	defer s.setupMocks(c).Finish()
+
+	poolUUID := tc.Must(c, domainstorage.NewStoragePoolUUID)
+	pool := domainstorage.StoragePool{
+		Name:     "pool1",
+		Provider: "provider1",
+		Attrs:    map[string]string{},
+	}
+
+	s.state.EXPECT().GetStoragePool(ctx, poolUUID).Return(pool, nil)
+	s.registry.EXPECT().StorageProvider(
+		internalstorage.ProviderType("provider1")).Return(s.provider, nil)
+	s.provider.EXPECT().Supports(
+		internalstorage.StorageKindBlock).Return(true).AnyTimes()
+	s.provider.EXPECT().Scope().Return(internalstorage.ScopeMachine)
+
+	svc := s.makeService()
+	_, err := svc.AdoptVolume(
+		ctx, domainstorage.Name("mystorage"), poolUUID, "vol-123", false)
+	c.Assert(err, tc.ErrorIs, domainstorageerrors.AdoptionNotSupported)
+}
+
+// TestAdoptVolumeSourceNotImporter tests that when the volume source does not
+// implement VolumeImporter, an error is returned.
+func (s *adoptFilesystemSuite) TestAdoptVolumeSourceNotImporter(c *tc.C) {
+	ctx := c.Context()
+	defer s.setupMocks(c).Finish()
+
+	poolUUID := tc.Must(c, domainstorage.NewStoragePoolUUID)
+	pool := domainstorage.StoragePool{
+		Name:     "pool1",
+		Provider: "provider1",
+		Attrs:    map[string]string{},
+	}
+
+	s.state.EXPECT().GetStoragePool(ctx, poolUUID).Return(pool, nil)
+	s.registry.EXPECT().StorageProvider(
+		internalstorage.ProviderType("provider1")).Return(s.provider, nil)
+	s.provider.EXPECT().Supports(
+		internalstorage.StorageKindBlock).Return(true).AnyTimes()
+	s.provider.EXPECT().Scope().Return(internalstorage.ScopeEnviron)
+	s.provider.EXPECT().VolumeSource(
+		gomock.Any()).Return(s.volumeSource.MockVolumeSource, nil)
+
+	svc := s.makeService()
+	_, err := svc.AdoptVolume(
+		ctx, domainstorage.Name("mystorage"), poolUUID, "vol-123", false)
+	c.Assert(err, tc.ErrorIs, domainstorageerrors.AdoptionNotSupported)
+}
+
+// TestAdoptVolumeNotFoundOnProvider tests that when the volume importer
+// returns NotFound, the appropriate error is returned.
+func (s *adoptFilesystemSuite) TestAdoptVolumeNotFoundOnProvider(c *tc.C) {
+	ctx := c.Context()
+	defer s.setupMocks(c).Finish()
+
+	poolUUID := tc.Must(c, domainstorage.NewStoragePoolUUID)
+	pool := domainstorage.StoragePool{
+		Name:     "pool1",
+		Provider: "provider1",
+		Attrs:    map[string]string{},
+	}
+	providerID := "vol-123"
+	storageName := domainstorage.Name("mystorage")
+
+	s.state.EXPECT().GetStoragePool(ctx, poolUUID).Return(pool, nil)
+	s.registry.EXPECT().StorageProvider(
+		internalstorage.ProviderType("provider1")).Return(s.provider, nil)
+	s.provider.EXPECT().Supports(
+		internalstorage.StorageKindBlock).Return(true).AnyTimes()
+	s.provider.EXPECT().Scope().Return(internalstorage.ScopeEnviron)
+	s.provider.EXPECT().VolumeSource(gomock.Any()).Return(s.volumeSource, nil)
+	s.state.EXPECT().GetStorageResourceTagInfoForModel(
+		ctx, config.ResourceTagsKey,
+	).Return(domainstorageprovisioning.ModelResourceTagInfo{
+		ControllerUUID: "controller-uuid",
+		ModelUUID:      "model-uuid",
+	}, nil)
+	s.volumeSource.MockVolumeImporter.EXPECT().ImportVolume(
+		ctx, providerID, storageName.String(), gomock.Any(), false,
+	).Return(internalstorage.VolumeInfo{}, coreerrors.NotFound)
+
+	svc := s.makeService()
+	_, err := svc.AdoptVolume(ctx, storageName, poolUUID, providerID, false)
+	c.Assert(err, tc.ErrorIs, domainstorageerrors.StorageEntityNotFoundInPool)
+}
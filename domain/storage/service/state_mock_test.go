@@ -32,6 +32,7 @@ type MockState struct {
 type MockStateMockRecorder struct {
 	mock                                                           *MockState
 	createStorageInstanceWithExistingFilesystemExpects             []*gomock.Call2_2[context.Context, internal.CreateStorageInstanceWithExistingFilesystem, string, error]
+	createStorageInstanceWithExistingVolumeExpects                 []*gomock.Call2_2[context.Context, internal.CreateStorageInstanceWithExistingVolume, string, error]
 	createStorageInstanceWithExistingVolumeBackedFilesystemExpects []*gomock.Call2_2[context.Context, internal.CreateStorageInstanceWithExistingVolumeBackedFilesystem, string, error]
 	createStoragePoolExpects                                       []*gomock.Call2_1[context.Context, internal.CreateStoragePool, error]
 	deleteStoragePoolExpects                                       []*gomock.Call2_1[context.Context, string, error]
@@ -83,6 +84,24 @@ func (mr *MockStateMockRecorder) CreateStorageInstanceWithExistingFilesystem(ctx
 // MockStateCreateStorageInstanceWithExistingFilesystemCall is the typed call wrapper for CreateStorageInstanceWithExistingFilesystem.
 type MockStateCreateStorageInstanceWithExistingFilesystemCall = gomock.Call2_2[context.Context, internal.CreateStorageInstanceWithExistingFilesystem, string, error]
 
+// CreateStorageInstanceWithExistingVolume mocks base method.
+func (m *MockState) CreateStorageInstanceWithExistingVolume(ctx context.Context, args internal.CreateStorageInstanceWithExistingVolume) (string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.createStorageInstanceWithExistingVolumeExpects, m.ctrl, m, "CreateStorageInstanceWithExistingVolume", ctx, args)
+}
+
+// CreateStorageInstanceWithExistingVolume indicates an expected call of CreateStorageInstanceWithExistingVolume.
+func (mr *MockStateMockRecorder) CreateStorageInstanceWithExistingVolume(ctx, args any) *MockStateCreateStorageInstanceWithExistingVolumeCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, internal.CreateStorageInstanceWithExistingVolume, string, error](mr.mock.ctrl.T, mr.mock, "CreateStorageInstanceWithExistingVolume", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(args))
+	mr.createStorageInstanceWithExistingVolumeExpects = append(mr.createStorageInstanceWithExistingVolumeExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateCreateStorageInstanceWithExistingVolumeCall is the typed call wrapper for CreateStorageInstanceWithExistingVolume.
+type MockStateCreateStorageInstanceWithExistingVolumeCall = gomock.Call2_2[context.Context, internal.CreateStorageInstanceWithExistingVolume, string, error]
+
 // CreateStorageInstanceWithExistingVolumeBackedFilesystem mocks base method.
 func (m *MockState) CreateStorageInstanceWithExistingVolumeBackedFilesystem(ctx context.Context, args internal.CreateStorageInstanceWithExistingVolumeBackedFilesystem) (string, error) {
 	m.ctrl.T.Helper()
@@ -451,3 +451,144 @@ VALUES ($insertStorageVolumeStatus.*)
 
 	return storageID, nil
 }
+
+// CreateStorageInstanceWithExistingVolume creates a new block storage
+// instance, with a volume using existing provisioned volume details. It
+// returns the new storage ID for the created storage instance.
+//
+// The following errors can be expected:
+// - [domainstorageerrors.StoragePoolNotFound] if a pool with the specified UUID
+// does not exist.
+func (st *State) CreateStorageInstanceWithExistingVolume(
+	ctx context.Context,
+	args domainstorageinternal.CreateStorageInstanceWithExistingVolume,
+) (string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	insertStorageInstanceStmt, err := st.Prepare(`
+INSERT INTO storage_instance (*)
+VALUES ($insertStorageInstance.*)
+`, insertStorageInstance{})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	insertVolumeStmt, err := st.Prepare(`
+INSERT INTO storage_volume (*)
+VALUES ($insertStorageVolume.*)
+`, insertStorageVolume{})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	storageInstanceVolume := insertStorageInstanceVolume{
+		StorageInstanceUUID: args.UUID.String(),
+		StorageVolumeUUID:   args.VolumeUUID.String(),
+	}
+	insertVolumeLinkStmt, err := st.Prepare(`
+INSERT INTO storage_instance_volume (*)
+VALUES ($insertStorageInstanceVolume.*)
+`, storageInstanceVolume)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	storageVolumeStatus := insertStorageVolumeStatus{
+		StorageVolumeUUID: args.VolumeUUID.String(),
+		StatusID:          args.VolumeStatusID,
+		Message:           args.VolumeStatusMessage,
+		UpdatedAt:         args.VolumeStatusUpdatedAt,
+	}
+	insertVolumeStatusStmt, err := st.Prepare(`
+INSERT INTO storage_volume_status (*)
+VALUES ($insertStorageVolumeStatus.*)
+`, storageVolumeStatus)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	var storageID string
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		storageID = ""
+
+		exists, err := st.checkStoragePoolExists(
+			ctx, tx, args.StoragePoolUUID.String())
+		if err != nil {
+			return errors.Errorf(
+				"checking if storage pool %q exists: %w",
+				args.StoragePoolUUID, err,
+			)
+		} else if !exists {
+			return errors.Errorf(
+				"storage pool %q does not exist", args.StoragePoolUUID,
+			).Add(domainstorageerrors.StoragePoolNotFound)
+		}
+
+		storageSeq, err := sequencestate.NextValue(
+			ctx, st, tx, domainstorage.StorageInstanceSequenceNamespace,
+		)
+		if err != nil {
+			return errors.Errorf("creating unique storage instance id: %w", err)
+		}
+		storageIDToInsert := corestorage.MakeID(
+			corestorage.Name(args.Name), storageSeq,
+		).String()
+
+		volumeSeq, err := sequencestate.NextValue(
+			ctx, st, tx, domainstorage.VolumeSequenceNamespace,
+		)
+		if err != nil {
+			return errors.Errorf("creating unique volume id: %w", err)
+		}
+		volumeID := fmt.Sprintf("%d", volumeSeq)
+
+		storageInstanceToInsert := insertStorageInstance{
+			UUID:             args.UUID.String(),
+			StorageName:      args.Name.String(),
+			StorageKindID:    int(domainstorage.StorageKindBlock),
+			StorageID:        storageIDToInsert,
+			LifeID:           int(life.Alive),
+			StoragePoolUUID:  args.StoragePoolUUID.String(),
+			RequestedSizeMiB: args.RequestedSizeMiB,
+		}
+		volumeToInsert := insertStorageVolume{
+			UUID:             args.VolumeUUID.String(),
+			VolumeID:         volumeID,
+			LifeID:           int(life.Alive),
+			ProvisionScopeID: int(args.VolumeProvisionScope),
+			ProviderID:       args.VolumeProviderID,
+			SizeMiB:          args.VolumeSize,
+			HardwareID:       args.VolumeHardwareID,
+			WWN:              args.VolumeWWN,
+			Persistent:       args.VolumePersistent,
+		}
+
+		err = tx.Query(ctx, insertStorageInstanceStmt, storageInstanceToInsert).Run()
+		if err != nil {
+			return errors.Errorf("inserting storage instance: %w", err)
+		}
+		err = tx.Query(ctx, insertVolumeStmt, volumeToInsert).Run()
+		if err != nil {
+			return errors.Errorf("inserting volume: %w", err)
+		}
+		err = tx.Query(ctx, insertVolumeLinkStmt, storageInstanceVolume).Run()
+		if err != nil {
+			return errors.Errorf("linking storage instance to volume: %w", err)
+		}
+		err = tx.Query(ctx, insertVolumeStatusStmt, storageVolumeStatus).Run()
+		if err != nil {
+			return errors.Errorf("inserting storage volume status: %w", err)
+		}
+
+		storageID = storageIDToInsert
+		return nil
+	})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	return storageID, nil
+}
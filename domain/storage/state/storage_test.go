@@ -429,3 +429,129 @@ func (s *storageSuite) TestCreateStorageInstanceWithExistingVolumeBackedFilesyst
 	_, err := st.CreateStorageInstanceWithExistingVolumeBackedFilesystem(c.Context(), args)
 	c.Check(err, tc.ErrorIs, domainstorageerrors.StoragePoolNotFound)
 }
+
+// TestCreateStorageInstanceWithExistingVolume asserts that a block storage
+// instance is created with the supplied existing volume, its status and no
+// filesystem.
+func (s *storageSuite) TestCreateStorageInstanceWithExistingVolume(c *tc.C) {
+	poolUUID := s.newStoragePool(c, "mypool", "myprovider", nil)
+
+	storageInstanceUUID := tc.Must(c, domainstorage.NewStorageInstanceUUID)
+	volumeUUID := tc.Must(c, domainstorage.NewVolumeUUID)
+	now := time.Now().UTC()
+
+	args := domainstorageinternal.CreateStorageInstanceWithExistingVolume{
+		UUID:                  storageInstanceUUID,
+		Name:                  domainstorage.Name("disk"),
+		StoragePoolUUID:       poolUUID,
+		RequestedSizeMiB:      4096,
+		VolumeUUID:            volumeUUID,
+		VolumeProvisionScope:  domainstorage.ProvisionScopeModel,
+		VolumeSize:            4096,
+		VolumeProviderID:      "vol-xyz789",
+		VolumeHardwareID:      "hw-001",
+		VolumeWWN:             "wwn-002",
+		VolumePersistent:      true,
+		VolumeStatusID:        2,
+		VolumeStatusMessage:   "vol-ready",
+		VolumeStatusUpdatedAt: now,
+	}
+
+	st := NewState(s.TxnRunnerFactory())
+	storageID, err := st.CreateStorageInstanceWithExistingVolume(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(storageID, tc.Matches, "disk/[0-9]+")
+
+	var (
+		gotStorageKindID int
+		gotRequestedSize uint64
+		gotPoolUUID      string
+	)
+	err = s.DB().QueryRow(`
+SELECT storage_kind_id, requested_size_mib, storage_pool_uuid
+FROM storage_instance
+WHERE uuid = ?
+`, storageInstanceUUID.String()).Scan(
+		&gotStorageKindID,
+		&gotRequestedSize,
+		&gotPoolUUID,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(gotStorageKindID, tc.Equals, int(domainstorage.StorageKindBlock))
+	c.Check(gotRequestedSize, tc.Equals, uint64(4096))
+	c.Check(gotPoolUUID, tc.Equals, poolUUID.String())
+
+	var (
+		gotVolProvisionScopeID int
+		gotVolumeProviderID    string
+		gotVolumeSize          uint64
+		gotVolumeHardwareID    string
+		gotVolumeWWN           string
+		gotVolumePersistent    bool
+	)
+	err = s.DB().QueryRow(`
+SELECT sv.provision_scope_id, sv.provider_id, sv.size_mib, sv.hardware_id,
+       sv.wwn, sv.persistent
+FROM storage_volume sv
+JOIN storage_instance_volume siv ON siv.storage_volume_uuid = sv.uuid
+WHERE siv.storage_instance_uuid = ?
+`, storageInstanceUUID.String()).Scan(
+		&gotVolProvisionScopeID,
+		&gotVolumeProviderID,
+		&gotVolumeSize,
+		&gotVolumeHardwareID,
+		&gotVolumeWWN,
+		&gotVolumePersistent,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(gotVolProvisionScopeID, tc.Equals, int(domainstorage.ProvisionScopeModel))
+	c.Check(gotVolumeProviderID, tc.Equals, "vol-xyz789")
+	c.Check(gotVolumeSize, tc.Equals, uint64(4096))
+	c.Check(gotVolumeHardwareID, tc.Equals, "hw-001")
+	c.Check(gotVolumeWWN, tc.Equals, "wwn-002")
+	c.Check(gotVolumePersistent, tc.Equals, true)
+
+	var (
+		gotVolumeStatusID      int
+		gotVolumeStatusMessage string
+	)
+	err = s.DB().QueryRow(`
+SELECT status_id, message
+FROM storage_volume_status
+WHERE volume_uuid = ?
+`, volumeUUID.String()).Scan(
+		&gotVolumeStatusID,
+		&gotVolumeStatusMessage,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(gotVolumeStatusID, tc.Equals, 2)
+	c.Check(gotVolumeStatusMessage, tc.Equals, "vol-ready")
+
+	var filesystemCount int
+	err = s.DB().QueryRow(`
+SELECT COUNT(*)
+FROM storage_instance_filesystem
+WHERE storage_instance_uuid = ?
+`, storageInstanceUUID.String()).Scan(&filesystemCount)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(filesystemCount, tc.Equals, 0)
+}
+
+// TestCreateStorageInstanceWithExistingVolumePoolNotFound asserts that when
+// the storage pool does not exist, a StoragePoolNotFound error is returned.
+func (s *storageSuite) TestCreateStorageInstanceWithExistingVolumePoolNotFound(c *tc.C) {
+	args := domainstorageinternal.CreateStorageInstanceWithExistingVolume{
+		UUID:                 tc.Must(c, domainstorage.NewStorageInstanceUUID),
+		Name:                 domainstorage.Name("disk"),
+		StoragePoolUUID:      tc.Must(c, domainstorage.NewStoragePoolUUID),
+		RequestedSizeMiB:     2048,
+		VolumeUUID:           tc.Must(c, domainstorage.NewVolumeUUID),
+		VolumeProvisionScope: domainstorage.ProvisionScopeModel,
+		VolumeSize:           2048,
+		VolumeProviderID:     "vol-xyz789",
+	}
+
+	st := NewState(s.TxnRunnerFactory())
+	_, err := st.CreateStorageInstanceWithExistingVolume(c.Context(), args)
+	c.Check(err, tc.ErrorIs, domainstorageerrors.StoragePoolNotFound)
+}
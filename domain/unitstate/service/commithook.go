@@ -238,8 +238,10 @@ func (s *LeadershipService) prepareSecretCreates(
 		}
 
 		if len(create.Data) > 0 {
-			p.Data = make(coresecrets.SecretData)
-			maps.Copy(p.Data, create.Data)
+			p.Data, err = s.secretEncrypter.EncryptSecretContent(ctx, create.Data)
+			if err != nil {
+				return nil, nil, errors.Errorf("encrypting content for create[%d]: %w", i, err)
+			}
 		}
 
 		rotatePolicy := domainsecret.MarshallRotatePolicy(create.RotatePolicy)
@@ -359,8 +361,11 @@ func (s *LeadershipService) prepareSecretUpdates(
 			arg.Label = update.Label
 		}
 		if len(update.Data) > 0 {
-			arg.Data = make(map[string]string, len(update.Data))
-			maps.Copy(arg.Data, update.Data)
+			data, err := s.secretEncrypter.EncryptSecretContent(ctx, update.Data)
+			if err != nil {
+				return nil, nil, errors.Errorf("encrypting content for update[%d]: %w", i, err)
+			}
+			arg.Data = data
 		}
 		if update.ValueRef != nil {
 			arg.ValueRefBackendID = update.ValueRef.BackendID
@@ -38,6 +38,7 @@ type commitHookSuite struct {
 	leadershipEnsurer     *MockEnsurer
 	secretBackend         *MockSecretBackendReferenceMutator
 	secretGrantAuthorizer *MockSecretGrantAuthorizer
+	secretEncrypter       *fakeSecretEncrypter
 	clock                 *testclock.Clock
 	uuidGen               func() (uuid.UUID, error)
 }
@@ -570,6 +571,71 @@ func (s *commitHookSuite) TestPrepareSecretUpdatesAlwaysAddsBackendRef(c *tc.C)
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *commitHookSuite) TestPrepareSecretUpdatesEncryptsContent(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.secretEncrypter.prefix = "enc:"
+	unitName := unittesting.GenNewName(c, "test/0")
+	unitUUID := tc.Must(c, coreunit.NewUUID)
+	unitInfo := internal.CommitHookUnitInfo{UnitUUID: unitUUID.String()}
+	s.st.EXPECT().GetCommitHookUnitInfo(gomock.Any(), unitName.String()).Return(unitInfo, nil)
+	s.st.EXPECT().GetModelUUID(gomock.Any()).Return("model-uuid", nil)
+
+	uri := coresecrets.NewURI()
+
+	s.secretBackend.EXPECT().AddSecretBackendReference(
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+	)
+	var got internal.CommitHookChangesArg
+	s.st.EXPECT().CommitHookChanges(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, arg internal.CommitHookChangesArg) error {
+			got = arg
+			return nil
+		})
+
+	arg := unitstate.CommitHookChangesArg{
+		UnitName: unitName,
+		SecretUpdates: []unitstate.UpdateSecretArg{{
+			URI: uri,
+			UpdateCharmSecretParams: secret.UpdateCharmSecretParams{
+				Data:     map[string]string{"key": "value"},
+				Checksum: "new-checksum",
+			},
+		}},
+	}
+
+	err := s.svc.CommitHookChanges(c.Context(), arg)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(got.SecretUpdates, tc.HasLen, 1)
+	c.Check(got.SecretUpdates[0].Data, tc.DeepEquals, map[string]string{"key": "enc:value"})
+	c.Check(arg.SecretUpdates[0].Data, tc.DeepEquals, coresecrets.SecretData{"key": "value"})
+}
+
+func (s *commitHookSuite) TestPrepareSecretUpdatesEncryptFailure(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.secretEncrypter.err = errors.New("kms unavailable")
+	unitName := unittesting.GenNewName(c, "test/0")
+	unitUUID := tc.Must(c, coreunit.NewUUID)
+	unitInfo := internal.CommitHookUnitInfo{UnitUUID: unitUUID.String()}
+	s.st.EXPECT().GetCommitHookUnitInfo(gomock.Any(), unitName.String()).Return(unitInfo, nil)
+	s.st.EXPECT().GetModelUUID(gomock.Any()).Return("model-uuid", nil)
+
+	arg := unitstate.CommitHookChangesArg{
+		UnitName: unitName,
+		SecretUpdates: []unitstate.UpdateSecretArg{{
+			URI: coresecrets.NewURI(),
+			UpdateCharmSecretParams: secret.UpdateCharmSecretParams{
+				Data:     map[string]string{"key": "value"},
+				Checksum: "new-checksum",
+			},
+		}},
+	}
+
+	err := s.svc.CommitHookChanges(c.Context(), arg)
+	c.Assert(err, tc.ErrorMatches, `encrypting content for update\[0\]: kms unavailable`)
+}
+
 func (s *commitHookSuite) TestPrepareSecretUpdatesDifferentChecksumAddsBackendRef(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1198,6 +1264,7 @@ func (s *commitHookSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.leadershipEnsurer = NewMockEnsurer(ctrl)
 	s.secretBackend = NewMockSecretBackendReferenceMutator(ctrl)
 	s.secretGrantAuthorizer = NewMockSecretGrantAuthorizer(ctrl)
+	s.secretEncrypter = &fakeSecretEncrypter{}
 	s.clock = testclock.NewClock(time.Now())
 	s.uuidGen = uuid.NewUUID
 
@@ -1205,6 +1272,7 @@ func (s *commitHookSuite) setupMocks(c *tc.C) *gomock.Controller {
 		s.st,
 		s.secretBackend,
 		s.secretGrantAuthorizer,
+		s.secretEncrypter,
 		s.leadershipEnsurer,
 		s.clock,
 		loggertesting.WrapCheckLog(c),
@@ -1220,3 +1288,21 @@ func (s *commitHookSuite) setupMocks(c *tc.C) *gomock.Controller {
 
 	return ctrl
 }
+
+// fakeSecretEncrypter "encrypts" secret content by adding a prefix to each
+// value. With no prefix, content is stored as given.
+type fakeSecretEncrypter struct {
+	prefix string
+	err    error
+}
+
+func (f *fakeSecretEncrypter) EncryptSecretContent(_ context.Context, data map[string]string) (map[string]string, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	result := make(map[string]string, len(data))
+	for k, v := range data {
+		result[k] = f.prefix + v
+	}
+	return result, nil
+}
@@ -77,6 +77,14 @@ type SecretGrantAuthorizer interface {
 	GetSecretOwnerKinds(ctx context.Context, uris []*coresecrets.URI) ([]secret.SecretOwnerInfo, error)
 }
 
+// SecretContentEncrypter encrypts secret content before it is stored in
+// the model database.
+type SecretContentEncrypter interface {
+	// EncryptSecretContent returns the secret content encrypted for
+	// storage in the model database.
+	EncryptSecretContent(ctx context.Context, data map[string]string) (map[string]string, error)
+}
+
 // UnitStateState defines a persistence layer interface for retrieving
 // and persisting unit agent state.
 type UnitStateState interface {
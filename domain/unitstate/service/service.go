@@ -33,6 +33,7 @@ type LeadershipService struct {
 	leaderEnsurer         leadership.Ensurer
 	secretBackendState    SecretBackendReferenceMutator
 	secretGrantAuthorizer SecretGrantAuthorizer
+	secretEncrypter       SecretContentEncrypter
 	clock                 clock.Clock
 	uuidGenerator         func() (uuid.UUID, error)
 	logger                logger.Logger
@@ -44,6 +45,7 @@ func NewLeadershipService(
 	st State,
 	secretBackendState SecretBackendReferenceMutator,
 	secretGrantAuthorizer SecretGrantAuthorizer,
+	secretEncrypter SecretContentEncrypter,
 	leaderEnsurer leadership.Ensurer,
 	clk clock.Clock,
 	logger logger.Logger,
@@ -53,6 +55,7 @@ func NewLeadershipService(
 		leaderEnsurer:         leaderEnsurer,
 		secretBackendState:    secretBackendState,
 		secretGrantAuthorizer: secretGrantAuthorizer,
+		secretEncrypter:       secretEncrypter,
 		clock:                 clk,
 		uuidGenerator:         uuid.NewUUID,
 		logger:                logger,
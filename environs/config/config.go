@@ -197,6 +197,10 @@ const (
 	// for containers.
 	ContainerNetworkingMethodKey = "container-networking-method"
 
+	// MachineRecoveryPolicyKey is the key for the policy applied to machines
+	// whose cloud instance has stopped or disappeared.
+	MachineRecoveryPolicyKey = "machine-recovery-policy"
+
 	// StorageDefaultBlockSourceKey is the key for the default block storage source.
 	StorageDefaultBlockSourceKey = "storage-default-block-source"
 
@@ -242,6 +246,10 @@ const (
 	// grow to before it is pruned, eg "5M"
 	MaxActionResultsSize = "max-action-results-size"
 
+	// MaxSecretAccessLogAge is the maximum age of secret access log entries
+	// to keep when pruning, eg "720h"
+	MaxSecretAccessLogAge = "max-secret-access-log-age"
+
 	// UpdateStatusHookInterval is how often to run the update-status hook.
 	UpdateStatusHookInterval = "update-status-hook-interval"
 
@@ -431,6 +439,14 @@ const (
 	// DefaultActionResultsSize is the default size of the action results.
 	DefaultActionResultsSize = "5G"
 
+	// DefaultSecretAccessLogAge is the default for the age of secret
+	// access log entries.
+	DefaultSecretAccessLogAge = "2160h" // 90 days
+
+	// DefaultMachineRecoveryPolicy is the default machine recovery policy,
+	// which leaves failed machines for the operator to deal with.
+	DefaultMachineRecoveryPolicy = "none"
+
 	// DefaultLxdSnapChannel is the default lxd snap channel to install on host vms.
 	DefaultLxdSnapChannel = "5.0/stable"
 
@@ -464,6 +480,7 @@ var defaultConfigValues = map[string]any{
 	// $ juju model-config net-bond-reconfigure-delay=30
 	NetBondReconfigureDelayKey:   17,
 	ContainerNetworkingMethodKey: "",
+	MachineRecoveryPolicyKey:     DefaultMachineRecoveryPolicy,
 
 	DefaultBaseKey: "",
 
@@ -523,6 +540,9 @@ var defaultConfigValues = map[string]any{
 	MaxActionResultsAge:  DefaultActionResultsAge,
 	MaxActionResultsSize: DefaultActionResultsSize,
 
+	// Secret access log settings
+	MaxSecretAccessLogAge: DefaultSecretAccessLogAge,
+
 	// Model firewall settings
 	SSHAllowKey:         "0.0.0.0/0,::/0",
 	SAASIngressAllowKey: "0.0.0.0/0,::/0",
@@ -681,6 +701,16 @@ func Validate(_ctx context.Context, cfg, old *Config) error {
 		}
 	}
 
+	if v, ok := cfg.defined[MaxSecretAccessLogAge].(string); ok {
+		age, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Annotate(err, "invalid max secret access log age in model configuration")
+		}
+		if age <= 0 {
+			return errors.NotValidf("max secret access log age %v", age)
+		}
+	}
+
 	if v, ok := cfg.defined[UpdateStatusHookInterval].(string); ok {
 		duration, err := time.ParseDuration(v)
 		if err != nil {
@@ -972,6 +1002,15 @@ func (c *Config) ContainerNetworkingMethod() coremodelconfig.ContainerNetworking
 	return coremodelconfig.ContainerNetworkingMethod(c.asString(ContainerNetworkingMethodKey))
 }
 
+// MachineRecoveryPolicy returns the policy applied to machines whose cloud
+// instance has stopped or disappeared.
+func (c *Config) MachineRecoveryPolicy() coremodelconfig.MachineRecoveryPolicy {
+	if v := c.asString(MachineRecoveryPolicyKey); v != "" {
+		return coremodelconfig.MachineRecoveryPolicy(v)
+	}
+	return DefaultMachineRecoveryPolicy
+}
+
 // LegacyProxySettings returns all four proxy settings; http, https, ftp, and no
 // proxy. These are considered legacy as using these values will cause the environment
 // to be updated, which has shown to not work in many cases. It is being kept to avoid
@@ -1517,6 +1556,18 @@ func (c *Config) MaxActionResultsAge() time.Duration {
 	return val
 }
 
+// MaxSecretAccessLogAge returns the maximum age of
+// secret access log entries to keep when pruning.
+func (c *Config) MaxSecretAccessLogAge() time.Duration {
+	v, _ := c.defined[MaxSecretAccessLogAge].(string)
+	if v == "" {
+		v = DefaultSecretAccessLogAge
+	}
+	// Value has already been validated.
+	val, _ := time.ParseDuration(v)
+	return val
+}
+
 func (c *Config) MaxActionResultsSizeMB() uint {
 	// Value has already been validated.
 	val, _ := utils.ParseSize(c.mustString(MaxActionResultsSize))
@@ -1682,8 +1733,10 @@ var alwaysOptional = schema.Defaults{
 	TransmitVendorMetricsKey:        schema.Omit,
 	NetBondReconfigureDelayKey:      schema.Omit,
 	ContainerNetworkingMethodKey:    schema.Omit,
+	MachineRecoveryPolicyKey:        schema.Omit,
 	MaxActionResultsAge:             schema.Omit,
 	MaxActionResultsSize:            schema.Omit,
+	MaxSecretAccessLogAge:           schema.Omit,
 	UpdateStatusHookInterval:        schema.Omit,
 	EgressSubnets:                   schema.Omit,
 	CloudInitUserDataKey:            schema.Omit,
@@ -1726,9 +1779,9 @@ func allDefaults() schema.Defaults {
 
 // immutableAttributes holds those attributes
 // which are not allowed to change in the lifetime
-// of an environment.
+// of an environment. The name is not among them, as
+// a model can be renamed.
 var immutableAttributes = []string{
-	NameKey,
 	TypeKey,
 	UUIDKey,
 	"firewall-mode",
@@ -16,6 +16,7 @@ import (
 	"github.com/juju/schema"
 	"github.com/juju/tc"
 
+	"github.com/juju/juju/core/modelconfig"
 	"github.com/juju/juju/core/semversion"
 	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/environs/config"
@@ -764,10 +765,6 @@ var validationTests = []validationTest{{
 	about: "Can't change the type",
 	new:   testing.Attrs{"type": "new-type"},
 	err:   `cannot change type from "my-type" to "new-type"`,
-}, {
-	about: "Can't change the name",
-	new:   testing.Attrs{"name": "new-name"},
-	err:   `cannot change name from "my-name" to "new-name"`,
 }, {
 	about: "Can't change the firewall-mode (global->instance)",
 	old:   testing.Attrs{"firewall-mode": config.FwGlobal},
@@ -1336,6 +1333,46 @@ func (s *ConfigSuite) TestUpdateStatusHookIntervalConfigValue(c *tc.C) {
 	c.Assert(cfg.UpdateStatusHookInterval(), tc.Equals, 30*time.Minute)
 }
 
+func (s *ConfigSuite) TestMaxSecretAccessLogAgeConfigDefault(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.MaxSecretAccessLogAge(), tc.Equals, 90*24*time.Hour)
+}
+
+func (s *ConfigSuite) TestMaxSecretAccessLogAgeConfigValue(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{
+		"max-secret-access-log-age": "720h",
+	})
+	c.Assert(cfg.MaxSecretAccessLogAge(), tc.Equals, 720*time.Hour)
+}
+
+func (s *ConfigSuite) TestMaxSecretAccessLogAgeConfigInvalid(c *tc.C) {
+	for _, v := range []string{"forever", "0s", "-1h"} {
+		_, err := config.New(config.UseDefaults, testing.FakeConfig().Merge(testing.Attrs{
+			"max-secret-access-log-age": v,
+		}))
+		c.Check(err, tc.ErrorMatches, ".*max secret access log age.*")
+	}
+}
+
+func (s *ConfigSuite) TestMachineRecoveryPolicyConfigDefault(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.MachineRecoveryPolicy(), tc.Equals, modelconfig.MachineRecoveryPolicyNone)
+}
+
+func (s *ConfigSuite) TestMachineRecoveryPolicyConfigValue(c *tc.C) {
+	cfg := newTestConfig(c, testing.Attrs{
+		"machine-recovery-policy": "reprovision",
+	})
+	c.Assert(cfg.MachineRecoveryPolicy(), tc.Equals, modelconfig.MachineRecoveryPolicyReprovision)
+}
+
+func (s *ConfigSuite) TestMachineRecoveryPolicyConfigInvalid(c *tc.C) {
+	_, err := config.New(config.UseDefaults, testing.FakeConfig().Merge(testing.Attrs{
+		"machine-recovery-policy": "rebuild",
+	}))
+	c.Check(err, tc.ErrorMatches, `machine-recovery-policy: expected one of \[none reprovision\], got "rebuild"`)
+}
+
 func (s *ConfigSuite) TestEgressSubnets(c *tc.C) {
 	cfg := newTestConfig(c, testing.Attrs{
 		"egress-subnets": "10.0.0.1/32, 192.168.1.1/16",
@@ -527,6 +527,21 @@ manually using:
 		Type:        configschema.Tstring,
 		Group:       configschema.EnvironGroup,
 	},
+	MachineRecoveryPolicyKey: {
+		Description: `The policy applied to machines whose cloud instance has stopped or disappeared - one of "none" or "reprovision".`,
+		Documentation: `
+- 'none' leaves the machine in error for the operator to deal with, for
+example with ` + "`juju reprovision-machine`" + `.
+
+- 'reprovision' shuts down the failed cloud instance and automatically
+reprovisions the machine onto a new one, re-attaching detachable storage
+and re-running the install of the units on the machine. A stopped instance
+is therefore terminated rather than started again. Attempts are retried
+with an increasing backoff and are shown in ` + "`juju status`" + `.`,
+		Type:   configschema.Tstring,
+		Values: []any{"none", "reprovision"},
+		Group:  configschema.EnvironGroup,
+	},
 	MaxActionResultsAge: {
 		Description: "The maximum age for action entries before they are pruned, in human-readable time format",
 		Type:        configschema.Tstring,
@@ -537,6 +552,11 @@ manually using:
 		Type:        configschema.Tstring,
 		Group:       configschema.EnvironGroup,
 	},
+	MaxSecretAccessLogAge: {
+		Description: "The maximum age for secret access log entries before they are pruned, in human-readable time format",
+		Type:        configschema.Tstring,
+		Group:       configschema.EnvironGroup,
+	},
 	UpdateStatusHookInterval: {
 		Description: "How often to run the charm update-status hook, in human-readable time format (default 5m, range 1-60m)",
 		Type:        configschema.Tstring,
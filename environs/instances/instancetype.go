@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"unicode"
 
 	"github.com/juju/juju/core/constraints"
 )
@@ -33,6 +34,10 @@ type InstanceType struct {
 	// True value indicates it supports Secure Encrypted Virtualization.
 	// False on the contrary.
 	IsSev bool
+	// Gpus is the number of GPUs attached to the instance type, and
+	// GpuType describes their model (e.g. "NVIDIA A100").
+	Gpus    uint64
+	GpuType string
 }
 
 // InstanceTypeNetworking hold relevant information about an instances
@@ -95,6 +100,12 @@ func (itype InstanceType) match(cons constraints.Value) (InstanceType, bool) {
 	if cons.HasVirtType() && (itype.VirtType == nil || *itype.VirtType != *cons.VirtType) {
 		return nothing, false
 	}
+	if itype.Gpus < cons.MinGpus() {
+		return nothing, false
+	}
+	if cons.HasGpuType() && !GpuTypeMatches(itype.GpuType, *cons.GpuType) {
+		return nothing, false
+	}
 	return itype, true
 }
 
@@ -176,6 +187,26 @@ func tagsMatch(wanted, have []string) bool {
 	return true
 }
 
+// GpuTypeMatches returns true if the GPU model described by have satisfies
+// the gpu-type constraint value wanted. Providers describe the same GPU in
+// different ways ("A100", "NVIDIA A100", "nvidia-tesla-a100"), so the
+// comparison is case-insensitive and every word of wanted must appear as a
+// word in have.
+func GpuTypeMatches(have, wanted string) bool {
+	haveWords := gpuTypeWords(have)
+	wantedWords := gpuTypeWords(wanted)
+	if len(haveWords) == 0 || len(wantedWords) == 0 {
+		return false
+	}
+	return tagsMatch(wantedWords, haveWords)
+}
+
+func gpuTypeWords(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
 // byCost is used to sort a slice of instance types by Cost.
 type byCost []InstanceType
 
@@ -228,6 +228,35 @@ var getInstanceTypesTest = []struct {
 		cons:           "virt-type=hvm",
 		expectedItypes: []string{"cc1.4xlarge", "cc2.8xlarge"},
 		itypesToUse:    nil,
+	}, {
+		about: "gpus",
+		cons:  "gpus=2",
+		itypesToUse: []InstanceType{
+			{Id: "4", Name: "it-4", Arch: "amd64", Mem: 8192, CpuCores: 8, Gpus: 4, GpuType: "NVIDIA A100", Cost: 400},
+			{Id: "3", Name: "it-3", Arch: "amd64", Mem: 8192, CpuCores: 8, Gpus: 2, GpuType: "NVIDIA T4", Cost: 200},
+			{Id: "2", Name: "it-2", Arch: "amd64", Mem: 8192, CpuCores: 8, Gpus: 1, GpuType: "NVIDIA A100", Cost: 100},
+			{Id: "1", Name: "it-1", Arch: "amd64", Mem: 8192, CpuCores: 8, Cost: 50},
+		},
+		expectedItypes: []string{"it-3", "it-4"},
+	}, {
+		about: "gpu-type implies at least one gpu",
+		cons:  "gpu-type=a100",
+		itypesToUse: []InstanceType{
+			{Id: "4", Name: "it-4", Arch: "amd64", Mem: 8192, CpuCores: 8, Gpus: 4, GpuType: "NVIDIA A100", Cost: 400},
+			{Id: "3", Name: "it-3", Arch: "amd64", Mem: 8192, CpuCores: 8, Gpus: 2, GpuType: "NVIDIA T4", Cost: 200},
+			{Id: "2", Name: "it-2", Arch: "amd64", Mem: 8192, CpuCores: 8, Gpus: 1, GpuType: "nvidia-tesla-a100", Cost: 100},
+			{Id: "1", Name: "it-1", Arch: "amd64", Mem: 8192, CpuCores: 8, Cost: 50},
+		},
+		expectedItypes: []string{"it-2", "it-4"},
+	}, {
+		about: "gpus and gpu-type",
+		cons:  "gpus=2 gpu-type=A100",
+		itypesToUse: []InstanceType{
+			{Id: "4", Name: "it-4", Arch: "amd64", Mem: 8192, CpuCores: 8, Gpus: 4, GpuType: "NVIDIA A100", Cost: 400},
+			{Id: "3", Name: "it-3", Arch: "amd64", Mem: 8192, CpuCores: 8, Gpus: 2, GpuType: "NVIDIA T4", Cost: 200},
+			{Id: "2", Name: "it-2", Arch: "amd64", Mem: 8192, CpuCores: 8, Gpus: 1, GpuType: "NVIDIA A100", Cost: 100},
+		},
+		expectedItypes: []string{"it-4"},
 	},
 }
 
@@ -268,6 +297,28 @@ func (s *instanceTypeSuite) TestGetMatchingInstanceTypesErrors(c *tc.C) {
 
 	_, err = MatchingInstanceTypes(instanceTypes, "test", constraints.MustParse("instance-type=dep.medium mem=8G"))
 	c.Check(err, tc.ErrorMatches, `no instance types in test matching constraints "instance-type=dep.medium mem=8192M"`)
+
+	_, err = MatchingInstanceTypes(instanceTypes, "test", constraints.MustParse("gpus=1"))
+	c.Check(err, tc.ErrorMatches, `no instance types in test matching constraints "gpus=1"`)
+}
+
+func (s *instanceTypeSuite) TestGpuTypeMatches(c *tc.C) {
+	for i, t := range []struct {
+		have, wanted string
+		match        bool
+	}{
+		{"NVIDIA A100", "a100", true},
+		{"NVIDIA A100", "nvidia-a100", true},
+		{"nvidia-tesla-a100", "A100", true},
+		{"nvidia-tesla-a100", "nvidia a100", true},
+		{"NVIDIA® A10", "nvidia-a10", true},
+		{"A10G", "a10", false},
+		{"NVIDIA A100", "h100", false},
+		{"", "a100", false},
+	} {
+		c.Logf("test %d: %q %q", i, t.have, t.wanted)
+		c.Check(GpuTypeMatches(t.have, t.wanted), tc.Equals, t.match)
+	}
 }
 
 var instanceTypeMatchTests = []struct {
@@ -10,6 +10,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -54,6 +55,10 @@ type ProvisionMachineArgs struct {
 	// machine.
 	PrivateKey string
 
+	// Constraints are recorded against the machine when it is added to
+	// the model. They describe the existing machine and don't change it.
+	Constraints constraints.Value
+
 	*params.UpdateBehavior
 }
 
@@ -45,6 +45,8 @@ func ProvisionMachine(ctx context.Context, args manual.ProvisionMachineArgs) (ma
 		return "", err
 	}
 
+	machineParams.Constraints = args.Constraints
+
 	// Inform Juju that the machine exists.
 	machineId, err = manual.RecordMachineInState(ctx, args.Client, *machineParams)
 	if err != nil {
@@ -21,6 +21,7 @@ import (
 	"github.com/juju/juju/api"
 	"github.com/juju/juju/core/arch"
 	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/semversion"
 	jujuversion "github.com/juju/juju/core/version"
@@ -46,6 +47,8 @@ func TestProvisionerSuite(t *stdtesting.T) {
 
 type mockMachineManager struct {
 	manual.ProvisioningClientAPI
+
+	added []params.AddMachineParams
 }
 
 func (m *mockMachineManager) ProvisioningScript(context.Context, params.ProvisioningScriptParams) (script string, err error) {
@@ -73,6 +76,7 @@ func (m *mockMachineManager) AddMachines(ctx context.Context, args []params.AddM
 	if len(a.Addrs) > 0 {
 		return nil, errors.Errorf("unexpected addresses: %v", a.Addrs)
 	}
+	m.added = append(m.added, a)
 	return []params.AddMachinesResult{{
 		Machine: "2",
 	}}, nil
@@ -155,6 +159,28 @@ func (s *provisionerSuite) TestProvisionMachine(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "error checking if provisioned: subprocess encountered error code 255")
 }
 
+func (s *provisionerSuite) TestProvisionMachineConstraints(c *tc.C) {
+	base := jujuversion.DefaultSupportedLTSBase()
+
+	args := s.getArgs(c)
+	args.User = "ubuntu"
+	args.Constraints = constraints.MustParse("spaces=alpha,^beta tags=rack-1")
+
+	defer fakeSSH{
+		Base:           base,
+		Arch:           arch.AMD64,
+		InitUbuntuUser: true,
+	}.install(c).Restore()
+
+	machineId, err := sshprovisioner.ProvisionMachine(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(machineId, tc.Equals, "2")
+
+	added := args.Client.(*mockMachineManager).added
+	c.Assert(added, tc.HasLen, 1)
+	c.Check(added[0].Constraints, tc.DeepEquals, args.Constraints)
+}
+
 func (s *provisionerSuite) TestProvisioningScript(c *tc.C) {
 	base := jujuversion.DefaultSupportedLTSBase()
 
@@ -217,6 +217,10 @@ type InstanceConfig struct {
 	// deployments. Empty means no X-Scope-OrgID header is sent.
 	LokiOrgID string
 
+	// LokiProtocol is the protocol spoken by the Loki endpoint, either
+	// "loki" or "otlp". Empty means "loki".
+	LokiProtocol string
+
 	// TracingHTTPEndpoint is the HTTP endpoint for the OpenTelemetry
 	// collector. Empty means no HTTP tracing endpoint is configured.
 	TracingHTTPEndpoint string
@@ -563,6 +567,21 @@ func (cfg *InstanceConfig) AgentConfig(
 		configParams.QueryTracingThreshold = cfg.ControllerConfig.QueryTracingThreshold()
 		configParams.DqliteBusyTimeout = cfg.ControllerConfig.DqliteBusyTimeout()
 	}
+	if cfg.IsController() && cfg.ControllerConfig != nil {
+		// Controllers write a log file per model; seed its rotation
+		// settings from the controller config.
+		values := maps.Clone(cfg.AgentEnvironment)
+		if values == nil {
+			values = make(map[string]string)
+		}
+		if _, ok := values[agent.ModelLogfileMaxSize]; !ok {
+			values[agent.ModelLogfileMaxSize] = fmt.Sprintf("%dM", cfg.ControllerConfig.ModelLogfileMaxSizeMB())
+		}
+		if _, ok := values[agent.ModelLogfileMaxBackups]; !ok {
+			values[agent.ModelLogfileMaxBackups] = strconv.Itoa(cfg.ControllerConfig.ModelLogfileMaxBackups())
+		}
+		configParams.Values = values
+	}
 	// Resolve the workload tracing config from the raw fields injected
 	// during provisioning. When no endpoint is configured the agent
 	// falls back to tracing disabled. Optional fields that are nil use
@@ -597,6 +616,7 @@ func (cfg *InstanceConfig) AgentConfig(
 	configParams.LokiCACert = cfg.LokiCACert
 	configParams.LokiInsecureSkipVerify = cfg.LokiInsecureSkipVerify
 	configParams.LokiOrgID = cfg.LokiOrgID
+	configParams.LokiProtocol = cfg.LokiProtocol
 	if cfg.Bootstrap == nil {
 		return agent.NewAgentConfig(configParams)
 	}
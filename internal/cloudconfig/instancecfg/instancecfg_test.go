@@ -146,6 +146,34 @@ func (*instancecfgSuite) TestAgentConfigLogParams(c *tc.C) {
 	c.Assert(config.AgentLogfileMaxBackups(), tc.Equals, 7)
 }
 
+func (*instancecfgSuite) TestAgentConfigModelLogfileSettings(c *tc.C) {
+	icfg := instancecfg.InstanceConfig{
+		APIInfo: &api.Info{
+			Addrs:    []string{"1.2.3.4:4321"},
+			CACert:   "cert",
+			ModelTag: names.NewModelTag(testing.ModelTag.Id()),
+			Password: "secret123",
+		},
+		ControllerConfig: controller.Config{
+			"model-logfile-max-size":    "20M",
+			"model-logfile-max-backups": 3,
+		},
+		ControllerTag: names.NewControllerTag(testing.ControllerTag.Id()),
+		DataDir:       "/path/to/datadir/",
+		Jobs:          []model.MachineJob{model.JobManageModel},
+	}
+	config, err := icfg.AgentConfig(names.NewMachineTag("0"), semversion.MustParse("1.2.3"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(config.Value(agent.ModelLogfileMaxSize), tc.Equals, "20M")
+	c.Check(config.Value(agent.ModelLogfileMaxBackups), tc.Equals, "3")
+	c.Check(icfg.AgentEnvironment, tc.IsNil)
+
+	icfg.Jobs = []model.MachineJob{model.JobHostUnits}
+	config, err = icfg.AgentConfig(names.NewMachineTag("1"), semversion.MustParse("1.2.3"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(config.Value(agent.ModelLogfileMaxSize), tc.Equals, "")
+}
+
 // TestAgentConfigLokiConfig verifies that the Loki endpoint and CA cert set on
 // the InstanceConfig reach the generated agent config so a newly provisioned
 // machine starts in the correct forwarding mode on first boot.
@@ -45,6 +45,7 @@ type LogTailer interface {
 // apply to log records in order to decide which to return.
 type LogTailerParams struct {
 	StartTime     time.Time
+	EndTime       time.Time
 	MinLevel      corelogger.Level
 	InitialLines  int
 	Firehose      bool
@@ -289,6 +290,9 @@ func (t *logTailer) includeRecord(rec corelogger.LogRecord) bool {
 	if rec.Time.Before(t.params.StartTime) {
 		return false
 	}
+	if !t.params.EndTime.IsZero() && rec.Time.After(t.params.EndTime) {
+		return false
+	}
 	if rec.Level < t.params.MinLevel {
 		return false
 	}
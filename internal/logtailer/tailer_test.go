@@ -102,6 +102,31 @@ func (s *TailerSuite) TestProcessReverseNoTail(c *tc.C) {
 	c.Assert(records, tc.DeepEquals, logRecords[2:])
 }
 
+func (s *TailerSuite) TestProcessTimeWindowNoTail(c *tc.C) {
+	testFileName := filepath.Join(c.MkDir(), "test.log")
+	err := os.WriteFile(testFileName, []byte(createLogFileContent(c)), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+
+	tailer, err := logtailer.NewLogTailer(coretesting.ModelTag.Id(), testFileName, logtailer.LogTailerParams{
+		NoTail:       true,
+		FromTheStart: true,
+		StartTime:    mustParseTime("2024-02-15 06:23:23"),
+		EndTime:      mustParseTime("2024-02-15 06:23:24"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	var records []corelogger.LogRecord
+	logs := tailer.Logs()
+	for {
+		rec, ok := <-logs
+		if !ok {
+			break
+		}
+		records = append(records, rec)
+	}
+	c.Assert(records, tc.DeepEquals, logRecords[1:3])
+}
+
 func (s *TailerSuite) fetchLogs(tailer logtailer.LogTailer, expected int) []corelogger.LogRecord {
 	var records []corelogger.LogRecord
 	timeout := time.After(testhelpers.LongWait)
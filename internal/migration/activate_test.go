@@ -131,6 +131,7 @@ func (g activationDomainServicesGetter) ServicesForModel(
 			cmrmodelstate.NewState(g.deps.ModelDB, modelUUID, g.deps.Clock, g.deps.Logger),
 			nil,
 			nil,
+			nil,
 			g.deps.Clock,
 			g.deps.Logger,
 		),
@@ -167,6 +168,7 @@ func (*controllerImportSuite) activateModel(
 			)
 		},
 		activationDomainServicesGetter{deps: deps},
+		deps.SecretKeyStore,
 		"",
 		deps.Logger,
 		deps.Clock,
@@ -565,6 +567,7 @@ func (*controllerImportSuite) commitActivation(
 			return coremodelmigration.NewScope(deps.ControllerDB, deps.ModelDB, nil, nil, modelUUID)
 		},
 		activationDomainServicesGetter{deps: deps, adopted: adopted},
+		deps.SecretKeyStore,
 		"",
 		deps.Logger,
 		deps.Clock,
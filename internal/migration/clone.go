@@ -0,0 +1,92 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package migration
+
+import (
+	"github.com/juju/errors"
+	goyaml "gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/core/permission"
+	domainexport "github.com/juju/juju/domain/export"
+	"github.com/juju/juju/domain/export/types/latest"
+	"github.com/juju/juju/domain/modelimport"
+	"github.com/juju/juju/rpc/params"
+)
+
+// CloneArgs describes the new model an assembled model is cloned into.
+type CloneArgs struct {
+	// ModelUUID is the UUID of the clone.
+	ModelUUID string
+
+	// ModelName is the name of the clone.
+	ModelName string
+
+	// WithoutUnits scales every application in the clone to zero.
+	WithoutUnits bool
+}
+
+// CloneEnvelope turns an assembled model into one that, when imported into
+// the same controller, creates a copy of the model with a fresh identity.
+// The clone keeps the model's applications, config, relations, constraints,
+// offers and secrets, but none of its machines or units, so that the clone
+// provisions from scratch. Charms, agent binaries and resources are kept,
+// so they are uploaded to the clone as for a migration.
+func CloneEnvelope(model AssembledModel, args CloneArgs) (AssembledModel, error) {
+	envelope := model.Envelope
+
+	decoded, err := domainexport.DecodePayload(envelope.PayloadVersion, envelope.Payload)
+	if err != nil {
+		return AssembledModel{}, errors.Trace(err)
+	}
+	payload, ok := decoded.(latest.ModelExport)
+	if !ok {
+		return AssembledModel{}, errors.NotSupportedf("cloning a model export at payload version %q", envelope.PayloadVersion)
+	}
+	cloned, err := modelimport.PrepareClone(payload, modelimport.CloneArgs{
+		ModelUUID:    args.ModelUUID,
+		ModelName:    args.ModelName,
+		WithoutUnits: args.WithoutUnits,
+	})
+	if err != nil {
+		return AssembledModel{}, errors.Trace(err)
+	}
+	envelope.Payload, err = goyaml.Marshal(cloned.Payload)
+	if err != nil {
+		return AssembledModel{}, errors.Annotate(err, "marshalling model payload")
+	}
+
+	sourceUUID := envelope.ModelInfo.UUID
+	envelope.ModelInfo.UUID = args.ModelUUID
+	envelope.ModelInfo.Name = args.ModelName
+	envelope.ModelNamespace = params.ModelNamespace{}
+
+	var permissions []params.ModelPermission
+	for _, perm := range envelope.Permissions {
+		switch permission.ObjectType(perm.ObjectType) {
+		case permission.Model:
+			if perm.GrantOn != sourceUUID {
+				continue
+			}
+			perm.GrantOn = args.ModelUUID
+		case permission.Offer:
+			offerUUID, ok := cloned.OfferUUIDs[perm.GrantOn]
+			if !ok {
+				continue
+			}
+			perm.GrantOn = offerUUID
+		}
+		permissions = append(permissions, perm)
+	}
+	envelope.Permissions = permissions
+
+	// Leadership is held by units, and secret values in external backends
+	// and remote controllers belong to the source model; none of them carry
+	// over to the clone.
+	envelope.Leases = nil
+	envelope.SecretBackendRefs = nil
+	envelope.ExternalControllers = nil
+
+	model.Envelope = envelope
+	return model, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package migration
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	goyaml "gopkg.in/yaml.v2"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	domainexport "github.com/juju/juju/domain/export"
+	"github.com/juju/juju/domain/export/types/latest"
+	"github.com/juju/juju/domain/export/types/v4_1_0"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+)
+
+type CloneSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestCloneSuite(t *testing.T) {
+	tc.Run(t, &CloneSuite{})
+}
+
+func (*CloneSuite) assembledModel(c *tc.C, payload latest.ModelExport) AssembledModel {
+	data, err := goyaml.Marshal(payload)
+	c.Assert(err, tc.ErrorIsNil)
+	return AssembledModel{
+		Envelope: params.SerializedModelV2{
+			PayloadVersion: domainexport.LatestSupportedPayloadVersion(),
+			Payload:        data,
+			ModelInfo: params.SerializedModelInfo{
+				UUID:      "source-uuid",
+				Name:      "source",
+				Qualifier: "prod",
+			},
+			Permissions: []params.ModelPermission{
+				{ObjectType: "model", GrantOn: "source-uuid", SubjectName: "fred", Access: "admin"},
+				{ObjectType: "offer", GrantOn: "offer-uuid", SubjectName: "mary", Access: "consume"},
+			},
+			SecretBackendRefs: []params.SecretBackendReference{{BackendName: "vault"}},
+			Leases:            []params.Lease{{Name: "app", Holder: "app/0"}},
+		},
+		Charms: []string{"ch:amd64/app-1"},
+	}
+}
+
+func (s *CloneSuite) TestCloneEnvelope(c *tc.C) {
+	model := s.assembledModel(c, latest.ModelExport{
+		ModelAgent:    []v4_1_0.ModelAgent{{ModelUUID: "source-uuid"}},
+		Machine:       []v4_1_0.Machine{{UUID: "machine-uuid", Name: "0"}},
+		Offer:         []v4_1_0.Offer{{UUID: "offer-uuid", Name: "db"}},
+		OfferEndpoint: []v4_1_0.OfferEndpoint{{OfferUUID: "offer-uuid", EndpointUUID: "endpoint-uuid"}},
+	})
+
+	cloned, err := CloneEnvelope(model, CloneArgs{
+		ModelUUID: "clone-uuid",
+		ModelName: "clone",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	envelope := cloned.Envelope
+	c.Check(envelope.ModelInfo, tc.DeepEquals, params.SerializedModelInfo{
+		UUID:      "clone-uuid",
+		Name:      "clone",
+		Qualifier: "prod",
+	})
+	c.Check(envelope.Leases, tc.HasLen, 0)
+	c.Check(envelope.SecretBackendRefs, tc.HasLen, 0)
+	c.Check(cloned.Charms, tc.DeepEquals, model.Charms)
+
+	decoded, err := domainexport.DecodePayload(envelope.PayloadVersion, envelope.Payload)
+	c.Assert(err, tc.ErrorIsNil)
+	payload := decoded.(latest.ModelExport)
+	c.Check(payload.ModelAgent, tc.DeepEquals, []v4_1_0.ModelAgent{{ModelUUID: "clone-uuid"}})
+	c.Check(payload.Machine, tc.HasLen, 0)
+	c.Assert(payload.Offer, tc.HasLen, 1)
+	offerUUID := payload.Offer[0].UUID
+	c.Check(offerUUID, tc.Not(tc.Equals), "offer-uuid")
+
+	c.Check(envelope.Permissions, tc.DeepEquals, []params.ModelPermission{
+		{ObjectType: "model", GrantOn: "clone-uuid", SubjectName: "fred", Access: "admin"},
+		{ObjectType: "offer", GrantOn: offerUUID, SubjectName: "mary", Access: "consume"},
+	})
+
+	// The source envelope is left untouched.
+	c.Check(model.Envelope.ModelInfo.UUID, tc.Equals, "source-uuid")
+	c.Check(model.Envelope.Permissions[0].GrantOn, tc.Equals, "source-uuid")
+}
+
+func (s *CloneSuite) TestCloneEnvelopeCrossModelRelations(c *tc.C) {
+	model := s.assembledModel(c, latest.ModelExport{
+		ApplicationRemoteConsumer: []v4_1_0.ApplicationRemoteConsumer{{}},
+	})
+
+	_, err := CloneEnvelope(model, CloneArgs{
+		ModelUUID: "clone-uuid",
+		ModelName: "clone",
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
+}
@@ -1,10 +1,11 @@
 // Copyright 2026 Canonical Ltd.
 // Licensed under the AGPLv3, see LICENCE file for details.
 
-package migrationmaster
+package migration
 
 import (
 	"context"
+	"io"
 	"sort"
 
 	"github.com/juju/errors"
@@ -20,39 +21,94 @@ import (
 	"github.com/juju/juju/domain/application/architecture"
 	applicationcharm "github.com/juju/juju/domain/application/charm"
 	deploymentcharm "github.com/juju/juju/domain/deployment/charm"
+	domainexport "github.com/juju/juju/domain/export"
 	"github.com/juju/juju/rpc/params"
 )
 
 const agentBinaryRelease = "ubuntu"
 
-// assembledModel carries the v8 wire envelope for the migrating model plus the
-// binary-transfer references the worker feeds to UploadBinaries. Both are
-// produced by the same assembly pass so they cannot diverge.
-type assembledModel struct {
-	// envelope is the wire envelope sent to the target's v8 Prechecks and
+// EnvelopeExportService exposes the model-database export used as the
+// envelope payload.
+type EnvelopeExportService interface {
+	// Export returns the model-database contents at the latest supported
+	// payload schema version.
+	Export(context.Context) (*domainexport.ModelExport, error)
+
+	// GetControllerModelInfo reads the controller-database information scoped
+	// to this model in target-portable semantic form.
+	GetControllerModelInfo(context.Context) (modelmigration.ControllerModelInfo, error)
+}
+
+// EnvelopeCharmService lists the charms used by the model.
+type EnvelopeCharmService interface {
+	// ListCharmLocators returns a list of charm locators. The locator allows
+	// the charm URL to be reconstructed. If no names are provided, all the
+	// model's charms are listed.
+	ListCharmLocators(context.Context, ...string) ([]applicationcharm.CharmLocator, error)
+}
+
+// EnvelopeAgentService reports the agent binaries in use by the model's
+// agents.
+type EnvelopeAgentService interface {
+	// GetModelAgentBinaryMetadata reports the agent binary metadata that each
+	// machine and unit in the model is running.
+	GetModelAgentBinaryMetadata(
+		context.Context,
+	) (map[machine.Name]coreagentbinary.Metadata, map[unit.Name]coreagentbinary.Metadata, error)
+}
+
+// EnvelopeResourceService lists the model resources that need binary
+// transfer and opens resource content for download.
+type EnvelopeResourceService interface {
+	// ListAllModelResources returns the application and unit resources to
+	// export for all applications in the model.
+	ListAllModelResources(context.Context) ([]coreresource.Resource, error)
+
+	// GetResourceUUIDByApplicationAndResourceName returns the UUID of the
+	// resource identified by application and resource name.
+	GetResourceUUIDByApplicationAndResourceName(ctx context.Context, appName, resName string) (coreresource.UUID, error)
+
+	// OpenResource returns the details of and a reader for the resource.
+	OpenResource(ctx context.Context, resourceUUID coreresource.UUID) (coreresource.Resource, io.ReadCloser, error)
+}
+
+// EnvelopeServices holds the model's domain services used to assemble its
+// wire envelope.
+type EnvelopeServices struct {
+	ExportService     EnvelopeExportService
+	CharmService      EnvelopeCharmService
+	ModelAgentService EnvelopeAgentService
+	ResourceService   EnvelopeResourceService
+}
+
+// AssembledModel carries the v8 wire envelope for a model plus the
+// binary-transfer references fed to UploadBinaries. Both are produced by the
+// same assembly pass so they cannot diverge.
+type AssembledModel struct {
+	// Envelope is the wire envelope sent to the target's v8 Prechecks and
 	// Import methods.
-	envelope params.SerializedModelV2
+	Envelope params.SerializedModelV2
 
-	// charms are the charm URLs to transfer via /migrate/charms.
-	charms []string
+	// Charms are the charm URLs to transfer via /migrate/charms.
+	Charms []string
 
-	// tools are the agent binaries to transfer via /migrate/tools, keyed on
+	// Tools are the agent binaries to transfer via /migrate/tools, keyed on
 	// the SHA256 sum and referenced to a binary version.
-	tools map[string]semversion.Binary
+	Tools map[string]semversion.Binary
 
-	// resources are the application resources to transfer via
+	// Resources are the application resources to transfer via
 	// /migrate/resources.
-	resources []coreresource.Resource
+	Resources []coreresource.Resource
 }
 
-// assembleEnvelope builds a fresh params.SerializedModelV2 envelope for this
-// model from the local domain services: the model-DB export payload, the
-// controller-DB data, and the charm/tools/resources binary
-// references.
-func (w *Worker) assembleEnvelope(ctx context.Context, migrationUUID string) (assembledModel, error) {
-	var empty assembledModel
+// AssembleEnvelope builds a fresh params.SerializedModelV2 envelope for a
+// model from its domain services: the model-DB export payload, the
+// controller-DB data, and the charm/tools/resources binary references. The
+// migration UUID is recorded as the envelope's source migration UUID.
+func AssembleEnvelope(ctx context.Context, services EnvelopeServices, migrationUUID string) (AssembledModel, error) {
+	var empty AssembledModel
 
-	export, err := w.config.ExportService.Export(ctx)
+	export, err := services.ExportService.Export(ctx)
 	if err != nil {
 		return empty, errors.Annotate(err, "exporting model")
 	}
@@ -64,7 +120,7 @@ func (w *Worker) assembleEnvelope(ctx context.Context, migrationUUID string) (as
 		return empty, errors.Annotate(err, "marshalling model payload")
 	}
 
-	info, err := w.config.ExportService.GetControllerModelInfo(ctx)
+	info, err := services.ExportService.GetControllerModelInfo(ctx)
 	if err != nil {
 		return empty, errors.Annotate(err, "reading controller-db data for model")
 	}
@@ -72,7 +128,7 @@ func (w *Worker) assembleEnvelope(ctx context.Context, migrationUUID string) (as
 	envelope.PayloadVersion = export.Version
 	envelope.Payload = payload
 
-	locators, err := w.config.CharmService.ListCharmLocators(ctx)
+	locators, err := services.CharmService.ListCharmLocators(ctx)
 	if err != nil {
 		return empty, errors.Annotate(err, "listing model charms")
 	}
@@ -82,27 +138,50 @@ func (w *Worker) assembleEnvelope(ctx context.Context, migrationUUID string) (as
 	}
 	envelope.Charms = charms
 
-	machineTools, unitTools, err := w.config.ModelAgentService.GetModelAgentBinaryMetadata(ctx)
+	machineTools, unitTools, err := services.ModelAgentService.GetModelAgentBinaryMetadata(ctx)
 	if err != nil {
 		return empty, errors.Annotate(err, "listing model agent binaries")
 	}
 	tools, envelopeTools := toolsForEnvelope(machineTools, unitTools)
 	envelope.Tools = envelopeTools
 
-	exported, err := w.config.ResourceService.ListAllModelResources(ctx)
+	exported, err := services.ResourceService.ListAllModelResources(ctx)
 	if err != nil {
 		return empty, errors.Annotate(err, "listing model resources")
 	}
 	envelope.Resources = resourcesForEnvelope(exported)
 
-	return assembledModel{
-		envelope:  envelope,
-		charms:    charms,
-		tools:     tools,
-		resources: exported,
+	return AssembledModel{
+		Envelope:  envelope,
+		Charms:    charms,
+		Tools:     tools,
+		Resources: exported,
 	}, nil
 }
 
+// NewResourceDownloader returns a ResourceDownloader which opens the
+// application resources held by the given resource service.
+func NewResourceDownloader(svc EnvelopeResourceService) ResourceDownloader {
+	return &resourceDownloader{svc: svc}
+}
+
+type resourceDownloader struct {
+	svc EnvelopeResourceService
+}
+
+// OpenResource implements ResourceDownloader.
+func (d *resourceDownloader) OpenResource(ctx context.Context, appName, resName string) (io.ReadCloser, error) {
+	uuid, err := d.svc.GetResourceUUIDByApplicationAndResourceName(ctx, appName, resName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	_, reader, err := d.svc.OpenResource(ctx, uuid)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return reader, nil
+}
+
 // envelopeFromControllerModelInfo converts the controller-DB information
 // for the migrating model into their wire envelope form. The migration UUID
 // of the active source migration is recorded as the envelope's
@@ -0,0 +1,312 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package exportarchive reads and writes the tar archive holding an offline
+// model export: the model's migration envelope together with the charms,
+// agent binaries and resources needed to import it into another controller.
+package exportarchive
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"time"
+
+	"github.com/juju/errors"
+
+	coreresource "github.com/juju/juju/core/resource"
+	"github.com/juju/juju/core/semversion"
+	charmresource "github.com/juju/juju/domain/deployment/charm/resource"
+	"github.com/juju/juju/internal/tools"
+	"github.com/juju/juju/rpc/params"
+)
+
+const (
+	// modelFile holds the JSON encoded params.SerializedModelV2 envelope.
+	modelFile = "model.json"
+
+	// manifestFile holds the JSON encoded Manifest describing the binaries
+	// in the archive.
+	manifestFile = "manifest.json"
+)
+
+// Manifest describes the binaries held in an export archive.
+type Manifest struct {
+	Charms    []CharmEntry    `json:"charms,omitempty"`
+	Tools     []ToolsEntry    `json:"tools,omitempty"`
+	Resources []ResourceEntry `json:"resources,omitempty"`
+
+	// Locked is true if the model was left locked against changes on the
+	// controller it was exported from.
+	Locked bool `json:"locked,omitempty"`
+}
+
+// CharmEntry describes a charm archive held in an export archive.
+type CharmEntry struct {
+	// URL is the charm URL of the charm.
+	URL string `json:"url"`
+
+	// Ref is the charm reference the charm is uploaded with.
+	Ref string `json:"ref"`
+
+	// Path is the path of the charm archive in the export archive.
+	Path string `json:"path"`
+}
+
+// ToolsEntry describes an agent binary tarball held in an export archive.
+type ToolsEntry struct {
+	// Version is the binary version of the agent binaries.
+	Version string `json:"version"`
+
+	// Path is the path of the agent binary tarball in the export archive.
+	Path string `json:"path"`
+}
+
+// ResourceEntry describes an application resource blob held in an export
+// archive.
+type ResourceEntry struct {
+	params.SerializedModelResource
+
+	// Path is the path of the resource blob in the export archive.
+	Path string `json:"path"`
+}
+
+// Resource returns the application resource described by the entry.
+func (e ResourceEntry) Resource() (coreresource.Resource, error) {
+	resType, err := charmresource.ParseType(e.Type)
+	if err != nil {
+		return coreresource.Resource{}, errors.Annotatef(err, "resource %s/%s", e.Application, e.Name)
+	}
+	origin, err := charmresource.ParseOrigin(e.Origin)
+	if err != nil {
+		return coreresource.Resource{}, errors.Annotatef(err, "resource %s/%s", e.Application, e.Name)
+	}
+	fingerprint, err := charmresource.ParseFingerprint(e.FingerprintHex)
+	if err != nil {
+		return coreresource.Resource{}, errors.Annotatef(err, "resource %s/%s", e.Application, e.Name)
+	}
+	return coreresource.Resource{
+		Resource: charmresource.Resource{
+			Meta: charmresource.Meta{
+				Name: e.Name,
+				Type: resType,
+			},
+			Origin:      origin,
+			Revision:    e.Revision,
+			Fingerprint: fingerprint,
+			Size:        e.Size,
+		},
+		ApplicationName: e.Application,
+		RetrievedBy:     e.Username,
+		Timestamp:       e.Timestamp,
+	}, nil
+}
+
+// Writer writes an export archive. Its UploadCharm, UploadTools and
+// UploadResource methods allow it to be used as the destination of
+// migration.UploadBinaries.
+type Writer struct {
+	tw       *tar.Writer
+	modTime  time.Time
+	manifest Manifest
+}
+
+// NewWriter returns a Writer writing an export archive to w. Every entry is
+// given the supplied modification time.
+func NewWriter(w io.Writer, modTime time.Time) *Writer {
+	return &Writer{
+		tw:      tar.NewWriter(w),
+		modTime: modTime,
+	}
+}
+
+// WriteModel writes the model's migration envelope to the archive.
+func (w *Writer) WriteModel(envelope params.SerializedModelV2) error {
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return errors.Annotate(err, "marshalling model envelope")
+	}
+	return errors.Trace(w.writeBytes(modelFile, data))
+}
+
+// SetLocked records in the manifest that the model was left locked against
+// changes on the controller it was exported from.
+func (w *Writer) SetLocked() {
+	w.manifest.Locked = true
+}
+
+// UploadCharm writes the charm archive to the export archive. It returns the
+// supplied charm URL, as the URL is not changed by the export.
+func (w *Writer) UploadCharm(_ context.Context, curl, charmRef string, content io.Reader) (string, error) {
+	entry := CharmEntry{
+		URL:  curl,
+		Ref:  charmRef,
+		Path: path.Join("charms", charmRef+".charm"),
+	}
+	if err := w.writeStream(entry.Path, content); err != nil {
+		return "", errors.Annotatef(err, "writing charm %s", curl)
+	}
+	w.manifest.Charms = append(w.manifest.Charms, entry)
+	return curl, nil
+}
+
+// UploadTools writes the agent binary tarball to the export archive.
+func (w *Writer) UploadTools(_ context.Context, r io.Reader, vers semversion.Binary) (tools.List, error) {
+	entry := ToolsEntry{
+		Version: vers.String(),
+		Path:    path.Join("tools", vers.String()+".tgz"),
+	}
+	if err := w.writeStream(entry.Path, r); err != nil {
+		return nil, errors.Annotatef(err, "writing agent binaries %s", vers)
+	}
+	w.manifest.Tools = append(w.manifest.Tools, entry)
+	return nil, nil
+}
+
+// UploadResource writes the application resource blob to the export archive.
+func (w *Writer) UploadResource(_ context.Context, res coreresource.Resource, r io.Reader) error {
+	entry := ResourceEntry{
+		SerializedModelResource: params.SerializedModelResource{
+			Application:    res.ApplicationName,
+			Name:           res.Name,
+			Revision:       res.Revision,
+			Type:           res.Type.String(),
+			Origin:         res.Origin.String(),
+			FingerprintHex: res.Fingerprint.Hex(),
+			Size:           res.Size,
+			Timestamp:      res.Timestamp,
+			Username:       res.RetrievedBy,
+		},
+		Path: path.Join("resources", res.ApplicationName, res.Name),
+	}
+	if err := w.writeStream(entry.Path, r); err != nil {
+		return errors.Annotatef(err, "writing resource %s/%s", res.ApplicationName, res.Name)
+	}
+	w.manifest.Resources = append(w.manifest.Resources, entry)
+	return nil
+}
+
+// Close writes the manifest of the binaries written so far and closes the
+// archive. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	data, err := json.Marshal(w.manifest)
+	if err != nil {
+		return errors.Annotate(err, "marshalling export manifest")
+	}
+	if err := w.writeBytes(manifestFile, data); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(w.tw.Close())
+}
+
+func (w *Writer) writeBytes(name string, data []byte) error {
+	if err := w.tw.WriteHeader(w.header(name, int64(len(data)))); err != nil {
+		return errors.Trace(err)
+	}
+	_, err := w.tw.Write(data)
+	return errors.Trace(err)
+}
+
+// writeStream writes the content under the given name. The size of a tar
+// entry must be known before it is written, so content which can't be
+// seeked is first collected in a temporary file.
+func (w *Writer) writeStream(name string, content io.Reader) error {
+	seeker, ok := content.(io.ReadSeeker)
+	if !ok {
+		tmpFile, err := os.CreateTemp("", "juju-model-export-")
+		if err != nil {
+			return errors.Trace(err)
+		}
+		defer func() {
+			_ = tmpFile.Close()
+			_ = os.Remove(tmpFile.Name())
+		}()
+		if _, err := io.Copy(tmpFile, content); err != nil {
+			return errors.Trace(err)
+		}
+		seeker = tmpFile
+	}
+
+	size, err := seeker.Seek(0, io.SeekEnd)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.tw.WriteHeader(w.header(name, size)); err != nil {
+		return errors.Trace(err)
+	}
+	_, err = io.Copy(w.tw, seeker)
+	return errors.Trace(err)
+}
+
+func (w *Writer) header(name string, size int64) *tar.Header {
+	return &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    size,
+		ModTime: w.modTime,
+	}
+}
+
+// ReadModel reads the model's migration envelope and the manifest of the
+// binaries from an export archive.
+func ReadModel(r io.Reader) (params.SerializedModelV2, Manifest, error) {
+	var (
+		envelope                  params.SerializedModelV2
+		manifest                  Manifest
+		foundModel, foundManifest bool
+	)
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return envelope, manifest, errors.Annotate(err, "reading export archive")
+		}
+		switch hdr.Name {
+		case modelFile:
+			if err := json.NewDecoder(tr).Decode(&envelope); err != nil {
+				return envelope, manifest, errors.Annotate(err, "decoding model envelope")
+			}
+			foundModel = true
+		case manifestFile:
+			if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+				return envelope, manifest, errors.Annotate(err, "decoding export manifest")
+			}
+			foundManifest = true
+		}
+	}
+	if !foundModel {
+		return envelope, manifest, errors.NotValidf("export archive without %s", modelFile)
+	}
+	if !foundManifest {
+		return envelope, manifest, errors.NotValidf("export archive without %s", manifestFile)
+	}
+	return envelope, manifest, nil
+}
+
+// WalkBinaries calls f with the path and content of every binary in an
+// export archive, in the order they were written.
+func WalkBinaries(r io.Reader, f func(name string, content io.Reader) error) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errors.Annotate(err, "reading export archive")
+		}
+		if hdr.Name == modelFile || hdr.Name == manifestFile {
+			continue
+		}
+		if err := f(hdr.Name, tr); err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
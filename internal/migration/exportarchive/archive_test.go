@@ -0,0 +1,118 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package exportarchive_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	coreresource "github.com/juju/juju/core/resource"
+	"github.com/juju/juju/core/semversion"
+	charmresource "github.com/juju/juju/domain/deployment/charm/resource"
+	"github.com/juju/juju/internal/migration/exportarchive"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+)
+
+type archiveSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestArchiveSuite(t *testing.T) {
+	tc.Run(t, &archiveSuite{})
+}
+
+func (s *archiveSuite) TestRoundTrip(c *tc.C) {
+	fp, err := charmresource.GenerateFingerprint(strings.NewReader("resource body"))
+	c.Assert(err, tc.ErrorIsNil)
+	timestamp := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	res := coreresource.Resource{
+		Resource: charmresource.Resource{
+			Meta: charmresource.Meta{
+				Name: "config",
+				Type: charmresource.TypeFile,
+			},
+			Origin:      charmresource.OriginUpload,
+			Revision:    -1,
+			Fingerprint: fp,
+			Size:        13,
+		},
+		ApplicationName: "app",
+		RetrievedBy:     "fred",
+		Timestamp:       timestamp,
+	}
+	envelope := params.SerializedModelV2{
+		ModelInfo: params.SerializedModelInfo{
+			UUID: "model-uuid",
+			Name: "prod",
+		},
+		PayloadVersion: semversion.MustParse("4.1.0"),
+		Payload:        []byte("payload"),
+		Charms:         []string{"ch:amd64/app-1"},
+	}
+
+	var buf bytes.Buffer
+	w := exportarchive.NewWriter(&buf, timestamp)
+	err = w.WriteModel(envelope)
+	c.Assert(err, tc.ErrorIsNil)
+	curl, err := w.UploadCharm(c.Context(), "ch:amd64/app-1", "app-abcdef01", strings.NewReader("charm body"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(curl, tc.Equals, "ch:amd64/app-1")
+	_, err = w.UploadTools(c.Context(), strings.NewReader("tools body"), semversion.MustParseBinary("4.1.0-ubuntu-amd64"))
+	c.Assert(err, tc.ErrorIsNil)
+	err = w.UploadResource(c.Context(), res, strings.NewReader("resource body"))
+	c.Assert(err, tc.ErrorIsNil)
+	err = w.Close()
+	c.Assert(err, tc.ErrorIsNil)
+
+	gotEnvelope, manifest, err := exportarchive.ReadModel(bytes.NewReader(buf.Bytes()))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(gotEnvelope, tc.DeepEquals, envelope)
+	c.Check(manifest.Charms, tc.DeepEquals, []exportarchive.CharmEntry{{
+		URL:  "ch:amd64/app-1",
+		Ref:  "app-abcdef01",
+		Path: "charms/app-abcdef01.charm",
+	}})
+	c.Check(manifest.Tools, tc.DeepEquals, []exportarchive.ToolsEntry{{
+		Version: "4.1.0-ubuntu-amd64",
+		Path:    "tools/4.1.0-ubuntu-amd64.tgz",
+	}})
+	c.Assert(manifest.Resources, tc.HasLen, 1)
+	c.Check(manifest.Resources[0].Path, tc.Equals, "resources/app/config")
+	gotRes, err := manifest.Resources[0].Resource()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(gotRes, tc.DeepEquals, res)
+
+	contents := make(map[string]string)
+	err = exportarchive.WalkBinaries(bytes.NewReader(buf.Bytes()), func(name string, content io.Reader) error {
+		data, err := io.ReadAll(content)
+		contents[name] = string(data)
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(contents, tc.DeepEquals, map[string]string{
+		"charms/app-abcdef01.charm":    "charm body",
+		"tools/4.1.0-ubuntu-amd64.tgz": "tools body",
+		"resources/app/config":         "resource body",
+	})
+}
+
+func (s *archiveSuite) TestReadModelMissingManifest(c *tc.C) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	err := tw.WriteHeader(&tar.Header{Name: "model.json", Mode: 0644, Size: 2})
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = tw.Write([]byte("{}"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(tw.Close(), tc.ErrorIsNil)
+
+	_, _, err = exportarchive.ReadModel(&buf)
+	c.Assert(err, tc.ErrorMatches, "export archive without manifest.json not valid")
+}
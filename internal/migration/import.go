@@ -38,15 +38,17 @@ import (
 	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
 	secretbackendstate "github.com/juju/juju/domain/secretbackend/state"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/envelope"
 )
 
 // Deps bundles the database and ambient dependencies the v8 import
 // orchestrator needs, supplied by the caller's migration scope.
 type Deps struct {
-	ControllerDB database.TxnRunnerFactory
-	ModelDB      database.TxnRunnerFactory
-	Clock        clock.Clock
-	Logger       logger.Logger
+	ControllerDB   database.TxnRunnerFactory
+	ModelDB        database.TxnRunnerFactory
+	SecretKeyStore envelope.KeyStore
+	Clock          clock.Clock
+	Logger         logger.Logger
 }
 
 // ImportModelArgs contains the data needed to perform a v8 model import: the
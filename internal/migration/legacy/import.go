@@ -15,6 +15,7 @@ import (
 	"github.com/juju/juju/core/modelmigration"
 	"github.com/juju/juju/domain/modeldefaults"
 	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 )
 
@@ -25,6 +26,7 @@ func ImportModel(
 	bytes []byte,
 	scope modelmigration.ScopeForModel,
 	domainServices services.DomainServicesGetter,
+	secretKeyStore envelope.KeyStore,
 	controllerUUID string,
 	logger corelogger.Logger,
 	clock clock.Clock,
@@ -52,7 +54,7 @@ func ImportModel(
 	}
 
 	coordinator := modelmigration.NewCoordinator(logger)
-	ImportOperations(coordinator, modelDefaultsProvider, configGetter, clock, logger)
+	ImportOperations(coordinator, modelDefaultsProvider, configGetter, secretKeyStore, clock, logger)
 	if err := coordinator.Perform(ctx, scope(modelUUID), model); err != nil {
 		return errors.Trace(err)
 	}
@@ -35,6 +35,7 @@ import (
 	status "github.com/juju/juju/domain/status/modelmigration"
 	storage "github.com/juju/juju/domain/storage/modelmigration"
 	unitstate "github.com/juju/juju/domain/unitstate/modelmigration"
+	"github.com/juju/juju/internal/secrets/envelope"
 )
 
 // Coordinator is the interface that is used to add operations to a migration.
@@ -50,6 +51,7 @@ func ImportOperations(
 	coordinator Coordinator,
 	modelDefaultsProvider modelconfigservice.ModelDefaultsProvider,
 	configGetter providertracker.EphemeralProviderConfigGetter,
+	secretKeyStore envelope.KeyStore,
 	clock clock.Clock,
 	logger logger.Logger,
 ) {
@@ -101,7 +103,7 @@ func ImportOperations(
 	status.RegisterImport(coordinator, clock, logger.Child("status"))
 	resource.RegisterImport(coordinator, clock, logger.Child("resource"))
 	port.RegisterImport(coordinator, logger.Child("port"))
-	secret.RegisterImport(coordinator, logger.Child("secret"))
+	secret.RegisterImport(coordinator, secretKeyStore, logger.Child("secret"))
 	crossmodelrelation.RegisterImportSecret(coordinator, clock, logger.Child("remotesecret"))
 	cloudimagemetadata.RegisterImport(coordinator, logger.Child("cloudimagemetadata"), clock)
 	unitstate.RegisterImport(coordinator, logger.Child("unitstate"))
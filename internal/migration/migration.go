@@ -27,6 +27,7 @@ import (
 	internalerrors "github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/migration/legacy"
 	"github.com/juju/juju/internal/naturalsort"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/tools"
 )
@@ -45,6 +46,7 @@ type ConfigSchemaSourceProvider = func(environs.CloudService) config.ConfigSchem
 // ModelImporter represents a model migration that implements Import.
 type ModelImporter struct {
 	domainServices services.DomainServicesGetter
+	secretKeyStore envelope.KeyStore
 
 	controllerUUID string
 	scope          modelmigration.ScopeForModel
@@ -58,6 +60,7 @@ type ModelImporter struct {
 func NewModelImporter(
 	scope modelmigration.ScopeForModel,
 	domainServices services.DomainServicesGetter,
+	secretKeyStore envelope.KeyStore,
 	controllerUUID string,
 	logger corelogger.Logger,
 	clock clock.Clock,
@@ -66,6 +69,7 @@ func NewModelImporter(
 		scope:          scope,
 		controllerUUID: controllerUUID,
 		domainServices: domainServices,
+		secretKeyStore: secretKeyStore,
 		logger:         logger,
 		clock:          clock,
 	}
@@ -76,7 +80,7 @@ func NewModelImporter(
 // and imports that as a new database model.
 func (i *ModelImporter) ImportModelLegacy(ctx context.Context, bytes []byte) error {
 	return legacy.ImportModel(
-		ctx, bytes, i.scope, i.domainServices, i.controllerUUID, i.logger, i.clock,
+		ctx, bytes, i.scope, i.domainServices, i.secretKeyStore, i.controllerUUID, i.logger, i.clock,
 	)
 }
 
@@ -129,10 +133,11 @@ func (i *ModelImporter) ImportModel(
 	modelUUID := coremodel.UUID(args.ControllerModelInfo.ModelInfo.UUID)
 	scope := i.scope(modelUUID)
 	deps := Deps{
-		ControllerDB: scope.ControllerDB(),
-		ModelDB:      scope.ModelDB(),
-		Clock:        i.clock,
-		Logger:       i.logger,
+		ControllerDB:   scope.ControllerDB(),
+		ModelDB:        scope.ModelDB(),
+		SecretKeyStore: i.secretKeyStore,
+		Clock:          i.clock,
+		Logger:         i.logger,
 	}
 
 	// Apply the controller-scoped data (claim, bootstrap, users, credential,
@@ -164,6 +169,9 @@ func (i *ModelImporter) ImportModel(
 	if err := modelimport.NewImporter(scope.ModelDB()).Import(ctx, args.ModelDBPayload); err != nil {
 		return internalerrors.Errorf("model-DB import for model %q: %w", modelUUID, err)
 	}
+	if err := encryptImportedSecretContent(ctx, deps, args.ModelDBPayload); err != nil {
+		return internalerrors.Errorf("model-DB import for model %q: %w", modelUUID, err)
+	}
 	return nil
 }
 
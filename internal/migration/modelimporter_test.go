@@ -27,8 +27,12 @@ import (
 	modeltesting "github.com/juju/juju/domain/model/state/testing"
 	migrationclaimstate "github.com/juju/juju/domain/modelmigration/state/controller"
 	schematesting "github.com/juju/juju/domain/schema/testing"
+	secretservice "github.com/juju/juju/domain/secret/service"
+	secretstate "github.com/juju/juju/domain/secret/state"
+	secretbackendstate "github.com/juju/juju/domain/secretbackend/state"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/migration"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/uuid"
 )
 
@@ -81,7 +85,7 @@ func (s *modelImporterSuite) TestImportModel(c *tc.C) {
 	scope := func(coremodel.UUID) coremodelmigration.Scope {
 		return coremodelmigration.NewScope(controllerFactory, modelFactory, nil, nil, modelUUID)
 	}
-	importer := migration.NewModelImporter(scope, nil, "controller-uuid", loggertesting.WrapCheckLog(c), clock.WallClock)
+	importer := migration.NewModelImporter(scope, nil, nil, "controller-uuid", loggertesting.WrapCheckLog(c), clock.WallClock)
 
 	importArgs := migration.ImportModelArgs{
 		SourceMigrationUUID: uuid.MustNewUUID().String(),
@@ -133,7 +137,7 @@ func (s *modelImporterSuite) TestImportModelNoSecretBackendRewriteRows(c *tc.C)
 	scope := func(coremodel.UUID) coremodelmigration.Scope {
 		return coremodelmigration.NewScope(controllerFactory, modelFactory, nil, nil, modelUUID)
 	}
-	importer := migration.NewModelImporter(scope, nil, "controller-uuid", loggertesting.WrapCheckLog(c), clock.WallClock)
+	importer := migration.NewModelImporter(scope, nil, nil, "controller-uuid", loggertesting.WrapCheckLog(c), clock.WallClock)
 
 	importArgs := migration.ImportModelArgs{
 		SourceMigrationUUID: uuid.MustNewUUID().String(),
@@ -230,7 +234,7 @@ func (s *modelImporterSuite) TestImportModelSecretBackendRewrite(c *tc.C) {
 	scope := func(coremodel.UUID) coremodelmigration.Scope {
 		return coremodelmigration.NewScope(controllerFactory, modelFactory, nil, nil, modelUUID)
 	}
-	importer := migration.NewModelImporter(scope, nil, "controller-uuid", loggertesting.WrapCheckLog(c), clock.WallClock)
+	importer := migration.NewModelImporter(scope, nil, nil, "controller-uuid", loggertesting.WrapCheckLog(c), clock.WallClock)
 
 	importArgs := migration.ImportModelArgs{
 		SourceMigrationUUID: uuid.MustNewUUID().String(),
@@ -278,6 +282,90 @@ func (s *modelImporterSuite) TestImportModelSecretBackendRewrite(c *tc.C) {
 	c.Check(insertedRevisionID, tc.Equals, "ext-rev-2")
 }
 
+// TestImportModelEncryptsSecretContent verifies that secret content, which is
+// exported in the clear, is encrypted with the target controller's keys once
+// it is imported.
+func (s *modelImporterSuite) TestImportModelEncryptsSecretContent(c *tc.C) {
+	modelUUID := tc.Must(c, coremodel.NewUUID)
+	controllerFactory := s.TxnRunnerFactory()
+	modelRunner := s.ModelTxnRunner(c, modelUUID.String())
+	modelFactory := func(context.Context) (coredatabase.TxnRunner, error) {
+		return modelRunner, nil
+	}
+
+	now := time.Now().UTC()
+	secretID := uuid.MustNewUUID().String()
+	revUUID := uuid.MustNewUUID().String()
+	passwordHash := "some-hash"
+
+	payload := &latest.ModelExport{
+		ModelAgent: []v4_1_0.ModelAgent{
+			{ModelUUID: modelUUID.String(), PasswordHash: &passwordHash},
+		},
+		Secret: []v4_1_0.Secret{
+			{ID: secretID},
+		},
+		SecretMetadata: []v4_1_0.SecretMetadata{
+			{SecretID: secretID, Version: 1, RotatePolicyID: 0, CreateTime: now, UpdateTime: now},
+		},
+		SecretRevision: []v4_1_0.SecretRevision{
+			{UUID: revUUID, SecretID: secretID, Revision: 1, CreateTime: now},
+		},
+		SecretContent: []v4_1_0.SecretContent{
+			{RevisionUUID: revUUID, Name: "password", Content: "s3cret"},
+		},
+	}
+
+	scope := func(coremodel.UUID) coremodelmigration.Scope {
+		return coremodelmigration.NewScope(controllerFactory, modelFactory, nil, nil, modelUUID)
+	}
+	keyStore := envelope.NewFileKeyStore(envelope.KeyStorePath(c.MkDir()))
+	kek, err := envelope.NewKeyEncryptionKey()
+	c.Assert(err, tc.ErrorIsNil)
+	err = keyStore.AddKeyEncryptionKeys(kek)
+	c.Assert(err, tc.ErrorIsNil)
+	importer := migration.NewModelImporter(scope, nil, keyStore, "controller-uuid", loggertesting.WrapCheckLog(c), clock.WallClock)
+
+	importArgs := migration.ImportModelArgs{
+		SourceMigrationUUID: uuid.MustNewUUID().String(),
+		ControllerModelInfo: coremodelmigration.ControllerModelInfo{
+			ModelInfo: coremodelmigration.ModelIdentityInfo{
+				UUID:      modelUUID.String(),
+				Name:      "imported-model",
+				Qualifier: "prod",
+				Type:      "iaas",
+				Cloud:     s.cloudName,
+				Life:      "alive",
+			},
+		},
+		ModelDBPayload: payload,
+	}
+	view := export.ProjectionView{AgentTargetVersion: jujuversion.Current}
+
+	err = importer.ImportModel(c.Context(), importArgs, view)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var content string
+	err = modelRunner.StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx,
+			`SELECT content FROM secret_content WHERE revision_uuid = ? AND name = ?`, revUUID, "password",
+		).Scan(&content)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(content, tc.Matches, `juju-enc:v1:.+`)
+
+	secrets := secretservice.NewSecretService(
+		secretstate.NewState(modelFactory, loggertesting.WrapCheckLog(c), clock.WallClock),
+		secretbackendstate.NewState(controllerFactory, loggertesting.WrapCheckLog(c)),
+		keyStore,
+		nil,
+		loggertesting.WrapCheckLog(c),
+	)
+	decrypted, err := secrets.DecryptSecretContent(c.Context(), map[string]string{"password": content})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(decrypted, tc.DeepEquals, map[string]string{"password": "s3cret"})
+}
+
 // TestImportModelSecretBackendRewriteMissingRef verifies that a
 // secret_value_ref revision absent from SecretBackendRefs causes
 // ImportModel to error before committing any model-DB rows.
@@ -319,7 +407,7 @@ func (s *modelImporterSuite) TestImportModelSecretBackendRewriteMissingRef(c *tc
 	scope := func(coremodel.UUID) coremodelmigration.Scope {
 		return coremodelmigration.NewScope(controllerFactory, modelFactory, nil, nil, modelUUID)
 	}
-	importer := migration.NewModelImporter(scope, nil, "controller-uuid", loggertesting.WrapCheckLog(c), clock.WallClock)
+	importer := migration.NewModelImporter(scope, nil, nil, "controller-uuid", loggertesting.WrapCheckLog(c), clock.WallClock)
 
 	importArgs := migration.ImportModelArgs{
 		SourceMigrationUUID: uuid.MustNewUUID().String(),
@@ -387,7 +475,7 @@ func (s *modelImporterSuite) TestImportModelSecretBackendRewriteMissingBackend(c
 	scope := func(coremodel.UUID) coremodelmigration.Scope {
 		return coremodelmigration.NewScope(controllerFactory, modelFactory, nil, nil, modelUUID)
 	}
-	importer := migration.NewModelImporter(scope, nil, "controller-uuid", loggertesting.WrapCheckLog(c), clock.WallClock)
+	importer := migration.NewModelImporter(scope, nil, nil, "controller-uuid", loggertesting.WrapCheckLog(c), clock.WallClock)
 
 	importArgs := migration.ImportModelArgs{
 		SourceMigrationUUID: uuid.MustNewUUID().String(),
@@ -0,0 +1,35 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package migration
+
+import (
+	"context"
+
+	"github.com/juju/juju/domain/export/types/latest"
+	secretservice "github.com/juju/juju/domain/secret/service"
+	secretstate "github.com/juju/juju/domain/secret/state"
+	secretbackendstate "github.com/juju/juju/domain/secretbackend/state"
+	"github.com/juju/juju/internal/errors"
+)
+
+// encryptImportedSecretContent encrypts the secret content inserted by the
+// model-DB import with the target controller's keys. The source controller
+// exports secret content in the clear, as its keys don't travel with the
+// model; it runs after the model-DB insert.
+func encryptImportedSecretContent(ctx context.Context, deps Deps, payload *latest.ModelExport) error {
+	if len(payload.SecretContent) == 0 {
+		return nil
+	}
+	secrets := secretservice.NewSecretService(
+		secretstate.NewState(deps.ModelDB, deps.Logger, deps.Clock),
+		secretbackendstate.NewState(deps.ControllerDB, deps.Logger),
+		deps.SecretKeyStore,
+		nil,
+		deps.Logger,
+	)
+	if err := secrets.RewrapSecretDataKeys(ctx); err != nil {
+		return errors.Errorf("encrypting secret content: %w", err)
+	}
+	return nil
+}
@@ -452,6 +452,10 @@ var unsupportedConstraints = []string{
 	constraints.Tags,
 	constraints.VirtType,
 	constraints.ImageID,
+	constraints.Market,
+	constraints.MaxPrice,
+	constraints.CapacityReservation,
+	constraints.PlacementGroup,
 }
 
 // ConstraintsValidator is defined on the Environs interface.
@@ -482,6 +486,8 @@ func (env *azureEnviron) ConstraintsValidator(ctx context.Context) (constraints.
 			constraints.Mem,
 			constraints.Cores,
 			constraints.Arch,
+			constraints.Gpus,
+			constraints.GpuType,
 		},
 	)
 	validator.RegisterConflictResolver(constraints.InstanceType, constraints.Arch, func(attrValues map[string]any) error {
@@ -2564,6 +2570,7 @@ func (env *azureEnviron) getInstanceTypesLocked(ctx context.Context) (map[string
 				cores    *int32
 				mem      *int32
 				rootDisk *int32
+				gpus     uint64
 			)
 			for _, capability := range resource.Capabilities {
 				if capability.Name == nil || capability.Value == nil {
@@ -2579,6 +2586,8 @@ func (env *azureEnviron) getInstanceTypesLocked(ctx context.Context) (map[string
 				case "OSVhdSizeMB":
 					rootDiskValue, _ := strconv.Atoi(*capability.Value)
 					rootDisk = new(int32(rootDiskValue))
+				case "GPUs":
+					gpus, _ = strconv.ParseUint(*capability.Value, 10, 64)
 				}
 			}
 			instanceType := newInstanceType(
@@ -2590,6 +2599,10 @@ func (env *azureEnviron) getInstanceTypesLocked(ctx context.Context) (map[string
 					MemoryInMB:     mem,
 				},
 			)
+			if gpus > 0 {
+				instanceType.Gpus = gpus
+				instanceType.GpuType = getGpuTypeFromResourceSKU(resource)
+			}
 
 			instanceTypes[instanceType.Name] = instanceType
 			// Create aliases for standard role sizes.
@@ -2618,6 +2631,30 @@ func getArchFromResourceSKU(resource *armcompute.ResourceSKU) corearch.Arch {
 	return corearch.AMD64
 }
 
+// gpuInstanceFamilies maps the GPU enabled instance families in Azure to
+// the model of GPU they are equipped with. The resource SKU reports the
+// number of GPUs but not their model.
+var gpuInstanceFamilies = map[string]string{
+	"standardncsv3family":        "NVIDIA V100",
+	"standardncasv3_t4family":    "NVIDIA T4",
+	"standardncadsa100v4family":  "NVIDIA A100",
+	"standardndamsra100v4family": "NVIDIA A100",
+	"standardndasv4_a100family":  "NVIDIA A100",
+	"standardncadsh100v5family":  "NVIDIA H100",
+	"standardndish100v5family":   "NVIDIA H100",
+	"standardnvadsa10v5family":   "NVIDIA A10",
+	"standardnvsv3family":        "NVIDIA M60",
+	"standardnvadsv710family":    "AMD V710",
+	"standardndmi300xv5family":   "AMD MI300X",
+}
+
+// getGpuTypeFromResourceSKU returns the model of GPU attached to the
+// instance type based on the resource SKU, or an empty string if it is not
+// known.
+func getGpuTypeFromResourceSKU(resource *armcompute.ResourceSKU) string {
+	return gpuInstanceFamilies[strings.ToLower(toValue(resource.Family))]
+}
+
 // Region is specified in the HasRegion interface.
 func (env *azureEnviron) Region() (simplestreams.CloudSpec, error) {
 	return simplestreams.CloudSpec{
@@ -3063,6 +3063,43 @@ func (s *environSuite) TestInstanceInformation(c *tc.C) {
 	c.Assert(types.InstanceTypes, tc.HasLen, 4)
 }
 
+func (s *environSuite) TestInstanceInformationGpus(c *tc.C) {
+	s.skus = append(s.skus, &armcompute.ResourceSKU{
+		Name:         new("Standard_NC24ads_A100_v4"),
+		Locations:    to.SliceOfPtrs("westus"),
+		ResourceType: new("virtualMachines"),
+		Capabilities: []*armcompute.ResourceSKUCapabilities{{
+			Name:  new("MemoryGB"),
+			Value: new("220"),
+		}, {
+			Name:  new("vCPUs"),
+			Value: new("24"),
+		}, {
+			Name:  new("GPUs"),
+			Value: new("1"),
+		}, {
+			Name:  new("OSVhdSizeMB"),
+			Value: new("1047552"),
+		}},
+		Family: new("StandardNCADSA100v4Family"),
+	})
+	env := s.openEnviron(c)
+	s.sender = azuretesting.Senders{s.resourceSKUsSender()}
+
+	types, err := env.InstanceTypes(c.Context(), constraints.MustParse("gpu-type=a100"))
+	c.Assert(err, tc.ErrorIsNil)
+	// The instance type is listed under its full name and its alias.
+	c.Assert(types.InstanceTypes, tc.HasLen, 2)
+	for _, itype := range types.InstanceTypes {
+		c.Check(itype.Name, tc.Equals, "Standard_NC24ads_A100_v4")
+		c.Check(itype.Gpus, tc.Equals, uint64(1))
+		c.Check(itype.GpuType, tc.Equals, "NVIDIA A100")
+	}
+
+	_, err = env.InstanceTypes(c.Context(), constraints.MustParse("gpus=2"))
+	c.Assert(err, tc.ErrorMatches, `no instance types in westus matching constraints "gpus=2"`)
+}
+
 func (s *environSuite) TestInstanceInformationWithInvalidCredential(c *tc.C) {
 	env := s.openEnviron(c)
 	s.createSenderWithUnauthorisedStatusCode(c)
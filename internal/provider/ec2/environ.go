@@ -280,9 +280,36 @@ func (e *environ) ConstraintsValidator(ctx context.Context) (constraints.Validat
 	)
 	validator.RegisterConflicts(
 		[]string{constraints.InstanceType},
-		[]string{constraints.Arch, constraints.Mem, constraints.Cores, constraints.CpuPower})
+		[]string{constraints.Arch, constraints.Mem, constraints.Cores, constraints.CpuPower, constraints.Gpus, constraints.GpuType})
 	validator.RegisterUnsupported(unsupportedConstraints)
 
+	// Spot instances cannot be launched into a capacity reservation, and a
+	// maximum price only makes sense on the spot market.
+	validator.RegisterVocabulary(
+		constraints.Market,
+		[]string{constraints.MarketOnDemand, constraints.MarketSpot},
+	)
+	validator.RegisterConflicts(
+		[]string{constraints.CapacityReservation},
+		[]string{constraints.Market, constraints.MaxPrice},
+	)
+	validator.RegisterConflicts(
+		[]string{constraints.Market},
+		[]string{constraints.MaxPrice},
+	)
+	validator.RegisterConflictResolver(constraints.Market, constraints.MaxPrice, func(attrValues map[string]any) error {
+		if market, _ := attrValues[constraints.Market].(string); market != constraints.MarketSpot {
+			return fmt.Errorf("%v requires %v=%q", constraints.MaxPrice, constraints.Market, constraints.MarketSpot)
+		}
+		return nil
+	})
+	validator.RegisterConflictResolver(constraints.Market, constraints.CapacityReservation, func(attrValues map[string]any) error {
+		if market, _ := attrValues[constraints.Market].(string); market == constraints.MarketSpot {
+			return fmt.Errorf("%v cannot be used with %v=%q", constraints.CapacityReservation, constraints.Market, constraints.MarketSpot)
+		}
+		return nil
+	})
+
 	instanceTypes, err := e.supportedInstanceTypes(ctx, allInstanceTypeFilter())
 	if err != nil {
 		return nil, errors.Trace(e.HandleCredentialError(ctx, err))
@@ -614,6 +641,9 @@ func (e *environ) StartInstance(
 			exactInstanceTypeFilter(types.InstanceType(*args.Constraints.InstanceType)),
 		)
 	}
+	if args.Constraints.MinGpus() > 0 {
+		instFilter = oneOfInstanceTypeFilter(instFilter, gpuInstanceTypeFilter())
+	}
 
 	instanceTypes, err := e.supportedInstanceTypes(ctx, instFilter)
 	if err != nil {
@@ -700,6 +730,9 @@ func (e *environ) StartInstance(
 
 	var instResp *ec2.RunInstancesOutput
 	commonRunArgs := &ec2.RunInstancesInput{
+		InstanceMarketOptions:            instanceMarketOptions(args.Constraints),
+		CapacityReservationSpecification: capacityReservationSpecification(args.Constraints),
+
 		MinCount:            aws.Int32(1),
 		MaxCount:            aws.Int32(1),
 		UserData:            aws.String(base64.StdEncoding.EncodeToString(userData)),
@@ -725,6 +758,9 @@ func (e *environ) StartInstance(
 		AvailabilityZone: aws.String(availabilityZone),
 	}
 	runArgs.SubnetId = subnet.SubnetId
+	if err := e.applyPlacementGroup(ctx, runArgs.Placement, args.Constraints, args.InstanceConfig.Tags); err != nil {
+		return nil, annotateWrapError(err, "cannot set up placement group")
+	}
 
 	_ = callback(ctx, status.Allocating,
 		fmt.Sprintf("Trying to start instance in availability zone %q", availabilityZone), nil)
@@ -796,6 +832,40 @@ func (e *environ) StartInstance(
 	}, nil
 }
 
+// instanceMarketOptions returns the market options used to request a spot
+// instance, or nil if the constraints ask for an on-demand instance.
+func instanceMarketOptions(cons constraints.Value) *types.InstanceMarketOptionsRequest {
+	if !cons.IsSpot() {
+		return nil
+	}
+	spotOptions := &types.SpotMarketOptions{
+		// Juju does not restart interrupted machines, so the spot request
+		// is not kept open once the instance goes away.
+		SpotInstanceType:             types.SpotInstanceTypeOneTime,
+		InstanceInterruptionBehavior: types.InstanceInterruptionBehaviorTerminate,
+	}
+	if cons.HasMaxPrice() {
+		spotOptions.MaxPrice = aws.String(*cons.MaxPrice)
+	}
+	return &types.InstanceMarketOptionsRequest{
+		MarketType:  types.MarketTypeSpot,
+		SpotOptions: spotOptions,
+	}
+}
+
+// capacityReservationSpecification returns the capacity reservation to start
+// an instance in, or nil if the constraints do not specify one.
+func capacityReservationSpecification(cons constraints.Value) *types.CapacityReservationSpecification {
+	if !cons.HasCapacityReservation() {
+		return nil
+	}
+	return &types.CapacityReservationSpecification{
+		CapacityReservationTarget: &types.CapacityReservationTarget{
+			CapacityReservationId: aws.String(*cons.CapacityReservation),
+		},
+	}
+}
+
 // maybeAttachInstanceProfile assesses if an instance profile needs to be
 // attached to an instance based on it's constraints. If the instance
 // constraints do not specify an instance role then this func returns silently.
@@ -994,6 +1064,23 @@ func (e *environ) deriveAvailabilityZoneAndSubnetID(
 		return "", "", errors.Trace(err)
 	}
 
+	// Instances in a cluster placement group must all be in the same
+	// availability zone, so a machine joining a group that already has
+	// instances is pinned to their zone.
+	clusterZone, err := e.clusterPlacementGroupZone(ctx, args)
+	if err != nil {
+		return "", "", errors.Trace(err)
+	}
+	if clusterZone != "" {
+		if placementZone != "" && placementZone != clusterZone {
+			return "", "", errors.Errorf(
+				"cannot create instance in zone %q, as the instances in its cluster placement group are in zone %q",
+				placementZone, clusterZone,
+			)
+		}
+		placementZone = clusterZone
+	}
+
 	var availabilityZone string
 	if placementZone != "" {
 		availabilityZone = placementZone
@@ -1228,9 +1315,47 @@ func (e *environ) Instances(ctx context.Context, ids []instance.Id) ([]instances
 	if err != nil {
 		return nil, err
 	}
+	e.gatherSpotInstanceStatus(ctx, insts)
 	return insts, nil
 }
 
+// gatherSpotInstanceStatus fetches the status of the spot requests of any
+// spot instances, so that interruption notices are reported in the instance
+// status. Failure to do so is not fatal; the instances are returned without
+// the spot request status.
+func (e *environ) gatherSpotInstanceStatus(ctx context.Context, insts []instances.Instance) {
+	spotInsts := make(map[string]*sdkInstance)
+	var requestIds []string
+	for _, inst := range insts {
+		sdkInst, ok := inst.(*sdkInstance)
+		if !ok || sdkInst.i.InstanceLifecycle != types.InstanceLifecycleTypeSpot {
+			continue
+		}
+		requestId := aws.ToString(sdkInst.i.SpotInstanceRequestId)
+		if requestId == "" {
+			continue
+		}
+		spotInsts[requestId] = sdkInst
+		requestIds = append(requestIds, requestId)
+	}
+	if len(requestIds) == 0 {
+		return
+	}
+
+	resp, err := e.ec2Client.DescribeSpotInstanceRequests(ctx, &ec2.DescribeSpotInstanceRequestsInput{
+		SpotInstanceRequestIds: requestIds,
+	})
+	if err != nil {
+		logger.Warningf(ctx, "cannot get spot instance request status: %v", e.HandleCredentialError(ctx, err))
+		return
+	}
+	for _, req := range resp.SpotInstanceRequests {
+		if inst, ok := spotInsts[aws.ToString(req.SpotInstanceRequestId)]; ok {
+			inst.spotStatus = req.Status
+		}
+	}
+}
+
 // gatherInstances tries to get information on each instance
 // id whose corresponding insts slot is nil.
 //
@@ -1756,6 +1881,9 @@ func (e *environ) Destroy(ctx context.Context) error {
 	if err := e.cleanModelSecurityGroups(ctx); err != nil {
 		return errors.Annotate(e.HandleCredentialError(ctx, err), "cannot delete model security groups")
 	}
+	if err := e.deletePlacementGroups(ctx, makeModelFilter(e.uuid())); err != nil {
+		return errors.Annotate(err, "cannot delete model placement groups")
+	}
 	return nil
 }
 
@@ -1809,6 +1937,11 @@ func (e *environ) destroyControllerManagedModels(ctx context.Context, controller
 		}
 	}
 
+	// Delete placement groups managed by the controller.
+	if err := e.deletePlacementGroups(ctx, makeControllerFilter(controllerUUID)); err != nil {
+		return errors.Trace(err)
+	}
+
 	instanceProfiles, err := listInstanceProfilesForController(ctx, e.iamClient, controllerUUID)
 	if errors.Is(err, errors.Unauthorized) {
 		logger.Warningf(ctx, "unable to list Instance Profiles for deletion, Instance Profiles may have to be manually cleaned up for controller %q", controllerUUID)
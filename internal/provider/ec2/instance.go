@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/juju/collections/set"
 
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/network"
@@ -23,8 +24,20 @@ import (
 type sdkInstance struct {
 	e *environ
 	i types.Instance
+
+	// spotStatus holds the status of the spot request for spot instances,
+	// if it has been fetched.
+	spotStatus *types.SpotInstanceStatus
 }
 
+// spotInterruptionCodes are the spot request status codes that EC2 reports
+// when it is about to interrupt a spot instance.
+var spotInterruptionCodes = set.NewStrings(
+	"marked-for-termination",
+	"marked-for-stop",
+	"marked-for-hibernation",
+)
+
 var _ instances.Instance = (*sdkInstance)(nil)
 
 // String returns a string representation of this instance (the ID).
@@ -64,9 +77,13 @@ func (inst *sdkInstance) Status(_ context.Context) instance.Status {
 	default:
 		jujuStatus = status.Empty
 	}
+	message := string(inst.i.State.Name)
+	if inst.spotStatus != nil && spotInterruptionCodes.Contains(aws.ToString(inst.spotStatus.Code)) {
+		message = fmt.Sprintf("%s, spot interruption notice: %s", message, aws.ToString(inst.spotStatus.Code))
+	}
 	return instance.Status{
 		Status:  jujuStatus,
-		Message: string(inst.i.State.Name),
+		Message: message,
 	}
 }
 
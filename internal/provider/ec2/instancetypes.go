@@ -178,6 +178,19 @@ func (e *environ) generalPurposeInstanceFilter(
 	), nil
 }
 
+// gpuInstanceTypeFilter filters out any instance type that is not current
+// generation or that has no GPUs attached. It is used alongside the general
+// purpose filter when GPUs are requested, as no general purpose instance
+// type has any.
+func gpuInstanceTypeFilter() instanceTypeFilter {
+	return allInstanceTypeFilter(
+		currentGenInstanceTypeFilter(),
+		instanceTypeFilterFunc(func(i types.InstanceTypeInfo) bool {
+			return gpuCount(i.GpuInfo) > 0
+		}),
+	)
+}
+
 // Filter implements instanceFilter Filter.
 func (f instanceTypeFilterFunc) Filter(i types.InstanceTypeInfo) bool {
 	return f(i)
@@ -440,6 +453,10 @@ func convertEC2InstanceType(
 	if info.MemoryInfo != nil && info.MemoryInfo.SizeInMiB != nil {
 		instType.Mem = uint64(*info.MemoryInfo.SizeInMiB)
 	}
+	if info.GpuInfo != nil {
+		instType.Gpus = gpuCount(info.GpuInfo)
+		instType.GpuType = gpuType(info.GpuInfo)
+	}
 	if info.ProcessorInfo != nil {
 		unsupportedSet := set.NewStrings(arch.UnsupportedArches...)
 		for _, instArch := range info.ProcessorInfo.SupportedArchitectures {
@@ -460,6 +477,28 @@ func convertEC2InstanceType(
 	return instType
 }
 
+// gpuCount returns the total number of GPUs described by info.
+func gpuCount(info *types.GpuInfo) uint64 {
+	if info == nil {
+		return 0
+	}
+	var count uint64
+	for _, gpu := range info.Gpus {
+		count += uint64(aws.ToInt32(gpu.Count))
+	}
+	return count
+}
+
+// gpuType returns a description of the GPUs described by info, made up of
+// the manufacturer and model of the first GPU device (e.g. "NVIDIA A100").
+func gpuType(info *types.GpuInfo) string {
+	if len(info.Gpus) == 0 {
+		return ""
+	}
+	gpu := info.Gpus[0]
+	return strings.TrimSpace(aws.ToString(gpu.Manufacturer) + " " + aws.ToString(gpu.Name))
+}
+
 // highestFamilyProcessorGeneration takes a slice of InstancceTypeInfo structs
 // and  calculates the highest generation supported by each family and processor
 // family. This is useful for Juju to align it's use of families on to the
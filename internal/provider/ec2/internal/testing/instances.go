@@ -50,6 +50,15 @@ type Instance struct {
 	metadataOptions     *types.InstanceMetadataOptionsResponse
 
 	iamInstanceProfile *types.IamInstanceProfileSpecification
+
+	// spotOptions is set when the instance was requested on the spot
+	// market; spotStatus holds the status of its spot request.
+	spotOptions           *types.SpotMarketOptions
+	spotStatus            types.SpotInstanceStatus
+	capacityReservationId string
+
+	placementGroup string
+	tenancy        types.Tenancy
 }
 
 // TerminateInstances implements ec2.Client.
@@ -119,8 +128,17 @@ func (srv *Server) RunInstances(ctx context.Context, in *ec2.RunInstancesInput,
 	instType := in.InstanceType
 	imageId := aws.ToString(in.ImageId)
 	availZone := ""
+	var (
+		placementGroup string
+		tenancy        types.Tenancy
+	)
 	if in.Placement != nil {
 		availZone = aws.ToString(in.Placement.AvailabilityZone)
+		placementGroup = aws.ToString(in.Placement.GroupName)
+		tenancy = in.Placement.Tenancy
+	}
+	if _, ok := srv.placementGroups[placementGroup]; placementGroup != "" && !ok {
+		return nil, apiError("InvalidPlacementGroup.Unknown", "The Placement Group '%s' is unknown.", placementGroup)
 	}
 	if availZone == "" {
 		availZone = defaultAvailZone
@@ -147,6 +165,21 @@ func (srv *Server) RunInstances(ctx context.Context, in *ec2.RunInstancesInput,
 		instSubnet = srv.getDefaultSubnet()
 	}
 
+	var spotOptions *types.SpotMarketOptions
+	if in.InstanceMarketOptions != nil && in.InstanceMarketOptions.MarketType == types.MarketTypeSpot {
+		spotOptions = in.InstanceMarketOptions.SpotOptions
+		if spotOptions == nil {
+			spotOptions = &types.SpotMarketOptions{}
+		}
+	}
+	var capacityReservationId string
+	if spec := in.CapacityReservationSpecification; spec != nil && spec.CapacityReservationTarget != nil {
+		if spotOptions != nil {
+			return nil, apiError("InvalidParameterCombination", "capacity reservations cannot be used with spot instances")
+		}
+		capacityReservationId = aws.ToString(spec.CapacityReservationTarget.CapacityReservationId)
+	}
+
 	ifacesToCreate, limitToOneInstance, err := srv.parseNetworkInterfaces(in.NetworkInterfaces)
 	if err != nil {
 		return nil, err
@@ -190,6 +223,16 @@ func (srv *Server) RunInstances(ctx context.Context, in *ec2.RunInstancesInput,
 			srv.createBlockDeviceMappingsOnRun(in.BlockDeviceMappings)...,
 		)
 		inst.metadataOptions = metadataResponse
+		inst.capacityReservationId = capacityReservationId
+		inst.placementGroup = placementGroup
+		inst.tenancy = tenancy
+		if spotOptions != nil {
+			inst.spotOptions = spotOptions
+			inst.spotStatus = types.SpotInstanceStatus{
+				Code:    aws.String("fulfilled"),
+				Message: aws.String("Your spot request is fulfilled."),
+			}
+		}
 		resp.Instances = append(resp.Instances, inst.ec2instance())
 	}
 	return resp, nil
@@ -316,6 +359,62 @@ func (srv *Server) DescribeInstances(ctx context.Context, in *ec2.DescribeInstan
 	}, nil
 }
 
+// DescribeSpotInstanceRequests implements ec2.Client.
+func (srv *Server) DescribeSpotInstanceRequests(ctx context.Context, in *ec2.DescribeSpotInstanceRequestsInput, opts ...func(*ec2.Options)) (*ec2.DescribeSpotInstanceRequestsOutput, error) {
+	if err, ok := srv.apiCallErrors["DescribeSpotInstanceRequests"]; ok {
+		return nil, err
+	}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	requestIds := make(map[string]bool)
+	for _, id := range in.SpotInstanceRequestIds {
+		requestIds[id] = true
+	}
+
+	resp := &ec2.DescribeSpotInstanceRequestsOutput{}
+	for _, inst := range srv.instances {
+		if inst.spotOptions == nil {
+			continue
+		}
+		requestId := inst.spotRequestId()
+		if len(requestIds) > 0 && !requestIds[requestId] {
+			continue
+		}
+		spotStatus := inst.spotStatus
+		resp.SpotInstanceRequests = append(resp.SpotInstanceRequests, types.SpotInstanceRequest{
+			SpotInstanceRequestId:        aws.String(requestId),
+			InstanceId:                   aws.String(inst.id()),
+			SpotPrice:                    inst.spotOptions.MaxPrice,
+			Type:                         inst.spotOptions.SpotInstanceType,
+			InstanceInterruptionBehavior: inst.spotOptions.InstanceInterruptionBehavior,
+			State:                        types.SpotInstanceStateActive,
+			Status:                       &spotStatus,
+		})
+	}
+	return resp, nil
+}
+
+// SetSpotInstanceStatus sets the status of the spot request for the
+// given instance, as EC2 does when it is about to interrupt it.
+func (srv *Server) SetSpotInstanceStatus(instId, code, message string) error {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	inst, ok := srv.instances[instId]
+	if !ok {
+		return apiError("InvalidInstanceID.NotFound", "instance %s not found", instId)
+	}
+	if inst.spotOptions == nil {
+		return errors.NotValidf("instance %s is not a spot instance", instId)
+	}
+	inst.spotStatus = types.SpotInstanceStatus{
+		Code:    aws.String(code),
+		Message: aws.String(message),
+	}
+	return nil
+}
+
 // SetInitialInstanceState sets the state that any new instances will be started in.
 func (srv *Server) SetInitialInstanceState(state types.InstanceState) {
 	srv.mu.Lock()
@@ -375,6 +474,21 @@ func (inst *Instance) id() string {
 	return fmt.Sprintf("i-%d", inst.seq)
 }
 
+func (inst *Instance) placement() *types.Placement {
+	placement := &types.Placement{
+		AvailabilityZone: aws.String(inst.availZone),
+		Tenancy:          inst.tenancy,
+	}
+	if inst.placementGroup != "" {
+		placement.GroupName = aws.String(inst.placementGroup)
+	}
+	return placement
+}
+
+func (inst *Instance) spotRequestId() string {
+	return fmt.Sprintf("sir-%d", inst.seq)
+}
+
 func (inst *Instance) terminate() (d types.InstanceStateChange) {
 	ps := inst.state
 	d.PreviousState = &ps
@@ -407,7 +521,7 @@ func (inst *Instance) ec2instance() types.Instance {
 		PublicIpAddress:     aws.String(fmt.Sprintf("8.0.0.%d", inst.seq%256)),
 		PrivateIpAddress:    aws.String(fmt.Sprintf("127.0.0.%d", inst.seq%256)),
 		State:               &inst.state,
-		Placement:           &types.Placement{AvailabilityZone: aws.String(inst.availZone)},
+		Placement:           inst.placement(),
 		VpcId:               aws.String(inst.vpcId),
 		SubnetId:            aws.String(inst.subnetId),
 		BlockDeviceMappings: blockDeviceMappings,
@@ -419,6 +533,14 @@ func (inst *Instance) ec2instance() types.Instance {
 		NetworkInterfaces:   instanceNetworkInterfaces(inst.ifaces),
 	}
 
+	if inst.spotOptions != nil {
+		i.InstanceLifecycle = types.InstanceLifecycleTypeSpot
+		i.SpotInstanceRequestId = aws.String(inst.spotRequestId())
+	}
+	if inst.capacityReservationId != "" {
+		i.CapacityReservationId = aws.String(inst.capacityReservationId)
+	}
+
 	// Set the ipv6 address on the instance to the first one we find.
 	for _, iface := range inst.ifaces {
 		if iface.Ipv6Address != nil {
@@ -475,6 +597,8 @@ func (inst *Instance) matchAttr(attr, value string) (ok bool, err error) {
 		return false, nil
 	case "image-id":
 		return value == inst.imageId, nil
+	case "placement-group-name":
+		return value == inst.placementGroup, nil
 	case "instance-state-code":
 		code, err := strconv.Atoi(value)
 		if err != nil {
@@ -79,6 +79,38 @@ func (*Server) DescribeInstanceTypes(_ context.Context, _ *ec2.DescribeInstanceT
 				SustainedClockSpeedInGhz: aws.Float64(2.5),
 			},
 			CurrentGeneration: aws.Bool(true),
+		}, {
+			InstanceType: "g5.xlarge",
+			VCpuInfo:     &types.VCpuInfo{DefaultVCpus: aws.Int32(4)},
+			MemoryInfo:   &types.MemoryInfo{SizeInMiB: aws.Int64(16384)},
+			ProcessorInfo: &types.ProcessorInfo{
+				SupportedArchitectures:   []types.ArchitectureType{"x86_64"},
+				SustainedClockSpeedInGhz: aws.Float64(3.3),
+			},
+			GpuInfo: &types.GpuInfo{
+				Gpus: []types.GpuDeviceInfo{{
+					Count:        aws.Int32(1),
+					Manufacturer: aws.String("NVIDIA"),
+					Name:         aws.String("A10G"),
+				}},
+			},
+			CurrentGeneration: aws.Bool(true),
+		}, {
+			InstanceType: "p4d.24xlarge",
+			VCpuInfo:     &types.VCpuInfo{DefaultVCpus: aws.Int32(96)},
+			MemoryInfo:   &types.MemoryInfo{SizeInMiB: aws.Int64(1179648)},
+			ProcessorInfo: &types.ProcessorInfo{
+				SupportedArchitectures:   []types.ArchitectureType{"x86_64"},
+				SustainedClockSpeedInGhz: aws.Float64(3.0),
+			},
+			GpuInfo: &types.GpuInfo{
+				Gpus: []types.GpuDeviceInfo{{
+					Count:        aws.Int32(8),
+					Manufacturer: aws.String("NVIDIA"),
+					Name:         aws.String("A100"),
+				}},
+			},
+			CurrentGeneration: aws.Bool(true),
 		}},
 	}, nil
 }
@@ -0,0 +1,120 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package testing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+type placementGroup struct {
+	types.PlacementGroup
+}
+
+func (g *placementGroup) matchAttr(attr, value string) (ok bool, err error) {
+	switch attr {
+	case "group-name":
+		return aws.ToString(g.GroupName) == value, nil
+	case "strategy":
+		return string(g.Strategy) == value, nil
+	case "state":
+		return string(g.State) == value, nil
+	}
+	if strings.HasPrefix(attr, "tag:") {
+		key := attr[len("tag:"):]
+		return matchTag(g.Tags, key, value), nil
+	}
+	return false, fmt.Errorf("unknown attribute %q", attr)
+}
+
+// CreatePlacementGroup implements ec2.Client.
+func (srv *Server) CreatePlacementGroup(ctx context.Context, in *ec2.CreatePlacementGroupInput, opts ...func(*ec2.Options)) (*ec2.CreatePlacementGroupOutput, error) {
+	if err, ok := srv.apiCallErrors["CreatePlacementGroup"]; ok {
+		return nil, err
+	}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	name := aws.ToString(in.GroupName)
+	if name == "" {
+		return nil, apiError("MissingParameter", "The request must contain the parameter groupName")
+	}
+	if _, ok := srv.placementGroups[name]; ok {
+		return nil, apiError("InvalidPlacementGroup.Duplicate", "The placement group '%s' already exists.", name)
+	}
+	g := &placementGroup{types.PlacementGroup{
+		GroupId:   aws.String(fmt.Sprintf("pg-%d", srv.placementGroupId.next())),
+		GroupName: aws.String(name),
+		Strategy:  in.Strategy,
+		State:     types.PlacementGroupStateAvailable,
+		Tags:      tagSpecForType(types.ResourceTypePlacementGroup, in.TagSpecifications).Tags,
+	}}
+	srv.placementGroups[name] = g
+	return &ec2.CreatePlacementGroupOutput{PlacementGroup: &g.PlacementGroup}, nil
+}
+
+// DeletePlacementGroup implements ec2.Client.
+func (srv *Server) DeletePlacementGroup(ctx context.Context, in *ec2.DeletePlacementGroupInput, opts ...func(*ec2.Options)) (*ec2.DeletePlacementGroupOutput, error) {
+	if err, ok := srv.apiCallErrors["DeletePlacementGroup"]; ok {
+		return nil, err
+	}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	name := aws.ToString(in.GroupName)
+	if _, ok := srv.placementGroups[name]; !ok {
+		return nil, apiError("InvalidPlacementGroup.Unknown", "The Placement Group '%s' is unknown.", name)
+	}
+	for _, inst := range srv.instances {
+		if inst.placementGroup != name {
+			continue
+		}
+		if inst.state != ShuttingDown && inst.state != Terminated {
+			return nil, apiError("InvalidPlacementGroup.InUse", "There are instances in placement group %s", name)
+		}
+	}
+	delete(srv.placementGroups, name)
+	return &ec2.DeletePlacementGroupOutput{}, nil
+}
+
+// DescribePlacementGroups implements ec2.Client.
+func (srv *Server) DescribePlacementGroups(ctx context.Context, in *ec2.DescribePlacementGroupsInput, opts ...func(*ec2.Options)) (*ec2.DescribePlacementGroupsOutput, error) {
+	if err, ok := srv.apiCallErrors["DescribePlacementGroups"]; ok {
+		return nil, err
+	}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	var f ec2filter
+	if in != nil {
+		f = in.Filters
+	}
+	groupNames := make(map[string]bool)
+	for _, name := range in.GroupNames {
+		groupNames[name] = true
+	}
+
+	resp := &ec2.DescribePlacementGroupsOutput{}
+	for name, g := range srv.placementGroups {
+		if len(groupNames) > 0 && !groupNames[name] {
+			continue
+		}
+		ok, err := f.ok(g)
+		if err != nil {
+			return nil, apiError("InvalidParameterValue", "describe placement groups: %v", err)
+		}
+		if ok {
+			resp.PlacementGroups = append(resp.PlacementGroups, g.PlacementGroup)
+		}
+	}
+	return resp, nil
+}
@@ -52,6 +52,9 @@ type Server struct {
 	attachId                    counter
 	initialInstanceState        types.InstanceState
 	instanceProfileAssociations map[string]types.IamInstanceProfileAssociation
+
+	placementGroups  map[string]*placementGroup // name -> group
+	placementGroupId counter
 }
 
 // NewServer returns a new server.
@@ -92,6 +95,7 @@ func (srv *Server) Reset(withoutZonesOrGroups bool) {
 	srv.volumeId.reset()
 	srv.ifaceId.reset()
 	srv.attachId.reset()
+	srv.placementGroupId.reset()
 
 	srv.instanceMutatingCalls.reset()
 	srv.groupMutatingCalls.reset()
@@ -114,6 +118,7 @@ func (srv *Server) Reset(withoutZonesOrGroups bool) {
 	srv.reservations = make(map[string]*reservation)
 
 	srv.instanceProfileAssociations = make(map[string]types.IamInstanceProfileAssociation)
+	srv.placementGroups = make(map[string]*placementGroup)
 
 	if !withoutZonesOrGroups {
 		srv.addDefaultZonesAndGroups()
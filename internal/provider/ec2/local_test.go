@@ -1707,6 +1707,269 @@ func (t *localServerSuite) TestStartInstanceWithImageID(c *tc.C) {
 	c.Assert(expectedImageID, tc.DeepEquals, instanceDesc.Reservations[0].Instances[0].ImageId)
 }
 
+func (t *localServerSuite) TestStartInstanceSpot(c *tc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	params := environs.StartInstanceParams{
+		ControllerUUID: t.ControllerUUID,
+		Constraints:    constraints.MustParse("max-price=0.05"),
+	}
+	result, err := testing.StartInstanceWithParams(c, env, "1", params)
+	c.Assert(err, tc.ErrorIsNil)
+
+	instanceID := string(result.Instance.Id())
+	instanceDesc, err := t.client.DescribeInstances(c.Context(), &awsec2.DescribeInstancesInput{InstanceIds: []string{instanceID}})
+	c.Assert(err, tc.ErrorIsNil)
+	inst := instanceDesc.Reservations[0].Instances[0]
+	c.Check(inst.InstanceLifecycle, tc.Equals, types.InstanceLifecycleTypeSpot)
+	c.Assert(inst.SpotInstanceRequestId, tc.NotNil)
+
+	requests, err := t.client.DescribeSpotInstanceRequests(c.Context(), &awsec2.DescribeSpotInstanceRequestsInput{
+		SpotInstanceRequestIds: []string{*inst.SpotInstanceRequestId},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(requests.SpotInstanceRequests, tc.HasLen, 1)
+	request := requests.SpotInstanceRequests[0]
+	c.Check(aws.ToString(request.SpotPrice), tc.Equals, "0.05")
+	c.Check(request.Type, tc.Equals, types.SpotInstanceTypeOneTime)
+	c.Check(request.InstanceInterruptionBehavior, tc.Equals, types.InstanceInterruptionBehaviorTerminate)
+}
+
+func (t *localServerSuite) TestStartInstanceCapacityReservation(c *tc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	params := environs.StartInstanceParams{
+		ControllerUUID: t.ControllerUUID,
+		Constraints:    constraints.MustParse("capacity-reservation=cr-0123456789abcdef0"),
+	}
+	result, err := testing.StartInstanceWithParams(c, env, "1", params)
+	c.Assert(err, tc.ErrorIsNil)
+
+	instanceID := string(result.Instance.Id())
+	instanceDesc, err := t.client.DescribeInstances(c.Context(), &awsec2.DescribeInstancesInput{InstanceIds: []string{instanceID}})
+	c.Assert(err, tc.ErrorIsNil)
+	inst := instanceDesc.Reservations[0].Instances[0]
+	c.Check(inst.InstanceLifecycle, tc.Equals, types.InstanceLifecycleType(""))
+	c.Check(aws.ToString(inst.CapacityReservationId), tc.Equals, "cr-0123456789abcdef0")
+}
+
+func (t *localServerSuite) TestStartInstanceGpus(c *tc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	for i, test := range []struct {
+		cons         string
+		instanceType types.InstanceType
+	}{
+		{"gpus=1", "g5.xlarge"},
+		{"gpus=2", "p4d.24xlarge"},
+		{"gpu-type=a10g", "g5.xlarge"},
+		{"gpus=2 gpu-type=nvidia-a100", "p4d.24xlarge"},
+	} {
+		c.Logf("test %d: %s", i, test.cons)
+		params := environs.StartInstanceParams{
+			ControllerUUID: t.ControllerUUID,
+			Constraints:    constraints.MustParse(test.cons),
+		}
+		result, err := testing.StartInstanceWithParams(c, env, fmt.Sprint(i+1), params)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(ec2.InstanceSDKEC2(result.Instance).InstanceType, tc.Equals, test.instanceType)
+	}
+}
+
+func (t *localServerSuite) TestStartInstanceGpusNoMatch(c *tc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	params := environs.StartInstanceParams{
+		ControllerUUID: t.ControllerUUID,
+		Constraints:    constraints.MustParse("gpu-type=h100"),
+	}
+	_, err := testing.StartInstanceWithParams(c, env, "1", params)
+	c.Assert(err, tc.ErrorMatches, `no instance types in test matching constraints ".*gpu-type=h100"`)
+}
+
+func (t *localServerSuite) TestInstancesSpotInterruptionStatus(c *tc.C) {
+	t.srv.ec2srv.SetInitialInstanceState(ec2test.Running)
+	env := t.prepareAndBootstrap(c)
+
+	params := environs.StartInstanceParams{
+		ControllerUUID: t.ControllerUUID,
+		Constraints:    constraints.MustParse("market=spot"),
+	}
+	result, err := testing.StartInstanceWithParams(c, env, "1", params)
+	c.Assert(err, tc.ErrorIsNil)
+	id := result.Instance.Id()
+
+	insts, err := env.Instances(c.Context(), []instance.Id{id})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(insts[0].Status(c.Context()), tc.DeepEquals, instance.Status{
+		Status:  status.Running,
+		Message: "running",
+	})
+
+	err = t.srv.ec2srv.SetSpotInstanceStatus(string(id), "marked-for-termination", "Spot Instance is marked for termination.")
+	c.Assert(err, tc.ErrorIsNil)
+
+	insts, err = env.Instances(c.Context(), []instance.Id{id})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(insts[0].Status(c.Context()), tc.DeepEquals, instance.Status{
+		Status:  status.Running,
+		Message: "running, spot interruption notice: marked-for-termination",
+	})
+
+	// Failing to fetch the spot request status does not fail the
+	// instance lookup.
+	t.srv.ec2srv.SetAPIError("DescribeSpotInstanceRequests", errors.New("boom"))
+	insts, err = env.Instances(c.Context(), []instance.Id{id})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(insts[0].Status(c.Context()).Message, tc.Equals, "running")
+}
+
+func (t *localServerSuite) startInstanceWithUnits(
+	c *tc.C, env environs.Environ, machineId, consStr, units string,
+) *environs.StartInstanceResult {
+	params := environs.StartInstanceParams{
+		ControllerUUID: t.ControllerUUID,
+		Constraints:    constraints.MustParse(consStr),
+	}
+	err := testing.FillInStartInstanceParams(c, env, machineId, false, &params)
+	c.Assert(err, tc.ErrorIsNil)
+	params.InstanceConfig.Tags[tags.JujuUnitsDeployed] = units
+	result, err := env.StartInstance(c.Context(), params)
+	c.Assert(err, tc.ErrorIsNil)
+	return result
+}
+
+func (t *localServerSuite) describeInstance(c *tc.C, id instance.Id) types.Instance {
+	instanceDesc, err := t.client.DescribeInstances(c.Context(), &awsec2.DescribeInstancesInput{InstanceIds: []string{string(id)}})
+	c.Assert(err, tc.ErrorIsNil)
+	return instanceDesc.Reservations[0].Instances[0]
+}
+
+func (t *localServerSuite) TestStartInstancePlacementGroup(c *tc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	result0 := t.startInstanceWithUnits(c, env, "1", "placement-group=cluster", "mysql/0")
+	result1 := t.startInstanceWithUnits(c, env, "2", "placement-group=cluster", "mysql/1 logging/0")
+	result2 := t.startInstanceWithUnits(c, env, "3", "placement-group=spread", "wordpress/0")
+
+	groupName := ec2.JujuGroupName(env)
+	c.Check(aws.ToString(t.describeInstance(c, result0.Instance.Id()).Placement.GroupName), tc.Equals, groupName+"-mysql-cluster")
+	c.Check(aws.ToString(t.describeInstance(c, result1.Instance.Id()).Placement.GroupName), tc.Equals, groupName+"-mysql-cluster")
+	c.Check(aws.ToString(t.describeInstance(c, result2.Instance.Id()).Placement.GroupName), tc.Equals, groupName+"-wordpress-spread")
+
+	resp, err := t.client.DescribePlacementGroups(c.Context(), &awsec2.DescribePlacementGroupsInput{
+		Filters: []types.Filter{makeFilter("tag:"+tags.JujuModel, env.Config().UUID())},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	strategies := make(map[string]types.PlacementStrategy)
+	for _, g := range resp.PlacementGroups {
+		strategies[aws.ToString(g.GroupName)] = g.Strategy
+	}
+	c.Check(strategies, tc.DeepEquals, map[string]types.PlacementStrategy{
+		groupName + "-mysql-cluster":    types.PlacementStrategyCluster,
+		groupName + "-wordpress-spread": types.PlacementStrategySpread,
+	})
+}
+
+func (t *localServerSuite) startClusterInstanceInZone(
+	c *tc.C, env environs.Environ, machineId, zone string,
+) (*environs.StartInstanceResult, error) {
+	params := environs.StartInstanceParams{
+		ControllerUUID:   t.ControllerUUID,
+		Constraints:      constraints.MustParse("placement-group=cluster"),
+		AvailabilityZone: zone,
+	}
+	err := testing.FillInStartInstanceParams(c, env, machineId, false, &params)
+	c.Assert(err, tc.ErrorIsNil)
+	params.InstanceConfig.Tags[tags.JujuUnitsDeployed] = "mysql/" + machineId
+	return env.StartInstance(c.Context(), params)
+}
+
+func (t *localServerSuite) TestStartInstancePlacementGroupClusterPinsZone(c *tc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	result0, err := t.startClusterInstanceInZone(c, env, "1", "test-available")
+	c.Assert(err, tc.ErrorIsNil)
+	result1, err := t.startClusterInstanceInZone(c, env, "2", "test-available2")
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(aws.ToString(t.describeInstance(c, result0.Instance.Id()).Placement.AvailabilityZone), tc.Equals, "test-available")
+	c.Check(aws.ToString(t.describeInstance(c, result1.Instance.Id()).Placement.AvailabilityZone), tc.Equals, "test-available")
+}
+
+func (t *localServerSuite) TestDeriveAvailabilityZonesPlacementGroupCluster(c *tc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	_, err := t.startClusterInstanceInZone(c, env, "1", "test-available2")
+	c.Assert(err, tc.ErrorIsNil)
+
+	params := environs.StartInstanceParams{
+		ControllerUUID: t.ControllerUUID,
+		Constraints:    constraints.MustParse("placement-group=cluster"),
+	}
+	err = testing.FillInStartInstanceParams(c, env, "2", false, &params)
+	c.Assert(err, tc.ErrorIsNil)
+	params.InstanceConfig.Tags[tags.JujuUnitsDeployed] = "mysql/2"
+
+	zones, err := env.(common.ZonedEnviron).DeriveAvailabilityZones(c.Context(), params)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(zones, tc.DeepEquals, []string{"test-available2"})
+
+	// A zone placement directive conflicting with the group's zone is
+	// rejected.
+	params.Placement = "zone=test-available"
+	_, err = env.(common.ZonedEnviron).DeriveAvailabilityZones(c.Context(), params)
+	c.Assert(err, tc.ErrorMatches, `cannot create instance in zone "test-available", as the instances in its cluster placement group are in zone "test-available2"`)
+}
+
+func (t *localServerSuite) TestStartInstancePlacementGroupNoUnits(c *tc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	result := t.startInstanceWithUnits(c, env, "1", "placement-group=spread", "")
+	inst := t.describeInstance(c, result.Instance.Id())
+	c.Check(aws.ToString(inst.Placement.GroupName), tc.Equals, ec2.JujuGroupName(env)+"-spread")
+}
+
+func (t *localServerSuite) TestStartInstancePlacementGroupDedicatedHost(c *tc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	result := t.startInstanceWithUnits(c, env, "1", "placement-group=dedicated-host", "mysql/0")
+	inst := t.describeInstance(c, result.Instance.Id())
+	c.Check(inst.Placement.Tenancy, tc.Equals, types.TenancyHost)
+	c.Check(inst.Placement.GroupName, tc.IsNil)
+}
+
+func (t *localServerSuite) TestStartInstancePlacementGroupError(c *tc.C) {
+	env := t.prepareAndBootstrap(c)
+	t.srv.ec2srv.SetAPIError("CreatePlacementGroup", errors.New("boom"))
+
+	params := environs.StartInstanceParams{
+		ControllerUUID: t.ControllerUUID,
+		Constraints:    constraints.MustParse("placement-group=cluster"),
+	}
+	_, err := testing.StartInstanceWithParams(c, env, "1", params)
+	c.Assert(err, tc.ErrorMatches, `cannot set up placement group: creating placement group ".*-cluster": boom`)
+	c.Check(err, tc.ErrorIs, environs.ErrAvailabilityZoneIndependent)
+}
+
+func (t *localServerSuite) TestDestroyDeletesPlacementGroups(c *tc.C) {
+	env := t.prepareAndBootstrap(c)
+	hostedEnv, err := environs.New(t.BootstrapContext, environs.OpenParams{
+		Cloud:  t.CloudSpec(),
+		Config: env.Config(),
+	}, environs.NoopCredentialInvalidator())
+	c.Assert(err, tc.ErrorIsNil)
+
+	t.startInstanceWithUnits(c, hostedEnv, "1", "placement-group=cluster", "mysql/0")
+
+	err = hostedEnv.Destroy(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	resp, err := t.client.DescribePlacementGroups(c.Context(), &awsec2.DescribePlacementGroupsInput{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(resp.PlacementGroups, tc.HasLen, 0)
+}
+
 func (t *localServerSuite) TestAddresses(c *tc.C) {
 	env := t.prepareAndBootstrap(c)
 	inst, _ := testing.AssertStartInstance(c, env, t.ControllerUUID, "1")
@@ -1794,6 +2057,42 @@ func (t *localServerSuite) TestConstraintsConflict(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, `ambiguous constraints: "arch" overlaps with "instance-type": instance-type="m1.small" expected arch="amd64" not "arm64"`)
 }
 
+func (t *localServerSuite) TestConstraintsValidatorMarket(c *tc.C) {
+	env := t.Prepare(c)
+	validator, err := env.ConstraintsValidator(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	for _, valid := range []string{
+		"market=spot",
+		"market=on-demand",
+		"max-price=0.05",
+		"market=spot max-price=0.05",
+		"capacity-reservation=cr-0123456789abcdef0",
+		"market=on-demand capacity-reservation=cr-0123456789abcdef0",
+	} {
+		_, err = validator.Validate(constraints.MustParse(valid))
+		c.Check(err, tc.ErrorIsNil, tc.Commentf("%s", valid))
+	}
+
+	_, err = validator.Validate(constraints.MustParse("market=on-demand max-price=0.05"))
+	c.Check(err, tc.ErrorMatches, `ambiguous constraints: "market" overlaps with "max-price": max-price requires market="spot"`)
+	_, err = validator.Validate(constraints.MustParse("market=spot capacity-reservation=cr-0123456789abcdef0"))
+	c.Check(err, tc.ErrorMatches, `ambiguous constraints: "capacity-reservation" overlaps with "market": capacity-reservation cannot be used with market="spot"`)
+	_, err = validator.Validate(constraints.MustParse("max-price=0.05 capacity-reservation=cr-0123456789abcdef0"))
+	c.Check(err, tc.ErrorMatches, `ambiguous constraints: "capacity-reservation" overlaps with "max-price"`)
+}
+
+func (t *localServerSuite) TestConstraintsMergeMarket(c *tc.C) {
+	env := t.Prepare(c)
+	validator, err := env.ConstraintsValidator(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	consA := constraints.MustParse("market=spot max-price=0.05 mem=4G")
+	consB := constraints.MustParse("capacity-reservation=cr-0123456789abcdef0")
+	cons, err := validator.Merge(consA, consB)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cons, tc.DeepEquals, constraints.MustParse("capacity-reservation=cr-0123456789abcdef0 mem=4G"))
+}
+
 func (t *localServerSuite) TestPrecheckInstanceValidInstanceType(c *tc.C) {
 	env := t.Prepare(c)
 	cons := constraints.MustParse("instance-type=m1.small root-disk=1G")
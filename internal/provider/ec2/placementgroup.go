@@ -0,0 +1,173 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/retry"
+
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/tags"
+	"github.com/juju/juju/internal/provider/common"
+)
+
+// placementGroupStrategies maps the placement-group constraint values that
+// are backed by an EC2 placement group to the group's strategy. The
+// dedicated-host value is handled through the instance tenancy instead.
+var placementGroupStrategies = map[string]types.PlacementStrategy{
+	constraints.PlacementGroupCluster: types.PlacementStrategyCluster,
+	constraints.PlacementGroupSpread:  types.PlacementStrategySpread,
+}
+
+// placementGroupName returns the name of the placement group for a machine
+// with the given instance tags. All machines hosting units of the same
+// application share a group; machines without units share a group for the
+// model.
+func (e *environ) placementGroupName(instanceTags map[string]string, policy string) string {
+	var appName string
+	for unitName := range strings.FieldsSeq(instanceTags[tags.JujuUnitsDeployed]) {
+		if !names.IsValidUnit(unitName) {
+			continue
+		}
+		if name, err := names.UnitApplication(unitName); err == nil {
+			appName = name
+			break
+		}
+	}
+	if appName == "" {
+		return fmt.Sprintf("%s-%s", e.jujuGroupName(), policy)
+	}
+	return fmt.Sprintf("%s-%s-%s", e.jujuGroupName(), appName, policy)
+}
+
+// applyPlacementGroup updates the placement of a new instance to honour the
+// placement-group constraint, creating the placement group if needed.
+func (e *environ) applyPlacementGroup(
+	ctx context.Context, placement *types.Placement, cons constraints.Value, instanceTags map[string]string,
+) error {
+	if !cons.HasPlacementGroup() {
+		return nil
+	}
+	policy := *cons.PlacementGroup
+	if policy == constraints.PlacementGroupDedicatedHost {
+		// Let EC2 choose one of the account's dedicated hosts that has
+		// auto-placement enabled.
+		placement.Tenancy = types.TenancyHost
+		return nil
+	}
+	strategy, ok := placementGroupStrategies[policy]
+	if !ok {
+		return errors.NotValidf("%s %q", constraints.PlacementGroup, policy)
+	}
+	groupName := e.placementGroupName(instanceTags, policy)
+	if err := e.ensurePlacementGroup(ctx, groupName, strategy); err != nil {
+		return errors.Trace(err)
+	}
+	placement.GroupName = aws.String(groupName)
+	return nil
+}
+
+// clusterPlacementGroupZone returns the availability zone of the instances
+// already in the cluster placement group a new instance would join, or ""
+// if the instance is not joining a cluster group or the group is empty. A
+// cluster placement group cannot span availability zones, so the new
+// instance must be started in that zone.
+func (e *environ) clusterPlacementGroupZone(ctx context.Context, args environs.StartInstanceParams) (string, error) {
+	if !args.Constraints.HasPlacementGroup() || *args.Constraints.PlacementGroup != constraints.PlacementGroupCluster {
+		return "", nil
+	}
+	if args.InstanceConfig == nil {
+		return "", nil
+	}
+	groupName := e.placementGroupName(args.InstanceConfig.Tags, constraints.PlacementGroupCluster)
+	resp, err := e.ec2Client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+		Filters: []types.Filter{
+			makeFilter("placement-group-name", groupName),
+			makeFilter("instance-state-name", aliveInstanceStates...),
+		},
+	})
+	if err != nil {
+		return "", errors.Annotatef(e.HandleCredentialError(ctx, err), "listing instances in placement group %q", groupName)
+	}
+	for _, r := range resp.Reservations {
+		for _, inst := range r.Instances {
+			if inst.Placement != nil && aws.ToString(inst.Placement.AvailabilityZone) != "" {
+				return aws.ToString(inst.Placement.AvailabilityZone), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// ensurePlacementGroup creates the named placement group, tagged with the
+// model and controller UUIDs, if it does not already exist.
+func (e *environ) ensurePlacementGroup(ctx context.Context, name string, strategy types.PlacementStrategy) error {
+	cfg := e.Config()
+	groupTags := tags.ResourceTags(
+		names.NewModelTag(cfg.UUID()),
+		names.NewControllerTag(e.controllerUUID),
+		cfg,
+	)
+	_, err := e.ec2Client.CreatePlacementGroup(ctx, &ec2.CreatePlacementGroupInput{
+		GroupName: aws.String(name),
+		Strategy:  strategy,
+		TagSpecifications: []types.TagSpecification{
+			CreateTagSpecification(types.ResourceTypePlacementGroup, groupTags),
+		},
+	})
+	if err != nil && ec2ErrCode(err) != "InvalidPlacementGroup.Duplicate" {
+		return errors.Annotatef(e.HandleCredentialError(ctx, err), "creating placement group %q", name)
+	}
+	return nil
+}
+
+// deletePlacementGroups deletes all placement groups matching the filter.
+// Groups cannot be deleted while they contain instances, so deletion is
+// retried while recently terminated instances go away.
+func (e *environ) deletePlacementGroups(ctx context.Context, filter types.Filter) error {
+	resp, err := e.ec2Client.DescribePlacementGroups(ctx, &ec2.DescribePlacementGroupsInput{
+		Filters: []types.Filter{filter},
+	})
+	if err != nil {
+		return errors.Annotate(e.HandleCredentialError(ctx, err), "listing placement groups")
+	}
+	for _, g := range resp.PlacementGroups {
+		name := aws.ToString(g.GroupName)
+		retryStrategy := shortRetryStrategy
+		retryStrategy.IsFatalError = func(err error) bool {
+			return ec2ErrCode(err) != "InvalidPlacementGroup.InUse"
+		}
+		retryStrategy.Func = func() error {
+			_, err := e.ec2Client.DeletePlacementGroup(ctx, &ec2.DeletePlacementGroupInput{
+				GroupName: aws.String(name),
+			})
+			if err != nil && ec2ErrCode(err) != "InvalidPlacementGroup.Unknown" {
+				return err
+			}
+			return nil
+		}
+		err := retry.Call(retryStrategy)
+		if retry.IsAttemptsExceeded(err) || retry.IsDurationExceeded(err) {
+			err = retry.LastError(err)
+		}
+		if err != nil {
+			err = e.HandleCredentialError(ctx, err)
+			if errors.Is(err, common.ErrorCredentialNotValid) {
+				return errors.Trace(err)
+			}
+			return errors.Annotatef(err, "cannot delete placement group %q: consider deleting it manually", name)
+		}
+		logger.Debugf(ctx, "deleted placement group %q", name)
+	}
+	return nil
+}
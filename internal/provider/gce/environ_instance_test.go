@@ -265,6 +265,55 @@ func (s *environInstSuite) TestListMachineTypes(c *tc.C) {
 
 }
 
+func (s *environInstSuite) TestInstanceTypesGpus(c *tc.C) {
+	ctrl := s.SetupMocks(c)
+	defer ctrl.Finish()
+
+	env := s.SetupEnv(c, s.MockService)
+
+	s.MockService.EXPECT().AvailabilityZones(gomock.Any(), "us-east1").Return([]*computepb.Zone{{
+		Name:   new("home-zone"),
+		Status: new("UP"),
+	}}, nil)
+	s.MockService.EXPECT().ListMachineTypes(gomock.Any(), "home-zone").Return([]*computepb.MachineType{{
+		Id:        new(uint64(0)),
+		Name:      new("n1-standard-8"),
+		GuestCpus: new(int32(8)),
+		MemoryMb:  new(int32(30720)),
+	}, {
+		Id:        new(uint64(1)),
+		Name:      new("g2-standard-8"),
+		GuestCpus: new(int32(8)),
+		MemoryMb:  new(int32(32768)),
+		Accelerators: []*computepb.Accelerators{{
+			GuestAcceleratorCount: new(int32(1)),
+			GuestAcceleratorType:  new("nvidia-l4"),
+		}},
+	}, {
+		Id:        new(uint64(2)),
+		Name:      new("a2-highgpu-2g"),
+		GuestCpus: new(int32(24)),
+		MemoryMb:  new(int32(174080)),
+		Accelerators: []*computepb.Accelerators{{
+			GuestAcceleratorCount: new(int32(2)),
+			GuestAcceleratorType:  new("nvidia-tesla-a100"),
+		}},
+	}}, nil)
+
+	types, err := env.InstanceTypes(c.Context(), constraints.MustParse("gpus=2 gpu-type=a100"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(types.InstanceTypes, tc.DeepEquals, []instances.InstanceType{{
+		Id:       "2",
+		Name:     "a2-highgpu-2g",
+		CpuCores: uint64(24),
+		Mem:      uint64(174080),
+		Arch:     "amd64",
+		VirtType: new("kvm"),
+		Gpus:     2,
+		GpuType:  "nvidia-tesla-a100",
+	}})
+}
+
 func (s *environInstSuite) TestAdoptResources(c *tc.C) {
 	ctrl := s.SetupMocks(c)
 	defer ctrl.Finish()
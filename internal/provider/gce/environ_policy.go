@@ -50,6 +50,10 @@ var unsupportedConstraints = []string{
 	constraints.VirtType,
 	constraints.ImageID,
 	constraints.IPFamily,
+	constraints.Market,
+	constraints.MaxPrice,
+	constraints.CapacityReservation,
+	constraints.PlacementGroup,
 }
 
 // instanceTypeConstraints defines the fields defined on each of the
@@ -61,6 +65,8 @@ var instanceTypeConstraints = []string{
 	constraints.CpuPower,
 	constraints.Mem,
 	constraints.Container, // VirtType
+	constraints.Gpus,
+	constraints.GpuType,
 }
 
 // ConstraintsValidator returns a Validator value which is used to
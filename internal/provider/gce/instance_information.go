@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"time"
 
+	"cloud.google.com/go/compute/apiv1/computepb"
 	"github.com/juju/clock"
 	"github.com/juju/errors"
 
@@ -87,6 +88,7 @@ func (env *environ) getAllInstanceTypes(ctx context.Context, clock clock.Clock)
 				Arch:     arch.AMD64,
 				VirtType: &virtType,
 			}
+			i.Gpus, i.GpuType = machineTypeAccelerators(m)
 			resultUnique[m.GetName()] = i
 		}
 	}
@@ -103,3 +105,23 @@ func (env *environ) getAllInstanceTypes(ctx context.Context, clock clock.Clock)
 	env.instCacheExpireAt = clock.Now().Add(10 * time.Minute)
 	return env.cachedInstanceTypes, nil
 }
+
+// machineTypeAccelerators returns the number and type of the GPUs bundled
+// with a machine type, such as those in the accelerator-optimized A2 and G2
+// families.
+func machineTypeAccelerators(m *computepb.MachineType) (uint64, string) {
+	var (
+		count   uint64
+		gpuType string
+	)
+	for _, accelerator := range m.GetAccelerators() {
+		if accelerator.GetGuestAcceleratorCount() <= 0 {
+			continue
+		}
+		count += uint64(accelerator.GetGuestAcceleratorCount())
+		if gpuType == "" {
+			gpuType = accelerator.GetGuestAcceleratorType()
+		}
+	}
+	return count, gpuType
+}
@@ -18,6 +18,12 @@ var unsupportedConstraints = []string{
 	constraints.AllocatePublicIP,
 	constraints.ImageID,
 	constraints.IPFamily,
+	constraints.Market,
+	constraints.MaxPrice,
+	constraints.CapacityReservation,
+	constraints.PlacementGroup,
+	constraints.Gpus,
+	constraints.GpuType,
 }
 
 // ConstraintsValidator returns a Validator value which is used to
@@ -86,7 +86,7 @@ func (cs *controllerStack) GetControllerSvcSpec(cloudType string, cfg *podcfg.Bo
 }
 
 func (cs *controllerStack) SetControllerAgentLokiConfig(endpoint string, caCert *string, insecureSkipVerify *bool, orgID string) {
-	cs.agentConfig.SetLokiConfig(endpoint, caCert, insecureSkipVerify, orgID)
+	cs.agentConfig.SetLokiConfig(endpoint, caCert, insecureSkipVerify, orgID, "")
 }
 
 func (cs *controllerStack) BuildContainerSpecForController(c *tc.C) *core.PodSpec {
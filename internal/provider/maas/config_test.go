@@ -49,18 +49,17 @@ func (s *configSuite) SetUpTest(c *tc.C) {
 
 func (*configSuite) TestValidateUpcallsEnvironsConfigValidate(c *tc.C) {
 	// The base Validate() function will not allow an environment to
-	// change its name.  Trigger that error so as to prove that the
+	// change its uuid.  Trigger that error so as to prove that the
 	// environment provider's Validate() calls the base Validate().
 	oldCfg, err := newConfig(c, nil)
 	c.Assert(err, tc.ErrorIsNil)
-	newName := oldCfg.Name() + "-but-different"
-	newCfg, err := oldCfg.Apply(map[string]any{"name": newName})
+	newCfg, err := oldCfg.Apply(map[string]any{"uuid": "dcfbdb4a-bca2-49ad-aa7c-f011424e0fe4"})
 	c.Assert(err, tc.ErrorIsNil)
 
 	_, err = EnvironProvider{}.Validate(c.Context(), newCfg, oldCfg.Config)
 
 	c.Assert(err, tc.NotNil)
-	c.Check(err, tc.ErrorMatches, ".*cannot change name.*")
+	c.Check(err, tc.ErrorMatches, ".*cannot change uuid.*")
 }
 
 func (*configSuite) TestSchema(c *tc.C) {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"net/url"
 	"strings"
+	"unicode"
 
 	"github.com/juju/collections/set"
 	"github.com/juju/gomaasapi/v3"
@@ -21,6 +22,10 @@ var unsupportedConstraints = []string{
 	constraints.InstanceType,
 	constraints.AllocatePublicIP,
 	constraints.IPFamily,
+	constraints.Market,
+	constraints.MaxPrice,
+	constraints.CapacityReservation,
+	constraints.PlacementGroup,
 }
 
 // ConstraintsValidator is defined on the Environs interface.
@@ -69,9 +74,32 @@ func convertConstraints(cons constraints.Value) gomaasapi.AllocateMachineArgs {
 	if cons.CpuPower != nil {
 		logger.Warningf(context.TODO(), "ignoring unsupported constraint 'cpu-power'")
 	}
+	params.Tags = append(params.Tags, gpuTags(cons)...)
 	return params
 }
 
+// gpuTags returns the MAAS tags that a machine must have to satisfy the gpus
+// and gpu-type constraints. MAAS does not report GPUs when allocating
+// machines, so operators are expected to tag machines with GPUs as "gpu" and
+// with the model of GPU as "gpu-<model>" (e.g. "gpu-nvidia-a100"), typically
+// using a tag definition matched against the commissioning data.
+func gpuTags(cons constraints.Value) []string {
+	if cons.MinGpus() == 0 {
+		return nil
+	}
+	if cons.HasGpus() && *cons.Gpus > 1 {
+		logger.Warningf(context.TODO(), "MAAS cannot match the number of GPUs, requiring a machine tagged %q", "gpu")
+	}
+	tags := []string{"gpu"}
+	if cons.HasGpuType() {
+		model := strings.Join(strings.FieldsFunc(strings.ToLower(*cons.GpuType), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}), "-")
+		tags = append(tags, "gpu-"+model)
+	}
+	return tags
+}
+
 // convertTagsToParams converts a list of positive/negative tags from
 // constraints into two comma-delimited lists of values, which can then be
 // passed to MAAS using the "tags" and "not_tags" arguments to acquire. If
@@ -43,6 +43,17 @@ func (*environSuite) TestConvertConstraints(c *tc.C) {
 			Tags:    []string{"tag1", "tag2"},
 			NotTags: []string{"tag3", "tag4"},
 		},
+	}, {
+		cons:     constraints.Value{Gpus: new(uint64(1))},
+		expected: gomaasapi.AllocateMachineArgs{Tags: []string{"gpu"}},
+	}, {
+		cons: constraints.Value{GpuType: new("NVIDIA A100"), Tags: &[]string{"foo"}},
+		expected: gomaasapi.AllocateMachineArgs{
+			Tags: []string{"foo", "gpu", "gpu-nvidia-a100"},
+		},
+	}, {
+		cons:     constraints.Value{Gpus: new(uint64(0))},
+		expected: gomaasapi.AllocateMachineArgs{},
 	}, { // CpuPower is ignored.
 		cons:     constraints.Value{CpuPower: new(uint64(1024))},
 		expected: gomaasapi.AllocateMachineArgs{},
@@ -317,6 +317,10 @@ var unsupportedConstraints = []string{
 	constraints.Tags,
 	constraints.ImageID,
 	constraints.IPFamily,
+	constraints.Market,
+	constraints.MaxPrice,
+	constraints.CapacityReservation,
+	constraints.PlacementGroup,
 }
 
 // ConstraintsValidator implements environs.Environ.
@@ -358,6 +358,12 @@ func instanceTypes(cli ComputeClient, compartmentID, imageID *string) ([]instanc
 			CpuCores: uint64(cpus),
 			VirtType: &instanceType,
 		}
+		if val.Gpus != nil && *val.Gpus > 0 {
+			newType.Gpus = uint64(*val.Gpus)
+			if val.GpuDescription != nil {
+				newType.GpuType = *val.GpuDescription
+			}
+		}
 		// If the shape is a flexible shape then the MemoryOptions and
 		// OcpuOptions will not be nil and they  indicate the maximum
 		// and minimum values. We assign the max memory and cpu cores
@@ -104,6 +104,8 @@ func (s *imagesSuite) TestInstanceTypes(c *tc.C) {
 			Mem:      240 * 1024,
 			CpuCores: 15,
 			VirtType: new("gpu"),
+			Gpus:     1,
+			GpuType:  "NVIDIA® A10",
 		}, {
 			Name:     "BM.Standard.A1.160",
 			Arch:     arch.ARM64,
@@ -333,6 +333,9 @@ type Environ struct {
 	neutronUnlocked NetworkingNeutron
 	volumeURL       *url.URL
 
+	// serverGroupMutex serialises the creation of server groups.
+	serverGroupMutex sync.Mutex
+
 	// keystoneImageDataSource caches the result of getKeystoneImageSource.
 	keystoneImageDataSourceMutex sync.Mutex
 	keystoneImageDataSource      simplestreams.DataSource
@@ -591,6 +594,11 @@ var unsupportedConstraints = []string{
 	constraints.Tags,
 	constraints.CpuPower,
 	constraints.IPFamily,
+	constraints.Market,
+	constraints.MaxPrice,
+	constraints.CapacityReservation,
+	constraints.Gpus,
+	constraints.GpuType,
 }
 
 // ConstraintsValidator is defined on the Environs interface.
@@ -711,6 +719,9 @@ func (e *Environ) PrecheckInstance(ctx context.Context, args environs.PrecheckIn
 	if _, err := e.deriveAvailabilityZone(ctx, args.Placement, args.VolumeAttachments); err != nil {
 		return errors.Trace(err)
 	}
+	if err := validatePlacementGroup(args.Constraints); err != nil {
+		return errors.Trace(err)
+	}
 	usingVolumeRootDisk := false
 	if args.Constraints.HasRootDiskSource() && args.Constraints.HasRootDisk() &&
 		*args.Constraints.RootDiskSource == rootDiskSourceVolume {
@@ -1131,6 +1142,11 @@ func (e *Environ) startInstance(
 	}
 	logger.Debugf(ctx, "openstack user data; %d bytes", len(userData))
 
+	serverGroupID, err := e.ensureServerGroup(args.Constraints, args.InstanceConfig.Tags)
+	if err != nil {
+		return nil, environs.ZoneIndependentError(errors.Annotate(err, "cannot set up server group"))
+	}
+
 	machineName := resourceName(
 		e.namespace,
 		e.name,
@@ -1230,7 +1246,11 @@ func (e *Environ) startInstance(
 		instanceOpts nova.RunServerOpts,
 	) (server *nova.Entity, err error) {
 		for a := attempts.Start(); a.Next(); {
-			server, err = client.RunServer(instanceOpts)
+			if serverGroupID != "" {
+				server, err = runServerInGroup(e.client(), instanceOpts, serverGroupID)
+			} else {
+				server, err = client.RunServer(instanceOpts)
+			}
 			if err != nil {
 				break
 			}
@@ -2004,6 +2024,9 @@ func (e *Environ) Destroy(ctx context.Context) error {
 	if err := e.firewaller.DeleteAllModelGroups(ctx); err != nil {
 		return e.HandleCredentialError(ctx, err)
 	}
+	if err := e.deleteServerGroups(ctx, e.serverGroupPrefix()); err != nil {
+		return e.HandleCredentialError(ctx, err)
+	}
 	return nil
 }
 
@@ -2021,6 +2044,9 @@ func (e *Environ) DestroyController(ctx context.Context, controllerUUID string)
 	if err := e.firewaller.DeleteAllControllerGroups(ctx, controllerUUID); err != nil {
 		return e.HandleCredentialError(ctx, err)
 	}
+	if err := e.deleteServerGroups(ctx, fmt.Sprintf("juju-%s-", controllerUUID)); err != nil {
+		return e.HandleCredentialError(ctx, err)
+	}
 	return nil
 }
 
@@ -0,0 +1,192 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-goose/goose/v5/client"
+	gooseerrors "github.com/go-goose/goose/v5/errors"
+	goosehttp "github.com/go-goose/goose/v5/http"
+	"github.com/go-goose/goose/v5/nova"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/environs/tags"
+)
+
+// serverGroupPolicies maps the placement-group constraint values that are
+// backed by a nova server group to the group's policy. Dedicated hosts are
+// not supported on OpenStack.
+var serverGroupPolicies = map[string]string{
+	constraints.PlacementGroupCluster: "affinity",
+	constraints.PlacementGroupSpread:  "anti-affinity",
+}
+
+// serverGroup is a nova server group, as returned by the os-server-groups
+// API, which goose does not wrap.
+type serverGroup struct {
+	Id       string   `json:"id"`
+	Name     string   `json:"name"`
+	Policies []string `json:"policies"`
+}
+
+const apiServerGroups = "os-server-groups"
+
+// validatePlacementGroup returns an error if the placement-group constraint
+// cannot be honoured by a nova server group.
+func validatePlacementGroup(cons constraints.Value) error {
+	if !cons.HasPlacementGroup() {
+		return nil
+	}
+	if _, ok := serverGroupPolicies[*cons.PlacementGroup]; !ok {
+		return errors.NotSupportedf("%s=%s on OpenStack", constraints.PlacementGroup, *cons.PlacementGroup)
+	}
+	return nil
+}
+
+// serverGroupPrefix returns the prefix of the names of the server groups
+// created for the model.
+func (e *Environ) serverGroupPrefix() string {
+	return fmt.Sprintf("juju-%s-%s-", e.controllerUUID, e.modelUUID)
+}
+
+// serverGroupName returns the name of the server group for a machine with
+// the given instance tags. All machines hosting units of the same
+// application share a group; machines without units share a group for the
+// model.
+func (e *Environ) serverGroupName(instanceTags map[string]string, policy string) string {
+	var appName string
+	for unitName := range strings.FieldsSeq(instanceTags[tags.JujuUnitsDeployed]) {
+		if !names.IsValidUnit(unitName) {
+			continue
+		}
+		if name, err := names.UnitApplication(unitName); err == nil {
+			appName = name
+			break
+		}
+	}
+	if appName == "" {
+		return e.serverGroupPrefix() + policy
+	}
+	return fmt.Sprintf("%s%s-%s", e.serverGroupPrefix(), appName, policy)
+}
+
+// ensureServerGroup returns the ID of the server group a new instance must
+// be started in to honour the placement-group constraint, creating the
+// group if needed. It returns "" if the constraint is not set.
+func (e *Environ) ensureServerGroup(cons constraints.Value, instanceTags map[string]string) (string, error) {
+	if err := validatePlacementGroup(cons); err != nil {
+		return "", errors.Trace(err)
+	}
+	if !cons.HasPlacementGroup() {
+		return "", nil
+	}
+	policy := serverGroupPolicies[*cons.PlacementGroup]
+	name := e.serverGroupName(instanceTags, *cons.PlacementGroup)
+
+	// Nova does not require server group names to be unique, so creation
+	// is serialised to avoid concurrent provisioning creating duplicates.
+	e.serverGroupMutex.Lock()
+	defer e.serverGroupMutex.Unlock()
+
+	groups, err := listServerGroups(e.client())
+	if err != nil {
+		return "", errors.Annotate(err, "listing server groups")
+	}
+	for _, g := range groups {
+		if g.Name == name {
+			return g.Id, nil
+		}
+	}
+	group, err := createServerGroup(e.client(), name, policy)
+	if err != nil {
+		return "", errors.Annotatef(err, "creating server group %q", name)
+	}
+	return group.Id, nil
+}
+
+// deleteServerGroups deletes all server groups whose names start with the
+// given prefix. Nova removes deleted instances from their groups, so groups
+// can be deleted once the instances in them have been terminated.
+func (e *Environ) deleteServerGroups(ctx context.Context, prefix string) error {
+	groups, err := listServerGroups(e.client())
+	if gooseerrors.IsNotFound(err) {
+		// The cloud does not provide server groups, so none were created.
+		return nil
+	} else if err != nil {
+		return errors.Annotate(err, "listing server groups")
+	}
+	for _, g := range groups {
+		if !strings.HasPrefix(g.Name, prefix) {
+			continue
+		}
+		if err := deleteServerGroup(e.client(), g.Id); err != nil {
+			return errors.Annotatef(err, "cannot delete server group %q: consider deleting it manually", g.Name)
+		}
+		logger.Debugf(ctx, "deleted server group %q", g.Name)
+	}
+	return nil
+}
+
+func listServerGroups(cl client.AuthenticatingClient) ([]serverGroup, error) {
+	var resp struct {
+		ServerGroups []serverGroup `json:"server_groups"`
+	}
+	requestData := goosehttp.RequestData{RespValue: &resp, ExpectedStatus: []int{http.StatusOK}}
+	// The error is returned as is so that callers can check its goose
+	// error code.
+	if err := cl.SendRequest(client.GET, "compute", "v2", apiServerGroups, &requestData); err != nil {
+		return nil, err
+	}
+	return resp.ServerGroups, nil
+}
+
+func createServerGroup(cl client.AuthenticatingClient, name, policy string) (*serverGroup, error) {
+	var req struct {
+		ServerGroup serverGroup `json:"server_group"`
+	}
+	req.ServerGroup = serverGroup{Name: name, Policies: []string{policy}}
+	var resp struct {
+		ServerGroup serverGroup `json:"server_group"`
+	}
+	requestData := goosehttp.RequestData{ReqValue: req, RespValue: &resp, ExpectedStatus: []int{http.StatusOK}}
+	if err := cl.SendRequest(client.POST, "compute", "v2", apiServerGroups, &requestData); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &resp.ServerGroup, nil
+}
+
+func deleteServerGroup(cl client.AuthenticatingClient, id string) error {
+	requestData := goosehttp.RequestData{ExpectedStatus: []int{http.StatusNoContent}}
+	err := cl.SendRequest(client.DELETE, "compute", "v2", apiServerGroups+"/"+id, &requestData)
+	if err != nil && !gooseerrors.IsNotFound(err) {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// runServerInGroup creates a new server in the given server group. It is
+// [nova.Client.RunServer] with the scheduler hint that places the server in
+// the group, which goose does not support.
+func runServerInGroup(cl client.AuthenticatingClient, opts nova.RunServerOpts, groupID string) (*nova.Entity, error) {
+	var req struct {
+		Server         nova.RunServerOpts `json:"server"`
+		SchedulerHints map[string]string  `json:"os:scheduler_hints"`
+	}
+	req.Server = opts
+	req.SchedulerHints = map[string]string{"group": groupID}
+	var resp struct {
+		Server nova.Entity `json:"server"`
+	}
+	requestData := goosehttp.RequestData{ReqValue: req, RespValue: &resp, ExpectedStatus: []int{http.StatusAccepted}}
+	if err := cl.SendRequest(client.POST, "compute", "v2", "servers", &requestData); err != nil {
+		return nil, errors.Annotatef(err, "failed to run a server in server group %q", groupID)
+	}
+	return &resp.Server, nil
+}
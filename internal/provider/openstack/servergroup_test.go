@@ -0,0 +1,156 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package openstack
+
+import (
+	"encoding/json"
+	stdtesting "testing"
+
+	"github.com/canonical/gomock/gomock"
+	gooseerrors "github.com/go-goose/goose/v5/errors"
+	goosehttp "github.com/go-goose/goose/v5/http"
+	"github.com/go-goose/goose/v5/nova"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/environs/tags"
+	"github.com/juju/juju/internal/testing"
+)
+
+type serverGroupSuite struct {
+	testing.BaseSuite
+
+	client *MockAuthenticatingClient
+}
+
+func TestServerGroupSuite(t *stdtesting.T) {
+	tc.Run(t, &serverGroupSuite{})
+}
+
+func (s *serverGroupSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.client = NewMockAuthenticatingClient(ctrl)
+	return ctrl
+}
+
+func (s *serverGroupSuite) environ() *Environ {
+	return &Environ{
+		controllerUUID: "ctrl",
+		modelUUID:      "model",
+		clientUnlocked: s.client,
+	}
+}
+
+// respondWith returns a SendRequest action decoding the JSON response into
+// the request's response value.
+func respondWith(c *tc.C, response string) func(string, string, string, string, *goosehttp.RequestData) error {
+	return func(_, _, _, _ string, requestData *goosehttp.RequestData) error {
+		c.Assert(json.Unmarshal([]byte(response), requestData.RespValue), tc.ErrorIsNil)
+		return nil
+	}
+}
+
+func (s *serverGroupSuite) TestEnsureServerGroupExisting(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.client.EXPECT().SendRequest("GET", "compute", "v2", "os-server-groups", gomock.Any()).DoAndReturn(
+		respondWith(c, `{"server_groups": [
+			{"id": "sg-1", "name": "juju-ctrl-model-wordpress-cluster"},
+			{"id": "sg-2", "name": "juju-ctrl-model-mysql-cluster"}
+		]}`),
+	)
+
+	id, err := s.environ().ensureServerGroup(
+		constraints.MustParse("placement-group=cluster"),
+		map[string]string{tags.JujuUnitsDeployed: "mysql/0 logging/0"},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, "sg-2")
+}
+
+func (s *serverGroupSuite) TestEnsureServerGroupCreates(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.client.EXPECT().SendRequest("GET", "compute", "v2", "os-server-groups", gomock.Any()).DoAndReturn(
+		respondWith(c, `{"server_groups": []}`),
+	)
+	s.client.EXPECT().SendRequest("POST", "compute", "v2", "os-server-groups", gomock.Any()).DoAndReturn(
+		func(method, svcType, svcVersion, apiCall string, requestData *goosehttp.RequestData) error {
+			req, err := json.Marshal(requestData.ReqValue)
+			c.Assert(err, tc.ErrorIsNil)
+			c.Check(string(req), tc.Equals,
+				`{"server_group":{"id":"","name":"juju-ctrl-model-spread","policies":["anti-affinity"]}}`)
+			return respondWith(c, `{"server_group": {"id": "sg-1"}}`)(method, svcType, svcVersion, apiCall, requestData)
+		},
+	)
+
+	id, err := s.environ().ensureServerGroup(constraints.MustParse("placement-group=spread"), nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, "sg-1")
+}
+
+func (s *serverGroupSuite) TestEnsureServerGroupNoConstraint(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	id, err := s.environ().ensureServerGroup(constraints.Value{}, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, "")
+}
+
+func (s *serverGroupSuite) TestEnsureServerGroupDedicatedHost(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.environ().ensureServerGroup(constraints.MustParse("placement-group=dedicated-host"), nil)
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+	c.Check(err, tc.ErrorMatches, `placement-group=dedicated-host on OpenStack not supported`)
+}
+
+func (s *serverGroupSuite) TestDeleteServerGroups(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.client.EXPECT().SendRequest("GET", "compute", "v2", "os-server-groups", gomock.Any()).DoAndReturn(
+		respondWith(c, `{"server_groups": [
+			{"id": "sg-1", "name": "juju-ctrl-model-mysql-cluster"},
+			{"id": "sg-2", "name": "juju-ctrl-other-mysql-cluster"}
+		]}`),
+	)
+	s.client.EXPECT().SendRequest("DELETE", "compute", "v2", "os-server-groups/sg-1", gomock.Any()).Return(nil)
+
+	env := s.environ()
+	err := env.deleteServerGroups(c.Context(), env.serverGroupPrefix())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serverGroupSuite) TestDeleteServerGroupsNotProvided(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.client.EXPECT().SendRequest("GET", "compute", "v2", "os-server-groups", gomock.Any()).Return(
+		gooseerrors.NewNotFoundf(nil, "", "not found"),
+	)
+
+	env := s.environ()
+	err := env.deleteServerGroups(c.Context(), env.serverGroupPrefix())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serverGroupSuite) TestRunServerInGroup(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.client.EXPECT().SendRequest("POST", "compute", "v2", "servers", gomock.Any()).DoAndReturn(
+		func(method, svcType, svcVersion, apiCall string, requestData *goosehttp.RequestData) error {
+			req, err := json.Marshal(requestData.ReqValue)
+			c.Assert(err, tc.ErrorIsNil)
+			var body map[string]any
+			c.Assert(json.Unmarshal(req, &body), tc.ErrorIsNil)
+			c.Check(body["os:scheduler_hints"], tc.DeepEquals, map[string]any{"group": "sg-1"})
+			c.Check(body["server"].(map[string]any)["name"], tc.Equals, "juju-machine-0")
+			return respondWith(c, `{"server": {"id": "server-1"}}`)(method, svcType, svcVersion, apiCall, requestData)
+		},
+	)
+
+	server, err := runServerInGroup(s.client, nova.RunServerOpts{Name: "juju-machine-0"}, "sg-1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(server.Id, tc.Equals, "server-1")
+}
@@ -910,6 +910,7 @@ func (task *provisionerTask) constructInstanceConfig(
 	instanceConfig.LokiCACert = pInfo.LokiCACert
 	instanceConfig.LokiInsecureSkipVerify = pInfo.LokiInsecureSkipVerify
 	instanceConfig.LokiOrgID = pInfo.LokiOrgID
+	instanceConfig.LokiProtocol = pInfo.LokiProtocol
 
 	// Inject the controller-wide tracing config so the machine agent
 	// starts exporting telemetry on first boot. When tracing is not
@@ -1217,6 +1218,17 @@ func (task *provisionerTask) populateDistributionGroupZoneMap(machineIds []strin
 	return dgAvailabilityZoneMachines
 }
 
+// zoneMachineIds returns the IDs of all the machines known to be in the
+// named zone. The caller must hold the machines mutex.
+func (task *provisionerTask) zoneMachineIds(zoneName string) set.Strings {
+	for _, azm := range task.availabilityZoneMachines {
+		if azm.ZoneName == zoneName {
+			return azm.MachineIds
+		}
+	}
+	return set.NewStrings()
+}
+
 // machineAvailabilityZoneDistribution returns a suggested availability zone
 // for the specified machine to start in.
 // If the current provider does not implement availability zones, "" and no
@@ -1225,11 +1237,14 @@ func (task *provisionerTask) populateDistributionGroupZoneMap(machineIds []strin
 // the "available" zones, and any supplied zone constraints.
 // Machines in the same DistributionGroup are placed in different zones,
 // distributed based on lowest population of machines in that DistributionGroup.
-// Machines are not placed in a zone they are excluded from.
+// Amongst equally populated zones, those hosting fewer of the machine's
+// anti-affinity machines are preferred.
+// Machines are not placed in a zone they are excluded from, either by
+// placement or by the application placement rules.
 // If availability zones are implemented and one isn't found, return NotFound error.
 func (task *provisionerTask) machineAvailabilityZoneDistribution(
 	ctx context.Context,
-	machineId string, distGroupMachineIds []string, cons constraints.Value,
+	machineId string, distGroup apiprovisioner.DistributionGroupResult, cons constraints.Value,
 ) (string, error) {
 	task.machinesMutex.Lock()
 	defer task.machinesMutex.Unlock()
@@ -1244,23 +1259,43 @@ func (task *provisionerTask) machineAvailabilityZoneDistribution(
 	// population of the distribution group machine.
 	// If more than one zone has the same number of machines, pick one of those at random.
 	zoneMachines := task.availabilityZoneMachines
-	if len(distGroupMachineIds) > 0 {
-		zoneMachines = task.populateDistributionGroupZoneMap(distGroupMachineIds)
+	if len(distGroup.MachineIds) > 0 {
+		zoneMachines = task.populateDistributionGroupZoneMap(distGroup.MachineIds)
 	}
+	excludedZones := set.NewStrings(distGroup.ExcludedZones...)
+	antiAffinity := set.NewStrings(distGroup.AntiAffinityMachineIds...)
 
-	// Make a map of zone machines keyed on count.
-	zoneMap := make(map[int][]*AvailabilityZoneMachine)
+	// Make a map of zone machines keyed on count, then on the number of
+	// anti-affinity machines in the zone.
+	type zoneCount struct {
+		machines     int
+		antiAffinity int
+	}
+	zoneMap := make(map[zoneCount][]*AvailabilityZoneMachine)
 	for _, zm := range zoneMachines {
-		machineCount := zm.MachineIds.Size()
-		zoneMap[machineCount] = append(zoneMap[machineCount], zm)
+		if excludedZones.Contains(zm.ZoneName) {
+			task.logger.Debugf(ctx, "machine %s does not match az %s: excluded by placement rules",
+				machineId, zm.ZoneName)
+			continue
+		}
+		count := zoneCount{
+			machines:     zm.MachineIds.Size(),
+			antiAffinity: task.zoneMachineIds(zm.ZoneName).Intersection(antiAffinity).Size(),
+		}
+		zoneMap[count] = append(zoneMap[count], zm)
 	}
 	// Sort the counts we have by size so
 	// we can process starting with the lowest.
-	var zoneCounts []int
+	var zoneCounts []zoneCount
 	for k := range zoneMap {
 		zoneCounts = append(zoneCounts, k)
 	}
-	sort.Ints(zoneCounts)
+	sort.Slice(zoneCounts, func(i, j int) bool {
+		if zoneCounts[i].machines != zoneCounts[j].machines {
+			return zoneCounts[i].machines < zoneCounts[j].machines
+		}
+		return zoneCounts[i].antiAffinity < zoneCounts[j].antiAffinity
+	})
 
 	var machineZone string
 done:
@@ -1366,7 +1401,7 @@ func (task *provisionerTask) queueStartMachines(ctx context.Context, machines []
 		// Reassign the loop variable to prevent
 		// overwriting the dispatched references.
 		machine := m
-		distGroup := machineDistributionGroups[i].MachineIds
+		distGroup := machineDistributionGroups[i]
 
 		provTask := workerpool.Task{
 			Type: fmt.Sprintf("start-instance %s", machine.Id()),
@@ -1423,7 +1458,7 @@ func (task *provisionerTask) setErrorStatus(ctx context.Context, msg string, mac
 func (task *provisionerTask) doStartMachine(
 	ctx context.Context,
 	machine apiprovisioner.MachineProvisioner,
-	distributionGroupMachineIds []string,
+	distributionGroup apiprovisioner.DistributionGroupResult,
 	pInfoResult params.ProvisioningInfoResult,
 ) (startErr error) {
 	defer func() {
@@ -1477,7 +1512,7 @@ func (task *provisionerTask) doStartMachine(
 	for attemptsLeft := task.retryStartInstanceStrategy.RetryCount; attemptsLeft >= 0; {
 		if startInstanceParams.AvailabilityZone, err = task.machineAvailabilityZoneDistribution(
 			ctx,
-			machine.Id(), distributionGroupMachineIds, startInstanceParams.Constraints,
+			machine.Id(), distributionGroup, startInstanceParams.Constraints,
 		); err != nil {
 			return task.setErrorStatus(ctx, "cannot start instance for machine %q: %v", machine, err)
 		}
@@ -770,6 +770,43 @@ func (s *ProvisionerTaskSuite) TestZoneConstraintsWithDistributionGroup(c *tc.C)
 	workertest.CleanKill(c, task)
 }
 
+func (s *ProvisionerTaskSuite) TestZoneExcludedByPlacementRules(c *tc.C) {
+	ctrl := s.setUpMocks(c)
+	defer ctrl.Finish()
+
+	m0 := &testMachine{
+		c:  c,
+		id: "0",
+	}
+
+	broker := s.setUpZonedEnviron(ctrl, m0)
+	broker.EXPECT().DeriveAvailabilityZones(gomock.Any(), gomock.Any()).Return([]string{}, nil)
+
+	// The placement rules of the machine's application exclude az1 and
+	// az2, so we expect the machine to be created in az3.
+	derivedZone := newStartInstanceParamsMatcher(map[string]func(environs.StartInstanceParams) bool{
+		"availability zone: az3": func(p environs.StartInstanceParams) bool {
+			return p.AvailabilityZone == "az3"
+		},
+	})
+
+	// Use satisfaction of this call as the synchronisation point.
+	broker.EXPECT().StartInstance(gomock.Any(), derivedZone).Return(&environs.StartInstanceResult{
+		Instance: &testInstance{id: "instance-0"},
+	}, nil)
+
+	finder := &mockDistributionGroupFinder{
+		results: map[names.MachineTag]apiprovisioner.DistributionGroupResult{
+			names.NewMachineTag("0"): {ExcludedZones: []string{"az1", "az2"}},
+		},
+	}
+	task := s.newProvisionerTaskWithBrokerAndFinder(c, broker, finder, numProvisionWorkersForTesting, nil)
+
+	s.sendModelMachinesChange(c, "0")
+	s.waitForProvisioned(c, m0)
+	workertest.CleanKill(c, task)
+}
+
 func (s *ProvisionerTaskSuite) TestZoneConstraintsWithDistributionGroupRetry(c *tc.C) {
 	ctrl := s.setUpMocks(c)
 	defer ctrl.Finish()
@@ -1791,13 +1828,24 @@ func (s *ProvisionerTaskSuite) newProvisionerTaskWithBrokerAndEventCb(
 	distributionGroups map[names.MachineTag][]string,
 	numProvisionWorkers int,
 	evtCb func(string),
+) provisionertask.ProvisionerTask {
+	return s.newProvisionerTaskWithBrokerAndFinder(c, broker,
+		&mockDistributionGroupFinder{groups: distributionGroups}, numProvisionWorkers, evtCb)
+}
+
+func (s *ProvisionerTaskSuite) newProvisionerTaskWithBrokerAndFinder(
+	c *tc.C,
+	broker environs.InstanceBroker,
+	distributionGroupFinder provisionertask.DistributionGroupFinder,
+	numProvisionWorkers int,
+	evtCb func(string),
 ) provisionertask.ProvisionerTask {
 	task, err := provisionertask.NewProvisionerTask(provisionertask.TaskConfig{
 		ControllerUUID:          internaltesting.ControllerTag.Id(),
 		Logger:                  loggertesting.WrapCheckLog(c),
 		ControllerAPI:           s.controllerAPI,
 		MachinesAPI:             s.machinesAPI,
-		DistributionGroupFinder: &mockDistributionGroupFinder{groups: distributionGroups},
+		DistributionGroupFinder: distributionGroupFinder,
 		ToolsFinder:             mockToolsFinder{},
 		MachineWatcher:          s.modelMachinesWatcher,
 		RetryWatcher:            s.machineErrorRetryWatcher,
@@ -2311,7 +2359,8 @@ func newStartInstanceParamsMatcher(
 }
 
 type mockDistributionGroupFinder struct {
-	groups map[names.MachineTag][]string
+	groups  map[names.MachineTag][]string
+	results map[names.MachineTag]apiprovisioner.DistributionGroupResult
 }
 
 func (mock *mockDistributionGroupFinder) DistributionGroupByMachineId(
@@ -2319,7 +2368,11 @@ func (mock *mockDistributionGroupFinder) DistributionGroupByMachineId(
 	tags ...names.MachineTag,
 ) ([]apiprovisioner.DistributionGroupResult, error) {
 	result := make([]apiprovisioner.DistributionGroupResult, len(tags))
-	if len(mock.groups) == 0 {
+	if len(mock.results) > 0 {
+		for i, tag := range tags {
+			result[i] = mock.results[tag]
+		}
+	} else if len(mock.groups) == 0 {
 		for i := range tags {
 			result[i] = apiprovisioner.DistributionGroupResult{MachineIds: []string{}}
 		}
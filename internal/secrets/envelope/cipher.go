@@ -0,0 +1,131 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// EncryptedPrefix marks a value encrypted with a data key. It is
+	// followed by the data key ID and the base64 encoded nonce and
+	// ciphertext, separated by colons.
+	EncryptedPrefix = "juju-enc:v1:"
+
+	// keySize is the size in bytes of data keys and local
+	// key-encryption keys, selecting AES-256.
+	keySize = 32
+)
+
+// DataKey is a key used to encrypt secret content.
+type DataKey struct {
+	// ID identifies the data key. It is recorded alongside each value
+	// encrypted with the key.
+	ID string
+
+	// Key is the plaintext key material.
+	Key []byte
+}
+
+// NewKey returns new random key material suitable for a data key or a
+// local key-encryption key.
+func NewKey() ([]byte, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Errorf("generating key: %w", err)
+	}
+	return key, nil
+}
+
+// IsEncrypted returns true if the value was encrypted with a data key.
+// Content stored before encryption was enabled is returned unchanged by
+// [DecryptValue], so callers can use this to find content still held in
+// the clear.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, EncryptedPrefix)
+}
+
+// DataKeyID returns the ID of the data key the value was encrypted with.
+func DataKeyID(value string) (string, error) {
+	id, _, err := splitEncrypted(value)
+	return id, err
+}
+
+// EncryptValue encrypts the value with the data key.
+func EncryptValue(key DataKey, value string) (string, error) {
+	aead, err := newAEAD(key.Key)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.Errorf("generating nonce: %w", err)
+	}
+	// The data key ID is authenticated so that a value can't be passed
+	// off as having been encrypted with a different key.
+	sealed := aead.Seal(nonce, nonce, []byte(value), []byte(key.ID))
+	return EncryptedPrefix + key.ID + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptValue decrypts a value encrypted with the data key. Values which
+// aren't encrypted are returned unchanged.
+func DecryptValue(key DataKey, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	id, sealed, err := splitEncrypted(value)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	if id != key.ID {
+		return "", errors.Errorf("value encrypted with data key %q, not %q", id, key.ID)
+	}
+	aead, err := newAEAD(key.Key)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("encrypted value too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(id))
+	if err != nil {
+		return "", errors.Errorf("decrypting value with data key %q: %w", id, err)
+	}
+	return string(plaintext), nil
+}
+
+func splitEncrypted(value string) (string, []byte, error) {
+	rest, ok := strings.CutPrefix(value, EncryptedPrefix)
+	if !ok {
+		return "", nil, errors.New("value is not encrypted")
+	}
+	id, encoded, ok := strings.Cut(rest, ":")
+	if !ok || id == "" {
+		return "", nil, errors.New("encrypted value missing data key ID")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", nil, errors.Errorf("decoding encrypted value: %w", err)
+	}
+	return id, sealed, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Errorf("creating cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Errorf("creating cipher: %w", err)
+	}
+	return aead, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package envelope
+
+import (
+	"strings"
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+)
+
+type cipherSuite struct{}
+
+func TestCipherSuite(t *stdtesting.T) {
+	tc.Run(t, &cipherSuite{})
+}
+
+func (s *cipherSuite) dataKey(c *tc.C, id string) DataKey {
+	key, err := NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	return DataKey{ID: id, Key: key}
+}
+
+func (s *cipherSuite) TestEncryptDecrypt(c *tc.C) {
+	key := s.dataKey(c, "dk-1")
+
+	encrypted, err := EncryptValue(key, "s3cret")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(IsEncrypted(encrypted), tc.IsTrue)
+	c.Check(strings.Contains(encrypted, "s3cret"), tc.IsFalse)
+
+	id, err := DataKeyID(encrypted)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, "dk-1")
+
+	decrypted, err := DecryptValue(key, encrypted)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(decrypted, tc.Equals, "s3cret")
+}
+
+func (s *cipherSuite) TestEncryptUsesFreshNonce(c *tc.C) {
+	key := s.dataKey(c, "dk-1")
+
+	first, err := EncryptValue(key, "s3cret")
+	c.Assert(err, tc.ErrorIsNil)
+	second, err := EncryptValue(key, "s3cret")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(first, tc.Not(tc.Equals), second)
+}
+
+func (s *cipherSuite) TestDecryptPlaintext(c *tc.C) {
+	decrypted, err := DecryptValue(s.dataKey(c, "dk-1"), "s3cret")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(decrypted, tc.Equals, "s3cret")
+}
+
+func (s *cipherSuite) TestDecryptWrongKeyID(c *tc.C) {
+	encrypted, err := EncryptValue(s.dataKey(c, "dk-1"), "s3cret")
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = DecryptValue(s.dataKey(c, "dk-2"), encrypted)
+	c.Assert(err, tc.ErrorMatches, `value encrypted with data key "dk-1", not "dk-2"`)
+}
+
+func (s *cipherSuite) TestDecryptWrongKey(c *tc.C) {
+	encrypted, err := EncryptValue(s.dataKey(c, "dk-1"), "s3cret")
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = DecryptValue(s.dataKey(c, "dk-1"), encrypted)
+	c.Assert(err, tc.ErrorMatches, `decrypting value with data key "dk-1": .*`)
+}
+
+func (s *cipherSuite) TestDecryptTampered(c *tc.C) {
+	key := s.dataKey(c, "dk-1")
+	encrypted, err := EncryptValue(key, "s3cret")
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Moving the value to another data key ID fails authentication.
+	tampered := strings.Replace(encrypted, ":dk-1:", ":dk-2:", 1)
+	_, err = DecryptValue(DataKey{ID: "dk-2", Key: key.Key}, tampered)
+	c.Assert(err, tc.ErrorMatches, `decrypting value with data key "dk-2": .*`)
+}
@@ -0,0 +1,13 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package envelope provides envelope encryption for secret content stored
+// in the Juju database.
+//
+// Secret values are encrypted with a per-model data key using AES-256-GCM.
+// Data keys are never stored in the clear; they are wrapped by a
+// controller key-encryption key, held either by the controller itself or
+// by an external key management service such as the Vault transit engine.
+// Rotating the key-encryption key only requires the data keys to be
+// re-wrapped; the secret content encrypted with them is left untouched.
+package envelope
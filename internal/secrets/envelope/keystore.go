@@ -0,0 +1,154 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package envelope
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/juju/utils/v4"
+	"gopkg.in/yaml.v3"
+
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// KeyStoreFile is the name of the file, in a controller agent's data
+// directory, holding the controller's key-encryption keys.
+const KeyStoreFile = "secret-encryption-keys.yaml"
+
+// KeyStorePath returns the path of the key-encryption key file in the given
+// agent data directory.
+func KeyStorePath(dataDir string) string {
+	return filepath.Join(dataDir, KeyStoreFile)
+}
+
+// KeyStore holds the controller's key-encryption keys. The keys are kept
+// out of the controller database, so that a copy of the database does not
+// reveal the data keys wrapped with them.
+type KeyStore interface {
+	// KeyEncryptionKeys returns the key-encryption keys, oldest first. The
+	// last key is the one used to wrap new data keys.
+	KeyEncryptionKeys() ([]KeyEncryptionKey, error)
+
+	// AddKeyEncryptionKeys appends the keys not already held to the
+	// key-encryption keys.
+	AddKeyEncryptionKeys(keys ...KeyEncryptionKey) error
+}
+
+// NewKeyEncryptionKey returns a new random key-encryption key.
+func NewKeyEncryptionKey() (KeyEncryptionKey, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return KeyEncryptionKey{}, errors.Capture(err)
+	}
+	key, err := NewKey()
+	if err != nil {
+		return KeyEncryptionKey{}, errors.Capture(err)
+	}
+	return KeyEncryptionKey{ID: id.String(), Key: key}, nil
+}
+
+// fileKeyStoreMu serialises updates to key store files, which may be
+// written by several workers of the same agent.
+var fileKeyStoreMu sync.Mutex
+
+// FileKeyStore is a [KeyStore] held in a file readable only by the
+// controller agent.
+type FileKeyStore struct {
+	path string
+}
+
+// NewFileKeyStore returns a key store held in the file at the given path.
+// The file is created when the first key is added.
+func NewFileKeyStore(path string) *FileKeyStore {
+	return &FileKeyStore{path: path}
+}
+
+type keyStoreDoc struct {
+	Keys []keyStoreKey `yaml:"keys"`
+}
+
+type keyStoreKey struct {
+	ID  string `yaml:"id"`
+	Key string `yaml:"key"`
+}
+
+// KeyEncryptionKeys is part of the [KeyStore] interface.
+func (s *FileKeyStore) KeyEncryptionKeys() ([]KeyEncryptionKey, error) {
+	fileKeyStoreMu.Lock()
+	defer fileKeyStoreMu.Unlock()
+
+	return s.read()
+}
+
+// AddKeyEncryptionKeys is part of the [KeyStore] interface.
+func (s *FileKeyStore) AddKeyEncryptionKeys(keys ...KeyEncryptionKey) error {
+	fileKeyStoreMu.Lock()
+	defer fileKeyStoreMu.Unlock()
+
+	existing, err := s.read()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	changed := false
+	for _, key := range keys {
+		if slices.ContainsFunc(existing, func(k KeyEncryptionKey) bool { return k.ID == key.ID }) {
+			continue
+		}
+		existing = append(existing, key)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	var doc keyStoreDoc
+	for _, key := range existing {
+		doc.Keys = append(doc.Keys, keyStoreKey{
+			ID:  key.ID,
+			Key: base64.StdEncoding.EncodeToString(key.Key),
+		})
+	}
+	data, err := yaml.Marshal(doc)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := utils.AtomicWriteFile(s.path, data, 0600); err != nil {
+		return errors.Errorf("writing key-encryption keys: %w", err)
+	}
+	return nil
+}
+
+func (s *FileKeyStore) read() ([]KeyEncryptionKey, error) {
+	info, err := os.Stat(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Errorf("reading key-encryption keys: %w", err)
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		return nil, errors.Errorf("key-encryption key file %q must only be accessible by its owner", s.path)
+	}
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, errors.Errorf("reading key-encryption keys: %w", err)
+	}
+	var doc keyStoreDoc
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, errors.Errorf("parsing key-encryption key file %q: %w", s.path, err)
+	}
+	keys := make([]KeyEncryptionKey, len(doc.Keys))
+	for i, key := range doc.Keys {
+		material, err := base64.StdEncoding.DecodeString(key.Key)
+		if err != nil {
+			return nil, errors.Errorf("decoding key-encryption key %q: %w", key.ID, err)
+		}
+		keys[i] = KeyEncryptionKey{ID: key.ID, Key: material}
+	}
+	return keys, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package envelope
+
+import (
+	"os"
+	"path/filepath"
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+)
+
+type fileKeyStoreSuite struct{}
+
+func TestFileKeyStoreSuite(t *stdtesting.T) {
+	tc.Run(t, &fileKeyStoreSuite{})
+}
+
+func (s *fileKeyStoreSuite) TestNoFile(c *tc.C) {
+	store := NewFileKeyStore(filepath.Join(c.MkDir(), KeyStoreFile))
+
+	keys, err := store.KeyEncryptionKeys()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(keys, tc.HasLen, 0)
+}
+
+func (s *fileKeyStoreSuite) TestAddKeyEncryptionKeys(c *tc.C) {
+	path := KeyStorePath(c.MkDir())
+	store := NewFileKeyStore(path)
+	kek1, err := NewKeyEncryptionKey()
+	c.Assert(err, tc.ErrorIsNil)
+	kek2, err := NewKeyEncryptionKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = store.AddKeyEncryptionKeys(kek1)
+	c.Assert(err, tc.ErrorIsNil)
+	err = store.AddKeyEncryptionKeys(kek1, kek2)
+	c.Assert(err, tc.ErrorIsNil)
+
+	keys, err := NewFileKeyStore(path).KeyEncryptionKeys()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(keys, tc.DeepEquals, []KeyEncryptionKey{kek1, kek2})
+
+	info, err := os.Stat(path)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(info.Mode().Perm(), tc.Equals, os.FileMode(0600))
+}
+
+func (s *fileKeyStoreSuite) TestReadableByOthers(c *tc.C) {
+	path := KeyStorePath(c.MkDir())
+	err := os.WriteFile(path, []byte("keys: []\n"), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = NewFileKeyStore(path).KeyEncryptionKeys()
+	c.Assert(err, tc.ErrorMatches, `key-encryption key file .* must only be accessible by its owner`)
+}
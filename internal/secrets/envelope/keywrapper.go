@@ -0,0 +1,205 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package envelope
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// KeyWrapper wraps and unwraps data keys with a key-encryption key.
+type KeyWrapper interface {
+	// Wrap encrypts the data key material with the current
+	// key-encryption key.
+	Wrap(ctx context.Context, key []byte) (string, error)
+
+	// Unwrap decrypts wrapped data key material.
+	Unwrap(ctx context.Context, wrapped string) ([]byte, error)
+
+	// Rewrap returns the wrapped data key material wrapped with the
+	// current key-encryption key, and whether it changed. Data keys
+	// already wrapped with the current key are returned unchanged.
+	Rewrap(ctx context.Context, wrapped string) (string, bool, error)
+
+	// CanUnwrap returns true if the wrapped data key material was
+	// produced by this kind of key wrapper.
+	CanUnwrap(wrapped string) bool
+}
+
+// localPrefix marks data keys wrapped by a local key-encryption key. It is
+// followed by the key-encryption key ID and the base64 encoded wrapped key,
+// separated by colons.
+const localPrefix = "local:"
+
+// KeyEncryptionKey is a key-encryption key held by the controller.
+type KeyEncryptionKey struct {
+	// ID identifies the key-encryption key.
+	ID string
+
+	// Key is the key material.
+	Key []byte
+}
+
+// LocalKeyWrapper wraps data keys with key-encryption keys held by the
+// controller.
+type LocalKeyWrapper struct {
+	current KeyEncryptionKey
+	keys    map[string]KeyEncryptionKey
+}
+
+// NewLocalKeyWrapper returns a key wrapper which wraps data keys with the
+// current key-encryption key, and can unwrap data keys wrapped with the
+// current or any of the previous key-encryption keys.
+func NewLocalKeyWrapper(current KeyEncryptionKey, previous ...KeyEncryptionKey) (*LocalKeyWrapper, error) {
+	keys := make(map[string]KeyEncryptionKey, len(previous)+1)
+	for _, kek := range append(previous, current) {
+		if kek.ID == "" || strings.Contains(kek.ID, ":") {
+			return nil, errors.Errorf("key-encryption key ID %q %w", kek.ID, coreerrors.NotValid)
+		}
+		if len(kek.Key) != keySize {
+			return nil, errors.Errorf("key-encryption key %q must be %d bytes %w", kek.ID, keySize, coreerrors.NotValid)
+		}
+		keys[kek.ID] = kek
+	}
+	return &LocalKeyWrapper{
+		current: current,
+		keys:    keys,
+	}, nil
+}
+
+// Wrap is part of the [KeyWrapper] interface.
+func (w *LocalKeyWrapper) Wrap(_ context.Context, key []byte) (string, error) {
+	sealed, err := EncryptValue(DataKey{ID: w.current.ID, Key: w.current.Key}, base64.StdEncoding.EncodeToString(key))
+	if err != nil {
+		return "", errors.Errorf("wrapping data key: %w", err)
+	}
+	return localPrefix + strings.TrimPrefix(sealed, EncryptedPrefix), nil
+}
+
+// Unwrap is part of the [KeyWrapper] interface.
+func (w *LocalKeyWrapper) Unwrap(_ context.Context, wrapped string) ([]byte, error) {
+	kek, err := w.wrappingKey(wrapped)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	encoded, err := DecryptValue(DataKey{ID: kek.ID, Key: kek.Key}, EncryptedPrefix+strings.TrimPrefix(wrapped, localPrefix))
+	if err != nil {
+		return nil, errors.Errorf("unwrapping data key: %w", err)
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.Errorf("unwrapping data key: %w", err)
+	}
+	return key, nil
+}
+
+// Rewrap is part of the [KeyWrapper] interface.
+func (w *LocalKeyWrapper) Rewrap(ctx context.Context, wrapped string) (string, bool, error) {
+	kek, err := w.wrappingKey(wrapped)
+	if err != nil {
+		return "", false, errors.Capture(err)
+	}
+	if kek.ID == w.current.ID {
+		return wrapped, false, nil
+	}
+	key, err := w.Unwrap(ctx, wrapped)
+	if err != nil {
+		return "", false, errors.Capture(err)
+	}
+	rewrapped, err := w.Wrap(ctx, key)
+	if err != nil {
+		return "", false, errors.Capture(err)
+	}
+	return rewrapped, true, nil
+}
+
+// CanUnwrap is part of the [KeyWrapper] interface.
+func (w *LocalKeyWrapper) CanUnwrap(wrapped string) bool {
+	return strings.HasPrefix(wrapped, localPrefix)
+}
+
+// wrappingKey returns the key-encryption key the data key was wrapped
+// with.
+func (w *LocalKeyWrapper) wrappingKey(wrapped string) (KeyEncryptionKey, error) {
+	rest, ok := strings.CutPrefix(wrapped, localPrefix)
+	if !ok {
+		return KeyEncryptionKey{}, errors.Errorf("data key not wrapped by a local key-encryption key %w", coreerrors.NotValid)
+	}
+	id, _, _ := strings.Cut(rest, ":")
+	kek, ok := w.keys[id]
+	if !ok {
+		return KeyEncryptionKey{}, errors.Errorf("key-encryption key %q %w", id, coreerrors.NotFound)
+	}
+	return kek, nil
+}
+
+// MultiKeyWrapper wraps data keys with a current key wrapper, and unwraps
+// data keys with whichever of its key wrappers produced them. It allows the
+// key-encryption key provider to be changed, with existing data keys moved
+// to the new provider as they are re-wrapped.
+type MultiKeyWrapper struct {
+	current  KeyWrapper
+	wrappers []KeyWrapper
+}
+
+// NewMultiKeyWrapper returns a key wrapper which wraps data keys with the
+// current key wrapper, and can unwrap data keys wrapped by it or any of the
+// others.
+func NewMultiKeyWrapper(current KeyWrapper, others ...KeyWrapper) *MultiKeyWrapper {
+	return &MultiKeyWrapper{
+		current:  current,
+		wrappers: append([]KeyWrapper{current}, others...),
+	}
+}
+
+// Wrap is part of the [KeyWrapper] interface.
+func (w *MultiKeyWrapper) Wrap(ctx context.Context, key []byte) (string, error) {
+	return w.current.Wrap(ctx, key)
+}
+
+// Unwrap is part of the [KeyWrapper] interface.
+func (w *MultiKeyWrapper) Unwrap(ctx context.Context, wrapped string) ([]byte, error) {
+	wrapper, err := w.wrapperFor(wrapped)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return wrapper.Unwrap(ctx, wrapped)
+}
+
+// Rewrap is part of the [KeyWrapper] interface. Data keys wrapped by a key
+// wrapper other than the current one are unwrapped and wrapped again with
+// the current key wrapper.
+func (w *MultiKeyWrapper) Rewrap(ctx context.Context, wrapped string) (string, bool, error) {
+	if w.current.CanUnwrap(wrapped) {
+		return w.current.Rewrap(ctx, wrapped)
+	}
+	key, err := w.Unwrap(ctx, wrapped)
+	if err != nil {
+		return "", false, errors.Capture(err)
+	}
+	rewrapped, err := w.current.Wrap(ctx, key)
+	if err != nil {
+		return "", false, errors.Capture(err)
+	}
+	return rewrapped, true, nil
+}
+
+// CanUnwrap is part of the [KeyWrapper] interface.
+func (w *MultiKeyWrapper) CanUnwrap(wrapped string) bool {
+	_, err := w.wrapperFor(wrapped)
+	return err == nil
+}
+
+func (w *MultiKeyWrapper) wrapperFor(wrapped string) (KeyWrapper, error) {
+	for _, wrapper := range w.wrappers {
+		if wrapper.CanUnwrap(wrapped) {
+			return wrapper, nil
+		}
+	}
+	return nil, errors.Errorf("no key wrapper for data key %w", coreerrors.NotFound)
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package envelope
+
+import (
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+)
+
+type localKeyWrapperSuite struct{}
+
+func TestLocalKeyWrapperSuite(t *stdtesting.T) {
+	tc.Run(t, &localKeyWrapperSuite{})
+}
+
+func (s *localKeyWrapperSuite) kek(c *tc.C, id string) KeyEncryptionKey {
+	key, err := NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	return KeyEncryptionKey{ID: id, Key: key}
+}
+
+func (s *localKeyWrapperSuite) TestWrapUnwrap(c *tc.C) {
+	w, err := NewLocalKeyWrapper(s.kek(c, "kek-1"))
+	c.Assert(err, tc.ErrorIsNil)
+	dataKey, err := NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	wrapped, err := w.Wrap(c.Context(), dataKey)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(wrapped, tc.Matches, `local:kek-1:.+`)
+
+	unwrapped, err := w.Unwrap(c.Context(), wrapped)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unwrapped, tc.DeepEquals, dataKey)
+}
+
+func (s *localKeyWrapperSuite) TestRewrap(c *tc.C) {
+	old := s.kek(c, "kek-1")
+	w, err := NewLocalKeyWrapper(old)
+	c.Assert(err, tc.ErrorIsNil)
+	dataKey, err := NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	wrapped, err := w.Wrap(c.Context(), dataKey)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Wrapped with the current key, nothing changes.
+	rewrapped, changed, err := w.Rewrap(c.Context(), wrapped)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(changed, tc.IsFalse)
+	c.Check(rewrapped, tc.Equals, wrapped)
+
+	w, err = NewLocalKeyWrapper(s.kek(c, "kek-2"), old)
+	c.Assert(err, tc.ErrorIsNil)
+	rewrapped, changed, err = w.Rewrap(c.Context(), wrapped)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(changed, tc.IsTrue)
+	c.Check(rewrapped, tc.Matches, `local:kek-2:.+`)
+
+	unwrapped, err := w.Unwrap(c.Context(), rewrapped)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unwrapped, tc.DeepEquals, dataKey)
+}
+
+func (s *localKeyWrapperSuite) TestUnwrapUnknownKey(c *tc.C) {
+	w, err := NewLocalKeyWrapper(s.kek(c, "kek-1"))
+	c.Assert(err, tc.ErrorIsNil)
+	wrapped, err := w.Wrap(c.Context(), []byte("key"))
+	c.Assert(err, tc.ErrorIsNil)
+
+	w, err = NewLocalKeyWrapper(s.kek(c, "kek-2"))
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = w.Unwrap(c.Context(), wrapped)
+	c.Assert(err, tc.ErrorIs, coreerrors.NotFound)
+}
+
+func (s *localKeyWrapperSuite) TestUnwrapNotLocal(c *tc.C) {
+	w, err := NewLocalKeyWrapper(s.kek(c, "kek-1"))
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = w.Unwrap(c.Context(), "vault:v1:abc")
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *localKeyWrapperSuite) TestInvalidKey(c *tc.C) {
+	_, err := NewLocalKeyWrapper(KeyEncryptionKey{ID: "kek-1", Key: []byte("short")})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+	_, err = NewLocalKeyWrapper(KeyEncryptionKey{ID: "kek:1", Key: make([]byte, 32)})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
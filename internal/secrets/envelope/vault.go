@@ -0,0 +1,162 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package envelope
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/vault/api"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// DefaultVaultTransitMount is the path the Vault transit secrets engine is
+// mounted on by default.
+const DefaultVaultTransitMount = "transit"
+
+// vaultPrefix is the prefix of ciphertext produced by a Vault transit
+// secrets engine.
+const vaultPrefix = "vault:"
+
+// VaultTransitConfig holds the details of a key in a Vault transit secrets
+// engine.
+type VaultTransitConfig struct {
+	// Address is the URL of the Vault server.
+	Address string
+
+	// Token is the Vault token used to access the transit engine.
+	Token string
+
+	// CACert is the PEM encoded CA certificate of the Vault server.
+	CACert string
+
+	// Mount is the path the transit engine is mounted on.
+	Mount string
+
+	// Key is the name of the transit key.
+	Key string
+}
+
+// Validate returns an error if the config is not valid.
+func (c VaultTransitConfig) Validate() error {
+	if c.Address == "" {
+		return errors.Errorf("vault address %w", coreerrors.NotValid)
+	}
+	if c.Key == "" {
+		return errors.Errorf("vault transit key %w", coreerrors.NotValid)
+	}
+	return nil
+}
+
+// ReadVaultTokenFile returns the Vault token held in the file at the given
+// path. The token is kept out of the controller configuration, so it is
+// read from a file on each controller machine.
+func ReadVaultTokenFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Errorf("reading vault token: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// VaultTransitKeyWrapper wraps data keys with a key held in a Vault transit
+// secrets engine. The key-encryption key never leaves Vault; rotating it
+// in Vault and re-wrapping the data keys keeps the data keys themselves
+// out of Juju's hands.
+type VaultTransitKeyWrapper struct {
+	client *api.Client
+	mount  string
+	key    string
+}
+
+// NewVaultTransitKeyWrapper returns a key wrapper using the Vault transit
+// key described by the config.
+func NewVaultTransitKeyWrapper(cfg VaultTransitConfig) (*VaultTransitKeyWrapper, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	vaultCfg := api.DefaultConfig()
+	vaultCfg.Address = cfg.Address
+	if cfg.CACert != "" {
+		if err := vaultCfg.ConfigureTLS(&api.TLSConfig{
+			CACertBytes: []byte(cfg.CACert),
+		}); err != nil {
+			return nil, errors.Errorf("configuring vault TLS: %w", err)
+		}
+	}
+	client, err := api.NewClient(vaultCfg)
+	if err != nil {
+		return nil, errors.Errorf("creating vault client: %w", err)
+	}
+	client.SetToken(cfg.Token)
+
+	mount := cfg.Mount
+	if mount == "" {
+		mount = DefaultVaultTransitMount
+	}
+	return &VaultTransitKeyWrapper{
+		client: client,
+		mount:  strings.Trim(mount, "/"),
+		key:    cfg.Key,
+	}, nil
+}
+
+// Wrap is part of the [KeyWrapper] interface.
+func (w *VaultTransitKeyWrapper) Wrap(ctx context.Context, key []byte) (string, error) {
+	ciphertext, err := w.write(ctx, "encrypt", "plaintext", base64.StdEncoding.EncodeToString(key), "ciphertext")
+	if err != nil {
+		return "", errors.Errorf("wrapping data key: %w", err)
+	}
+	return ciphertext, nil
+}
+
+// Unwrap is part of the [KeyWrapper] interface.
+func (w *VaultTransitKeyWrapper) Unwrap(ctx context.Context, wrapped string) ([]byte, error) {
+	encoded, err := w.write(ctx, "decrypt", "ciphertext", wrapped, "plaintext")
+	if err != nil {
+		return nil, errors.Errorf("unwrapping data key: %w", err)
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.Errorf("unwrapping data key: %w", err)
+	}
+	return key, nil
+}
+
+// Rewrap is part of the [KeyWrapper] interface. The data key is re-wrapped
+// inside Vault, so its plaintext is never seen by the controller.
+func (w *VaultTransitKeyWrapper) Rewrap(ctx context.Context, wrapped string) (string, bool, error) {
+	rewrapped, err := w.write(ctx, "rewrap", "ciphertext", wrapped, "ciphertext")
+	if err != nil {
+		return "", false, errors.Errorf("rewrapping data key: %w", err)
+	}
+	return rewrapped, rewrapped != wrapped, nil
+}
+
+// CanUnwrap is part of the [KeyWrapper] interface.
+func (w *VaultTransitKeyWrapper) CanUnwrap(wrapped string) bool {
+	return strings.HasPrefix(wrapped, vaultPrefix)
+}
+
+func (w *VaultTransitKeyWrapper) write(ctx context.Context, op, inField, in, outField string) (string, error) {
+	secret, err := w.client.Logical().WriteWithContext(ctx, path.Join(w.mount, op, w.key), map[string]any{
+		inField: in,
+	})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	if secret == nil || secret.Data == nil {
+		return "", errors.Errorf("empty response from vault transit %s", op)
+	}
+	out, _ := secret.Data[outField].(string)
+	if out == "" {
+		return "", errors.Errorf("vault transit %s response missing %q", op, outField)
+	}
+	return out, nil
+}
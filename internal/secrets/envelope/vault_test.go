@@ -0,0 +1,159 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package envelope
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+)
+
+type vaultKeyWrapperSuite struct {
+	server  *httptest.Server
+	version int
+	paths   []string
+}
+
+func TestVaultKeyWrapperSuite(t *stdtesting.T) {
+	tc.Run(t, &vaultKeyWrapperSuite{})
+}
+
+func (s *vaultKeyWrapperSuite) SetUpTest(c *tc.C) {
+	s.version = 1
+	s.paths = nil
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveTransit))
+}
+
+func (s *vaultKeyWrapperSuite) TearDownTest(c *tc.C) {
+	s.server.Close()
+}
+
+// serveTransit fakes the encrypt, decrypt and rewrap endpoints of a transit
+// engine. Its "ciphertext" is the plaintext tagged with the key version.
+func (s *vaultKeyWrapperSuite) serveTransit(w http.ResponseWriter, r *http.Request) {
+	s.paths = append(s.paths, r.URL.Path)
+	if r.Header.Get("X-Vault-Token") != "token" {
+		http.Error(w, `{"errors":["permission denied"]}`, http.StatusForbidden)
+		return
+	}
+	var in map[string]string
+	if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	prefix := fmt.Sprintf("vault:v%d:", s.version)
+	data := map[string]string{}
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/encrypt/juju"):
+		data["ciphertext"] = prefix + in["plaintext"]
+	case strings.HasSuffix(r.URL.Path, "/decrypt/juju"):
+		parts := strings.SplitN(in["ciphertext"], ":", 3)
+		data["plaintext"] = parts[2]
+	case strings.HasSuffix(r.URL.Path, "/rewrap/juju"):
+		parts := strings.SplitN(in["ciphertext"], ":", 3)
+		data["ciphertext"] = prefix + parts[2]
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+}
+
+func (s *vaultKeyWrapperSuite) newWrapper(c *tc.C, mount string) *VaultTransitKeyWrapper {
+	w, err := NewVaultTransitKeyWrapper(VaultTransitConfig{
+		Address: s.server.URL,
+		Token:   "token",
+		Mount:   mount,
+		Key:     "juju",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return w
+}
+
+func (s *vaultKeyWrapperSuite) TestWrapUnwrap(c *tc.C) {
+	w := s.newWrapper(c, "")
+
+	wrapped, err := w.Wrap(c.Context(), []byte("data key"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(wrapped, tc.Matches, `vault:v1:.+`)
+
+	key, err := w.Unwrap(c.Context(), wrapped)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(key), tc.Equals, "data key")
+	c.Check(s.paths, tc.DeepEquals, []string{"/v1/transit/encrypt/juju", "/v1/transit/decrypt/juju"})
+}
+
+func (s *vaultKeyWrapperSuite) TestRewrap(c *tc.C) {
+	w := s.newWrapper(c, "/juju-transit/")
+	wrapped, err := w.Wrap(c.Context(), []byte("data key"))
+	c.Assert(err, tc.ErrorIsNil)
+
+	rewrapped, changed, err := w.Rewrap(c.Context(), wrapped)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(changed, tc.IsFalse)
+	c.Check(rewrapped, tc.Equals, wrapped)
+
+	// Rotating the key in vault changes the wrapping.
+	s.version = 2
+	rewrapped, changed, err = w.Rewrap(c.Context(), wrapped)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(changed, tc.IsTrue)
+	c.Check(rewrapped, tc.Matches, `vault:v2:.+`)
+	c.Check(s.paths[len(s.paths)-1], tc.Equals, "/v1/juju-transit/rewrap/juju")
+}
+
+func (s *vaultKeyWrapperSuite) TestPermissionDenied(c *tc.C) {
+	w, err := NewVaultTransitKeyWrapper(VaultTransitConfig{
+		Address: s.server.URL,
+		Token:   "wrong",
+		Key:     "juju",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = w.Wrap(c.Context(), []byte("data key"))
+	c.Assert(err, tc.ErrorMatches, `(?s)wrapping data key: .*permission denied`)
+}
+
+func (s *vaultKeyWrapperSuite) TestConfigNotValid(c *tc.C) {
+	_, err := NewVaultTransitKeyWrapper(VaultTransitConfig{Key: "juju"})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+	_, err = NewVaultTransitKeyWrapper(VaultTransitConfig{Address: s.server.URL})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *vaultKeyWrapperSuite) TestMultiKeyWrapperMovesToVault(c *tc.C) {
+	kek, err := NewKey()
+	c.Assert(err, tc.ErrorIsNil)
+	local, err := NewLocalKeyWrapper(KeyEncryptionKey{ID: "kek-1", Key: kek})
+	c.Assert(err, tc.ErrorIsNil)
+	localWrapped, err := local.Wrap(c.Context(), []byte("data key"))
+	c.Assert(err, tc.ErrorIsNil)
+
+	w := NewMultiKeyWrapper(s.newWrapper(c, ""), local)
+	c.Check(w.CanUnwrap(localWrapped), tc.IsTrue)
+	c.Check(w.CanUnwrap("other:data"), tc.IsFalse)
+
+	key, err := w.Unwrap(c.Context(), localWrapped)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(key), tc.Equals, "data key")
+
+	rewrapped, changed, err := w.Rewrap(c.Context(), localWrapped)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(changed, tc.IsTrue)
+	c.Check(rewrapped, tc.Matches, `vault:v1:.+`)
+
+	key, err = w.Unwrap(c.Context(), rewrapped)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(key), tc.Equals, "data key")
+
+	_, err = w.Unwrap(c.Context(), "other:data")
+	c.Check(err, tc.ErrorIs, coreerrors.NotFound)
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+)
+
+// SecretsManagerClient is the subset of the Secrets Manager API used by
+// the backend.
+type SecretsManagerClient interface {
+	CreateSecret(context.Context, *secretsmanager.CreateSecretInput, ...func(*secretsmanager.Options)) (*secretsmanager.CreateSecretOutput, error)
+	PutSecretValue(context.Context, *secretsmanager.PutSecretValueInput, ...func(*secretsmanager.Options)) (*secretsmanager.PutSecretValueOutput, error)
+	GetSecretValue(context.Context, *secretsmanager.GetSecretValueInput, ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+	DeleteSecret(context.Context, *secretsmanager.DeleteSecretInput, ...func(*secretsmanager.Options)) (*secretsmanager.DeleteSecretOutput, error)
+	ListSecrets(context.Context, *secretsmanager.ListSecretsInput, ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error)
+}
+
+type awsBackend struct {
+	// namePrefix is prepended to the revision ID of secret content to
+	// give the name of the secret in Secrets Manager.
+	namePrefix string
+	// managedPrefix is the prefix of the names of all secrets
+	// Juju stores in Secrets Manager, for any model.
+	managedPrefix string
+	kmsKeyID      string
+	client        SecretsManagerClient
+}
+
+func (k awsBackend) secretName(revisionId string) string {
+	return k.namePrefix + revisionId
+}
+
+// GetContent implements SecretsBackend.
+func (k awsBackend) GetContent(ctx context.Context, revisionId string) (_ secrets.SecretValue, err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	out, err := k.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(k.secretName(revisionId)),
+	})
+	if isNotFound(err) {
+		return nil, fmt.Errorf("secret revision %q not found%w", revisionId, errors.Hide(secreterrors.SecretRevisionNotFound))
+	} else if err != nil {
+		return nil, errors.Annotatef(err, "getting secret %q", revisionId)
+	}
+	val := make(map[string]string)
+	if err := json.Unmarshal([]byte(aws.ToString(out.SecretString)), &val); err != nil {
+		return nil, errors.Annotatef(err, "decoding secret %q", revisionId)
+	}
+	return secrets.NewSecretValue(val), nil
+}
+
+// DeleteContent implements SecretsBackend.
+func (k awsBackend) DeleteContent(ctx context.Context, revisionId string) (err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	_, err = k.client.DeleteSecret(ctx, &secretsmanager.DeleteSecretInput{
+		SecretId:                   aws.String(k.secretName(revisionId)),
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+	})
+	if isNotFound(err) {
+		return fmt.Errorf("secret revision %q not found%w", revisionId, errors.Hide(secreterrors.SecretRevisionNotFound))
+	}
+	return errors.Annotatef(err, "deleting secret %q", revisionId)
+}
+
+// SaveContent implements SecretsBackend.
+func (k awsBackend) SaveContent(ctx context.Context, uri *secrets.URI, revision int, value secrets.SecretValue) (_ string, err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	revisionId := uri.Name(revision)
+	data, err := json.Marshal(value.EncodedValues())
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	name := k.secretName(revisionId)
+	in := &secretsmanager.CreateSecretInput{
+		Name:         aws.String(name),
+		SecretString: aws.String(string(data)),
+		Description:  aws.String(fmt.Sprintf("juju secret %s revision %d", uri.ID, revision)),
+	}
+	if k.kmsKeyID != "" {
+		in.KmsKeyId = aws.String(k.kmsKeyID)
+	}
+	_, err = k.client.CreateSecret(ctx, in)
+	if isAlreadyExists(err) {
+		// The content may have been saved by an earlier attempt which
+		// was interrupted before the revision was updated, eg when
+		// draining to a new backend.
+		_, err = k.client.PutSecretValue(ctx, &secretsmanager.PutSecretValueInput{
+			SecretId:     aws.String(name),
+			SecretString: aws.String(string(data)),
+		})
+	}
+	if err != nil {
+		return "", errors.Annotatef(err, "saving secret content for %q", revisionId)
+	}
+	return revisionId, nil
+}
+
+// Ping implements SecretsBackend.
+func (k awsBackend) Ping() error {
+	_, err := k.client.ListSecrets(context.Background(), &secretsmanager.ListSecretsInput{
+		MaxResults: aws.Int32(1),
+	})
+	if err == nil {
+		return nil
+	}
+	if isPermissionDenied(err) {
+		return errors.New("credentials invalid: permission denied")
+	}
+	return errors.Annotate(err, "backend not reachable")
+}
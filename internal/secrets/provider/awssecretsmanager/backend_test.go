@@ -0,0 +1,110 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/secrets/provider"
+	"github.com/juju/juju/internal/secrets/provider/awssecretsmanager"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type backendSuite struct {
+	testhelpers.IsolationSuite
+
+	emulator *emulator
+	backend  provider.SecretsBackend
+}
+
+func TestBackendSuite(t *testing.T) {
+	tc.Run(t, &backendSuite{})
+}
+
+func (s *backendSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.emulator = newEmulator()
+	s.AddCleanup(func(*tc.C) { s.emulator.Close() })
+
+	p := awssecretsmanager.NewProvider()
+	var err error
+	s.backend, err = p.NewBackend(&provider.ModelBackendConfig{
+		ControllerUUID: coretesting.ControllerTag.Id(),
+		ModelUUID:      coretesting.ModelTag.Id(),
+		ModelName:      "fred",
+		BackendConfig:  backendConfig(s.emulator.URL),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func backendConfig(endpoint string) provider.BackendConfig {
+	return provider.BackendConfig{
+		BackendType: awssecretsmanager.BackendType,
+		Config: map[string]any{
+			"region":     "us-east-1",
+			"endpoint":   endpoint,
+			"access-key": "access",
+			"secret-key": "secret",
+			"kms-key-id": "alias/juju",
+		},
+	}
+}
+
+func (s *backendSuite) TestSaveContent(c *tc.C) {
+	uri := secrets.NewURI()
+	revisionId, err := s.backend.SaveContent(c.Context(), uri, 1, secrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(revisionId, tc.Equals, uri.ID+"-1")
+
+	saved := s.emulator.get("juju/fred-" + coretesting.ModelTag.Id()[30:] + "/" + revisionId)
+	c.Assert(saved, tc.NotNil)
+	c.Check(saved.SecretString, tc.Equals, `{"foo":"YmFy"}`)
+	c.Check(saved.KmsKeyId, tc.Equals, "alias/juju")
+	c.Check(saved.Description, tc.Equals, "juju secret "+uri.ID+" revision 1")
+
+	val, err := s.backend.GetContent(c.Context(), revisionId)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(val.EncodedValues(), tc.DeepEquals, map[string]string{"foo": "YmFy"})
+}
+
+func (s *backendSuite) TestSaveContentExisting(c *tc.C) {
+	uri := secrets.NewURI()
+	_, err := s.backend.SaveContent(c.Context(), uri, 1, secrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, tc.ErrorIsNil)
+
+	revisionId, err := s.backend.SaveContent(c.Context(), uri, 1, secrets.NewSecretValue(map[string]string{"foo": "YmF6"}))
+	c.Assert(err, tc.ErrorIsNil)
+
+	val, err := s.backend.GetContent(c.Context(), revisionId)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(val.EncodedValues(), tc.DeepEquals, map[string]string{"foo": "YmF6"})
+}
+
+func (s *backendSuite) TestGetContentNotFound(c *tc.C) {
+	_, err := s.backend.GetContent(c.Context(), "missing-1")
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
+
+func (s *backendSuite) TestDeleteContent(c *tc.C) {
+	uri := secrets.NewURI()
+	revisionId, err := s.backend.SaveContent(c.Context(), uri, 1, secrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.backend.DeleteContent(c.Context(), revisionId)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.emulator.names(), tc.HasLen, 0)
+
+	err = s.backend.DeleteContent(c.Context(), revisionId)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
+
+func (s *backendSuite) TestPing(c *tc.C) {
+	err := s.backend.Ping()
+	c.Assert(err, tc.ErrorIsNil)
+}
@@ -0,0 +1,203 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/juju/errors"
+	"github.com/juju/schema"
+
+	coreconfig "github.com/juju/juju/core/config"
+	"github.com/juju/juju/internal/configschema"
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+const (
+	RegionKey       = "region"
+	EndpointKey     = "endpoint"
+	AccessKeyKey    = "access-key"
+	SecretKeyKey    = "secret-key"
+	SessionTokenKey = "session-token"
+	RoleARNKey      = "role-arn"
+	PrefixKey       = "prefix"
+	KMSKeyIDKey     = "kms-key-id"
+)
+
+// DefaultPrefix is the default prefix of the names of secrets stored in
+// Secrets Manager.
+const DefaultPrefix = "juju"
+
+var configSchema = configschema.Fields{
+	RegionKey: {
+		Description: "The AWS region hosting Secrets Manager.",
+		Type:        configschema.Tstring,
+		Immutable:   true,
+		Mandatory:   true,
+	},
+	EndpointKey: {
+		Description: "The Secrets Manager and STS endpoint, used in place of the AWS endpoints; for example a local emulator.",
+		Type:        configschema.Tstring,
+	},
+	AccessKeyKey: {
+		Description: "The AWS access key. If not set, credentials are read from the controller's environment or instance profile.",
+		Type:        configschema.Tstring,
+	},
+	SecretKeyKey: {
+		Description: "The AWS secret access key.",
+		Type:        configschema.Tstring,
+		Secret:      true,
+	},
+	SessionTokenKey: {
+		Description: "The AWS session token, if the access key is temporary.",
+		Type:        configschema.Tstring,
+		Secret:      true,
+	},
+	RoleARNKey: {
+		Description: "The IAM role assumed to issue credentials restricted to the secrets a unit can access. If not set, federation tokens are issued instead.",
+		Type:        configschema.Tstring,
+	},
+	PrefixKey: {
+		Description: "The prefix of the names of secrets stored in Secrets Manager.",
+		Type:        configschema.Tstring,
+		Immutable:   true,
+	},
+	KMSKeyIDKey: {
+		Description: "The KMS key used to encrypt secrets. If not set, the AWS managed key is used.",
+		Type:        configschema.Tstring,
+	},
+	provider.ExternalPathPrefixesKey: {
+		Description: "Comma separated name prefixes of secrets managed outside of Juju which Juju secrets may reference.",
+		Type:        configschema.Tstring,
+	},
+}
+
+var configDefaults = schema.Defaults{
+	PrefixKey: DefaultPrefix,
+}
+
+type backendConfig struct {
+	validAttrs map[string]any
+}
+
+func (c *backendConfig) region() string {
+	return c.validAttrs[RegionKey].(string)
+}
+
+func (c *backendConfig) endpoint() string {
+	v, _ := c.validAttrs[EndpointKey].(string)
+	return v
+}
+
+func (c *backendConfig) accessKey() string {
+	v, _ := c.validAttrs[AccessKeyKey].(string)
+	return v
+}
+
+func (c *backendConfig) secretKey() string {
+	v, _ := c.validAttrs[SecretKeyKey].(string)
+	return v
+}
+
+func (c *backendConfig) sessionToken() string {
+	v, _ := c.validAttrs[SessionTokenKey].(string)
+	return v
+}
+
+func (c *backendConfig) roleARN() string {
+	v, _ := c.validAttrs[RoleARNKey].(string)
+	return v
+}
+
+func (c *backendConfig) prefix() string {
+	v, _ := c.validAttrs[PrefixKey].(string)
+	return strings.Trim(v, "/")
+}
+
+func (c *backendConfig) kmsKeyID() string {
+	v, _ := c.validAttrs[KMSKeyIDKey].(string)
+	return v
+}
+
+// awsConfig returns the AWS SDK config used to create clients. Static
+// credentials are used if an access key is configured, otherwise the
+// default credential chain is used.
+func (c *backendConfig) awsConfig(ctx context.Context) (aws.Config, error) {
+	if c.accessKey() == "" {
+		cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(c.region()))
+		return cfg, errors.Annotate(err, "loading default AWS config")
+	}
+	return aws.Config{
+		Region:      c.region(),
+		Credentials: credentials.NewStaticCredentialsProvider(c.accessKey(), c.secretKey(), c.sessionToken()),
+	}, nil
+}
+
+// ConfigSchema implements SecretBackendProvider.
+func (p awsProvider) ConfigSchema() configschema.Fields {
+	return configSchema
+}
+
+// ConfigDefaults implements SecretBackendProvider.
+func (p awsProvider) ConfigDefaults() schema.Defaults {
+	return configDefaults
+}
+
+// ValidateConfig implements SecretBackendProvider.
+func (p awsProvider) ValidateConfig(oldCfg, newCfg provider.ConfigAttrs, tokenRotateInterval *time.Duration) error {
+	newValidCfg, err := newConfig(newCfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if endpoint := newValidCfg.endpoint(); endpoint != "" {
+		if _, err := url.Parse(endpoint); err != nil {
+			return errors.Annotate(err, "invalid endpoint")
+		}
+	}
+	if newValidCfg.accessKey() != "" && newValidCfg.secretKey() == "" {
+		return errors.NotValidf("aws secrets manager config missing secret key")
+	}
+	if newValidCfg.accessKey() == "" && newValidCfg.secretKey() != "" {
+		return errors.NotValidf("aws secrets manager config missing access key")
+	}
+	if newValidCfg.accessKey() == "" && newValidCfg.sessionToken() != "" {
+		return errors.NotValidf("aws secrets manager config with session token but no access key")
+	}
+	if newValidCfg.prefix() == "" {
+		return errors.NotValidf("empty prefix")
+	}
+
+	if oldCfg == nil {
+		return nil
+	}
+	oldValidCfg, err := newConfig(oldCfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for n, field := range configSchema {
+		if !field.Immutable {
+			continue
+		}
+		oldV := oldValidCfg.validAttrs[n]
+		newV := newValidCfg.validAttrs[n]
+		if oldV != newV {
+			return errors.Errorf("cannot change immutable field %q", n)
+		}
+	}
+	return nil
+}
+
+func newConfig(attrs map[string]any) (*backendConfig, error) {
+	cfg, err := coreconfig.NewConfig(attrs, configSchema, configDefaults)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &backendConfig{cfg.Attributes()}, nil
+}
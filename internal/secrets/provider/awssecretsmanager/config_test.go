@@ -0,0 +1,80 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/secrets/provider"
+	_ "github.com/juju/juju/internal/secrets/provider/all"
+	"github.com/juju/juju/internal/secrets/provider/awssecretsmanager"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type configSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestConfigSuite(t *testing.T) {
+	tc.Run(t, &configSuite{})
+}
+
+func (s *configSuite) TestValidateConfig(c *tc.C) {
+	p, err := provider.Provider(awssecretsmanager.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+	configValidator, ok := p.(provider.ProviderConfig)
+	c.Assert(ok, tc.IsTrue)
+	for _, t := range []struct {
+		cfg    map[string]any
+		oldCfg map[string]any
+		err    string
+	}{{
+		cfg: map[string]any{},
+		err: "region: expected string, got nothing",
+	}, {
+		cfg:    map[string]any{"region": "us-east-1"},
+		oldCfg: map[string]any{"region": "eu-west-1"},
+		err:    `cannot change immutable field "region"`,
+	}, {
+		cfg:    map[string]any{"region": "us-east-1", "prefix": "new"},
+		oldCfg: map[string]any{"region": "us-east-1"},
+		err:    `cannot change immutable field "prefix"`,
+	}, {
+		cfg: map[string]any{"region": "us-east-1", "access-key": "aaa"},
+		err: `aws secrets manager config missing secret key not valid`,
+	}, {
+		cfg: map[string]any{"region": "us-east-1", "secret-key": "aaa"},
+		err: `aws secrets manager config missing access key not valid`,
+	}, {
+		cfg: map[string]any{"region": "us-east-1", "session-token": "aaa"},
+		err: `aws secrets manager config with session token but no access key not valid`,
+	}, {
+		cfg: map[string]any{"region": "us-east-1", "prefix": "/"},
+		err: `empty prefix not valid`,
+	}} {
+		err = configValidator.ValidateConfig(t.oldCfg, t.cfg, nil)
+		c.Check(err, tc.ErrorMatches, t.err)
+	}
+}
+
+func (s *configSuite) TestValidateConfigValid(c *tc.C) {
+	p, err := provider.Provider(awssecretsmanager.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+	configValidator, ok := p.(provider.ProviderConfig)
+	c.Assert(ok, tc.IsTrue)
+
+	err = configValidator.ValidateConfig(map[string]any{
+		"region": "us-east-1",
+	}, map[string]any{
+		"region":     "us-east-1",
+		"endpoint":   "http://localhost:4566",
+		"access-key": "access",
+		"secret-key": "secret",
+		"role-arn":   "arn:aws:iam::123456789012:role/juju-secrets",
+		"kms-key-id": "alias/juju",
+	}, nil)
+	c.Assert(err, tc.ErrorIsNil)
+}
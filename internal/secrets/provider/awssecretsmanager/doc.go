@@ -0,0 +1,5 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package awssecretsmanager provides the AWS Secrets Manager secrets backend.
+package awssecretsmanager
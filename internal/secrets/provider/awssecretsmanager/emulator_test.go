@@ -0,0 +1,232 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// emulator is a minimal in-memory Secrets Manager, serving the JSON
+// protocol used by the AWS SDK so that the backend can be tested with a
+// real client.
+type emulator struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	secrets map[string]*emulatedSecret
+}
+
+type emulatedSecret struct {
+	ARN          string
+	Name         string
+	SecretString string
+	KmsKeyId     string
+	Description  string
+}
+
+type emulatorError struct {
+	status int
+	code   string
+}
+
+func newEmulator() *emulator {
+	e := &emulator{
+		secrets: make(map[string]*emulatedSecret),
+	}
+	e.Server = httptest.NewServer(http.HandlerFunc(e.serveHTTP))
+	return e
+}
+
+// put adds a secret directly to the emulator.
+func (e *emulator) put(name, value string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.secrets[name] = &emulatedSecret{
+		ARN:          secretARN(name),
+		Name:         name,
+		SecretString: value,
+	}
+}
+
+// get returns the named secret, or nil if it doesn't exist.
+func (e *emulator) get(name string) *emulatedSecret {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.secrets[name]
+}
+
+// names returns the names of all the secrets in the emulator.
+func (e *emulator) names() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var result []string
+	for name := range e.secrets {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func secretARN(name string) string {
+	return fmt.Sprintf("arn:aws:secretsmanager:us-east-1:123456789012:secret:%s-AbCdEf", name)
+}
+
+func (e *emulator) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	var req map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, emulatorError{http.StatusBadRequest, "SerializationException"})
+		return
+	}
+	op := strings.TrimPrefix(r.Header.Get("X-Amz-Target"), "secretsmanager.")
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var (
+		resp any
+		err  *emulatorError
+	)
+	switch op {
+	case "CreateSecret":
+		resp, err = e.createSecret(req)
+	case "PutSecretValue":
+		resp, err = e.putSecretValue(req)
+	case "GetSecretValue":
+		resp, err = e.getSecretValue(req)
+	case "DeleteSecret":
+		resp, err = e.deleteSecret(req)
+	case "ListSecrets":
+		resp, err = e.listSecrets(req)
+	default:
+		err = &emulatorError{http.StatusBadRequest, "UnknownOperationException"}
+	}
+	if err != nil {
+		writeError(w, *err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func writeError(w http.ResponseWriter, err emulatorError) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	w.WriteHeader(err.status)
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"__type":  err.code,
+		"message": err.code,
+	})
+}
+
+func (e *emulator) lookup(req map[string]any) (*emulatedSecret, *emulatorError) {
+	id, _ := req["SecretId"].(string)
+	for _, s := range e.secrets {
+		if s.Name == id || s.ARN == id {
+			return s, nil
+		}
+	}
+	return nil, &emulatorError{http.StatusBadRequest, "ResourceNotFoundException"}
+}
+
+func (e *emulator) createSecret(req map[string]any) (any, *emulatorError) {
+	name, _ := req["Name"].(string)
+	if _, ok := e.secrets[name]; ok {
+		return nil, &emulatorError{http.StatusBadRequest, "ResourceExistsException"}
+	}
+	s := &emulatedSecret{
+		ARN:  secretARN(name),
+		Name: name,
+	}
+	s.SecretString, _ = req["SecretString"].(string)
+	s.KmsKeyId, _ = req["KmsKeyId"].(string)
+	s.Description, _ = req["Description"].(string)
+	e.secrets[name] = s
+	return map[string]string{"ARN": s.ARN, "Name": s.Name}, nil
+}
+
+func (e *emulator) putSecretValue(req map[string]any) (any, *emulatorError) {
+	s, err := e.lookup(req)
+	if err != nil {
+		return nil, err
+	}
+	s.SecretString, _ = req["SecretString"].(string)
+	return map[string]string{"ARN": s.ARN, "Name": s.Name}, nil
+}
+
+func (e *emulator) getSecretValue(req map[string]any) (any, *emulatorError) {
+	s, err := e.lookup(req)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"ARN": s.ARN, "Name": s.Name, "SecretString": s.SecretString}, nil
+}
+
+func (e *emulator) deleteSecret(req map[string]any) (any, *emulatorError) {
+	s, err := e.lookup(req)
+	if err != nil {
+		return nil, err
+	}
+	delete(e.secrets, s.Name)
+	return map[string]string{"ARN": s.ARN, "Name": s.Name}, nil
+}
+
+// listSecrets returns the secrets in pages of two, unless a smaller page
+// size is requested. Like Secrets Manager, the name filter matches secrets
+// with a word in the name starting with the filter value.
+func (e *emulator) listSecrets(req map[string]any) (any, *emulatorError) {
+	var prefixes []string
+	filters, _ := req["Filters"].([]any)
+	for _, f := range filters {
+		filter, _ := f.(map[string]any)
+		if filter["Key"] != "name" {
+			continue
+		}
+		values, _ := filter["Values"].([]any)
+		for _, v := range values {
+			prefixes = append(prefixes, fmt.Sprint(v))
+		}
+	}
+	matches := func(name string) bool {
+		if len(prefixes) == 0 {
+			return true
+		}
+		for _, prefix := range prefixes {
+			for _, word := range append([]string{name}, strings.Split(name, "/")...) {
+				if strings.HasPrefix(word, strings.Trim(prefix, "/")) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	var names []string
+	for name := range e.secrets {
+		if matches(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	start, _ := strconv.Atoi(fmt.Sprint(req["NextToken"]))
+	pageSize := 2
+	if max, ok := req["MaxResults"].(float64); ok && int(max) < pageSize {
+		pageSize = int(max)
+	}
+	end := min(start+pageSize, len(names))
+	var list []map[string]string
+	for _, name := range names[min(start, len(names)):end] {
+		list = append(list, map[string]string{"ARN": e.secrets[name].ARN, "Name": name})
+	}
+	resp := map[string]any{"SecretList": list}
+	if end < len(names) {
+		resp["NextToken"] = strconv.Itoa(end)
+	}
+	return resp, nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/aws/smithy-go"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/internal/secrets"
+)
+
+func isNotFound(err error) bool {
+	var notFound *types.ResourceNotFoundException
+	return errors.As(err, &notFound)
+}
+
+func isAlreadyExists(err error) bool {
+	var exists *types.ResourceExistsException
+	return errors.As(err, &exists)
+}
+
+func isPermissionDenied(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.ErrorCode() {
+		case "AccessDeniedException", "AccessDenied", "UnrecognizedClientException", "ExpiredTokenException":
+			return true
+		}
+	}
+	return false
+}
+
+func maybePermissionDenied(err error) error {
+	if isPermissionDenied(err) {
+		return errors.WithType(err, secrets.PermissionDenied)
+	}
+	return err
+}
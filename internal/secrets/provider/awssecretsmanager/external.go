@@ -0,0 +1,90 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+)
+
+// externalValueKey is the key used for external content
+// which isn't a JSON object of key values.
+const externalValueKey = "value"
+
+// GetExternalContent implements provider.ExternalContentReader.
+// The path is the name or ARN of the secret, and the version is the
+// Secrets Manager version ID. A secret string holding a JSON object is
+// read as a set of key values; any other content is read as the value
+// of the "value" key.
+func (k awsBackend) GetExternalContent(
+	ctx context.Context, path, version string,
+) (_ secrets.SecretValue, _ string, err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	if k.isJujuSecret(path) {
+		return nil, "", errors.NotValidf("path %q of a secret managed by Juju", path)
+	}
+
+	in := &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(path),
+	}
+	if version != "" {
+		in.VersionId = aws.String(version)
+	}
+	out, err := k.client.GetSecretValue(ctx, in)
+	if isNotFound(err) {
+		return nil, "", fmt.Errorf("external secret %q not found%w", path, errors.Hide(secreterrors.SecretNotFound))
+	} else if err != nil {
+		return nil, "", errors.Annotatef(err, "reading external secret %q", path)
+	}
+
+	val := make(map[string]string)
+	if out.SecretString == nil {
+		val[externalValueKey] = base64.StdEncoding.EncodeToString(out.SecretBinary)
+		return secrets.NewSecretValue(val), aws.ToString(out.VersionId), nil
+	}
+
+	var obj map[string]any
+	if err := json.Unmarshal([]byte(*out.SecretString), &obj); err != nil || len(obj) == 0 {
+		val[externalValueKey] = base64.StdEncoding.EncodeToString([]byte(*out.SecretString))
+		return secrets.NewSecretValue(val), aws.ToString(out.VersionId), nil
+	}
+	for k, v := range obj {
+		if s, ok := v.(string); ok {
+			val[k] = base64.StdEncoding.EncodeToString([]byte(s))
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, "", errors.Annotatef(err, "encoding value %q of %q", k, path)
+		}
+		val[k] = base64.StdEncoding.EncodeToString(b)
+	}
+	return secrets.NewSecretValue(val), aws.ToString(out.VersionId), nil
+}
+
+// isJujuSecret returns true if the path, a secret name or ARN, refers to a
+// secret which Juju stores the content of secrets in. External secrets must
+// not reference such content, as it belongs to other secrets and models.
+func (k awsBackend) isJujuSecret(path string) bool {
+	name := path
+	if strings.HasPrefix(path, "arn:") {
+		if _, after, ok := strings.Cut(path, ":secret:"); ok {
+			name = after
+		}
+	}
+	return k.managedPrefix != "" && strings.HasPrefix(name, k.managedPrefix)
+}
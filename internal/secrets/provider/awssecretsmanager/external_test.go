@@ -0,0 +1,53 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager_test
+
+import (
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+func (s *backendSuite) externalReader(c *tc.C) provider.ExternalContentReader {
+	reader, ok := s.backend.(provider.ExternalContentReader)
+	c.Assert(ok, tc.IsTrue)
+	return reader
+}
+
+func (s *backendSuite) TestGetExternalContentKeyValues(c *tc.C) {
+	s.emulator.put("app/db", `{"user":"fred","port":5432}`)
+
+	val, _, err := s.externalReader(c).GetExternalContent(c.Context(), "app/db", "")
+	c.Assert(err, tc.ErrorIsNil)
+	values, err := val.Values()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(values, tc.DeepEquals, map[string]string{"user": "fred", "port": "5432"})
+}
+
+func (s *backendSuite) TestGetExternalContentString(c *tc.C) {
+	s.emulator.put("app/token", "s3cret")
+
+	val, _, err := s.externalReader(c).GetExternalContent(c.Context(), "app/token", "")
+	c.Assert(err, tc.ErrorIsNil)
+	values, err := val.Values()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(values, tc.DeepEquals, map[string]string{"value": "s3cret"})
+}
+
+func (s *backendSuite) TestGetExternalContentNotFound(c *tc.C) {
+	_, _, err := s.externalReader(c).GetExternalContent(c.Context(), "app/missing", "")
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretNotFound)
+}
+
+func (s *backendSuite) TestGetExternalContentJujuManaged(c *tc.C) {
+	for _, path := range []string{
+		"juju/other-model/secret-1",
+		"arn:aws:secretsmanager:us-east-1:123456789012:secret:juju/other-model/secret-1-AbCdEf",
+	} {
+		_, _, err := s.externalReader(c).GetExternalContent(c.Context(), path, "")
+		c.Check(err, tc.ErrorIs, coreerrors.NotValid, tc.Commentf("path %q", path))
+	}
+}
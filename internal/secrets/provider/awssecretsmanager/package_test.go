@@ -0,0 +1,23 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager
+
+import (
+	"context"
+
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+//go:generate go run github.com/canonical/gomock/mockgen -package awssecretsmanager -destination sts_mock_test.go github.com/juju/juju/internal/secrets/provider/awssecretsmanager STSClient
+
+// NewProviderForTest returns an AWS Secrets Manager provider which issues
+// restricted credentials with the given STS client.
+func NewProviderForTest(stsClient STSClient) provider.SecretBackendProvider {
+	return awsProvider{
+		newSecretsManagerClient: newSecretsManagerClient,
+		newSTSClient: func(context.Context, *backendConfig) (STSClient, error) {
+			return stsClient, nil
+		},
+	}
+}
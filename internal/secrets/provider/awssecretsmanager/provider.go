@@ -0,0 +1,443 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/secrets"
+	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+var logger = internallogger.GetLogger("juju.secrets.awssecretsmanager")
+
+const (
+	// BackendType is the type of the AWS Secrets Manager secrets backend.
+	BackendType = "aws-secrets-manager"
+
+	// minCredentialValidity is the shortest duration STS will issue
+	// temporary credentials for.
+	minCredentialValidity = 15 * time.Minute
+
+	// maxSessionPolicySize is the largest session policy, in characters,
+	// STS accepts.
+	maxSessionPolicySize = 2048
+)
+
+// STSClient is the subset of the STS API used to issue restricted
+// credentials.
+type STSClient interface {
+	AssumeRole(context.Context, *sts.AssumeRoleInput, ...func(*sts.Options)) (*sts.AssumeRoleOutput, error)
+	GetFederationToken(context.Context, *sts.GetFederationTokenInput, ...func(*sts.Options)) (*sts.GetFederationTokenOutput, error)
+}
+
+// NewProvider returns an AWS Secrets Manager secrets provider.
+func NewProvider() provider.SecretBackendProvider {
+	return awsProvider{
+		newSecretsManagerClient: newSecretsManagerClient,
+		newSTSClient:            newSTSClient,
+	}
+}
+
+type awsProvider struct {
+	newSecretsManagerClient func(context.Context, *backendConfig) (SecretsManagerClient, error)
+	newSTSClient            func(context.Context, *backendConfig) (STSClient, error)
+}
+
+func newSecretsManagerClient(ctx context.Context, cfg *backendConfig) (SecretsManagerClient, error) {
+	awsCfg, err := cfg.awsConfig(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return secretsmanager.NewFromConfig(awsCfg, func(o *secretsmanager.Options) {
+		if endpoint := cfg.endpoint(); endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+	}), nil
+}
+
+func newSTSClient(ctx context.Context, cfg *backendConfig) (STSClient, error) {
+	awsCfg, err := cfg.awsConfig(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return sts.NewFromConfig(awsCfg, func(o *sts.Options) {
+		if endpoint := cfg.endpoint(); endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+	}), nil
+}
+
+func (p awsProvider) Type() string {
+	return BackendType
+}
+
+// modelPath returns the path, below the configured prefix, under which the
+// model's secrets are named.
+func modelPath(name, modelUUID string) string {
+	if name == "" || modelUUID == "" {
+		return ""
+	}
+	suffix := modelUUID[len(modelUUID)-6:]
+	return name + "-" + suffix
+}
+
+// namePrefix returns the prefix of the names of the model's secrets.
+func namePrefix(cfg *backendConfig, modelName, modelUUID string) string {
+	return cfg.prefix() + "/" + modelPath(modelName, modelUUID) + "/"
+}
+
+// Initialise is part of the SecretBackendProvider interface. Secrets Manager
+// has no per-model resources to create.
+func (p awsProvider) Initialise(*provider.ModelBackendConfig) error {
+	return nil
+}
+
+// CleanupModel deletes all secrets associated with the model.
+func (p awsProvider) CleanupModel(ctx context.Context, cfg *provider.ModelBackendConfig) (err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	validCfg, err := newConfig(cfg.Config)
+	if err != nil {
+		return errors.Annotatef(err, "invalid aws secrets manager config")
+	}
+	client, err := p.newSecretsManagerClient(ctx, validCfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	prefix := namePrefix(validCfg, cfg.ModelName, cfg.ModelUUID)
+	var arns []string
+	paginator := secretsmanager.NewListSecretsPaginator(client, &secretsmanager.ListSecretsInput{
+		Filters: []types.Filter{{
+			Key:    types.FilterNameStringTypeName,
+			Values: []string{prefix},
+		}},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return errors.Annotate(err, "listing model secrets")
+		}
+		for _, s := range page.SecretList {
+			// The name filter matches on words in the name as well as
+			// the prefix, so check the name.
+			if strings.HasPrefix(aws.ToString(s.Name), prefix) {
+				arns = append(arns, aws.ToString(s.ARN))
+			}
+		}
+	}
+	for _, arn := range arns {
+		_, err := client.DeleteSecret(ctx, &secretsmanager.DeleteSecretInput{
+			SecretId:                   aws.String(arn),
+			ForceDeleteWithoutRecovery: aws.Bool(true),
+		})
+		if err != nil && !isNotFound(err) {
+			return errors.Annotatef(err, "deleting secret %q", arn)
+		}
+	}
+	return nil
+}
+
+// CleanupSecrets is part of the SecretBackendProvider interface. Access to
+// secrets is granted by session policies on short lived credentials, so
+// there are no ACLs to remove.
+func (p awsProvider) CleanupSecrets(context.Context, *provider.ModelBackendConfig, secrets.Accessor, provider.SecretRevisions) error {
+	return nil
+}
+
+// IssuesTokens returns true if this secret backend provider needs to issue
+// a token to provide a restricted (delegated) config.
+func (p awsProvider) IssuesTokens() bool {
+	return true
+}
+
+// CleanupIssuedTokens is part of the SecretBackendProvider interface. STS
+// credentials can't be revoked individually; they expire by themselves, so
+// all the tokens are reported as cleaned up.
+func (p awsProvider) CleanupIssuedTokens(
+	_ context.Context,
+	_ *provider.ModelBackendConfig,
+	issuedTokenUUIDs []string,
+) ([]string, error) {
+	return issuedTokenUUIDs, nil
+}
+
+// RestrictedConfig returns the config needed to create a
+// secrets backend client restricted to manage the specified
+// owned secrets and read shared secrets for the given accessor.
+// The restriction is applied with an IAM session policy on
+// temporary credentials issued by STS.
+func (p awsProvider) RestrictedConfig(
+	ctx context.Context,
+	adminCfg *provider.ModelBackendConfig,
+	sameController, forDrain bool,
+	issuedTokenUUID string,
+	accessor secrets.Accessor,
+	owned []string,
+	ownedRevs provider.SecretRevisions,
+	readRevs provider.SecretRevisions,
+) (_ *provider.BackendConfig, err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	validCfg, err := newConfig(adminCfg.Config)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid aws secrets manager config")
+	}
+	policy, err := sessionPolicy(
+		validCfg.region(), namePrefix(validCfg, adminCfg.ModelName, adminCfg.ModelUUID),
+		forDrain, accessor, owned, readRevs,
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	logger.Tracef(ctx, "session policy for %q: %s", issuedTokenUUID, policy)
+
+	stsClient, err := p.newSTSClient(ctx, validCfg)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	duration := aws.Int32(int32(max(secrets.IssuedTokenValidity, minCredentialValidity).Seconds()))
+
+	var creds *ststypes.Credentials
+	if roleARN := validCfg.roleARN(); roleARN != "" {
+		out, err := stsClient.AssumeRole(ctx, &sts.AssumeRoleInput{
+			RoleArn:         aws.String(roleARN),
+			RoleSessionName: aws.String("juju-" + issuedTokenUUID),
+			Policy:          aws.String(policy),
+			DurationSeconds: duration,
+		})
+		if err != nil {
+			return nil, errors.Annotatef(err, "assuming role %q", roleARN)
+		}
+		creds = out.Credentials
+	} else {
+		// Federated user names are limited to 32 characters.
+		out, err := stsClient.GetFederationToken(ctx, &sts.GetFederationTokenInput{
+			Name:            aws.String(strings.ReplaceAll(issuedTokenUUID, "-", "")),
+			Policy:          aws.String(policy),
+			DurationSeconds: duration,
+		})
+		if err != nil {
+			return nil, errors.Annotate(err, "creating secret access token")
+		}
+		creds = out.Credentials
+	}
+	if creds == nil {
+		return nil, errors.New("creating secret access token: no credentials issued")
+	}
+
+	cfg := provider.BackendConfig{
+		BackendType: adminCfg.BackendType,
+		Config:      maps.Clone(adminCfg.Config),
+	}
+	cfg.Config[AccessKeyKey] = aws.ToString(creds.AccessKeyId)
+	cfg.Config[SecretKeyKey] = aws.ToString(creds.SecretAccessKey)
+	cfg.Config[SessionTokenKey] = aws.ToString(creds.SessionToken)
+	delete(cfg.Config, RoleARNKey)
+	return &cfg, nil
+}
+
+// NewBackend returns an AWS Secrets Manager backed secrets backend client.
+func (p awsProvider) NewBackend(cfg *provider.ModelBackendConfig) (provider.SecretsBackend, error) {
+	validCfg, err := newConfig(cfg.Config)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid aws secrets manager config")
+	}
+	client, err := p.newSecretsManagerClient(context.Background(), validCfg)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &awsBackend{
+		namePrefix:    namePrefix(validCfg, cfg.ModelName, cfg.ModelUUID),
+		managedPrefix: validCfg.prefix() + "/",
+		kmsKeyID:      validCfg.kmsKeyID(),
+		client:        client,
+	}, nil
+}
+
+type policyStatement struct {
+	Effect   string   `json:"Effect"`
+	Action   []string `json:"Action"`
+	Resource []string `json:"Resource"`
+}
+
+type policyDocument struct {
+	Version   string            `json:"Version"`
+	Statement []policyStatement `json:"Statement"`
+}
+
+// sessionPolicy returns the IAM session policy restricting the accessor to
+// the secrets it owns or can read.
+func sessionPolicy(
+	region, prefix string,
+	forDrain bool,
+	accessor secrets.Accessor,
+	owned []string,
+	readRevs provider.SecretRevisions,
+) (string, error) {
+	arn := func(name string) string {
+		return fmt.Sprintf("arn:%s:secretsmanager:%s:*:secret:%s%s", partition(region), region, prefix, name)
+	}
+
+	var statements []policyStatement
+	adminUser := accessor.Kind == secrets.ModelAccessor
+	if forDrain && adminUser {
+		// For controller drain worker, we need to be able to update a
+		// secret in case the worker was restarted after saving content
+		// but before updating the secret to use the new backend.
+		statements = append(statements, policyStatement{
+			Effect:   "Allow",
+			Action:   []string{"secretsmanager:PutSecretValue"},
+			Resource: []string{arn("*")},
+		})
+	}
+	if adminUser {
+		// For admin users, all secrets for the model can be read.
+		statements = append(statements, policyStatement{
+			Effect:   "Allow",
+			Action:   []string{"secretsmanager:GetSecretValue"},
+			Resource: []string{arn("*")},
+		})
+	}
+
+	// Any secrets owned by the agent can be updated/deleted etc.
+	var ownedResources []string
+	for _, id := range owned {
+		ownedResources = append(ownedResources, arn(id+"-*"))
+	}
+	if len(ownedResources) > 0 {
+		statements = append(statements, policyStatement{
+			Effect: "Allow",
+			Action: []string{
+				"secretsmanager:CreateSecret",
+				"secretsmanager:GetSecretValue",
+				"secretsmanager:PutSecretValue",
+				"secretsmanager:DeleteSecret",
+				"secretsmanager:DescribeSecret",
+			},
+			Resource: ownedResources,
+		})
+	}
+
+	// Any secrets consumed by the agent can be read. As the size of a
+	// session policy is limited, the fewest, most specific resources which
+	// fit are used.
+	var policy string
+	for _, names := range readResourceNames(readRevs) {
+		readStatements := statements
+		if len(names) > 0 {
+			var resources []string
+			for _, name := range names {
+				resources = append(resources, arn(name))
+			}
+			readStatements = append(readStatements, policyStatement{
+				Effect:   "Allow",
+				Action:   []string{"secretsmanager:GetSecretValue"},
+				Resource: resources,
+			})
+		}
+		if len(readStatements) == 0 {
+			// A session policy needs at least one statement; deny
+			// everything if there's nothing to allow.
+			readStatements = append(readStatements, policyStatement{
+				Effect:   "Deny",
+				Action:   []string{"secretsmanager:*"},
+				Resource: []string{"*"},
+			})
+		}
+		data, err := json.Marshal(policyDocument{
+			Version:   "2012-10-17",
+			Statement: readStatements,
+		})
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		if policy = string(data); len(policy) <= maxSessionPolicySize {
+			return policy, nil
+		}
+	}
+	return "", errors.Errorf("session policy of %d characters exceeds the limit of %d", len(policy), maxSessionPolicySize)
+}
+
+// readResourceNames returns the candidate sets of secret name patterns
+// granting read access to the specified revisions, from the most to the
+// least specific. Secrets Manager appends 6 random characters to the name of
+// a secret in its ARN.
+//
+// The first set matches each revision; the next matches every revision of
+// each secret. The remaining sets match secrets by ever shorter prefixes of
+// their IDs, ending with a set matching all of the model's secrets. These
+// also match other secrets of the model, so they are only used when the
+// secrets which can be read are too many to be listed.
+func readResourceNames(readRevs provider.SecretRevisions) [][]string {
+	var (
+		ids       []string
+		revisions []string
+		maxLen    int
+	)
+	for id, revs := range readRevs {
+		if revs.IsEmpty() {
+			continue
+		}
+		ids = append(ids, id)
+		maxLen = max(maxLen, len(id))
+	}
+	if len(ids) == 0 {
+		return [][]string{nil}
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		for _, revId := range readRevs[id].SortedValues() {
+			revisions = append(revisions, revId+"-??????")
+		}
+	}
+
+	result := [][]string{revisions}
+	perSecret := make([]string, len(ids))
+	for i, id := range ids {
+		perSecret[i] = id + "-*"
+	}
+	result = append(result, perSecret)
+	for n := maxLen - 1; n >= 0; n-- {
+		var names []string
+		for _, id := range ids {
+			name := id[:min(n, len(id))] + "*"
+			if len(names) == 0 || names[len(names)-1] != name {
+				names = append(names, name)
+			}
+		}
+		result = append(result, names)
+	}
+	return result
+}
+
+// partition returns the AWS partition of the region.
+func partition(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return "aws-cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		return "aws-us-gov"
+	}
+	return "aws"
+}
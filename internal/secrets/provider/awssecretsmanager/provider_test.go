@@ -0,0 +1,337 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager_test
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/collections/set"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/secrets/provider"
+	"github.com/juju/juju/internal/secrets/provider/awssecretsmanager"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type providerSuite struct {
+	testhelpers.IsolationSuite
+
+	emulator  *emulator
+	stsClient *awssecretsmanager.MockSTSClient
+}
+
+func TestProviderSuite(t *testing.T) {
+	tc.Run(t, &providerSuite{})
+}
+
+func (s *providerSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.emulator = newEmulator()
+	s.AddCleanup(func(*tc.C) { s.emulator.Close() })
+}
+
+func (s *providerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.stsClient = awssecretsmanager.NewMockSTSClient(ctrl)
+	return ctrl
+}
+
+func (s *providerSuite) modelConfig() *provider.ModelBackendConfig {
+	return &provider.ModelBackendConfig{
+		ControllerUUID: coretesting.ControllerTag.Id(),
+		ModelUUID:      coretesting.ModelTag.Id(),
+		ModelName:      "fred",
+		BackendConfig:  backendConfig(s.emulator.URL),
+	}
+}
+
+func (s *providerSuite) modelPrefix() string {
+	return "juju/fred-" + coretesting.ModelTag.Id()[30:] + "/"
+}
+
+var issuedCredentials = &ststypes.Credentials{
+	AccessKeyId:     aws.String("restricted-access"),
+	SecretAccessKey: aws.String("restricted-secret"),
+	SessionToken:    aws.String("restricted-token"),
+}
+
+func (s *providerSuite) TestCleanupModel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	prefix := s.modelPrefix()
+	for _, name := range []string{
+		prefix + "a-1", prefix + "b-1", prefix + "c-1",
+		"juju/mary-123456/a-1", "other/" + prefix + "d-1",
+	} {
+		s.emulator.put(name, "{}")
+	}
+
+	p := awssecretsmanager.NewProviderForTest(s.stsClient)
+	err := p.CleanupModel(c.Context(), s.modelConfig())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.emulator.names(), tc.DeepEquals, []string{
+		"juju/mary-123456/a-1", "other/" + prefix + "d-1",
+	})
+}
+
+func (s *providerSuite) TestRestrictedConfigFederationToken(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var policy string
+	s.stsClient.EXPECT().GetFederationToken(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, in *sts.GetFederationTokenInput, _ ...func(*sts.Options)) (*sts.GetFederationTokenOutput, error) {
+			c.Check(aws.ToString(in.Name), tc.Equals, "0123456789abcdef0123456789abcdef")
+			c.Check(aws.ToInt32(in.DurationSeconds), tc.Equals, int32(900))
+			policy = aws.ToString(in.Policy)
+			return &sts.GetFederationTokenOutput{Credentials: issuedCredentials}, nil
+		})
+
+	adminCfg := s.modelConfig()
+	p := awssecretsmanager.NewProviderForTest(s.stsClient)
+	cfg, err := p.RestrictedConfig(c.Context(), adminCfg, true, false, "01234567-89ab-cdef-0123-456789abcdef",
+		secrets.Accessor{Kind: secrets.UnitAccessor, ID: "ubuntu/0"},
+		[]string{"owned-a"},
+		nil,
+		provider.SecretRevisions{"read-b": set.NewStrings("read-b-1", "read-b-2")},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.BackendType, tc.Equals, awssecretsmanager.BackendType)
+	c.Assert(cfg.Config, tc.DeepEquals, provider.ConfigAttrs{
+		"region":        "us-east-1",
+		"endpoint":      s.emulator.URL,
+		"access-key":    "restricted-access",
+		"secret-key":    "restricted-secret",
+		"session-token": "restricted-token",
+		"kms-key-id":    "alias/juju",
+	})
+	// The admin config is not changed.
+	c.Assert(adminCfg.Config["access-key"], tc.Equals, "access")
+
+	arn := "arn:aws:secretsmanager:us-east-1:*:secret:" + s.modelPrefix()
+	s.assertPolicy(c, policy, []any{
+		map[string]any{
+			"Effect": "Allow",
+			"Action": []any{
+				"secretsmanager:CreateSecret",
+				"secretsmanager:GetSecretValue",
+				"secretsmanager:PutSecretValue",
+				"secretsmanager:DeleteSecret",
+				"secretsmanager:DescribeSecret",
+			},
+			"Resource": []any{arn + "owned-a-*"},
+		},
+		map[string]any{
+			"Effect":   "Allow",
+			"Action":   []any{"secretsmanager:GetSecretValue"},
+			"Resource": []any{arn + "read-b-1-??????", arn + "read-b-2-??????"},
+		},
+	})
+}
+
+func (s *providerSuite) TestRestrictedConfigAssumeRole(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var policy string
+	s.stsClient.EXPECT().AssumeRole(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, in *sts.AssumeRoleInput, _ ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+			c.Check(aws.ToString(in.RoleArn), tc.Equals, "arn:aws:iam::123456789012:role/juju-secrets")
+			c.Check(aws.ToString(in.RoleSessionName), tc.Equals, "juju-token-uuid")
+			policy = aws.ToString(in.Policy)
+			return &sts.AssumeRoleOutput{Credentials: issuedCredentials}, nil
+		})
+
+	adminCfg := s.modelConfig()
+	adminCfg.Config["role-arn"] = "arn:aws:iam::123456789012:role/juju-secrets"
+	p := awssecretsmanager.NewProviderForTest(s.stsClient)
+	cfg, err := p.RestrictedConfig(c.Context(), adminCfg, true, true, "token-uuid",
+		secrets.Accessor{Kind: secrets.ModelAccessor, ID: coretesting.ModelTag.Id()},
+		nil, nil, nil,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cfg.Config["access-key"], tc.Equals, "restricted-access")
+	_, ok := cfg.Config["role-arn"]
+	c.Check(ok, tc.IsFalse)
+
+	arn := "arn:aws:secretsmanager:us-east-1:*:secret:" + s.modelPrefix() + "*"
+	s.assertPolicy(c, policy, []any{
+		map[string]any{
+			"Effect":   "Allow",
+			"Action":   []any{"secretsmanager:PutSecretValue"},
+			"Resource": []any{arn},
+		},
+		map[string]any{
+			"Effect":   "Allow",
+			"Action":   []any{"secretsmanager:GetSecretValue"},
+			"Resource": []any{arn},
+		},
+	})
+}
+
+func (s *providerSuite) TestRestrictedConfigNoAccess(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var policy string
+	s.stsClient.EXPECT().GetFederationToken(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, in *sts.GetFederationTokenInput, _ ...func(*sts.Options)) (*sts.GetFederationTokenOutput, error) {
+			policy = aws.ToString(in.Policy)
+			return &sts.GetFederationTokenOutput{Credentials: issuedCredentials}, nil
+		})
+
+	p := awssecretsmanager.NewProviderForTest(s.stsClient)
+	_, err := p.RestrictedConfig(c.Context(), s.modelConfig(), true, false, "token-uuid",
+		secrets.Accessor{Kind: secrets.UnitAccessor, ID: "ubuntu/0"},
+		nil, nil, nil,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	s.assertPolicy(c, policy, []any{
+		map[string]any{
+			"Effect":   "Deny",
+			"Action":   []any{"secretsmanager:*"},
+			"Resource": []any{"*"},
+		},
+	})
+}
+
+// TestRestrictedConfigManySecretRevisions asserts that every revision of
+// each secret can be read when the revisions don't all fit in the session
+// policy.
+func (s *providerSuite) TestRestrictedConfigManySecretRevisions(c *tc.C) {
+	readRevs := make(provider.SecretRevisions)
+	var ids []string
+	for range 15 {
+		uri := secrets.NewURI()
+		ids = append(ids, uri.ID)
+		readRevs.Add(uri, uri.Name(1))
+		readRevs.Add(uri, uri.Name(2))
+		readRevs.Add(uri, uri.Name(3))
+	}
+
+	resources := s.readResources(c, readRevs)
+	arn := "arn:aws:secretsmanager:us-east-1:*:secret:" + s.modelPrefix()
+	expected := set.NewStrings()
+	for _, id := range ids {
+		expected.Add(arn + id + "-*")
+	}
+	c.Check(set.NewStrings(resources...), tc.DeepEquals, expected)
+}
+
+// TestRestrictedConfigSessionPolicyLimit asserts that the session policy
+// stays within the size STS accepts however many secrets can be read, and
+// that it still allows each of them to be read.
+func (s *providerSuite) TestRestrictedConfigSessionPolicyLimit(c *tc.C) {
+	readRevs := make(provider.SecretRevisions)
+	var revisions []string
+	for range 500 {
+		uri := secrets.NewURI()
+		for rev := 1; rev <= 3; rev++ {
+			readRevs.Add(uri, uri.Name(rev))
+			revisions = append(revisions, uri.Name(rev))
+		}
+	}
+
+	resources := s.readResources(c, readRevs)
+	arn := "arn:aws:secretsmanager:us-east-1:*:secret:" + s.modelPrefix()
+	for _, revId := range revisions {
+		name := arn + revId + "-AbC123"
+		var matched bool
+		for _, resource := range resources {
+			if ok, err := path.Match(resource, name); err == nil && ok {
+				matched = true
+				break
+			}
+		}
+		c.Check(matched, tc.IsTrue, tc.Commentf("revision %q not readable", revId))
+	}
+}
+
+// readResources returns the resources of the read statement in the session
+// policy issued to a unit reading the specified revisions, checking that
+// the policy fits within the size STS accepts.
+func (s *providerSuite) readResources(c *tc.C, readRevs provider.SecretRevisions) []string {
+	defer s.setupMocks(c).Finish()
+
+	var policy string
+	s.stsClient.EXPECT().GetFederationToken(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, in *sts.GetFederationTokenInput, _ ...func(*sts.Options)) (*sts.GetFederationTokenOutput, error) {
+			policy = aws.ToString(in.Policy)
+			return &sts.GetFederationTokenOutput{Credentials: issuedCredentials}, nil
+		})
+
+	p := awssecretsmanager.NewProviderForTest(s.stsClient)
+	_, err := p.RestrictedConfig(c.Context(), s.modelConfig(), true, false, "token-uuid",
+		secrets.Accessor{Kind: secrets.UnitAccessor, ID: "ubuntu/0"},
+		nil, nil, readRevs,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(len(policy) <= 2048, tc.IsTrue, tc.Commentf("policy of %d characters", len(policy)))
+
+	var doc struct {
+		Statement []struct {
+			Effect   string
+			Action   []string
+			Resource []string
+		}
+	}
+	err = json.Unmarshal([]byte(policy), &doc)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(doc.Statement, tc.HasLen, 1)
+	c.Assert(doc.Statement[0].Effect, tc.Equals, "Allow")
+	c.Assert(doc.Statement[0].Action, tc.DeepEquals, []string{"secretsmanager:GetSecretValue"})
+	return doc.Statement[0].Resource
+}
+
+func (s *providerSuite) TestRestrictedConfigBackendAccess(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.stsClient.EXPECT().GetFederationToken(gomock.Any(), gomock.Any()).Return(
+		&sts.GetFederationTokenOutput{Credentials: issuedCredentials}, nil)
+
+	p := awssecretsmanager.NewProviderForTest(s.stsClient)
+	cfg, err := p.RestrictedConfig(c.Context(), s.modelConfig(), true, false, "token-uuid",
+		secrets.Accessor{Kind: secrets.UnitAccessor, ID: "ubuntu/0"},
+		[]string{"owned-a"}, nil, nil,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The restricted config can be used to create a backend client.
+	backend, err := p.NewBackend(&provider.ModelBackendConfig{
+		ControllerUUID: coretesting.ControllerTag.Id(),
+		ModelUUID:      coretesting.ModelTag.Id(),
+		ModelName:      "fred",
+		BackendConfig:  *cfg,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	s.emulator.put(s.modelPrefix()+"owned-a-1", `{"foo":"YmFy"}`)
+	val, err := backend.GetContent(c.Context(), "owned-a-1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(val.EncodedValues(), tc.DeepEquals, map[string]string{"foo": "YmFy"})
+}
+
+func (s *providerSuite) TestCleanupIssuedTokens(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	p := awssecretsmanager.NewProviderForTest(s.stsClient)
+	revoked, err := p.CleanupIssuedTokens(c.Context(), s.modelConfig(), []string{"a", "b"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(revoked, tc.DeepEquals, []string{"a", "b"})
+}
+
+func (s *providerSuite) assertPolicy(c *tc.C, policy string, statements []any) {
+	var doc map[string]any
+	err := json.Unmarshal([]byte(policy), &doc)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(doc, tc.DeepEquals, map[string]any{
+		"Version":   "2012-10-17",
+		"Statement": statements,
+	})
+}
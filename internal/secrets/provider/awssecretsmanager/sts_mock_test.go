@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/secrets/provider/awssecretsmanager (interfaces: STSClient)
+//
+// Generated by this command:
+//
+//	mockgen -package awssecretsmanager -destination sts_mock_test.go github.com/juju/juju/internal/secrets/provider/awssecretsmanager STSClient
+//
+
+// Package awssecretsmanager is a generated GoMock package.
+package awssecretsmanager
+
+import (
+	context "context"
+
+	sts "github.com/aws/aws-sdk-go-v2/service/sts"
+	gomock "github.com/canonical/gomock/gomock"
+)
+
+// MockSTSClient is a mock of STSClient interface.
+type MockSTSClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSTSClientMockRecorder
+	isgomock struct{}
+}
+
+// MockSTSClientMockRecorder is the mock recorder for MockSTSClient.
+type MockSTSClientMockRecorder struct {
+	mock                      *MockSTSClient
+	assumeRoleExpects         []*gomock.Call2V_2[context.Context, *sts.AssumeRoleInput, func(*sts.Options), *sts.AssumeRoleOutput, error]
+	getFederationTokenExpects []*gomock.Call2V_2[context.Context, *sts.GetFederationTokenInput, func(*sts.Options), *sts.GetFederationTokenOutput, error]
+}
+
+// NewMockSTSClient creates a new mock instance.
+func NewMockSTSClient(ctrl *gomock.Controller) *MockSTSClient {
+	mock := &MockSTSClient{ctrl: ctrl}
+	mock.recorder = &MockSTSClientMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSTSClient) EXPECT() *MockSTSClientMockRecorder {
+	return m.recorder
+}
+
+// AssumeRole mocks base method.
+func (m *MockSTSClient) AssumeRole(arg0 context.Context, arg1 *sts.AssumeRoleInput, arg2 ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2V_2(&m.recorder.assumeRoleExpects, m.ctrl, m, "AssumeRole", arg0, arg1, arg2...)
+}
+
+// AssumeRole indicates an expected call of AssumeRole.
+func (mr *MockSTSClientMockRecorder) AssumeRole(arg0, arg1 any, arg2 ...any) *MockSTSClientAssumeRoleCall {
+	mr.mock.ctrl.T.Helper()
+	varArgs := gomock.EnsureVariadicMatcher(arg2)
+	call := gomock.NewCall2V_2[context.Context, *sts.AssumeRoleInput, func(*sts.Options), *sts.AssumeRoleOutput, error](mr.mock.ctrl.T, mr.mock, "AssumeRole", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1), varArgs)
+	mr.assumeRoleExpects = append(mr.assumeRoleExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSTSClientAssumeRoleCall is the typed call wrapper for AssumeRole.
+type MockSTSClientAssumeRoleCall = gomock.Call2V_2[context.Context, *sts.AssumeRoleInput, func(*sts.Options), *sts.AssumeRoleOutput, error]
+
+// GetFederationToken mocks base method.
+func (m *MockSTSClient) GetFederationToken(arg0 context.Context, arg1 *sts.GetFederationTokenInput, arg2 ...func(*sts.Options)) (*sts.GetFederationTokenOutput, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2V_2(&m.recorder.getFederationTokenExpects, m.ctrl, m, "GetFederationToken", arg0, arg1, arg2...)
+}
+
+// GetFederationToken indicates an expected call of GetFederationToken.
+func (mr *MockSTSClientMockRecorder) GetFederationToken(arg0, arg1 any, arg2 ...any) *MockSTSClientGetFederationTokenCall {
+	mr.mock.ctrl.T.Helper()
+	varArgs := gomock.EnsureVariadicMatcher(arg2)
+	call := gomock.NewCall2V_2[context.Context, *sts.GetFederationTokenInput, func(*sts.Options), *sts.GetFederationTokenOutput, error](mr.mock.ctrl.T, mr.mock, "GetFederationToken", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1), varArgs)
+	mr.getFederationTokenExpects = append(mr.getFederationTokenExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSTSClientGetFederationTokenCall is the typed call wrapper for GetFederationToken.
+type MockSTSClientGetFederationTokenCall = gomock.Call2V_2[context.Context, *sts.GetFederationTokenInput, func(*sts.Options), *sts.GetFederationTokenOutput, error]
@@ -5,6 +5,8 @@ package provider
 
 import (
 	"context"
+	"path"
+	"strings"
 
 	"github.com/juju/juju/core/secrets"
 )
@@ -22,6 +24,48 @@ type SecretsBackend interface {
 	DeleteContent(_ context.Context, revisionId string) error
 }
 
+// ExternalContentReader is implemented by secrets backends which can read
+// content managed outside of Juju, stored at an arbitrary path.
+type ExternalContentReader interface {
+	// GetExternalContent returns the content stored at the specified path,
+	// together with an opaque identifier of the version which was read.
+	// If version is empty, the latest version of the content is read.
+	// It *must* return a NotFound error if the content does not exist.
+	GetExternalContent(_ context.Context, path, version string) (secrets.SecretValue, string, error)
+}
+
+// ExternalPathPrefixesKey is the backend config attribute listing, separated
+// by commas, the path prefixes of the content managed outside of Juju which
+// secrets may reference. No external content may be referenced if it is not
+// set.
+const ExternalPathPrefixesKey = "external-path-prefixes"
+
+// ExternalPathAllowed returns true if the backend config allows secrets to
+// reference external content at the specified path. A prefix matches whole
+// path elements, so "kv/app" allows "kv/app/db" but not "kv/apple". Paths
+// with ".." elements are never allowed, so that a path can't escape from
+// an allowed prefix.
+func ExternalPathAllowed(cfg ConfigAttrs, p string) bool {
+	for _, elem := range strings.Split(p, "/") {
+		if elem == ".." {
+			return false
+		}
+	}
+	p = strings.Trim(path.Clean("/"+p), "/")
+
+	prefixes, _ := cfg[ExternalPathPrefixesKey].(string)
+	for _, prefix := range strings.Split(prefixes, ",") {
+		prefix = strings.Trim(path.Clean("/"+strings.TrimSpace(prefix)), "/")
+		if prefix == "" {
+			continue
+		}
+		if p == prefix || strings.HasPrefix(p, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
 // BackendConfig is used when constructing a secrets backend.
 type BackendConfig struct {
 	BackendType string
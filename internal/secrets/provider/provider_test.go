@@ -35,3 +35,26 @@ func (*providerSuite) TestRevisionMetaSlice(c *tc.C) {
 		"d-1", "d-2", "d-3", "d-4",
 	})
 }
+
+func (*providerSuite) TestExternalPathAllowed(c *tc.C) {
+	cfg := provider.ConfigAttrs{
+		provider.ExternalPathPrefixesKey: "kv/app, /team/shared/",
+	}
+	for path, allowed := range map[string]bool{
+		"kv/app":           true,
+		"kv/app/db":        true,
+		"/kv/app/db":       true,
+		"team/shared/key":  true,
+		"kv/apple":         false,
+		"kv":               false,
+		"other/app/db":     false,
+		"team/shared-keys": false,
+		"kv//app/./db":     true,
+		"kv/app/../other":  false,
+		"kv/app/..":        false,
+		"../kv/app/db":     false,
+	} {
+		c.Check(provider.ExternalPathAllowed(cfg, path), tc.Equals, allowed, tc.Commentf("path %q", path))
+	}
+	c.Check(provider.ExternalPathAllowed(provider.ConfigAttrs{}, "kv/app/db"), tc.IsFalse)
+}
@@ -16,7 +16,10 @@ import (
 
 type vaultBackend struct {
 	mountPath string
-	client    *vault.Client
+	// baseMountPath is the configured mount path below which
+	// Juju mounts the KV secrets engines of models.
+	baseMountPath string
+	client        *vault.Client
 }
 
 // GetContent implements SecretsBackend.
@@ -65,6 +65,10 @@ var configSchema = configschema.Fields{
 		Description: "The vault TLS server name.",
 		Type:        configschema.Tstring,
 	},
+	provider.ExternalPathPrefixesKey: {
+		Description: "Comma separated path prefixes, including the KV mount, of content managed outside of Juju which secrets may reference.",
+		Type:        configschema.Tstring,
+	},
 }
 
 var configDefaults = schema.Defaults{}
@@ -0,0 +1,137 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+)
+
+// GetExternalContent implements provider.ExternalContentReader.
+// The path includes the mount of the KV secrets engine, eg "kv/app/db".
+// Both version 1 and version 2 KV secrets engines are supported; as
+// version 1 content is not versioned, the version returned for it is a
+// checksum of the content, and the latest content is always read.
+func (k vaultBackend) GetExternalContent(
+	ctx context.Context, path, version string,
+) (_ secrets.SecretValue, _ string, err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	path = strings.Trim(path, "/")
+	mount, kvVersion := k.kvMount(ctx, path)
+	if k.isJujuMount(mount) {
+		return nil, "", errors.NotValidf("path %q in a secrets engine managed by Juju", path)
+	}
+	secretPath := strings.TrimPrefix(strings.TrimPrefix(path, mount), "/")
+	if secretPath == "" {
+		return nil, "", errors.NotValidf("path %q does not refer to a secret", path)
+	}
+
+	var data map[string]any
+	if kvVersion == "2" {
+		var s *api.KVSecret
+		if version == "" {
+			s, err = k.client.KVv2(mount).Get(ctx, secretPath)
+		} else {
+			v, convErr := strconv.Atoi(version)
+			if convErr != nil {
+				return nil, "", errors.NotValidf("version %q for %q", version, path)
+			}
+			s, err = k.client.KVv2(mount).GetVersion(ctx, secretPath, v)
+		}
+		if err == nil && s.Data == nil {
+			// The version has been deleted or destroyed.
+			err = api.ErrSecretNotFound
+		}
+		if err != nil {
+			return nil, "", externalReadError(path, err)
+		}
+		data = s.Data
+		if s.VersionMetadata != nil {
+			version = strconv.Itoa(s.VersionMetadata.Version)
+		}
+	} else {
+		s, err := k.client.KVv1(mount).Get(ctx, secretPath)
+		if err != nil {
+			return nil, "", externalReadError(path, err)
+		}
+		data = s.Data
+		version = ""
+	}
+
+	val := make(map[string]string)
+	for k, v := range data {
+		if s, ok := v.(string); ok {
+			val[k] = base64.StdEncoding.EncodeToString([]byte(s))
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, "", errors.Annotatef(err, "encoding value %q of %q", k, path)
+		}
+		val[k] = base64.StdEncoding.EncodeToString(b)
+	}
+	value := secrets.NewSecretValue(val)
+	if version == "" {
+		if version, err = value.Checksum(); err != nil {
+			return nil, "", errors.Trace(err)
+		}
+	}
+	return value, version, nil
+}
+
+// kvMount returns the mount path and KV engine version for the specified path.
+// If the mount cannot be determined, the first path element is used as the
+// mount of a version 1 KV engine.
+func (k vaultBackend) kvMount(ctx context.Context, path string) (string, string) {
+	// This endpoint is readable by any token with access to the path
+	// and is what the vault CLI uses to determine the engine version.
+	s, err := k.client.Logical().ReadWithContext(ctx, "sys/internal/ui/mounts/"+path)
+	if err == nil && s != nil {
+		mount, _ := s.Data["path"].(string)
+		options, _ := s.Data["options"].(map[string]any)
+		kvVersion, _ := options["version"].(string)
+		if mount = strings.Trim(mount, "/"); mount != "" {
+			return mount, kvVersion
+		}
+	}
+	mount, _, _ := strings.Cut(path, "/")
+	return mount, "1"
+}
+
+// modelMountPattern matches the names of the KV secrets engines Juju mounts
+// for models, "<model name>-<last 6 characters of the model UUID>".
+var modelMountPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?-[0-9a-f]{6}$`)
+
+// isJujuMount returns true if the mount is a KV secrets engine which Juju
+// manages to store the content of secrets. External secrets must not
+// reference such content, as it belongs to other secrets and models.
+func (k vaultBackend) isJujuMount(mount string) bool {
+	if base := strings.Trim(k.baseMountPath, "/"); base != "" {
+		return mount == base || strings.HasPrefix(mount, base+"/")
+	}
+	// Models created before mounts were named for them use the model UUID.
+	return modelMountPattern.MatchString(mount) || names.IsValidModel(mount)
+}
+
+func externalReadError(path string, err error) error {
+	if errors.Is(err, api.ErrSecretNotFound) || isNotFound(err) {
+		return fmt.Errorf("external secret %q not found%w", path, errors.Hide(secreterrors.SecretNotFound))
+	}
+	return errors.Annotatef(err, "reading external secret %q", path)
+}
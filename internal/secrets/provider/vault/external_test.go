@@ -0,0 +1,209 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package vault_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/secrets/provider"
+	jujuvault "github.com/juju/juju/internal/secrets/provider/vault"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+// vaultResponse is the status and body returned by the mock vault for a
+// request URI.
+type vaultResponse struct {
+	status int
+	body   string
+}
+
+// externalReader returns a backend which reads external content from a mock
+// vault serving the specified responses, keyed by request URI.
+func (s *providerSuite) externalReader(c *tc.C, responses map[string]vaultResponse) (*gomock.Controller, provider.ExternalContentReader) {
+	ctrl, newVaultClient := s.newVaultClient(c, nil)
+	s.PatchValue(&jujuvault.NewVaultClient, newVaultClient)
+
+	s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(
+		func(req *http.Request) (*http.Response, error) {
+			c.Check(req.Method, tc.Equals, http.MethodGet)
+			resp, ok := responses[req.URL.RequestURI()]
+			if !ok {
+				c.Errorf("unexpected request %q", req.URL.RequestURI())
+				resp = vaultResponse{status: http.StatusNotFound, body: `{"errors":[]}`}
+			}
+			return &http.Response{
+				Request:    req,
+				StatusCode: resp.status,
+				Body:       io.NopCloser(strings.NewReader(resp.body)),
+			}, nil
+		},
+	).AnyTimes()
+
+	p, err := provider.Provider(jujuvault.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+	b, err := p.NewBackend(&provider.ModelBackendConfig{
+		ModelName: "fred",
+		ModelUUID: coretesting.ModelTag.Id(),
+		BackendConfig: provider.BackendConfig{
+			BackendType: jujuvault.BackendType,
+			Config: map[string]any{
+				"endpoint":        "http://vault-ip:8200/",
+				"token":           "vault-token",
+				"ca-cert":         coretesting.CACert,
+				"tls-server-name": "tls-server",
+			},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	reader, ok := b.(provider.ExternalContentReader)
+	c.Assert(ok, tc.IsTrue)
+	return ctrl, reader
+}
+
+const (
+	kvV2Mount = `{"data":{"path":"kv/","type":"kv","options":{"version":"2"}}}`
+	kvV1Mount = `{"data":{"path":"kv/","type":"kv","options":{}}}`
+)
+
+func (s *providerSuite) TestGetExternalContentKVv2(c *tc.C) {
+	ctrl, reader := s.externalReader(c, map[string]vaultResponse{
+		"/v1/sys/internal/ui/mounts/kv/app/db": {http.StatusOK, kvV2Mount},
+		"/v1/kv/data/app/db": {http.StatusOK, `{"data":{
+			"data":{"password":"secret","port":5432},
+			"metadata":{"version":3,"created_time":"2026-01-02T03:04:05Z","deletion_time":"","destroyed":false}
+		}}`},
+	})
+	defer ctrl.Finish()
+
+	value, version, err := reader.GetExternalContent(c.Context(), "/kv/app/db/", "")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(version, tc.Equals, "3")
+	c.Check(value.EncodedValues(), tc.DeepEquals, map[string]string{
+		"password": "c2VjcmV0",
+		"port":     "NTQzMg==",
+	})
+}
+
+func (s *providerSuite) TestGetExternalContentKVv2Version(c *tc.C) {
+	ctrl, reader := s.externalReader(c, map[string]vaultResponse{
+		"/v1/sys/internal/ui/mounts/kv/app/db": {http.StatusOK, kvV2Mount},
+		"/v1/kv/data/app/db?version=2": {http.StatusOK, `{"data":{
+			"data":{"password":"old"},
+			"metadata":{"version":2,"created_time":"2026-01-02T03:04:05Z","deletion_time":"","destroyed":false}
+		}}`},
+	})
+	defer ctrl.Finish()
+
+	value, version, err := reader.GetExternalContent(c.Context(), "kv/app/db", "2")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(version, tc.Equals, "2")
+	c.Check(value.EncodedValues(), tc.DeepEquals, map[string]string{"password": "b2xk"})
+}
+
+func (s *providerSuite) TestGetExternalContentKVv2VersionNotValid(c *tc.C) {
+	ctrl, reader := s.externalReader(c, map[string]vaultResponse{
+		"/v1/sys/internal/ui/mounts/kv/app/db": {http.StatusOK, kvV2Mount},
+	})
+	defer ctrl.Finish()
+
+	_, _, err := reader.GetExternalContent(c.Context(), "kv/app/db", "latest")
+	c.Assert(err, tc.ErrorIs, errors.NotValid)
+}
+
+func (s *providerSuite) TestGetExternalContentKVv2Destroyed(c *tc.C) {
+	ctrl, reader := s.externalReader(c, map[string]vaultResponse{
+		"/v1/sys/internal/ui/mounts/kv/app/db": {http.StatusOK, kvV2Mount},
+		"/v1/kv/data/app/db?version=2": {http.StatusOK, `{"data":{
+			"data":null,
+			"metadata":{"version":2,"created_time":"2026-01-02T03:04:05Z","deletion_time":"","destroyed":true}
+		}}`},
+	})
+	defer ctrl.Finish()
+
+	_, _, err := reader.GetExternalContent(c.Context(), "kv/app/db", "2")
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretNotFound)
+}
+
+func (s *providerSuite) TestGetExternalContentKVv2NotFound(c *tc.C) {
+	ctrl, reader := s.externalReader(c, map[string]vaultResponse{
+		"/v1/sys/internal/ui/mounts/kv/app/db": {http.StatusOK, kvV2Mount},
+		"/v1/kv/data/app/db":                   {http.StatusNotFound, `{"errors":[]}`},
+	})
+	defer ctrl.Finish()
+
+	_, _, err := reader.GetExternalContent(c.Context(), "kv/app/db", "")
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretNotFound)
+}
+
+func (s *providerSuite) TestGetExternalContentKVv1(c *tc.C) {
+	ctrl, reader := s.externalReader(c, map[string]vaultResponse{
+		"/v1/sys/internal/ui/mounts/kv/app/db": {http.StatusOK, kvV1Mount},
+		"/v1/kv/app/db":                        {http.StatusOK, `{"data":{"password":"secret"}}`},
+	})
+	defer ctrl.Finish()
+
+	value, version, err := reader.GetExternalContent(c.Context(), "kv/app/db", "")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(value.EncodedValues(), tc.DeepEquals, map[string]string{"password": "c2VjcmV0"})
+
+	// KV v1 content isn't versioned, so the version is its checksum.
+	checksum, err := value.Checksum()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(version, tc.Equals, checksum)
+
+	// Asking for a version still reads the latest content.
+	_, pinned, err := reader.GetExternalContent(c.Context(), "kv/app/db", "some-version")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pinned, tc.Equals, checksum)
+}
+
+// TestGetExternalContentMountLookupFails asserts that the first path element
+// is read as a KV v1 mount when the mount can't be looked up.
+func (s *providerSuite) TestGetExternalContentMountLookupFails(c *tc.C) {
+	ctrl, reader := s.externalReader(c, map[string]vaultResponse{
+		"/v1/sys/internal/ui/mounts/secret/app/db": {http.StatusForbidden, `{"errors":["permission denied"]}`},
+		"/v1/secret/app/db":                        {http.StatusOK, `{"data":{"password":"secret"}}`},
+	})
+	defer ctrl.Finish()
+
+	value, _, err := reader.GetExternalContent(c.Context(), "secret/app/db", "")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(value.EncodedValues(), tc.DeepEquals, map[string]string{"password": "c2VjcmV0"})
+}
+
+func (s *providerSuite) TestGetExternalContentJujuMount(c *tc.C) {
+	ctrl, reader := s.externalReader(c, map[string]vaultResponse{
+		"/v1/sys/internal/ui/mounts/fred-06f00d/some-secret": {
+			http.StatusOK, `{"data":{"path":"fred-06f00d/","type":"kv","options":{}}}`,
+		},
+		"/v1/sys/internal/ui/mounts/" + coretesting.ModelTag.Id() + "/some-secret": {
+			http.StatusForbidden, `{"errors":["permission denied"]}`,
+		},
+	})
+	defer ctrl.Finish()
+
+	_, _, err := reader.GetExternalContent(c.Context(), "fred-06f00d/some-secret", "")
+	c.Check(err, tc.ErrorIs, errors.NotValid)
+
+	// Models created before mounts were named for them use the model UUID.
+	_, _, err = reader.GetExternalContent(c.Context(), coretesting.ModelTag.Id()+"/some-secret", "")
+	c.Check(err, tc.ErrorIs, errors.NotValid)
+}
+
+func (s *providerSuite) TestGetExternalContentMountOnly(c *tc.C) {
+	ctrl, reader := s.externalReader(c, map[string]vaultResponse{
+		"/v1/sys/internal/ui/mounts/kv": {http.StatusOK, kvV2Mount},
+	})
+	defer ctrl.Finish()
+
+	_, _, err := reader.GetExternalContent(c.Context(), "kv", "")
+	c.Assert(err, tc.ErrorIs, errors.NotValid)
+}
@@ -369,8 +369,9 @@ func (p vaultProvider) newBackendNoMount(cfg *provider.BackendConfig) (*vaultBac
 		c.SetNamespace(ns)
 	}
 	return &vaultBackend{
-		client:    c,
-		mountPath: validCfg.mountPath(),
+		client:        c,
+		mountPath:     validCfg.mountPath(),
+		baseMountPath: validCfg.mountPath(),
 	}, nil
 }
 
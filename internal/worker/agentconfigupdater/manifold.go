@@ -21,6 +21,7 @@ import (
 	"github.com/juju/juju/core/model"
 	coretrace "github.com/juju/juju/core/trace"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 	jworker "github.com/juju/juju/internal/worker"
 	"github.com/juju/juju/internal/worker/trace"
@@ -139,6 +140,18 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 			if err != nil {
 				return nil, errors.Errorf("getting state serving info: %w", err)
 			}
+
+			// Copy the controller's secret key-encryption keys, so that
+			// this machine can decrypt secret content written by the
+			// others.
+			keys, err := apiState.SecretKeyEncryptionKeys(ctx)
+			if err != nil {
+				return nil, errors.Errorf("getting secret key-encryption keys: %w", err)
+			}
+			keyStore := envelope.NewFileKeyStore(envelope.KeyStorePath(currentConfig.DataDir()))
+			if err := keyStore.AddKeyEncryptionKeys(keys...); err != nil {
+				return nil, errors.Capture(err)
+			}
 			err = agent.ChangeConfig(func(config jujuagent.ConfigSetter) error {
 				existing, hasInfo := config.ControllerAgentInfo()
 				if hasInfo {
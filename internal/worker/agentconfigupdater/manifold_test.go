@@ -24,6 +24,7 @@ import (
 	"github.com/juju/juju/core/watcher/watchertest"
 	"github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/testhelpers"
 	"github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/worker/agentconfigupdater"
@@ -117,7 +118,8 @@ func (s *AgentConfigUpdaterSuite) TestIsControllerFailure(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "checking is controller agent: boom")
 }
 
-func (s *AgentConfigUpdaterSuite) startManifold(c *tc.C, a agent.Agent, mockAPIPort int) (worker.Worker, error) {
+func (s *AgentConfigUpdaterSuite) startManifold(c *tc.C, a *mockAgent, mockAPIPort int) (worker.Worker, error) {
+	a.conf.dataDir = c.MkDir()
 	apiCaller := basetesting.APICallerFunc(
 		func(objType string, version int, id, request string, args, response any) error {
 			c.Assert(objType, tc.Equals, "Agent")
@@ -128,6 +130,10 @@ func (s *AgentConfigUpdaterSuite) startManifold(c *tc.C, a agent.Agent, mockAPIP
 					Cert:       "cert",
 					PrivateKey: "key",
 					APIPort:    mockAPIPort,
+					SecretKeyEncryptionKeys: []params.SecretKeyEncryptionKey{{
+						ID:  "kek-1",
+						Key: []byte("key-material"),
+					}},
 				}
 			default:
 				c.Fatalf("not sure how to handle: %q", request)
@@ -169,6 +175,14 @@ func (s *AgentConfigUpdaterSuite) TestControllerAgentInfo(c *tc.C) {
 	c.Assert(a.conf.cai.APIPort, tc.Equals, mockAPIPort)
 	c.Assert(a.conf.cai.Cert, tc.Equals, "cert")
 	c.Assert(a.conf.cai.PrivateKey, tc.Equals, "key")
+
+	// Verify that the secret key-encryption keys were copied.
+	keys, err := envelope.NewFileKeyStore(envelope.KeyStorePath(a.conf.dataDir)).KeyEncryptionKeys()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(keys, tc.DeepEquals, []envelope.KeyEncryptionKey{{
+		ID:  "kek-1",
+		Key: []byte("key-material"),
+	}})
 }
 
 func (s *AgentConfigUpdaterSuite) TestControllerAgentInfoNotOverwriteCert(c *tc.C) {
@@ -291,9 +305,10 @@ func (ma *mockAgent) ChangeConfig(f agent.ConfigMutator) error {
 
 type mockConfig struct {
 	agent.ConfigSetter
-	tag    names.Tag
-	caiSet bool
-	cai    controller.ControllerAgentInfo
+	tag     names.Tag
+	dataDir string
+	caiSet  bool
+	cai     controller.ControllerAgentInfo
 
 	queryTracingEnabled                bool
 	queryTracingThreshold              time.Duration
@@ -315,6 +330,10 @@ func (mc *mockConfig) Tag() names.Tag {
 	return mc.tag
 }
 
+func (mc *mockConfig) DataDir() string {
+	return mc.dataDir
+}
+
 func (mc *mockConfig) Model() names.ModelTag {
 	return testing.ModelTag
 }
@@ -310,8 +310,18 @@ type NewExternalControllerConnectionFunc func(context.Context, *api.Info) (api.C
 // NewExternalControllerConnection returns an api connection to a controller
 // with the specified api info.
 func NewExternalControllerConnection(ctx context.Context, apiInfo *api.Info) (api.Connection, error) {
+	return NewExternalControllerConnectionWithLoginProvider(ctx, apiInfo, nil)
+}
+
+// NewExternalControllerConnectionWithLoginProvider returns an api connection
+// to a controller with the specified api info, logging in with the supplied
+// login provider. A nil login provider logs in with the api info.
+func NewExternalControllerConnectionWithLoginProvider(
+	ctx context.Context, apiInfo *api.Info, loginProvider api.LoginProvider,
+) (api.Connection, error) {
 	return api.Open(ctx, apiInfo, api.DialOpts{
-		Timeout:    2 * time.Second,
-		RetryDelay: 500 * time.Millisecond,
+		Timeout:       2 * time.Second,
+		RetryDelay:    500 * time.Millisecond,
+		LoginProvider: loginProvider,
 	})
 }
@@ -112,12 +112,15 @@ type MockConfigMockRecorder struct {
 	lokiEndpointExpects                       []*gomock.Call0_1[string]
 	lokiInsecureSkipVerifyExpects             []*gomock.Call0_1[*bool]
 	lokiOrgIDExpects                          []*gomock.Call0_1[string]
+	lokiProtocolExpects                       []*gomock.Call0_1[string]
 	metricsSpoolDirExpects                    []*gomock.Call0_1[string]
 	modelExpects                              []*gomock.Call0_1[names.ModelTag]
 	nonceExpects                              []*gomock.Call0_1[string]
 	oldPasswordExpects                        []*gomock.Call0_1[string]
+	openTelemetryCACertificateExpects         []*gomock.Call0_1[string]
 	openTelemetryEnabledExpects               []*gomock.Call0_1[bool]
-	openTelemetryEndpointExpects              []*gomock.Call0_1[string]
+	openTelemetryGRPCEndpointExpects          []*gomock.Call0_1[string]
+	openTelemetryHTTPEndpointExpects          []*gomock.Call0_1[string]
 	openTelemetryInsecureExpects              []*gomock.Call0_1[bool]
 	openTelemetrySampleRatioExpects           []*gomock.Call0_1[float64]
 	openTelemetryStackTracesExpects           []*gomock.Call0_1[bool]
@@ -468,6 +471,24 @@ func (mr *MockConfigMockRecorder) LokiOrgID() *MockConfigLokiOrgIDCall {
 // MockConfigLokiOrgIDCall is the typed call wrapper for LokiOrgID.
 type MockConfigLokiOrgIDCall = gomock.Call0_1[string]
 
+// LokiProtocol mocks base method.
+func (m *MockConfig) LokiProtocol() string {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.lokiProtocolExpects, m.ctrl, m, "LokiProtocol")
+}
+
+// LokiProtocol indicates an expected call of LokiProtocol.
+func (mr *MockConfigMockRecorder) LokiProtocol() *MockConfigLokiProtocolCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[string](mr.mock.ctrl.T, mr.mock, "LokiProtocol")
+	mr.lokiProtocolExpects = append(mr.lokiProtocolExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockConfigLokiProtocolCall is the typed call wrapper for LokiProtocol.
+type MockConfigLokiProtocolCall = gomock.Call0_1[string]
+
 // MetricsSpoolDir mocks base method.
 func (m *MockConfig) MetricsSpoolDir() string {
 	m.ctrl.T.Helper()
@@ -540,6 +561,24 @@ func (mr *MockConfigMockRecorder) OldPassword() *MockConfigOldPasswordCall {
 // MockConfigOldPasswordCall is the typed call wrapper for OldPassword.
 type MockConfigOldPasswordCall = gomock.Call0_1[string]
 
+// OpenTelemetryCACertificate mocks base method.
+func (m *MockConfig) OpenTelemetryCACertificate() string {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.openTelemetryCACertificateExpects, m.ctrl, m, "OpenTelemetryCACertificate")
+}
+
+// OpenTelemetryCACertificate indicates an expected call of OpenTelemetryCACertificate.
+func (mr *MockConfigMockRecorder) OpenTelemetryCACertificate() *MockConfigOpenTelemetryCACertificateCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[string](mr.mock.ctrl.T, mr.mock, "OpenTelemetryCACertificate")
+	mr.openTelemetryCACertificateExpects = append(mr.openTelemetryCACertificateExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockConfigOpenTelemetryCACertificateCall is the typed call wrapper for OpenTelemetryCACertificate.
+type MockConfigOpenTelemetryCACertificateCall = gomock.Call0_1[string]
+
 // OpenTelemetryEnabled mocks base method.
 func (m *MockConfig) OpenTelemetryEnabled() bool {
 	m.ctrl.T.Helper()
@@ -558,23 +597,41 @@ func (mr *MockConfigMockRecorder) OpenTelemetryEnabled() *MockConfigOpenTelemetr
 // MockConfigOpenTelemetryEnabledCall is the typed call wrapper for OpenTelemetryEnabled.
 type MockConfigOpenTelemetryEnabledCall = gomock.Call0_1[bool]
 
-// OpenTelemetryEndpoint mocks base method.
-func (m *MockConfig) OpenTelemetryEndpoint() string {
+// OpenTelemetryGRPCEndpoint mocks base method.
+func (m *MockConfig) OpenTelemetryGRPCEndpoint() string {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.openTelemetryGRPCEndpointExpects, m.ctrl, m, "OpenTelemetryGRPCEndpoint")
+}
+
+// OpenTelemetryGRPCEndpoint indicates an expected call of OpenTelemetryGRPCEndpoint.
+func (mr *MockConfigMockRecorder) OpenTelemetryGRPCEndpoint() *MockConfigOpenTelemetryGRPCEndpointCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[string](mr.mock.ctrl.T, mr.mock, "OpenTelemetryGRPCEndpoint")
+	mr.openTelemetryGRPCEndpointExpects = append(mr.openTelemetryGRPCEndpointExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockConfigOpenTelemetryGRPCEndpointCall is the typed call wrapper for OpenTelemetryGRPCEndpoint.
+type MockConfigOpenTelemetryGRPCEndpointCall = gomock.Call0_1[string]
+
+// OpenTelemetryHTTPEndpoint mocks base method.
+func (m *MockConfig) OpenTelemetryHTTPEndpoint() string {
 	m.ctrl.T.Helper()
-	return gomock.Dispatch0_1(&m.recorder.openTelemetryEndpointExpects, m.ctrl, m, "OpenTelemetryEndpoint")
+	return gomock.Dispatch0_1(&m.recorder.openTelemetryHTTPEndpointExpects, m.ctrl, m, "OpenTelemetryHTTPEndpoint")
 }
 
-// OpenTelemetryEndpoint indicates an expected call of OpenTelemetryEndpoint.
-func (mr *MockConfigMockRecorder) OpenTelemetryEndpoint() *MockConfigOpenTelemetryEndpointCall {
+// OpenTelemetryHTTPEndpoint indicates an expected call of OpenTelemetryHTTPEndpoint.
+func (mr *MockConfigMockRecorder) OpenTelemetryHTTPEndpoint() *MockConfigOpenTelemetryHTTPEndpointCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[string](mr.mock.ctrl.T, mr.mock, "OpenTelemetryEndpoint")
-	mr.openTelemetryEndpointExpects = append(mr.openTelemetryEndpointExpects, call)
+	call := gomock.NewCall0_1[string](mr.mock.ctrl.T, mr.mock, "OpenTelemetryHTTPEndpoint")
+	mr.openTelemetryHTTPEndpointExpects = append(mr.openTelemetryHTTPEndpointExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
-// MockConfigOpenTelemetryEndpointCall is the typed call wrapper for OpenTelemetryEndpoint.
-type MockConfigOpenTelemetryEndpointCall = gomock.Call0_1[string]
+// MockConfigOpenTelemetryHTTPEndpointCall is the typed call wrapper for OpenTelemetryHTTPEndpoint.
+type MockConfigOpenTelemetryHTTPEndpointCall = gomock.Call0_1[string]
 
 // OpenTelemetryInsecure mocks base method.
 func (m *MockConfig) OpenTelemetryInsecure() bool {
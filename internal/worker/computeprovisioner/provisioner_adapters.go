@@ -24,6 +24,7 @@ import (
 	"github.com/juju/juju/core/watcher/eventsource"
 	"github.com/juju/juju/domain/agentbinary"
 	agentbinaryservice "github.com/juju/juju/domain/agentbinary/service"
+	"github.com/juju/juju/domain/application"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	provisioning "github.com/juju/juju/domain/provisioner"
 	"github.com/juju/juju/environs/config"
@@ -84,6 +85,7 @@ type AgentBinaryDomainService interface {
 // ApplicationDomainService provides access to application domain operations.
 type ApplicationDomainService interface {
 	GetMachinesForApplication(ctx context.Context, appName string) ([]coremachine.Name, error)
+	GetMachinePlacementZoneRules(ctx context.Context, machineName coremachine.Name) (application.MachineZoneRules, error)
 }
 
 // RemovalDomainService provides access to removal domain operations.
@@ -425,6 +427,16 @@ func (a *distributionGroupFinderAdapter) DistributionGroupByMachineId(ctx contex
 			continue
 		}
 		results[i].MachineIds = machineIds
+
+		rules, err := a.appSvc.GetMachinePlacementZoneRules(ctx, machineName)
+		if err != nil {
+			results[i].Err = convertError(err)
+			continue
+		}
+		results[i].ExcludedZones = rules.ExcludedZones
+		for _, m := range rules.AntiAffinityMachines {
+			results[i].AntiAffinityMachineIds = append(results[i].AntiAffinityMachineIds, m.String())
+		}
 	}
 	return results, nil
 }
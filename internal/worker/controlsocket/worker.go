@@ -322,15 +322,20 @@ func (w *Worker) registerHandlers(r *mux.Router) {
 	r.Handle("/s3-config", w.withMetrics("/s3-config", http.HandlerFunc(w.handleRemoveS3Config))).
 		Methods(http.MethodDelete)
 
-	// loki-endpoint endpoint for managing the Loki push API endpoint. This
+	// loki-endpoint endpoint for managing the log forwarding endpoint. This
 	// is a POST endpoint that accepts a JSON body with the following format:
 	//
 	// {
 	//   "url": <string>,
+	//   "ca_cert": <string>,
+	//   "insecure_skip_verify": <bool>,
+	//   "org_id": <string>,
+	//   "protocol": "loki" | "otlp",
 	// }
 	//
-	// The worker will persist the Loki endpoint in the controller database
-	// so it can be distributed to agents for direct log shipping.
+	// The protocol defaults to "loki". The worker will persist the endpoint
+	// in the controller database so it can be distributed to agents for
+	// direct log shipping.
 	r.Handle("/loki-endpoint", w.withMetrics("/loki-endpoint", w.handleJSONPost(w.handleSetLokiEndpoint))).
 		Methods(http.MethodPost)
 	r.Handle("/loki-endpoint", w.withMetrics("/loki-endpoint", http.HandlerFunc(w.handleRemoveLokiEndpoint))).
@@ -625,6 +630,7 @@ type lokiEndpointRequest struct {
 	CACertificate      string `json:"ca_cert"`
 	InsecureSkipVerify *bool  `json:"insecure_skip_verify"`
 	OrgID              string `json:"org_id"`
+	Protocol           string `json:"protocol"`
 }
 
 func (w *Worker) handleSetLokiEndpoint(resp http.ResponseWriter, req *http.Request) {
@@ -652,26 +658,27 @@ func (w *Worker) handleSetLokiEndpoint(resp http.ResponseWriter, req *http.Reque
 		CACertificate:      parsedBody.CACertificate,
 		InsecureSkipVerify: parsedBody.InsecureSkipVerify,
 		OrgID:              parsedBody.OrgID,
+		Protocol:           logging.Protocol(parsedBody.Protocol),
 	}); internalerrors.Is(err, coreerrors.NotValid) {
-		w.writeErrorResponse(ctx, resp, http.StatusBadRequest, internalerrors.Errorf("invalid loki endpoint: %w", err))
+		w.writeErrorResponse(ctx, resp, http.StatusBadRequest, internalerrors.Errorf("invalid log forwarding endpoint: %w", err))
 		return
 	} else if err != nil {
-		w.writeErrorResponse(ctx, resp, http.StatusInternalServerError, internalerrors.Errorf("saving loki endpoint: %w", err))
+		w.writeErrorResponse(ctx, resp, http.StatusInternalServerError, internalerrors.Errorf("saving log forwarding endpoint: %w", err))
 		return
 	}
 
-	w.writeResponse(ctx, resp, http.StatusOK, infof("updated loki endpoint"))
+	w.writeResponse(ctx, resp, http.StatusOK, infof("updated log forwarding endpoint"))
 }
 
 func (w *Worker) handleRemoveLokiEndpoint(resp http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 
 	if err := w.loggingService.DeleteLokiConfig(ctx); err != nil {
-		w.writeErrorResponse(ctx, resp, http.StatusInternalServerError, internalerrors.Errorf("removing loki endpoint: %w", err))
+		w.writeErrorResponse(ctx, resp, http.StatusInternalServerError, internalerrors.Errorf("removing log forwarding endpoint: %w", err))
 		return
 	}
 
-	w.writeResponse(ctx, resp, http.StatusOK, infof("removed loki endpoint"))
+	w.writeResponse(ctx, resp, http.StatusOK, infof("removed log forwarding endpoint"))
 }
 
 func (w *Worker) handleJSONPost(fn func(http.ResponseWriter, *http.Request)) http.Handler {
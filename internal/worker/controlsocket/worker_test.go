@@ -159,7 +159,7 @@ func (s *workerSuite) TestRequestMetrics(c *tc.C) {
 		endpoint:   "/loki-endpoint",
 		body:       `{"url":"http://loki:3100"}`,
 		statusCode: http.StatusOK,
-		response:   `.*updated loki endpoint.*`,
+		response:   `.*updated log forwarding endpoint.*`,
 	})
 
 	c.Check(testutil.ToFloat64(collector.Requests.WithLabelValues(
@@ -1206,7 +1206,7 @@ func (s *workerSuite) TestSetLokiEndpoint(c *tc.C) {
 		endpoint:   "/loki-endpoint",
 		body:       `{"url":"http://loki:3100/loki/api/v1/push","ca_cert":"ca-cert"}`,
 		statusCode: http.StatusOK,
-		response:   ".*updated loki endpoint.*",
+		response:   ".*updated log forwarding endpoint.*",
 	})
 }
 
@@ -1230,7 +1230,7 @@ func (s *workerSuite) TestSetLokiEndpointInsecureSkipVerify(c *tc.C) {
 		endpoint:   "/loki-endpoint",
 		body:       `{"url":"http://loki:3100/loki/api/v1/push","ca_cert":"ca-cert","insecure_skip_verify":true}`,
 		statusCode: http.StatusOK,
-		response:   ".*updated loki endpoint.*",
+		response:   ".*updated log forwarding endpoint.*",
 	})
 }
 
@@ -1252,7 +1252,7 @@ func (s *workerSuite) TestSetLokiEndpointOmitsInsecureSkipVerify(c *tc.C) {
 		endpoint:   "/loki-endpoint",
 		body:       `{"url":"http://loki:3100/loki/api/v1/push"}`,
 		statusCode: http.StatusOK,
-		response:   ".*updated loki endpoint.*",
+		response:   ".*updated log forwarding endpoint.*",
 	})
 }
 
@@ -1260,7 +1260,7 @@ func (s *workerSuite) TestSetLokiEndpointEmptyURL(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.loggingService.EXPECT().SetLokiConfig(gomock.Any(), logging.LokiConfig{}).Return(
-		internalerrors.Errorf("empty loki endpoint").Add(coreerrors.NotValid),
+		internalerrors.Errorf("empty log forwarding endpoint").Add(coreerrors.NotValid),
 	)
 
 	socket := s.newSocket(c)
@@ -1273,7 +1273,53 @@ func (s *workerSuite) TestSetLokiEndpointEmptyURL(c *tc.C) {
 		endpoint:   "/loki-endpoint",
 		body:       `{"url":""}`,
 		statusCode: http.StatusBadRequest,
-		response:   ".*invalid loki endpoint.*",
+		response:   ".*invalid log forwarding endpoint.*",
+	})
+}
+
+func (s *workerSuite) TestSetLokiEndpointOTLPProtocol(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.loggingService.EXPECT().SetLokiConfig(gomock.Any(), logging.LokiConfig{
+		Endpoint: "http://collector:4318/v1/logs",
+		Protocol: logging.ProtocolOTLP,
+	}).Return(nil)
+
+	socket := s.newSocket(c)
+
+	w := s.newWorker(c, socket)
+	defer workertest.CleanKill(c, w)
+
+	s.runHandlerTest(c, socket, handlerTest{
+		method:     http.MethodPost,
+		endpoint:   "/loki-endpoint",
+		body:       `{"url":"http://collector:4318/v1/logs","protocol":"otlp"}`,
+		statusCode: http.StatusOK,
+		response:   ".*updated log forwarding endpoint.*",
+	})
+}
+
+func (s *workerSuite) TestSetLokiEndpointInvalidProtocol(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.loggingService.EXPECT().SetLokiConfig(gomock.Any(), logging.LokiConfig{
+		Endpoint: "http://collector:4318/v1/logs",
+		Protocol: "syslog",
+	}).Return(
+		internalerrors.Errorf(`unknown logging protocol "syslog"`).Add(coreerrors.NotValid),
+	)
+
+	socket := s.newSocket(c)
+
+	w := s.newWorker(c, socket)
+	defer workertest.CleanKill(c, w)
+
+	s.runHandlerTest(c, socket, handlerTest{
+		method:     http.MethodPost,
+		endpoint:   "/loki-endpoint",
+		body:       `{"url":"http://collector:4318/v1/logs","protocol":"syslog"}`,
+		statusCode: http.StatusBadRequest,
+		response:   `.*invalid log forwarding endpoint: unknown logging protocol \\"syslog\\".*`,
 	})
 }
 
@@ -1308,7 +1354,7 @@ func (s *workerSuite) TestRemoveLokiEndpoint(c *tc.C) {
 		method:     http.MethodDelete,
 		endpoint:   "/loki-endpoint",
 		statusCode: http.StatusOK,
-		response:   ".*removed loki endpoint.*",
+		response:   ".*removed log forwarding endpoint.*",
 	})
 }
 
@@ -1328,7 +1374,7 @@ func (s *workerSuite) TestRemoveLokiEndpointError(c *tc.C) {
 		method:     http.MethodDelete,
 		endpoint:   "/loki-endpoint",
 		statusCode: http.StatusInternalServerError,
-		response:   ".*removing loki endpoint.*",
+		response:   ".*removing log forwarding endpoint.*",
 	})
 }
 
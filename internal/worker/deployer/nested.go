@@ -383,6 +383,7 @@ func (c *nestedContext) createUnitAgentConfig(ctx context.Context, tag names.Uni
 			LokiCACert:             derefString(lokiCACert),
 			LokiInsecureSkipVerify: agentConfig.LokiInsecureSkipVerify(),
 			LokiOrgID:              agentConfig.LokiOrgID(),
+			LokiProtocol:           agentConfig.LokiProtocol(),
 
 			AgentLogfileMaxBackups: agentConfig.AgentLogfileMaxBackups(),
 			AgentLogfileMaxSizeMB:  agentConfig.AgentLogfileMaxSizeMB(),
@@ -399,7 +400,7 @@ func (c *nestedContext) createUnitAgentConfig(ctx context.Context, tag names.Uni
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	conf.SetLokiConfig(agentConfig.LokiEndpoint(), lokiCACert, agentConfig.LokiInsecureSkipVerify(), agentConfig.LokiOrgID())
+	conf.SetLokiConfig(agentConfig.LokiEndpoint(), lokiCACert, agentConfig.LokiInsecureSkipVerify(), agentConfig.LokiOrgID(), agentConfig.LokiProtocol())
 	return conf, errors.Trace(conf.Write())
 }
 
@@ -550,7 +551,10 @@ func (c *nestedContext) syncUnitLokiConfig(ctx context.Context) error {
 		if unit == nil {
 			continue
 		}
-		changed, err := unit.syncLokiConfig(agentConfig.LokiEndpoint(), lokiCACert, agentConfig.LokiInsecureSkipVerify(), agentConfig.LokiOrgID())
+		changed, err := unit.syncLokiConfig(
+			agentConfig.LokiEndpoint(), lokiCACert, agentConfig.LokiInsecureSkipVerify(),
+			agentConfig.LokiOrgID(), agentConfig.LokiProtocol(),
+		)
 		if err != nil {
 			return errors.Annotatef(err, "syncing Loki config for %q", unit.name)
 		}
@@ -214,7 +214,7 @@ func (s *NestedContextSuite) TestDeployUnitCopiesMachineLokiConfig(c *tc.C) {
 	caCert := "loki-ca"
 	insecureSkipVerify := true
 	err := s.agent.ChangeConfig(func(setter agent.ConfigSetter) error {
-		setter.SetLokiConfig("https://loki.example.com/loki/api/v1/push", &caCert, &insecureSkipVerify, "tenant-a")
+		setter.SetLokiConfig("https://loki.example.com/loki/api/v1/push", &caCert, &insecureSkipVerify, "tenant-a", "")
 		return nil
 	})
 	c.Assert(err, tc.ErrorIsNil)
@@ -245,7 +245,7 @@ func (s *NestedContextSuite) TestSyncExistingUnitLokiConfig(c *tc.C) {
 	caCert := "updated-loki-ca"
 	insecureSkipVerify := true
 	err = s.agent.ChangeConfig(func(setter agent.ConfigSetter) error {
-		setter.SetLokiConfig("https://loki.example.com/loki/api/v1/push", &caCert, &insecureSkipVerify, "tenant-b")
+		setter.SetLokiConfig("https://loki.example.com/loki/api/v1/push", &caCert, &insecureSkipVerify, "tenant-b", "")
 		return nil
 	})
 	c.Assert(err, tc.ErrorIsNil)
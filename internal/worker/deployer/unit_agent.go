@@ -340,7 +340,7 @@ func (a *UnitAgent) CurrentConfig() agent.Config {
 	return a.agentConf.Clone()
 }
 
-func (a *UnitAgent) syncLokiConfig(endpoint string, caCert *string, insecureSkipVerify *bool, orgID string) (bool, error) {
+func (a *UnitAgent) syncLokiConfig(endpoint string, caCert *string, insecureSkipVerify *bool, orgID, protocol string) (bool, error) {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
@@ -348,11 +348,12 @@ func (a *UnitAgent) syncLokiConfig(endpoint string, caCert *string, insecureSkip
 	if current.LokiEndpoint() == endpoint &&
 		current.LokiCACert() == stringValue(caCert) &&
 		boolPtrEqual(current.LokiInsecureSkipVerify(), insecureSkipVerify) &&
-		current.LokiOrgID() == orgID {
+		current.LokiOrgID() == orgID &&
+		current.LokiProtocol() == protocol {
 		return false, nil
 	}
 
-	a.agentConf.SetLokiConfig(endpoint, caCert, insecureSkipVerify, orgID)
+	a.agentConf.SetLokiConfig(endpoint, caCert, insecureSkipVerify, orgID, protocol)
 	if err := a.agentConf.Write(); err != nil {
 		return false, errors.Trace(err)
 	}
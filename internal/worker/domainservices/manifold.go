@@ -21,6 +21,7 @@ import (
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
 	domainservices "github.com/juju/juju/domain/services"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 	sshimporter "github.com/juju/juju/internal/ssh/importer"
 	"github.com/juju/juju/internal/worker/common"
@@ -38,6 +39,7 @@ type ManifoldConfig struct {
 	LeaseManagerName            string
 	LogSinkName                 string
 	LogDir                      string
+	DataDir                     string
 	Logger                      logger.Logger
 	Clock                       clock.Clock
 	NewWorker                   func(Config) (worker.Worker, error)
@@ -59,6 +61,7 @@ type DomainServicesGetterFn func(
 	coredatabase.ClusterDescriber,
 	corehttp.HTTPClient,
 	string,
+	envelope.KeyStore,
 	clock.Clock,
 	logger.LoggerContextGetter,
 ) services.DomainServicesGetter
@@ -68,6 +71,7 @@ type DomainServicesGetterFn func(
 type ControllerDomainServicesFn func(
 	changestream.WatchableDBGetter,
 	objectstore.NamespacedObjectStoreGetter,
+	envelope.KeyStore,
 	clock.Clock,
 	logger.Logger,
 	logger.LoggerContextGetter,
@@ -86,6 +90,7 @@ type ModelDomainServicesFn func(
 	coredatabase.ClusterDescriber,
 	corehttp.HTTPClient,
 	string,
+	envelope.KeyStore,
 	clock.Clock,
 	logger.Logger,
 ) services.ModelDomainServices
@@ -131,6 +136,9 @@ func (config ManifoldConfig) Validate() error {
 	if config.LogDir == "" {
 		return errors.NotValidf("empty LogDir")
 	}
+	if config.DataDir == "" {
+		return errors.NotValidf("empty DataDir")
+	}
 	if config.Logger == nil {
 		return errors.NotValidf("nil Logger")
 	}
@@ -225,6 +233,7 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 		LeaseManager:                leaseManager,
 		LoggerContextGetter:         loggerContextGetter,
 		LogDir:                      config.LogDir,
+		SecretKeyStore:              envelope.NewFileKeyStore(envelope.KeyStorePath(config.DataDir)),
 		Logger:                      config.Logger,
 		Clock:                       config.Clock,
 		NewDomainServicesGetter:     config.NewDomainServicesGetter,
@@ -260,6 +269,7 @@ func (config ManifoldConfig) output(in worker.Worker, out any) error {
 func NewControllerDomainServices(
 	dbGetter changestream.WatchableDBGetter,
 	controllerObjectStoreGetter objectstore.NamespacedObjectStoreGetter,
+	secretKeyStore envelope.KeyStore,
 	clock clock.Clock,
 	logger logger.Logger,
 	loggerContextGetter logger.LoggerContextGetter,
@@ -267,6 +277,7 @@ func NewControllerDomainServices(
 	return domainservices.NewControllerServices(
 		changestream.NewWatchableDBFactoryForNamespace(dbGetter.GetWatchableDB, coredatabase.ControllerNS),
 		controllerObjectStoreGetter,
+		secretKeyStore,
 		clock,
 		logger,
 		loggerContextGetter,
@@ -287,6 +298,7 @@ func NewProviderTrackerModelDomainServices(
 	clusterDescriber coredatabase.ClusterDescriber,
 	simpleStreamsHTTPClient corehttp.HTTPClient,
 	logDir string,
+	secretKeyStore envelope.KeyStore,
 	clock clock.Clock,
 	logger logger.Logger,
 ) services.ModelDomainServices {
@@ -303,6 +315,7 @@ func NewProviderTrackerModelDomainServices(
 		clusterDescriber,
 		simpleStreamsHTTPClient,
 		logDir,
+		secretKeyStore,
 		clock,
 		logger,
 	)
@@ -321,6 +334,7 @@ func NewDomainServicesGetter(
 	clusterDescriber coredatabase.ClusterDescriber,
 	simpleStreamsHTTPClient corehttp.HTTPClient,
 	logDir string,
+	secretKeyStore envelope.KeyStore,
 	clock clock.Clock,
 	loggerContextGetter logger.LoggerContextGetter,
 ) services.DomainServicesGetter {
@@ -336,6 +350,7 @@ func NewDomainServicesGetter(
 		clusterDescriber:        clusterDescriber,
 		simpleStreamsHTTPClient: simpleStreamsHTTPClient,
 		logDir:                  logDir,
+		secretKeyStore:          secretKeyStore,
 		clock:                   clock,
 		loggerContextGetter:     loggerContextGetter,
 	}
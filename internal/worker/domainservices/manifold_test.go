@@ -24,6 +24,7 @@ import (
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
 	domainservices "github.com/juju/juju/domain/services"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 )
 
@@ -97,6 +98,10 @@ func (s *manifoldSuite) TestValidateConfig(c *tc.C) {
 	cfg.LogDir = ""
 	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
 
+	cfg = s.getConfig(c)
+	cfg.DataDir = ""
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
 	cfg = s.getConfig(c)
 	cfg.Clock = nil
 	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
@@ -134,6 +139,7 @@ func (s *manifoldSuite) TestStart(c *tc.C) {
 		NewControllerDomainServices: NewControllerDomainServices,
 		NewModelDomainServices:      NewProviderTrackerModelDomainServices,
 		LogDir:                      c.MkDir(),
+		DataDir:                     c.MkDir(),
 		Clock:                       s.clock,
 	})
 	w, err := manifold.Start(c.Context(), dt.StubGetter(getter))
@@ -160,6 +166,7 @@ func (s *manifoldSuite) TestOutputControllerDomainServices(c *tc.C) {
 		NewControllerDomainServices: NewControllerDomainServices,
 		NewModelDomainServices:      NewProviderTrackerModelDomainServices,
 		LogDir:                      c.MkDir(),
+		SecretKeyStore:              s.secretKeyStore(c),
 		Clock:                       s.clock,
 		SimpleStreamsClient:         s.httpClient,
 	})
@@ -191,6 +198,7 @@ func (s *manifoldSuite) TestOutputDomainServicesGetter(c *tc.C) {
 		NewModelDomainServices:      NewProviderTrackerModelDomainServices,
 		SimpleStreamsClient:         s.httpClient,
 		LogDir:                      c.MkDir(),
+		SecretKeyStore:              s.secretKeyStore(c),
 		Clock:                       s.clock,
 	})
 	c.Assert(err, tc.ErrorIsNil)
@@ -221,6 +229,7 @@ func (s *manifoldSuite) TestOutputInvalid(c *tc.C) {
 		NewModelDomainServices:      NewProviderTrackerModelDomainServices,
 		SimpleStreamsClient:         s.httpClient,
 		LogDir:                      c.MkDir(),
+		SecretKeyStore:              s.secretKeyStore(c),
 		Clock:                       s.clock,
 	})
 	c.Assert(err, tc.ErrorIsNil)
@@ -234,7 +243,7 @@ func (s *manifoldSuite) TestOutputInvalid(c *tc.C) {
 }
 
 func (s *manifoldSuite) TestNewControllerDomainServices(c *tc.C) {
-	factory := NewControllerDomainServices(s.dbGetter, s.modelObjectStoreGetter, s.clock, s.logger, s.loggerContextGetter)
+	factory := NewControllerDomainServices(s.dbGetter, s.modelObjectStoreGetter, s.secretKeyStore(c), s.clock, s.logger, s.loggerContextGetter)
 	c.Assert(factory, tc.NotNil)
 }
 
@@ -250,6 +259,7 @@ func (s *manifoldSuite) TestNewModelDomainServices(c *tc.C) {
 		s.clusterDescriber,
 		s.httpClient,
 		c.MkDir(),
+		s.secretKeyStore(c),
 		s.clock,
 		s.logger,
 	)
@@ -262,7 +272,7 @@ func (s *manifoldSuite) TestNewDomainServicesGetter(c *tc.C) {
 	s.loggerContextGetter.EXPECT().GetLoggerContext(gomock.Any(), coremodel.UUID("model")).Return(s.loggerContext, nil)
 	s.loggerContext.EXPECT().GetLogger("juju.services").Return(s.logger)
 
-	ctrlFactory := NewControllerDomainServices(s.dbGetter, s.modelObjectStoreGetter, s.clock, s.logger, s.loggerContextGetter)
+	ctrlFactory := NewControllerDomainServices(s.dbGetter, s.modelObjectStoreGetter, s.secretKeyStore(c), s.clock, s.logger, s.loggerContextGetter)
 	factory := NewDomainServicesGetter(
 		ctrlFactory,
 		s.dbGetter,
@@ -275,6 +285,7 @@ func (s *manifoldSuite) TestNewDomainServicesGetter(c *tc.C) {
 		s.clusterDescriber,
 		s.httpClient,
 		c.MkDir(),
+		s.secretKeyStore(c),
 		s.clock,
 		s.loggerContextGetter,
 	)
@@ -296,6 +307,7 @@ func (s *manifoldSuite) getConfig(c *tc.C) ManifoldConfig {
 		LeaseManagerName:    "leasemanager",
 		LogSinkName:         "logsink",
 		LogDir:              c.MkDir(),
+		DataDir:             c.MkDir(),
 		Clock:               s.clock,
 		Logger:              s.logger,
 		NewWorker: func(Config) (worker.Worker, error) {
@@ -319,6 +331,7 @@ func noopDomainServicesGetter(
 	database.ClusterDescriber,
 	corehttp.HTTPClient,
 	string,
+	envelope.KeyStore,
 	clock.Clock,
 	logger.LoggerContextGetter,
 ) services.DomainServicesGetter {
@@ -328,6 +341,7 @@ func noopDomainServicesGetter(
 func noopControllerDomainServices(
 	changestream.WatchableDBGetter,
 	objectstore.NamespacedObjectStoreGetter,
+	envelope.KeyStore,
 	clock.Clock,
 	logger.Logger,
 	logger.LoggerContextGetter,
@@ -347,6 +361,7 @@ func noopModelDomainServices(
 	database.ClusterDescriber,
 	corehttp.HTTPClient,
 	string,
+	envelope.KeyStore,
 	clock.Clock,
 	logger.Logger,
 ) services.ModelDomainServices {
@@ -21,6 +21,7 @@ import (
 	domaintesting "github.com/juju/juju/domain/schema/testing"
 	domainservices "github.com/juju/juju/domain/services"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 	sshimporter "github.com/juju/juju/internal/ssh/importer"
 )
@@ -149,6 +150,10 @@ func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 	return ctrl
 }
 
+func (s *baseSuite) secretKeyStore(c *tc.C) envelope.KeyStore {
+	return envelope.NewFileKeyStore(envelope.KeyStorePath(c.MkDir()))
+}
+
 // NewModelDomainServices returns a new model domain services.
 // This creates a model domain services without a provider tracker. The provider
 // tracker will return not supported errors for all methods.
@@ -163,6 +168,7 @@ func NewModelDomainServices(
 	clusterDescriber coredatabase.ClusterDescriber,
 	simpleStreamsClient corehttp.HTTPClient,
 	logDir string,
+	secretKeyStore envelope.KeyStore,
 	clock clock.Clock,
 	logger logger.Logger,
 ) services.ModelDomainServices {
@@ -179,6 +185,7 @@ func NewModelDomainServices(
 		clusterDescriber,
 		simpleStreamsClient,
 		logDir,
+		secretKeyStore,
 		clock,
 		logger,
 	)
@@ -22,6 +22,7 @@ import (
 	"github.com/juju/juju/core/storage"
 	domainservices "github.com/juju/juju/domain/services"
 	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 	internalstorage "github.com/juju/juju/internal/storage"
 )
@@ -55,6 +56,9 @@ type Config struct {
 	// LogDir is the directory where logs are stored.
 	LogDir string
 
+	// SecretKeyStore holds the controller's secret key-encryption keys.
+	SecretKeyStore envelope.KeyStore
+
 	// Logger is used to log messages.
 	Logger logger.Logger
 
@@ -115,6 +119,9 @@ func (config Config) Validate() error {
 	if config.LogDir == "" {
 		return errors.NotValidf("empty LogDir")
 	}
+	if config.SecretKeyStore == nil {
+		return errors.NotValidf("nil SecretKeyStore")
+	}
 	if config.Logger == nil {
 		return errors.NotValidf("nil Logger")
 	}
@@ -136,6 +143,7 @@ func NewWorker(config Config) (worker.Worker, error) {
 	ctrlFactory := config.NewControllerDomainServices(
 		config.DBGetter,
 		controllerObjectStoreGetter,
+		config.SecretKeyStore,
 		config.Clock,
 		config.Logger,
 		config.LoggerContextGetter,
@@ -154,6 +162,7 @@ func NewWorker(config Config) (worker.Worker, error) {
 			config.ClusterDescriber,
 			config.SimpleStreamsClient,
 			config.LogDir,
+			config.SecretKeyStore,
 			config.Clock,
 			config.LoggerContextGetter,
 		),
@@ -221,6 +230,7 @@ type domainServicesGetter struct {
 	clusterDescriber        coredatabase.ClusterDescriber
 	simpleStreamsHTTPClient http.HTTPClient
 	logDir                  string
+	secretKeyStore          envelope.KeyStore
 	clock                   clock.Clock
 	loggerContextGetter     logger.LoggerContextGetter
 }
@@ -257,6 +267,7 @@ func (s *domainServicesGetter) ServicesForModel(ctx context.Context, modelUUID c
 			s.clusterDescriber,
 			s.simpleStreamsHTTPClient,
 			s.logDir,
+			s.secretKeyStore,
 			s.clock,
 			loggerContext.GetLogger("juju.services"),
 		),
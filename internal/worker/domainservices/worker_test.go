@@ -22,6 +22,7 @@ import (
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
 	domainservices "github.com/juju/juju/domain/services"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 )
 
@@ -91,6 +92,10 @@ func (s *workerSuite) TestValidateConfig(c *tc.C) {
 	cfg.LogDir = ""
 	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
 
+	cfg = s.getConfig(c)
+	cfg.SecretKeyStore = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
 	cfg = s.getConfig(c)
 	cfg.Clock = nil
 	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
@@ -110,6 +115,7 @@ func (s *workerSuite) getConfig(c *tc.C) Config {
 		LeaseManager:          s.leaseManager,
 		ClusterDescriber:      s.clusterDescriber,
 		LogDir:                c.MkDir(),
+		SecretKeyStore:        envelope.NewFileKeyStore(envelope.KeyStorePath(c.MkDir())),
 		Clock:                 s.clock,
 		SimpleStreamsClient:   s.simpleStreamClient,
 		Logger:                s.logger,
@@ -126,6 +132,7 @@ func (s *workerSuite) getConfig(c *tc.C) Config {
 			database.ClusterDescriber,
 			corehttp.HTTPClient,
 			string,
+			envelope.KeyStore,
 			clock.Clock,
 			logger.LoggerContextGetter,
 		) services.DomainServicesGetter {
@@ -134,6 +141,7 @@ func (s *workerSuite) getConfig(c *tc.C) Config {
 		NewControllerDomainServices: func(
 			changestream.WatchableDBGetter,
 			objectstore.NamespacedObjectStoreGetter,
+			envelope.KeyStore,
 			clock.Clock,
 			logger.Logger,
 			logger.LoggerContextGetter,
@@ -152,6 +160,7 @@ func (s *workerSuite) getConfig(c *tc.C) Config {
 			database.ClusterDescriber,
 			corehttp.HTTPClient,
 			string,
+			envelope.KeyStore,
 			clock.Clock,
 			logger.Logger,
 		) services.ModelDomainServices {
@@ -0,0 +1,16 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package externalsecrets provides a worker that periodically checks the
+// content of externally managed user secrets for upstream changes.
+//
+// The content of an external secret is held in a secret backend and is
+// never copied into Juju. On each tick of the refresh interval, the worker
+// asks the SecretService to compare the upstream version of each external
+// secret's content with the version recorded for its latest revision. A
+// new revision is added for any secret whose content has changed, which
+// fires secret-changed hooks for the consumers of the secret.
+//
+// Failing to read the content of a secret is not fatal to the worker; the
+// error is logged and the secret is checked again at the next interval.
+package externalsecrets
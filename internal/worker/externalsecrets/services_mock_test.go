@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/externalsecrets (interfaces: SecretService)
+//
+// Generated by this command:
+//
+//	mockgen -package externalsecrets -destination services_mock_test.go github.com/juju/juju/internal/worker/externalsecrets SecretService
+//
+
+// Package externalsecrets is a generated GoMock package.
+package externalsecrets
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+)
+
+// MockSecretService is a mock of SecretService interface.
+type MockSecretService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSecretServiceMockRecorder is the mock recorder for MockSecretService.
+type MockSecretServiceMockRecorder struct {
+	mock                          *MockSecretService
+	refreshExternalSecretsExpects []*gomock.Call1_1[context.Context, error]
+}
+
+// NewMockSecretService creates a new mock instance.
+func NewMockSecretService(ctrl *gomock.Controller) *MockSecretService {
+	mock := &MockSecretService{ctrl: ctrl}
+	mock.recorder = &MockSecretServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretService) EXPECT() *MockSecretServiceMockRecorder {
+	return m.recorder
+}
+
+// RefreshExternalSecrets mocks base method.
+func (m *MockSecretService) RefreshExternalSecrets(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_1(&m.recorder.refreshExternalSecretsExpects, m.ctrl, m, "RefreshExternalSecrets", ctx)
+}
+
+// RefreshExternalSecrets indicates an expected call of RefreshExternalSecrets.
+func (mr *MockSecretServiceMockRecorder) RefreshExternalSecrets(ctx any) *MockSecretServiceRefreshExternalSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_1[context.Context, error](mr.mock.ctrl.T, mr.mock, "RefreshExternalSecrets", gomock.EnsureMatcher(ctx))
+	mr.refreshExternalSecretsExpects = append(mr.refreshExternalSecretsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretServiceRefreshExternalSecretsCall is the typed call wrapper for RefreshExternalSecrets.
+type MockSecretServiceRefreshExternalSecretsCall = gomock.Call1_1[context.Context, error]
@@ -0,0 +1,134 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package externalsecrets
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+)
+
+// SecretService provides access to externally managed secrets.
+type SecretService interface {
+	// RefreshExternalSecrets adds a new revision to each externally
+	// managed secret whose content has changed upstream.
+	RefreshExternalSecrets(ctx context.Context) error
+}
+
+// Config is the configuration for the external secrets worker.
+type Config struct {
+	Clock         clock.Clock
+	SecretService SecretService
+	Logger        logger.Logger
+
+	// RefreshInterval is the interval at which upstream content is checked.
+	RefreshInterval time.Duration
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.Clock == nil {
+		return errors.Errorf("nil clock.Clock").Add(coreerrors.NotValid)
+	}
+	if config.SecretService == nil {
+		return errors.Errorf("nil SecretService").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	if config.RefreshInterval <= 0 {
+		return errors.Errorf("refresh interval must be positive").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// refreshWorker is a worker that refreshes externally managed secrets.
+type refreshWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	lastRefresh time.Time
+	lastError   string
+}
+
+// NewWorker returns a new external secrets worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	w := &refreshWorker{
+		config: config,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "external-secrets",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Capture(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *refreshWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *refreshWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Report shows up in the dependency engine report.
+func (w *refreshWorker) Report(ctx context.Context) map[string]any {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return map[string]any{
+		"last-refresh": w.lastRefresh,
+		"last-error":   w.lastError,
+	}
+}
+
+func (w *refreshWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	timer := w.config.Clock.NewTimer(w.config.RefreshInterval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-timer.Chan():
+			w.refresh(ctx)
+			timer.Reset(w.config.RefreshInterval)
+		}
+	}
+}
+
+// refresh checks external secrets for upstream changes. Errors are logged
+// rather than returned, as the content of a secret may be temporarily
+// unavailable, and restarting the worker would not help.
+func (w *refreshWorker) refresh(ctx context.Context) {
+	err := w.config.SecretService.RefreshExternalSecrets(ctx)
+	if err != nil {
+		w.config.Logger.Warningf(ctx, "%v", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.lastRefresh = w.config.Clock.Now()
+	w.lastError = ""
+	if err != nil {
+		w.lastError = err.Error()
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package externalsecrets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+
+	coretesting "github.com/juju/juju/core/testing"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type workerSuite struct {
+	clock         *testclock.Clock
+	secretService *MockSecretService
+}
+
+func TestWorkerSuite(t *testing.T) { tc.Run(t, &workerSuite{}) }
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.clock = testclock.NewClock(time.Now())
+	s.secretService = NewMockSecretService(ctrl)
+	return ctrl
+}
+
+func (s *workerSuite) newConfig(c *tc.C) Config {
+	return Config{
+		Clock:           s.clock,
+		SecretService:   s.secretService,
+		Logger:          loggertesting.WrapCheckLog(c),
+		RefreshInterval: time.Minute,
+	}
+}
+
+func (s *workerSuite) TestValidateConfig(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := s.newConfig(c)
+	c.Check(cfg.Validate(), tc.ErrorIsNil)
+
+	bad := cfg
+	bad.Clock = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil clock.Clock.*")
+
+	bad = cfg
+	bad.SecretService = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil SecretService.*")
+
+	bad = cfg
+	bad.Logger = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil Logger.*")
+
+	bad = cfg
+	bad.RefreshInterval = 0
+	c.Check(bad.Validate(), tc.ErrorMatches, "refresh interval must be positive.*")
+}
+
+func (s *workerSuite) TestRefreshesEachInterval(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	refreshed := make(chan struct{})
+	s.secretService.EXPECT().RefreshExternalSecrets(gomock.Any()).DoAndReturn(func(context.Context) error {
+		refreshed <- struct{}{}
+		return nil
+	}).Times(2)
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	for range 2 {
+		err = s.clock.WaitAdvance(time.Minute, coretesting.ShortWait, 1)
+		c.Assert(err, tc.ErrorIsNil)
+		s.waitRefresh(c, refreshed)
+	}
+}
+
+func (s *workerSuite) TestRefreshErrorNotFatal(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	refreshed := make(chan struct{})
+	gomock.InOrder(
+		s.secretService.EXPECT().RefreshExternalSecrets(gomock.Any()).DoAndReturn(func(context.Context) error {
+			refreshed <- struct{}{}
+			return errors.New("boom")
+		}),
+		s.secretService.EXPECT().RefreshExternalSecrets(gomock.Any()).DoAndReturn(func(context.Context) error {
+			refreshed <- struct{}{}
+			return nil
+		}),
+	)
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	for range 2 {
+		err = s.clock.WaitAdvance(time.Minute, coretesting.ShortWait, 1)
+		c.Assert(err, tc.ErrorIsNil)
+		s.waitRefresh(c, refreshed)
+	}
+	workertest.CheckAlive(c, w)
+}
+
+func (s *workerSuite) waitRefresh(c *tc.C, refreshed <-chan struct{}) {
+	select {
+	case <-refreshed:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for refresh")
+	}
+}
@@ -99,6 +99,7 @@ type MockMachineServiceMockRecorder struct {
 	getMachineLifeExpects                         []*gomock.Call2_2[context.Context, machine.Name, life.Value, error]
 	getMachineLifeAndIsManuallyProvisionedExpects []*gomock.Call2_3[context.Context, machine.Name, life.Value, bool, error]
 	getPollingInfosExpects                        []*gomock.Call2_2[context.Context, []machine.Name, machine0.PollingInfos, error]
+	recoverFailedMachineExpects                   []*gomock.Call2_1[context.Context, machine.Name, error]
 	watchModelMachineLifeAndStartTimesExpects     []*gomock.Call1_2[context.Context, watcher.StringsWatcher, error]
 }
 
@@ -168,6 +169,24 @@ func (mr *MockMachineServiceMockRecorder) GetPollingInfos(ctx, machineNames any)
 // MockMachineServiceGetPollingInfosCall is the typed call wrapper for GetPollingInfos.
 type MockMachineServiceGetPollingInfosCall = gomock.Call2_2[context.Context, []machine.Name, machine0.PollingInfos, error]
 
+// RecoverFailedMachine mocks base method.
+func (m *MockMachineService) RecoverFailedMachine(arg0 context.Context, arg1 machine.Name) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.recoverFailedMachineExpects, m.ctrl, m, "RecoverFailedMachine", arg0, arg1)
+}
+
+// RecoverFailedMachine indicates an expected call of RecoverFailedMachine.
+func (mr *MockMachineServiceMockRecorder) RecoverFailedMachine(arg0, arg1 any) *MockMachineServiceRecoverFailedMachineCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, machine.Name, error](mr.mock.ctrl.T, mr.mock, "RecoverFailedMachine", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1))
+	mr.recoverFailedMachineExpects = append(mr.recoverFailedMachineExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockMachineServiceRecoverFailedMachineCall is the typed call wrapper for RecoverFailedMachine.
+type MockMachineServiceRecoverFailedMachineCall = gomock.Call2_1[context.Context, machine.Name, error]
+
 // WatchModelMachineLifeAndStartTimes mocks base method.
 func (m *MockMachineService) WatchModelMachineLifeAndStartTimes(arg0 context.Context) (watcher.StringsWatcher, error) {
 	m.ctrl.T.Helper()
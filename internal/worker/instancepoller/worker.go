@@ -66,6 +66,11 @@ type MachineService interface {
 
 	// GetPollingInfos returns the polling information for the specified machines.
 	GetPollingInfos(ctx context.Context, machineNames []machine.Name) (domainmachine.PollingInfos, error)
+
+	// RecoverFailedMachine makes an attempt to recover a machine whose cloud
+	// instance has stopped or disappeared, as directed by the model's machine
+	// recovery policy.
+	RecoverFailedMachine(context.Context, machine.Name) error
 }
 
 // StatusService defines the interface for interacting with the status
@@ -454,10 +459,12 @@ func (u *updaterWorker) pollGroupMembers(ctx context.Context, groupType pollGrou
 			// If we're in the short poll group, bump all the poll intervals for
 			// entries with an instance ID. Any without an instance ID will
 			// already have had their intervals bumped above.
-			if groupType == shortPollGroup {
-				for _, id := range allInstances {
-					entryByInstanceID[id].bumpShortPollInterval(u.config.Clock)
+			for _, id := range allInstances {
+				entry := entryByInstanceID[id]
+				if groupType == shortPollGroup {
+					entry.bumpShortPollInterval(u.config.Clock)
 				}
+				u.recoverFailedMachine(ctx, entry)
 			}
 
 			return nil
@@ -515,6 +522,7 @@ func (u *updaterWorker) processOneInstance(
 		if groupType == shortPollGroup {
 			entry.bumpShortPollInterval(u.config.Clock)
 		}
+		u.recoverFailedMachine(ctx, entry)
 		return nil
 	}
 
@@ -522,6 +530,9 @@ func (u *updaterWorker) processOneInstance(
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if instanceMayHaveFailed(providerStatus) {
+		u.recoverFailedMachine(ctx, entry)
+	}
 
 	machineStatus, err := u.config.StatusService.GetMachineStatus(ctx, entry.machineName)
 	if err != nil {
@@ -532,6 +543,27 @@ func (u *updaterWorker) processOneInstance(
 	return nil
 }
 
+// recoverFailedMachine asks for the machine to be recovered when its instance
+// has stopped or disappeared. Whether anything is done depends on the model's
+// machine recovery policy, so failures are logged rather than stopping the
+// worker.
+func (u *updaterWorker) recoverFailedMachine(ctx context.Context, entry *pollGroupEntry) {
+	if err := u.config.MachineService.RecoverFailedMachine(ctx, entry.machineName); err != nil {
+		u.config.Logger.Warningf(ctx, "cannot recover machine %q (instance ID %q): %v",
+			entry.machineName, entry.instanceID, err)
+	}
+}
+
+// instanceMayHaveFailed reports whether the instance status reported by the
+// provider indicates that the instance is neither running nor starting.
+func instanceMayHaveFailed(instStatus status.Status) bool {
+	switch instStatus {
+	case status.Running, status.Allocating, status.Pending, status.Unknown:
+		return false
+	}
+	return true
+}
+
 // processProviderInfo updates an entry's machine status and set of provider
 // addresses based on the information collected from the provider. It returns
 // the *instance* status and the number of provider addresses currently
@@ -791,6 +791,7 @@ func (s *workerSuite) TestShortPollMachineNotKnownByProviderIntervalBackoff(c *t
 	mocked.environ.EXPECT().NetworkInterfaces(gomock.Any(), []instance.Id{instID}).Return(
 		nil, nil,
 	)
+	mocked.machineService.EXPECT().RecoverFailedMachine(gomock.Any(), machineName).Return(nil)
 
 	// Advance the clock to trigger processing of the short poll group.
 	s.assertWorkerCompletesLoops(c, updWorker, 1, func() {
@@ -827,6 +828,7 @@ func (s *workerSuite) TestLongPollNoMachineInGroupKnownByProvider(c *tc.C) {
 	mocked.environ.EXPECT().Instances(gomock.Any(), []instance.Id{instID}).Return(
 		nil, environs.ErrNoInstances,
 	)
+	mocked.machineService.EXPECT().RecoverFailedMachine(gomock.Any(), machineName).Return(nil)
 
 	// Advance the clock to trigger processing of both the short AND long
 	// poll groups. This should trigger to full loop runs.
@@ -857,6 +859,7 @@ func (s *workerSuite) TestShortPollNoMachineInGroupKnownByProviderIntervalBackof
 	mocked.environ.EXPECT().Instances(gomock.Any(), []instance.Id{instID}).Return(
 		nil, environs.ErrNoInstances,
 	)
+	mocked.machineService.EXPECT().RecoverFailedMachine(gomock.Any(), machineName).Return(nil)
 
 	// Advance the clock to trigger processing of the short poll group.
 	s.assertWorkerCompletesLoops(c, updWorker, 1, func() {
@@ -946,3 +949,56 @@ func (s *workerSuite) assertEnqueueChange(c *tc.C, values []string) {
 		c.Fatal("timed out waiting for worker to pick up change")
 	}
 }
+
+func (s *workerSuite) TestStoppedInstanceRecoversMachine(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, mocked := s.startWorker(c, ctrl)
+	defer workertest.CleanKill(c, w)
+	updWorker := w.(*updaterWorker)
+
+	machineName := machine.Name("0")
+	entry := &pollGroupEntry{
+		machineUUID: machinetesting.GenUUID(c),
+		machineName: machineName,
+		instanceID:  "b4dc0ffee",
+	}
+
+	// The provider reports that the instance has been stopped.
+	mocked.statusService.EXPECT().GetInstanceStatus(gomock.Any(), machineName).Return(status.StatusInfo{Status: status.Running}, nil)
+	instInfo := mocks.NewMockInstance(ctrl)
+	instInfo.EXPECT().Status(gomock.Any()).Return(instance.Status{Status: status.Empty, Message: "stopped"})
+	mocked.statusService.EXPECT().SetInstanceStatus(gomock.Any(), machineName, status.StatusInfo{
+		Status:  status.Empty,
+		Message: "stopped",
+	}).Return(nil)
+	mocked.machineService.EXPECT().GetMachineLife(gomock.Any(), machineName).Return(life.Alive, nil)
+	mocked.machineService.EXPECT().RecoverFailedMachine(gomock.Any(), machineName).Return(nil)
+	mocked.statusService.EXPECT().GetMachineStatus(gomock.Any(), machineName).Return(status.StatusInfo{Status: status.Down}, nil)
+
+	err := updWorker.processOneInstance(c.Context(), entry, instInfo, nil, shortPollGroup)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *workerSuite) TestRecoverFailedMachineErrorIsNotFatal(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, mocked := s.startWorker(c, ctrl)
+	defer workertest.CleanKill(c, w)
+	updWorker := w.(*updaterWorker)
+
+	machineName := machine.Name("0")
+	entry := &pollGroupEntry{
+		machineName: machineName,
+		instanceID:  "b4dc0ffee",
+	}
+
+	// The instance has gone from the provider and recovering the machine
+	// fails; the worker carries on polling.
+	mocked.machineService.EXPECT().RecoverFailedMachine(gomock.Any(), machineName).Return(fmt.Errorf("boom"))
+
+	err := updWorker.processOneInstance(c.Context(), entry, nil, nil, longPollGroup)
+	c.Assert(err, tc.ErrorIsNil)
+}
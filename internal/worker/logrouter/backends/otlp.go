@@ -0,0 +1,533 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backends
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/loggo/v3"
+	"github.com/juju/retry"
+	"github.com/juju/worker/v5/catacomb"
+	collectorlogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
+
+	corelogger "github.com/juju/juju/core/logger"
+	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/loki"
+	"github.com/juju/juju/internal/worker/logsender"
+)
+
+const (
+	// DefaultOTLPBatchSize is the default maximum number of log records
+	// sent in a single OTLP export request.
+	DefaultOTLPBatchSize = 512
+	// DefaultOTLPFlushInterval is the default maximum time a log record is
+	// buffered before being exported.
+	DefaultOTLPFlushInterval = time.Second
+	// DefaultOTLPMaxRetries is the default number of times a failed export
+	// request is retried.
+	DefaultOTLPMaxRetries = 5
+	// DefaultOTLPInitialBackoff is the default delay before the first retry.
+	DefaultOTLPInitialBackoff = 500 * time.Millisecond
+	// DefaultOTLPMaxBackoff is the default upper bound on the retry delay.
+	DefaultOTLPMaxBackoff = 30 * time.Second
+
+	// otlpDrainTimeout bounds the time spent exporting buffered records
+	// when the backend is stopped.
+	otlpDrainTimeout = 5 * time.Second
+
+	// Resource attribute keys identifying the origin of a log record.
+	otlpAttrServiceName    = "service.name"
+	otlpAttrControllerUUID = "juju.controller_uuid"
+	otlpAttrModelUUID      = "juju.model_uuid"
+	otlpAttrEntity         = "juju.entity"
+
+	// Log record attribute keys describing where a record was emitted.
+	// Record labels are emitted as resource attributes.
+	otlpAttrModule   = "juju.module"
+	otlpAttrLocation = "code.location"
+)
+
+// OTLPConfig contains the settings required by the OTLP backend.
+type OTLPConfig struct {
+	BackendBufferSize int
+	// BatchSize is the maximum number of log records sent in a single
+	// export request.
+	BatchSize int
+	// FlushInterval is the maximum time a log record is buffered before
+	// being exported.
+	FlushInterval time.Duration
+	// MaxRetries is the number of times a failed export request is
+	// retried. Only network errors, 429 and 5xx responses are retried.
+	MaxRetries     int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	// Endpoint is the full URL of the OTLP/HTTP logs receiver, typically
+	// ending in /v1/logs.
+	Endpoint       string
+	HTTPClient     loki.HTTPClient
+	Clock          clock.Clock
+	ControllerUUID string
+	ModelUUID      string
+	AgentID        string
+	ServiceName    string
+}
+
+// Validate checks that the OTLP backend config is usable.
+func (c OTLPConfig) Validate() error {
+	if c.BackendBufferSize <= 0 {
+		return errors.NotValidf("non-positive BackendBufferSize")
+	}
+	if c.BatchSize <= 0 {
+		return errors.NotValidf("non-positive BatchSize")
+	}
+	if c.FlushInterval <= 0 {
+		return errors.NotValidf("non-positive FlushInterval")
+	}
+	if c.MaxRetries < 0 {
+		return errors.NotValidf("negative MaxRetries")
+	}
+	if c.Endpoint == "" {
+		return errors.NotValidf("empty Endpoint")
+	}
+	if c.HTTPClient == nil {
+		return errors.NotValidf("nil HTTPClient")
+	}
+	if c.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if c.ServiceName == "" {
+		return errors.NotValidf("empty ServiceName")
+	}
+	return nil
+}
+
+type otlpStats struct {
+	sent         atomic.Uint64
+	dropped      atomic.Uint64
+	exportErrors atomic.Uint64
+}
+
+type otlpBackend struct {
+	catacomb catacomb.Catacomb
+	cfg      OTLPConfig
+	records  logsender.LogRecordCh
+	stats    otlpStats
+}
+
+// NewOTLP returns a backend that exports log records to an OpenTelemetry
+// collector using the OTLP/HTTP protocol with protobuf encoding.
+func NewOTLP(cfg OTLPConfig) (Backend, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+
+	w := &otlpBackend{
+		cfg:     cfg,
+		records: make(logsender.LogRecordCh, cfg.BackendBufferSize),
+	}
+	if err := catacomb.Invoke(catacomb.Plan{
+		Name: "log-router-otlp",
+		Site: &w.catacomb,
+		Work: w.loop,
+	}); err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	return w, nil
+}
+
+// Kill stops the backend and closes the log record channel.
+func (w *otlpBackend) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait waits for the backend to stop.
+func (w *otlpBackend) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// LogRecords returns the channel that the log router will send log records to.
+func (w *otlpBackend) LogRecords() logsender.LogRecordCh {
+	return w.records
+}
+
+// Log implements corelogger.LogSink by converting records to the internal
+// logsender format and submitting them to the backend's record channel.
+func (w *otlpBackend) Log(records []corelogger.LogRecord) error {
+	return sendRecords(w.records, records)
+}
+
+// WatchRefresh implements corelogger.LogSink. Individual backends never
+// change their underlying target; refresh signalling is handled by the log
+// router when switching backends.
+func (w *otlpBackend) WatchRefresh() <-chan struct{} {
+	return corelogger.NoRefresh()
+}
+
+// Report returns a report of the backend's current state.
+func (w *otlpBackend) Report(_ context.Context) map[string]any {
+	return map[string]any{
+		"name":            "otlp-backend",
+		"endpoint":        w.cfg.Endpoint,
+		"service_name":    w.cfg.ServiceName,
+		"bufferedRecords": len(w.records),
+		"sent":            w.stats.sent.Load(),
+		"dropped":         w.stats.dropped.Load(),
+		"exportErrors":    w.stats.exportErrors.Load(),
+	}
+}
+
+func (w *otlpBackend) loop() error {
+	ctx, cancel := context.WithCancel(w.catacomb.Context(context.Background()))
+	defer cancel()
+
+	batch := make([]*logsender.LogRecord, 0, w.cfg.BatchSize)
+	timer := w.cfg.Clock.NewTimer(w.cfg.FlushInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			w.drain(batch)
+			return w.catacomb.ErrDying()
+
+		case rec, ok := <-w.records:
+			if !ok {
+				w.drain(batch)
+				return nil
+			}
+			if rec == nil {
+				continue
+			}
+			batch = append(batch, rec)
+			if len(batch) < w.cfg.BatchSize {
+				continue
+			}
+			w.export(ctx, batch)
+			batch = batch[:0]
+			timer.Reset(w.cfg.FlushInterval)
+
+		case <-timer.Chan():
+			if len(batch) > 0 {
+				w.export(ctx, batch)
+				batch = batch[:0]
+			}
+			timer.Reset(w.cfg.FlushInterval)
+		}
+	}
+}
+
+// drain exports the supplied batch along with any records still buffered in
+// the record channel. The export is best-effort and bounded by
+// otlpDrainTimeout, as the backend is already stopping.
+func (w *otlpBackend) drain(batch []*logsender.LogRecord) {
+buffered:
+	for {
+		select {
+		case rec, ok := <-w.records:
+			if !ok {
+				break buffered
+			}
+			if rec != nil {
+				batch = append(batch, rec)
+			}
+		default:
+			break buffered
+		}
+	}
+	if len(batch) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), otlpDrainTimeout)
+	defer cancel()
+	for i := 0; i < len(batch); i += w.cfg.BatchSize {
+		end := min(i+w.cfg.BatchSize, len(batch))
+		w.export(ctx, batch[i:end])
+	}
+}
+
+// export sends a single batch of records to the OTLP endpoint. Failures are
+// recorded in the backend stats and the records are dropped, so that an
+// unavailable collector never blocks log delivery.
+func (w *otlpBackend) export(ctx context.Context, batch []*logsender.LogRecord) {
+	if err := w.exportBatch(ctx, batch); err != nil {
+		w.stats.exportErrors.Add(1)
+		w.stats.dropped.Add(uint64(len(batch)))
+		return
+	}
+	w.stats.sent.Add(uint64(len(batch)))
+}
+
+func (w *otlpBackend) exportBatch(ctx context.Context, batch []*logsender.LogRecord) error {
+	data, err := proto.Marshal(w.buildRequest(batch))
+	if err != nil {
+		return internalerrors.Errorf("marshaling otlp logs request: %w", err)
+	}
+
+	err = retry.Call(retry.CallArgs{
+		Attempts: w.cfg.MaxRetries + 1,
+		Delay:    w.cfg.InitialBackoff,
+		MaxDelay: w.cfg.MaxBackoff,
+		Func: func() error {
+			return w.doRequest(ctx, data)
+		},
+		IsFatalError: func(err error) bool {
+			return !isRetryableOTLPError(err)
+		},
+		BackoffFunc: retry.ExpBackoff(w.cfg.InitialBackoff, w.cfg.MaxBackoff, 2.0, true),
+		Clock:       w.cfg.Clock,
+		Stop:        ctx.Done(),
+	})
+	if retry.IsAttemptsExceeded(err) {
+		return retry.LastError(err)
+	}
+	if retry.IsRetryStopped(err) {
+		return ctx.Err()
+	}
+	return err
+}
+
+// otlpRetryableError indicates an export request failure that can be
+// retried.
+type otlpRetryableError struct {
+	msg string
+}
+
+func (e *otlpRetryableError) Error() string {
+	return e.msg
+}
+
+func isRetryableOTLPError(err error) bool {
+	_, ok := err.(*otlpRetryableError)
+	return ok
+}
+
+// doRequest sends a single export request to the OTLP endpoint. It returns
+// an otlpRetryableError for transient failures (network errors, 429, 502,
+// 503 and 504), as recommended by the OTLP/HTTP specification.
+func (w *otlpBackend) doRequest(ctx context.Context, data []byte) error {
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, w.cfg.Endpoint, bytes.NewReader(data),
+	)
+	if err != nil {
+		return internalerrors.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+
+	resp, err := w.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return &otlpRetryableError{
+			msg: fmt.Sprintf("sending request: %s", err),
+		}
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	// Read and discard the body to enable connection reuse.
+	if _, err = io.Copy(io.Discard, io.LimitReader(resp.Body, 1024)); err != nil {
+		return &otlpRetryableError{
+			msg: fmt.Sprintf("reading response: %s", err),
+		}
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+		return nil
+	case http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return &otlpRetryableError{
+			msg: fmt.Sprintf("otlp receiver returned status %d", resp.StatusCode),
+		}
+	default:
+		return internalerrors.Errorf("otlp receiver returned status %d", resp.StatusCode)
+	}
+}
+
+// otlpResourceKey identifies the resource a log record belongs to.
+type otlpResourceKey struct {
+	modelUUID string
+	entity    string
+	// labels is the canonical encoding of the record labels that are
+	// emitted as resource attributes.
+	labels string
+}
+
+// buildRequest groups the batch into one ResourceLogs per model, entity and
+// label set, preserving the order in which each resource was first seen.
+func (w *otlpBackend) buildRequest(batch []*logsender.LogRecord) *collectorlogspb.ExportLogsServiceRequest {
+	groups := make(map[otlpResourceKey]*logspb.ScopeLogs)
+	resourceLogs := make([]*logspb.ResourceLogs, 0)
+
+	for _, rec := range batch {
+		labels := resourceLabels(rec.Labels)
+		key := otlpResourceKey{
+			modelUUID: w.cfg.ModelUUID,
+			entity:    w.cfg.AgentID,
+			labels:    encodeLabels(labels),
+		}
+		if rec.ModelUUID != "" {
+			key.modelUUID = rec.ModelUUID
+		}
+		if rec.Entity != "" {
+			key.entity = rec.Entity
+		}
+
+		scope, ok := groups[key]
+		if !ok {
+			scope = &logspb.ScopeLogs{
+				Scope: &commonpb.InstrumentationScope{Name: "juju"},
+			}
+			groups[key] = scope
+			resourceLogs = append(resourceLogs, &logspb.ResourceLogs{
+				Resource:  &resourcepb.Resource{Attributes: w.resourceAttributes(key, labels)},
+				ScopeLogs: []*logspb.ScopeLogs{scope},
+			})
+		}
+		scope.LogRecords = append(scope.LogRecords, toOTLPLogRecord(rec))
+	}
+
+	return &collectorlogspb.ExportLogsServiceRequest{
+		ResourceLogs: resourceLogs,
+	}
+}
+
+// resourceLabels returns the sorted record labels that describe the resource
+// emitting the record. Trace context labels belong to the individual record
+// and are excluded.
+func resourceLabels(labels map[string]string) []*commonpb.KeyValue {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		if isTraceContextLabel(k, labels[k]) {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]*commonpb.KeyValue, 0, len(keys))
+	for _, k := range keys {
+		attrs = append(attrs, stringAttribute(k, labels[k]))
+	}
+	return attrs
+}
+
+// encodeLabels returns a string uniquely identifying the sorted labels, for
+// use in a map key.
+func encodeLabels(labels []*commonpb.KeyValue) string {
+	var b strings.Builder
+	for _, kv := range labels {
+		b.WriteString(strconv.Quote(kv.Key))
+		b.WriteByte('=')
+		b.WriteString(strconv.Quote(kv.Value.GetStringValue()))
+		b.WriteByte(',')
+	}
+	return b.String()
+}
+
+// isTraceContextLabel reports whether the label carries a valid trace or
+// span ID, which is exported on the log record itself.
+func isTraceContextLabel(key, value string) bool {
+	switch key {
+	case "trace_id":
+		id, err := hex.DecodeString(value)
+		return err == nil && len(id) == 16
+	case "span_id":
+		id, err := hex.DecodeString(value)
+		return err == nil && len(id) == 8
+	}
+	return false
+}
+
+func (w *otlpBackend) resourceAttributes(key otlpResourceKey, labels []*commonpb.KeyValue) []*commonpb.KeyValue {
+	attrs := []*commonpb.KeyValue{
+		stringAttribute(otlpAttrServiceName, w.cfg.ServiceName),
+	}
+	if w.cfg.ControllerUUID != "" {
+		attrs = append(attrs, stringAttribute(otlpAttrControllerUUID, w.cfg.ControllerUUID))
+	}
+	if key.modelUUID != "" {
+		attrs = append(attrs, stringAttribute(otlpAttrModelUUID, key.modelUUID))
+	}
+	if key.entity != "" {
+		attrs = append(attrs, stringAttribute(otlpAttrEntity, key.entity))
+	}
+	return append(attrs, labels...)
+}
+
+func toOTLPLogRecord(rec *logsender.LogRecord) *logspb.LogRecord {
+	severity, severityText := otlpSeverity(rec.Level)
+	out := &logspb.LogRecord{
+		TimeUnixNano:   uint64(rec.Time.UnixNano()),
+		SeverityNumber: severity,
+		SeverityText:   severityText,
+		Body: &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_StringValue{StringValue: rec.Message},
+		},
+	}
+	if rec.Module != "" {
+		out.Attributes = append(out.Attributes, stringAttribute(otlpAttrModule, rec.Module))
+	}
+	if rec.Location != "" {
+		out.Attributes = append(out.Attributes, stringAttribute(otlpAttrLocation, rec.Location))
+	}
+
+	// Other labels are exported as resource attributes; see resourceLabels.
+	if v := rec.Labels["trace_id"]; isTraceContextLabel("trace_id", v) {
+		out.TraceId, _ = hex.DecodeString(v)
+	}
+	if v := rec.Labels["span_id"]; isTraceContextLabel("span_id", v) {
+		out.SpanId, _ = hex.DecodeString(v)
+	}
+	return out
+}
+
+// otlpSeverity maps a loggo level onto the OTLP severity number range of the
+// same name.
+func otlpSeverity(level loggo.Level) (logspb.SeverityNumber, string) {
+	switch level {
+	case loggo.TRACE:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_TRACE, "TRACE"
+	case loggo.DEBUG:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG, "DEBUG"
+	case loggo.INFO:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_INFO, "INFO"
+	case loggo.WARNING:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_WARN, "WARNING"
+	case loggo.ERROR:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_ERROR, "ERROR"
+	case loggo.CRITICAL:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_FATAL, "CRITICAL"
+	default:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_UNSPECIFIED, ""
+	}
+}
+
+func stringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key: key,
+		Value: &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_StringValue{StringValue: value},
+		},
+	}
+}
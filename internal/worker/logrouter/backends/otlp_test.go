@@ -0,0 +1,313 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backends
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/loggo/v3"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+	collectorlogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/protobuf/proto"
+
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/worker/logsender"
+)
+
+type otlpSuite struct{}
+
+func TestOTLPSuite(t *testing.T) {
+	tc.Run(t, &otlpSuite{})
+}
+
+func (s *otlpSuite) TestExportsBatchGroupedByResource(c *tc.C) {
+	receiver := newOTLPReceiver(c, http.StatusOK)
+	defer receiver.Close()
+
+	cfg := validOTLPConfig(receiver.URL + "/v1/logs")
+	cfg.BatchSize = 3
+	cfg.FlushInterval = time.Hour
+	w, err := NewOTLP(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	now := time.Now()
+	w.LogRecords() <- &logsender.LogRecord{
+		Time:     now,
+		Module:   "juju.worker.uniter",
+		Location: "uniter.go:10",
+		Level:    loggo.INFO,
+		Message:  "first",
+		Labels: map[string]string{
+			"http.method": "GET",
+			"trace_id":    "0123456789abcdef0123456789abcdef",
+			"span_id":     "0123456789abcdef",
+		},
+		Entity: "unit-mysql-0",
+	}
+	w.LogRecords() <- &logsender.LogRecord{
+		Time:    now,
+		Level:   loggo.ERROR,
+		Message: "second",
+		Labels: map[string]string{
+			"http.method": "GET",
+		},
+		Entity: "unit-mysql-0",
+	}
+	w.LogRecords() <- &logsender.LogRecord{
+		Time:      now,
+		Level:     loggo.WARNING,
+		Message:   "third",
+		ModelUUID: "other-model",
+	}
+
+	req := receiver.waitRequest(c)
+	c.Assert(req.ResourceLogs, tc.HasLen, 2)
+
+	first := req.ResourceLogs[0]
+	c.Check(attributeMap(first.Resource.Attributes), tc.DeepEquals, map[string]string{
+		"service.name":         "juju-unit",
+		"juju.controller_uuid": "controller",
+		"juju.model_uuid":      "model",
+		"juju.entity":          "unit-mysql-0",
+		"http.method":          "GET",
+	})
+	c.Assert(first.ScopeLogs, tc.HasLen, 1)
+	records := first.ScopeLogs[0].LogRecords
+	c.Assert(records, tc.HasLen, 2)
+
+	c.Check(records[0].Body.GetStringValue(), tc.Equals, "first")
+	c.Check(records[0].TimeUnixNano, tc.Equals, uint64(now.UnixNano()))
+	c.Check(records[0].SeverityNumber, tc.Equals, logspb.SeverityNumber_SEVERITY_NUMBER_INFO)
+	c.Check(records[0].SeverityText, tc.Equals, "INFO")
+	c.Check(attributeMap(records[0].Attributes), tc.DeepEquals, map[string]string{
+		"juju.module":   "juju.worker.uniter",
+		"code.location": "uniter.go:10",
+	})
+	c.Check(records[0].TraceId, tc.HasLen, 16)
+	c.Check(records[0].SpanId, tc.HasLen, 8)
+
+	c.Check(records[1].Body.GetStringValue(), tc.Equals, "second")
+	c.Check(records[1].SeverityNumber, tc.Equals, logspb.SeverityNumber_SEVERITY_NUMBER_ERROR)
+
+	second := req.ResourceLogs[1]
+	c.Check(attributeMap(second.Resource.Attributes), tc.DeepEquals, map[string]string{
+		"service.name":         "juju-unit",
+		"juju.controller_uuid": "controller",
+		"juju.model_uuid":      "other-model",
+		"juju.entity":          "machine-0",
+	})
+	c.Check(second.ScopeLogs[0].LogRecords[0].Body.GetStringValue(), tc.Equals, "third")
+
+	waitReport(c, w, "sent", uint64(3))
+}
+
+func (s *otlpSuite) TestFlushesOnInterval(c *tc.C) {
+	receiver := newOTLPReceiver(c, http.StatusOK)
+	defer receiver.Close()
+
+	cfg := validOTLPConfig(receiver.URL + "/v1/logs")
+	cfg.FlushInterval = 10 * time.Millisecond
+	w, err := NewOTLP(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	w.LogRecords() <- &logsender.LogRecord{
+		Time:    time.Now(),
+		Level:   loggo.INFO,
+		Message: "flushed",
+	}
+
+	req := receiver.waitRequest(c)
+	c.Assert(req.ResourceLogs, tc.HasLen, 1)
+	c.Check(req.ResourceLogs[0].ScopeLogs[0].LogRecords[0].Body.GetStringValue(), tc.Equals, "flushed")
+}
+
+func (s *otlpSuite) TestDrainsBufferedRecordsOnStop(c *tc.C) {
+	receiver := newOTLPReceiver(c, http.StatusOK)
+	defer receiver.Close()
+
+	cfg := validOTLPConfig(receiver.URL + "/v1/logs")
+	cfg.FlushInterval = time.Hour
+	w, err := NewOTLP(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	w.LogRecords() <- &logsender.LogRecord{
+		Time:    time.Now(),
+		Level:   loggo.INFO,
+		Message: "drained",
+	}
+
+	workertest.CleanKill(c, w)
+
+	req := receiver.waitRequest(c)
+	c.Check(req.ResourceLogs[0].ScopeLogs[0].LogRecords[0].Body.GetStringValue(), tc.Equals, "drained")
+}
+
+func (s *otlpSuite) TestRetriesTransientFailures(c *tc.C) {
+	receiver := newOTLPReceiver(c, http.StatusServiceUnavailable, http.StatusOK)
+	defer receiver.Close()
+
+	cfg := validOTLPConfig(receiver.URL + "/v1/logs")
+	cfg.BatchSize = 1
+	w, err := NewOTLP(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	w.LogRecords() <- &logsender.LogRecord{
+		Time:    time.Now(),
+		Level:   loggo.INFO,
+		Message: "retried",
+	}
+
+	_ = receiver.waitRequest(c)
+	req := receiver.waitRequest(c)
+	c.Check(req.ResourceLogs[0].ScopeLogs[0].LogRecords[0].Body.GetStringValue(), tc.Equals, "retried")
+	c.Check(receiver.requests.Load(), tc.Equals, int32(2))
+}
+
+func (s *otlpSuite) TestDropsBatchOnPermanentFailure(c *tc.C) {
+	receiver := newOTLPReceiver(c, http.StatusBadRequest)
+	defer receiver.Close()
+
+	cfg := validOTLPConfig(receiver.URL + "/v1/logs")
+	cfg.BatchSize = 1
+	w, err := NewOTLP(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	w.LogRecords() <- &logsender.LogRecord{
+		Time:    time.Now(),
+		Level:   loggo.INFO,
+		Message: "rejected",
+	}
+	_ = receiver.waitRequest(c)
+	waitReport(c, w, "dropped", uint64(1))
+
+	workertest.CleanKill(c, w)
+
+	report := w.Report(c.Context())
+	c.Check(report["name"], tc.Equals, "otlp-backend")
+	c.Check(report["exportErrors"], tc.Equals, uint64(1))
+	c.Check(receiver.requests.Load(), tc.Equals, int32(1))
+}
+
+func (s *otlpSuite) TestOTLPConfigValidate(c *tc.C) {
+	cfg := validOTLPConfig("http://otel-collector:4318/v1/logs")
+	c.Assert(cfg.Validate(), tc.ErrorIsNil)
+
+	cfg.BackendBufferSize = 0
+	c.Check(cfg.Validate(), tc.ErrorMatches, "non-positive BackendBufferSize not valid")
+
+	cfg = validOTLPConfig("http://otel-collector:4318/v1/logs")
+	cfg.BatchSize = 0
+	c.Check(cfg.Validate(), tc.ErrorMatches, "non-positive BatchSize not valid")
+
+	cfg = validOTLPConfig("http://otel-collector:4318/v1/logs")
+	cfg.FlushInterval = 0
+	c.Check(cfg.Validate(), tc.ErrorMatches, "non-positive FlushInterval not valid")
+
+	cfg = validOTLPConfig("")
+	c.Check(cfg.Validate(), tc.ErrorMatches, "empty Endpoint not valid")
+
+	cfg = validOTLPConfig("http://otel-collector:4318/v1/logs")
+	cfg.HTTPClient = nil
+	c.Check(cfg.Validate(), tc.ErrorMatches, "nil HTTPClient not valid")
+
+	cfg = validOTLPConfig("http://otel-collector:4318/v1/logs")
+	cfg.ServiceName = ""
+	c.Check(cfg.Validate(), tc.ErrorMatches, "empty ServiceName not valid")
+}
+
+func validOTLPConfig(endpoint string) OTLPConfig {
+	return OTLPConfig{
+		BackendBufferSize: 10,
+		BatchSize:         DefaultOTLPBatchSize,
+		FlushInterval:     DefaultOTLPFlushInterval,
+		MaxRetries:        1,
+		InitialBackoff:    time.Millisecond,
+		MaxBackoff:        time.Millisecond,
+		Endpoint:          endpoint,
+		HTTPClient:        &http.Client{},
+		Clock:             clock.WallClock,
+		ControllerUUID:    "controller",
+		ModelUUID:         "model",
+		AgentID:           "machine-0",
+		ServiceName:       "juju-unit",
+	}
+}
+
+// otlpReceiver is a stub OTLP/HTTP logs receiver that decodes each export
+// request and responds with the next configured status code.
+type otlpReceiver struct {
+	*httptest.Server
+	statuses []int
+	requests atomic.Int32
+	received chan *collectorlogspb.ExportLogsServiceRequest
+}
+
+func newOTLPReceiver(c *tc.C, statuses ...int) *otlpReceiver {
+	r := &otlpReceiver{
+		statuses: statuses,
+		received: make(chan *collectorlogspb.ExportLogsServiceRequest, 10),
+	}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		n := int(r.requests.Add(1))
+		c.Check(req.Method, tc.Equals, http.MethodPost)
+		c.Check(req.URL.Path, tc.Equals, "/v1/logs")
+		c.Check(req.Header.Get("Content-Type"), tc.Equals, "application/x-protobuf")
+
+		data, err := io.ReadAll(req.Body)
+		c.Check(err, tc.ErrorIsNil)
+		var export collectorlogspb.ExportLogsServiceRequest
+		c.Check(proto.Unmarshal(data, &export), tc.ErrorIsNil)
+		r.received <- &export
+
+		status := r.statuses[min(n, len(r.statuses))-1]
+		w.WriteHeader(status)
+	}))
+	return r
+}
+
+func (r *otlpReceiver) waitRequest(c *tc.C) *collectorlogspb.ExportLogsServiceRequest {
+	select {
+	case req := <-r.received:
+		return req
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for otlp export request")
+	}
+	return nil
+}
+
+func waitReport(c *tc.C, w Backend, key string, expected any) {
+	timeout := time.After(coretesting.LongWait)
+	for {
+		if w.Report(c.Context())[key] == expected {
+			return
+		}
+		select {
+		case <-time.After(coretesting.ShortWait):
+		case <-timeout:
+			c.Fatalf("timed out waiting for report %q to be %v", key, expected)
+		}
+	}
+}
+
+func attributeMap(attrs []*commonpb.KeyValue) map[string]string {
+	out := make(map[string]string, len(attrs))
+	for _, attr := range attrs {
+		out[attr.Key] = attr.Value.GetStringValue()
+	}
+	return out
+}
@@ -318,6 +318,12 @@ func NewBackend(
 				},
 			})
 
+		case BackendTypeOTLP:
+			if err := replaceCACert(httpClient, snapshot); err != nil {
+				return nil, internalerrors.Capture(err)
+			}
+			return newOTLPBackend(httpClient, clock, snapshot, UnitServiceName)
+
 		case BackendTypeDrain:
 			return backends.NewDrain(defaultBackendBufferSize)
 
@@ -374,6 +380,12 @@ func NewControllerBackend(
 				},
 			})
 
+		case BackendTypeOTLP:
+			if err := replaceCACert(httpClient, snapshot); err != nil {
+				return nil, internalerrors.Capture(err)
+			}
+			return newOTLPBackend(httpClient, clock, snapshot, ControllerServiceName)
+
 		case BackendTypeDrain:
 			return backends.NewDrain(defaultBackendBufferSize)
 
@@ -382,3 +394,40 @@ func NewControllerBackend(
 		}
 	}
 }
+
+// replaceCACert updates the CA certificate trusted by the supplied HTTP
+// client, if it supports doing so, to match the backend configuration.
+func replaceCACert(httpClient loki.HTTPClient, snapshot ConfigSnapshot) error {
+	updater, ok := httpClient.(corehttp.CACertUpdater)
+	if !ok {
+		return nil
+	}
+	insecureSkipVerify := false
+	if snapshot.InsecureSkipVerify != nil {
+		insecureSkipVerify = *snapshot.InsecureSkipVerify
+	}
+	return updater.ReplaceCACert(snapshot.CACertificate, insecureSkipVerify)
+}
+
+func newOTLPBackend(
+	httpClient loki.HTTPClient,
+	clock clock.Clock,
+	snapshot ConfigSnapshot,
+	serviceName string,
+) (Backend, error) {
+	return backends.NewOTLP(backends.OTLPConfig{
+		BackendBufferSize: defaultBackendBufferSize,
+		BatchSize:         backends.DefaultOTLPBatchSize,
+		FlushInterval:     backends.DefaultOTLPFlushInterval,
+		MaxRetries:        backends.DefaultOTLPMaxRetries,
+		InitialBackoff:    backends.DefaultOTLPInitialBackoff,
+		MaxBackoff:        backends.DefaultOTLPMaxBackoff,
+		Endpoint:          snapshot.Endpoint,
+		HTTPClient:        httpClient,
+		Clock:             clock,
+		ControllerUUID:    snapshot.ControllerUUID,
+		ModelUUID:         snapshot.ModelUUID,
+		AgentID:           snapshot.AgentID,
+		ServiceName:       serviceName,
+	})
+}
@@ -29,6 +29,10 @@ const (
 	defaultConvergeTimeout   = time.Second * 60
 	defaultRestartDelay      = time.Second * 1
 	backendDrainID           = "drain"
+
+	// protocolOTLP is the agent config Loki protocol value selecting the
+	// OTLP/HTTP backend. Any other value selects the Loki backend.
+	protocolOTLP = "otlp"
 )
 
 // BackendType identifies the active log delivery backend.
@@ -39,6 +43,8 @@ const (
 	BackendTypeLogSink BackendType = "logsink"
 	// BackendTypeLoki forwards records to a Loki push endpoint.
 	BackendTypeLoki BackendType = "loki"
+	// BackendTypeOTLP forwards records to an OTLP/HTTP logs receiver.
+	BackendTypeOTLP BackendType = "otlp"
 	// BackendTypeDrain discards records locally.
 	BackendTypeDrain BackendType = "drain-only"
 )
@@ -68,8 +74,8 @@ type pendingRecordsExporter interface {
 type BackendFunc func(BackendType, ConfigSnapshot) (Backend, error)
 
 // LogRouter provides access to the log router's LogSink, which delegates to
-// the active backend (logsink, Loki, or drain) and fires a refresh channel
-// when the backend changes.
+// the active backend (logsink, Loki, OTLP or drain) and fires a refresh
+// channel when the backend changes.
 type LogRouter interface {
 	// LogSink returns a sink that forwards records to the active
 	// backend. The sink's WatchRefresh channel fires whenever the
@@ -102,7 +108,7 @@ type WorkerConfig struct {
 	NewBackend BackendFunc
 
 	// RemoveLegacyLogSinkWriter is called when switching to Loki
-	// backend mode. It should remove the legacy "logsink" writer
+	// or OTLP backend mode. It should remove the legacy "logsink" writer
 	// from the default loggo context. It must be idempotent.
 	RemoveLegacyLogSinkWriter func()
 
@@ -122,6 +128,7 @@ type ConfigSnapshot struct {
 	ModelUUID          string
 	AgentID            string
 	OrgID              string
+	Protocol           string
 }
 
 // ConfigSnapshotFromAgentConfig builds a ConfigSnapshot from the Loki-
@@ -135,6 +142,7 @@ func ConfigSnapshotFromAgentConfig(cfg agent.Config) ConfigSnapshot {
 		ModelUUID:          cfg.Model().Id(),
 		AgentID:            cfg.Tag().String(),
 		OrgID:              cfg.LokiOrgID(),
+		Protocol:           cfg.LokiProtocol(),
 	}
 }
 
@@ -452,6 +460,8 @@ func (w *logRouter) currentSnapshot() (ConfigSnapshot, error) {
 	switch {
 	case w.config.DrainOnly:
 		snapshot.Mode = BackendTypeDrain
+	case snapshot.Endpoint != "" && snapshot.Protocol == protocolOTLP:
+		snapshot.Mode = BackendTypeOTLP
 	case snapshot.Endpoint != "":
 		snapshot.Mode = BackendTypeLoki
 	default:
@@ -663,7 +673,7 @@ func (w *logRouter) replayPendingRecords(ctx context.Context, records logsender.
 
 func (w *logRouter) manageLegacyLogSinkWriter(ctx context.Context, next ConfigSnapshot) {
 	switch next.Mode {
-	case BackendTypeLoki:
+	case BackendTypeLoki, BackendTypeOTLP:
 		w.config.RemoveLegacyLogSinkWriter()
 	case BackendTypeLogSink, BackendTypeDrain:
 		if err := w.config.AddLegacyLogSinkWriter(); err != nil {
@@ -107,6 +107,63 @@ func (s *workerSuite) TestSwitchStopsOldBackendAndStartsNew(c *tc.C) {
 	})
 }
 
+func (s *workerSuite) TestStartsOTLPWhenProtocolIsOTLP(c *tc.C) {
+	fixture := newFixture(c, "https://otel-collector:4318/v1/logs")
+	fixture.agent.setProtocol("otlp")
+	events := make(chan backendEvent, 10)
+
+	w, err := NewWorker(WorkerConfig{
+		LokiConfigProvider:        fixture.agent,
+		LogSource:                 fixture.logs,
+		AgentConfigChanged:        fixture.configChanged,
+		Logger:                    internallogger.GetLogger("juju.worker.logrouter.test"),
+		Clock:                     clock.WallClock,
+		ConvergeTimeout:           defaultConvergeTimeout,
+		RestartDelay:              time.Millisecond * 10,
+		NewBackend:                recordingBackendFunc(events, defaultBackendBufferSize),
+		RemoveLegacyLogSinkWriter: func() {},
+		AddLegacyLogSinkWriter:    func() error { return nil },
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	waitForEvents(c, events, backendEvent{
+		backend: "drain-only",
+		kind:    "start",
+	}, backendEvent{
+		backend: "otlp",
+		kind:    "start",
+	})
+}
+
+func (s *workerSuite) TestStartsLokiForOTLPPathWithoutProtocol(c *tc.C) {
+	fixture := newFixture(c, "https://otel-collector:4318/v1/logs")
+	events := make(chan backendEvent, 10)
+
+	w, err := NewWorker(WorkerConfig{
+		LokiConfigProvider:        fixture.agent,
+		LogSource:                 fixture.logs,
+		AgentConfigChanged:        fixture.configChanged,
+		Logger:                    internallogger.GetLogger("juju.worker.logrouter.test"),
+		Clock:                     clock.WallClock,
+		ConvergeTimeout:           defaultConvergeTimeout,
+		RestartDelay:              time.Millisecond * 10,
+		NewBackend:                recordingBackendFunc(events, defaultBackendBufferSize),
+		RemoveLegacyLogSinkWriter: func() {},
+		AddLegacyLogSinkWriter:    func() error { return nil },
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	waitForEvents(c, events, backendEvent{
+		backend: "drain-only",
+		kind:    "start",
+	}, backendEvent{
+		backend: "loki",
+		kind:    "start",
+	})
+}
+
 func (s *workerSuite) TestSwitchReplaysPendingRecordsToNewBackend(c *tc.C) {
 	fixture := newFixture(c, "")
 	events := make(chan backendEvent, 20)
@@ -517,7 +574,7 @@ func newFixture(c *tc.C, lokiEndpoint string) fixture {
 	})
 	c.Assert(err, tc.ErrorIsNil)
 	emptyCACert := ""
-	cfg.SetLokiConfig(lokiEndpoint, &emptyCACert, nil, "")
+	cfg.SetLokiConfig(lokiEndpoint, &emptyCACert, nil, "", "")
 	return fixture{
 		agent: &testAgent{
 			cfg: cfg,
@@ -557,7 +614,14 @@ func (a *testAgent) CurrentLokiConfig() (ConfigSnapshot, error) {
 func (a *testAgent) setLokiConfig(endpoint, caCert string) {
 	a.mu.Lock()
 	defer a.mu.Unlock()
-	a.cfg.SetLokiConfig(endpoint, &caCert, nil, "")
+	a.cfg.SetLokiConfig(endpoint, &caCert, nil, "", "")
+}
+
+func (a *testAgent) setProtocol(protocol string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	caCert := a.cfg.LokiCACert()
+	a.cfg.SetLokiConfig(a.cfg.LokiEndpoint(), &caCert, a.cfg.LokiInsecureSkipVerify(), a.cfg.LokiOrgID(), protocol)
 }
 
 func (a *testAgent) setConfigError(err error) {
@@ -38,6 +38,10 @@ type ManifoldConfig struct {
 
 	// NewModelLogger creates a new model logger.
 	NewModelLogger NewModelLoggerFunc
+
+	// ModelLogFiles, if set, provides the settings of the per-model log
+	// files written on controllers.
+	ModelLogFiles ModelLogFileConfigProvider
 }
 
 // Validate validates the manifold configuration.
@@ -75,6 +79,7 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 				LogRouter:      StaticLogRouter(logSink),
 				Clock:          clock.WallClock,
 				NewModelLogger: NewModelLogger,
+				ModelLogFiles:  config.ModelLogFiles,
 			})
 			if err != nil {
 				return nil, errors.Trace(err)
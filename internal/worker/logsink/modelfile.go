@@ -0,0 +1,189 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logsink
+
+import (
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/lumberjack/v2"
+	"github.com/juju/names/v6"
+	"github.com/juju/utils/v4"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	"github.com/juju/juju/agent"
+	"github.com/juju/juju/controller"
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/logsink"
+)
+
+const (
+	// modelLogFileBatchSize and modelLogFileFlushInterval match the
+	// batching of the agent's own logsink.log.
+	modelLogFileBatchSize     = 512
+	modelLogFileFlushInterval = 2 * time.Second
+)
+
+// ModelLogFileConfig holds the location and rotation settings of the log
+// file written for a single model.
+type ModelLogFileConfig struct {
+	// LogDir is the agent's log directory. The model's log file is
+	// written to models/<model-uuid>.log within it.
+	LogDir string
+
+	// MaxSizeMB is the size in MB at which the log file is rotated.
+	MaxSizeMB int
+
+	// MaxBackups is the number of rotated log files to keep.
+	MaxBackups int
+}
+
+// ModelLogFileConfigProvider provides the settings of the per-model log
+// files. It is consulted each time a model logger is started, so changes
+// take effect the next time the model's logger is started.
+type ModelLogFileConfigProvider interface {
+	// ModelLogFileConfig returns the log file settings for the model.
+	ModelLogFileConfig(modelUUID model.UUID) (ModelLogFileConfig, error)
+}
+
+// ModelLogFileConfigFromAgentConfig returns the log file settings for the
+// model from the agent config. The controller-wide MODEL_LOGFILE_MAX_SIZE and
+// MODEL_LOGFILE_MAX_BACKUPS values apply unless the model has its own
+// override, falling back to the controller config defaults when unset.
+func ModelLogFileConfigFromAgentConfig(cfg agent.Config, modelUUID model.UUID) (ModelLogFileConfig, error) {
+	result := ModelLogFileConfig{
+		LogDir:     cfg.LogDir(),
+		MaxSizeMB:  controller.DefaultModelLogfileMaxSize,
+		MaxBackups: controller.DefaultModelLogfileMaxBackups,
+	}
+	for _, key := range []string{
+		agent.ModelLogfileMaxSize,
+		agent.ModelLogfileOverrideKey(agent.ModelLogfileMaxSize, modelUUID.String()),
+	} {
+		v := cfg.Value(key)
+		if v == "" {
+			continue
+		}
+		size, err := utils.ParseSize(v)
+		if err != nil {
+			return result, errors.Annotatef(err, "parsing %s", key)
+		}
+		if size < 1 {
+			return result, errors.NotValidf("%s %q less than 1MB", key, v)
+		}
+		result.MaxSizeMB = int(size)
+	}
+	for _, key := range []string{
+		agent.ModelLogfileMaxBackups,
+		agent.ModelLogfileOverrideKey(agent.ModelLogfileMaxBackups, modelUUID.String()),
+	} {
+		v := cfg.Value(key)
+		if v == "" {
+			continue
+		}
+		backups, err := strconv.Atoi(v)
+		if err != nil {
+			return result, errors.Annotatef(err, "parsing %s", key)
+		}
+		if backups < 0 {
+			return result, errors.NotValidf("negative %s %d", key, backups)
+		}
+		result.MaxBackups = backups
+	}
+	return result, nil
+}
+
+// ModelLogFilePath returns the path of the model's log file.
+func ModelLogFilePath(logDir string, modelUUID model.UUID) string {
+	return filepath.Join(logDir, "models", modelUUID.String()+".log")
+}
+
+// modelFileLogger is a model logger that also writes the model's log
+// records to a rotated log file of its own.
+type modelFileLogger struct {
+	LogSinkWriter
+	catacomb catacomb.Catacomb
+}
+
+// newModelFileLogger starts a model logger whose records are written to
+// both the supplied log sink and the model's log file.
+func newModelFileLogger(
+	newModelLogger NewModelLoggerFunc,
+	logSink corelogger.LogSink,
+	modelUUID model.UUID,
+	agentTag names.Tag,
+	cfg ModelLogFileConfig,
+	clock clock.Clock,
+) (worker.Worker, error) {
+	fileSink := logsink.NewLogSink(&lumberjack.Logger{
+		Filename:   ModelLogFilePath(cfg.LogDir, modelUUID),
+		MaxSize:    cfg.MaxSizeMB,
+		MaxBackups: cfg.MaxBackups,
+		Compress:   true,
+	}, modelLogFileBatchSize, modelLogFileFlushInterval, clock)
+
+	modelLogger, err := newModelLogger(teeLogSink{
+		LogSink: logSink,
+		file:    fileSink,
+	}, modelUUID, agentTag)
+	if err != nil {
+		_ = fileSink.Close()
+		return nil, errors.Trace(err)
+	}
+	writer, ok := modelLogger.(LogSinkWriter)
+	if !ok {
+		modelLogger.Kill()
+		_ = fileSink.Close()
+		return nil, errors.Errorf("model logger %T is not a log sink writer", modelLogger)
+	}
+
+	w := &modelFileLogger{
+		LogSinkWriter: writer,
+	}
+	if err := catacomb.Invoke(catacomb.Plan{
+		Name: "model-file-logger",
+		Site: &w.catacomb,
+		Work: func() error {
+			<-w.catacomb.Dying()
+			return w.catacomb.ErrDying()
+		},
+		Init: []worker.Worker{
+			modelLogger,
+			fileSink,
+		},
+	}); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Kill implements worker.Worker.
+func (w *modelFileLogger) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait implements worker.Worker.
+func (w *modelFileLogger) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// teeLogSink writes log records to both the active log sink and the
+// model's log file.
+type teeLogSink struct {
+	corelogger.LogSink
+	file corelogger.LogWriter
+}
+
+// Log writes the given log records to the log sink and the log file.
+func (s teeLogSink) Log(records []corelogger.LogRecord) error {
+	if err := s.LogSink.Log(records); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(s.file.Log(records))
+}
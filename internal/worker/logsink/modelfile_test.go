@@ -0,0 +1,164 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logsink
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+
+	"github.com/juju/juju/agent"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/logger"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/semversion"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type modelFileSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestModelFileSuite(t *testing.T) {
+	tc.Run(t, &modelFileSuite{})
+}
+
+func (s *modelFileSuite) TestModelLogFileConfigFromAgentConfigDefaults(c *tc.C) {
+	cfg := s.agentConfig(c, nil)
+
+	result, err := ModelLogFileConfigFromAgentConfig(cfg, tc.Must0(c, coremodel.NewUUID))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, ModelLogFileConfig{
+		LogDir:     cfg.LogDir(),
+		MaxSizeMB:  controller.DefaultModelLogfileMaxSize,
+		MaxBackups: controller.DefaultModelLogfileMaxBackups,
+	})
+}
+
+func (s *modelFileSuite) TestModelLogFileConfigFromAgentConfigOverride(c *tc.C) {
+	overridden := tc.Must0(c, coremodel.NewUUID)
+	cfg := s.agentConfig(c, map[string]string{
+		agent.ModelLogfileMaxSize:    "20M",
+		agent.ModelLogfileMaxBackups: "4",
+		agent.ModelLogfileOverrideKey(agent.ModelLogfileMaxSize, overridden.String()):    "1G",
+		agent.ModelLogfileOverrideKey(agent.ModelLogfileMaxBackups, overridden.String()): "0",
+	})
+
+	result, err := ModelLogFileConfigFromAgentConfig(cfg, tc.Must0(c, coremodel.NewUUID))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.MaxSizeMB, tc.Equals, 20)
+	c.Check(result.MaxBackups, tc.Equals, 4)
+
+	result, err = ModelLogFileConfigFromAgentConfig(cfg, overridden)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.MaxSizeMB, tc.Equals, 1024)
+	c.Check(result.MaxBackups, tc.Equals, 0)
+}
+
+func (s *modelFileSuite) TestModelLogFileConfigFromAgentConfigInvalid(c *tc.C) {
+	modelUUID := tc.Must0(c, coremodel.NewUUID)
+	cfg := s.agentConfig(c, map[string]string{
+		agent.ModelLogfileOverrideKey(agent.ModelLogfileMaxBackups, modelUUID.String()): "-1",
+	})
+
+	_, err := ModelLogFileConfigFromAgentConfig(cfg, modelUUID)
+	c.Check(err, tc.ErrorMatches, `negative MODEL_LOGFILE_MAX_BACKUPS_.* -1 not valid`)
+}
+
+func (s *modelFileSuite) TestModelLogFilesRotatePerModel(c *tc.C) {
+	defaulted := tc.Must0(c, coremodel.NewUUID)
+	overridden := tc.Must0(c, coremodel.NewUUID)
+	cfg := s.agentConfig(c, map[string]string{
+		agent.ModelLogfileMaxSize: "1M",
+		agent.ModelLogfileOverrideKey(agent.ModelLogfileMaxSize, overridden.String()): "4M",
+	})
+
+	w, err := NewWorker(Config{
+		AgentTag:       names.NewMachineTag("0"),
+		LogRouter:      StaticLogRouter(noopLogSink{}),
+		Clock:          clock.WallClock,
+		NewModelLogger: NewModelLogger,
+		ModelLogFiles:  agentConfigModelLogFiles{cfg: cfg},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	// Write over 1MB of records for each model, which is more than the
+	// controller-wide size but less than the override.
+	message := strings.Repeat("x", 1024)
+	for _, modelUUID := range []coremodel.UUID{defaulted, overridden} {
+		writer, err := w.(*LogSink).GetLogWriter(c.Context(), modelUUID)
+		c.Assert(err, tc.ErrorIsNil)
+		for range 12 {
+			records := make([]logger.LogRecord, 100)
+			for i := range records {
+				records[i] = logger.LogRecord{
+					Time:      time.Now(),
+					ModelUUID: modelUUID.String(),
+					Entity:    "machine-0",
+					Level:     logger.INFO,
+					Message:   message,
+				}
+			}
+			c.Assert(writer.Log(records), tc.ErrorIsNil)
+		}
+	}
+	workertest.CleanKill(c, w)
+
+	dir := filepath.Join(cfg.LogDir(), "models")
+	c.Check(filepath.Join(dir, defaulted.String()+".log"), tc.IsNonEmptyFile)
+	c.Check(filepath.Join(dir, overridden.String()+".log"), tc.IsNonEmptyFile)
+
+	// Wait for the rotated file to be compressed, so that nothing is
+	// written to the directory once the test has finished.
+	var backups []string
+	deadline := time.After(testhelpers.LongWait)
+	for len(backups) == 0 {
+		backups, err = filepath.Glob(filepath.Join(dir, defaulted.String()+"-*.log.gz"))
+		c.Assert(err, tc.ErrorIsNil)
+		select {
+		case <-deadline:
+			c.Fatalf("timed out waiting for the rotated log file")
+		case <-time.After(testhelpers.ShortWait):
+		}
+	}
+	c.Check(backups, tc.Not(tc.HasLen), 0)
+
+	overriddenBackups, err := filepath.Glob(filepath.Join(dir, overridden.String()+"-*"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(overriddenBackups, tc.HasLen, 0)
+}
+
+func (s *modelFileSuite) agentConfig(c *tc.C, values map[string]string) agent.Config {
+	cfg, err := agent.NewAgentConfig(agent.AgentConfigParams{
+		Paths: agent.Paths{
+			DataDir: c.MkDir(),
+			LogDir:  c.MkDir(),
+		},
+		Tag:               names.NewMachineTag("0"),
+		UpgradedToVersion: semversion.MustParse("4.0.0"),
+		Password:          "password",
+		CACert:            "ca cert",
+		APIAddresses:      []string{"127.0.0.1:17070"},
+		Controller:        names.NewControllerTag("01234567-89ab-cdef-0123-456789abcdef"),
+		Model:             names.NewModelTag("abcdef01-2345-6789-abcd-ef0123456789"),
+		Values:            values,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return cfg
+}
+
+type agentConfigModelLogFiles struct {
+	cfg agent.Config
+}
+
+func (p agentConfigModelLogFiles) ModelLogFileConfig(modelUUID coremodel.UUID) (ModelLogFileConfig, error) {
+	return ModelLogFileConfigFromAgentConfig(p.cfg, modelUUID)
+}
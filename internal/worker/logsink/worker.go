@@ -45,6 +45,11 @@ type Config struct {
 	Clock          clock.Clock
 	MachineID      string
 	NewModelLogger NewModelLoggerFunc
+
+	// ModelLogFiles, if set, provides the settings of the per-model log
+	// files each model's records are also written to. It is only set on
+	// controllers.
+	ModelLogFiles ModelLogFileConfigProvider
 }
 
 // request is used to pass requests for LogSink
@@ -235,7 +240,17 @@ func (w *LogSink) workerFromCache(modelUUID model.UUID) (LogSinkWriter, error) {
 
 func (w *LogSink) initLogger(ctx context.Context, modelUUID model.UUID) error {
 	err := w.runner.StartWorker(ctx, modelUUID.String(), func(ctx context.Context) (worker.Worker, error) {
-		return w.cfg.NewModelLogger(w.cfg.LogRouter.LogSink(), modelUUID, w.cfg.AgentTag)
+		if w.cfg.ModelLogFiles == nil {
+			return w.cfg.NewModelLogger(w.cfg.LogRouter.LogSink(), modelUUID, w.cfg.AgentTag)
+		}
+		fileConfig, err := w.cfg.ModelLogFiles.ModelLogFileConfig(modelUUID)
+		if err != nil {
+			return nil, errors.Annotatef(err, "getting log file config for model %q", modelUUID)
+		}
+		return newModelFileLogger(
+			w.cfg.NewModelLogger, w.cfg.LogRouter.LogSink(),
+			modelUUID, w.cfg.AgentTag, fileConfig, w.cfg.Clock,
+		)
 	})
 	if errors.Is(err, errors.AlreadyExists) {
 		return nil
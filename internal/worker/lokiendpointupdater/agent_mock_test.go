@@ -112,6 +112,7 @@ type MockConfigMockRecorder struct {
 	lokiEndpointExpects                       []*gomock.Call0_1[string]
 	lokiInsecureSkipVerifyExpects             []*gomock.Call0_1[*bool]
 	lokiOrgIDExpects                          []*gomock.Call0_1[string]
+	lokiProtocolExpects                       []*gomock.Call0_1[string]
 	metricsSpoolDirExpects                    []*gomock.Call0_1[string]
 	modelExpects                              []*gomock.Call0_1[names.ModelTag]
 	nonceExpects                              []*gomock.Call0_1[string]
@@ -470,6 +471,24 @@ func (mr *MockConfigMockRecorder) LokiOrgID() *MockConfigLokiOrgIDCall {
 // MockConfigLokiOrgIDCall is the typed call wrapper for LokiOrgID.
 type MockConfigLokiOrgIDCall = gomock.Call0_1[string]
 
+// LokiProtocol mocks base method.
+func (m *MockConfig) LokiProtocol() string {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.lokiProtocolExpects, m.ctrl, m, "LokiProtocol")
+}
+
+// LokiProtocol indicates an expected call of LokiProtocol.
+func (mr *MockConfigMockRecorder) LokiProtocol() *MockConfigLokiProtocolCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[string](mr.mock.ctrl.T, mr.mock, "LokiProtocol")
+	mr.lokiProtocolExpects = append(mr.lokiProtocolExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockConfigLokiProtocolCall is the typed call wrapper for LokiProtocol.
+type MockConfigLokiProtocolCall = gomock.Call0_1[string]
+
 // MetricsSpoolDir mocks base method.
 func (m *MockConfig) MetricsSpoolDir() string {
 	m.ctrl.T.Helper()
@@ -109,7 +109,8 @@ func (w *lokiEndpointUpdater) update(ctx context.Context) error {
 	if currentConfig.LokiEndpoint() == lokiConfig.Endpoint &&
 		currentConfig.LokiCACert() == lokiConfig.CACert &&
 		configInsecureEquals(currentConfig.LokiInsecureSkipVerify(), lokiConfig.InsecureSkipVerify) &&
-		currentConfig.LokiOrgID() == lokiConfig.OrgID {
+		currentConfig.LokiOrgID() == lokiConfig.OrgID &&
+		currentConfig.LokiProtocol() == lokiConfig.Protocol {
 		return nil
 	}
 
@@ -118,7 +119,7 @@ func (w *lokiEndpointUpdater) update(ctx context.Context) error {
 		caCert = &lokiConfig.CACert
 	}
 	err = w.config.Agent.ChangeConfig(func(setter agent.ConfigSetter) error {
-		setter.SetLokiConfig(lokiConfig.Endpoint, caCert, lokiConfig.InsecureSkipVerify, lokiConfig.OrgID)
+		setter.SetLokiConfig(lokiConfig.Endpoint, caCert, lokiConfig.InsecureSkipVerify, lokiConfig.OrgID, lokiConfig.Protocol)
 		return nil
 	})
 	if err != nil {
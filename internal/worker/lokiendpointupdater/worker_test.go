@@ -88,6 +88,7 @@ func (s *workerSuite) expectCurrentConfigReads(endpoint, caCert string, insecure
 	s.agentConfig.EXPECT().LokiCACert().Return(caCert).AnyTimes()
 	s.agentConfig.EXPECT().LokiInsecureSkipVerify().Return(insecure).AnyTimes()
 	s.agentConfig.EXPECT().LokiOrgID().Return("").AnyTimes()
+	s.agentConfig.EXPECT().LokiProtocol().Return("").AnyTimes()
 }
 
 // expectChangeConfig sets up ChangeConfig to invoke the mutator with a real
@@ -469,7 +469,7 @@ func (w *Worker) prechecks(ctx context.Context, status coremigration.MigrationSt
 	if err := checkTargetSupportsEnvelope(targetClient); err != nil {
 		return errors.Trace(err)
 	}
-	err = targetClient.PrechecksV2(ctx, model.envelope)
+	err = targetClient.PrechecksV2(ctx, model.Envelope)
 	return errors.Annotate(err, "target prechecks failed")
 }
 
@@ -533,7 +533,7 @@ func (w *Worker) transferModel(ctx context.Context, status coremigration.Migrati
 		return coremigration.UNKNOWN, errors.Trace(err)
 	}
 
-	err = targetClient.ImportV2(ctx, model.envelope)
+	err = targetClient.ImportV2(ctx, model.Envelope)
 	switch {
 	case params.IsCodeAlreadyExists(err):
 		if importErrIsActivating(err) {
@@ -560,16 +560,16 @@ func (w *Worker) transferModel(ctx context.Context, status coremigration.Migrati
 	w.setInfoStatus(ctx, "uploading model binaries into target controller")
 	wrapper := &uploadWrapper{targetClient, status.ModelUUID}
 	err = w.config.UploadBinaries(ctx, migration.UploadBinariesConfig{
-		Charms:        model.charms,
+		Charms:        model.Charms,
 		CharmService:  w.config.CharmService,
 		CharmUploader: wrapper,
 
-		Tools:            model.tools,
+		Tools:            model.Tools,
 		AgentBinaryStore: w.config.AgentBinaryStore,
 		ToolsUploader:    wrapper,
 
-		Resources:          model.resources,
-		ResourceDownloader: &resourceDownloaderShim{svc: w.config.ResourceService},
+		Resources:          model.Resources,
+		ResourceDownloader: migration.NewResourceDownloader(w.config.ResourceService),
 		ResourceUploader:   wrapper,
 	}, w.logger)
 	if err != nil {
@@ -1064,25 +1064,18 @@ func (w *Worker) openAPIConnForModel(ctx context.Context, targetInfo coremigrati
 	return w.config.APIOpen(ctx, apiInfo, migration.ControllerDialOpts(loginProvider))
 }
 
-func modelHasMigrated(phase coremigration.Phase) bool {
-	return phase == coremigration.DONE || phase == coremigration.REAPFAILED
-}
-
-// resourceDownloaderShim implements migration.ResourceDownloader by
-// resolving the resource UUID from the application/name pair and opening
-// the resource content through the domain service directly.
-type resourceDownloaderShim struct {
-	svc ResourceService
+// assembleEnvelope builds a fresh params.SerializedModelV2 envelope for this
+// model from the local domain services.
+func (w *Worker) assembleEnvelope(ctx context.Context, migrationUUID string) (migration.AssembledModel, error) {
+	model, err := migration.AssembleEnvelope(ctx, migration.EnvelopeServices{
+		ExportService:     w.config.ExportService,
+		CharmService:      w.config.CharmService,
+		ModelAgentService: w.config.ModelAgentService,
+		ResourceService:   w.config.ResourceService,
+	}, migrationUUID)
+	return model, errors.Trace(err)
 }
 
-func (a *resourceDownloaderShim) OpenResource(ctx context.Context, appName, resName string) (io.ReadCloser, error) {
-	uuid, err := a.svc.GetResourceUUIDByApplicationAndResourceName(ctx, appName, resName)
-	if err != nil {
-		return nil, errors.Trace(err)
-	}
-	_, reader, err := a.svc.OpenResource(ctx, uuid)
-	if err != nil {
-		return nil, errors.Trace(err)
-	}
-	return reader, nil
+func modelHasMigrated(phase coremigration.Phase) bool {
+	return phase == coremigration.DONE || phase == coremigration.REAPFAILED
 }
@@ -22,7 +22,6 @@ import (
 	"github.com/juju/worker/v5"
 	"github.com/juju/worker/v5/workertest"
 	"gopkg.in/macaroon.v2"
-	goyaml "gopkg.in/yaml.v2"
 
 	"github.com/juju/juju/api"
 	"github.com/juju/juju/api/base"
@@ -250,22 +249,46 @@ func (s *Suite) SetUpTest(c *tc.C) {
 	}
 }
 
-// expectedEnvelope builds the SerializedModelV2 envelope the worker is
-// expected to assemble from the suite's stub services.
-func (s *Suite) expectedEnvelope(c *tc.C) params.SerializedModelV2 {
-	payload, err := goyaml.Marshal(fakeExportPayload)
-	c.Assert(err, tc.ErrorIsNil)
-	envelope := migrationmaster.EnvelopeFromControllerModelInfo(fakeControllerModelInfo, "model-uuid:2")
-	envelope.PayloadVersion = fakeExportVersion
-	envelope.Payload = payload
-	envelope.Charms = fakeCharmURLs
-	_, envelope.Tools = migrationmaster.ToolsForEnvelope(fakeMachineTools, nil)
-	envelope.Resources = migrationmaster.ResourcesForEnvelope(s.resourceService.resources)
+// expectedEnvelope returns the SerializedModelV2 envelope the worker is
+// expected to assemble from the suite's fixtures.
+func (s *Suite) expectedEnvelope() params.SerializedModelV2 {
+	envelope := params.SerializedModelV2{
+		PayloadVersion: fakeExportVersion,
+		Payload:        []byte("model: data\n"),
+		ModelInfo: params.SerializedModelInfo{
+			UUID:                modelUUID,
+			Name:                modelName,
+			Qualifier:           modelQualifier.String(),
+			Type:                "iaas",
+			Cloud:               "aws",
+			Life:                "alive",
+			SourceMigrationUUID: "model-uuid:2",
+		},
+		Charms: fakeCharmURLs,
+		Tools: []params.SerializedModelTools{{
+			Version: "2.1.0-ubuntu-amd64",
+			URI:     "/tools/2.1.0-ubuntu-amd64",
+			SHA256:  fakeToolsSHA256,
+		}},
+	}
+	for _, res := range s.resourceService.resources {
+		envelope.Resources = append(envelope.Resources, params.SerializedModelResource{
+			Application:    res.ApplicationName,
+			Name:           res.Name,
+			Revision:       res.Revision,
+			Type:           "file",
+			Origin:         "upload",
+			FingerprintHex: res.Fingerprint.Hex(),
+			Size:           res.Size,
+			Timestamp:      res.Timestamp,
+			Username:       res.RetrievedBy,
+		})
+	}
 	return envelope
 }
 
 // prechecksCalls are the stub calls recorded by one worker prechecks pass.
-func (s *Suite) prechecksCalls(c *tc.C) []testhelpers.StubCall {
+func (s *Suite) prechecksCalls() []testhelpers.StubCall {
 	return joinCalls(
 		[]testhelpers.StubCall{
 			{FuncName: "facade.Prechecks", Args: []any{}},
@@ -273,17 +296,17 @@ func (s *Suite) prechecksCalls(c *tc.C) []testhelpers.StubCall {
 		assembleCalls,
 		[]testhelpers.StubCall{
 			apiOpenControllerCall,
-			{FuncName: "MigrationTarget.Prechecks", Args: []any{s.expectedEnvelope(c)}},
+			{FuncName: "MigrationTarget.Prechecks", Args: []any{s.expectedEnvelope()}},
 			apiCloseCall,
 		},
 	)
 }
 
 // importCall is the v8 import of the authoritative envelope.
-func (s *Suite) importCall(c *tc.C) testhelpers.StubCall {
+func (s *Suite) importCall() testhelpers.StubCall {
 	return testhelpers.StubCall{
 		FuncName: "MigrationTarget.Import",
-		Args:     []any{s.expectedEnvelope(c)},
+		Args:     []any{s.expectedEnvelope()},
 	}
 }
 
@@ -335,12 +358,12 @@ func (s *Suite) TestSuccessfulMigration(c *tc.C) {
 		},
 
 		// QUIESCE
-		s.prechecksCalls(c),
+		s.prechecksCalls(),
 		[]testhelpers.StubCall{
 			{FuncName: "modelMigrationService.WatchMinionReports", Args: nil},
 			{FuncName: "modelMigrationService.MinionReports", Args: nil},
 		},
-		s.prechecksCalls(c),
+		s.prechecksCalls(),
 		[]testhelpers.StubCall{
 			{FuncName: "modelMigrationService.SetMigrationPhase", Args: []any{coremigration.IMPORT}},
 		},
@@ -349,7 +372,7 @@ func (s *Suite) TestSuccessfulMigration(c *tc.C) {
 		assembleCalls,
 		[]testhelpers.StubCall{
 			apiOpenControllerCall,
-			s.importCall(c),
+			s.importCall(),
 			{FuncName: "UploadBinaries", Args: []any{
 				fakeCharmURLs,
 				s.charmService,
@@ -556,7 +579,7 @@ func (s *Suite) TestQUIESCEFailedAgent(c *tc.C) {
 		[]testhelpers.StubCall{
 			{FuncName: "controllerConfigService.ControllerConfig", Args: nil},
 		},
-		s.prechecksCalls(c),
+		s.prechecksCalls(),
 		[]testhelpers.StubCall{
 			{FuncName: "modelMigrationService.WatchMinionReports", Args: nil},
 			{FuncName: "modelMigrationService.MinionReports", Args: nil},
@@ -629,7 +652,7 @@ func (s *Suite) TestQUIESCETargetChecksFail(c *tc.C) {
 		[]testhelpers.StubCall{
 			{FuncName: "controllerConfigService.ControllerConfig", Args: nil},
 		},
-		s.prechecksCalls(c),
+		s.prechecksCalls(),
 		abortCalls,
 	))
 }
@@ -682,7 +705,7 @@ func (s *Suite) TestImportFailure(c *tc.C) {
 		assembleCalls,
 		[]testhelpers.StubCall{
 			apiOpenControllerCall,
-			s.importCall(c),
+			s.importCall(),
 			apiCloseCall,
 		},
 		abortCalls,
@@ -710,7 +733,7 @@ func (s *Suite) TestImportFailureAlreadyExistsActivating(c *tc.C) {
 		assembleCalls,
 		[]testhelpers.StubCall{
 			apiOpenControllerCall,
-			s.importCall(c),
+			s.importCall(),
 			apiCloseCall,
 			{FuncName: "modelMigrationService.SetMigrationPhase", Args: []any{coremigration.VALIDATION}},
 			{FuncName: "modelMigrationService.WatchMinionReports", Args: nil},
@@ -736,7 +759,7 @@ func (s *Suite) TestImportFailureAlreadyExistsImporting(c *tc.C) {
 		assembleCalls,
 		[]testhelpers.StubCall{
 			apiOpenControllerCall,
-			s.importCall(c),
+			s.importCall(),
 			apiCloseCall,
 		},
 		abortCalls,
@@ -890,7 +890,7 @@ type stubAgentConfig struct {
 	lokiOrgID    string
 }
 
-func (mc *stubAgentConfig) SetLokiConfig(endpoint string, caCert *string, insecureSkipVerify *bool, orgID string) {
+func (mc *stubAgentConfig) SetLokiConfig(endpoint string, caCert *string, insecureSkipVerify *bool, orgID, protocol string) {
 	mc.mu.Lock()
 	defer mc.mu.Unlock()
 	mc.lokiEndpoint = endpoint
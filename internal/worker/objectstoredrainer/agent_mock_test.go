@@ -113,6 +113,7 @@ type MockConfigMockRecorder struct {
 	lokiEndpointExpects                       []*gomock.Call0_1[string]
 	lokiInsecureSkipVerifyExpects             []*gomock.Call0_1[*bool]
 	lokiOrgIDExpects                          []*gomock.Call0_1[string]
+	lokiProtocolExpects                       []*gomock.Call0_1[string]
 	metricsSpoolDirExpects                    []*gomock.Call0_1[string]
 	modelExpects                              []*gomock.Call0_1[names.ModelTag]
 	nonceExpects                              []*gomock.Call0_1[string]
@@ -471,6 +472,24 @@ func (mr *MockConfigMockRecorder) LokiOrgID() *MockConfigLokiOrgIDCall {
 // MockConfigLokiOrgIDCall is the typed call wrapper for LokiOrgID.
 type MockConfigLokiOrgIDCall = gomock.Call0_1[string]
 
+// LokiProtocol mocks base method.
+func (m *MockConfig) LokiProtocol() string {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.lokiProtocolExpects, m.ctrl, m, "LokiProtocol")
+}
+
+// LokiProtocol indicates an expected call of LokiProtocol.
+func (mr *MockConfigMockRecorder) LokiProtocol() *MockConfigLokiProtocolCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[string](mr.mock.ctrl.T, mr.mock, "LokiProtocol")
+	mr.lokiProtocolExpects = append(mr.lokiProtocolExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockConfigLokiProtocolCall is the typed call wrapper for LokiProtocol.
+type MockConfigLokiProtocolCall = gomock.Call0_1[string]
+
 // MetricsSpoolDir mocks base method.
 func (m *MockConfig) MetricsSpoolDir() string {
 	m.ctrl.T.Helper()
@@ -860,6 +879,7 @@ type MockConfigSetterMockRecorder struct {
 	lokiEndpointExpects                          []*gomock.Call0_1[string]
 	lokiInsecureSkipVerifyExpects                []*gomock.Call0_1[*bool]
 	lokiOrgIDExpects                             []*gomock.Call0_1[string]
+	lokiProtocolExpects                          []*gomock.Call0_1[string]
 	metricsSpoolDirExpects                       []*gomock.Call0_1[string]
 	modelExpects                                 []*gomock.Call0_1[names.ModelTag]
 	nonceExpects                                 []*gomock.Call0_1[string]
@@ -879,7 +899,7 @@ type MockConfigSetterMockRecorder struct {
 	setControllerAgentInfoExpects                []*gomock.Call1_0[controller.ControllerAgentInfo]
 	setDqliteBusyTimeoutExpects                  []*gomock.Call1_0[time.Duration]
 	setLoggingConfigExpects                      []*gomock.Call1_0[string]
-	setLokiConfigExpects                         []*gomock.Call4_0[string, *string, *bool, string]
+	setLokiConfigExpects                         []*gomock.Call5_0[string, *string, *bool, string, string]
 	setOldPasswordExpects                        []*gomock.Call1_0[string]
 	setOpenTelemetryCACertificateExpects         []*gomock.Call1_0[string]
 	setOpenTelemetryEnabledExpects               []*gomock.Call1_0[bool]
@@ -1256,6 +1276,24 @@ func (mr *MockConfigSetterMockRecorder) LokiOrgID() *MockConfigSetterLokiOrgIDCa
 // MockConfigSetterLokiOrgIDCall is the typed call wrapper for LokiOrgID.
 type MockConfigSetterLokiOrgIDCall = gomock.Call0_1[string]
 
+// LokiProtocol mocks base method.
+func (m *MockConfigSetter) LokiProtocol() string {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.lokiProtocolExpects, m.ctrl, m, "LokiProtocol")
+}
+
+// LokiProtocol indicates an expected call of LokiProtocol.
+func (mr *MockConfigSetterMockRecorder) LokiProtocol() *MockConfigSetterLokiProtocolCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[string](mr.mock.ctrl.T, mr.mock, "LokiProtocol")
+	mr.lokiProtocolExpects = append(mr.lokiProtocolExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockConfigSetterLokiProtocolCall is the typed call wrapper for LokiProtocol.
+type MockConfigSetterLokiProtocolCall = gomock.Call0_1[string]
+
 // MetricsSpoolDir mocks base method.
 func (m *MockConfigSetter) MetricsSpoolDir() string {
 	m.ctrl.T.Helper()
@@ -1599,22 +1637,22 @@ func (mr *MockConfigSetterMockRecorder) SetLoggingConfig(arg0 any) *MockConfigSe
 type MockConfigSetterSetLoggingConfigCall = gomock.Call1_0[string]
 
 // SetLokiConfig mocks base method.
-func (m *MockConfigSetter) SetLokiConfig(endpoint string, caCert *string, insecureSkipVerify *bool, orgID string) {
+func (m *MockConfigSetter) SetLokiConfig(endpoint string, caCert *string, insecureSkipVerify *bool, orgID, protocol string) {
 	m.ctrl.T.Helper()
-	gomock.Dispatch4_0(&m.recorder.setLokiConfigExpects, m.ctrl, m, "SetLokiConfig", endpoint, caCert, insecureSkipVerify, orgID)
+	gomock.Dispatch5_0(&m.recorder.setLokiConfigExpects, m.ctrl, m, "SetLokiConfig", endpoint, caCert, insecureSkipVerify, orgID, protocol)
 }
 
 // SetLokiConfig indicates an expected call of SetLokiConfig.
-func (mr *MockConfigSetterMockRecorder) SetLokiConfig(endpoint, caCert, insecureSkipVerify, orgID any) *MockConfigSetterSetLokiConfigCall {
+func (mr *MockConfigSetterMockRecorder) SetLokiConfig(endpoint, caCert, insecureSkipVerify, orgID, protocol any) *MockConfigSetterSetLokiConfigCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall4_0[string, *string, *bool, string](mr.mock.ctrl.T, mr.mock, "SetLokiConfig", gomock.EnsureMatcher(endpoint), gomock.EnsureMatcher(caCert), gomock.EnsureMatcher(insecureSkipVerify), gomock.EnsureMatcher(orgID))
+	call := gomock.NewCall5_0[string, *string, *bool, string, string](mr.mock.ctrl.T, mr.mock, "SetLokiConfig", gomock.EnsureMatcher(endpoint), gomock.EnsureMatcher(caCert), gomock.EnsureMatcher(insecureSkipVerify), gomock.EnsureMatcher(orgID), gomock.EnsureMatcher(protocol))
 	mr.setLokiConfigExpects = append(mr.setLokiConfigExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockConfigSetterSetLokiConfigCall is the typed call wrapper for SetLokiConfig.
-type MockConfigSetterSetLokiConfigCall = gomock.Call4_0[string, *string, *bool, string]
+type MockConfigSetterSetLokiConfigCall = gomock.Call5_0[string, *string, *bool, string, string]
 
 // SetOldPassword mocks base method.
 func (m *MockConfigSetter) SetOldPassword(oldPassword string) {
@@ -55,6 +55,14 @@ const (
 	// is ever made to this address.
 	disabledLokiLocation = "http://0.0.0.0:0"
 
+	// protocolOTLP is the controller Loki config protocol selecting an
+	// OTLP/HTTP logs receiver rather than a Loki push endpoint.
+	protocolOTLP = "otlp"
+
+	// otlpLogsPath is the path Pebble appends to the location of an
+	// opentelemetry log-target.
+	otlpLogsPath = "/v1/logs"
+
 	// retry constants for transient Pebble errors.
 	retryInitialDelay  = 1 * time.Second
 	retryMaxDelay      = 30 * time.Second
@@ -315,8 +323,9 @@ func (w *pebbleLokiConfigWorker) reconcileWithRetry(
 }
 
 // BuildLayerYAML marshals a Pebble layer containing a single log-targets
-// entry for the given Loki config. Custom labels never use the reserved
-// pebble_ prefix.
+// entry for the given Loki config. An opentelemetry target is used when the
+// config protocol is OTLP. Custom labels never use the reserved pebble_
+// prefix.
 func BuildLayerYAML(
 	lokiConfig logger.ControllerLokiConfig,
 	agentTag names.Tag,
@@ -342,6 +351,12 @@ func BuildLayerYAML(
 		Services: []string{pebble.ContainerAgentService},
 		Labels:   labels,
 	}
+	if lokiConfig.Protocol == protocolOTLP {
+		// The controller stores the full logs receiver URL, whereas
+		// Pebble expects the collector base URL.
+		target.Type = "opentelemetry"
+		target.Location = strings.TrimSuffix(strings.TrimSuffix(lokiConfig.Endpoint, "/"), otlpLogsPath)
+	}
 	if lokiConfig.Endpoint == "" {
 		// Pebble does not support an "override: remove" directive and has
 		// no API to delete a log-target. To effectively disable the
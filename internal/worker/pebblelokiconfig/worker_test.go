@@ -454,6 +454,32 @@ func (s *workerSuite) TestBuildLayerYAML(c *tc.C) {
 	c.Check(target.Labels["juju_agent"], tc.Equals, "machine-0")
 }
 
+func (s *workerSuite) TestBuildLayerYAMLOTLP(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	lokiConfig := logger.ControllerLokiConfig{
+		Endpoint: "https://otel-collector:4318/v1/logs",
+		Protocol: "otlp",
+	}
+	data, err := BuildLayerYAML(
+		lokiConfig,
+		names.NewMachineTag("0"),
+		names.NewControllerTag("controller-uuid"),
+		names.NewModelTag("model-uuid"),
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var layer layerYAML
+	err = yaml.Unmarshal(data, &layer)
+	c.Assert(err, tc.ErrorIsNil)
+
+	target, ok := layer.LogTargets["juju-loki"]
+	c.Assert(ok, tc.IsTrue)
+	c.Check(target.Type, tc.Equals, "opentelemetry")
+	c.Check(target.Location, tc.Equals, "https://otel-collector:4318")
+	c.Check(target.Services, tc.DeepEquals, []string{pebble.ContainerAgentService})
+}
+
 func (s *workerSuite) TestBuildLayerYAMLNoReservedLabels(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
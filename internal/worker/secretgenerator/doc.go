@@ -0,0 +1,18 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package secretgenerator provides a worker that rotates user secrets whose
+// content is generated by the controller.
+//
+// A user secret may be created with a generator spec describing the format
+// of its content (a password, hex value, or RSA or Ed25519 key pair) and a
+// rotate policy. The worker watches the rotation times of such secrets, and
+// schedules them with the same secretrotate worker used by the uniter for
+// charm secrets. When a secret is due, the worker asks the SecretService to
+// generate new content for it. The new content is added as a new revision
+// of the secret, which fires secret-changed hooks for the consumers of the
+// secret; the owning charm is not involved.
+//
+// Failing to rotate a secret is not fatal to the worker; the error is logged
+// and the SecretService reschedules the secret to be rotated again later.
+package secretgenerator
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/secretgenerator (interfaces: SecretService)
+//
+// Generated by this command:
+//
+//	mockgen -package secretgenerator -destination services_mock_test.go github.com/juju/juju/internal/worker/secretgenerator SecretService
+//
+
+// Package secretgenerator is a generated GoMock package.
+package secretgenerator
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	secrets "github.com/juju/juju/core/secrets"
+	watcher "github.com/juju/juju/core/watcher"
+)
+
+// MockSecretService is a mock of SecretService interface.
+type MockSecretService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSecretServiceMockRecorder is the mock recorder for MockSecretService.
+type MockSecretServiceMockRecorder struct {
+	mock                                        *MockSecretService
+	rotateGeneratedSecretsExpects               []*gomock.Call1V_1[context.Context, *secrets.URI, error]
+	watchGeneratedSecretsRotationChangesExpects []*gomock.Call1_2[context.Context, watcher.SecretTriggerWatcher, error]
+}
+
+// NewMockSecretService creates a new mock instance.
+func NewMockSecretService(ctrl *gomock.Controller) *MockSecretService {
+	mock := &MockSecretService{ctrl: ctrl}
+	mock.recorder = &MockSecretServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretService) EXPECT() *MockSecretServiceMockRecorder {
+	return m.recorder
+}
+
+// RotateGeneratedSecrets mocks base method.
+func (m *MockSecretService) RotateGeneratedSecrets(ctx context.Context, uris ...*secrets.URI) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1V_1(&m.recorder.rotateGeneratedSecretsExpects, m.ctrl, m, "RotateGeneratedSecrets", ctx, uris...)
+}
+
+// RotateGeneratedSecrets indicates an expected call of RotateGeneratedSecrets.
+func (mr *MockSecretServiceMockRecorder) RotateGeneratedSecrets(ctx any, uris ...any) *MockSecretServiceRotateGeneratedSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	varArgs := gomock.EnsureVariadicMatcher(uris)
+	call := gomock.NewCall1V_1[context.Context, *secrets.URI, error](mr.mock.ctrl.T, mr.mock, "RotateGeneratedSecrets", gomock.EnsureMatcher(ctx), varArgs)
+	mr.rotateGeneratedSecretsExpects = append(mr.rotateGeneratedSecretsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretServiceRotateGeneratedSecretsCall is the typed call wrapper for RotateGeneratedSecrets.
+type MockSecretServiceRotateGeneratedSecretsCall = gomock.Call1V_1[context.Context, *secrets.URI, error]
+
+// WatchGeneratedSecretsRotationChanges mocks base method.
+func (m *MockSecretService) WatchGeneratedSecretsRotationChanges(ctx context.Context) (watcher.SecretTriggerWatcher, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.watchGeneratedSecretsRotationChangesExpects, m.ctrl, m, "WatchGeneratedSecretsRotationChanges", ctx)
+}
+
+// WatchGeneratedSecretsRotationChanges indicates an expected call of WatchGeneratedSecretsRotationChanges.
+func (mr *MockSecretServiceMockRecorder) WatchGeneratedSecretsRotationChanges(ctx any) *MockSecretServiceWatchGeneratedSecretsRotationChangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, watcher.SecretTriggerWatcher, error](mr.mock.ctrl.T, mr.mock, "WatchGeneratedSecretsRotationChanges", gomock.EnsureMatcher(ctx))
+	mr.watchGeneratedSecretsRotationChangesExpects = append(mr.watchGeneratedSecretsRotationChangesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretServiceWatchGeneratedSecretsRotationChangesCall is the typed call wrapper for WatchGeneratedSecretsRotationChanges.
+type MockSecretServiceWatchGeneratedSecretsRotationChangesCall = gomock.Call1_2[context.Context, watcher.SecretTriggerWatcher, error]
@@ -0,0 +1,151 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretgenerator
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+	"github.com/juju/names/v6"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/worker/secretrotate"
+)
+
+// SecretService provides access to secrets generated by the controller.
+type SecretService interface {
+	// WatchGeneratedSecretsRotationChanges returns a watcher that notifies
+	// when the rotation time of a generated secret changes.
+	WatchGeneratedSecretsRotationChanges(ctx context.Context) (watcher.SecretTriggerWatcher, error)
+
+	// RotateGeneratedSecrets generates new content for each of the
+	// specified generated secrets.
+	RotateGeneratedSecrets(ctx context.Context, uris ...*secrets.URI) error
+}
+
+// Config is the configuration for the secret generator worker.
+type Config struct {
+	ModelUUID     string
+	Clock         clock.Clock
+	SecretService SecretService
+	Logger        logger.Logger
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if !names.IsValidModel(config.ModelUUID) {
+		return errors.Errorf("model UUID %q", config.ModelUUID).Add(coreerrors.NotValid)
+	}
+	if config.Clock == nil {
+		return errors.Errorf("nil clock.Clock").Add(coreerrors.NotValid)
+	}
+	if config.SecretService == nil {
+		return errors.Errorf("nil SecretService").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// generatorWorker is a worker that rotates generated secrets.
+type generatorWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+}
+
+// NewWorker returns a new secret generator worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	w := &generatorWorker{
+		config: config,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "secret-generator",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Capture(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *generatorWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *generatorWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *generatorWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	// The secret rotate worker used by the uniter to fire secret-rotate
+	// hooks schedules the rotations; here, it is fed the rotation times of
+	// the generated secrets instead of those of a charm's secrets.
+	rotate := make(chan []string)
+	rotateWorker, err := secretrotate.New(secretrotate.Config{
+		SecretManagerFacade: rotationWatcher{service: w.config.SecretService},
+		Logger:              w.config.Logger,
+		Clock:               w.config.Clock,
+		SecretOwners:        []names.Tag{names.NewModelTag(w.config.ModelUUID)},
+		RotateSecrets:       rotate,
+	})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := w.catacomb.Add(rotateWorker); err != nil {
+		return errors.Capture(err)
+	}
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case ids := <-rotate:
+			w.rotate(ctx, ids)
+		}
+	}
+}
+
+// rotate generates new content for the secrets which are due to be rotated.
+// Errors are logged rather than returned, as the secret backend may be
+// temporarily unavailable, and restarting the worker would not help.
+// The secret service reschedules secrets which fail to rotate.
+func (w *generatorWorker) rotate(ctx context.Context, ids []string) {
+	uris := make([]*secrets.URI, 0, len(ids))
+	for _, id := range ids {
+		uri, err := secrets.ParseURI(id)
+		if err != nil {
+			w.config.Logger.Warningf(ctx, "invalid secret URI %q: %v", id, err)
+			continue
+		}
+		uris = append(uris, uri)
+	}
+	if err := w.config.SecretService.RotateGeneratedSecrets(ctx, uris...); err != nil {
+		w.config.Logger.Warningf(ctx, "%v", err)
+	}
+}
+
+// rotationWatcher adapts the secret service to the facade used by the
+// secret rotate worker. The generated secrets are owned by the model, so
+// the owners passed by the rotate worker are not needed.
+type rotationWatcher struct {
+	service SecretService
+}
+
+// WatchSecretsRotationChanges is part of the [secretrotate.SecretManagerFacade]
+// interface.
+func (r rotationWatcher) WatchSecretsRotationChanges(ctx context.Context, _ ...names.Tag) (watcher.SecretTriggerWatcher, error) {
+	return r.service.WatchGeneratedSecretsRotationChanges(ctx)
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretgenerator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+
+	"github.com/juju/juju/core/secrets"
+	coretesting "github.com/juju/juju/core/testing"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/watchertest"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type workerSuite struct {
+	clock         testclock.AdvanceableClock
+	secretService *MockSecretService
+	changes       chan []watcher.SecretTriggerChange
+}
+
+func TestWorkerSuite(t *testing.T) { tc.Run(t, &workerSuite{}) }
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.clock = testclock.NewDilatedWallClock(100 * time.Millisecond)
+	s.secretService = NewMockSecretService(ctrl)
+	s.changes = make(chan []watcher.SecretTriggerChange)
+	return ctrl
+}
+
+func (s *workerSuite) newConfig(c *tc.C) Config {
+	return Config{
+		ModelUUID:     uuid.MustNewUUID().String(),
+		Clock:         s.clock,
+		SecretService: s.secretService,
+		Logger:        loggertesting.WrapCheckLog(c),
+	}
+}
+
+func (s *workerSuite) expectWatch() {
+	s.secretService.EXPECT().WatchGeneratedSecretsRotationChanges(gomock.Any()).Return(
+		watchertest.NewMockWatcher[[]watcher.SecretTriggerChange](s.changes), nil)
+}
+
+func (s *workerSuite) TestValidateConfig(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := s.newConfig(c)
+	c.Check(cfg.Validate(), tc.ErrorIsNil)
+
+	bad := cfg
+	bad.ModelUUID = ""
+	c.Check(bad.Validate(), tc.ErrorMatches, `model UUID "".*`)
+
+	bad = cfg
+	bad.Clock = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil clock.Clock.*")
+
+	bad = cfg
+	bad.SecretService = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil SecretService.*")
+
+	bad = cfg
+	bad.Logger = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil Logger.*")
+}
+
+func (s *workerSuite) TestRotatesDueSecrets(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectWatch()
+	due := secrets.NewURI()
+	rotated := make(chan struct{})
+	s.secretService.EXPECT().RotateGeneratedSecrets(gomock.Any(), due).DoAndReturn(
+		func(context.Context, ...*secrets.URI) error {
+			rotated <- struct{}{}
+			return nil
+		})
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	now := s.clock.Now()
+	s.sendChanges(c, []watcher.SecretTriggerChange{{
+		URI:             due,
+		Revision:        1,
+		NextTriggerTime: now.Add(-time.Hour),
+	}, {
+		URI:             secrets.NewURI(),
+		Revision:        1,
+		NextTriggerTime: now.Add(time.Hour),
+	}})
+	s.waitRotate(c, rotated)
+}
+
+func (s *workerSuite) TestRotateErrorNotFatal(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectWatch()
+	uri := secrets.NewURI()
+	rotated := make(chan struct{})
+	gomock.InOrder(
+		s.secretService.EXPECT().RotateGeneratedSecrets(gomock.Any(), uri).DoAndReturn(
+			func(context.Context, ...*secrets.URI) error {
+				rotated <- struct{}{}
+				return errors.New("boom")
+			}),
+		s.secretService.EXPECT().RotateGeneratedSecrets(gomock.Any(), uri).DoAndReturn(
+			func(context.Context, ...*secrets.URI) error {
+				rotated <- struct{}{}
+				return nil
+			}),
+	)
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	// The secret service reschedules a failed rotation, which is
+	// reported by the watcher as a new rotation time.
+	for i := range 2 {
+		s.sendChanges(c, []watcher.SecretTriggerChange{{
+			URI:             uri,
+			Revision:        1,
+			NextTriggerTime: s.clock.Now().Add(-time.Duration(i+1) * time.Minute),
+		}})
+		s.waitRotate(c, rotated)
+	}
+	workertest.CheckAlive(c, w)
+}
+
+func (s *workerSuite) sendChanges(c *tc.C, changes []watcher.SecretTriggerChange) {
+	select {
+	case s.changes <- changes:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out sending rotation changes")
+	}
+}
+
+func (s *workerSuite) waitRotate(c *tc.C, rotated <-chan struct{}) {
+	select {
+	case <-rotated:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for rotation")
+	}
+}
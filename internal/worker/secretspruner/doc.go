@@ -3,4 +3,7 @@
 
 // Package secretspruner provides a worker for tracking and
 // pruning when a user supplied secret revision is obsolote.
+// The worker also periodically prunes entries older than the
+// max-secret-access-log-age model config value from the secret
+// access log.
 package secretspruner
@@ -5,13 +5,16 @@ package secretspruner
 
 import (
 	"context"
+	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v5"
 	"github.com/juju/worker/v5/dependency"
 
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/services"
 )
 
@@ -19,6 +22,11 @@ import (
 type ManifoldConfig struct {
 	DomainServicesName string
 	Logger             logger.Logger
+	Clock              clock.Clock
+
+	// AccessLogPruneInterval is the interval at which old entries
+	// are pruned from the secret access log.
+	AccessLogPruneInterval time.Duration
 
 	NewWorker func(Config) (worker.Worker, error)
 }
@@ -41,6 +49,12 @@ func (cfg ManifoldConfig) Validate() error {
 	if cfg.Logger == nil {
 		return errors.NotValidf("nil Logger")
 	}
+	if cfg.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if cfg.AccessLogPruneInterval <= 0 {
+		return errors.NotValidf("non-positive AccessLogPruneInterval")
+	}
 	if cfg.NewWorker == nil {
 		return errors.NotValidf("nil NewWorker")
 	}
@@ -59,12 +73,15 @@ func (cfg ManifoldConfig) start(context context.Context, getter dependency.Gette
 	}
 
 	facade := &secretServiceAdapter{
-		secretSvc: domainServices.Secret(),
+		secretSvc:      domainServices.Secret(),
+		modelConfigSvc: domainServices.Config(),
 	}
 
 	worker, err := cfg.NewWorker(Config{
-		SecretsFacade: facade,
-		Logger:        cfg.Logger,
+		SecretsFacade:          facade,
+		Logger:                 cfg.Logger,
+		Clock:                  cfg.Clock,
+		AccessLogPruneInterval: cfg.AccessLogPruneInterval,
 	})
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -75,7 +92,8 @@ func (cfg ManifoldConfig) start(context context.Context, getter dependency.Gette
 // secretServiceAdapter satisfies the SecretsFacade interface by reading
 // from the domain secret service directly.
 type secretServiceAdapter struct {
-	secretSvc secretService
+	secretSvc      secretService
+	modelConfigSvc modelConfigService
 }
 
 // secretService is the subset of the domain secret service needed by the
@@ -83,6 +101,13 @@ type secretServiceAdapter struct {
 type secretService interface {
 	WatchObsoleteUserSecretsToPrune(context.Context) (watcher.NotifyWatcher, error)
 	DeleteObsoleteUserSecretRevisions(context.Context) error
+	PruneSecretAccessLog(context.Context, time.Duration) error
+}
+
+// modelConfigService is the subset of the domain model config service
+// needed by the secrets-pruner facade adapter.
+type modelConfigService interface {
+	ModelConfig(context.Context) (*config.Config, error)
 }
 
 // WatchRevisionsToPrune is part of the SecretsFacade interface.
@@ -94,3 +119,15 @@ func (a *secretServiceAdapter) WatchRevisionsToPrune(ctx context.Context) (watch
 func (a *secretServiceAdapter) DeleteObsoleteUserSecretRevisions(ctx context.Context) error {
 	return a.secretSvc.DeleteObsoleteUserSecretRevisions(ctx)
 }
+
+// PruneSecretAccessLog is part of the SecretsFacade interface. Entries older
+// than the max-secret-access-log-age model config value are pruned; the
+// model config is read each time so that changes to it take effect at the
+// next prune.
+func (a *secretServiceAdapter) PruneSecretAccessLog(ctx context.Context) error {
+	cfg, err := a.modelConfigSvc.ModelConfig(ctx)
+	if err != nil {
+		return errors.Annotate(err, "getting model config")
+	}
+	return a.secretSvc.PruneSecretAccessLog(ctx, cfg.MaxSecretAccessLogAge())
+}
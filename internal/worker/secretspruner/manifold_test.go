@@ -5,7 +5,9 @@ package secretspruner_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/juju/clock/testclock"
 	"github.com/juju/errors"
 	"github.com/juju/tc"
 	"github.com/juju/worker/v5"
@@ -33,8 +35,10 @@ func (s *manifoldSuite) SetUpTest(c *tc.C) {
 
 func (s *manifoldSuite) validConfig(c *tc.C) secretspruner.ManifoldConfig {
 	return secretspruner.ManifoldConfig{
-		DomainServicesName: "domain-services",
-		Logger:             loggertesting.WrapCheckLog(c),
+		DomainServicesName:     "domain-services",
+		Logger:                 loggertesting.WrapCheckLog(c),
+		Clock:                  testclock.NewClock(time.Now()),
+		AccessLogPruneInterval: time.Hour,
 		NewWorker: func(config secretspruner.Config) (worker.Worker, error) {
 			return nil, nil
 		},
@@ -55,6 +59,16 @@ func (s *manifoldSuite) TestMissingLogger(c *tc.C) {
 	s.checkNotValid(c, "nil Logger not valid")
 }
 
+func (s *manifoldSuite) TestMissingClock(c *tc.C) {
+	s.config.Clock = nil
+	s.checkNotValid(c, "nil Clock not valid")
+}
+
+func (s *manifoldSuite) TestMissingAccessLogPruneInterval(c *tc.C) {
+	s.config.AccessLogPruneInterval = 0
+	s.checkNotValid(c, "non-positive AccessLogPruneInterval not valid")
+}
+
 func (s *manifoldSuite) TestMissingNewWorker(c *tc.C) {
 	s.config.NewWorker = nil
 	s.checkNotValid(c, "nil NewWorker not valid")
@@ -27,6 +27,7 @@ type MockSecretsFacade struct {
 type MockSecretsFacadeMockRecorder struct {
 	mock                                     *MockSecretsFacade
 	deleteObsoleteUserSecretRevisionsExpects []*gomock.Call1_1[context.Context, error]
+	pruneSecretAccessLogExpects              []*gomock.Call1_1[context.Context, error]
 	watchRevisionsToPruneExpects             []*gomock.Call1_2[context.Context, watcher.NotifyWatcher, error]
 }
 
@@ -60,6 +61,24 @@ func (mr *MockSecretsFacadeMockRecorder) DeleteObsoleteUserSecretRevisions(arg0
 // MockSecretsFacadeDeleteObsoleteUserSecretRevisionsCall is the typed call wrapper for DeleteObsoleteUserSecretRevisions.
 type MockSecretsFacadeDeleteObsoleteUserSecretRevisionsCall = gomock.Call1_1[context.Context, error]
 
+// PruneSecretAccessLog mocks base method.
+func (m *MockSecretsFacade) PruneSecretAccessLog(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_1(&m.recorder.pruneSecretAccessLogExpects, m.ctrl, m, "PruneSecretAccessLog", arg0)
+}
+
+// PruneSecretAccessLog indicates an expected call of PruneSecretAccessLog.
+func (mr *MockSecretsFacadeMockRecorder) PruneSecretAccessLog(arg0 any) *MockSecretsFacadePruneSecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_1[context.Context, error](mr.mock.ctrl.T, mr.mock, "PruneSecretAccessLog", gomock.EnsureMatcher(arg0))
+	mr.pruneSecretAccessLogExpects = append(mr.pruneSecretAccessLogExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretsFacadePruneSecretAccessLogCall is the typed call wrapper for PruneSecretAccessLog.
+type MockSecretsFacadePruneSecretAccessLogCall = gomock.Call1_1[context.Context, error]
+
 // WatchRevisionsToPrune mocks base method.
 func (m *MockSecretsFacade) WatchRevisionsToPrune(arg0 context.Context) (watcher.NotifyWatcher, error) {
 	m.ctrl.T.Helper()
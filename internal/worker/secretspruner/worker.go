@@ -5,7 +5,9 @@ package secretspruner
 
 import (
 	"context"
+	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v5"
 	"github.com/juju/worker/v5/catacomb"
@@ -18,12 +20,18 @@ import (
 type SecretsFacade interface {
 	WatchRevisionsToPrune(context.Context) (watcher.NotifyWatcher, error)
 	DeleteObsoleteUserSecretRevisions(context.Context) error
+	PruneSecretAccessLog(context.Context) error
 }
 
 // Config defines the operation of the Worker.
 type Config struct {
 	SecretsFacade
 	Logger logger.Logger
+	Clock  clock.Clock
+
+	// AccessLogPruneInterval is the interval at which old entries
+	// are pruned from the secret access log.
+	AccessLogPruneInterval time.Duration
 }
 
 // Validate returns an error if config cannot drive the Worker.
@@ -34,6 +42,12 @@ func (config Config) Validate() error {
 	if config.Logger == nil {
 		return errors.NotValidf("nil Logger")
 	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.AccessLogPruneInterval <= 0 {
+		return errors.NotValidf("non-positive AccessLogPruneInterval")
+	}
 	return nil
 }
 
@@ -52,7 +66,8 @@ func NewWorker(config Config) (worker.Worker, error) {
 	return w, errors.Trace(err)
 }
 
-// Worker prunes the user supplied secret revisions.
+// Worker prunes the user supplied secret revisions, and old entries
+// in the secret access log.
 type Worker struct {
 	catacomb catacomb.Catacomb
 	config   Config
@@ -86,6 +101,9 @@ func (w *Worker) loop() (err error) {
 		return errors.Trace(err)
 	}
 
+	accessLogTimer := w.config.Clock.NewTimer(w.config.AccessLogPruneInterval)
+	defer accessLogTimer.Stop()
+
 	for {
 		select {
 		case <-w.catacomb.Dying():
@@ -100,10 +118,22 @@ func (w *Worker) loop() (err error) {
 			if err := w.processChanges(ctx); err != nil {
 				return errors.Trace(err)
 			}
+		case <-accessLogTimer.Chan():
+			w.pruneAccessLog(ctx)
+			accessLogTimer.Reset(w.config.AccessLogPruneInterval)
 		}
 	}
 }
 
+// pruneAccessLog deletes old entries from the secret access log. Errors are
+// logged rather than returned, as restarting the worker would not help; the
+// entries are pruned at the next interval instead.
+func (w *Worker) pruneAccessLog(ctx context.Context) {
+	if err := w.config.SecretsFacade.PruneSecretAccessLog(ctx); err != nil {
+		w.config.Logger.Warningf(ctx, "pruning secret access log: %v", err)
+	}
+}
+
 func (w *Worker) scopeContext() (context.Context, context.CancelFunc) {
 	return context.WithCancel(w.catacomb.Context(context.Background()))
 }
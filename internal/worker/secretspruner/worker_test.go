@@ -5,11 +5,13 @@ package secretspruner_test
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
 	"github.com/juju/tc"
 	"github.com/juju/worker/v5/workertest"
 
@@ -27,6 +29,7 @@ type workerSuite struct {
 	logger logger.Logger
 
 	facade *mocks.MockSecretsFacade
+	clock  *testclock.Clock
 
 	done      chan struct{}
 	changedCh chan struct{}
@@ -40,6 +43,7 @@ func (s *workerSuite) getWorkerNewer(c *tc.C) (func(string), *gomock.Controller)
 	ctrl := gomock.NewController(c)
 	s.logger = loggertesting.WrapCheckLog(c)
 	s.facade = mocks.NewMockSecretsFacade(ctrl)
+	s.clock = testclock.NewClock(time.Now())
 
 	s.changedCh = make(chan struct{}, 1)
 	s.done = make(chan struct{})
@@ -47,8 +51,10 @@ func (s *workerSuite) getWorkerNewer(c *tc.C) (func(string), *gomock.Controller)
 
 	start := func(expectedErr string) {
 		w, err := secretspruner.NewWorker(secretspruner.Config{
-			Logger:        s.logger,
-			SecretsFacade: s.facade,
+			Logger:                 s.logger,
+			SecretsFacade:          s.facade,
+			Clock:                  s.clock,
+			AccessLogPruneInterval: time.Hour,
 		})
 		c.Assert(err, tc.ErrorIsNil)
 		c.Assert(w, tc.NotNil)
@@ -95,3 +101,33 @@ func (s *workerSuite) TestPrune(c *tc.C) {
 
 	start("")
 }
+
+func (s *workerSuite) TestPruneAccessLog(c *tc.C) {
+	start, ctrl := s.getWorkerNewer(c)
+	defer ctrl.Finish()
+
+	pruned := make(chan struct{})
+	gomock.InOrder(
+		s.facade.EXPECT().PruneSecretAccessLog(gomock.Any()).DoAndReturn(func(context.Context) error {
+			pruned <- struct{}{}
+			return errors.New("boom")
+		}),
+		s.facade.EXPECT().PruneSecretAccessLog(gomock.Any()).DoAndReturn(func(context.Context) error {
+			pruned <- struct{}{}
+			return nil
+		}),
+	)
+	close(s.done)
+	start("")
+
+	// Failing to prune the access log is not fatal to the worker.
+	for range 2 {
+		err := s.clock.WaitAdvance(time.Hour, coretesting.ShortWait, 1)
+		c.Assert(err, tc.ErrorIsNil)
+		select {
+		case <-pruned:
+		case <-time.After(coretesting.LongWait):
+			c.Fatalf("timed out waiting for access log prune")
+		}
+	}
+}
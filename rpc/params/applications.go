@@ -730,3 +730,61 @@ type ApplicationStorageUpdate struct {
 	// Holds the application storage constraints where the key is the storage name.
 	StorageDirectives map[string]StorageDirectives `json:"storage-constraints"`
 }
+
+// ApplicationPlacementRules holds the placement rules for the units of an
+// application.
+type ApplicationPlacementRules struct {
+	// MaxUnitsPerMachine is the maximum number of units that may share a
+	// machine. Zero means there is no limit.
+	MaxUnitsPerMachine int `json:"max-units-per-machine,omitempty"`
+
+	// MaxUnitsPerZone is the maximum number of units that may share an
+	// availability zone. Zero means there is no limit.
+	MaxUnitsPerZone int `json:"max-units-per-zone,omitempty"`
+
+	// AntiAffinity holds the names of the applications whose units must
+	// never share a machine with the units of the application.
+	AntiAffinity []string `json:"anti-affinity,omitempty"`
+
+	// Affinity holds the names of the applications whose units must be
+	// present on the machines the units of the application are placed on.
+	Affinity []string `json:"affinity,omitempty"`
+}
+
+// SetApplicationPlacementRulesArgs holds the placement rules to set for one
+// or more applications.
+type SetApplicationPlacementRulesArgs struct {
+	Args []SetApplicationPlacementRulesArg `json:"args"`
+}
+
+// SetApplicationPlacementRulesArg holds the placement rules to set for a
+// single application.
+type SetApplicationPlacementRulesArg struct {
+	ApplicationTag string                    `json:"application-tag"`
+	Rules          ApplicationPlacementRules `json:"rules"`
+}
+
+// RenameApplicationArgs holds the arguments for renaming one or more
+// applications.
+type RenameApplicationArgs struct {
+	Args []RenameApplicationArg `json:"args"`
+}
+
+// RenameApplicationArg holds the new name for a single application.
+type RenameApplicationArg struct {
+	ApplicationTag string `json:"application-tag"`
+	Name           string `json:"name"`
+}
+
+// ApplicationPlacementRulesResults holds the placement rules for a bulk
+// request. The number and order of results matches the input entities.
+type ApplicationPlacementRulesResults struct {
+	Results []ApplicationPlacementRulesResult `json:"results"`
+}
+
+// ApplicationPlacementRulesResult holds the placement rules for a single
+// application, or any error retrieving them.
+type ApplicationPlacementRulesResult struct {
+	Rules *ApplicationPlacementRules `json:"rules,omitempty"`
+	Error *Error                     `json:"error,omitempty"`
+}
@@ -49,10 +49,18 @@ type EndpointFilterAttributes struct {
 // Offers matching any of the filters are returned.
 type OfferFilters struct {
 	Filters []OfferFilter
+
+	// Macaroons are used to authenticate with external controllers when a
+	// filter specifies a source controller.
+	Macaroons []macaroon.Slice `json:"macaroons,omitempty"`
 }
 
 // OfferFilter is used to query offers.
 type OfferFilter struct {
+	// Source is the alias of the external controller hosting the offers. It
+	// is empty for offers hosted on this controller.
+	Source string `json:"source,omitempty"`
+
 	// ModelQualifier is the owner identifier used to disambiguate ModelName.
 	// It uses user-id form (for example "admin" or "alice@external"),
 	// not full user-tag form.
@@ -128,6 +136,24 @@ type AddApplicationOffer struct {
 	OwnerTag               string            `json:"owner-tag,omitempty"`
 }
 
+// UpdateApplicationOffers holds parameters for the UpdateOffer call.
+type UpdateApplicationOffers struct {
+	Offers []UpdateApplicationOffer `json:"offers"`
+}
+
+// UpdateApplicationOffer values are used to update an existing application
+// offer in place.
+type UpdateApplicationOffer struct {
+	ModelTag  string            `json:"model-tag"`
+	OfferName string            `json:"offer-name"`
+	Endpoints map[string]string `json:"endpoints"`
+	// Description replaces the offer description when set. An empty
+	// description reverts to the charm description.
+	Description *string `json:"description,omitempty"`
+	// Force allows endpoints with live relations to be removed.
+	Force bool `json:"force,omitempty"`
+}
+
 // DestroyApplicationOffers holds parameters for the DestroyOffers call.
 type DestroyApplicationOffers struct {
 	OfferURLs []string `json:"offer-urls"`
@@ -165,6 +191,10 @@ type OfferURLs struct {
 
 	// BakeryVersion is the version of the bakery used to mint macaroons.
 	BakeryVersion bakery.Version `json:"bakery-version,omitempty"`
+
+	// Macaroons are used to authenticate with external controllers hosting
+	// offers whose URL specifies a source controller.
+	Macaroons []macaroon.Slice `json:"macaroons,omitempty"`
 }
 
 // ConsumeApplicationArgV5 holds the arguments for consuming a remote application.
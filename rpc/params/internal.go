@@ -103,6 +103,7 @@ type LokiConfigResult struct {
 	CACert             *string `json:"ca-cert,omitempty"`
 	InsecureSkipVerify *bool   `json:"insecure-skip-verify,omitempty"`
 	OrgID              string  `json:"org-id,omitempty"`
+	Protocol           string  `json:"protocol,omitempty"`
 }
 
 // TracingConfigResult holds a controller-wide tracing configuration or an
@@ -896,6 +897,10 @@ type ProvisioningInfo struct {
 	// deployments. Empty means no X-Scope-OrgID header is sent.
 	LokiOrgID string `json:"loki-org-id,omitempty"`
 
+	// LokiProtocol is the protocol spoken by the logging endpoint, either
+	// "loki" or "otlp". Empty means "loki".
+	LokiProtocol string `json:"loki-protocol,omitempty"`
+
 	// TracingHTTPEndpoint is the HTTP endpoint for the OpenTelemetry
 	// collector. Empty means no HTTP tracing endpoint is configured.
 	TracingHTTPEndpoint string `json:"tracing-http-endpoint,omitempty"`
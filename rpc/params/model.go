@@ -465,6 +465,20 @@ type ChangeModelCredentialsParams struct {
 	Models []ChangeModelCredentialParams `json:"model-credentials"`
 }
 
+// RenameModelParams holds the arguments for renaming a model.
+type RenameModelParams struct {
+	// ModelTag is the tag of the model to rename.
+	ModelTag string `json:"model-tag"`
+
+	// Name is the new name of the model.
+	Name string `json:"name"`
+}
+
+// RenameModelsParams holds the arguments for renaming models.
+type RenameModelsParams struct {
+	Models []RenameModelParams `json:"models"`
+}
+
 // ValidateModelUpgradeParams is used to ensure that a model can be upgraded.
 type ValidateModelUpgradeParams struct {
 	Models []ModelParam `json:"model"`
@@ -721,6 +721,16 @@ type StateServingInfo struct {
 	CAPrivateKey string `json:"ca-private-key"`
 	// SystemIdentity will be passed as the KeyFile for the database.
 	SystemIdentity string `json:"system-identity"`
+	// SecretKeyEncryptionKeys are the keys which wrap the data keys
+	// encrypting secret content, so that a new controller machine can
+	// decrypt the content written by the others.
+	SecretKeyEncryptionKeys []SecretKeyEncryptionKey `json:"secret-key-encryption-keys,omitempty"`
+}
+
+// SecretKeyEncryptionKey holds a key which wraps secret data keys.
+type SecretKeyEncryptionKey struct {
+	ID  string `json:"id"`
+	Key []byte `json:"key"`
 }
 
 // IsMasterResult holds the result of an IsMaster API call.
@@ -1212,6 +1222,96 @@ type DestroyMachineInfo struct {
 	DestroyedContainers []DestroyMachineResult `json:"destroyed-containers,omitempty"`
 }
 
+// RemovalPreviewResults contains the results of a bulk removal preview
+// request.
+type RemovalPreviewResults struct {
+	Results []RemovalPreviewResult `json:"results"`
+}
+
+// RemovalPreviewResult contains one of the results of a removal preview
+// request.
+type RemovalPreviewResult struct {
+	Error *Error          `json:"error,omitempty"`
+	Info  *RemovalPreview `json:"info,omitempty"`
+}
+
+// RemovalPreview describes the entities that would be removed as a result of
+// removing an entity, without anything having been removed.
+type RemovalPreview struct {
+	// Machines are the tags of machines, including containers, that would be
+	// removed.
+	Machines []Entity `json:"machines,omitempty"`
+
+	// Applications are the tags of applications that would be removed.
+	Applications []Entity `json:"applications,omitempty"`
+
+	// RemoteApplications are the tags of consumed (SAAS) applications that
+	// would be removed.
+	RemoteApplications []Entity `json:"remote-applications,omitempty"`
+
+	// Units are the tags of units that would be removed.
+	Units []Entity `json:"units,omitempty"`
+
+	// Relations are the tags of relations that would be removed.
+	Relations []Entity `json:"relations,omitempty"`
+
+	// Offers are the names of application offers that would be removed.
+	Offers []string `json:"offers,omitempty"`
+
+	// DestroyedStorage are the tags of storage instances that would be
+	// destroyed.
+	DestroyedStorage []Entity `json:"destroyed-storage,omitempty"`
+
+	// DetachedStorage are the tags of storage instances that would be
+	// detached, and would remain in the model after the removal.
+	DetachedStorage []Entity `json:"detached-storage,omitempty"`
+
+	// Secrets are the URIs of secrets that would be removed.
+	Secrets []string `json:"secrets,omitempty"`
+}
+
+// RemovalJobResults contains the pending removal jobs for a model.
+type RemovalJobResults struct {
+	Results []RemovalJob `json:"results"`
+}
+
+// RemovalJob describes a pending removal job, and the outcome of any
+// previous attempts to execute it.
+type RemovalJob struct {
+	// UUID uniquely identifies the removal job.
+	UUID string `json:"uuid"`
+
+	// EntityType is the type of entity being removed.
+	EntityType string `json:"entity-type"`
+
+	// EntityUUID is the UUID of the entity being removed.
+	EntityUUID string `json:"entity-uuid"`
+
+	// EntityName is the name by which the entity being removed is known
+	// to users. It is empty if the entity no longer exists.
+	EntityName string `json:"entity-name,omitempty"`
+
+	// Force indicates whether the removal is forced.
+	Force bool `json:"force"`
+
+	// ScheduledFor is the time after which the job is next due.
+	ScheduledFor time.Time `json:"scheduled-for"`
+
+	// Attempts is the number of unsuccessful attempts to execute the job.
+	Attempts int `json:"attempts"`
+
+	// LastError is the reason the last attempt did not complete.
+	LastError string `json:"last-error,omitempty"`
+
+	// LastAttemptedAt is the time of the last unsuccessful attempt.
+	LastAttemptedAt *time.Time `json:"last-attempted-at,omitempty"`
+}
+
+// RemovalJobArgs identifies removal jobs by their UUIDs.
+type RemovalJobArgs struct {
+	UUIDs []string `json:"uuids"`
+}
+
 // DestroyUnitResults contains the results of a DestroyUnit API request.
 type DestroyUnitResults struct {
 	Results []DestroyUnitResult `json:"results,omitempty"`
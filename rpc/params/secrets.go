@@ -111,6 +111,61 @@ type CreateSecretArg struct {
 	OwnerTag string `json:"owner-tag"`
 }
 
+// CreateExternalSecretArgs holds args for creating externally managed secrets.
+type CreateExternalSecretArgs struct {
+	Args []CreateExternalSecretArg `json:"args"`
+}
+
+// CreateExternalSecretArg holds the args for creating a secret whose
+// content is managed outside of Juju.
+type CreateExternalSecretArg struct {
+	// Description represents the secret's description.
+	Description *string `json:"description,omitempty"`
+	// Label is the secret's label.
+	Label *string `json:"label,omitempty"`
+	// BackendName is the name of the secret backend holding the content.
+	BackendName string `json:"backend-name"`
+	// Path is the location of the content in the backend.
+	Path string `json:"path"`
+	// Key, if set, selects a single key from the content.
+	Key string `json:"key,omitempty"`
+	// OwnerTag is the owner of the secret.
+	OwnerTag string `json:"owner-tag"`
+}
+
+// CreateGeneratedSecretArgs holds args for creating secrets
+// whose content is generated by the controller.
+type CreateGeneratedSecretArgs struct {
+	Args []CreateGeneratedSecretArg `json:"args"`
+}
+
+// CreateGeneratedSecretArg holds the args for creating a secret
+// whose content is generated by the controller.
+type CreateGeneratedSecretArg struct {
+	// Description represents the secret's description.
+	Description *string `json:"description,omitempty"`
+	// Label is the secret's label.
+	Label *string `json:"label,omitempty"`
+	// Generator describes how the secret content is generated.
+	Generator SecretGeneratorSpec `json:"generator"`
+	// RotatePolicy is how often new content is generated.
+	RotatePolicy *secrets.RotatePolicy `json:"rotate-policy,omitempty"`
+	// OwnerTag is the owner of the secret.
+	OwnerTag string `json:"owner-tag"`
+}
+
+// SecretGeneratorSpec describes how secret content is generated.
+type SecretGeneratorSpec struct {
+	// Format is the format of the generated value,
+	// one of password, hex, rsa or ed25519.
+	Format string `json:"format"`
+	// Length is the length of a password or hex value,
+	// or the number of bits of an RSA key.
+	Length int `json:"length,omitempty"`
+	// Charset is the set of characters used for a password.
+	Charset string `json:"charset,omitempty"`
+}
+
 // UpdateSecretArgs holds args for updating secrets.
 type UpdateSecretArgs struct {
 	Args []UpdateSecretArg `json:"args"`
@@ -351,6 +406,37 @@ type AccessInfo struct {
 	Role      secrets.SecretRole `json:"role"`
 }
 
+// SecretURIArgs holds the URIs of a number of secrets.
+type SecretURIArgs struct {
+	Args []SecretURIArg `json:"args"`
+}
+
+// SecretURIArg holds the URI of a secret.
+type SecretURIArg struct {
+	URI string `json:"uri"`
+}
+
+// SecretAccessRecord records reads of a secret revision's content
+// by a single accessor.
+type SecretAccessRecord struct {
+	Revision        int       `json:"revision"`
+	AccessorTag     string    `json:"accessor-tag"`
+	FirstAccessTime time.Time `json:"first-access-time"`
+	LastAccessTime  time.Time `json:"last-access-time"`
+	AccessCount     int       `json:"access-count"`
+}
+
+// SecretAccessLogResult holds the access log for a secret.
+type SecretAccessLogResult struct {
+	Access []SecretAccessRecord `json:"access,omitempty"`
+	Error  *Error               `json:"error,omitempty"`
+}
+
+// SecretAccessLogResults holds the access logs for a number of secrets.
+type SecretAccessLogResults struct {
+	Results []SecretAccessLogResult `json:"results"`
+}
+
 // SecretTriggerChange describes a change to a secret trigger.
 type SecretTriggerChange struct {
 	URI             string    `json:"uri"`
@@ -18,6 +18,10 @@ import (
 type StatusParams struct {
 	Patterns       []string `json:"patterns"`
 	IncludeStorage bool     `json:"include-storage,omitempty"`
+
+	// Statuses, if not empty, restricts the status to the entities in one
+	// of the given statuses.
+	Statuses []string `json:"statuses,omitempty"`
 }
 
 // FullStatus holds information about the status of a juju model.
@@ -131,6 +135,39 @@ type MachineStatus struct {
 	// instance and, thus, can be considered a primary controller machine in HA
 	// setup.
 	PrimaryControllerMachine *bool `json:"primary-controller-machine,omitempty"`
+
+	// Recovery describes the automatic recovery of the machine after its
+	// cloud instance has failed, if any has been attempted.
+	Recovery *MachineRecoveryStatus `json:"recovery,omitempty"`
+}
+
+// MachineRecoveryStatus holds status info about the automatic recovery of a
+// machine whose cloud instance has stopped or disappeared.
+type MachineRecoveryStatus struct {
+	// Policy is the model's machine recovery policy.
+	Policy string `json:"policy"`
+
+	// Attempts is the number of recent recovery attempts for the machine.
+	Attempts int `json:"attempts"`
+
+	// MaxAttempts is the number of recent attempts after which no more
+	// attempts are made.
+	MaxAttempts int `json:"max-attempts"`
+
+	// NextAttempt is the earliest time at which another attempt will be
+	// made, if the machine is backing off from a previous attempt.
+	NextAttempt *time.Time `json:"next-attempt,omitempty"`
+
+	// History holds the most recent recovery attempts, oldest first.
+	History []MachineRecoveryAttempt `json:"history,omitempty"`
+}
+
+// MachineRecoveryAttempt holds details of an attempt to recover a machine.
+type MachineRecoveryAttempt struct {
+	AttemptedAt time.Time `json:"attempted-at"`
+	InstanceId  string    `json:"instance-id"`
+	Reason      string    `json:"reason"`
+	Error       string    `json:"error,omitempty"`
 }
 
 // LXDProfile holds status info about a LXDProfile
@@ -160,6 +197,10 @@ type ApplicationStatus struct {
 	WorkloadVersion  string                     `json:"workload-version"`
 	EndpointBindings map[string]string          `json:"endpoint-bindings"`
 
+	// PlacementViolations describes the units of the application whose
+	// placement breaks the placement rules of the application.
+	PlacementViolations []string `json:"placement-violations,omitempty"`
+
 	// The following are for CAAS models.
 	Scale         int    `json:"int,omitempty"`
 	ProviderId    string `json:"provider-id,omitempty"`